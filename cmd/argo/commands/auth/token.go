@@ -1,25 +1,126 @@
 package auth
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 )
 
+type tokenOps struct {
+	serviceAccount string        // --service-account
+	expiresIn      time.Duration // --expires-in
+	output         string        // --output
+}
+
+// createsToken returns whether a service account token is requested, rather than printing the current auth token
+func (o tokenOps) createsToken(cmd *cobra.Command) bool {
+	return cmd.Flags().Changed("service-account") || cmd.Flags().Changed("expires-in") || o.output != ""
+}
+
 func NewTokenCommand() *cobra.Command {
-	return &cobra.Command{
+	var tokenOpts tokenOps
+	command := &cobra.Command{
 		Use:   "token",
 		Short: "Print the auth token",
-		Args:  cobra.NoArgs,
+		Long: `Print the auth token.
+
+With --service-account or --expires-in, a token is created for the service account in the current namespace using the TokenRequest API, so that automation can call the Argo API with a token that expires.`,
+		Example: `# Print the current auth token:
+
+  argo auth token
+
+# Print a token for the "default" service account which expires in an hour:
+
+  argo auth token --expires-in 1h
+
+# Write a kubeconfig which uses a token for the "automation" service account:
+
+  argo auth token --service-account automation --expires-in 24h -o kubeconfig > automation.kubeconfig
+`,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			authString, err := client.GetAuthString()
+			if tokenOpts.output != "" && tokenOpts.output != "kubeconfig" {
+				return fmt.Errorf("unknown output format: %s", tokenOpts.output)
+			}
+			if !tokenOpts.createsToken(cmd) {
+				authString, err := client.GetAuthString()
+				if err != nil {
+					return err
+				}
+				fmt.Println(authString)
+				return nil
+			}
+			ctx := cmd.Context()
+			restConfig, err := client.GetConfig().ClientConfig()
 			if err != nil {
 				return err
 			}
-			fmt.Println(authString)
+			kubeClient, err := kubernetes.NewForConfig(restConfig)
+			if err != nil {
+				return err
+			}
+			namespace := client.Namespace(ctx)
+			token, err := createServiceAccountToken(ctx, kubeClient, namespace, tokenOpts)
+			if err != nil {
+				return err
+			}
+			if tokenOpts.output == "kubeconfig" {
+				data, err := tokenKubeconfig(restConfig, namespace, tokenOpts.serviceAccount, token)
+				if err != nil {
+					return err
+				}
+				fmt.Print(string(data))
+				return nil
+			}
+			fmt.Println("Bearer " + token)
 			return nil
 		},
 	}
+	command.Flags().StringVar(&tokenOpts.serviceAccount, "service-account", "default", "service account to create a token for")
+	command.Flags().DurationVar(&tokenOpts.expiresIn, "expires-in", time.Hour, "duration the created token is valid for")
+	command.Flags().StringVarP(&tokenOpts.output, "output", "o", "", "Output format. One of: kubeconfig")
+	return command
+}
+
+// createServiceAccountToken requests a token for the service account which expires after --expires-in
+func createServiceAccountToken(ctx context.Context, kubeClient kubernetes.Interface, namespace string, tokenOpts tokenOps) (string, error) {
+	if tokenOpts.expiresIn <= 0 {
+		return "", fmt.Errorf("--expires-in must be greater than zero")
+	}
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: ptr.To(int64(tokenOpts.expiresIn.Seconds()))},
+	}
+	created, err := kubeClient.CoreV1().ServiceAccounts(namespace).CreateToken(ctx, tokenOpts.serviceAccount, tokenRequest, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to create a token for service account %s/%s: %w", namespace, tokenOpts.serviceAccount, err)
+	}
+	return created.Status.Token, nil
+}
+
+// tokenKubeconfig returns a kubeconfig for the cluster of the rest config, which authenticates with the token
+func tokenKubeconfig(restConfig *restclient.Config, namespace, serviceAccount, token string) ([]byte, error) {
+	name := namespace + "/" + serviceAccount
+	config := clientcmdapi.NewConfig()
+	config.Clusters[name] = &clientcmdapi.Cluster{
+		Server:                   restConfig.Host,
+		TLSServerName:            restConfig.TLSClientConfig.ServerName,
+		InsecureSkipTLSVerify:    restConfig.TLSClientConfig.Insecure,
+		CertificateAuthority:     restConfig.TLSClientConfig.CAFile,
+		CertificateAuthorityData: restConfig.TLSClientConfig.CAData,
+	}
+	config.AuthInfos[name] = &clientcmdapi.AuthInfo{Token: token}
+	config.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: name, Namespace: namespace}
+	config.CurrentContext = name
+	return clientcmd.Write(*config)
 }
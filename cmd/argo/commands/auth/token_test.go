@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	restclient "k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func Test_createServiceAccountToken(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Create", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset()
+		var got *authenticationv1.TokenRequest
+		kubeClient.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			create := action.(k8stesting.CreateAction)
+			assert.Equal(t, "token", create.GetSubresource())
+			assert.Equal(t, "argo", create.GetNamespace())
+			got = create.GetObject().(*authenticationv1.TokenRequest)
+			return true, &authenticationv1.TokenRequest{Status: authenticationv1.TokenRequestStatus{Token: "my-token"}}, nil
+		})
+
+		token, err := createServiceAccountToken(ctx, kubeClient, "argo", tokenOps{serviceAccount: "automation", expiresIn: time.Hour})
+		require.NoError(t, err)
+		assert.Equal(t, "my-token", token)
+		require.NotNil(t, got)
+		require.NotNil(t, got.Spec.ExpirationSeconds)
+		assert.Equal(t, int64(3600), *got.Spec.ExpirationSeconds)
+	})
+	t.Run("NoExpiry", func(t *testing.T) {
+		_, err := createServiceAccountToken(ctx, fake.NewSimpleClientset(), "argo", tokenOps{serviceAccount: "automation"})
+		require.EqualError(t, err, "--expires-in must be greater than zero")
+	})
+}
+
+func Test_tokenKubeconfig(t *testing.T) {
+	restConfig := &restclient.Config{
+		Host:            "https://kubernetes.example.com",
+		TLSClientConfig: restclient.TLSClientConfig{CAData: []byte("my-ca")},
+	}
+	data, err := tokenKubeconfig(restConfig, "argo", "automation", "my-token")
+	require.NoError(t, err)
+
+	config, err := clientcmd.Load(data)
+	require.NoError(t, err)
+	assert.Equal(t, "argo/automation", config.CurrentContext)
+	assert.Equal(t, "argo", config.Contexts["argo/automation"].Namespace)
+	assert.Equal(t, "https://kubernetes.example.com", config.Clusters["argo/automation"].Server)
+	assert.Equal(t, []byte("my-ca"), config.Clusters["argo/automation"].CertificateAuthorityData)
+	assert.Equal(t, "my-token", config.AuthInfos["argo/automation"].Token)
+}
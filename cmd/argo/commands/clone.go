@@ -0,0 +1,156 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+type cloneOps struct {
+	namespace   string   // --namespace
+	toNamespace string   // --to-namespace
+	parameters  []string // --param-override
+}
+
+func NewCloneCommand() *cobra.Command {
+	var (
+		cloneOpts cloneOps
+		output    = common.NewPrintWorkflowOutputValue("")
+	)
+	command := &cobra.Command{
+		Use:   "clone WORKFLOW",
+		Short: "clone a workflow into another namespace",
+		Long: `Create a new workflow in another namespace from the spec of an existing workflow.
+
+The status of the workflow is not copied. Synchronization and volume claim templates that refer to the workflow's namespace are changed to refer to the new namespace.
+Service accounts, secrets, config maps, persistent volume claims and workflow templates are referred to by name, so they must also exist in the new namespace.`,
+		Example: `# Clone a workflow from the current namespace into the "team-b" namespace:
+
+  argo clone my-wf --to-namespace team-b
+
+# Clone a workflow from the "team-a" namespace, overriding a parameter:
+
+  argo clone my-wf -n team-a --to-namespace team-b --param-override message=hello
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			ctx, apiClient, err := client.NewAPIClient(ctx)
+			if err != nil {
+				return err
+			}
+			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
+			cloneOpts.namespace = client.Namespace(ctx)
+			created, err := cloneWorkflowToNamespace(ctx, serviceClient, cloneOpts, args[0])
+			if err != nil {
+				return err
+			}
+			return printWorkflow(created, common.GetFlags{Output: output})
+		},
+	}
+	command.Flags().StringVar(&cloneOpts.toNamespace, "to-namespace", "", "namespace to create the new workflow in")
+	command.Flags().StringArrayVar(&cloneOpts.parameters, "param-override", []string{}, "input parameter to override on the original workflow spec, as NAME=VALUE")
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	_ = command.MarkFlagRequired("to-namespace")
+	return command
+}
+
+// cloneWorkflowToNamespace gets a workflow and creates a copy of it in another namespace
+func cloneWorkflowToNamespace(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, cloneOpts cloneOps, name string) (*wfv1.Workflow, error) {
+	if cloneOpts.toNamespace == "" {
+		return nil, errors.New("--to-namespace is required")
+	}
+	wf, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Name: name, Namespace: cloneOpts.namespace})
+	if err != nil {
+		return nil, err
+	}
+	newWf, err := cloneWorkflow(wf, cloneOpts.toNamespace, cloneOpts.parameters)
+	if err != nil {
+		return nil, err
+	}
+	return serviceClient.CreateWorkflow(ctx, &workflowpkg.WorkflowCreateRequest{Namespace: newWf.Namespace, Workflow: newWf})
+}
+
+// cloneWorkflow returns a new workflow in the namespace, with the spec, labels and annotations of the workflow
+func cloneWorkflow(wf *wfv1.Workflow, namespace string, parameters []string) (*wfv1.Workflow, error) {
+	newWf := &wfv1.Workflow{
+		TypeMeta: wf.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: wf.GenerateName,
+			Namespace:    namespace,
+			Labels:       map[string]string{},
+			Annotations:  map[string]string{},
+		},
+		Spec: *wf.Spec.DeepCopy(),
+	}
+	if newWf.GenerateName == "" {
+		newWf.GenerateName = wf.Name + "-"
+	}
+	for key, val := range wf.Labels {
+		switch key {
+		case wfcommon.LabelKeyCreator, wfcommon.LabelKeyCreatorEmail, wfcommon.LabelKeyCreatorPreferredUsername,
+			wfcommon.LabelKeyPhase, wfcommon.LabelKeyCompleted, wfcommon.LabelKeyWorkflowArchivingStatus:
+			// set by the server and the controller for the new workflow
+		default:
+			newWf.Labels[key] = val
+		}
+	}
+	for key, val := range wf.Annotations {
+		newWf.Annotations[key] = val
+	}
+
+	if newWf.Spec.ActiveDeadlineSeconds != nil && *newWf.Spec.ActiveDeadlineSeconds == 0 {
+		// if it was terminated, unset the deadline
+		newWf.Spec.ActiveDeadlineSeconds = nil
+	}
+	newWf.Spec.Shutdown = ""
+
+	for i := range newWf.Spec.VolumeClaimTemplates {
+		if newWf.Spec.VolumeClaimTemplates[i].Namespace == wf.Namespace {
+			newWf.Spec.VolumeClaimTemplates[i].Namespace = namespace
+		}
+	}
+	cloneSynchronization(newWf.Spec.Synchronization, wf.Namespace, namespace)
+	for _, tmpl := range newWf.Spec.Templates {
+		cloneSynchronization(tmpl.Synchronization, wf.Namespace, namespace)
+	}
+
+	if err := util.ApplySubmitOpts(newWf, &wfv1.SubmitOpts{Parameters: parameters}); err != nil {
+		return nil, err
+	}
+	return newWf, nil
+}
+
+// cloneSynchronization changes the semaphores and mutexes in the namespace "from" to the namespace "to"
+func cloneSynchronization(sync *wfv1.Synchronization, from, to string) {
+	if sync == nil {
+		return
+	}
+	semaphores := sync.Semaphores
+	if sync.Semaphore != nil {
+		semaphores = append([]*wfv1.SemaphoreRef{sync.Semaphore}, semaphores...)
+	}
+	for _, semaphore := range semaphores {
+		if semaphore != nil && semaphore.Namespace == from {
+			semaphore.Namespace = to
+		}
+	}
+	mutexes := sync.Mutexes
+	if sync.Mutex != nil {
+		mutexes = append([]*wfv1.Mutex{sync.Mutex}, mutexes...)
+	}
+	for _, mutex := range mutexes {
+		if mutex != nil && mutex.Namespace == from {
+			mutex.Namespace = to
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowmocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+const cloneWorkflowManifest = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: my-wf
+  namespace: team-a
+  labels:
+    app: my-app
+    workflows.argoproj.io/creator: alice
+    workflows.argoproj.io/phase: Succeeded
+    workflows.argoproj.io/completed: "true"
+  annotations:
+    note: copied
+spec:
+  entrypoint: main
+  shutdown: Terminate
+  activeDeadlineSeconds: 0
+  arguments:
+    parameters:
+    - name: message
+      value: hello
+  synchronization:
+    mutexes:
+    - name: my-mutex
+      namespace: team-a
+    - name: other-mutex
+      namespace: shared
+  volumeClaimTemplates:
+  - metadata:
+      name: work
+      namespace: team-a
+  templates:
+  - name: main
+    synchronization:
+      semaphores:
+      - configMapKeyRef:
+          name: my-config
+          key: workflow
+        namespace: team-a
+    container:
+      image: busybox
+status:
+  phase: Succeeded
+`
+
+func Test_cloneWorkflowToNamespace(t *testing.T) {
+	t.Run("Clone", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		c.On("GetWorkflow", mock.Anything, &workflowpkg.WorkflowGetRequest{Name: "my-wf", Namespace: "team-a"}).
+			Return(wfv1.MustUnmarshalWorkflow(cloneWorkflowManifest), nil)
+		c.On("CreateWorkflow", mock.Anything, mock.Anything).Return(&wfv1.Workflow{}, nil)
+
+		ctx := logging.TestContext(t.Context())
+		_, err := cloneWorkflowToNamespace(ctx, c, cloneOps{namespace: "team-a", toNamespace: "team-b", parameters: []string{"message=goodbye"}}, "my-wf")
+		require.NoError(t, err)
+
+		c.AssertNumberOfCalls(t, "CreateWorkflow", 1)
+		req := c.Calls[1].Arguments.Get(1).(*workflowpkg.WorkflowCreateRequest)
+		assert.Equal(t, "team-b", req.Namespace)
+		wf := req.Workflow
+		assert.Equal(t, "team-b", wf.Namespace)
+		assert.Equal(t, "my-wf-", wf.GenerateName)
+		assert.Empty(t, wf.Name)
+		assert.Equal(t, map[string]string{"app": "my-app"}, wf.Labels)
+		assert.Equal(t, "copied", wf.Annotations["note"])
+		assert.Empty(t, wf.Status.Phase)
+		assert.Empty(t, wf.Spec.Shutdown)
+		assert.Nil(t, wf.Spec.ActiveDeadlineSeconds)
+		assert.Equal(t, "goodbye", wf.Spec.Arguments.Parameters[0].Value.String())
+		assert.Equal(t, "team-b", wf.Spec.Synchronization.Mutexes[0].Namespace)
+		assert.Equal(t, "shared", wf.Spec.Synchronization.Mutexes[1].Namespace)
+		assert.Equal(t, "team-b", wf.Spec.VolumeClaimTemplates[0].Namespace)
+		assert.Equal(t, "team-b", wf.Spec.Templates[0].Synchronization.Semaphores[0].Namespace)
+	})
+
+	t.Run("NoNamespace", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		ctx := logging.TestContext(t.Context())
+		_, err := cloneWorkflowToNamespace(ctx, c, cloneOps{namespace: "team-a"}, "my-wf")
+		require.EqualError(t, err, "--to-namespace is required")
+		c.AssertNotCalled(t, "GetWorkflow", mock.Anything, mock.Anything)
+	})
+}
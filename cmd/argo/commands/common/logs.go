@@ -10,15 +10,16 @@ import (
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 )
 
-func LogWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace, workflow, podName, grep, selector string, logOptions *corev1.PodLogOptions) error {
+func LogWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace, workflow, podName, grep, selector string, nodeStatusFilter []string, logOptions *corev1.PodLogOptions) error {
 	// logs
 	stream, err := serviceClient.WorkflowLogs(ctx, &workflowpkg.WorkflowLogRequest{
-		Name:       workflow,
-		Namespace:  namespace,
-		PodName:    podName,
-		LogOptions: logOptions,
-		Selector:   selector,
-		Grep:       grep,
+		Name:             workflow,
+		Namespace:        namespace,
+		PodName:          podName,
+		LogOptions:       logOptions,
+		Selector:         selector,
+		Grep:             grep,
+		NodeStatusFilter: nodeStatusFilter,
 	})
 	if err != nil {
 		return err
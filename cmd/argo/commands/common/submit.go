@@ -11,15 +11,17 @@ import (
 
 // CliSubmitOpts holds submission options specific to CLI submission (e.g. controlling output)
 type CliSubmitOpts struct {
-	Output        EnumFlagValue // --output
-	Wait          bool          // --wait
-	Watch         bool          // --watch
-	Log           bool          // --log
-	Strict        bool          // --strict
-	Priority      *int32        // --priority
-	GetArgs       GetFlags
-	ScheduledTime string   // --scheduled-time
-	Parameters    []string // --parameter
+	Output            EnumFlagValue // --output
+	Wait              bool          // --wait
+	Watch             bool          // --watch
+	Log               bool          // --log
+	Strict            bool          // --strict
+	Priority          *int32        // --priority
+	GetArgs           GetFlags
+	ScheduledTime     string   // --scheduled-time
+	Parameters        []string // --parameter
+	ValidateResources bool     // --validate-resources
+	Preview           bool     // --preview
 }
 
 func NewCliSubmitOpts() CliSubmitOpts {
@@ -31,7 +33,7 @@ func NewCliSubmitOpts() CliSubmitOpts {
 func WaitWatchOrLog(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, workflowNames []string, cliSubmitOpts CliSubmitOpts) error {
 	if cliSubmitOpts.Log {
 		for _, workflow := range workflowNames {
-			if err := LogWorkflow(ctx, serviceClient, namespace, workflow, "", "", "", &corev1.PodLogOptions{
+			if err := LogWorkflow(ctx, serviceClient, namespace, workflow, "", "", "", nil, &corev1.PodLogOptions{
 				Container: common.MainContainerName,
 				Follow:    true,
 				Previous:  false,
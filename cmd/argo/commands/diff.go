@@ -0,0 +1,233 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// workflowDifference is a single value that differs between two workflows
+type workflowDifference struct {
+	Path  string      `json:"path"`
+	Left  interface{} `json:"left,omitempty"`
+	Right interface{} `json:"right,omitempty"`
+}
+
+type workflowDiff struct {
+	Left        string               `json:"left"`
+	Right       string               `json:"right"`
+	Differences []workflowDifference `json:"differences"`
+}
+
+// nodeSummary is the part of a node that is compared, leaving out fields such as timestamps that always differ
+type nodeSummary struct {
+	Type         wfv1.NodeType  `json:"type,omitempty"`
+	TemplateName string         `json:"templateName,omitempty"`
+	Phase        wfv1.NodePhase `json:"phase,omitempty"`
+	Message      string         `json:"message,omitempty"`
+	Inputs       *wfv1.Inputs   `json:"inputs,omitempty"`
+	Outputs      *wfv1.Outputs  `json:"outputs,omitempty"`
+}
+
+const rootNodeKey = "(root)"
+
+func NewDiffCommand() *cobra.Command {
+	output := common.EnumFlagValue{
+		AllowedValues: []string{"text", "json"},
+		Value:         "text",
+	}
+	command := &cobra.Command{
+		Use:   "diff WORKFLOW1 WORKFLOW2",
+		Short: "show the differences between two workflows",
+		Long:  "Compare the spec, node statuses and outputs of two workflows, e.g. to find out why one run succeeded and another failed. Nodes are matched by their name relative to their workflow.",
+		Example: `# Compare two workflows:
+
+  argo diff my-wf-1 my-wf-2
+
+# Compare two workflows and print the differences as JSON:
+
+  argo diff my-wf-1 my-wf-2 -o json
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			ctx, apiClient, err := client.NewAPIClient(ctx)
+			if err != nil {
+				return err
+			}
+			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
+			namespace := client.Namespace(ctx)
+			var wfs []*wfv1.Workflow
+			for _, name := range args {
+				wf, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{
+					Name:      name,
+					Namespace: namespace,
+				})
+				if err != nil {
+					return err
+				}
+				wfs = append(wfs, wf)
+			}
+			diff, err := diffWorkflows(wfs[0], wfs[1])
+			if err != nil {
+				return err
+			}
+			return printWorkflowDiff(os.Stdout, diff, output.String())
+		},
+	}
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
+
+// diffWorkflows compares the spec, status.nodes and status.outputs of two workflows
+func diffWorkflows(left, right *wfv1.Workflow) (*workflowDiff, error) {
+	diff := &workflowDiff{Left: left.Name, Right: right.Name, Differences: []workflowDifference{}}
+	for _, item := range []struct {
+		path        string
+		left, right interface{}
+	}{
+		{"spec", left.Spec, right.Spec},
+		{"status.phase", left.Status.Phase, right.Status.Phase},
+		{"status.nodes", nodeSummaries(left), nodeSummaries(right)},
+		{"status.outputs", left.Status.Outputs, right.Status.Outputs},
+	} {
+		l, err := toJSONValue(item.left)
+		if err != nil {
+			return nil, err
+		}
+		r, err := toJSONValue(item.right)
+		if err != nil {
+			return nil, err
+		}
+		diffValues(item.path, l, r, &diff.Differences)
+	}
+	return diff, nil
+}
+
+// nodeSummaries keys the nodes of a workflow by their name relative to the workflow name, as node IDs and names
+// differ between workflows
+func nodeSummaries(wf *wfv1.Workflow) map[string]nodeSummary {
+	summaries := make(map[string]nodeSummary, len(wf.Status.Nodes))
+	for _, node := range wf.Status.Nodes {
+		key := strings.TrimPrefix(strings.TrimPrefix(node.Name, wf.Name), ".")
+		if key == "" {
+			key = rootNodeKey
+		}
+		summaries[key] = nodeSummary{
+			Type:         node.Type,
+			TemplateName: node.TemplateName,
+			Phase:        node.Phase,
+			Message:      node.Message,
+			Inputs:       node.Inputs,
+			Outputs:      node.Outputs,
+		}
+	}
+	return summaries
+}
+
+// toJSONValue converts v to the generic maps, slices and scalars it would be decoded to from JSON
+func toJSONValue(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func diffValues(path string, left, right interface{}, diffs *[]workflowDifference) {
+	switch l := left.(type) {
+	case map[string]interface{}:
+		if r, ok := right.(map[string]interface{}); ok {
+			keys := make(map[string]bool)
+			for k := range l {
+				keys[k] = true
+			}
+			for k := range r {
+				keys[k] = true
+			}
+			sorted := make([]string, 0, len(keys))
+			for k := range keys {
+				sorted = append(sorted, k)
+			}
+			sort.Strings(sorted)
+			for _, k := range sorted {
+				diffValues(childPath(path, k), l[k], r[k], diffs)
+			}
+			return
+		}
+	case []interface{}:
+		if r, ok := right.([]interface{}); ok {
+			for i := 0; i < len(l) || i < len(r); i++ {
+				var li, ri interface{}
+				if i < len(l) {
+					li = l[i]
+				}
+				if i < len(r) {
+					ri = r[i]
+				}
+				diffValues(fmt.Sprintf("%s[%d]", path, i), li, ri, diffs)
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(left, right) {
+		*diffs = append(*diffs, workflowDifference{Path: path, Left: left, Right: right})
+	}
+}
+
+func childPath(path, key string) string {
+	if path == "status.nodes" {
+		return fmt.Sprintf("%s[%s]", path, key)
+	}
+	return path + "." + key
+}
+
+func printWorkflowDiff(w io.Writer, diff *workflowDiff, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case "text", "":
+		_, _ = fmt.Fprintf(w, "--- %s\n+++ %s\n", diff.Left, diff.Right)
+		if len(diff.Differences) == 0 {
+			_, _ = fmt.Fprintln(w, "No differences found")
+			return nil
+		}
+		for _, d := range diff.Differences {
+			_, _ = fmt.Fprintf(w, "%s:\n  - %s\n  + %s\n", d.Path, formatDiffValue(d.Left), formatDiffValue(d.Right))
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+}
+
+func formatDiffValue(v interface{}) string {
+	if v == nil {
+		return "<none>"
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+var diffWorkflowYaml = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: %s
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: message
+      value: hello
+  templates:
+  - name: main
+    steps:
+    - - name: print
+        template: print
+  - name: print
+    container:
+      image: alpine:3.18
+status:
+  phase: Succeeded
+  startedAt: "2024-01-01T00:00:00Z"
+  nodes:
+    %[1]s:
+      id: %[1]s
+      name: %[1]s
+      type: Steps
+      templateName: main
+      phase: Succeeded
+      startedAt: "2024-01-01T00:00:00Z"
+    %[1]s-123:
+      id: %[1]s-123
+      name: "%[1]s[0].print"
+      type: Pod
+      templateName: print
+      phase: Succeeded
+      startedAt: "2024-01-01T00:00:00Z"
+      outputs:
+        exitCode: "0"
+`
+
+func newDiffWorkflow(t *testing.T, name string) *wfv1.Workflow {
+	t.Helper()
+	return wfv1.MustUnmarshalWorkflow(fmt.Sprintf(diffWorkflowYaml, name))
+}
+
+func Test_diffWorkflows(t *testing.T) {
+	t.Run("Identical", func(t *testing.T) {
+		left := newDiffWorkflow(t, "wf-1")
+		right := newDiffWorkflow(t, "wf-2")
+		// timestamps always differ and are not compared
+		right.Status.StartedAt = metav1.Now()
+		diff, err := diffWorkflows(left, right)
+		require.NoError(t, err)
+		assert.Equal(t, "wf-1", diff.Left)
+		assert.Equal(t, "wf-2", diff.Right)
+		assert.Empty(t, diff.Differences)
+	})
+	t.Run("SpecAndStatus", func(t *testing.T) {
+		left := newDiffWorkflow(t, "wf-1")
+		right := newDiffWorkflow(t, "wf-2")
+		right.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr("goodbye")
+		right.Spec.Templates[1].Container.Image = "alpine:3.19"
+		right.Status.Phase = wfv1.WorkflowFailed
+		node := right.Status.Nodes["wf-2-123"]
+		node.Phase = wfv1.NodeFailed
+		node.Message = "Error (exit code 1)"
+		exitCode := "1"
+		node.Outputs.ExitCode = &exitCode
+		right.Status.Nodes["wf-2-123"] = node
+		diff, err := diffWorkflows(left, right)
+		require.NoError(t, err)
+		assert.Equal(t, []workflowDifference{
+			{Path: "spec.arguments.parameters[0].value", Left: "hello", Right: "goodbye"},
+			{Path: "spec.templates[1].container.image", Left: "alpine:3.18", Right: "alpine:3.19"},
+			{Path: "status.phase", Left: "Succeeded", Right: "Failed"},
+			{Path: "status.nodes[[0].print].message", Right: "Error (exit code 1)"},
+			{Path: "status.nodes[[0].print].outputs.exitCode", Left: "0", Right: "1"},
+			{Path: "status.nodes[[0].print].phase", Left: "Succeeded", Right: "Failed"},
+		}, diff.Differences)
+	})
+	t.Run("MissingNode", func(t *testing.T) {
+		left := newDiffWorkflow(t, "wf-1")
+		right := newDiffWorkflow(t, "wf-2")
+		delete(right.Status.Nodes, "wf-2-123")
+		right.Status.Outputs = &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "result", Value: wfv1.AnyStringPtr("x")}}}
+		diff, err := diffWorkflows(left, right)
+		require.NoError(t, err)
+		require.Len(t, diff.Differences, 2)
+		assert.Equal(t, "status.nodes[[0].print]", diff.Differences[0].Path)
+		assert.NotNil(t, diff.Differences[0].Left)
+		assert.Nil(t, diff.Differences[0].Right)
+		assert.Equal(t, "status.outputs", diff.Differences[1].Path)
+		assert.Nil(t, diff.Differences[1].Left)
+	})
+}
+
+func Test_printWorkflowDiff(t *testing.T) {
+	diff := &workflowDiff{
+		Left:  "wf-1",
+		Right: "wf-2",
+		Differences: []workflowDifference{
+			{Path: "status.phase", Left: "Succeeded", Right: "Failed"},
+			{Path: "status.nodes[[0].print].message", Right: "Error (exit code 1)"},
+		},
+	}
+	t.Run("Text", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printWorkflowDiff(&out, diff, "text"))
+		assert.Equal(t, `--- wf-1
++++ wf-2
+status.phase:
+  - "Succeeded"
+  + "Failed"
+status.nodes[[0].print].message:
+  - <none>
+  + "Error (exit code 1)"
+`, out.String())
+	})
+	t.Run("TextNoDifferences", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printWorkflowDiff(&out, &workflowDiff{Left: "wf-1", Right: "wf-2"}, "text"))
+		assert.Equal(t, "--- wf-1\n+++ wf-2\nNo differences found\n", out.String())
+	})
+	t.Run("JSON", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printWorkflowDiff(&out, diff, "json"))
+		var printed workflowDiff
+		require.NoError(t, json.Unmarshal(out.Bytes(), &printed))
+		assert.Equal(t, *diff, printed)
+	})
+}
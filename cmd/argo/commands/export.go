@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+type exportOps struct {
+	namespace     string // --namespace
+	includeStatus bool   // --include-status
+}
+
+func NewExportCommand() *cobra.Command {
+	var exportOpts exportOps
+	command := &cobra.Command{
+		Use:   "export WORKFLOW",
+		Short: "export a workflow as a portable YAML manifest",
+		Long: `Print a workflow as YAML, with the metadata set by the server removed so that it can be used in another namespace or cluster.
+
+With --include-status the status of the workflow is exported too, so that the manifest is a full snapshot of a completed workflow, which can be added to the workflow archive of another cluster with 'argo import'.`,
+		Example: `# Export the spec of a workflow:
+
+  argo export my-wf > my-wf.yaml
+
+# Export a snapshot of a completed workflow, including its status:
+
+  argo export my-wf --include-status > my-wf.yaml
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			ctx, apiClient, err := client.NewAPIClient(ctx)
+			if err != nil {
+				return err
+			}
+			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
+			exportOpts.namespace = client.Namespace(ctx)
+			out, err := exportWorkflow(ctx, serviceClient, exportOpts, args[0])
+			if err != nil {
+				return err
+			}
+			_, err = os.Stdout.Write(out)
+			return err
+		},
+	}
+	command.Flags().BoolVar(&exportOpts.includeStatus, "include-status", false, "include the status of the workflow")
+	return command
+}
+
+// exportWorkflow gets a workflow and returns it as YAML, with server-managed metadata removed
+func exportWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, exportOpts exportOps, name string) ([]byte, error) {
+	wf, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Name: name, Namespace: exportOpts.namespace})
+	if err != nil {
+		return nil, err
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(portableWorkflow(wf, exportOpts.includeStatus))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert workflow %q: %w", wf.Name, err)
+	}
+	// these are always marshaled, but are empty for a portable workflow
+	unstructured.RemoveNestedField(obj, "metadata", "creationTimestamp")
+	if !exportOpts.includeStatus {
+		unstructured.RemoveNestedField(obj, "status")
+	}
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal workflow %q: %w", wf.Name, err)
+	}
+	return out, nil
+}
+
+// portableWorkflow returns the workflow with server-managed metadata removed, so that it can be used in another
+// namespace or cluster. The status is only kept if includeStatus is set.
+func portableWorkflow(wf *wfv1.Workflow, includeStatus bool) *wfv1.Workflow {
+	newWf := &wfv1.Workflow{
+		TypeMeta: metav1.TypeMeta{APIVersion: workflow.APIVersion, Kind: workflow.WorkflowKind},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        wf.Name,
+			Labels:      map[string]string{},
+			Annotations: wf.Annotations,
+		},
+		Spec: *wf.Spec.DeepCopy(),
+	}
+	for key, val := range wf.Labels {
+		if key == wfcommon.LabelKeyWorkflowArchivingStatus {
+			// set by the controller, and by the archive when the workflow is imported
+			continue
+		}
+		newWf.Labels[key] = val
+	}
+	if includeStatus {
+		newWf.Status = *wf.Status.DeepCopy()
+		// the nodes of an offloaded workflow are hydrated by the server
+		newWf.Status.OffloadNodeStatusVersion = ""
+	}
+	return newWf
+}
@@ -0,0 +1,157 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowmocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+const exportWorkflowManifest = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: my-wf
+  namespace: team-a
+  uid: 4c2d7a35-1b8e-4f0e-9a6b-2f4b1c3d5e6f
+  resourceVersion: "1234"
+  generation: 7
+  creationTimestamp: "2024-01-01T00:00:00Z"
+  labels:
+    app: my-app
+    workflows.argoproj.io/completed: "true"
+    workflows.argoproj.io/phase: Succeeded
+    workflows.argoproj.io/workflow-archiving-status: Archived
+  annotations:
+    note: exported
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: busybox
+status:
+  phase: Succeeded
+  startedAt: "2024-01-01T00:00:00Z"
+  finishedAt: "2024-01-01T00:01:00Z"
+  offloadNodeStatusVersion: fnv-123
+  nodes:
+    my-wf:
+      id: my-wf
+      name: my-wf
+      displayName: my-wf
+      type: Pod
+      templateName: main
+      phase: Succeeded
+      startedAt: "2024-01-01T00:00:00Z"
+      finishedAt: "2024-01-01T00:01:00Z"
+      outputs:
+        exitCode: "0"
+`
+
+// fakeArchivedWorkflowServiceClient records the workflows that are archived
+type fakeArchivedWorkflowServiceClient struct {
+	workflowarchivepkg.ArchivedWorkflowServiceClient
+	archived []*wfv1.Workflow
+}
+
+func (c *fakeArchivedWorkflowServiceClient) CreateArchivedWorkflow(_ context.Context, in *workflowarchivepkg.CreateArchivedWorkflowRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	wf := in.Workflow.DeepCopy()
+	wf.UID = "new-uid"
+	c.archived = append(c.archived, wf)
+	return wf, nil
+}
+
+func exportMockClient() *workflowmocks.WorkflowServiceClient {
+	c := &workflowmocks.WorkflowServiceClient{}
+	c.On("GetWorkflow", mock.Anything, &workflowpkg.WorkflowGetRequest{Name: "my-wf", Namespace: "team-a"}).
+		Return(wfv1.MustUnmarshalWorkflow(exportWorkflowManifest), nil)
+	return c
+}
+
+func Test_exportWorkflow(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Spec", func(t *testing.T) {
+		out, err := exportWorkflow(ctx, exportMockClient(), exportOps{namespace: "team-a"}, "my-wf")
+		require.NoError(t, err)
+		wf := wfv1.MustUnmarshalWorkflow(out)
+		assert.Equal(t, "my-wf", wf.Name)
+		assert.Empty(t, wf.Namespace)
+		assert.Empty(t, wf.UID)
+		assert.Empty(t, wf.ResourceVersion)
+		assert.Zero(t, wf.Generation)
+		assert.True(t, wf.CreationTimestamp.IsZero())
+		assert.Equal(t, map[string]string{"app": "my-app", "workflows.argoproj.io/completed": "true", "workflows.argoproj.io/phase": "Succeeded"}, wf.Labels)
+		assert.Equal(t, "exported", wf.Annotations["note"])
+		assert.Equal(t, "main", wf.Spec.Entrypoint)
+		assert.Empty(t, wf.Status.Phase)
+		assert.NotContains(t, string(out), "status:")
+		assert.NotContains(t, string(out), "creationTimestamp")
+	})
+	t.Run("IncludeStatus", func(t *testing.T) {
+		out, err := exportWorkflow(ctx, exportMockClient(), exportOps{namespace: "team-a", includeStatus: true}, "my-wf")
+		require.NoError(t, err)
+		wf := wfv1.MustUnmarshalWorkflow(out)
+		assert.Equal(t, wfv1.WorkflowSucceeded, wf.Status.Phase)
+		assert.Empty(t, wf.Status.OffloadNodeStatusVersion)
+		assert.Equal(t, wfv1.NodeSucceeded, wf.Status.Nodes["my-wf"].Phase)
+	})
+}
+
+func Test_exportImportRoundTrip(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	out, err := exportWorkflow(ctx, exportMockClient(), exportOps{namespace: "team-a", includeStatus: true}, "my-wf")
+	require.NoError(t, err)
+	file := filepath.Join(t.TempDir(), "my-wf.yaml")
+	require.NoError(t, os.WriteFile(file, out, 0o644))
+
+	workflows, err := readExportedWorkflows(ctx, []string{file}, true)
+	require.NoError(t, err)
+	archiveClient := &fakeArchivedWorkflowServiceClient{}
+	require.NoError(t, importWorkflows(ctx, archiveClient, "team-b", workflows))
+
+	require.Len(t, archiveClient.archived, 1)
+	original := wfv1.MustUnmarshalWorkflow(exportWorkflowManifest)
+	imported := archiveClient.archived[0]
+	assert.Equal(t, "my-wf", imported.Name)
+	assert.Equal(t, "team-b", imported.Namespace)
+	assert.Equal(t, original.Spec, imported.Spec)
+	original.Status.OffloadNodeStatusVersion = ""
+	assert.Equal(t, original.Status, imported.Status)
+
+	// exporting the imported workflow again gives the same snapshot
+	c := &workflowmocks.WorkflowServiceClient{}
+	c.On("GetWorkflow", mock.Anything, mock.Anything).Return(imported, nil)
+	reexported, err := exportWorkflow(ctx, c, exportOps{namespace: "team-b", includeStatus: true}, "my-wf")
+	require.NoError(t, err)
+	assert.Equal(t, string(out), string(reexported))
+}
+
+func Test_importWorkflows(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("WithoutStatus", func(t *testing.T) {
+		out, err := exportWorkflow(ctx, exportMockClient(), exportOps{namespace: "team-a"}, "my-wf")
+		require.NoError(t, err)
+		archiveClient := &fakeArchivedWorkflowServiceClient{}
+		err = importWorkflows(ctx, archiveClient, "team-a", []wfv1.Workflow{*wfv1.MustUnmarshalWorkflow(out)})
+		require.EqualError(t, err, `workflow "my-wf" has not completed, only workflows exported with --include-status after they completed can be imported`)
+		assert.Empty(t, archiveClient.archived)
+	})
+	t.Run("KeepsNamespace", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(exportWorkflowManifest)
+		archiveClient := &fakeArchivedWorkflowServiceClient{}
+		require.NoError(t, importWorkflows(ctx, archiveClient, "team-b", []wfv1.Workflow{*wf}))
+		assert.Equal(t, "team-a", archiveClient.archived[0].Namespace)
+	})
+}
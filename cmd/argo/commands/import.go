@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+type importOps struct {
+	namespace string // --namespace
+	strict    bool   // --strict
+}
+
+func NewImportCommand() *cobra.Command {
+	var importOpts importOps
+	command := &cobra.Command{
+		Use:   "import FILE...",
+		Short: "import completed workflows into the workflow archive",
+		Long: `Add workflows exported with 'argo export --include-status' to the workflow archive.
+
+Only completed workflows can be imported. The workflows are not created in the cluster, but can be viewed, retried and resubmitted like any other archived workflow.
+Workflows without a namespace are imported into the current namespace. This command requires the Argo Server, with the workflow archive enabled.`,
+		Example: `# Import a workflow exported from another cluster:
+
+  argo import my-wf.yaml
+
+# Import a workflow from stdin:
+
+  argo export my-wf --include-status | argo import -
+`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			ctx, apiClient, err := client.NewAPIClient(ctx)
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			if err != nil {
+				return err
+			}
+			importOpts.namespace = client.Namespace(ctx)
+			workflows, err := readExportedWorkflows(ctx, args, importOpts.strict)
+			if err != nil {
+				return err
+			}
+			return importWorkflows(ctx, serviceClient, importOpts.namespace, workflows)
+		},
+	}
+	command.Flags().BoolVar(&importOpts.strict, "strict", true, "perform strict workflow validation")
+	return command
+}
+
+// readExportedWorkflows reads the workflows in the files
+func readExportedWorkflows(ctx context.Context, filePaths []string, strict bool) ([]wfv1.Workflow, error) {
+	fileContents, err := util.ReadManifest(filePaths...)
+	if err != nil {
+		return nil, err
+	}
+	var workflows []wfv1.Workflow
+	for _, body := range fileContents {
+		wfs, err := wfcommon.SplitWorkflowYAMLFile(ctx, body, strict)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse workflow: %w", err)
+		}
+		workflows = append(workflows, wfs...)
+	}
+	if len(workflows) == 0 {
+		return nil, fmt.Errorf("no workflow found in given files")
+	}
+	return workflows, nil
+}
+
+// importWorkflows adds each of the workflows to the archive. All workflows are checked to be complete before any are
+// imported, so that a snapshot exported without --include-status does not leave a partial import.
+func importWorkflows(ctx context.Context, serviceClient workflowarchivepkg.ArchivedWorkflowServiceClient, namespace string, workflows []wfv1.Workflow) error {
+	for _, wf := range workflows {
+		if !wf.Status.Fulfilled() {
+			return fmt.Errorf("workflow %q has not completed, only workflows exported with --include-status after they completed can be imported", wf.Name)
+		}
+	}
+	for _, wf := range workflows {
+		if wf.Namespace == "" {
+			wf.Namespace = namespace
+		}
+		archived, err := serviceClient.CreateArchivedWorkflow(ctx, &workflowarchivepkg.CreateArchivedWorkflowRequest{
+			Namespace: wf.Namespace,
+			Workflow:  &wf,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to import workflow %q: %w", wf.Name, err)
+		}
+		fmt.Printf("Workflow '%s/%s' archived with UID %s\n", archived.Namespace, archived.Name, archived.UID)
+	}
+	return nil
+}
@@ -21,7 +21,7 @@ func NewLintCommand() *cobra.Command {
 		strict    bool
 		lintKinds []string
 		output    = common.EnumFlagValue{
-			AllowedValues: []string{"pretty", "simple"},
+			AllowedValues: []string{"pretty", "simple", "sarif"},
 			Value:         "pretty",
 		}
 		offline bool
@@ -37,7 +37,11 @@ func NewLintCommand() *cobra.Command {
 
 # Lint only manifests of Workflows and CronWorkflows from stdin:
 
-  cat manifests.yaml | argo lint --kinds=workflows,cronworkflows -`,
+  cat manifests.yaml | argo lint --kinds=workflows,cronworkflows -
+
+# Lint all manifests offline, and write the errors as SARIF for code scanning tools:
+
+  argo lint --offline --output sarif ./manifests > argo-lint.sarif`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runLint(cmd.Context(), args, offline, lintKinds, output.String(), strict)
@@ -15,11 +15,12 @@ import (
 
 func NewLogsCommand() *cobra.Command {
 	var (
-		since     time.Duration
-		sinceTime string
-		tailLines int64
-		grep      string
-		selector  string
+		since            time.Duration
+		sinceTime        string
+		tailLines        int64
+		grep             string
+		selector         string
+		nodeStatusFilter []string
 	)
 	logOptions := &corev1.PodLogOptions{}
 	command := &cobra.Command{
@@ -49,6 +50,10 @@ func NewLogsCommand() *cobra.Command {
 
   argo logs --since=1h my-pod
 
+# Follow the logs of only the failed nodes of a workflow:
+
+  argo logs my-wf --follow --node-status-filter Failed,Error
+
 # Print the logs of the latest workflow:
   argo logs @latest
 `,
@@ -92,7 +97,7 @@ func NewLogsCommand() *cobra.Command {
 			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
 			namespace := client.Namespace(ctx)
 
-			return common.LogWorkflow(ctx, serviceClient, namespace, workflow, podName, grep, selector, logOptions)
+			return common.LogWorkflow(ctx, serviceClient, namespace, workflow, podName, grep, selector, nodeStatusFilter, logOptions)
 		},
 	}
 	command.Flags().StringVarP(&logOptions.Container, "container", "c", "main", "Print the logs of this container")
@@ -103,6 +108,7 @@ func NewLogsCommand() *cobra.Command {
 	command.Flags().Int64Var(&tailLines, "tail", -1, "If set, the number of lines from the end of the logs to show. If not specified, logs are shown from the creation of the container or sinceSeconds or sinceTime")
 	command.Flags().StringVar(&grep, "grep", "", "grep for lines")
 	command.Flags().StringVarP(&selector, "selector", "l", "", "log selector for some pod")
+	command.Flags().StringSliceVar(&nodeStatusFilter, "node-status-filter", []string{}, "Only print the logs of nodes in these phases, e.g. Failed,Error")
 	command.Flags().BoolVar(&logOptions.Timestamps, "timestamps", false, "Include timestamps on each line in the log output")
 	command.Flags().BoolVar(&common.NoColor, "no-color", false, "Disable colorized output")
 	return command
@@ -1,16 +1,21 @@
 package commands
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
 type setOps struct {
@@ -22,6 +27,7 @@ type setOps struct {
 
 func NewNodeCommand() *cobra.Command {
 	var setArgs setOps
+	logOptions := &corev1.PodLogOptions{}
 
 	command := &cobra.Command{
 		Use:   "node ACTION WORKFLOW FLAGS",
@@ -33,9 +39,27 @@ func NewNodeCommand() *cobra.Command {
 # Set the message of a node within a workflow:
 
   argo node set my-wf --message "We did it!"" --node-field-selector displayName=approve
+
+# Print the logs of a node, by its ID, name or display name:
+
+  argo node logs my-wf my-node
+
+# Print the logs of the previous instance of a node's container, e.g. before it was OOM killed and restarted:
+
+  argo node logs my-wf my-node --previous
 `,
 		Args: cobra.MinimumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if args[0] == "logs" {
+				if len(args) != 3 {
+					return fmt.Errorf("expected WORKFLOW and NODE, got %d arguments", len(args)-1)
+				}
+				ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+				if err != nil {
+					return err
+				}
+				return logNode(ctx, apiClient.NewWorkflowServiceClient(ctx), client.Namespace(ctx), args[1], args[2], logOptions)
+			}
 			if args[0] != "set" {
 				return fmt.Errorf("unknown action '%s'", args[0])
 			}
@@ -93,5 +117,40 @@ func NewNodeCommand() *cobra.Command {
 	command.Flags().StringVar(&setArgs.phase, "phase", "", "Phase to set the node to, eg: --phase Succeeded")
 	command.Flags().StringArrayVarP(&setArgs.outputParameters, "output-parameter", "p", []string{}, "Set a \"supplied\" output parameter of node, eg: --output-parameter parameter-name=\"Hello, world!\"")
 	command.Flags().StringVarP(&setArgs.message, "message", "m", "", "Set the message of a node, eg: --message \"Hello, world!\"")
+	command.Flags().StringVarP(&logOptions.Container, "container", "c", "main", "Print the logs of this container of the node")
+	command.Flags().BoolVarP(&logOptions.Follow, "follow", "f", false, "Specify if the logs of the node should be streamed.")
+	command.Flags().BoolVar(&logOptions.Previous, "previous", false, "Print the logs of the previously terminated instance of the node's container.")
 	return command
 }
+
+// logNode prints the logs of the pod of the node, which is its ID, name or display name
+func logNode(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace, workflow, node string, logOptions *corev1.PodLogOptions) error {
+	wf, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Name: workflow, Namespace: namespace})
+	if err != nil {
+		return err
+	}
+	podName, err := nodePodName(wf, node)
+	if err != nil {
+		return err
+	}
+	return common.LogWorkflow(ctx, serviceClient, namespace, wf.Name, podName, "", "", nil, logOptions)
+}
+
+// nodePodName returns the name of the pod of the node, which is its ID, name or display name
+func nodePodName(wf *wfv1.Workflow, node string) (string, error) {
+	n, ok := wf.Status.Nodes[node]
+	if !ok {
+		found := wf.Status.Nodes.FindByName(node)
+		if found == nil {
+			found = wf.Status.Nodes.FindByDisplayName(node)
+		}
+		if found == nil {
+			return "", fmt.Errorf("node %q not found in workflow %q", node, wf.Name)
+		}
+		n = *found
+	}
+	if n.Type != wfv1.NodeTypePod {
+		return "", fmt.Errorf("node %q is a %s node, which has no logs", node, n.Type)
+	}
+	return util.GenerateWorkflowPodName(wf, n.Name, util.GetTemplateFromNode(n), n.ID), nil
+}
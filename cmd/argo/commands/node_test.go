@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func Test_nodePodName(t *testing.T) {
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Annotations: map[string]string{common.AnnotationKeyPodNameVersion: "v2"}},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"my-wf":            {ID: "my-wf", Name: "my-wf", DisplayName: "my-wf", Type: wfv1.NodeTypeDAG},
+			"my-wf-2303241693": {ID: "my-wf-2303241693", Name: "my-wf.build", DisplayName: "build", TemplateName: "builder", Type: wfv1.NodeTypePod},
+		}},
+	}
+	for _, node := range []string{"my-wf-2303241693", "my-wf.build", "build"} {
+		podName, err := nodePodName(wf, node)
+		require.NoError(t, err)
+		assert.Equal(t, "my-wf-builder-2303241693", podName)
+	}
+	_, err := nodePodName(wf, "my-wf")
+	require.EqualError(t, err, `node "my-wf" is a DAG node, which has no logs`)
+	_, err = nodePodName(wf, "test")
+	require.EqualError(t, err, `node "test" not found in workflow "my-wf"`)
+}
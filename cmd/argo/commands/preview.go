@@ -0,0 +1,137 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient"
+	clusterworkflowtmplpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/template"
+	wfcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// workflowTemplateSpecGetter returns the spec of the workflow template or cluster workflow template a workflow references
+type workflowTemplateSpecGetter func(ctx context.Context, namespace string, ref *wfv1.WorkflowTemplateRef) (*wfv1.WorkflowSpec, error)
+
+func newWorkflowTemplateSpecGetter(apiClient apiclient.Client) workflowTemplateSpecGetter {
+	return func(ctx context.Context, namespace string, ref *wfv1.WorkflowTemplateRef) (*wfv1.WorkflowSpec, error) {
+		if ref.ClusterScope {
+			serviceClient, err := apiClient.NewClusterWorkflowTemplateServiceClient()
+			if err != nil {
+				return nil, err
+			}
+			cwftmpl, err := serviceClient.GetClusterWorkflowTemplate(ctx, &clusterworkflowtmplpkg.ClusterWorkflowTemplateGetRequest{Name: ref.Name})
+			if err != nil {
+				return nil, err
+			}
+			return &cwftmpl.Spec, nil
+		}
+		serviceClient, err := apiClient.NewWorkflowTemplateServiceClient()
+		if err != nil {
+			return nil, err
+		}
+		wftmpl, err := serviceClient.GetWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateGetRequest{Name: ref.Name, Namespace: namespace})
+		if err != nil {
+			return nil, err
+		}
+		return &wftmpl.Spec, nil
+	}
+}
+
+// previewWorkflows prints the workflows as they would be run, without creating them
+func previewWorkflows(ctx context.Context, getSpec workflowTemplateSpecGetter, namespace string, workflows []wfv1.Workflow, submitOpts *wfv1.SubmitOpts, cliOpts *common.CliSubmitOpts) error {
+	if err := validateOptions(workflows, submitOpts, cliOpts); err != nil {
+		return err
+	}
+	if len(workflows) == 0 {
+		return errors.New("No Workflow found in given files")
+	}
+	output := cliOpts.Output
+	if output.String() == "" {
+		output.Value = "yaml"
+	}
+	for _, wf := range workflows {
+		if wf.Namespace == "" {
+			wf.Namespace = namespace
+		}
+		if err := util.ApplySubmitOpts(&wf, submitOpts); err != nil {
+			return err
+		}
+		if cliOpts.Priority != nil {
+			wf.Spec.Priority = cliOpts.Priority
+		}
+		preview, err := previewWorkflow(ctx, &wf, getSpec)
+		if err != nil {
+			return err
+		}
+		if err := printWorkflow(preview, common.GetFlags{Output: output}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// previewWorkflow returns the workflow with the spec of its workflowTemplateRef merged in, as the controller does, and
+// the global parameters that are known before the workflow is created substituted. Other variables, such as step
+// outputs or items, are left as they are.
+func previewWorkflow(ctx context.Context, wf *wfv1.Workflow, getSpec workflowTemplateSpecGetter) (*wfv1.Workflow, error) {
+	preview := wf.DeepCopy()
+	if ref := wf.Spec.WorkflowTemplateRef; ref != nil {
+		spec, err := getSpec(ctx, wf.Namespace, ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workflow template %s: %w", ref.Name, err)
+		}
+		merged, err := util.JoinWorkflowSpec(&wf.Spec, spec, nil)
+		if err != nil {
+			return nil, err
+		}
+		preview.Spec = merged.Spec
+		preview.Spec.WorkflowTemplateRef = nil
+	}
+
+	globalParams := map[string]string{
+		wfcommon.GlobalVarWorkflowNamespace:          preview.Namespace,
+		wfcommon.GlobalVarWorkflowMainEntrypoint:     preview.Spec.Entrypoint,
+		wfcommon.GlobalVarWorkflowServiceAccountName: preview.Spec.ServiceAccountName,
+	}
+	// a generated name is not known until the workflow is created
+	if preview.Name != "" {
+		globalParams[wfcommon.GlobalVarWorkflowName] = preview.Name
+	}
+	if preview.Spec.Priority != nil {
+		globalParams[wfcommon.GlobalVarWorkflowPriority] = strconv.Itoa(int(*preview.Spec.Priority))
+	}
+	for _, param := range preview.Spec.Arguments.Parameters {
+		if param.Value != nil {
+			globalParams["workflow.parameters."+param.Name] = param.Value.String()
+		}
+	}
+	for k, v := range preview.Annotations {
+		globalParams["workflow.annotations."+k] = v
+	}
+	for k, v := range preview.Labels {
+		globalParams["workflow.labels."+k] = v
+	}
+
+	specBytes, err := json.Marshal(preview.Spec)
+	if err != nil {
+		return nil, err
+	}
+	replaced, err := template.Replace(ctx, string(specBytes), globalParams, true)
+	if err != nil {
+		return nil, err
+	}
+	var spec wfv1.WorkflowSpec
+	if err := json.Unmarshal([]byte(replaced), &spec); err != nil {
+		return nil, err
+	}
+	preview.Spec = spec
+	return preview, nil
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+var previewWorkflowTemplate = wfv1.MustUnmarshalWorkflowTemplate(`
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: my-wftmpl
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: message
+      value: hello
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: greeting
+        value: "{{workflow.parameters.message}}"
+    container:
+      image: alpine:3.18
+      args: ["{{inputs.parameters.greeting}}", "{{workflow.namespace}}", "{{workflow.labels.team}}"]
+`)
+
+func Test_previewWorkflow(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	getSpec := func(_ context.Context, namespace string, ref *wfv1.WorkflowTemplateRef) (*wfv1.WorkflowSpec, error) {
+		assert.Equal(t, "my-ns", namespace)
+		assert.Equal(t, "my-wftmpl", ref.Name)
+		return &previewWorkflowTemplate.Spec, nil
+	}
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  generateName: my-wf-
+  namespace: my-ns
+  labels:
+    team: blue
+spec:
+  arguments:
+    parameters:
+    - name: message
+      value: goodbye
+  workflowTemplateRef:
+    name: my-wftmpl
+`)
+
+	preview, err := previewWorkflow(ctx, wf, getSpec)
+	require.NoError(t, err)
+	assert.Nil(t, preview.Spec.WorkflowTemplateRef)
+	assert.Equal(t, "main", preview.Spec.Entrypoint)
+	require.Len(t, preview.Spec.Templates, 1)
+	tmpl := preview.Spec.Templates[0]
+	assert.Equal(t, "goodbye", tmpl.Inputs.Parameters[0].Value.String())
+	// input parameters are only known when the template runs
+	assert.Equal(t, []string{"{{inputs.parameters.greeting}}", "my-ns", "blue"}, tmpl.Container.Args)
+	// the submitted workflow is left as it is
+	assert.NotNil(t, wf.Spec.WorkflowTemplateRef)
+}
+
+func Test_validateOptions_preview(t *testing.T) {
+	assert.NoError(t, validateOptions(nil, &wfv1.SubmitOpts{}, &common.CliSubmitOpts{Preview: true}))
+	require.EqualError(t, validateOptions(nil, &wfv1.SubmitOpts{}, &common.CliSubmitOpts{Preview: true, Wait: true}), "--preview cannot be combined with --wait, --watch or --log")
+	require.EqualError(t, validateOptions(nil, &wfv1.SubmitOpts{ServerDryRun: true}, &common.CliSubmitOpts{Preview: true, Output: common.NewPrintWorkflowOutputValue("yaml")}), "--preview cannot be combined with --dry-run or --server-dry-run")
+}
@@ -13,6 +13,7 @@ import (
 
 type resumeOps struct {
 	nodeFieldSelector string // --node-field-selector
+	nodeID            string // --node
 }
 
 func NewResumeCommand() *cobra.Command {
@@ -36,11 +37,18 @@ func NewResumeCommand() *cobra.Command {
 # Resume multiple workflows by node field selector:
 		
   argo resume --node-field-selector inputs.paramaters.myparam.value=abc		
+
+# Continue the main container of a pod node paused with "argo suspend --node":
+
+  argo resume my-wf --node my-wf-1234567890
 `,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 && resumeArgs.nodeFieldSelector == "" {
 				return errors.New("requires either node field selector or workflow")
 			}
+			if resumeArgs.nodeID != "" && (len(args) != 1 || resumeArgs.nodeFieldSelector != "") {
+				return errors.New("--node requires exactly one workflow and cannot be combined with --node-field-selector")
+			}
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -62,15 +70,21 @@ func NewResumeCommand() *cobra.Command {
 					Name:              wfName,
 					Namespace:         namespace,
 					NodeFieldSelector: selector.String(),
+					NodeId:            resumeArgs.nodeID,
 				})
 				if err != nil {
 					return fmt.Errorf("Failed to resume %s: %+v", wfName, err)
 				}
+				if resumeArgs.nodeID != "" {
+					fmt.Printf("node %s of workflow %s resumed\n", resumeArgs.nodeID, wfName)
+					continue
+				}
 				fmt.Printf("workflow %s resumed\n", wfName)
 			}
 			return nil
 		},
 	}
 	command.Flags().StringVar(&resumeArgs.nodeFieldSelector, "node-field-selector", "", "selector of node to resume, eg: --node-field-selector inputs.paramaters.myparam.value=abc")
+	command.Flags().StringVar(&resumeArgs.nodeID, "node", "", "ID of a paused pod node whose main container to continue (SIGCONT)")
 	return command
 }
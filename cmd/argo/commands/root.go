@@ -95,10 +95,14 @@ If your server is behind an ingress with a path (running "argo server --base-hre
 	}
 	command.AddCommand(NewCompletionCommand())
 	command.AddCommand(NewDeleteCommand())
+	command.AddCommand(NewDiffCommand())
+	command.AddCommand(NewExportCommand())
 	command.AddCommand(NewGetCommand())
+	command.AddCommand(NewImportCommand())
 	command.AddCommand(NewLintCommand())
 	command.AddCommand(NewListCommand())
 	command.AddCommand(NewLogsCommand())
+	command.AddCommand(NewCloneCommand())
 	command.AddCommand(NewResubmitCommand())
 	command.AddCommand(NewResumeCommand())
 	command.AddCommand(NewRetryCommand())
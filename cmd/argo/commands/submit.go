@@ -24,11 +24,12 @@ import (
 
 func NewSubmitCommand() *cobra.Command {
 	var (
-		submitOpts     wfv1.SubmitOpts
-		parametersFile string
-		cliSubmitOpts  = common.NewCliSubmitOpts()
-		priority       int32
-		from           string
+		submitOpts        wfv1.SubmitOpts
+		parametersFile    string
+		parametersFromEnv string
+		cliSubmitOpts     = common.NewCliSubmitOpts()
+		priority          int32
+		from              string
 	)
 	command := &cobra.Command{
 		Use:   "submit [FILE... | --from `kind/name]",
@@ -53,14 +54,39 @@ func NewSubmitCommand() *cobra.Command {
 
   argo submit --from cronwf/my-cron-wf
 
+# Submit a workflow template, passing every key of a ConfigMap as a parameter (--parameter takes precedence):
+
+  argo submit --from workflowtemplate/my-wftmpl --from-configmap my-params -p message=hello
+
+# Submit with the parameters api_token and message from the environment variables CI_API_TOKEN and CI_MESSAGE:
+
+  argo submit --parameter-from-env CI my-wf.yaml
+
 # Submit multiple workflows from stdin:
 
   cat my-wf.yaml | argo submit -
+
+# Check the resources a workflow references exist, without submitting it:
+
+  argo submit --dry-run -o name --validate-resources my-wf.yaml
+
+# Print a workflow with its workflowTemplateRef resolved and its global parameters substituted, without submitting it:
+
+  argo submit --preview -p message=hello my-wf.yaml
 `,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if from != "" && len(args) != 0 {
 				return errors.New("cannot combine --from with file arguments")
 			}
+			if from != "" && cliSubmitOpts.ValidateResources {
+				return errors.New("cannot combine --from with --validate-resources")
+			}
+			if from != "" && cliSubmitOpts.Preview {
+				return errors.New("cannot combine --from with --preview")
+			}
+			if from == "" && submitOpts.ParametersFromConfigMap != "" {
+				return errors.New("--from-configmap can only be used with --from")
+			}
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -83,11 +109,20 @@ func NewSubmitCommand() *cobra.Command {
 					return err
 				}
 			}
+			if parametersFromEnv != "" {
+				util.ReadParametersFromEnv(parametersFromEnv, os.Environ(), &submitOpts)
+			}
 
 			serviceClient := apiClient.NewWorkflowServiceClient(ctx)
 			namespace := client.Namespace(ctx)
 			if from != "" {
 				return submitWorkflowFromResource(ctx, serviceClient, namespace, from, &submitOpts, &cliSubmitOpts)
+			} else if cliSubmitOpts.Preview {
+				workflows, err := readWorkflowsFromFile(ctx, args, cliSubmitOpts.Strict)
+				if err != nil {
+					return err
+				}
+				return previewWorkflows(ctx, newWorkflowTemplateSpecGetter(apiClient), namespace, workflows, &submitOpts, &cliSubmitOpts)
 			} else {
 				return submitWorkflowsFromFile(ctx, serviceClient, namespace, args, &submitOpts, &cliSubmitOpts)
 			}
@@ -101,9 +136,14 @@ func NewSubmitCommand() *cobra.Command {
 	command.Flags().BoolVar(&cliSubmitOpts.Strict, "strict", true, "perform strict workflow validation")
 	command.Flags().Int32Var(&priority, "priority", 0, "workflow priority")
 	command.Flags().StringVar(&from, "from", "", "Submit from an existing `kind/name` E.g., --from=cronwf/hello-world-cwf")
+	command.Flags().StringVar(&parametersFromEnv, "parameter-from-env", "", "pass every environment variable named PREFIX_NAME as an input parameter called name. Parameters passed with --parameter or --parameter-file take precedence")
+	command.Flags().StringVar(&submitOpts.ParametersFromConfigMap, "from-configmap", "", "pass every key of this ConfigMap as an input parameter. Parameters passed with --parameter or --parameter-file take precedence. Requires --from")
 	command.Flags().StringVar(&cliSubmitOpts.GetArgs.Status, "status", "", "Filter by status (Pending, Running, Succeeded, Skipped, Failed, Error). Should only be used with --watch.")
 	command.Flags().StringVar(&cliSubmitOpts.GetArgs.NodeFieldSelectorString, "node-field-selector", "", "selector of node to display, eg: --node-field-selector phase=abc")
 	command.Flags().StringVar(&cliSubmitOpts.ScheduledTime, "scheduled-time", "", "Override the workflow's scheduledTime parameter (useful for backfilling). The time must be RFC3339")
+	command.Flags().BoolVar(&cliSubmitOpts.ValidateResources, "validate-resources", false, "check that the ConfigMaps, Secrets, PersistentVolumeClaims, StorageClasses, ServiceAccounts and workflow templates referenced by the workflow exist. Requires --dry-run or --server-dry-run, and read access to those resources in the cluster")
+	command.Flags().BoolVar(&cliSubmitOpts.Preview, "preview", false, "print the workflow with its workflowTemplateRef resolved and its global parameters substituted, without submitting it")
+	command.Flags().BoolVar(&cliSubmitOpts.Preview, "dry-run-expand", false, "alias for --preview")
 
 	// Only complete files with appropriate extension.
 	ctx, _, err := cmdutil.CmdContextWithLogger(command, string(logging.Info), string(logging.Text))
@@ -121,18 +161,26 @@ func NewSubmitCommand() *cobra.Command {
 }
 
 func submitWorkflowsFromFile(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, filePaths []string, submitOpts *wfv1.SubmitOpts, cliOpts *common.CliSubmitOpts) error {
-	fileContents, err := util.ReadManifest(filePaths...)
+	workflows, err := readWorkflowsFromFile(ctx, filePaths, cliOpts.Strict)
 	if err != nil {
 		return err
 	}
 
+	return submitWorkflows(ctx, serviceClient, namespace, workflows, submitOpts, cliOpts)
+}
+
+func readWorkflowsFromFile(ctx context.Context, filePaths []string, strict bool) ([]wfv1.Workflow, error) {
+	fileContents, err := util.ReadManifest(filePaths...)
+	if err != nil {
+		return nil, err
+	}
+
 	var workflows []wfv1.Workflow
 	for _, body := range fileContents {
-		wfs := unmarshalWorkflows(ctx, body, cliOpts.Strict)
+		wfs := unmarshalWorkflows(ctx, body, strict)
 		workflows = append(workflows, wfs...)
 	}
-
-	return submitWorkflows(ctx, serviceClient, namespace, workflows, submitOpts, cliOpts)
+	return workflows, nil
 }
 
 func validateOptions(workflows []wfv1.Workflow, submitOpts *wfv1.SubmitOpts, cliOpts *common.CliSubmitOpts) error {
@@ -174,6 +222,23 @@ func validateOptions(workflows []wfv1.Workflow, submitOpts *wfv1.SubmitOpts, cli
 			return errors.New("--server-dry-run should have an output option")
 		}
 	}
+
+	if cliOpts.Preview {
+		if cliOpts.Wait || cliOpts.Watch || cliOpts.Log {
+			return errors.New("--preview cannot be combined with --wait, --watch or --log")
+		}
+		if submitOpts.DryRun || submitOpts.ServerDryRun {
+			return errors.New("--preview cannot be combined with --dry-run or --server-dry-run")
+		}
+		if cliOpts.ValidateResources {
+			return errors.New("--preview cannot be combined with --validate-resources")
+		}
+		return nil
+	}
+
+	if cliOpts.ValidateResources && !submitOpts.DryRun && !submitOpts.ServerDryRun {
+		return errors.New("--validate-resources should be combined with --dry-run or --server-dry-run")
+	}
 	return nil
 }
 
@@ -224,6 +289,15 @@ func submitWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServ
 		return errors.New("No Workflow found in given files")
 	}
 
+	var validator *resourceValidator
+	if cliOpts.ValidateResources {
+		var err error
+		validator, err = newResourceValidator()
+		if err != nil {
+			return err
+		}
+	}
+
 	var workflowNames []string
 
 	for _, wf := range workflows {
@@ -238,6 +312,11 @@ func submitWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServ
 		if cliOpts.Priority != nil {
 			wf.Spec.Priority = cliOpts.Priority
 		}
+		if validator != nil {
+			if err := validator.validate(ctx, &wf); err != nil {
+				return err
+			}
+		}
 		options := &metav1.CreateOptions{}
 		if submitOpts.DryRun {
 			options.DryRun = []string{"All"}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -10,6 +11,8 @@ import (
 )
 
 func NewSuspendCommand() *cobra.Command {
+	var nodeID string // --node
+
 	command := &cobra.Command{
 		Use:   "suspend WORKFLOW1 WORKFLOW2...",
 		Short: "suspend zero or more workflows (opposite of resume)",
@@ -19,7 +22,16 @@ func NewSuspendCommand() *cobra.Command {
 
 # Suspend the latest workflow:
   argo suspend @latest
+
+# Pause the main container of a single running pod node:
+  argo suspend my-wf --node my-wf-1234567890
 `,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if nodeID != "" && len(args) != 1 {
+				return errors.New("--node requires exactly one workflow")
+			}
+			return nil
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 			ctx, apiClient, err := client.NewAPIClient(ctx)
@@ -32,14 +44,20 @@ func NewSuspendCommand() *cobra.Command {
 				_, err := serviceClient.SuspendWorkflow(ctx, &workflowpkg.WorkflowSuspendRequest{
 					Name:      wfName,
 					Namespace: namespace,
+					NodeId:    nodeID,
 				})
 				if err != nil {
 					return fmt.Errorf("Failed to suspended %s: %+v", wfName, err)
 				}
+				if nodeID != "" {
+					fmt.Printf("node %s of workflow %s paused\n", nodeID, wfName)
+					continue
+				}
 				fmt.Printf("workflow %s suspended\n", wfName)
 			}
 			return nil
 		},
 	}
+	command.Flags().StringVar(&nodeID, "node", "", "ID of a running pod node whose main container to pause (SIGSTOP) instead of suspending the workflow")
 	return command
 }
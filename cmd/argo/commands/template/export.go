@@ -0,0 +1,117 @@
+package template
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type exportFlags struct {
+	bundle        string // --bundle
+	allNamespaces bool   // --all-namespaces
+	labels        string // --selector
+}
+
+func NewExportCommand() *cobra.Command {
+	var exportArgs exportFlags
+	command := &cobra.Command{
+		Use:   "export --bundle FILE",
+		Short: "export workflow templates to a single YAML bundle",
+		Example: `# Export all Workflow Templates in the current namespace:
+  argo template export --bundle templates.yaml
+
+# Export all Workflow Templates in every namespace:
+  argo template export --bundle templates.yaml -A
+
+# Export Workflow Templates matching a label selector:
+  argo template export --bundle templates.yaml -l team=data
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			ctx, apiClient, err := client.NewAPIClient(ctx)
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewWorkflowTemplateServiceClient()
+			if err != nil {
+				return err
+			}
+			namespace := client.Namespace(ctx)
+			if exportArgs.allNamespaces {
+				namespace = apiv1.NamespaceAll
+			}
+			bundle, err := exportWorkflowTemplates(ctx, serviceClient, namespace, exportArgs.labels)
+			if err != nil {
+				return err
+			}
+			return os.WriteFile(exportArgs.bundle, bundle, 0o644)
+		},
+	}
+	command.Flags().StringVar(&exportArgs.bundle, "bundle", "", "File to write the bundle to")
+	command.Flags().BoolVarP(&exportArgs.allNamespaces, "all-namespaces", "A", false, "Export workflow templates from all namespaces")
+	command.Flags().StringVarP(&exportArgs.labels, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	_ = command.MarkFlagRequired("bundle")
+	return command
+}
+
+// exportWorkflowTemplates lists the workflow templates in namespace and returns them as a multi-document YAML bundle,
+// with server-managed metadata removed so that the bundle can be imported into another cluster. The namespace is only
+// kept when exporting from all namespaces, so a single-namespace bundle is imported into the current namespace.
+func exportWorkflowTemplates(ctx context.Context, serviceClient workflowtemplatepkg.WorkflowTemplateServiceClient, namespace, labels string) ([]byte, error) {
+	wftmplList, err := serviceClient.ListWorkflowTemplates(ctx, &workflowtemplatepkg.WorkflowTemplateListRequest{
+		Namespace:   namespace,
+		ListOptions: &metav1.ListOptions{LabelSelector: labels},
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := wftmplList.Items
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+	var buf bytes.Buffer
+	for i := range items {
+		wftmpl := portableWorkflowTemplate(items[i], namespace == apiv1.NamespaceAll)
+		out, err := yaml.Marshal(wftmpl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal workflow template %q: %w", wftmpl.Name, err)
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(out)
+	}
+	return buf.Bytes(), nil
+}
+
+// portableWorkflowTemplate returns the workflow template with server-managed metadata removed, so that it can be
+// created in another cluster. The namespace is only kept if keepNamespace is set.
+func portableWorkflowTemplate(wftmpl wfv1.WorkflowTemplate, keepNamespace bool) wfv1.WorkflowTemplate {
+	wftmpl.TypeMeta = metav1.TypeMeta{APIVersion: workflow.APIVersion, Kind: workflow.WorkflowTemplateKind}
+	objectMeta := metav1.ObjectMeta{
+		Name:        wftmpl.Name,
+		Labels:      wftmpl.Labels,
+		Annotations: wftmpl.Annotations,
+	}
+	if keepNamespace {
+		objectMeta.Namespace = wftmpl.Namespace
+	}
+	wftmpl.ObjectMeta = objectMeta
+	return wftmpl
+}
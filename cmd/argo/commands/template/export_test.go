@@ -0,0 +1,66 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+	workflowtemplatemocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func testWorkflowTemplate(namespace, name string) wfv1.WorkflowTemplate {
+	return wfv1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			ResourceVersion: "123",
+			UID:             "a-uid",
+			Labels:          map[string]string{"team": "data"},
+		},
+		Spec: wfv1.WorkflowSpec{Entrypoint: "main", Templates: []wfv1.Template{{Name: "main", Container: &apiv1.Container{Image: "busybox"}}}},
+	}
+}
+
+func Test_exportWorkflowTemplates(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	list := &wfv1.WorkflowTemplateList{Items: wfv1.WorkflowTemplates{
+		testWorkflowTemplate("ns-b", "foo"),
+		testWorkflowTemplate("ns-a", "bar"),
+	}}
+	t.Run("Namespace", func(t *testing.T) {
+		c := &workflowtemplatemocks.WorkflowTemplateServiceClient{}
+		c.On("ListWorkflowTemplates", mock.Anything, &workflowtemplatepkg.WorkflowTemplateListRequest{Namespace: "ns-a", ListOptions: &metav1.ListOptions{}}).Return(list, nil)
+		bundle, err := exportWorkflowTemplates(ctx, c, "ns-a", "")
+		require.NoError(t, err)
+		wftmpls, err := common.SplitWorkflowTemplateYAMLFile(ctx, bundle, true)
+		require.NoError(t, err)
+		require.Len(t, wftmpls, 2)
+		assert.Equal(t, "bar", wftmpls[0].Name)
+		assert.Equal(t, "foo", wftmpls[1].Name)
+		assert.Empty(t, wftmpls[0].Namespace)
+		assert.Empty(t, wftmpls[0].ResourceVersion)
+		assert.Empty(t, wftmpls[0].UID)
+		assert.Equal(t, map[string]string{"team": "data"}, wftmpls[0].Labels)
+		assert.Equal(t, "main", wftmpls[0].Spec.Entrypoint)
+	})
+	t.Run("AllNamespaces", func(t *testing.T) {
+		c := &workflowtemplatemocks.WorkflowTemplateServiceClient{}
+		c.On("ListWorkflowTemplates", mock.Anything, &workflowtemplatepkg.WorkflowTemplateListRequest{ListOptions: &metav1.ListOptions{LabelSelector: "team=data"}}).Return(list, nil)
+		bundle, err := exportWorkflowTemplates(ctx, c, apiv1.NamespaceAll, "team=data")
+		require.NoError(t, err)
+		assert.Contains(t, string(bundle), "\n---\n")
+		wftmpls, err := common.SplitWorkflowTemplateYAMLFile(ctx, bundle, true)
+		require.NoError(t, err)
+		require.Len(t, wftmpls, 2)
+		assert.Equal(t, "ns-a", wftmpls[0].Namespace)
+		assert.Equal(t, "ns-b", wftmpls[1].Namespace)
+	})
+}
@@ -0,0 +1,110 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	conflictPolicySkip    = "skip"
+	conflictPolicyReplace = "replace"
+	conflictPolicyError   = "error"
+)
+
+type importFlags struct {
+	bundle         string               // --bundle
+	conflictPolicy common.EnumFlagValue // --conflict-policy
+	strict         bool                 // --strict
+}
+
+func NewImportCommand() *cobra.Command {
+	var importArgs = importFlags{conflictPolicy: common.EnumFlagValue{
+		AllowedValues: []string{conflictPolicySkip, conflictPolicyReplace, conflictPolicyError},
+		Value:         conflictPolicyError,
+	}}
+	command := &cobra.Command{
+		Use:   "import --bundle FILE",
+		Short: "import workflow templates from a YAML bundle",
+		Example: `# Import a bundle, failing if any Workflow Template already exists:
+  argo template import --bundle templates.yaml
+
+# Import a bundle, leaving existing Workflow Templates untouched:
+  argo template import --bundle templates.yaml --conflict-policy skip
+
+# Import a bundle, overwriting existing Workflow Templates:
+  argo template import --bundle templates.yaml --conflict-policy replace
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			ctx, apiClient, err := client.NewAPIClient(ctx)
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewWorkflowTemplateServiceClient()
+			if err != nil {
+				return err
+			}
+			workflowTemplates := generateWorkflowTemplates(ctx, []string{importArgs.bundle}, importArgs.strict)
+			for i := range workflowTemplates {
+				if workflowTemplates[i].Namespace == "" {
+					workflowTemplates[i].Namespace = client.Namespace(ctx)
+				}
+			}
+			return importWorkflowTemplates(ctx, serviceClient, workflowTemplates, importArgs.conflictPolicy.String())
+		},
+	}
+	command.Flags().StringVar(&importArgs.bundle, "bundle", "", "File to read the bundle from")
+	command.Flags().Var(&importArgs.conflictPolicy, "conflict-policy", "What to do when a workflow template already exists. "+importArgs.conflictPolicy.Usage())
+	command.Flags().BoolVar(&importArgs.strict, "strict", true, "perform strict workflow validation")
+	_ = command.MarkFlagRequired("bundle")
+	return command
+}
+
+// importWorkflowTemplates creates each of the workflow templates, applying the conflict policy to any that already exist,
+// so that importing the same bundle twice with "skip" or "replace" is a no-op.
+func importWorkflowTemplates(ctx context.Context, serviceClient workflowtemplatepkg.WorkflowTemplateServiceClient, workflowTemplates []wfv1.WorkflowTemplate, conflictPolicy string) error {
+	for _, wftmpl := range workflowTemplates {
+		current, err := serviceClient.GetWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateGetRequest{
+			Name:      wftmpl.Name,
+			Namespace: wftmpl.Namespace,
+		})
+		switch {
+		case status.Code(err) == codes.NotFound:
+			_, err = serviceClient.CreateWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateCreateRequest{
+				Namespace: wftmpl.Namespace,
+				Template:  &wftmpl,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create workflow template %q: %v", wftmpl.Name, err)
+			}
+			fmt.Printf("WorkflowTemplate '%s/%s' created\n", wftmpl.Namespace, wftmpl.Name)
+		case err != nil:
+			return fmt.Errorf("failed to get workflow template %q: %v", wftmpl.Name, err)
+		case conflictPolicy == conflictPolicySkip:
+			fmt.Printf("WorkflowTemplate '%s/%s' skipped\n", wftmpl.Namespace, wftmpl.Name)
+		case conflictPolicy == conflictPolicyReplace:
+			wftmpl.ResourceVersion = current.ResourceVersion
+			_, err = serviceClient.UpdateWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateUpdateRequest{
+				Namespace: wftmpl.Namespace,
+				Template:  &wftmpl,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to replace workflow template %q: %v", wftmpl.Name, err)
+			}
+			fmt.Printf("WorkflowTemplate '%s/%s' replaced\n", wftmpl.Namespace, wftmpl.Name)
+		default:
+			return fmt.Errorf("workflow template '%s/%s' already exists", wftmpl.Namespace, wftmpl.Name)
+		}
+	}
+	return nil
+}
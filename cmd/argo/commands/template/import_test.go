@@ -0,0 +1,52 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+	workflowtemplatemocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func Test_importWorkflowTemplates(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wftmpl := testWorkflowTemplate("my-ns", "foo")
+	wftmpl.ResourceVersion = ""
+	getReq := &workflowtemplatepkg.WorkflowTemplateGetRequest{Name: "foo", Namespace: "my-ns"}
+	existing := testWorkflowTemplate("my-ns", "foo")
+	t.Run("Create", func(t *testing.T) {
+		c := &workflowtemplatemocks.WorkflowTemplateServiceClient{}
+		c.On("GetWorkflowTemplate", mock.Anything, getReq).Return(nil, status.Error(codes.NotFound, "not found"))
+		c.On("CreateWorkflowTemplate", mock.Anything, mock.Anything).Return(&wftmpl, nil)
+		require.NoError(t, importWorkflowTemplates(ctx, c, []wfv1.WorkflowTemplate{wftmpl}, conflictPolicyError))
+		c.AssertCalled(t, "CreateWorkflowTemplate", mock.Anything, mock.Anything)
+	})
+	t.Run("Skip", func(t *testing.T) {
+		c := &workflowtemplatemocks.WorkflowTemplateServiceClient{}
+		c.On("GetWorkflowTemplate", mock.Anything, getReq).Return(&existing, nil)
+		require.NoError(t, importWorkflowTemplates(ctx, c, []wfv1.WorkflowTemplate{wftmpl}, conflictPolicySkip))
+		c.AssertNotCalled(t, "CreateWorkflowTemplate", mock.Anything, mock.Anything)
+		c.AssertNotCalled(t, "UpdateWorkflowTemplate", mock.Anything, mock.Anything)
+	})
+	t.Run("Replace", func(t *testing.T) {
+		c := &workflowtemplatemocks.WorkflowTemplateServiceClient{}
+		c.On("GetWorkflowTemplate", mock.Anything, getReq).Return(&existing, nil)
+		c.On("UpdateWorkflowTemplate", mock.Anything, mock.MatchedBy(func(req *workflowtemplatepkg.WorkflowTemplateUpdateRequest) bool {
+			return req.Template.ResourceVersion == "123"
+		})).Return(&existing, nil)
+		require.NoError(t, importWorkflowTemplates(ctx, c, []wfv1.WorkflowTemplate{wftmpl}, conflictPolicyReplace))
+		c.AssertCalled(t, "UpdateWorkflowTemplate", mock.Anything, mock.Anything)
+	})
+	t.Run("Error", func(t *testing.T) {
+		c := &workflowtemplatemocks.WorkflowTemplateServiceClient{}
+		c.On("GetWorkflowTemplate", mock.Anything, getReq).Return(&existing, nil)
+		err := importWorkflowTemplates(ctx, c, []wfv1.WorkflowTemplate{wftmpl}, conflictPolicyError)
+		require.EqualError(t, err, "workflow template 'my-ns/foo' already exists")
+	})
+}
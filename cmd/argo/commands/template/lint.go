@@ -15,7 +15,7 @@ func NewLintCommand() *cobra.Command {
 	var (
 		strict bool
 		output = common.EnumFlagValue{
-			AllowedValues: []string{"pretty", "simple"},
+			AllowedValues: []string{"pretty", "simple", "sarif"},
 			Value:         "pretty",
 		}
 	)
@@ -0,0 +1,106 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"sigs.k8s.io/yaml"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	// ociConfigMediaType is the media type of the config of an OCI artifact holding a workflow template
+	ociConfigMediaType types.MediaType = "application/vnd.argoproj.workflowtemplate.config.v1+json"
+	// ociLayerMediaType is the media type of the layer holding the workflow template YAML
+	ociLayerMediaType types.MediaType = "application/vnd.argoproj.workflowtemplate.v1+yaml"
+)
+
+// parseOCIReference parses an OCI reference, replacing its tag if tag is set.
+func parseOCIReference(ref, tag string) (name.Reference, error) {
+	parsed, err := name.ParseReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OCI reference %q: %w", ref, err)
+	}
+	if tag == "" {
+		return parsed, nil
+	}
+	if _, ok := parsed.(name.Digest); ok {
+		return nil, fmt.Errorf("--tag cannot be used with the digest reference %q", ref)
+	}
+	return parsed.Context().Tag(tag), nil
+}
+
+// pushWorkflowTemplate pushes the workflow template to ref as an OCI artifact with a single YAML layer, and returns
+// the digest of the pushed manifest so that it can be pulled by digest.
+func pushWorkflowTemplate(ctx context.Context, wftmpl *wfv1.WorkflowTemplate, ref name.Reference, options ...remote.Option) (string, error) {
+	if _, ok := ref.(name.Digest); ok {
+		return "", fmt.Errorf("cannot push to the digest reference %q, use a tag instead", ref)
+	}
+	data, err := yaml.Marshal(wftmpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal workflow template %q: %w", wftmpl.Name, err)
+	}
+	img, err := mutate.AppendLayers(empty.Image, static.NewLayer(data, ociLayerMediaType))
+	if err != nil {
+		return "", err
+	}
+	img = mutate.ConfigMediaType(mutate.MediaType(img, types.OCIManifestSchema1), ociConfigMediaType)
+	if err := remote.Write(ref, img, append(defaultRemoteOptions(ctx), options...)...); err != nil {
+		return "", fmt.Errorf("failed to push workflow template to %s: %w", ref, err)
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		return "", err
+	}
+	return digest.String(), nil
+}
+
+// pullWorkflowTemplate pulls the workflow template pushed to ref by pushWorkflowTemplate.
+func pullWorkflowTemplate(ctx context.Context, ref name.Reference, options ...remote.Option) (*wfv1.WorkflowTemplate, error) {
+	img, err := remote.Image(ref, append(defaultRemoteOptions(ctx), options...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull workflow template from %s: %w", ref, err)
+	}
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, err
+	}
+	for _, layer := range layers {
+		mediaType, err := layer.MediaType()
+		if err != nil {
+			return nil, err
+		}
+		if mediaType != ociLayerMediaType {
+			continue
+		}
+		// the layer is stored uncompressed, so its compressed and uncompressed contents are the same
+		rc, err := layer.Compressed()
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = rc.Close() }()
+		data, err := io.ReadAll(rc)
+		if err != nil {
+			return nil, err
+		}
+		var wftmpl wfv1.WorkflowTemplate
+		if err := yaml.UnmarshalStrict(data, &wftmpl); err != nil {
+			return nil, fmt.Errorf("failed to parse workflow template from %s: %w", ref, err)
+		}
+		return &wftmpl, nil
+	}
+	return nil, fmt.Errorf("%s is not a workflow template: no layer with media type %s", ref, ociLayerMediaType)
+}
+
+func defaultRemoteOptions(ctx context.Context) []remote.Option {
+	return []remote.Option{remote.WithContext(ctx), remote.WithAuthFromKeychain(authn.DefaultKeychain)}
+}
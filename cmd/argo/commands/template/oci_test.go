@@ -0,0 +1,76 @@
+package template
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func Test_parseOCIReference(t *testing.T) {
+	t.Run("Tag", func(t *testing.T) {
+		ref, err := parseOCIReference("example.com/templates/foo:v1", "")
+		require.NoError(t, err)
+		assert.Equal(t, "example.com/templates/foo:v1", ref.String())
+	})
+	t.Run("TagOverride", func(t *testing.T) {
+		ref, err := parseOCIReference("example.com/templates/foo:v1", "v2")
+		require.NoError(t, err)
+		assert.Equal(t, "example.com/templates/foo:v2", ref.String())
+	})
+	t.Run("TagOverrideDigest", func(t *testing.T) {
+		_, err := parseOCIReference("example.com/templates/foo@sha256:"+strings.Repeat("0", 64), "v2")
+		require.ErrorContains(t, err, "--tag cannot be used with the digest reference")
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := parseOCIReference("Example.com/UPPER:v1", "")
+		require.ErrorContains(t, err, "invalid OCI reference")
+	})
+}
+
+func Test_pushPullWorkflowTemplate(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+	wftmpl := portableWorkflowTemplate(testWorkflowTemplate("my-ns", "foo"), false)
+
+	ref, err := name.ParseReference(host+"/templates/foo:v1", name.Insecure)
+	require.NoError(t, err)
+	digest, err := pushWorkflowTemplate(ctx, &wftmpl, ref)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(digest, "sha256:"))
+
+	t.Run("ByTag", func(t *testing.T) {
+		pulled, err := pullWorkflowTemplate(ctx, ref)
+		require.NoError(t, err)
+		assert.Equal(t, wftmpl, *pulled)
+	})
+	t.Run("ByDigest", func(t *testing.T) {
+		pinned, err := name.ParseReference(host+"/templates/foo@"+digest, name.Insecure)
+		require.NoError(t, err)
+		pulled, err := pullWorkflowTemplate(ctx, pinned)
+		require.NoError(t, err)
+		assert.Equal(t, "foo", pulled.Name)
+		assert.Empty(t, pulled.Namespace)
+		assert.Empty(t, pulled.ResourceVersion)
+	})
+	t.Run("PushDigest", func(t *testing.T) {
+		pinned, err := name.ParseReference(host+"/templates/foo@"+digest, name.Insecure)
+		require.NoError(t, err)
+		_, err = pushWorkflowTemplate(ctx, &wftmpl, pinned)
+		require.ErrorContains(t, err, "cannot push to the digest reference")
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		missing, err := name.ParseReference(host+"/templates/missing:v1", name.Insecure)
+		require.NoError(t, err)
+		_, err = pullWorkflowTemplate(ctx, missing)
+		require.ErrorContains(t, err, "failed to pull workflow template")
+	})
+}
@@ -0,0 +1,61 @@
+package template
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type pullFlags struct {
+	tag            string               // --tag
+	conflictPolicy common.EnumFlagValue // --conflict-policy
+}
+
+func NewPullCommand() *cobra.Command {
+	var pullArgs = pullFlags{conflictPolicy: common.EnumFlagValue{
+		AllowedValues: []string{conflictPolicySkip, conflictPolicyReplace, conflictPolicyError},
+		Value:         conflictPolicyReplace,
+	}}
+	command := &cobra.Command{
+		Use:   "pull OCI_REFERENCE",
+		Short: "pull a workflow template from an OCI registry and apply it",
+		Long: `Pull a workflow template pushed by "argo template push" from an OCI registry, and create or update it in the current namespace.
+
+Registry credentials are read from the Docker config file, as used by "docker login".`,
+		Example: `# Pull a workflow template by tag:
+  argo template pull ghcr.io/my-org/templates/my-template:v1
+
+# Pull a workflow template pinned to a digest:
+  argo template pull ghcr.io/my-org/templates/my-template@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef
+
+# Pull a workflow template, failing if it already exists:
+  argo template pull ghcr.io/my-org/templates/my-template:v1 --conflict-policy error
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ref, err := parseOCIReference(args[0], pullArgs.tag)
+			if err != nil {
+				return err
+			}
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewWorkflowTemplateServiceClient()
+			if err != nil {
+				return err
+			}
+			wftmpl, err := pullWorkflowTemplate(ctx, ref)
+			if err != nil {
+				return err
+			}
+			wftmpl.Namespace = client.Namespace(ctx)
+			return importWorkflowTemplates(ctx, serviceClient, []wfv1.WorkflowTemplate{*wftmpl}, pullArgs.conflictPolicy.String())
+		},
+	}
+	command.Flags().StringVar(&pullArgs.tag, "tag", "", "Tag to pull, replacing the tag of the reference")
+	command.Flags().Var(&pullArgs.conflictPolicy, "conflict-policy", "What to do when the workflow template already exists. "+pullArgs.conflictPolicy.Usage())
+	return command
+}
@@ -0,0 +1,63 @@
+package template
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+)
+
+type pushFlags struct {
+	tag string // --tag
+}
+
+func NewPushCommand() *cobra.Command {
+	var pushArgs pushFlags
+	command := &cobra.Command{
+		Use:   "push WORKFLOW_TEMPLATE OCI_REFERENCE",
+		Short: "push a workflow template to an OCI registry",
+		Long: `Push a workflow template to an OCI registry as an artifact with a single YAML layer.
+
+Registry credentials are read from the Docker config file, as used by "docker login".
+The digest of the pushed artifact is printed, so that it can be pulled by digest.`,
+		Example: `# Push a workflow template:
+  argo template push my-template ghcr.io/my-org/templates/my-template:v1
+
+# Push a workflow template, overriding the tag of the reference:
+  argo template push my-template ghcr.io/my-org/templates/my-template --tag v2
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ref, err := parseOCIReference(args[1], pushArgs.tag)
+			if err != nil {
+				return err
+			}
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewWorkflowTemplateServiceClient()
+			if err != nil {
+				return err
+			}
+			wftmpl, err := serviceClient.GetWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateGetRequest{
+				Name:      args[0],
+				Namespace: client.Namespace(ctx),
+			})
+			if err != nil {
+				return err
+			}
+			portable := portableWorkflowTemplate(*wftmpl, false)
+			digest, err := pushWorkflowTemplate(ctx, &portable, ref)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("WorkflowTemplate '%s' pushed to %s@%s\n", wftmpl.Name, ref.Context().Name(), digest)
+			return nil
+		},
+	}
+	command.Flags().StringVar(&pushArgs.tag, "tag", "", "Tag to push to, replacing the tag of the reference")
+	return command
+}
@@ -19,6 +19,10 @@ func NewTemplateCommand() *cobra.Command {
 	command.AddCommand(NewDeleteCommand())
 	command.AddCommand(NewLintCommand())
 	command.AddCommand(NewUpdateCommand())
+	command.AddCommand(NewExportCommand())
+	command.AddCommand(NewImportCommand())
+	command.AddCommand(NewPushCommand())
+	command.AddCommand(NewPullCommand())
 
 	return command
 }
@@ -0,0 +1,266 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+)
+
+// resourceRef identifies a resource referenced by a workflow. Namespace is empty for cluster-scoped kinds.
+type resourceRef struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+func (r resourceRef) String() string {
+	if r.namespace == "" {
+		return fmt.Sprintf("%s %s", r.kind, r.name)
+	}
+	return fmt.Sprintf("%s %s/%s", r.kind, r.namespace, r.name)
+}
+
+// resourceValidator checks that the resources a workflow references exist in the cluster, so that
+// `argo submit --dry-run --validate-resources` can report them before any pods are created.
+type resourceValidator struct {
+	kubeClient kubernetes.Interface
+	wfClient   wfclientset.Interface
+}
+
+func newResourceValidator() (*resourceValidator, error) {
+	config, err := client.GetConfig().ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	wfClient, err := wfclientset.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return &resourceValidator{kubeClient: kubeClient, wfClient: wfClient}, nil
+}
+
+// validate returns an error listing every resource referenced by the workflow that does not exist.
+func (v *resourceValidator) validate(ctx context.Context, wf *wfv1.Workflow) error {
+	c := &refCollector{namespace: wf.Namespace, seen: map[resourceRef]bool{}}
+	c.addSpec(&wf.Spec)
+	if ref := wf.Spec.WorkflowTemplateRef; ref != nil {
+		// the referenced template's own references are checked too, as its spec is what the workflow will run
+		spec, err := v.getWorkflowTemplateSpec(ctx, wf.Namespace, ref)
+		if err != nil {
+			return err
+		}
+		if spec != nil {
+			c.addSpec(spec)
+		}
+	}
+
+	var missing []string
+	for _, ref := range c.refs {
+		exists, err := v.exists(ctx, ref)
+		if err != nil {
+			return fmt.Errorf("failed to get %s: %w", ref, err)
+		}
+		if !exists {
+			missing = append(missing, ref.String())
+		}
+	}
+	if len(missing) > 0 {
+		name := wf.Name
+		if name == "" {
+			name = wf.GenerateName
+		}
+		return fmt.Errorf("workflow %q references missing resources: %s", name, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// getWorkflowTemplateSpec returns the spec of the referenced (cluster) workflow template, or nil if it does not exist.
+func (v *resourceValidator) getWorkflowTemplateSpec(ctx context.Context, namespace string, ref *wfv1.WorkflowTemplateRef) (*wfv1.WorkflowSpec, error) {
+	var spec *wfv1.WorkflowSpec
+	var err error
+	if ref.ClusterScope {
+		var cwftmpl *wfv1.ClusterWorkflowTemplate
+		cwftmpl, err = v.wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates().Get(ctx, ref.Name, metav1.GetOptions{})
+		if err == nil {
+			spec = &cwftmpl.Spec
+		}
+	} else {
+		var wftmpl *wfv1.WorkflowTemplate
+		wftmpl, err = v.wfClient.ArgoprojV1alpha1().WorkflowTemplates(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err == nil {
+			spec = &wftmpl.Spec
+		}
+	}
+	if apierr.IsNotFound(err) {
+		return nil, nil
+	}
+	return spec, err
+}
+
+func (v *resourceValidator) exists(ctx context.Context, ref resourceRef) (bool, error) {
+	var err error
+	switch ref.kind {
+	case "ConfigMap":
+		_, err = v.kubeClient.CoreV1().ConfigMaps(ref.namespace).Get(ctx, ref.name, metav1.GetOptions{})
+	case "Secret":
+		_, err = v.kubeClient.CoreV1().Secrets(ref.namespace).Get(ctx, ref.name, metav1.GetOptions{})
+	case "PersistentVolumeClaim":
+		_, err = v.kubeClient.CoreV1().PersistentVolumeClaims(ref.namespace).Get(ctx, ref.name, metav1.GetOptions{})
+	case "ServiceAccount":
+		_, err = v.kubeClient.CoreV1().ServiceAccounts(ref.namespace).Get(ctx, ref.name, metav1.GetOptions{})
+	case "StorageClass":
+		_, err = v.kubeClient.StorageV1().StorageClasses().Get(ctx, ref.name, metav1.GetOptions{})
+	case workflow.WorkflowTemplateKind:
+		_, err = v.wfClient.ArgoprojV1alpha1().WorkflowTemplates(ref.namespace).Get(ctx, ref.name, metav1.GetOptions{})
+	case workflow.ClusterWorkflowTemplateKind:
+		_, err = v.wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates().Get(ctx, ref.name, metav1.GetOptions{})
+	default:
+		return false, fmt.Errorf("unknown kind %q", ref.kind)
+	}
+	if apierr.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// refCollector gathers the unique resources referenced by a workflow spec, in the order they are found.
+type refCollector struct {
+	namespace string
+	seen      map[resourceRef]bool
+	refs      []resourceRef
+}
+
+func (c *refCollector) add(kind, namespace, name string) {
+	// names containing variables are only known once the workflow runs
+	if name == "" || strings.Contains(name, "{{") {
+		return
+	}
+	ref := resourceRef{kind: kind, namespace: namespace, name: name}
+	if !c.seen[ref] {
+		c.seen[ref] = true
+		c.refs = append(c.refs, ref)
+	}
+}
+
+func (c *refCollector) addSpec(spec *wfv1.WorkflowSpec) {
+	c.add("ServiceAccount", c.namespace, spec.ServiceAccountName)
+	if spec.Executor != nil {
+		c.add("ServiceAccount", c.namespace, spec.Executor.ServiceAccountName)
+	}
+	if ref := spec.WorkflowTemplateRef; ref != nil {
+		c.addTemplateRef(ref.Name, ref.ClusterScope)
+	}
+	for _, secret := range spec.ImagePullSecrets {
+		c.add("Secret", c.namespace, secret.Name)
+	}
+	for _, pvc := range spec.VolumeClaimTemplates {
+		if pvc.Spec.StorageClassName != nil {
+			c.add("StorageClass", "", *pvc.Spec.StorageClassName)
+		}
+	}
+	c.addVolumes(spec.Volumes)
+	for i := range spec.Templates {
+		c.addTemplate(&spec.Templates[i])
+	}
+}
+
+func (c *refCollector) addTemplate(tmpl *wfv1.Template) {
+	c.add("ServiceAccount", c.namespace, tmpl.ServiceAccountName)
+	if tmpl.Executor != nil {
+		c.add("ServiceAccount", c.namespace, tmpl.Executor.ServiceAccountName)
+	}
+	c.addVolumes(tmpl.Volumes)
+	if tmpl.Container != nil {
+		c.addContainer(tmpl.Container)
+	}
+	if tmpl.Script != nil {
+		c.addContainer(&tmpl.Script.Container)
+	}
+	if tmpl.ContainerSet != nil {
+		for i := range tmpl.ContainerSet.Containers {
+			c.addContainer(&tmpl.ContainerSet.Containers[i].Container)
+		}
+	}
+	for i := range tmpl.InitContainers {
+		c.addContainer(&tmpl.InitContainers[i].Container)
+	}
+	for i := range tmpl.Sidecars {
+		c.addContainer(&tmpl.Sidecars[i].Container)
+	}
+	for _, parallelSteps := range tmpl.Steps {
+		for _, step := range parallelSteps.Steps {
+			if step.TemplateRef != nil {
+				c.addTemplateRef(step.TemplateRef.Name, step.TemplateRef.ClusterScope)
+			}
+		}
+	}
+	if tmpl.DAG != nil {
+		for _, task := range tmpl.DAG.Tasks {
+			if task.TemplateRef != nil {
+				c.addTemplateRef(task.TemplateRef.Name, task.TemplateRef.ClusterScope)
+			}
+		}
+	}
+}
+
+func (c *refCollector) addTemplateRef(name string, clusterScope bool) {
+	if clusterScope {
+		c.add(workflow.ClusterWorkflowTemplateKind, "", name)
+	} else {
+		c.add(workflow.WorkflowTemplateKind, c.namespace, name)
+	}
+}
+
+func (c *refCollector) addContainer(ctr *apiv1.Container) {
+	for _, env := range ctr.Env {
+		if env.ValueFrom == nil {
+			continue
+		}
+		if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil && !isOptional(ref.Optional) {
+			c.add("ConfigMap", c.namespace, ref.Name)
+		}
+		if ref := env.ValueFrom.SecretKeyRef; ref != nil && !isOptional(ref.Optional) {
+			c.add("Secret", c.namespace, ref.Name)
+		}
+	}
+	for _, envFrom := range ctr.EnvFrom {
+		if ref := envFrom.ConfigMapRef; ref != nil && !isOptional(ref.Optional) {
+			c.add("ConfigMap", c.namespace, ref.Name)
+		}
+		if ref := envFrom.SecretRef; ref != nil && !isOptional(ref.Optional) {
+			c.add("Secret", c.namespace, ref.Name)
+		}
+	}
+}
+
+func (c *refCollector) addVolumes(volumes []apiv1.Volume) {
+	for _, vol := range volumes {
+		if vol.ConfigMap != nil && !isOptional(vol.ConfigMap.Optional) {
+			c.add("ConfigMap", c.namespace, vol.ConfigMap.Name)
+		}
+		if vol.Secret != nil && !isOptional(vol.Secret.Optional) {
+			c.add("Secret", c.namespace, vol.Secret.SecretName)
+		}
+		if vol.PersistentVolumeClaim != nil {
+			c.add("PersistentVolumeClaim", c.namespace, vol.PersistentVolumeClaim.ClaimName)
+		}
+	}
+}
+
+func isOptional(optional *bool) bool {
+	return optional != nil && *optional
+}
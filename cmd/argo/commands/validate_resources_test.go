@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wffake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+const validateResourcesWorkflow = `
+metadata:
+  name: my-wf
+  namespace: argo
+spec:
+  entrypoint: main
+  serviceAccountName: my-sa
+  volumes:
+  - name: config
+    configMap:
+      name: my-cm
+  - name: optional-secret
+    secret:
+      secretName: optional-secret
+      optional: true
+  volumeClaimTemplates:
+  - metadata:
+      name: work
+    spec:
+      storageClassName: fast
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        template: print
+      - name: b
+        templateRef:
+          name: my-wftmpl
+          template: print
+  - name: print
+    container:
+      image: argoproj/argosay:v2
+      env:
+      - name: PASSWORD
+        valueFrom:
+          secretKeyRef:
+            name: my-secret
+            key: password
+      - name: TEMPLATED
+        valueFrom:
+          secretKeyRef:
+            name: "{{workflow.parameters.secret}}"
+            key: password
+`
+
+func Test_resourceValidator(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(validateResourcesWorkflow)
+	t.Run("AllMissing", func(t *testing.T) {
+		v := &resourceValidator{kubeClient: kubefake.NewSimpleClientset(), wfClient: wffake.NewSimpleClientset()}
+		err := v.validate(ctx, wf)
+		require.EqualError(t, err, `workflow "my-wf" references missing resources: ServiceAccount argo/my-sa, StorageClass fast, ConfigMap argo/my-cm, WorkflowTemplate argo/my-wftmpl, Secret argo/my-secret`)
+	})
+	t.Run("AllPresent", func(t *testing.T) {
+		v := &resourceValidator{
+			kubeClient: kubefake.NewSimpleClientset(
+				&apiv1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "my-sa", Namespace: "argo"}},
+				&apiv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "my-cm", Namespace: "argo"}},
+				&apiv1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "argo"}},
+			),
+			wfClient: wffake.NewSimpleClientset(
+				&wfv1.WorkflowTemplate{ObjectMeta: metav1.ObjectMeta{Name: "my-wftmpl", Namespace: "argo"}},
+			),
+		}
+		err := v.validate(ctx, wf)
+		require.EqualError(t, err, `workflow "my-wf" references missing resources: StorageClass fast`)
+	})
+	t.Run("WorkflowTemplateRef", func(t *testing.T) {
+		wf := &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "from-ref-", Namespace: "argo"},
+			Spec:       wfv1.WorkflowSpec{WorkflowTemplateRef: &wfv1.WorkflowTemplateRef{Name: "my-cwftmpl", ClusterScope: true}},
+		}
+		v := &resourceValidator{kubeClient: kubefake.NewSimpleClientset(), wfClient: wffake.NewSimpleClientset()}
+		err := v.validate(ctx, wf)
+		require.EqualError(t, err, `workflow "from-ref-" references missing resources: ClusterWorkflowTemplate my-cwftmpl`)
+
+		// the references of the referenced template are checked too
+		v.wfClient = wffake.NewSimpleClientset(&wfv1.ClusterWorkflowTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-cwftmpl"},
+			Spec:       wfv1.WorkflowSpec{ServiceAccountName: "tmpl-sa"},
+		})
+		err = v.validate(ctx, wf)
+		require.EqualError(t, err, `workflow "from-ref-" references missing resources: ServiceAccount argo/tmpl-sa`)
+	})
+}
+
+func Test_validateOptions_validateResources(t *testing.T) {
+	err := validateOptions(nil, &wfv1.SubmitOpts{}, &common.CliSubmitOpts{ValidateResources: true})
+	require.EqualError(t, err, "--validate-resources should be combined with --dry-run or --server-dry-run")
+	cliOpts := common.NewCliSubmitOpts()
+	cliOpts.ValidateResources = true
+	require.NoError(t, cliOpts.Output.Set("name"))
+	assert.NoError(t, validateOptions(nil, &wfv1.SubmitOpts{DryRun: true}, &cliOpts))
+}
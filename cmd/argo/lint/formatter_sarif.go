@@ -0,0 +1,134 @@
+package lint
+
+import (
+	"encoding/json"
+
+	"github.com/argoproj/argo-workflows/v3"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	sarifRuleID  = "invalid-manifest"
+)
+
+// The subset of SARIF 2.1.0 that is needed to report linting errors.
+// See https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool        sarifTool         `json:"tool"`
+	Invocations []sarifInvocation `json:"invocations"`
+	Results     []sarifResult     `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Version        string      `json:"version"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifInvocation struct {
+	ExecutionSuccessful        bool                `json:"executionSuccessful"`
+	ToolExecutionNotifications []sarifNotification `json:"toolExecutionNotifications,omitempty"`
+}
+
+type sarifNotification struct {
+	Level   string       `json:"level"`
+	Message sarifMessage `json:"message"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+}
+
+// formatterSARIF writes a single SARIF log of all the linting errors, for code scanning tools
+type formatterSARIF struct{}
+
+func (f formatterSARIF) Format(*LintResult) string {
+	// the results are only written once they are all known, as one JSON document
+	return ""
+}
+
+func (f formatterSARIF) Summarize(l *LintResults) string {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "argo lint",
+			InformationURI: "https://argo-workflows.readthedocs.io/en/latest/cli/argo_lint/",
+			Version:        argo.GetVersion().Version,
+			Rules: []sarifRule{{
+				ID:               sarifRuleID,
+				ShortDescription: sarifMessage{Text: "The manifest is not valid"},
+			}},
+		}},
+		Invocations: []sarifInvocation{{ExecutionSuccessful: l.anythingLinted}},
+		Results:     []sarifResult{},
+	}
+	if !l.anythingLinted {
+		run.Invocations[0].ToolExecutionNotifications = []sarifNotification{{
+			Level:   "error",
+			Message: sarifMessage{Text: "found nothing to lint in the specified paths"},
+		}}
+	}
+	for _, r := range l.Results {
+		if !r.Linted {
+			continue
+		}
+		for _, e := range r.Errs {
+			line, column := errorPosition(e)
+			run.Results = append(run.Results, sarifResult{
+				RuleID:  sarifRuleID,
+				Level:   "error",
+				Message: sarifMessage{Text: e.Error()},
+				Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: r.File},
+					Region:           sarifRegion{StartLine: line, StartColumn: column},
+				}}},
+			})
+		}
+	}
+	out, err := json.MarshalIndent(sarifLog{Schema: sarifSchema, Version: sarifVersion, Runs: []sarifRun{run}}, "", "  ")
+	if err != nil {
+		return err.Error() + "\n"
+	}
+	return string(out) + "\n"
+}
@@ -0,0 +1,138 @@
+package lint
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient"
+	wf "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+var sarifLintData = []byte(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: valid
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      container:
+        image: busybox
+---
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: missing-image
+spec:
+  entrypoint: main
+  templates:
+    - name: other
+      container:
+        image: busybox
+    - name: main
+      container:
+        command: [echo]
+---
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: missing-template-
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      steps:
+        - - name: hello
+            template: nope
+`)
+
+func TestSARIFFormat(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "workflows.yaml")
+	require.NoError(t, os.WriteFile(file, sarifLintData, 0o600))
+	ctx := logging.TestContext(t.Context())
+	ctx, client, err := apiclient.NewClientFromOptsWithContext(ctx, apiclient.Opts{Offline: true, OfflineFiles: []string{file}})
+	require.NoError(t, err)
+	clients, err := getLintClients(ctx, client, []string{wf.WorkflowPlural})
+	require.NoError(t, err)
+	fmtr, err := GetFormatter("sarif")
+	require.NoError(t, err)
+	out := &strings.Builder{}
+
+	res, err := Lint(ctx, &LintOptions{Files: []string{file}, Strict: true, ServiceClients: clients, Formatter: fmtr, Printer: out})
+	require.NoError(t, err)
+	assert.False(t, res.Success)
+
+	var log map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(out.String()), &log), "the output is a single JSON document")
+	assert.Equal(t, "https://json.schemastore.org/sarif-2.1.0.json", log["$schema"])
+	assert.Equal(t, "2.1.0", log["version"])
+	runs := log["runs"].([]interface{})
+	require.Len(t, runs, 1)
+	run := runs[0].(map[string]interface{})
+	driver := run["tool"].(map[string]interface{})["driver"].(map[string]interface{})
+	assert.Equal(t, "argo lint", driver["name"])
+	rules := driver["rules"].([]interface{})
+	require.Len(t, rules, 1)
+	assert.Equal(t, sarifRuleID, rules[0].(map[string]interface{})["id"])
+
+	type location struct {
+		uri          string
+		line, column float64
+	}
+	var messages []string
+	var locations []location
+	for _, r := range run["results"].([]interface{}) {
+		result := r.(map[string]interface{})
+		assert.Equal(t, sarifRuleID, result["ruleId"])
+		assert.Equal(t, "error", result["level"])
+		messages = append(messages, result["message"].(map[string]interface{})["text"].(string))
+		physical := result["locations"].([]interface{})[0].(map[string]interface{})["physicalLocation"].(map[string]interface{})
+		region := physical["region"].(map[string]interface{})
+		locations = append(locations, location{
+			uri:    physical["artifactLocation"].(map[string]interface{})["uri"].(string),
+			line:   region["startLine"].(float64),
+			column: region["startColumn"].(float64),
+		})
+	}
+	require.Len(t, messages, 2)
+	assert.Equal(t, `in "missing-image" (Workflow): templates.main.container.image may not be empty`, messages[0])
+	assert.Contains(t, messages[1], `in "missing-template-" (Workflow): templates.main.steps[0].hello`)
+	// the name of the template with the error, and the step with the error
+	assert.Equal(t, []location{{file, 23, 7}, {file, 35, 13}}, locations)
+}
+
+func TestSARIFNothingLinted(t *testing.T) {
+	out := formatterSARIF{}.Summarize(&LintResults{})
+	var log sarifLog
+	require.NoError(t, json.Unmarshal([]byte(out), &log))
+	require.Len(t, log.Runs, 1)
+	assert.Empty(t, log.Runs[0].Results)
+	assert.False(t, log.Runs[0].Invocations[0].ExecutionSuccessful)
+	assert.Equal(t, "found nothing to lint in the specified paths", log.Runs[0].Invocations[0].ToolExecutionNotifications[0].Message.Text)
+}
+
+func TestFindPosition(t *testing.T) {
+	obj := &metav1.ObjectMeta{Name: "missing-image"}
+	for _, tt := range []struct {
+		msg          string
+		line, column int
+	}{
+		{"templates.main.container.image may not be empty", 23, 7},
+		{"spec.templates[1].container", 23, 7},
+		{"rpc error: code = InvalidArgument desc = templates.other.container.image is invalid", 21, 9},
+		{"entrypoint is invalid", 17, 3},
+		{"something else", 12, 1},
+	} {
+		line, column := findPosition(sarifLintData, "Workflow", obj, errors.New(tt.msg))
+		assert.Equal(t, []int{tt.line, tt.column}, []int{line, column}, tt.msg)
+	}
+}
@@ -67,6 +67,7 @@ var (
 	formatters = map[string]Formatter{
 		"pretty": formatterPretty{},
 		"simple": formatterSimple{},
+		"sarif":  formatterSARIF{},
 	}
 )
 
@@ -157,11 +158,12 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 		if namespace == "" {
 			namespace = opts.DefaultNamespace
 		}
-		objName := ""
+		kind, objName := "", ""
 		ctx, logger := logging.RequireLoggerFromContext(ctx).WithField("objectName", objName).InContext(ctx)
 		switch v := obj.(type) {
 		case *wfv1.ClusterWorkflowTemplate:
-			objName = getObjectName(wf.ClusterWorkflowTemplateKind, v, i)
+			kind = wf.ClusterWorkflowTemplateKind
+			objName = getObjectName(kind, v, i)
 			if opts.ServiceClients.ClusterWorkflowTemplateClient == nil {
 				logger.Debug(ctx, "ignoring object, not in lint options kinds")
 				continue
@@ -174,7 +176,8 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 				)
 			}
 		case *wfv1.CronWorkflow:
-			objName = getObjectName(wf.CronWorkflowKind, v, i)
+			kind = wf.CronWorkflowKind
+			objName = getObjectName(kind, v, i)
 			if opts.ServiceClients.CronWorkflowsClient == nil {
 				logger.Debug(ctx, "ignoring object, not in lint options kinds")
 				continue
@@ -187,7 +190,8 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 				)
 			}
 		case *wfv1.Workflow:
-			objName = getObjectName(wf.WorkflowKind, v, i)
+			kind = wf.WorkflowKind
+			objName = getObjectName(kind, v, i)
 			if opts.ServiceClients.WorkflowsClient == nil {
 				logger.Debug(ctx, "ignoring object, not in lint options kinds")
 				continue
@@ -202,7 +206,8 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 		case *wfv1.WorkflowEventBinding:
 			// noop
 		case *wfv1.WorkflowTemplate:
-			objName = getObjectName(wf.WorkflowTemplateKind, v, i)
+			kind = wf.WorkflowTemplateKind
+			objName = getObjectName(kind, v, i)
 			if opts.ServiceClients.WorkflowTemplatesClient == nil {
 				logger.Debug(ctx, "ignoring object, not in lint options kinds")
 				continue
@@ -219,7 +224,8 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 		}
 
 		if err != nil {
-			res.Errs = append(res.Errs, fmt.Errorf("in %s: %w", objName, err))
+			line, column := findPosition(data, kind, obj, err)
+			res.Errs = append(res.Errs, &positionError{err: fmt.Errorf("in %s: %w", objName, err), line: line, column: column})
 		}
 	}
 
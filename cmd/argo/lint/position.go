@@ -0,0 +1,152 @@
+package lint
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// positionError is a lint error with the position in the source that it refers to
+type positionError struct {
+	err    error
+	line   int
+	column int
+}
+
+func (e *positionError) Error() string {
+	return e.err.Error()
+}
+
+func (e *positionError) Unwrap() error {
+	return e.err
+}
+
+// errorPosition returns the line and column of the error, or 1 and 1 if it cannot be found
+func errorPosition(err error) (int, int) {
+	var p *positionError
+	if errors.As(err, &p) {
+		return p.line, p.column
+	}
+	return 1, 1
+}
+
+// fieldPath matches the path to the field at the start of a validation error, e.g. templates.main.container.image
+var fieldPath = regexp.MustCompile(`^[a-zA-Z][\w-]*(?:\.[\w-]+|\[\d+\])*`)
+
+// findPosition returns the line and column in data of the field that the error refers to, or of the object if the
+// field cannot be found
+func findPosition(data []byte, kind string, obj metav1.Object, err error) (int, int) {
+	doc := findDocument(data, kind, obj)
+	if doc == nil {
+		return 1, 1
+	}
+	line, column := doc.Line, doc.Column
+	msg := err.Error()
+	// errors from the server are wrapped in the gRPC status
+	if i := strings.LastIndex(msg, "desc = "); i >= 0 {
+		msg = msg[i+len("desc = "):]
+	}
+	segments := fieldPathSegments(fieldPath.FindString(msg))
+	node := doc
+	// paths are relative to the spec, unless they start with a top-level field
+	if len(segments) > 0 && segments[0] != "spec" && segments[0] != "metadata" {
+		node = mappingValue(doc, "spec")
+	}
+	for _, segment := range segments {
+		if node == nil {
+			break
+		}
+		var key *yaml.Node
+		key, node = child(node, segment)
+		if key != nil {
+			line, column = key.Line, key.Column
+		}
+	}
+	return line, column
+}
+
+// fieldPathSegments splits a path such as templates.main.steps[0] into templates, main, steps and 0
+func fieldPathSegments(path string) []string {
+	if path == "" {
+		return nil
+	}
+	return strings.FieldsFunc(path, func(r rune) bool { return r == '.' || r == '[' || r == ']' })
+}
+
+// findDocument returns the mapping of the YAML document of the object
+func findDocument(data []byte, kind string, obj metav1.Object) *yaml.Node {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc yaml.Node
+		if err := decoder.Decode(&doc); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			// skip documents that are not valid YAML
+			continue
+		}
+		if len(doc.Content) == 0 {
+			continue
+		}
+		root := doc.Content[0]
+		if value(mappingValue(root, "kind")) != kind {
+			continue
+		}
+		metadata := mappingValue(root, "metadata")
+		if value(mappingValue(metadata, "name")) == obj.GetName() && value(mappingValue(metadata, "generateName")) == obj.GetGenerateName() {
+			return root
+		}
+	}
+}
+
+// child returns the key and value of the segment of a field path in the node. The segment of a list is either the
+// index of an item, or the name of an item, e.g. templates.main.
+func child(node *yaml.Node, segment string) (*yaml.Node, *yaml.Node) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == segment {
+				return node.Content[i], node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		if i, err := strconv.Atoi(segment); err == nil {
+			if i >= 0 && i < len(node.Content) {
+				return node.Content[i], node.Content[i]
+			}
+			return nil, nil
+		}
+		for _, item := range node.Content {
+			if item.Kind != yaml.MappingNode {
+				continue
+			}
+			for i := 0; i+1 < len(item.Content); i += 2 {
+				if item.Content[i].Value == "name" && item.Content[i+1].Value == segment {
+					return item.Content[i], item
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	_, v := child(node, key)
+	return v
+}
+
+func value(node *yaml.Node) string {
+	if node == nil {
+		return ""
+	}
+	return node.Value
+}
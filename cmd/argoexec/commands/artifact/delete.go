@@ -16,14 +16,19 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	workflow "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	wfv1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/retry"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	executor "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
+// newDriver is overridden in tests
+var newDriver = executor.NewDriver
+
 func NewArtifactDeleteCommand() *cobra.Command {
-	return &cobra.Command{
+	var dryRun bool
+	command := &cobra.Command{
 		Use:          "delete",
 		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -42,7 +47,7 @@ func NewArtifactDeleteCommand() *cobra.Command {
 				artifactGCTaskInterface := workflowInterface.ArgoprojV1alpha1().WorkflowArtifactGCTasks(namespace)
 				labelSelector := fmt.Sprintf("%s = %s", common.LabelKeyArtifactGCPodHash, podName)
 
-				err = deleteArtifacts(labelSelector, ctx, artifactGCTaskInterface)
+				err = deleteArtifacts(labelSelector, ctx, artifactGCTaskInterface, dryRun)
 				if err != nil {
 					return err
 				}
@@ -50,9 +55,12 @@ func NewArtifactDeleteCommand() *cobra.Command {
 			return nil
 		},
 	}
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "log the artifacts that would be deleted, without deleting them")
+	return command
 }
 
-func deleteArtifacts(labelSelector string, ctx context.Context, artifactGCTaskInterface wfv1alpha1.WorkflowArtifactGCTaskInterface) error {
+func deleteArtifacts(labelSelector string, ctx context.Context, artifactGCTaskInterface wfv1alpha1.WorkflowArtifactGCTaskInterface, dryRun bool) error {
+	logger := logging.RequireLoggerFromContext(ctx)
 
 	taskList, err := artifactGCTaskInterface.List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
 	if err != nil {
@@ -79,11 +87,17 @@ func deleteArtifacts(labelSelector string, ctx context.Context, artifactGCTaskIn
 					}
 				}
 
-				drv, err := executor.NewDriver(ctx, &artifact, resources)
+				drv, err := newDriver(ctx, &artifact, resources)
 				if err != nil {
 					return err
 				}
 
+				if dryRun {
+					logger.WithFields(logging.Fields{"node": nodeName, "artifact": artifact.Name, "uri": artifact.StorageURI()}).Info(ctx, "dry run: would delete artifact")
+					artResultNodeStatus.ArtifactResults[artifact.Name] = v1alpha1.ArtifactResult{Name: artifact.Name, Success: true}
+					continue
+				}
+
 				err = waitutil.Backoff(retry.DefaultRetry(ctx), func() (bool, error) {
 					err = drv.Delete(ctx, &artifact)
 					if err != nil {
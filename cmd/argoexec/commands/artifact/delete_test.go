@@ -0,0 +1,73 @@
+package artifact
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+type fakeArtifactDriver struct {
+	artifactscommon.ArtifactDriver
+	deleted []string
+}
+
+func (d *fakeArtifactDriver) Delete(_ context.Context, artifact *v1alpha1.Artifact) error {
+	d.deleted = append(d.deleted, artifact.Name)
+	return nil
+}
+
+func TestDeleteArtifacts(t *testing.T) {
+	task := &v1alpha1.WorkflowArtifactGCTask{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-task", Namespace: "my-ns", Labels: map[string]string{"my-label": "my-value"}},
+		Spec: v1alpha1.ArtifactGCSpec{
+			ArtifactsByNode: map[string]v1alpha1.ArtifactNodeSpec{
+				"my-node": {
+					ArchiveLocation: &v1alpha1.ArtifactLocation{S3: &v1alpha1.S3Artifact{S3Bucket: v1alpha1.S3Bucket{Bucket: "my-bucket"}}},
+					Artifacts: map[string]v1alpha1.Artifact{
+						"my-artifact": {Name: "my-artifact", ArtifactLocation: v1alpha1.ArtifactLocation{S3: &v1alpha1.S3Artifact{Key: "my-key"}}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		dryRun  bool
+		deleted []string
+	}{
+		{"Delete", false, []string{"my-artifact"}},
+		{"DryRun", true, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := logging.TestContext(t.Context())
+			drv := &fakeArtifactDriver{}
+			defer func(f func(context.Context, *v1alpha1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error)) {
+				newDriver = f
+			}(newDriver)
+			newDriver = func(context.Context, *v1alpha1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+				return drv, nil
+			}
+			artifactGCTaskInterface := fake.NewSimpleClientset(task).ArgoprojV1alpha1().WorkflowArtifactGCTasks("my-ns")
+
+			err := deleteArtifacts("my-label = my-value", ctx, artifactGCTaskInterface, tt.dryRun)
+			require.NoError(t, err)
+			assert.Equal(t, tt.deleted, drv.deleted)
+
+			updated, err := artifactGCTaskInterface.Get(ctx, "my-task", metav1.GetOptions{})
+			require.NoError(t, err)
+			result := updated.Status.ArtifactResultsByNode["my-node"].ArtifactResults["my-artifact"]
+			assert.True(t, result.Success)
+			assert.Nil(t, result.Error)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"os/exec"
 	"os/signal"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"syscall"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3/workflow/executor"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/emissary"
+	"github.com/argoproj/argo-workflows/v3/workflow/executor/vault"
 
 	"github.com/argoproj/argo-workflows/v3/util/archive"
 	"github.com/argoproj/argo-workflows/v3/util/errors"
@@ -34,6 +36,7 @@ var (
 	varRunArgo          = common.VarRunArgoPath
 	containerName       = os.Getenv(common.EnvVarContainerName)
 	includeScriptOutput = os.Getenv(common.EnvVarIncludeScriptOutput) == "true" // capture stdout/combined
+	scriptSourcePath    = common.ExecutorScriptSourcePath
 	template            = &wfv1.Template{}
 )
 
@@ -115,6 +118,22 @@ func NewEmissaryCommand() *cobra.Command {
 				}
 			}
 
+			if containerName == common.MainContainerName {
+				for _, x := range template.Outputs.Artifacts {
+					if x.NamedPipe != nil {
+						if err := executor.CreateNamedPipe(x.NamedPipe.Path); err != nil {
+							return fmt.Errorf("failed to create the named pipe of artifact %q: %w", x.Name, err)
+						}
+					}
+				}
+			}
+
+			if containerName == common.MainContainerName && template.Script != nil && len(template.Script.Preprocessors) > 0 {
+				if err := preprocessScript(ctx, template.Script.Preprocessors); err != nil {
+					return err
+				}
+			}
+
 			name, err = exec.LookPath(name)
 			if err != nil {
 				return fmt.Errorf("failed to find name in PATH: %w", err)
@@ -242,6 +261,14 @@ func NewEmissaryCommand() *cobra.Command {
 							return err
 						}
 					}
+					// the wait container reads the artifact a jqFilter is applied to as it reads a path parameter
+					if x.ValueFrom != nil && x.ValueFrom.ArtifactName != "" {
+						if art := template.GetArtifactByName(x.ValueFrom.ArtifactName); art != nil && art.Path != "" {
+							if err := saveParameter(ctx, art.Path); err != nil {
+								return err
+							}
+						}
+					}
 				}
 				for _, x := range template.Outputs.Artifacts {
 					if x.Path != "" {
@@ -249,6 +276,22 @@ func NewEmissaryCommand() *cobra.Command {
 							return err
 						}
 					}
+					for _, p := range x.Paths {
+						if err := saveArtifact(ctx, p); err != nil {
+							return err
+						}
+					}
+					if x.GlobPath != "" {
+						matches, err := filepath.Glob(x.GlobPath)
+						if err != nil {
+							return fmt.Errorf("failed to expand globPath %s: %w", x.GlobPath, err)
+						}
+						for _, match := range matches {
+							if err := saveArtifact(ctx, match); err != nil {
+								return err
+							}
+						}
+					}
 				}
 			} else {
 				logger.Info(ctx, "not saving outputs - not main container")
@@ -259,11 +302,58 @@ func NewEmissaryCommand() *cobra.Command {
 	}
 }
 
+// preprocessScript replaces the script source staged by the init container with the output of its preprocessors
+func preprocessScript(ctx context.Context, preprocessors []wfv1.Preprocessor) error {
+	source, err := os.ReadFile(filepath.Clean(scriptSourcePath))
+	if err != nil {
+		return fmt.Errorf("failed to read script source: %w", err)
+	}
+	body, err := executor.PreprocessScript(ctx, string(source), preprocessors)
+	if err != nil {
+		return err
+	}
+	// the staged script may be owned by the user of the init container, so it is replaced rather than written to
+	tmp := scriptSourcePath + ".preprocessed"
+	if err := os.WriteFile(tmp, body, 0o755); err != nil { //nolint:gosec
+		return fmt.Errorf("failed to write preprocessed script source: %w", err)
+	}
+	return os.Rename(tmp, scriptSourcePath)
+}
+
+// optionalArtifactEnv tells the command which optional input artifacts the init container loaded
+func optionalArtifactEnv(template *wfv1.Template) []string {
+	var env []string
+	for _, art := range template.Inputs.Artifacts {
+		if !art.Optional {
+			continue
+		}
+		present := true
+		if _, err := os.Stat(filepath.Join(varRunArgo, "inputs", "absent-artifacts", art.Name)); err == nil {
+			present = false
+		}
+		env = append(env, fmt.Sprintf("%s=%t", common.EnvVarArtifactPresent(art.Name), present))
+	}
+	return env
+}
+
+// vaultEnv reads the environment variables of the vault-env annotation from the Vault agent, for the main containers
+func vaultEnv(ctx context.Context, template *wfv1.Template) ([]string, error) {
+	annotation, ok := template.Metadata.Annotations[common.AnnotationKeyVaultEnv]
+	if !ok || !slices.Contains(template.GetMainContainerNames(), containerName) {
+		return nil, nil
+	}
+	return vault.Env(ctx, annotation)
+}
+
 func startCommand(ctx context.Context, name string, args []string, template *wfv1.Template) (*exec.Cmd, func(), error) {
 	logger := logging.RequireLoggerFromContext(ctx)
 
+	secretEnv, err := vaultEnv(ctx, template)
+	if err != nil {
+		return nil, nil, err
+	}
 	command := exec.Command(name, args...)
-	command.Env = os.Environ()
+	command.Env = append(append(os.Environ(), optionalArtifactEnv(template)...), secretEnv...)
 
 	var closer = func() {}
 	var stdout io.Writer = os.Stdout
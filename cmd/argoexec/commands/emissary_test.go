@@ -4,18 +4,26 @@ package commands
 
 import (
 	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strconv"
 	"sync"
 	"syscall"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	cmdutil "github.com/argoproj/argo-workflows/v3/util/cmd"
 	"github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/executor/vault"
 )
 
 func TestEmissary(t *testing.T) {
@@ -195,6 +203,150 @@ func TestEmissary(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotEmpty(t, string(data)) // data is tgz format
 	})
+	t.Run("OptionalInputArtifact", func(t *testing.T) {
+		err = os.WriteFile(varRunArgo+"/template", []byte(`
+{
+	"inputs": {
+		"artifacts": [
+			{"name": "present", "path": "/tmp/present", "optional": true},
+			{"name": "my-absent", "path": "/tmp/absent", "optional": true},
+			{"name": "required", "path": "/tmp/required"}
+		]
+	},
+	"outputs": null,
+	"containerSet": null
+}
+`), 0o600)
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(varRunArgo+"/inputs/absent-artifacts", 0o700))
+		require.NoError(t, os.WriteFile(varRunArgo+"/inputs/absent-artifacts/my-absent", nil, 0o600))
+		_ = os.Remove(varRunArgo + "/ctr/main/stdout")
+		err := run(`echo "$ARGO_ARTIFACT_PRESENT_PRESENT $ARGO_ARTIFACT_MY_ABSENT_PRESENT $ARGO_ARTIFACT_REQUIRED_PRESENT"`)
+		require.NoError(t, err)
+		data, err := os.ReadFile(varRunArgo + "/ctr/main/stdout")
+		require.NoError(t, err)
+		assert.Equal(t, "true false \n", string(data))
+	})
+	t.Run("VaultEnv", func(t *testing.T) {
+		socket := filepath.Join(t.TempDir(), "agent.sock")
+		listener, err := net.Listen("unix", socket)
+		require.NoError(t, err)
+		agent := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/database/creds/readonly", r.URL.Path)
+			_, _ = w.Write([]byte(`{"data": {"password": "my-password"}}`))
+		}))
+		agent.Listener = listener
+		agent.Start()
+		defer agent.Close()
+		t.Setenv(vault.EnvVarAgentAddr, "unix://"+socket)
+
+		err = os.WriteFile(varRunArgo+"/template", []byte(`
+{
+	"metadata": {
+		"annotations": {
+			"workflows.argoproj.io/vault-env": "DB_PASSWORD: {vaultSecretRef: {path: database/creds/readonly, key: password}}"
+		}
+	}
+}
+`), 0o600)
+		require.NoError(t, err)
+		_ = os.Remove(varRunArgo + "/ctr/main/stdout")
+		err = run(`echo "$DB_PASSWORD"`)
+		require.NoError(t, err)
+		data, err := os.ReadFile(varRunArgo + "/ctr/main/stdout")
+		require.NoError(t, err)
+		assert.Equal(t, "my-password\n", string(data))
+	})
+	t.Run("ScriptPreprocessors", func(t *testing.T) {
+		// the template is unmarshalled over that of the previous runs
+		template = &wfv1.Template{}
+		scriptSourcePath = filepath.Join(t.TempDir(), "script")
+		defer func() { scriptSourcePath = common.ExecutorScriptSourcePath }()
+		require.NoError(t, os.WriteFile(scriptSourcePath, []byte("echo hello\n"), 0o755))
+		err = os.WriteFile(varRunArgo+"/template", []byte(`
+{
+	"script": {
+		"source": "echo hello\n",
+		"preprocessors": [
+			{"command": ["tr", "a-z", "A-Z"]},
+			{"command": ["sed", "s/ECHO/echo/"]}
+		]
+	}
+}
+`), 0o600)
+		require.NoError(t, err)
+		_ = os.Remove(varRunArgo + "/ctr/main/stdout")
+		err := run("sh " + scriptSourcePath)
+		require.NoError(t, err)
+		data, err := os.ReadFile(varRunArgo + "/ctr/main/stdout")
+		require.NoError(t, err)
+		assert.Equal(t, "HELLO\n", string(data))
+	})
+	t.Run("ScriptPreprocessorFailed", func(t *testing.T) {
+		template = &wfv1.Template{}
+		scriptSourcePath = filepath.Join(t.TempDir(), "script")
+		defer func() { scriptSourcePath = common.ExecutorScriptSourcePath }()
+		require.NoError(t, os.WriteFile(scriptSourcePath, []byte("echo hello\n"), 0o755))
+		err = os.WriteFile(varRunArgo+"/template", []byte(`{"script": {"preprocessors": [{"command": ["sh", "-c", "echo oops >&2; exit 1"]}]}}`), 0o600)
+		require.NoError(t, err)
+		err := run("sh " + scriptSourcePath)
+		require.EqualError(t, err, "script preprocessor [sh -c echo oops >&2; exit 1] failed: exit status 1: oops")
+	})
+	t.Run("NamedPipe", func(t *testing.T) {
+		template = &wfv1.Template{}
+		pipePath := filepath.Join(t.TempDir(), "numbers.txt")
+		err = os.WriteFile(varRunArgo+"/template", []byte(`
+{
+	"outputs": {
+		"artifacts": [
+			{"name": "numbers", "namedPipe": {"path": "`+pipePath+`"}}
+		]
+	}
+}
+`), 0o600)
+		require.NoError(t, err)
+		// the pipe exists before the command runs, even though the wait container has not created it
+		read := make(chan string)
+		go func() {
+			for {
+				if fi, err := os.Lstat(pipePath); err == nil && fi.Mode()&os.ModeNamedPipe != 0 {
+					data, _ := os.ReadFile(pipePath)
+					read <- string(data)
+					return
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+		}()
+		err := run(`test -p ` + pipePath + ` && seq 3 > ` + pipePath)
+		require.NoError(t, err)
+		assert.Equal(t, "1\n2\n3\n", <-read)
+	})
+	t.Run("ArtifactParameter", func(t *testing.T) {
+		// the template is unmarshalled over that of the previous runs
+		template = &wfv1.Template{}
+		err = os.WriteFile(varRunArgo+"/template", []byte(`
+{
+	"outputs": {
+		"artifacts": [
+			{"name": "report", "path": "/tmp/report.json"}
+		],
+		"parameters": [
+			{
+				"name": "phase",
+				"valueFrom": {"artifactName": "report", "jqFilter": ".phase"}
+			}
+		]
+	}
+}
+`), 0o600)
+		require.NoError(t, err)
+		err := run(`echo '{"phase": "Succeeded"}' > /tmp/report.json`)
+		require.NoError(t, err)
+		// staged where the wait container reads base image layer parameters from
+		data, err := os.ReadFile(varRunArgo + "/outputs/parameters/tmp/report.json")
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"phase": "Succeeded"}`, string(data))
+	})
 }
 
 func run(script string) error {
@@ -46,8 +46,8 @@ func execResource(ctx context.Context, action string) error {
 	}
 
 	isDelete := action == "delete"
-	if isDelete && (wfExecutor.Template.Resource.SuccessCondition != "" || wfExecutor.Template.Resource.FailureCondition != "" || len(wfExecutor.Template.Outputs.Parameters) > 0) {
-		err = fmt.Errorf("successCondition, failureCondition and outputs are not supported for delete action")
+	if isDelete && (wfExecutor.Template.Resource.SuccessCondition != "" || wfExecutor.Template.Resource.FailureCondition != "" || wfExecutor.Template.Resource.WaitForResource != nil || len(wfExecutor.Template.Outputs.Parameters) > 0) {
+		err = fmt.Errorf("successCondition, failureCondition, waitForResource and outputs are not supported for delete action")
 		wfExecutor.AddError(ctx, err)
 		return err
 	}
@@ -74,6 +74,11 @@ func execResource(ctx context.Context, action string) error {
 			wfExecutor.AddError(ctx, err)
 			return err
 		}
+		err = wfExecutor.WaitForResource(ctx, resourceNamespace, resourceName, selfLink)
+		if err != nil {
+			wfExecutor.AddError(ctx, err)
+			return err
+		}
 		err = wfExecutor.SaveResourceParameters(ctx, resourceNamespace, resourceName)
 		if err != nil {
 			wfExecutor.AddError(ctx, err)
@@ -42,6 +42,9 @@ func waitContainer(ctx context.Context) error {
 	// Create a new empty (placeholder) task result with LabelKeyReportOutputsCompleted set to false.
 	wfExecutor.InitializeOutput(bgCtx)
 
+	// Upload the output artifacts that the main container writes to named pipes while it runs
+	wfExecutor.StartNamedPipeArtifacts(bgCtx)
+
 	// Wait for main container to complete
 	err := wfExecutor.Wait(ctx)
 	if err != nil {
@@ -107,6 +107,15 @@ type Config struct {
 	// https://argo-workflows.readthedocs.io/en/latest/workflow-executors/#emissary-emissary
 	Images map[string]Image `json:"images,omitempty"`
 
+	// ValidateImageEntrypoints looks up the entrypoint of the image of each main container that sets command, from
+	// images or the image's registry, and emits a warning event on the workflow if the command is likely meant to be
+	// arguments to the entrypoint
+	ValidateImageEntrypoints bool `json:"validateImageEntrypoints,omitempty"`
+
+	// Interpreters are the commands that script templates can use by name with script.interpreter, for example
+	// python3: [/usr/local/bin/python3]
+	Interpreters map[string][]string `json:"interpreters,omitempty"`
+
 	// Workflow retention by number of workflows
 	RetentionPolicy *RetentionPolicy `json:"retentionPolicy,omitempty"`
 
@@ -118,6 +127,15 @@ type Config struct {
 
 	// Synchronization via databases config
 	Synchronization *SyncConfig `json:"synchronization,omitempty"`
+
+	// AggregatedPullSecrets are image pull secrets that the controller creates and refreshes before they expire
+	AggregatedPullSecrets *AggregatedPullSecrets `json:"aggregatedPullSecrets,omitempty"`
+
+	// RetryTransientErrors adds to the failures that are retried by `retryStrategy.retryOn.transientErrors`
+	RetryTransientErrors *TransientErrors `json:"retryTransientErrors,omitempty"`
+
+	// WorkflowEventSources enables the webhooks of workflow event sources, which are disabled by default
+	WorkflowEventSources *WorkflowEventSources `json:"workflowEventSources,omitempty"`
 }
 
 func (c Config) GetExecutor() *apiv1.Container {
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AggregatedPullSecrets configures image pull secrets that the controller creates and refreshes from short-lived
+// registry tokens, so that the pods of long-running workflows can still pull images after the first token expired
+type AggregatedPullSecrets struct {
+	// RefreshBuffer is how long before the tokens expire that a pull secret is refreshed, defaults to 15m
+	RefreshBuffer *metav1.Duration `json:"refreshBuffer,omitempty"`
+	// Secrets are the managed pull secrets. A workflow uses one by adding its name to `imagePullSecrets`.
+	Secrets []AggregatedPullSecret `json:"secrets,omitempty"`
+}
+
+func (s AggregatedPullSecrets) GetRefreshBuffer() time.Duration {
+	if s.RefreshBuffer != nil {
+		return s.RefreshBuffer.Duration
+	}
+	return 15 * time.Minute
+}
+
+// AggregatedPullSecret is a pull secret that aggregates the credentials of one or more registries
+type AggregatedPullSecret struct {
+	// Name of the secret, which is created in the namespace of each workflow that uses it
+	Name string `json:"name"`
+	// Namespaces are the namespaces that the secret may be created in, as it holds the registry credentials of the
+	// controller. Workflows in other namespaces that use a secret with this name are left alone.
+	Namespaces []string `json:"namespaces"`
+	// ECR gets tokens for Amazon Elastic Container Registry
+	ECR *ECRPullSecret `json:"ecr,omitempty"`
+	// GCR gets tokens for Google Container Registry and Artifact Registry
+	GCR *GCRPullSecret `json:"gcr,omitempty"`
+	// ACR gets tokens for Azure Container Registry
+	ACR *ACRPullSecret `json:"acr,omitempty"`
+}
+
+// ECRPullSecret gets tokens from ECR, using the default AWS credentials of the controller
+type ECRPullSecret struct {
+	// Region of the registries
+	Region string `json:"region"`
+	// RegistryIDs are the AWS account IDs of the registries, defaults to the account of the credentials
+	RegistryIDs []string `json:"registryIDs,omitempty"`
+	// Endpoint overrides the ECR API endpoint, e.g. for a VPC endpoint
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// GCRPullSecret gets access tokens using the Google application default credentials of the controller
+type GCRPullSecret struct {
+	// Registries are the hosts to use the tokens for, e.g. `gcr.io` or `us-docker.pkg.dev`
+	Registries []string `json:"registries"`
+}
+
+// ACRPullSecret gets refresh tokens from ACR, by exchanging an Azure AD token of the default Azure credentials of the
+// controller
+type ACRPullSecret struct {
+	// Registries are the login servers of the registries, e.g. `myregistry.azurecr.io`
+	Registries []string `json:"registries"`
+}
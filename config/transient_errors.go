@@ -0,0 +1,10 @@
+package config
+
+// TransientErrors are the failures that are retried by `retryStrategy.retryOn.transientErrors`, in addition to the
+// built-in ones
+type TransientErrors struct {
+	// ExitCodes of the main container
+	ExitCodes []int `json:"exitCodes,omitempty"`
+	// MessagePatterns are regular expressions that are matched against the message of the failed node
+	MessagePatterns []string `json:"messagePatterns,omitempty"`
+}
@@ -0,0 +1,15 @@
+package config
+
+// WorkflowEventSources enables the webhooks of WorkflowEventSources, which the controller listens for itself. Changing
+// it requires restarting the controller.
+type WorkflowEventSources struct {
+	// MinPort is the lowest port that a webhook may listen on
+	MinPort int32 `json:"minPort"`
+	// MaxPort is the highest port that a webhook may listen on
+	MaxPort int32 `json:"maxPort"`
+}
+
+// AllowsPort returns whether a webhook may listen on the port
+func (s WorkflowEventSources) AllowsPort(port int32) bool {
+	return port >= s.MinPort && port <= s.MaxPort
+}
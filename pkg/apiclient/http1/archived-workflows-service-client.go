@@ -52,6 +52,11 @@ func (h ArchivedWorkflowsServiceClient) RetryArchivedWorkflow(ctx context.Contex
 	return out, h.Put(ctx, in, out, "/api/v1/archived-workflows/{uid}/retry")
 }
 
+func (h ArchivedWorkflowsServiceClient) CreateArchivedWorkflow(ctx context.Context, in *workflowarchivepkg.CreateArchivedWorkflowRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	out := &wfv1.Workflow{}
+	return out, h.Post(ctx, in, out, "/api/v1/archived-workflows")
+}
+
 func (h ArchivedWorkflowsServiceClient) ResubmitArchivedWorkflow(ctx context.Context, in *workflowarchivepkg.ResubmitArchivedWorkflowRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
 	out := &wfv1.Workflow{}
 	return out, h.Put(ctx, in, out, "/api/v1/archived-workflows/{uid}/resubmit")
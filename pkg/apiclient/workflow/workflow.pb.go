@@ -430,6 +430,7 @@ type WorkflowResumeRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	NodeFieldSelector    string   `protobuf:"bytes,3,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	NodeId               string   `protobuf:"bytes,4,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -489,6 +490,13 @@ func (m *WorkflowResumeRequest) GetNodeFieldSelector() string {
 	return ""
 }
 
+func (m *WorkflowResumeRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
 type WorkflowTerminateRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -705,6 +713,7 @@ func (m *WorkflowSetRequest) GetOutputParameters() string {
 type WorkflowSuspendRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NodeId               string   `protobuf:"bytes,3,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -757,16 +766,25 @@ func (m *WorkflowSuspendRequest) GetNamespace() string {
 	return ""
 }
 
+func (m *WorkflowSuspendRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
 type WorkflowLogRequest struct {
-	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace            string             `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	PodName              string             `protobuf:"bytes,3,opt,name=podName,proto3" json:"podName,omitempty"`
-	LogOptions           *v11.PodLogOptions `protobuf:"bytes,4,opt,name=logOptions,proto3" json:"logOptions,omitempty"`
-	Grep                 string             `protobuf:"bytes,5,opt,name=grep,proto3" json:"grep,omitempty"`
-	Selector             string             `protobuf:"bytes,6,opt,name=selector,proto3" json:"selector,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Name       string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace  string             `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PodName    string             `protobuf:"bytes,3,opt,name=podName,proto3" json:"podName,omitempty"`
+	LogOptions *v11.PodLogOptions `protobuf:"bytes,4,opt,name=logOptions,proto3" json:"logOptions,omitempty"`
+	Grep       string             `protobuf:"bytes,5,opt,name=grep,proto3" json:"grep,omitempty"`
+	Selector   string             `protobuf:"bytes,6,opt,name=selector,proto3" json:"selector,omitempty"`
+	// only stream the logs of nodes in these phases, e.g. Failed,Error
+	NodeStatusFilter     []string `protobuf:"bytes,7,rep,name=nodeStatusFilter,proto3" json:"nodeStatusFilter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *WorkflowLogRequest) Reset()         { *m = WorkflowLogRequest{} }
@@ -844,6 +862,13 @@ func (m *WorkflowLogRequest) GetSelector() string {
 	return ""
 }
 
+func (m *WorkflowLogRequest) GetNodeStatusFilter() []string {
+	if m != nil {
+		return m.NodeStatusFilter
+	}
+	return nil
+}
+
 type WorkflowDeleteRequest struct {
 	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string            `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -1337,100 +1362,102 @@ func init() {
 }
 
 var fileDescriptor_1f6bb75f9e833cb6 = []byte{
-	// 1486 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcd, 0x8f, 0x14, 0x45,
-	0x1b, 0xc0, 0x53, 0xb3, 0xb0, 0x2c, 0xb5, 0x1f, 0x40, 0xbd, 0xc0, 0x3b, 0x6f, 0x07, 0x96, 0xa5,
-	0x78, 0xc1, 0x65, 0x61, 0xbb, 0xf7, 0x03, 0x15, 0x4c, 0x34, 0x01, 0x16, 0x36, 0xe2, 0x88, 0x64,
-	0xc6, 0xc4, 0xe8, 0xc5, 0xf4, 0xf6, 0x3c, 0xd3, 0xdb, 0x6c, 0x4f, 0x57, 0x5b, 0x55, 0x33, 0x64,
-	0x45, 0x4c, 0xf4, 0xa2, 0x07, 0x12, 0x0f, 0x1e, 0xbd, 0x99, 0x18, 0x3d, 0x18, 0x35, 0x26, 0x26,
-	0x46, 0x13, 0xe3, 0xc1, 0x83, 0x47, 0x12, 0xae, 0x1e, 0x0c, 0xf1, 0x1f, 0xf0, 0x3f, 0x30, 0x55,
-	0xfd, 0xbd, 0x33, 0x0c, 0xed, 0xee, 0xa0, 0xdc, 0xaa, 0xaa, 0xab, 0xea, 0xf9, 0xd5, 0xf3, 0x54,
-	0x3d, 0x1f, 0x33, 0xf8, 0x64, 0xb8, 0xe1, 0x5a, 0x76, 0xe8, 0x39, 0xbe, 0x07, 0x81, 0xb4, 0x6e,
-	0x31, 0xbe, 0xd1, 0xf2, 0xd9, 0xad, 0xb4, 0x61, 0x86, 0x9c, 0x49, 0x46, 0xc6, 0x92, 0xbe, 0x71,
-	0xc4, 0x65, 0xcc, 0xf5, 0x41, 0xad, 0xb1, 0xec, 0x20, 0x60, 0xd2, 0x96, 0x1e, 0x0b, 0x44, 0x34,
-	0xcf, 0x38, 0xb7, 0x71, 0x5e, 0x98, 0x1e, 0x53, 0x5f, 0xdb, 0xb6, 0xb3, 0xee, 0x05, 0xc0, 0x37,
-	0xad, 0x58, 0x84, 0xb0, 0xda, 0x20, 0x6d, 0xab, 0xbb, 0x68, 0xb9, 0x10, 0x00, 0xb7, 0x25, 0x34,
-	0xe3, 0x55, 0x2f, 0xbb, 0x9e, 0x5c, 0xef, 0xac, 0x99, 0x0e, 0x6b, 0x5b, 0x36, 0x77, 0x59, 0xc8,
-	0xd9, 0x4d, 0xdd, 0x98, 0x4f, 0xc4, 0x8a, 0x6c, 0x93, 0x14, 0xb1, 0xbb, 0x68, 0xfb, 0xe1, 0xba,
-	0xdd, 0xbb, 0x1d, 0xcd, 0x20, 0x2c, 0x87, 0x71, 0xe8, 0x23, 0x92, 0xfe, 0x5c, 0xc1, 0x87, 0x5e,
-	0x8b, 0x77, 0xba, 0xcc, 0xc1, 0x96, 0x50, 0x87, 0xb7, 0x3a, 0x20, 0x24, 0x39, 0x82, 0xf7, 0x06,
-	0x76, 0x1b, 0x44, 0x68, 0x3b, 0x50, 0x45, 0x33, 0x68, 0x76, 0x6f, 0x3d, 0x1b, 0x20, 0x2d, 0x9c,
-	0xaa, 0xa2, 0x5a, 0x99, 0x41, 0xb3, 0xe3, 0x4b, 0xd7, 0xcc, 0x8c, 0xde, 0x4c, 0xe8, 0x75, 0xe3,
-	0xcd, 0x94, 0xde, 0xec, 0x2e, 0x9b, 0xe1, 0x86, 0x6b, 0xaa, 0x03, 0x98, 0xa9, 0x6a, 0x93, 0x03,
-	0x98, 0x09, 0x48, 0x3d, 0xdd, 0x9b, 0x50, 0x8c, 0xbd, 0x40, 0x48, 0x3b, 0x70, 0xe0, 0xc5, 0x95,
-	0xea, 0x88, 0xc2, 0xb8, 0x54, 0xa9, 0xa2, 0x7a, 0x6e, 0x94, 0x50, 0x3c, 0x21, 0x80, 0x77, 0x81,
-	0xaf, 0xf0, 0xcd, 0x7a, 0x27, 0xa8, 0xee, 0x9a, 0x41, 0xb3, 0x63, 0xf5, 0xc2, 0x18, 0x79, 0x1d,
-	0x4f, 0x3a, 0xfa, 0x78, 0xaf, 0x84, 0xda, 0x4e, 0xd5, 0xdd, 0x1a, 0x7a, 0xd9, 0x8c, 0x74, 0x64,
-	0xe6, 0x0d, 0x95, 0x21, 0x2a, 0x43, 0x99, 0xdd, 0x45, 0xf3, 0x72, 0x7e, 0x69, 0xbd, 0xb8, 0x13,
-	0xfd, 0x06, 0x61, 0x92, 0x90, 0xaf, 0x82, 0x4c, 0xf4, 0x47, 0xf0, 0x2e, 0xa5, 0xae, 0x58, 0x75,
-	0xba, 0x5d, 0xd4, 0x69, 0x65, 0xab, 0x4e, 0x6f, 0x60, 0xec, 0x82, 0x4c, 0x00, 0x47, 0x34, 0xe0,
-	0x42, 0x39, 0xc0, 0xd5, 0x74, 0x5d, 0x3d, 0xb7, 0x07, 0x39, 0x8c, 0x47, 0x5b, 0x1e, 0xf8, 0x4d,
-	0xa1, 0x75, 0xb2, 0xb7, 0x1e, 0xf7, 0xe8, 0xdd, 0x0a, 0xfe, 0x4f, 0x82, 0x5c, 0xf3, 0x84, 0x2c,
-	0x67, 0xf3, 0x06, 0x1e, 0xf7, 0x3d, 0x91, 0x02, 0x46, 0x66, 0x5f, 0x2c, 0x07, 0x58, 0xcb, 0x16,
-	0xd6, 0xf3, 0xbb, 0xe4, 0x10, 0x47, 0xf2, 0x88, 0x64, 0x1a, 0x63, 0x25, 0xf9, 0xaa, 0xe7, 0x4b,
-	0xe0, 0x31, 0x7e, 0x6e, 0x44, 0x19, 0x3d, 0x32, 0x43, 0xf3, 0x62, 0x4b, 0xcd, 0xd8, 0xad, 0x67,
-	0x14, 0xc6, 0xc8, 0x29, 0x3c, 0xd5, 0xf2, 0x02, 0x4f, 0xac, 0x43, 0xf3, 0x12, 0xb4, 0x18, 0x87,
-	0xea, 0xa8, 0x9e, 0xb5, 0x65, 0x94, 0x7e, 0x80, 0xf0, 0x7f, 0xd3, 0xbb, 0x07, 0xa2, 0xb3, 0xd6,
-	0xf6, 0x76, 0x60, 0x46, 0x03, 0x8f, 0xb5, 0xa1, 0xcd, 0xbc, 0xb7, 0xa1, 0xa9, 0xcf, 0x34, 0x56,
-	0x4f, 0xfb, 0xea, 0x54, 0xa1, 0xcd, 0xed, 0x36, 0x48, 0xe0, 0xea, 0x0e, 0x8e, 0xa8, 0x53, 0x65,
-	0x23, 0xf4, 0x17, 0x84, 0x0f, 0x66, 0x24, 0x92, 0x6f, 0x6e, 0x1f, 0xe3, 0x2c, 0x3e, 0xc0, 0x41,
-	0x48, 0x9b, 0xcb, 0x46, 0xc7, 0x71, 0x40, 0x88, 0x56, 0xc7, 0x8f, 0x79, 0x7a, 0x3f, 0xa8, 0xd9,
-	0x01, 0x6b, 0xc2, 0x55, 0xa5, 0xfc, 0x06, 0xf8, 0xe0, 0x48, 0x96, 0x68, 0xbd, 0xf7, 0xc3, 0x23,
-	0x8f, 0x71, 0x2b, 0x73, 0x2a, 0x4a, 0x9f, 0x6d, 0xd8, 0xd1, 0x31, 0x7a, 0xc1, 0x46, 0x1e, 0x02,
-	0x46, 0x6b, 0xb8, 0x9a, 0x08, 0x7e, 0x15, 0x78, 0xdb, 0x0b, 0x72, 0x0e, 0xed, 0x6f, 0xcb, 0xa6,
-	0x1f, 0xa1, 0xec, 0x99, 0x34, 0x24, 0x0b, 0xff, 0xa1, 0x53, 0x90, 0x2a, 0xde, 0xd3, 0x06, 0x21,
-	0x6c, 0x17, 0x62, 0x13, 0x24, 0x5d, 0x7a, 0x2f, 0xe7, 0x6b, 0x1a, 0x3b, 0xf1, 0x35, 0x43, 0x02,
-	0x22, 0x07, 0xf1, 0xee, 0x70, 0xdd, 0x16, 0x10, 0xbf, 0xbf, 0xa8, 0x43, 0xe6, 0xf0, 0x7e, 0xd6,
-	0x91, 0x61, 0x47, 0xde, 0xc8, 0x6e, 0x49, 0xf4, 0xf4, 0x7a, 0xc6, 0xe9, 0x35, 0x7c, 0x38, 0x3d,
-	0x51, 0x47, 0x84, 0x10, 0x34, 0xb7, 0x6f, 0xb0, 0xfb, 0x39, 0xf5, 0xd4, 0x98, 0xbb, 0x7d, 0xf5,
-	0x54, 0xf1, 0x9e, 0x90, 0x35, 0xaf, 0xab, 0x45, 0x91, 0x52, 0x92, 0x2e, 0xb9, 0x88, 0xb1, 0xcf,
-	0xdc, 0xc4, 0x07, 0xee, 0xd2, 0x3e, 0xf0, 0x78, 0xce, 0x07, 0x9a, 0x2a, 0xd2, 0x2a, 0x8f, 0x77,
-	0x83, 0x35, 0x6b, 0xe9, 0xc4, 0x7a, 0x6e, 0x91, 0xc2, 0x71, 0x39, 0x84, 0xb1, 0xca, 0x74, 0x5b,
-	0x39, 0x0d, 0x91, 0x98, 0x21, 0xd2, 0x54, 0xda, 0xa7, 0x3f, 0xa0, 0xec, 0x39, 0xad, 0x80, 0x0f,
-	0x3b, 0xb8, 0xd2, 0x2a, 0x0e, 0x36, 0xf5, 0x16, 0xc5, 0x30, 0x53, 0x32, 0x0e, 0xae, 0xe4, 0x97,
-	0xd6, 0x8b, 0x3b, 0xa9, 0xab, 0xd0, 0x62, 0xdc, 0x81, 0x38, 0xfe, 0x46, 0x1d, 0x5a, 0xcd, 0xcc,
-	0x9b, 0xb0, 0x8b, 0x90, 0x05, 0x02, 0xe8, 0xa7, 0xea, 0x58, 0xb6, 0x74, 0xd6, 0x93, 0xef, 0xe2,
-	0xc9, 0x0b, 0x43, 0xf4, 0x6e, 0xee, 0x46, 0x69, 0xd8, 0x2b, 0x5d, 0x08, 0xb4, 0xe2, 0xe5, 0x66,
-	0x98, 0x2a, 0x5e, 0xb5, 0xc9, 0x1a, 0x1e, 0x65, 0x6b, 0x37, 0xc1, 0x91, 0x8f, 0x21, 0x21, 0x8a,
-	0x77, 0x56, 0x91, 0x8a, 0x64, 0x18, 0xff, 0xa2, 0xc2, 0xe8, 0x0b, 0x78, 0xac, 0xc6, 0xdc, 0x2b,
-	0x81, 0xe4, 0x9b, 0xea, 0xb5, 0x38, 0x2c, 0x90, 0x10, 0xc8, 0x58, 0x78, 0xd2, 0xcd, 0xbf, 0xa3,
-	0x4a, 0xe1, 0x1d, 0xd1, 0x4f, 0x50, 0x3e, 0x05, 0x09, 0xe4, 0x13, 0x95, 0x76, 0xd2, 0x3f, 0x73,
-	0x4f, 0xae, 0x51, 0xc8, 0x07, 0x06, 0xf3, 0x51, 0x3c, 0xc1, 0x41, 0xb0, 0x0e, 0x77, 0xe0, 0x25,
-	0x2f, 0x68, 0xc6, 0x87, 0x2e, 0x8c, 0xe5, 0xe7, 0xe4, 0x1c, 0x4c, 0x61, 0x8c, 0x70, 0x3c, 0x19,
-	0xa5, 0x21, 0x45, 0x47, 0x53, 0xdb, 0xf9, 0x61, 0x1b, 0xc9, 0xb6, 0xa2, 0x5e, 0x14, 0xb1, 0xf4,
-	0xdb, 0x21, 0xbc, 0x2f, 0x8b, 0x2d, 0xbc, 0xeb, 0x39, 0x40, 0x3e, 0x47, 0x78, 0x2a, 0x4a, 0x7e,
-	0x93, 0x2f, 0xe4, 0x58, 0xb6, 0x69, 0xdf, 0xc2, 0xc1, 0x18, 0xa2, 0x45, 0xe8, 0xec, 0xfb, 0xf7,
-	0xff, 0xf8, 0xb8, 0x42, 0xe9, 0x51, 0x5d, 0xc4, 0x74, 0x17, 0xad, 0xac, 0x10, 0xba, 0x9d, 0x6a,
-	0xfd, 0xce, 0x73, 0x68, 0x8e, 0x7c, 0x86, 0xf0, 0xf8, 0x2a, 0xc8, 0x14, 0xf3, 0x48, 0x2f, 0x66,
-	0x96, 0x9c, 0x0f, 0x95, 0xf1, 0xac, 0x66, 0x3c, 0x45, 0xfe, 0x3f, 0x90, 0x31, 0x6a, 0xdf, 0x51,
-	0x9c, 0x93, 0xea, 0x51, 0xa5, 0x4e, 0x8f, 0x1c, 0xed, 0x25, 0xcd, 0xe5, 0xe4, 0xc6, 0xf5, 0xe1,
-	0xa1, 0xaa, 0x6d, 0xe9, 0x49, 0x8d, 0x7b, 0x8c, 0x0c, 0x56, 0x29, 0x79, 0x17, 0x4f, 0x15, 0x9d,
-	0x73, 0xc1, 0xf0, 0xfd, 0xdc, 0xb6, 0xd1, 0x47, 0xe5, 0x99, 0xaf, 0xa2, 0x67, 0xb4, 0xdc, 0x93,
-	0xe4, 0xc4, 0x56, 0xb9, 0xf3, 0xa0, 0x7d, 0x59, 0x5e, 0xfa, 0x02, 0x22, 0x02, 0x8f, 0xe7, 0x1c,
-	0x5d, 0xc1, 0x9c, 0x3d, 0xfe, 0xcf, 0xf8, 0x5f, 0xbf, 0x00, 0x1c, 0x89, 0x3d, 0xad, 0xc5, 0x9e,
-	0x20, 0xc7, 0x13, 0xb1, 0x42, 0x72, 0xb0, 0xdb, 0x56, 0x5f, 0xa1, 0xef, 0x21, 0x3c, 0x15, 0x45,
-	0xa9, 0x41, 0xd7, 0xbd, 0x10, 0x83, 0x8d, 0x99, 0x87, 0x4f, 0x88, 0x03, 0x5d, 0x7c, 0x41, 0xe6,
-	0xca, 0x5d, 0x90, 0x6f, 0x11, 0x9e, 0xd4, 0xa9, 0x7f, 0x8a, 0x30, 0xdd, 0x2b, 0x21, 0x5f, 0x1b,
-	0x0c, 0xf5, 0x32, 0x3f, 0xad, 0x59, 0x2d, 0x63, 0xae, 0x0c, 0xab, 0xc5, 0x15, 0x86, 0x7a, 0x7d,
-	0x3f, 0x22, 0xbc, 0x3f, 0xa9, 0x9c, 0x52, 0xee, 0xe3, 0xfd, 0xb8, 0x0b, 0xd5, 0xd5, 0x50, 0xd1,
-	0xcf, 0x6b, 0xf4, 0x25, 0x63, 0xbe, 0x24, 0x7a, 0x44, 0xa2, 0xe8, 0xbf, 0x43, 0x78, 0x2a, 0xaa,
-	0x53, 0x06, 0x99, 0xbd, 0x50, 0xc9, 0x0c, 0x95, 0xfc, 0x19, 0x4d, 0xbe, 0x60, 0x9c, 0x29, 0x4d,
-	0xde, 0x06, 0xc5, 0xfd, 0x3d, 0xc2, 0xfb, 0xe2, 0x9c, 0x39, 0x05, 0xef, 0x73, 0x1d, 0x8b, 0x69,
-	0xf5, 0x50, 0xc9, 0x9f, 0xd5, 0xe4, 0x8b, 0xc6, 0xd9, 0x52, 0xe4, 0x22, 0x02, 0x51, 0xe8, 0x3f,
-	0x21, 0x7c, 0x20, 0xad, 0xd0, 0x52, 0x78, 0xda, 0x0b, 0xbf, 0xb5, 0x8c, 0x1b, 0x2a, 0xfe, 0x05,
-	0x8d, 0xbf, 0x6c, 0x98, 0xa5, 0xf0, 0x65, 0x82, 0xa2, 0x0e, 0xf0, 0x35, 0xc2, 0x13, 0xaa, 0x26,
-	0x4c, 0xd9, 0xfb, 0xb8, 0xf1, 0x5c, 0xcd, 0x38, 0x54, 0xec, 0x73, 0x1a, 0xdb, 0x34, 0x4e, 0x97,
-	0xd3, 0xba, 0x64, 0xa1, 0x22, 0xfe, 0x12, 0xe1, 0xf1, 0xc6, 0xe0, 0x08, 0xd9, 0x78, 0x3c, 0x11,
-	0x72, 0x59, 0xf3, 0xce, 0x1b, 0xb3, 0xe5, 0x78, 0x41, 0x3f, 0xca, 0x2f, 0x10, 0x9e, 0x50, 0x89,
-	0xe1, 0x20, 0x05, 0xe7, 0x12, 0xc7, 0xa1, 0x02, 0xcf, 0x6b, 0xe0, 0xa7, 0x28, 0x1d, 0x0c, 0xec,
-	0x7b, 0x81, 0x46, 0x7d, 0x07, 0xef, 0x89, 0xaa, 0x3d, 0xd1, 0x4f, 0xa9, 0x59, 0x21, 0x6a, 0x90,
-	0xec, 0x6b, 0x92, 0x3c, 0xd3, 0xe7, 0xb5, 0xac, 0x73, 0x64, 0xa9, 0x94, 0x72, 0x6e, 0xc7, 0xf9,
-	0xf3, 0x1d, 0xcb, 0x67, 0xee, 0x87, 0x15, 0xb4, 0x80, 0x88, 0xc4, 0x13, 0x39, 0x51, 0xdb, 0x41,
-	0x58, 0xd0, 0x08, 0x73, 0xa4, 0x9c, 0x7d, 0x7c, 0xe6, 0x2e, 0x20, 0xf2, 0x15, 0xc2, 0x53, 0x8d,
-	0xa2, 0xbf, 0x3f, 0xd6, 0xcf, 0xf5, 0x3c, 0x2e, 0x6f, 0x6f, 0x69, 0xe6, 0xd3, 0xf4, 0x11, 0x41,
-	0x35, 0x75, 0xf2, 0x97, 0x56, 0x7f, 0x7d, 0x30, 0x8d, 0xee, 0x3d, 0x98, 0x46, 0xbf, 0x3f, 0x98,
-	0x46, 0x6f, 0x5c, 0x28, 0xff, 0x53, 0xfb, 0x96, 0xbf, 0x04, 0xd6, 0x46, 0xf5, 0x2f, 0xe7, 0xcb,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x5f, 0x40, 0x23, 0x33, 0x18, 0x00, 0x00,
+	// 1519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0x4d, 0x8f, 0x14, 0xc5,
+	0x1b, 0xc0, 0x53, 0xb3, 0xb0, 0xbb, 0xd4, 0xbe, 0x00, 0xf5, 0x07, 0xfe, 0x63, 0x07, 0x96, 0xa5,
+	0x10, 0x5c, 0x16, 0xb6, 0x7b, 0x5f, 0x50, 0x81, 0x44, 0x13, 0x60, 0x61, 0x83, 0xae, 0x48, 0x66,
+	0x4c, 0x8c, 0x5e, 0x4c, 0x6f, 0xcf, 0x33, 0xbd, 0xcd, 0xf6, 0x74, 0xb5, 0x5d, 0x35, 0x43, 0x56,
+	0xc4, 0x44, 0x2f, 0x7a, 0x20, 0xf1, 0xe0, 0xd1, 0x9b, 0x89, 0xd1, 0x83, 0x51, 0x63, 0x62, 0x62,
+	0x34, 0x31, 0x1e, 0x3c, 0x78, 0x24, 0xf1, 0xea, 0xc1, 0x10, 0xbf, 0x80, 0x9f, 0x40, 0x53, 0xd5,
+	0x6f, 0xd5, 0x3b, 0xc3, 0xd0, 0xee, 0x0e, 0xca, 0xad, 0xaa, 0xba, 0xaa, 0x9e, 0xdf, 0xf3, 0x54,
+	0xd5, 0xf3, 0x32, 0x83, 0x4f, 0x84, 0x1b, 0xae, 0x65, 0x87, 0x9e, 0xe3, 0x7b, 0x10, 0x08, 0xeb,
+	0x16, 0x8b, 0x36, 0x9a, 0x3e, 0xbb, 0x95, 0x35, 0xcc, 0x30, 0x62, 0x82, 0x91, 0xd1, 0xb4, 0x6f,
+	0x1c, 0x76, 0x19, 0x73, 0x7d, 0x90, 0x6b, 0x2c, 0x3b, 0x08, 0x98, 0xb0, 0x85, 0xc7, 0x02, 0x1e,
+	0xcf, 0x33, 0xce, 0x6e, 0x9c, 0xe3, 0xa6, 0xc7, 0xe4, 0xd7, 0x96, 0xed, 0xac, 0x7b, 0x01, 0x44,
+	0x9b, 0x56, 0x22, 0x82, 0x5b, 0x2d, 0x10, 0xb6, 0xd5, 0x59, 0xb0, 0x5c, 0x08, 0x20, 0xb2, 0x05,
+	0x34, 0x92, 0x55, 0x2f, 0xb9, 0x9e, 0x58, 0x6f, 0xaf, 0x99, 0x0e, 0x6b, 0x59, 0x76, 0xe4, 0xb2,
+	0x30, 0x62, 0x37, 0x55, 0x63, 0x2e, 0x15, 0xcb, 0xf3, 0x4d, 0x32, 0xc4, 0xce, 0x82, 0xed, 0x87,
+	0xeb, 0x76, 0xf7, 0x76, 0x34, 0x87, 0xb0, 0x1c, 0x16, 0x41, 0x0f, 0x91, 0xf4, 0xa7, 0x0a, 0x3e,
+	0xf8, 0x6a, 0xb2, 0xd3, 0xe5, 0x08, 0x6c, 0x01, 0x35, 0x78, 0xb3, 0x0d, 0x5c, 0x90, 0xc3, 0x78,
+	0x4f, 0x60, 0xb7, 0x80, 0x87, 0xb6, 0x03, 0x55, 0x34, 0x8d, 0x66, 0xf6, 0xd4, 0xf2, 0x01, 0xd2,
+	0xc4, 0x99, 0x29, 0xaa, 0x95, 0x69, 0x34, 0x33, 0xb6, 0xf8, 0x82, 0x99, 0xd3, 0x9b, 0x29, 0xbd,
+	0x6a, 0xbc, 0x91, 0xd1, 0x9b, 0x9d, 0x25, 0x33, 0xdc, 0x70, 0x4d, 0xa9, 0x80, 0x99, 0x99, 0x36,
+	0x55, 0xc0, 0x4c, 0x41, 0x6a, 0xd9, 0xde, 0x84, 0x62, 0xec, 0x05, 0x5c, 0xd8, 0x81, 0x03, 0xd7,
+	0x96, 0xab, 0x43, 0x12, 0xe3, 0x52, 0xa5, 0x8a, 0x6a, 0xda, 0x28, 0xa1, 0x78, 0x9c, 0x43, 0xd4,
+	0x81, 0x68, 0x39, 0xda, 0xac, 0xb5, 0x83, 0xea, 0xae, 0x69, 0x34, 0x33, 0x5a, 0x2b, 0x8c, 0x91,
+	0xd7, 0xf0, 0x84, 0xa3, 0xd4, 0x7b, 0x39, 0x54, 0xe7, 0x54, 0xdd, 0xad, 0xa0, 0x97, 0xcc, 0xd8,
+	0x46, 0xa6, 0x7e, 0x50, 0x39, 0xa2, 0x3c, 0x28, 0xb3, 0xb3, 0x60, 0x5e, 0xd6, 0x97, 0xd6, 0x8a,
+	0x3b, 0xd1, 0xaf, 0x11, 0x26, 0x29, 0xf9, 0x0a, 0x88, 0xd4, 0x7e, 0x04, 0xef, 0x92, 0xe6, 0x4a,
+	0x4c, 0xa7, 0xda, 0x45, 0x9b, 0x56, 0xb6, 0xda, 0xf4, 0x06, 0xc6, 0x2e, 0x88, 0x14, 0x70, 0x48,
+	0x01, 0xce, 0x97, 0x03, 0x5c, 0xc9, 0xd6, 0xd5, 0xb4, 0x3d, 0xc8, 0x21, 0x3c, 0xdc, 0xf4, 0xc0,
+	0x6f, 0x70, 0x65, 0x93, 0x3d, 0xb5, 0xa4, 0x47, 0xef, 0x56, 0xf0, 0xff, 0x52, 0xe4, 0x55, 0x8f,
+	0x8b, 0x72, 0x67, 0x5e, 0xc7, 0x63, 0xbe, 0xc7, 0x33, 0xc0, 0xf8, 0xd8, 0x17, 0xca, 0x01, 0xae,
+	0xe6, 0x0b, 0x6b, 0xfa, 0x2e, 0x1a, 0xe2, 0x90, 0x8e, 0x48, 0xa6, 0x30, 0x96, 0x92, 0xaf, 0x7a,
+	0xbe, 0x80, 0x28, 0xc1, 0xd7, 0x46, 0xe4, 0xa1, 0xc7, 0xc7, 0xd0, 0xb8, 0xd8, 0x94, 0x33, 0x76,
+	0xab, 0x19, 0x85, 0x31, 0x72, 0x12, 0x4f, 0x36, 0xbd, 0xc0, 0xe3, 0xeb, 0xd0, 0xb8, 0x04, 0x4d,
+	0x16, 0x41, 0x75, 0x58, 0xcd, 0xda, 0x32, 0x4a, 0xdf, 0x47, 0xf8, 0xff, 0xd9, 0xdd, 0x03, 0xde,
+	0x5e, 0x6b, 0x79, 0x3b, 0x38, 0x46, 0x03, 0x8f, 0xb6, 0xa0, 0xc5, 0xbc, 0xb7, 0xa0, 0xa1, 0x74,
+	0x1a, 0xad, 0x65, 0x7d, 0xa9, 0x55, 0x68, 0x47, 0x76, 0x0b, 0x04, 0x44, 0xf2, 0x0e, 0x0e, 0x49,
+	0xad, 0xf2, 0x11, 0xfa, 0x33, 0xc2, 0x07, 0x72, 0x12, 0x11, 0x6d, 0x6e, 0x1f, 0xe3, 0x0c, 0xde,
+	0x1f, 0x01, 0x17, 0x76, 0x24, 0xea, 0x6d, 0xc7, 0x01, 0xce, 0x9b, 0x6d, 0x3f, 0xe1, 0xe9, 0xfe,
+	0x20, 0x67, 0x07, 0xac, 0x01, 0x57, 0xa5, 0xf1, 0xeb, 0xe0, 0x83, 0x23, 0x58, 0x6a, 0xf5, 0xee,
+	0x0f, 0x0f, 0x55, 0xe3, 0x43, 0x94, 0x7b, 0x15, 0x69, 0xd0, 0x16, 0xec, 0x48, 0x8f, 0x6e, 0xb2,
+	0xa1, 0x07, 0x91, 0x1d, 0xc2, 0xc3, 0x72, 0xf0, 0x5a, 0x23, 0xbd, 0xf1, 0x71, 0x8f, 0xae, 0xe2,
+	0x6a, 0x0a, 0xf4, 0x0a, 0x44, 0x2d, 0x2f, 0xd0, 0x3c, 0xdd, 0x3f, 0x66, 0x92, 0xfa, 0x65, 0xef,
+	0xa7, 0x2e, 0x58, 0xf8, 0x6f, 0x69, 0x57, 0xc5, 0x23, 0x2d, 0xe0, 0xdc, 0x76, 0x21, 0x51, 0x2f,
+	0xed, 0xd2, 0x7b, 0x9a, 0x13, 0xaa, 0xef, 0xc4, 0x09, 0x0d, 0x08, 0x88, 0x1c, 0xc0, 0xbb, 0xc3,
+	0x75, 0x9b, 0x43, 0xf2, 0x30, 0xe3, 0x0e, 0x99, 0xc5, 0xfb, 0x58, 0x5b, 0x84, 0x6d, 0x71, 0x23,
+	0xbf, 0x3e, 0xf1, 0x9b, 0xec, 0x1a, 0xa7, 0x6b, 0xf8, 0x50, 0xa6, 0x51, 0x9b, 0x87, 0x10, 0x34,
+	0xb6, 0xaf, 0x55, 0x7e, 0x2d, 0x86, 0x0a, 0xd7, 0xe2, 0x2f, 0xcd, 0x6c, 0xab, 0xcc, 0xdd, 0xbe,
+	0x80, 0x2a, 0x1e, 0x09, 0x59, 0xe3, 0xba, 0x5c, 0x14, 0x4b, 0x48, 0xbb, 0xe4, 0x22, 0xc6, 0x3e,
+	0x73, 0x53, 0xa7, 0xb9, 0x4b, 0x39, 0xcd, 0x63, 0x9a, 0xd3, 0x34, 0x65, 0x68, 0x96, 0x2e, 0xf2,
+	0x06, 0x6b, 0xac, 0x66, 0x13, 0x6b, 0xda, 0x22, 0x89, 0xe3, 0x46, 0x10, 0x26, 0xa6, 0x54, 0x6d,
+	0xe9, 0x65, 0x78, 0x7a, 0x3c, 0xb1, 0x05, 0xb3, 0xbe, 0xb4, 0xb2, 0xd4, 0xaf, 0x2e, 0x6c, 0xd1,
+	0xe6, 0x89, 0x07, 0x1d, 0x51, 0x8f, 0xb4, 0x6b, 0x9c, 0x7e, 0xaf, 0x3d, 0xd5, 0x65, 0xf0, 0x61,
+	0x07, 0xcf, 0x42, 0x06, 0xd9, 0x86, 0xda, 0xa2, 0x18, 0xc3, 0x4a, 0x06, 0xd9, 0x65, 0x7d, 0x69,
+	0xad, 0xb8, 0x93, 0xbc, 0x4e, 0x4d, 0x16, 0x39, 0x90, 0x04, 0xf7, 0xb8, 0x43, 0xab, 0xf9, 0x15,
+	0x49, 0xd9, 0x79, 0xc8, 0x02, 0x0e, 0xf4, 0x13, 0xa9, 0x96, 0x2d, 0x9c, 0xf5, 0xf4, 0x3b, 0x7f,
+	0xfc, 0x62, 0x1c, 0xbd, 0xab, 0xdd, 0x3e, 0x05, 0x7b, 0xa5, 0x03, 0x81, 0x32, 0xbc, 0xd8, 0x0c,
+	0x33, 0xc3, 0xcb, 0x36, 0x59, 0xc3, 0xc3, 0x6c, 0xed, 0x26, 0x38, 0xe2, 0x11, 0x64, 0x5b, 0xc9,
+	0xce, 0x32, 0x0c, 0x92, 0x1c, 0xe3, 0x3f, 0x34, 0x18, 0x7d, 0x1e, 0x8f, 0xae, 0x32, 0xf7, 0x4a,
+	0x20, 0xa2, 0x4d, 0xf9, 0xb2, 0x1c, 0x16, 0x08, 0x08, 0x44, 0x22, 0x3c, 0xed, 0xea, 0x6f, 0xae,
+	0x52, 0x78, 0x73, 0xf4, 0x63, 0xa4, 0xe7, 0x37, 0x81, 0x78, 0xac, 0x72, 0x5a, 0xfa, 0xa7, 0xf6,
+	0xe4, 0xea, 0x85, 0x64, 0xa3, 0x3f, 0x1f, 0xc5, 0xe3, 0x11, 0x70, 0xd6, 0x8e, 0x1c, 0x78, 0xd1,
+	0x0b, 0x1a, 0x89, 0xd2, 0x85, 0x31, 0x7d, 0x8e, 0xe6, 0x8c, 0x0a, 0x63, 0x24, 0xc2, 0x13, 0x71,
+	0x8e, 0x53, 0x74, 0x4a, 0xab, 0x3b, 0x57, 0xb6, 0x9e, 0x6e, 0xcb, 0x6b, 0x45, 0x11, 0x8b, 0xbf,
+	0x1d, 0xc4, 0x7b, 0xf3, 0xf8, 0x14, 0x75, 0x3c, 0x07, 0xc8, 0x67, 0x08, 0x4f, 0xc6, 0x99, 0x75,
+	0xfa, 0x85, 0x1c, 0xcd, 0x37, 0xed, 0x59, 0x95, 0x18, 0x03, 0x3c, 0x11, 0x3a, 0xf3, 0xde, 0xaf,
+	0x7f, 0x7c, 0x54, 0xa1, 0xf4, 0x88, 0xaa, 0x90, 0x3a, 0x0b, 0x56, 0x5e, 0x65, 0xdd, 0xce, 0xac,
+	0x7e, 0xe7, 0x02, 0x9a, 0x25, 0x9f, 0x22, 0x3c, 0xb6, 0x02, 0x22, 0xc3, 0x3c, 0xdc, 0x8d, 0x99,
+	0x67, 0xfe, 0x03, 0x65, 0x3c, 0xa3, 0x18, 0x4f, 0x92, 0x27, 0xfb, 0x32, 0xc6, 0xed, 0x3b, 0x92,
+	0x73, 0x42, 0x3e, 0xaa, 0xcc, 0xe9, 0x91, 0x23, 0xdd, 0xa4, 0x5a, 0xc2, 0x6f, 0x5c, 0x1f, 0x1c,
+	0xaa, 0xdc, 0x96, 0x9e, 0x50, 0xb8, 0x47, 0x49, 0x7f, 0x93, 0x92, 0x77, 0xf0, 0x64, 0xd1, 0x39,
+	0x17, 0x0e, 0xbe, 0x97, 0xdb, 0x36, 0x7a, 0x98, 0x3c, 0xf7, 0x55, 0xf4, 0xb4, 0x92, 0x7b, 0x82,
+	0x1c, 0xdf, 0x2a, 0x77, 0x0e, 0x94, 0x2f, 0xd3, 0xa5, 0xcf, 0x23, 0xc2, 0xf1, 0x98, 0xe6, 0xe8,
+	0x0a, 0xc7, 0xd9, 0xe5, 0xff, 0x8c, 0x27, 0x7a, 0x05, 0xeb, 0x58, 0xec, 0x29, 0x25, 0xf6, 0x38,
+	0x39, 0x96, 0x8a, 0xe5, 0x22, 0x02, 0xbb, 0x65, 0xf5, 0x14, 0xfa, 0x2e, 0xc2, 0x93, 0x71, 0x94,
+	0xea, 0x77, 0xdd, 0x0b, 0x31, 0xd8, 0x98, 0x7e, 0xf0, 0x84, 0x24, 0xd0, 0x25, 0x17, 0x64, 0xb6,
+	0xdc, 0x05, 0xf9, 0x06, 0xe1, 0x09, 0x55, 0x57, 0x64, 0x08, 0x53, 0xdd, 0x12, 0xf4, 0xc2, 0x63,
+	0xa0, 0x97, 0xf9, 0x69, 0xc5, 0x6a, 0x19, 0xb3, 0x65, 0x58, 0xad, 0x48, 0x62, 0xc8, 0xd7, 0xf7,
+	0x03, 0xc2, 0xfb, 0xd2, 0xb2, 0x2c, 0xe3, 0x3e, 0xd6, 0x8b, 0xbb, 0x50, 0xba, 0x0d, 0x14, 0xfd,
+	0x9c, 0x42, 0x5f, 0x34, 0xe6, 0x4a, 0xa2, 0xc7, 0x24, 0x92, 0xfe, 0x5b, 0x84, 0x27, 0xe3, 0x1a,
+	0xa8, 0xdf, 0xb1, 0x17, 0xaa, 0xa4, 0x81, 0x92, 0x3f, 0xa3, 0xc8, 0xe7, 0x8d, 0xd3, 0xa5, 0xc9,
+	0x5b, 0x20, 0xb9, 0xbf, 0x43, 0x78, 0x6f, 0x92, 0x77, 0x67, 0xe0, 0x3d, 0xae, 0x63, 0x31, 0x35,
+	0x1f, 0x28, 0xf9, 0xb3, 0x8a, 0x7c, 0xc1, 0x38, 0x53, 0x8a, 0x9c, 0xc7, 0x20, 0x12, 0xfd, 0x47,
+	0x84, 0xf7, 0x67, 0x55, 0x5e, 0x06, 0x4f, 0xbb, 0xe1, 0xb7, 0x96, 0x82, 0x03, 0xc5, 0x3f, 0xaf,
+	0xf0, 0x97, 0x2e, 0xa0, 0x59, 0xc3, 0x2c, 0xa5, 0x81, 0x48, 0x69, 0xc8, 0x57, 0x08, 0x8f, 0xcb,
+	0xba, 0x32, 0x63, 0xef, 0xe1, 0xc6, 0xb5, 0xba, 0x73, 0xa0, 0xd8, 0x67, 0x15, 0xb6, 0x69, 0x9c,
+	0x2a, 0x67, 0x75, 0xc1, 0x42, 0x69, 0xf2, 0x2f, 0x10, 0x1e, 0xab, 0xf7, 0x8f, 0x90, 0xf5, 0x47,
+	0x13, 0x21, 0x97, 0x14, 0xef, 0x9c, 0x31, 0x53, 0x8e, 0x17, 0xd4, 0xa3, 0xfc, 0x1c, 0xe1, 0x71,
+	0x99, 0x18, 0xf6, 0x33, 0xb0, 0x96, 0x38, 0x0e, 0x14, 0x78, 0x4e, 0x01, 0x3f, 0x45, 0x69, 0x7f,
+	0x60, 0xdf, 0x0b, 0x14, 0xea, 0xdb, 0x78, 0x24, 0xae, 0x0c, 0x79, 0x2f, 0xa3, 0xe6, 0x45, 0xab,
+	0x41, 0xf2, 0xaf, 0x69, 0xf2, 0x4c, 0x9f, 0x53, 0xb2, 0xce, 0x92, 0xc5, 0x52, 0xc6, 0xb9, 0x9d,
+	0xe4, 0xcf, 0x77, 0x2c, 0x9f, 0xb9, 0x1f, 0x54, 0xd0, 0x3c, 0x22, 0x02, 0x8f, 0x6b, 0xa2, 0xb6,
+	0x83, 0x30, 0xaf, 0x10, 0x66, 0x49, 0xb9, 0xf3, 0xf1, 0x99, 0x3b, 0x8f, 0xc8, 0x97, 0x08, 0x4f,
+	0xd6, 0x8b, 0xfe, 0xfe, 0x68, 0x2f, 0xd7, 0xf3, 0xa8, 0xbc, 0xbd, 0xa5, 0x98, 0x4f, 0xd1, 0x87,
+	0x04, 0xd5, 0xcc, 0xc9, 0x5f, 0x5a, 0xf9, 0xe5, 0xfe, 0x14, 0xba, 0x77, 0x7f, 0x0a, 0xfd, 0x7e,
+	0x7f, 0x0a, 0xbd, 0x7e, 0xbe, 0xfc, 0xef, 0xf8, 0x5b, 0xfe, 0x6f, 0x58, 0x1b, 0x56, 0x3f, 0xcb,
+	0x2f, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x56, 0x4f, 0xe9, 0x46, 0x90, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2561,6 +2588,13 @@ func (m *WorkflowResumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.NodeFieldSelector) > 0 {
 		i -= len(m.NodeFieldSelector)
 		copy(dAtA[i:], m.NodeFieldSelector)
@@ -2774,6 +2808,13 @@ func (m *WorkflowSuspendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Namespace) > 0 {
 		i -= len(m.Namespace)
 		copy(dAtA[i:], m.Namespace)
@@ -2815,6 +2856,15 @@ func (m *WorkflowLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NodeStatusFilter) > 0 {
+		for iNdEx := len(m.NodeStatusFilter) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NodeStatusFilter[iNdEx])
+			copy(dAtA[i:], m.NodeStatusFilter[iNdEx])
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeStatusFilter[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Selector) > 0 {
 		i -= len(m.Selector)
 		copy(dAtA[i:], m.Selector)
@@ -3433,6 +3483,10 @@ func (m *WorkflowResumeRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3537,6 +3591,10 @@ func (m *WorkflowSuspendRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3573,6 +3631,12 @@ func (m *WorkflowLogRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
+	if len(m.NodeStatusFilter) > 0 {
+		for _, s := range m.NodeStatusFilter {
+			l = len(s)
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4884,6 +4948,38 @@ func (m *WorkflowResumeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -5536,6 +5632,38 @@ func (m *WorkflowSuspendRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -5783,6 +5911,38 @@ func (m *WorkflowLogRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Selector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeStatusFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeStatusFilter = append(m.NodeStatusFilter, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
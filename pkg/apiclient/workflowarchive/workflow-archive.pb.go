@@ -528,6 +528,61 @@ func (m *ResubmitArchivedWorkflowRequest) GetParameters() []string {
 	return nil
 }
 
+type CreateArchivedWorkflowRequest struct {
+	Workflow             *v1alpha1.Workflow `protobuf:"bytes,1,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	Namespace            string             `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CreateArchivedWorkflowRequest) Reset()         { *m = CreateArchivedWorkflowRequest{} }
+func (m *CreateArchivedWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateArchivedWorkflowRequest) ProtoMessage()    {}
+func (*CreateArchivedWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{8}
+}
+func (m *CreateArchivedWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateArchivedWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateArchivedWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateArchivedWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateArchivedWorkflowRequest.Merge(m, src)
+}
+func (m *CreateArchivedWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateArchivedWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateArchivedWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateArchivedWorkflowRequest proto.InternalMessageInfo
+
+func (m *CreateArchivedWorkflowRequest) GetWorkflow() *v1alpha1.Workflow {
+	if m != nil {
+		return m.Workflow
+	}
+	return nil
+}
+
+func (m *CreateArchivedWorkflowRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ListArchivedWorkflowsRequest)(nil), "workflowarchive.ListArchivedWorkflowsRequest")
 	proto.RegisterType((*GetArchivedWorkflowRequest)(nil), "workflowarchive.GetArchivedWorkflowRequest")
@@ -537,6 +592,7 @@ func init() {
 	proto.RegisterType((*ListArchivedWorkflowLabelValuesRequest)(nil), "workflowarchive.ListArchivedWorkflowLabelValuesRequest")
 	proto.RegisterType((*RetryArchivedWorkflowRequest)(nil), "workflowarchive.RetryArchivedWorkflowRequest")
 	proto.RegisterType((*ResubmitArchivedWorkflowRequest)(nil), "workflowarchive.ResubmitArchivedWorkflowRequest")
+	proto.RegisterType((*CreateArchivedWorkflowRequest)(nil), "workflowarchive.CreateArchivedWorkflowRequest")
 }
 
 func init() {
@@ -544,58 +600,61 @@ func init() {
 }
 
 var fileDescriptor_95ca9a2d33e8bb19 = []byte{
-	// 802 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xc7, 0x35, 0xe9, 0xee, 0x6a, 0x3b, 0x3d, 0x00, 0x83, 0x16, 0x22, 0x2b, 0x9b, 0x06, 0x0b,
-	0x76, 0xfb, 0x42, 0xc6, 0x4d, 0x5b, 0x04, 0xea, 0x09, 0x50, 0x55, 0x24, 0xfa, 0x86, 0x1c, 0x09,
-	0x24, 0x2e, 0x30, 0xb1, 0x9f, 0x26, 0x43, 0x6c, 0x8f, 0x99, 0x19, 0xbb, 0x14, 0xc4, 0x05, 0x3e,
-	0x02, 0x47, 0x4e, 0x48, 0x7c, 0x08, 0xc4, 0x1d, 0x89, 0x13, 0xe2, 0xe5, 0xc6, 0x01, 0xa1, 0x8a,
-	0x0f, 0x82, 0x6c, 0xc7, 0x71, 0xeb, 0x38, 0x2f, 0xd2, 0xa6, 0xb7, 0x99, 0x67, 0x66, 0x9e, 0xe7,
-	0xff, 0xf7, 0x3c, 0xfe, 0x69, 0xf0, 0x7e, 0x38, 0xec, 0x5b, 0x2c, 0xe4, 0x8e, 0xc7, 0x21, 0xd0,
-	0xd6, 0xa5, 0x90, 0xc3, 0x0b, 0x4f, 0x5c, 0x32, 0xe9, 0x0c, 0x78, 0x0c, 0xe3, 0x79, 0x7b, 0x14,
-	0xa0, 0xa1, 0x14, 0x5a, 0x90, 0xe7, 0x4a, 0xfb, 0x8c, 0x46, 0x5f, 0x88, 0xbe, 0x07, 0x49, 0x26,
-	0x8b, 0x05, 0x81, 0xd0, 0x4c, 0x73, 0x11, 0xa8, 0x6c, 0xbb, 0xb1, 0x3f, 0x7c, 0x4b, 0x51, 0x2e,
-	0x92, 0x55, 0x9f, 0x39, 0x03, 0x1e, 0x80, 0xbc, 0xb2, 0x46, 0x85, 0x95, 0xe5, 0x83, 0x66, 0x56,
-	0xdc, 0xb1, 0xfa, 0x10, 0x80, 0x64, 0x1a, 0xdc, 0xd1, 0xa9, 0xd3, 0x3e, 0xd7, 0x83, 0xa8, 0x47,
-	0x1d, 0xe1, 0x5b, 0x4c, 0xf6, 0x45, 0x28, 0xc5, 0x67, 0xe9, 0xa0, 0x9d, 0x57, 0x57, 0x45, 0x92,
-	0x3c, 0x64, 0xc5, 0x1d, 0xe6, 0x85, 0x03, 0x36, 0x91, 0xce, 0xfc, 0x03, 0xe1, 0xc6, 0x09, 0x57,
-	0xfa, 0x9d, 0x4c, 0xb2, 0xfb, 0x51, 0x9e, 0xc4, 0x86, 0xcf, 0x23, 0x50, 0x9a, 0x74, 0xf1, 0x9a,
-	0xc7, 0x95, 0x3e, 0x0f, 0x53, 0xe9, 0x75, 0xd4, 0x42, 0x1b, 0x6b, 0xbb, 0x1d, 0x9a, 0x69, 0xa7,
-	0x37, 0xb5, 0xd3, 0x70, 0xd8, 0x4f, 0x02, 0x8a, 0x26, 0xda, 0x69, 0xdc, 0xa1, 0x27, 0xc5, 0x41,
-	0xfb, 0x66, 0x16, 0xd2, 0xc4, 0x38, 0x60, 0x3e, 0x7c, 0x20, 0xe1, 0x82, 0x7f, 0x51, 0xaf, 0xb5,
-	0xd0, 0xc6, 0xaa, 0x7d, 0x23, 0x42, 0x1a, 0x78, 0x35, 0x99, 0xa9, 0x90, 0x39, 0x50, 0x5f, 0x49,
-	0x97, 0x8b, 0x40, 0x7e, 0xfa, 0x88, 0x7b, 0x1a, 0x64, 0xfd, 0x5e, 0x71, 0x3a, 0x8b, 0x98, 0x9f,
-	0x62, 0xe3, 0x3d, 0x98, 0x70, 0x94, 0x1b, 0x7a, 0x1e, 0xaf, 0x44, 0xdc, 0x4d, 0x8d, 0xac, 0xda,
-	0xc9, 0xf0, 0x76, 0xb5, 0x5a, 0xb9, 0x1a, 0xc1, 0xf7, 0x92, 0xc9, 0x48, 0x46, 0x3a, 0x36, 0xcf,
-	0xf1, 0xe3, 0x43, 0xf0, 0x40, 0xc3, 0x92, 0x8a, 0x98, 0xaf, 0xe0, 0xf5, 0x72, 0xaa, 0xac, 0x80,
-	0x6b, 0x83, 0x0a, 0x45, 0xa0, 0xc0, 0x3c, 0xc4, 0xaf, 0x56, 0x5d, 0xd4, 0x09, 0xeb, 0x81, 0x77,
-	0x0c, 0x57, 0xe3, 0x0b, 0xbb, 0x55, 0x08, 0x95, 0x0b, 0x7d, 0x8f, 0xf0, 0x93, 0xa9, 0x69, 0x3e,
-	0x64, 0x5e, 0x04, 0x77, 0x7b, 0xf3, 0xb3, 0x3f, 0xc3, 0x3f, 0x08, 0x37, 0x6c, 0xd0, 0xf2, 0x6a,
-	0xf1, 0xef, 0x9a, 0x5f, 0x4f, 0xad, 0xb8, 0x9e, 0x39, 0xed, 0xf3, 0x3a, 0x7e, 0x41, 0x82, 0xd2,
-	0x4c, 0xea, 0x6e, 0xe4, 0x38, 0xa0, 0xd4, 0x45, 0xe4, 0xa5, 0x5d, 0xf4, 0xd0, 0x9e, 0x5c, 0x48,
-	0x76, 0x07, 0xc2, 0x85, 0x23, 0x0e, 0x9e, 0xdb, 0x05, 0x0f, 0x1c, 0x2d, 0x64, 0xfd, 0x7e, 0x9a,
-	0x73, 0x72, 0x21, 0x69, 0xcd, 0x90, 0x49, 0xe6, 0x83, 0x06, 0xa9, 0xea, 0x0f, 0x5a, 0x2b, 0x49,
-	0x6b, 0x16, 0x11, 0xf3, 0x07, 0x84, 0xd7, 0x6d, 0x50, 0x51, 0xcf, 0xe7, 0xfa, 0x2e, 0x3d, 0x1a,
-	0xf8, 0xa1, 0x0f, 0xbe, 0xe0, 0x5f, 0x82, 0x3b, 0xb2, 0x36, 0x9e, 0x97, 0x34, 0xde, 0x2f, 0x6b,
-	0xdc, 0xfd, 0x76, 0x0d, 0xbf, 0x5c, 0xd6, 0xd6, 0x05, 0x19, 0x73, 0x07, 0xc8, 0xcf, 0x08, 0x3f,
-	0xaa, 0xc4, 0x05, 0x69, 0xd3, 0x12, 0xfd, 0xe8, 0x2c, 0xac, 0x18, 0x67, 0xb4, 0xe0, 0x18, 0xcd,
-	0x39, 0x96, 0x0e, 0x3e, 0x19, 0x73, 0x8c, 0xc6, 0x7b, 0x45, 0x67, 0xe5, 0x51, 0x9a, 0xa3, 0x8c,
-	0x8e, 0x5b, 0x97, 0x2b, 0x6d, 0x9a, 0xdf, 0xfc, 0xf5, 0xdf, 0x77, 0xb5, 0x06, 0x31, 0x52, 0xd8,
-	0xc6, 0x1d, 0x6b, 0xa4, 0xc2, 0x2d, 0xb0, 0x48, 0x7e, 0x42, 0xf8, 0xc5, 0x0a, 0x30, 0x90, 0xed,
-	0x09, 0xe9, 0xd3, 0xf1, 0x61, 0xbc, 0xbf, 0x3c, 0xe1, 0xe6, 0x46, 0x2a, 0xda, 0x24, 0xad, 0xe9,
-	0xa2, 0xad, 0xaf, 0x22, 0xee, 0x7e, 0x4d, 0x7e, 0x44, 0xf8, 0xa5, 0x6a, 0xe2, 0x10, 0x3a, 0xa1,
-	0x7e, 0x26, 0x9a, 0x8c, 0x9d, 0x89, 0xfd, 0xf3, 0xc8, 0x33, 0x92, 0xb9, 0x35, 0x5f, 0xe6, 0x9f,
-	0x08, 0x3f, 0x9e, 0x09, 0x29, 0xf2, 0xc6, 0x42, 0x6d, 0x52, 0x86, 0x9a, 0x71, 0xfc, 0xec, 0x5f,
-	0x7d, 0x9c, 0xd3, 0x6c, 0xa7, 0x7e, 0x9e, 0x92, 0xd7, 0xa6, 0xfb, 0x69, 0x7b, 0xc9, 0xee, 0xf6,
-	0x30, 0x91, 0xfc, 0x37, 0xc2, 0xeb, 0x73, 0x90, 0x49, 0xde, 0x5c, 0xdc, 0xd6, 0x2d, 0xc8, 0x1a,
-	0xa7, 0x4b, 0x32, 0x96, 0x65, 0x35, 0xad, 0xd4, 0xda, 0x26, 0x79, 0x3a, 0xd7, 0x5a, 0x9c, 0x09,
-	0xff, 0x05, 0xe1, 0x47, 0x95, 0xc4, 0xad, 0xf8, 0xa1, 0x67, 0x91, 0x79, 0xa9, 0xff, 0x45, 0x27,
-	0x75, 0xb1, 0x6d, 0x3c, 0x99, 0xd7, 0x70, 0x96, 0x4c, 0x24, 0x1d, 0xa0, 0x2d, 0xf2, 0x1b, 0xc2,
-	0xf5, 0x69, 0x60, 0x25, 0x3b, 0x15, 0x56, 0x66, 0x32, 0x78, 0xa9, 0x6e, 0xf6, 0x53, 0x37, 0xd4,
-	0xd8, 0x5c, 0xc0, 0x4d, 0xa6, 0xea, 0x00, 0x6d, 0xbd, 0x7b, 0xf6, 0xeb, 0x75, 0x13, 0xfd, 0x7e,
-	0xdd, 0x44, 0xff, 0x5e, 0x37, 0xd1, 0xc7, 0x6f, 0x2f, 0xfe, 0xea, 0xab, 0x7e, 0xb3, 0xf6, 0x1e,
-	0xa4, 0xef, 0xbd, 0xbd, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x00, 0xca, 0x0c, 0xdb, 0x0a,
-	0x00, 0x00,
+	// 854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0xd7, 0x24, 0x6d, 0x95, 0x4c, 0x91, 0x80, 0x41, 0x2d, 0x2b, 0x6b, 0xf3, 0x07, 0x8b, 0xb6,
+	0x69, 0xca, 0x8e, 0xbb, 0x6d, 0x10, 0x28, 0x27, 0xfe, 0x54, 0x45, 0xa2, 0x69, 0x8b, 0x1c, 0x09,
+	0x24, 0x2e, 0x30, 0xb1, 0x5f, 0x36, 0xc3, 0xda, 0x1e, 0x33, 0x33, 0x76, 0x09, 0x88, 0x0b, 0x5f,
+	0x81, 0x23, 0x27, 0x24, 0xf8, 0x0e, 0x08, 0x4e, 0x1c, 0x90, 0x38, 0x21, 0xfe, 0xdc, 0x38, 0x20,
+	0x14, 0xf1, 0x41, 0x90, 0xc7, 0xf6, 0x3a, 0xb1, 0xbd, 0xde, 0x95, 0xba, 0xb9, 0xcd, 0xbc, 0x79,
+	0xf3, 0xde, 0xef, 0xe7, 0xf7, 0xe6, 0xf7, 0x76, 0xf1, 0x4e, 0x3c, 0x1e, 0x39, 0x2c, 0xe6, 0x5e,
+	0xc0, 0x21, 0xd2, 0xce, 0x13, 0x21, 0xc7, 0x87, 0x81, 0x78, 0xc2, 0xa4, 0x77, 0xc4, 0x53, 0x98,
+	0xec, 0x07, 0x85, 0x81, 0xc6, 0x52, 0x68, 0x41, 0x9e, 0xad, 0xf9, 0x59, 0xfd, 0x91, 0x10, 0xa3,
+	0x00, 0xb2, 0x48, 0x0e, 0x8b, 0x22, 0xa1, 0x99, 0xe6, 0x22, 0x52, 0xb9, 0xbb, 0xb5, 0x33, 0x7e,
+	0x5d, 0x51, 0x2e, 0xb2, 0xd3, 0x90, 0x79, 0x47, 0x3c, 0x02, 0x79, 0xec, 0x14, 0x89, 0x95, 0x13,
+	0x82, 0x66, 0x4e, 0x3a, 0x74, 0x46, 0x10, 0x81, 0x64, 0x1a, 0xfc, 0xe2, 0xd6, 0xc3, 0x11, 0xd7,
+	0x47, 0xc9, 0x01, 0xf5, 0x44, 0xe8, 0x30, 0x39, 0x12, 0xb1, 0x14, 0x9f, 0x98, 0xc5, 0xa0, 0xcc,
+	0xae, 0xaa, 0x20, 0xa5, 0xc9, 0x49, 0x87, 0x2c, 0x88, 0x8f, 0x58, 0x23, 0x9c, 0xfd, 0x07, 0xc2,
+	0xfd, 0x3d, 0xae, 0xf4, 0x9b, 0x39, 0x64, 0xff, 0x83, 0x32, 0x88, 0x0b, 0x9f, 0x26, 0xa0, 0x34,
+	0xd9, 0xc7, 0x97, 0x03, 0xae, 0xf4, 0xe3, 0xd8, 0x40, 0xef, 0xa1, 0x4d, 0xb4, 0x75, 0xf9, 0xce,
+	0x90, 0xe6, 0xd8, 0xe9, 0x69, 0xec, 0x34, 0x1e, 0x8f, 0x32, 0x83, 0xa2, 0x19, 0x76, 0x9a, 0x0e,
+	0xe9, 0x5e, 0x75, 0xd1, 0x3d, 0x1d, 0x85, 0xac, 0x63, 0x1c, 0xb1, 0x10, 0xde, 0x93, 0x70, 0xc8,
+	0x3f, 0xeb, 0x2d, 0x6d, 0xa2, 0xad, 0x55, 0xf7, 0x94, 0x85, 0xf4, 0xf1, 0x6a, 0xb6, 0x53, 0x31,
+	0xf3, 0xa0, 0xb7, 0x6c, 0x8e, 0x2b, 0x43, 0x79, 0xfb, 0x3e, 0x0f, 0x34, 0xc8, 0xde, 0x85, 0xea,
+	0x76, 0x6e, 0xb1, 0x3f, 0xc6, 0xd6, 0x3b, 0xd0, 0x60, 0x54, 0x12, 0x7a, 0x0e, 0x2f, 0x27, 0xdc,
+	0x37, 0x44, 0x56, 0xdd, 0x6c, 0x79, 0x36, 0xdb, 0x52, 0x3d, 0x1b, 0xc1, 0x17, 0xb2, 0x4d, 0x01,
+	0xc3, 0xac, 0xed, 0xc7, 0x78, 0xed, 0x1e, 0x04, 0xa0, 0x61, 0x41, 0x49, 0xec, 0x97, 0xf0, 0x46,
+	0x3d, 0x54, 0x9e, 0xc0, 0x77, 0x41, 0xc5, 0x22, 0x52, 0x60, 0xdf, 0xc3, 0x2f, 0xb7, 0x15, 0x6a,
+	0x8f, 0x1d, 0x40, 0xf0, 0x00, 0x8e, 0x27, 0x05, 0x3b, 0x93, 0x08, 0xd5, 0x13, 0x7d, 0x83, 0xf0,
+	0xf5, 0xa9, 0x61, 0xde, 0x67, 0x41, 0x02, 0xe7, 0x5b, 0xf9, 0xee, 0xcf, 0xf0, 0x0f, 0xc2, 0x7d,
+	0x17, 0xb4, 0x3c, 0x9e, 0xff, 0xbb, 0x96, 0xe5, 0x59, 0xaa, 0xca, 0x33, 0xa3, 0x7d, 0x5e, 0xc1,
+	0xcf, 0x4b, 0x50, 0x9a, 0x49, 0xbd, 0x9f, 0x78, 0x1e, 0x28, 0x75, 0x98, 0x04, 0xa6, 0x8b, 0x56,
+	0xdc, 0xe6, 0x41, 0xe6, 0x1d, 0x09, 0x1f, 0xee, 0x73, 0x08, 0xfc, 0x7d, 0x08, 0xc0, 0xd3, 0x42,
+	0xf6, 0x2e, 0x9a, 0x98, 0xcd, 0x83, 0xac, 0x35, 0x63, 0x26, 0x59, 0x08, 0x1a, 0xa4, 0xea, 0x5d,
+	0xda, 0x5c, 0xce, 0x5a, 0xb3, 0xb2, 0xd8, 0xdf, 0x22, 0xbc, 0xe1, 0x82, 0x4a, 0x0e, 0x42, 0xae,
+	0xcf, 0x93, 0xa3, 0x85, 0x57, 0x42, 0x08, 0x05, 0xff, 0x1c, 0xfc, 0x82, 0xda, 0x64, 0x5f, 0xc3,
+	0x78, 0xb1, 0x81, 0xf1, 0x7b, 0x84, 0xd7, 0xde, 0x96, 0xc0, 0xa6, 0x77, 0xf7, 0x21, 0x5e, 0x29,
+	0x95, 0xa5, 0x68, 0x8b, 0x77, 0x69, 0x25, 0x4b, 0xb4, 0x94, 0x25, 0xb3, 0xf8, 0xa8, 0xf4, 0x54,
+	0x34, 0xbd, 0x5b, 0x35, 0x4a, 0x69, 0xa5, 0xa5, 0x32, 0xd1, 0x49, 0x92, 0x49, 0xec, 0xee, 0x66,
+	0xb9, 0xf3, 0xf3, 0x33, 0xf8, 0xc5, 0x3a, 0xc2, 0x7d, 0x90, 0x29, 0xf7, 0x80, 0xfc, 0x88, 0xf0,
+	0x95, 0x56, 0x59, 0x23, 0x03, 0x5a, 0x53, 0x69, 0xda, 0x25, 0x7f, 0xd6, 0xa3, 0xc5, 0x11, 0xcb,
+	0xf2, 0xd8, 0xf6, 0x57, 0x7f, 0xfd, 0xf7, 0xf5, 0x52, 0x9f, 0x58, 0x66, 0x28, 0xa4, 0x43, 0xa7,
+	0x40, 0xe1, 0x57, 0xf2, 0x4d, 0x7e, 0x40, 0xf8, 0x85, 0x16, 0x01, 0x23, 0xb7, 0x1a, 0xd0, 0xa7,
+	0xcb, 0x9c, 0xb5, 0xc0, 0x8a, 0xd8, 0x5b, 0x06, 0xb4, 0x4d, 0x36, 0xa7, 0x83, 0x76, 0xbe, 0x48,
+	0xb8, 0xff, 0x25, 0xf9, 0x0e, 0xe1, 0xab, 0xed, 0xca, 0x48, 0x68, 0x03, 0x7d, 0xa7, 0x84, 0x5a,
+	0xb7, 0x1b, 0xfe, 0xb3, 0x14, 0xb2, 0x80, 0xb9, 0x3d, 0x1b, 0xe6, 0x9f, 0x08, 0xaf, 0x75, 0x8a,
+	0x29, 0x79, 0x75, 0xae, 0x36, 0xa9, 0x8b, 0xaf, 0xf5, 0xe0, 0xe9, 0xbf, 0xfa, 0x24, 0xa6, 0x3d,
+	0x30, 0x7c, 0x6e, 0x90, 0x6b, 0xd3, 0xf9, 0x0c, 0x82, 0xcc, 0x7b, 0x30, 0xce, 0x20, 0xff, 0x8d,
+	0xf0, 0xc6, 0x0c, 0x69, 0x27, 0xaf, 0xcd, 0x4f, 0xeb, 0xcc, 0x30, 0xb0, 0x1e, 0x2e, 0x88, 0x58,
+	0x1e, 0xd5, 0x76, 0x0c, 0xb5, 0x9b, 0xe4, 0xc6, 0x4c, 0x6a, 0x69, 0x0e, 0xfc, 0x17, 0x84, 0xaf,
+	0xb4, 0x4e, 0x86, 0x96, 0x07, 0xdd, 0x35, 0x41, 0x16, 0xfa, 0x2e, 0x86, 0x86, 0xc5, 0xad, 0x5d,
+	0xb4, 0x6d, 0x5d, 0x9f, 0xd5, 0x73, 0x8e, 0xcc, 0x50, 0x91, 0x9f, 0x10, 0xbe, 0xda, 0x2e, 0xae,
+	0x2d, 0x0f, 0xa4, 0x53, 0x85, 0x17, 0xca, 0xe4, 0x9a, 0x61, 0xb2, 0x61, 0x77, 0xc8, 0xd2, 0x2e,
+	0xda, 0x26, 0xbf, 0x21, 0xdc, 0x9b, 0x36, 0xbe, 0xc8, 0xed, 0x96, 0x42, 0x74, 0x4e, 0xba, 0x85,
+	0x32, 0xd8, 0x31, 0x0c, 0xa8, 0x75, 0x73, 0x8e, 0x42, 0xe4, 0xa8, 0x76, 0xd1, 0xf6, 0x5b, 0x8f,
+	0x7e, 0x3d, 0x59, 0x47, 0xbf, 0x9f, 0xac, 0xa3, 0x7f, 0x4f, 0xd6, 0xd1, 0x87, 0x6f, 0xcc, 0xff,
+	0xdb, 0xba, 0xfd, 0x9f, 0xc1, 0xc1, 0x25, 0xf3, 0xab, 0xfa, 0xee, 0xff, 0x03, 0x00, 0xc1, 0xc4,
+	0x06, 0xe9, 0x41, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -616,6 +675,7 @@ type ArchivedWorkflowServiceClient interface {
 	ListArchivedWorkflowLabelKeys(ctx context.Context, in *ListArchivedWorkflowLabelKeysRequest, opts ...grpc.CallOption) (*v1alpha1.LabelKeys, error)
 	ListArchivedWorkflowLabelValues(ctx context.Context, in *ListArchivedWorkflowLabelValuesRequest, opts ...grpc.CallOption) (*v1alpha1.LabelValues, error)
 	RetryArchivedWorkflow(ctx context.Context, in *RetryArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	CreateArchivedWorkflow(ctx context.Context, in *CreateArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	ResubmitArchivedWorkflow(ctx context.Context, in *ResubmitArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 }
 
@@ -681,6 +741,15 @@ func (c *archivedWorkflowServiceClient) RetryArchivedWorkflow(ctx context.Contex
 	return out, nil
 }
 
+func (c *archivedWorkflowServiceClient) CreateArchivedWorkflow(ctx context.Context, in *CreateArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/CreateArchivedWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archivedWorkflowServiceClient) ResubmitArchivedWorkflow(ctx context.Context, in *ResubmitArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	out := new(v1alpha1.Workflow)
 	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ResubmitArchivedWorkflow", in, out, opts...)
@@ -698,6 +767,7 @@ type ArchivedWorkflowServiceServer interface {
 	ListArchivedWorkflowLabelKeys(context.Context, *ListArchivedWorkflowLabelKeysRequest) (*v1alpha1.LabelKeys, error)
 	ListArchivedWorkflowLabelValues(context.Context, *ListArchivedWorkflowLabelValuesRequest) (*v1alpha1.LabelValues, error)
 	RetryArchivedWorkflow(context.Context, *RetryArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
+	CreateArchivedWorkflow(context.Context, *CreateArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
 	ResubmitArchivedWorkflow(context.Context, *ResubmitArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
 }
 
@@ -723,6 +793,9 @@ func (*UnimplementedArchivedWorkflowServiceServer) ListArchivedWorkflowLabelValu
 func (*UnimplementedArchivedWorkflowServiceServer) RetryArchivedWorkflow(ctx context.Context, req *RetryArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetryArchivedWorkflow not implemented")
 }
+func (*UnimplementedArchivedWorkflowServiceServer) CreateArchivedWorkflow(ctx context.Context, req *CreateArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateArchivedWorkflow not implemented")
+}
 func (*UnimplementedArchivedWorkflowServiceServer) ResubmitArchivedWorkflow(ctx context.Context, req *ResubmitArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResubmitArchivedWorkflow not implemented")
 }
@@ -839,6 +912,24 @@ func _ArchivedWorkflowService_RetryArchivedWorkflow_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchivedWorkflowService_CreateArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateArchivedWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).CreateArchivedWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/CreateArchivedWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).CreateArchivedWorkflow(ctx, req.(*CreateArchivedWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArchivedWorkflowService_ResubmitArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResubmitArchivedWorkflowRequest)
 	if err := dec(in); err != nil {
@@ -885,6 +976,10 @@ var _ArchivedWorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RetryArchivedWorkflow",
 			Handler:    _ArchivedWorkflowService_RetryArchivedWorkflow_Handler,
 		},
+		{
+			MethodName: "CreateArchivedWorkflow",
+			Handler:    _ArchivedWorkflowService_CreateArchivedWorkflow_Handler,
+		},
 		{
 			MethodName: "ResubmitArchivedWorkflow",
 			Handler:    _ArchivedWorkflowService_ResubmitArchivedWorkflow_Handler,
@@ -1291,6 +1386,52 @@ func (m *ResubmitArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *CreateArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Workflow != nil {
+		{
+			size, err := m.Workflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintWorkflowArchive(dAtA []byte, offset int, v uint64) int {
 	offset -= sovWorkflowArchive(v)
 	base := offset
@@ -1492,6 +1633,26 @@ func (m *ResubmitArchivedWorkflowRequest) Size() (n int) {
 	return n
 }
 
+func (m *CreateArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovWorkflowArchive(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2626,6 +2787,125 @@ func (m *ResubmitArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CreateArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateArchivedWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Workflow == nil {
+				m.Workflow = &v1alpha1.Workflow{}
+			}
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWorkflowArchive(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,6 +27,8 @@ const (
 	ClusterWorkflowTemplateShortName string = "cwftmpl"
 	ClusterWorkflowTemplateFullName  string = ClusterWorkflowTemplatePlural + "." + Group
 	WorkflowEventBindingKind         string = "WorkflowEventBinding"
+	WorkflowEventSourceKind          string = "WorkflowEventSource"
+	WorkflowEventSourcePlural        string = "workfloweventsources"
 	WorkflowTaskSetKind              string = "WorkflowTaskSet"
 	WorkflowTaskSetSingular          string = "workflowtaskset"
 	WorkflowTaskSetPlural            string = "workflowtasksets"
@@ -11,6 +11,11 @@ type Amount struct {
 	Value json.Number `json:"-" protobuf:"bytes,1,opt,name=value,casttype=encoding/json.Number"`
 }
 
+// NewAmount returns an Amount of the given value.
+func NewAmount(f float64) Amount {
+	return Amount{Value: json.Number(strconv.FormatFloat(f, 'f', -1, 64))}
+}
+
 func (a *Amount) UnmarshalJSON(data []byte) error {
 	a.Value = json.Number(data)
 	return nil
@@ -47,7 +47,7 @@ type ArtifactResult struct {
 	// Name is the name of the Artifact
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 
-	// Success describes whether the deletion succeeded
+	// Success describes whether the deletion succeeded, or for the DryRun strategy whether the artifact would have been deleted
 	Success bool `json:"success,omitempty" protobuf:"varint,2,opt,name=success"`
 
 	// Error is an optional error message which should be set if Success==false
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"slices"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -66,3 +67,8 @@ func (cwftmpl *ClusterWorkflowTemplate) GetPodMetadata() *Metadata {
 func (cwftmpl *ClusterWorkflowTemplate) GetWorkflowSpec() *WorkflowSpec {
 	return &cwftmpl.Spec
 }
+
+// AllowsNamespace returns whether workflows in the namespace may reference this cluster workflow template.
+func (cwftmpl *ClusterWorkflowTemplate) AllowsNamespace(namespace string) bool {
+	return len(cwftmpl.Spec.AllowedNamespaces) == 0 || slices.Contains(cwftmpl.Spec.AllowedNamespaces, namespace)
+}
@@ -72,4 +72,7 @@ type SubmitOpts struct {
 	// Priority is used if controller is configured to process limited number of workflows in parallel, higher priority workflows
 	// are processed first.
 	Priority *int32 `json:"priority,omitempty" protobuf:"bytes,14,opt,name=priority"`
+	// ParametersFromConfigMap passes every key of this ConfigMap, in the workflow's namespace, as an input parameter
+	// to the workflow. Parameters take precedence over values from the ConfigMap.
+	ParametersFromConfigMap string `json:"parametersFromConfigMap,omitempty" protobuf:"bytes,15,opt,name=parametersFromConfigMap"`
 }
@@ -29,6 +29,18 @@ type TransformationStep struct {
 type DataSource struct {
 	// ArtifactPaths is a data transformation that collects a list of artifact paths
 	ArtifactPaths *ArtifactPaths `json:"artifactPaths,omitempty" protobuf:"bytes,1,opt,name=artifactPaths"`
+
+	// ParameterName is the name of an input parameter whose value is JSON to transform
+	ParameterName string `json:"parameterName,omitempty" protobuf:"bytes,2,opt,name=parameterName"`
+
+	// ArtifactName is the name of a raw input artifact whose data is JSON to transform
+	ArtifactName string `json:"artifactName,omitempty" protobuf:"bytes,3,opt,name=artifactName"`
+}
+
+// IsFromInputs returns whether the data is sourced from the template's inputs,
+// in which case the controller transforms it without creating a pod.
+func (ds *DataSource) IsFromInputs() bool {
+	return ds.ParameterName != "" || ds.ArtifactName != ""
 }
 
 // ArtifactPaths expands a step from a collection of artifacts
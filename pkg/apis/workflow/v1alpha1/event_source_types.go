@@ -0,0 +1,58 @@
+package v1alpha1
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkflowEventSource is a source of events that the workflow controller receives itself, and dispatches to
+// workflow event bindings, without the Argo Server or Argo Events
+// +genclient
+// +genclient:noStatus
+// +kubebuilder:resource:shortName=wfes
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type WorkflowEventSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Spec              WorkflowEventSourceSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// WorkflowEventSourceList is list of event source resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type WorkflowEventSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []WorkflowEventSource `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+type WorkflowEventSourceSpec struct {
+	// Source is where the events are received from
+	Source EventSource `json:"source" protobuf:"bytes,1,opt,name=source"`
+	// Bindings are the workflow event bindings, in the namespace of the event source, that the events are dispatched to.
+	// The discriminator of the events is the name of the event source.
+	// +listType=atomic
+	Bindings []WorkflowEventBindingRef `json:"bindings" protobuf:"bytes,2,rep,name=bindings"`
+	// ServiceAccountName is the service account, in the namespace of the event source, that the workflows are submitted
+	// as. Defaults to `default`.
+	ServiceAccountName string `json:"serviceAccountName,omitempty" protobuf:"bytes,3,opt,name=serviceAccountName"`
+}
+
+type EventSource struct {
+	// Webhook receives events as HTTP POST requests. It is the only kind of source, e.g. NATS is not supported.
+	Webhook *WebhookEventSource `json:"webhook,omitempty" protobuf:"bytes,1,opt,name=webhook"`
+}
+
+type WebhookEventSource struct {
+	// Port the workflow controller listens on. The payload of an event is the JSON body of a POST request to any path.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port" protobuf:"varint,1,opt,name=port"`
+	// SecretRef is the key of a secret, in the namespace of the event source, holding the token that requests must
+	// send as `Authorization: Bearer <token>`
+	SecretRef *apiv1.SecretKeySelector `json:"secretRef" protobuf:"bytes,2,opt,name=secretRef"`
+}
+
+type WorkflowEventBindingRef struct {
+	// Name of the workflow event binding
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+}
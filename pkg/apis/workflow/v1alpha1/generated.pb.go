@@ -12,10 +12,10 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
-	v1 "k8s.io/api/core/v1"
+	v11 "k8s.io/api/core/v1"
 	v12 "k8s.io/api/policy/v1"
 	k8s_io_apimachinery_pkg_apis_meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
 	math_bits "math/bits"
@@ -64,10 +64,38 @@ func (m *Amount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Amount proto.InternalMessageInfo
 
+func (m *AnnotationValueFrom) Reset()      { *m = AnnotationValueFrom{} }
+func (*AnnotationValueFrom) ProtoMessage() {}
+func (*AnnotationValueFrom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{1}
+}
+func (m *AnnotationValueFrom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AnnotationValueFrom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AnnotationValueFrom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnotationValueFrom.Merge(m, src)
+}
+func (m *AnnotationValueFrom) XXX_Size() int {
+	return m.Size()
+}
+func (m *AnnotationValueFrom) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnotationValueFrom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnotationValueFrom proto.InternalMessageInfo
+
 func (m *ArchiveStrategy) Reset()      { *m = ArchiveStrategy{} }
 func (*ArchiveStrategy) ProtoMessage() {}
 func (*ArchiveStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{1}
+	return fileDescriptor_724696e352c3df5f, []int{2}
 }
 func (m *ArchiveStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -95,7 +123,7 @@ var xxx_messageInfo_ArchiveStrategy proto.InternalMessageInfo
 func (m *Arguments) Reset()      { *m = Arguments{} }
 func (*Arguments) ProtoMessage() {}
 func (*Arguments) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{2}
+	return fileDescriptor_724696e352c3df5f, []int{3}
 }
 func (m *Arguments) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +151,7 @@ var xxx_messageInfo_Arguments proto.InternalMessageInfo
 func (m *ArtGCStatus) Reset()      { *m = ArtGCStatus{} }
 func (*ArtGCStatus) ProtoMessage() {}
 func (*ArtGCStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{3}
+	return fileDescriptor_724696e352c3df5f, []int{4}
 }
 func (m *ArtGCStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -151,7 +179,7 @@ var xxx_messageInfo_ArtGCStatus proto.InternalMessageInfo
 func (m *Artifact) Reset()      { *m = Artifact{} }
 func (*Artifact) ProtoMessage() {}
 func (*Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{4}
+	return fileDescriptor_724696e352c3df5f, []int{5}
 }
 func (m *Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,7 +207,7 @@ var xxx_messageInfo_Artifact proto.InternalMessageInfo
 func (m *ArtifactGC) Reset()      { *m = ArtifactGC{} }
 func (*ArtifactGC) ProtoMessage() {}
 func (*ArtifactGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{5}
+	return fileDescriptor_724696e352c3df5f, []int{6}
 }
 func (m *ArtifactGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +235,7 @@ var xxx_messageInfo_ArtifactGC proto.InternalMessageInfo
 func (m *ArtifactGCSpec) Reset()      { *m = ArtifactGCSpec{} }
 func (*ArtifactGCSpec) ProtoMessage() {}
 func (*ArtifactGCSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{6}
+	return fileDescriptor_724696e352c3df5f, []int{7}
 }
 func (m *ArtifactGCSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +263,7 @@ var xxx_messageInfo_ArtifactGCSpec proto.InternalMessageInfo
 func (m *ArtifactGCStatus) Reset()      { *m = ArtifactGCStatus{} }
 func (*ArtifactGCStatus) ProtoMessage() {}
 func (*ArtifactGCStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{7}
+	return fileDescriptor_724696e352c3df5f, []int{8}
 }
 func (m *ArtifactGCStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +291,7 @@ var xxx_messageInfo_ArtifactGCStatus proto.InternalMessageInfo
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{8}
+	return fileDescriptor_724696e352c3df5f, []int{9}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,10 +316,38 @@ func (m *ArtifactLocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 
+func (m *ArtifactNaming) Reset()      { *m = ArtifactNaming{} }
+func (*ArtifactNaming) ProtoMessage() {}
+func (*ArtifactNaming) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{10}
+}
+func (m *ArtifactNaming) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactNaming) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactNaming) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactNaming.Merge(m, src)
+}
+func (m *ArtifactNaming) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactNaming) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactNaming.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactNaming proto.InternalMessageInfo
+
 func (m *ArtifactNodeSpec) Reset()      { *m = ArtifactNodeSpec{} }
 func (*ArtifactNodeSpec) ProtoMessage() {}
 func (*ArtifactNodeSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{9}
+	return fileDescriptor_724696e352c3df5f, []int{11}
 }
 func (m *ArtifactNodeSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +375,7 @@ var xxx_messageInfo_ArtifactNodeSpec proto.InternalMessageInfo
 func (m *ArtifactPaths) Reset()      { *m = ArtifactPaths{} }
 func (*ArtifactPaths) ProtoMessage() {}
 func (*ArtifactPaths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{10}
+	return fileDescriptor_724696e352c3df5f, []int{12}
 }
 func (m *ArtifactPaths) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,10 +400,38 @@ func (m *ArtifactPaths) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArtifactPaths proto.InternalMessageInfo
 
+func (m *ArtifactRenamePolicy) Reset()      { *m = ArtifactRenamePolicy{} }
+func (*ArtifactRenamePolicy) ProtoMessage() {}
+func (*ArtifactRenamePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{13}
+}
+func (m *ArtifactRenamePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactRenamePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactRenamePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactRenamePolicy.Merge(m, src)
+}
+func (m *ArtifactRenamePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactRenamePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactRenamePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactRenamePolicy proto.InternalMessageInfo
+
 func (m *ArtifactRepository) Reset()      { *m = ArtifactRepository{} }
 func (*ArtifactRepository) ProtoMessage() {}
 func (*ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{11}
+	return fileDescriptor_724696e352c3df5f, []int{14}
 }
 func (m *ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +459,7 @@ var xxx_messageInfo_ArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactRepositoryRef) Reset()      { *m = ArtifactRepositoryRef{} }
 func (*ArtifactRepositoryRef) ProtoMessage() {}
 func (*ArtifactRepositoryRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{12}
+	return fileDescriptor_724696e352c3df5f, []int{15}
 }
 func (m *ArtifactRepositoryRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +487,7 @@ var xxx_messageInfo_ArtifactRepositoryRef proto.InternalMessageInfo
 func (m *ArtifactRepositoryRefStatus) Reset()      { *m = ArtifactRepositoryRefStatus{} }
 func (*ArtifactRepositoryRefStatus) ProtoMessage() {}
 func (*ArtifactRepositoryRefStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{13}
+	return fileDescriptor_724696e352c3df5f, []int{16}
 }
 func (m *ArtifactRepositoryRefStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +515,7 @@ var xxx_messageInfo_ArtifactRepositoryRefStatus proto.InternalMessageInfo
 func (m *ArtifactResult) Reset()      { *m = ArtifactResult{} }
 func (*ArtifactResult) ProtoMessage() {}
 func (*ArtifactResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{14}
+	return fileDescriptor_724696e352c3df5f, []int{17}
 }
 func (m *ArtifactResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +543,7 @@ var xxx_messageInfo_ArtifactResult proto.InternalMessageInfo
 func (m *ArtifactResultNodeStatus) Reset()      { *m = ArtifactResultNodeStatus{} }
 func (*ArtifactResultNodeStatus) ProtoMessage() {}
 func (*ArtifactResultNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{15}
+	return fileDescriptor_724696e352c3df5f, []int{18}
 }
 func (m *ArtifactResultNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +571,7 @@ var xxx_messageInfo_ArtifactResultNodeStatus proto.InternalMessageInfo
 func (m *ArtifactSearchQuery) Reset()      { *m = ArtifactSearchQuery{} }
 func (*ArtifactSearchQuery) ProtoMessage() {}
 func (*ArtifactSearchQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{16}
+	return fileDescriptor_724696e352c3df5f, []int{19}
 }
 func (m *ArtifactSearchQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +599,7 @@ var xxx_messageInfo_ArtifactSearchQuery proto.InternalMessageInfo
 func (m *ArtifactSearchResult) Reset()      { *m = ArtifactSearchResult{} }
 func (*ArtifactSearchResult) ProtoMessage() {}
 func (*ArtifactSearchResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{17}
+	return fileDescriptor_724696e352c3df5f, []int{20}
 }
 func (m *ArtifactSearchResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +627,7 @@ var xxx_messageInfo_ArtifactSearchResult proto.InternalMessageInfo
 func (m *ArtifactoryArtifact) Reset()      { *m = ArtifactoryArtifact{} }
 func (*ArtifactoryArtifact) ProtoMessage() {}
 func (*ArtifactoryArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{18}
+	return fileDescriptor_724696e352c3df5f, []int{21}
 }
 func (m *ArtifactoryArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +655,7 @@ var xxx_messageInfo_ArtifactoryArtifact proto.InternalMessageInfo
 func (m *ArtifactoryArtifactRepository) Reset()      { *m = ArtifactoryArtifactRepository{} }
 func (*ArtifactoryArtifactRepository) ProtoMessage() {}
 func (*ArtifactoryArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{19}
+	return fileDescriptor_724696e352c3df5f, []int{22}
 }
 func (m *ArtifactoryArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +683,7 @@ var xxx_messageInfo_ArtifactoryArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactoryAuth) Reset()      { *m = ArtifactoryAuth{} }
 func (*ArtifactoryAuth) ProtoMessage() {}
 func (*ArtifactoryAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{20}
+	return fileDescriptor_724696e352c3df5f, []int{23}
 }
 func (m *ArtifactoryAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +711,7 @@ var xxx_messageInfo_ArtifactoryAuth proto.InternalMessageInfo
 func (m *AzureArtifact) Reset()      { *m = AzureArtifact{} }
 func (*AzureArtifact) ProtoMessage() {}
 func (*AzureArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{21}
+	return fileDescriptor_724696e352c3df5f, []int{24}
 }
 func (m *AzureArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +739,7 @@ var xxx_messageInfo_AzureArtifact proto.InternalMessageInfo
 func (m *AzureArtifactRepository) Reset()      { *m = AzureArtifactRepository{} }
 func (*AzureArtifactRepository) ProtoMessage() {}
 func (*AzureArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{22}
+	return fileDescriptor_724696e352c3df5f, []int{25}
 }
 func (m *AzureArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +767,7 @@ var xxx_messageInfo_AzureArtifactRepository proto.InternalMessageInfo
 func (m *AzureBlobContainer) Reset()      { *m = AzureBlobContainer{} }
 func (*AzureBlobContainer) ProtoMessage() {}
 func (*AzureBlobContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{23}
+	return fileDescriptor_724696e352c3df5f, []int{26}
 }
 func (m *AzureBlobContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +795,7 @@ var xxx_messageInfo_AzureBlobContainer proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{24}
+	return fileDescriptor_724696e352c3df5f, []int{27}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +823,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuth) Reset()      { *m = BasicAuth{} }
 func (*BasicAuth) ProtoMessage() {}
 func (*BasicAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{25}
+	return fileDescriptor_724696e352c3df5f, []int{28}
 }
 func (m *BasicAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +851,7 @@ var xxx_messageInfo_BasicAuth proto.InternalMessageInfo
 func (m *Cache) Reset()      { *m = Cache{} }
 func (*Cache) ProtoMessage() {}
 func (*Cache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{26}
+	return fileDescriptor_724696e352c3df5f, []int{29}
 }
 func (m *Cache) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +879,7 @@ var xxx_messageInfo_Cache proto.InternalMessageInfo
 func (m *ClientCertAuth) Reset()      { *m = ClientCertAuth{} }
 func (*ClientCertAuth) ProtoMessage() {}
 func (*ClientCertAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{27}
+	return fileDescriptor_724696e352c3df5f, []int{30}
 }
 func (m *ClientCertAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +907,7 @@ var xxx_messageInfo_ClientCertAuth proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplate) Reset()      { *m = ClusterWorkflowTemplate{} }
 func (*ClusterWorkflowTemplate) ProtoMessage() {}
 func (*ClusterWorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{28}
+	return fileDescriptor_724696e352c3df5f, []int{31}
 }
 func (m *ClusterWorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +935,7 @@ var xxx_messageInfo_ClusterWorkflowTemplate proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplateList) Reset()      { *m = ClusterWorkflowTemplateList{} }
 func (*ClusterWorkflowTemplateList) ProtoMessage() {}
 func (*ClusterWorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{29}
+	return fileDescriptor_724696e352c3df5f, []int{32}
 }
 func (m *ClusterWorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +963,7 @@ var xxx_messageInfo_ClusterWorkflowTemplateList proto.InternalMessageInfo
 func (m *Column) Reset()      { *m = Column{} }
 func (*Column) ProtoMessage() {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{30}
+	return fileDescriptor_724696e352c3df5f, []int{33}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +991,7 @@ var xxx_messageInfo_Column proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{31}
+	return fileDescriptor_724696e352c3df5f, []int{34}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1019,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerNode) Reset()      { *m = ContainerNode{} }
 func (*ContainerNode) ProtoMessage() {}
 func (*ContainerNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{32}
+	return fileDescriptor_724696e352c3df5f, []int{35}
 }
 func (m *ContainerNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1047,7 @@ var xxx_messageInfo_ContainerNode proto.InternalMessageInfo
 func (m *ContainerSetRetryStrategy) Reset()      { *m = ContainerSetRetryStrategy{} }
 func (*ContainerSetRetryStrategy) ProtoMessage() {}
 func (*ContainerSetRetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{33}
+	return fileDescriptor_724696e352c3df5f, []int{36}
 }
 func (m *ContainerSetRetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1075,7 @@ var xxx_messageInfo_ContainerSetRetryStrategy proto.InternalMessageInfo
 func (m *ContainerSetTemplate) Reset()      { *m = ContainerSetTemplate{} }
 func (*ContainerSetTemplate) ProtoMessage() {}
 func (*ContainerSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{34}
+	return fileDescriptor_724696e352c3df5f, []int{37}
 }
 func (m *ContainerSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1103,7 @@ var xxx_messageInfo_ContainerSetTemplate proto.InternalMessageInfo
 func (m *ContinueOn) Reset()      { *m = ContinueOn{} }
 func (*ContinueOn) ProtoMessage() {}
 func (*ContinueOn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{35}
+	return fileDescriptor_724696e352c3df5f, []int{38}
 }
 func (m *ContinueOn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1131,7 @@ var xxx_messageInfo_ContinueOn proto.InternalMessageInfo
 func (m *Counter) Reset()      { *m = Counter{} }
 func (*Counter) ProtoMessage() {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{36}
+	return fileDescriptor_724696e352c3df5f, []int{39}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1159,7 @@ var xxx_messageInfo_Counter proto.InternalMessageInfo
 func (m *CreateS3BucketOptions) Reset()      { *m = CreateS3BucketOptions{} }
 func (*CreateS3BucketOptions) ProtoMessage() {}
 func (*CreateS3BucketOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{37}
+	return fileDescriptor_724696e352c3df5f, []int{40}
 }
 func (m *CreateS3BucketOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1187,7 @@ var xxx_messageInfo_CreateS3BucketOptions proto.InternalMessageInfo
 func (m *CronWorkflow) Reset()      { *m = CronWorkflow{} }
 func (*CronWorkflow) ProtoMessage() {}
 func (*CronWorkflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{38}
+	return fileDescriptor_724696e352c3df5f, []int{41}
 }
 func (m *CronWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1215,7 @@ var xxx_messageInfo_CronWorkflow proto.InternalMessageInfo
 func (m *CronWorkflowList) Reset()      { *m = CronWorkflowList{} }
 func (*CronWorkflowList) ProtoMessage() {}
 func (*CronWorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{39}
+	return fileDescriptor_724696e352c3df5f, []int{42}
 }
 func (m *CronWorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1243,7 @@ var xxx_messageInfo_CronWorkflowList proto.InternalMessageInfo
 func (m *CronWorkflowSpec) Reset()      { *m = CronWorkflowSpec{} }
 func (*CronWorkflowSpec) ProtoMessage() {}
 func (*CronWorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{40}
+	return fileDescriptor_724696e352c3df5f, []int{43}
 }
 func (m *CronWorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1271,7 @@ var xxx_messageInfo_CronWorkflowSpec proto.InternalMessageInfo
 func (m *CronWorkflowStatus) Reset()      { *m = CronWorkflowStatus{} }
 func (*CronWorkflowStatus) ProtoMessage() {}
 func (*CronWorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{41}
+	return fileDescriptor_724696e352c3df5f, []int{44}
 }
 func (m *CronWorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1299,7 @@ var xxx_messageInfo_CronWorkflowStatus proto.InternalMessageInfo
 func (m *DAGTask) Reset()      { *m = DAGTask{} }
 func (*DAGTask) ProtoMessage() {}
 func (*DAGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{42}
+	return fileDescriptor_724696e352c3df5f, []int{45}
 }
 func (m *DAGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1327,7 @@ var xxx_messageInfo_DAGTask proto.InternalMessageInfo
 func (m *DAGTemplate) Reset()      { *m = DAGTemplate{} }
 func (*DAGTemplate) ProtoMessage() {}
 func (*DAGTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{43}
+	return fileDescriptor_724696e352c3df5f, []int{46}
 }
 func (m *DAGTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1355,7 @@ var xxx_messageInfo_DAGTemplate proto.InternalMessageInfo
 func (m *Data) Reset()      { *m = Data{} }
 func (*Data) ProtoMessage() {}
 func (*Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{44}
+	return fileDescriptor_724696e352c3df5f, []int{47}
 }
 func (m *Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1383,7 @@ var xxx_messageInfo_Data proto.InternalMessageInfo
 func (m *DataSource) Reset()      { *m = DataSource{} }
 func (*DataSource) ProtoMessage() {}
 func (*DataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{45}
+	return fileDescriptor_724696e352c3df5f, []int{48}
 }
 func (m *DataSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1324,10 +1408,38 @@ func (m *DataSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DataSource proto.InternalMessageInfo
 
+func (m *EntrypointRef) Reset()      { *m = EntrypointRef{} }
+func (*EntrypointRef) ProtoMessage() {}
+func (*EntrypointRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{49}
+}
+func (m *EntrypointRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntrypointRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EntrypointRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntrypointRef.Merge(m, src)
+}
+func (m *EntrypointRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntrypointRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntrypointRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntrypointRef proto.InternalMessageInfo
+
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{46}
+	return fileDescriptor_724696e352c3df5f, []int{50}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1352,10 +1464,38 @@ func (m *Event) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Event proto.InternalMessageInfo
 
+func (m *EventSource) Reset()      { *m = EventSource{} }
+func (*EventSource) ProtoMessage() {}
+func (*EventSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{51}
+}
+func (m *EventSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSource.Merge(m, src)
+}
+func (m *EventSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSource proto.InternalMessageInfo
+
 func (m *ExecutorConfig) Reset()      { *m = ExecutorConfig{} }
 func (*ExecutorConfig) ProtoMessage() {}
 func (*ExecutorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{47}
+	return fileDescriptor_724696e352c3df5f, []int{52}
 }
 func (m *ExecutorConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1523,7 @@ var xxx_messageInfo_ExecutorConfig proto.InternalMessageInfo
 func (m *GCSArtifact) Reset()      { *m = GCSArtifact{} }
 func (*GCSArtifact) ProtoMessage() {}
 func (*GCSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{48}
+	return fileDescriptor_724696e352c3df5f, []int{53}
 }
 func (m *GCSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1551,7 @@ var xxx_messageInfo_GCSArtifact proto.InternalMessageInfo
 func (m *GCSArtifactRepository) Reset()      { *m = GCSArtifactRepository{} }
 func (*GCSArtifactRepository) ProtoMessage() {}
 func (*GCSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{49}
+	return fileDescriptor_724696e352c3df5f, []int{54}
 }
 func (m *GCSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1579,7 @@ var xxx_messageInfo_GCSArtifactRepository proto.InternalMessageInfo
 func (m *GCSBucket) Reset()      { *m = GCSBucket{} }
 func (*GCSBucket) ProtoMessage() {}
 func (*GCSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{50}
+	return fileDescriptor_724696e352c3df5f, []int{55}
 }
 func (m *GCSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1607,7 @@ var xxx_messageInfo_GCSBucket proto.InternalMessageInfo
 func (m *Gauge) Reset()      { *m = Gauge{} }
 func (*Gauge) ProtoMessage() {}
 func (*Gauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{51}
+	return fileDescriptor_724696e352c3df5f, []int{56}
 }
 func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1635,7 @@ var xxx_messageInfo_Gauge proto.InternalMessageInfo
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{52}
+	return fileDescriptor_724696e352c3df5f, []int{57}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1663,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *HDFSArtifact) Reset()      { *m = HDFSArtifact{} }
 func (*HDFSArtifact) ProtoMessage() {}
 func (*HDFSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{53}
+	return fileDescriptor_724696e352c3df5f, []int{58}
 }
 func (m *HDFSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1691,7 @@ var xxx_messageInfo_HDFSArtifact proto.InternalMessageInfo
 func (m *HDFSArtifactRepository) Reset()      { *m = HDFSArtifactRepository{} }
 func (*HDFSArtifactRepository) ProtoMessage() {}
 func (*HDFSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{54}
+	return fileDescriptor_724696e352c3df5f, []int{59}
 }
 func (m *HDFSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1719,7 @@ var xxx_messageInfo_HDFSArtifactRepository proto.InternalMessageInfo
 func (m *HDFSConfig) Reset()      { *m = HDFSConfig{} }
 func (*HDFSConfig) ProtoMessage() {}
 func (*HDFSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{55}
+	return fileDescriptor_724696e352c3df5f, []int{60}
 }
 func (m *HDFSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1747,7 @@ var xxx_messageInfo_HDFSConfig proto.InternalMessageInfo
 func (m *HDFSKrbConfig) Reset()      { *m = HDFSKrbConfig{} }
 func (*HDFSKrbConfig) ProtoMessage() {}
 func (*HDFSKrbConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{56}
+	return fileDescriptor_724696e352c3df5f, []int{61}
 }
 func (m *HDFSKrbConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1775,7 @@ var xxx_messageInfo_HDFSKrbConfig proto.InternalMessageInfo
 func (m *HTTP) Reset()      { *m = HTTP{} }
 func (*HTTP) ProtoMessage() {}
 func (*HTTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{57}
+	return fileDescriptor_724696e352c3df5f, []int{62}
 }
 func (m *HTTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +1803,7 @@ var xxx_messageInfo_HTTP proto.InternalMessageInfo
 func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
 func (*HTTPArtifact) ProtoMessage() {}
 func (*HTTPArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{58}
+	return fileDescriptor_724696e352c3df5f, []int{63}
 }
 func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +1831,7 @@ var xxx_messageInfo_HTTPArtifact proto.InternalMessageInfo
 func (m *HTTPAuth) Reset()      { *m = HTTPAuth{} }
 func (*HTTPAuth) ProtoMessage() {}
 func (*HTTPAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{59}
+	return fileDescriptor_724696e352c3df5f, []int{64}
 }
 func (m *HTTPAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +1859,7 @@ var xxx_messageInfo_HTTPAuth proto.InternalMessageInfo
 func (m *HTTPBodySource) Reset()      { *m = HTTPBodySource{} }
 func (*HTTPBodySource) ProtoMessage() {}
 func (*HTTPBodySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{60}
+	return fileDescriptor_724696e352c3df5f, []int{65}
 }
 func (m *HTTPBodySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +1887,7 @@ var xxx_messageInfo_HTTPBodySource proto.InternalMessageInfo
 func (m *HTTPHeader) Reset()      { *m = HTTPHeader{} }
 func (*HTTPHeader) ProtoMessage() {}
 func (*HTTPHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{61}
+	return fileDescriptor_724696e352c3df5f, []int{66}
 }
 func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +1915,7 @@ var xxx_messageInfo_HTTPHeader proto.InternalMessageInfo
 func (m *HTTPHeaderSource) Reset()      { *m = HTTPHeaderSource{} }
 func (*HTTPHeaderSource) ProtoMessage() {}
 func (*HTTPHeaderSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{62}
+	return fileDescriptor_724696e352c3df5f, []int{67}
 }
 func (m *HTTPHeaderSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1800,10 +1940,38 @@ func (m *HTTPHeaderSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPHeaderSource proto.InternalMessageInfo
 
+func (m *HTTPTLSConfig) Reset()      { *m = HTTPTLSConfig{} }
+func (*HTTPTLSConfig) ProtoMessage() {}
+func (*HTTPTLSConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{68}
+}
+func (m *HTTPTLSConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPTLSConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPTLSConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPTLSConfig.Merge(m, src)
+}
+func (m *HTTPTLSConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPTLSConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPTLSConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPTLSConfig proto.InternalMessageInfo
+
 func (m *Header) Reset()      { *m = Header{} }
 func (*Header) ProtoMessage() {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{63}
+	return fileDescriptor_724696e352c3df5f, []int{69}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +1999,7 @@ var xxx_messageInfo_Header proto.InternalMessageInfo
 func (m *Histogram) Reset()      { *m = Histogram{} }
 func (*Histogram) ProtoMessage() {}
 func (*Histogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{64}
+	return fileDescriptor_724696e352c3df5f, []int{70}
 }
 func (m *Histogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1856,10 +2024,66 @@ func (m *Histogram) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Histogram proto.InternalMessageInfo
 
+func (m *ImageLabelSource) Reset()      { *m = ImageLabelSource{} }
+func (*ImageLabelSource) ProtoMessage() {}
+func (*ImageLabelSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{71}
+}
+func (m *ImageLabelSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageLabelSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ImageLabelSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageLabelSource.Merge(m, src)
+}
+func (m *ImageLabelSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageLabelSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageLabelSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageLabelSource proto.InternalMessageInfo
+
+func (m *ImageRef) Reset()      { *m = ImageRef{} }
+func (*ImageRef) ProtoMessage() {}
+func (*ImageRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{72}
+}
+func (m *ImageRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImageRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ImageRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageRef.Merge(m, src)
+}
+func (m *ImageRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImageRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageRef proto.InternalMessageInfo
+
 func (m *Inputs) Reset()      { *m = Inputs{} }
 func (*Inputs) ProtoMessage() {}
 func (*Inputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{65}
+	return fileDescriptor_724696e352c3df5f, []int{73}
 }
 func (m *Inputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2111,7 @@ var xxx_messageInfo_Inputs proto.InternalMessageInfo
 func (m *Item) Reset()      { *m = Item{} }
 func (*Item) ProtoMessage() {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{66}
+	return fileDescriptor_724696e352c3df5f, []int{74}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2139,7 @@ var xxx_messageInfo_Item proto.InternalMessageInfo
 func (m *LabelKeys) Reset()      { *m = LabelKeys{} }
 func (*LabelKeys) ProtoMessage() {}
 func (*LabelKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{67}
+	return fileDescriptor_724696e352c3df5f, []int{75}
 }
 func (m *LabelKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2167,7 @@ var xxx_messageInfo_LabelKeys proto.InternalMessageInfo
 func (m *LabelValueFrom) Reset()      { *m = LabelValueFrom{} }
 func (*LabelValueFrom) ProtoMessage() {}
 func (*LabelValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{68}
+	return fileDescriptor_724696e352c3df5f, []int{76}
 }
 func (m *LabelValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2195,7 @@ var xxx_messageInfo_LabelValueFrom proto.InternalMessageInfo
 func (m *LabelValues) Reset()      { *m = LabelValues{} }
 func (*LabelValues) ProtoMessage() {}
 func (*LabelValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{69}
+	return fileDescriptor_724696e352c3df5f, []int{77}
 }
 func (m *LabelValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2223,7 @@ var xxx_messageInfo_LabelValues proto.InternalMessageInfo
 func (m *LifecycleHook) Reset()      { *m = LifecycleHook{} }
 func (*LifecycleHook) ProtoMessage() {}
 func (*LifecycleHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{70}
+	return fileDescriptor_724696e352c3df5f, []int{78}
 }
 func (m *LifecycleHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2251,7 @@ var xxx_messageInfo_LifecycleHook proto.InternalMessageInfo
 func (m *Link) Reset()      { *m = Link{} }
 func (*Link) ProtoMessage() {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{71}
+	return fileDescriptor_724696e352c3df5f, []int{79}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2279,7 @@ var xxx_messageInfo_Link proto.InternalMessageInfo
 func (m *ManifestFrom) Reset()      { *m = ManifestFrom{} }
 func (*ManifestFrom) ProtoMessage() {}
 func (*ManifestFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{72}
+	return fileDescriptor_724696e352c3df5f, []int{80}
 }
 func (m *ManifestFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2307,7 @@ var xxx_messageInfo_ManifestFrom proto.InternalMessageInfo
 func (m *MemoizationStatus) Reset()      { *m = MemoizationStatus{} }
 func (*MemoizationStatus) ProtoMessage() {}
 func (*MemoizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{73}
+	return fileDescriptor_724696e352c3df5f, []int{81}
 }
 func (m *MemoizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2335,7 @@ var xxx_messageInfo_MemoizationStatus proto.InternalMessageInfo
 func (m *Memoize) Reset()      { *m = Memoize{} }
 func (*Memoize) ProtoMessage() {}
 func (*Memoize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{74}
+	return fileDescriptor_724696e352c3df5f, []int{82}
 }
 func (m *Memoize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2363,7 @@ var xxx_messageInfo_Memoize proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{75}
+	return fileDescriptor_724696e352c3df5f, []int{83}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2391,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MetricLabel) Reset()      { *m = MetricLabel{} }
 func (*MetricLabel) ProtoMessage() {}
 func (*MetricLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{76}
+	return fileDescriptor_724696e352c3df5f, []int{84}
 }
 func (m *MetricLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2419,7 @@ var xxx_messageInfo_MetricLabel proto.InternalMessageInfo
 func (m *Metrics) Reset()      { *m = Metrics{} }
 func (*Metrics) ProtoMessage() {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{77}
+	return fileDescriptor_724696e352c3df5f, []int{85}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2447,7 @@ var xxx_messageInfo_Metrics proto.InternalMessageInfo
 func (m *Mutex) Reset()      { *m = Mutex{} }
 func (*Mutex) ProtoMessage() {}
 func (*Mutex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{78}
+	return fileDescriptor_724696e352c3df5f, []int{86}
 }
 func (m *Mutex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2475,7 @@ var xxx_messageInfo_Mutex proto.InternalMessageInfo
 func (m *MutexHolding) Reset()      { *m = MutexHolding{} }
 func (*MutexHolding) ProtoMessage() {}
 func (*MutexHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{79}
+	return fileDescriptor_724696e352c3df5f, []int{87}
 }
 func (m *MutexHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2503,7 @@ var xxx_messageInfo_MutexHolding proto.InternalMessageInfo
 func (m *MutexStatus) Reset()      { *m = MutexStatus{} }
 func (*MutexStatus) ProtoMessage() {}
 func (*MutexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{80}
+	return fileDescriptor_724696e352c3df5f, []int{88}
 }
 func (m *MutexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2304,10 +2528,38 @@ func (m *MutexStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MutexStatus proto.InternalMessageInfo
 
+func (m *NamedPipeArtifact) Reset()      { *m = NamedPipeArtifact{} }
+func (*NamedPipeArtifact) ProtoMessage() {}
+func (*NamedPipeArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{89}
+}
+func (m *NamedPipeArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamedPipeArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NamedPipeArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamedPipeArtifact.Merge(m, src)
+}
+func (m *NamedPipeArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamedPipeArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamedPipeArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamedPipeArtifact proto.InternalMessageInfo
+
 func (m *NodeFlag) Reset()      { *m = NodeFlag{} }
 func (*NodeFlag) ProtoMessage() {}
 func (*NodeFlag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{81}
+	return fileDescriptor_724696e352c3df5f, []int{90}
 }
 func (m *NodeFlag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2335,7 +2587,7 @@ var xxx_messageInfo_NodeFlag proto.InternalMessageInfo
 func (m *NodeResult) Reset()      { *m = NodeResult{} }
 func (*NodeResult) ProtoMessage() {}
 func (*NodeResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{82}
+	return fileDescriptor_724696e352c3df5f, []int{91}
 }
 func (m *NodeResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,7 +2615,7 @@ var xxx_messageInfo_NodeResult proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{83}
+	return fileDescriptor_724696e352c3df5f, []int{92}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2643,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *NodeSynchronizationStatus) Reset()      { *m = NodeSynchronizationStatus{} }
 func (*NodeSynchronizationStatus) ProtoMessage() {}
 func (*NodeSynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{84}
+	return fileDescriptor_724696e352c3df5f, []int{93}
 }
 func (m *NodeSynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2671,7 @@ var xxx_messageInfo_NodeSynchronizationStatus proto.InternalMessageInfo
 func (m *NoneStrategy) Reset()      { *m = NoneStrategy{} }
 func (*NoneStrategy) ProtoMessage() {}
 func (*NoneStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{85}
+	return fileDescriptor_724696e352c3df5f, []int{94}
 }
 func (m *NoneStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +2699,7 @@ var xxx_messageInfo_NoneStrategy proto.InternalMessageInfo
 func (m *OAuth2Auth) Reset()      { *m = OAuth2Auth{} }
 func (*OAuth2Auth) ProtoMessage() {}
 func (*OAuth2Auth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{86}
+	return fileDescriptor_724696e352c3df5f, []int{95}
 }
 func (m *OAuth2Auth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +2727,7 @@ var xxx_messageInfo_OAuth2Auth proto.InternalMessageInfo
 func (m *OAuth2EndpointParam) Reset()      { *m = OAuth2EndpointParam{} }
 func (*OAuth2EndpointParam) ProtoMessage() {}
 func (*OAuth2EndpointParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{87}
+	return fileDescriptor_724696e352c3df5f, []int{96}
 }
 func (m *OAuth2EndpointParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2503,7 +2755,7 @@ var xxx_messageInfo_OAuth2EndpointParam proto.InternalMessageInfo
 func (m *OSSArtifact) Reset()      { *m = OSSArtifact{} }
 func (*OSSArtifact) ProtoMessage() {}
 func (*OSSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{88}
+	return fileDescriptor_724696e352c3df5f, []int{97}
 }
 func (m *OSSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2531,7 +2783,7 @@ var xxx_messageInfo_OSSArtifact proto.InternalMessageInfo
 func (m *OSSArtifactRepository) Reset()      { *m = OSSArtifactRepository{} }
 func (*OSSArtifactRepository) ProtoMessage() {}
 func (*OSSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{89}
+	return fileDescriptor_724696e352c3df5f, []int{98}
 }
 func (m *OSSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +2811,7 @@ var xxx_messageInfo_OSSArtifactRepository proto.InternalMessageInfo
 func (m *OSSBucket) Reset()      { *m = OSSBucket{} }
 func (*OSSBucket) ProtoMessage() {}
 func (*OSSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{90}
+	return fileDescriptor_724696e352c3df5f, []int{99}
 }
 func (m *OSSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2587,7 +2839,7 @@ var xxx_messageInfo_OSSBucket proto.InternalMessageInfo
 func (m *OSSLifecycleRule) Reset()      { *m = OSSLifecycleRule{} }
 func (*OSSLifecycleRule) ProtoMessage() {}
 func (*OSSLifecycleRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{91}
+	return fileDescriptor_724696e352c3df5f, []int{100}
 }
 func (m *OSSLifecycleRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,7 +2867,7 @@ var xxx_messageInfo_OSSLifecycleRule proto.InternalMessageInfo
 func (m *Object) Reset()      { *m = Object{} }
 func (*Object) ProtoMessage() {}
 func (*Object) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{92}
+	return fileDescriptor_724696e352c3df5f, []int{101}
 }
 func (m *Object) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2640,10 +2892,38 @@ func (m *Object) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Object proto.InternalMessageInfo
 
+func (m *OutputParameterRef) Reset()      { *m = OutputParameterRef{} }
+func (*OutputParameterRef) ProtoMessage() {}
+func (*OutputParameterRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{102}
+}
+func (m *OutputParameterRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutputParameterRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OutputParameterRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputParameterRef.Merge(m, src)
+}
+func (m *OutputParameterRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutputParameterRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputParameterRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutputParameterRef proto.InternalMessageInfo
+
 func (m *Outputs) Reset()      { *m = Outputs{} }
 func (*Outputs) ProtoMessage() {}
 func (*Outputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{93}
+	return fileDescriptor_724696e352c3df5f, []int{103}
 }
 func (m *Outputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2671,7 +2951,7 @@ var xxx_messageInfo_Outputs proto.InternalMessageInfo
 func (m *ParallelSteps) Reset()      { *m = ParallelSteps{} }
 func (*ParallelSteps) ProtoMessage() {}
 func (*ParallelSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{94}
+	return fileDescriptor_724696e352c3df5f, []int{104}
 }
 func (m *ParallelSteps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2699,7 +2979,7 @@ var xxx_messageInfo_ParallelSteps proto.InternalMessageInfo
 func (m *Parameter) Reset()      { *m = Parameter{} }
 func (*Parameter) ProtoMessage() {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{95}
+	return fileDescriptor_724696e352c3df5f, []int{105}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2724,10 +3004,38 @@ func (m *Parameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Parameter proto.InternalMessageInfo
 
+func (m *PersistentVolumeClaimSource) Reset()      { *m = PersistentVolumeClaimSource{} }
+func (*PersistentVolumeClaimSource) ProtoMessage() {}
+func (*PersistentVolumeClaimSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{106}
+}
+func (m *PersistentVolumeClaimSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PersistentVolumeClaimSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PersistentVolumeClaimSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersistentVolumeClaimSource.Merge(m, src)
+}
+func (m *PersistentVolumeClaimSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *PersistentVolumeClaimSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersistentVolumeClaimSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersistentVolumeClaimSource proto.InternalMessageInfo
+
 func (m *Plugin) Reset()      { *m = Plugin{} }
 func (*Plugin) ProtoMessage() {}
 func (*Plugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{96}
+	return fileDescriptor_724696e352c3df5f, []int{107}
 }
 func (m *Plugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2755,7 +3063,7 @@ var xxx_messageInfo_Plugin proto.InternalMessageInfo
 func (m *PodGC) Reset()      { *m = PodGC{} }
 func (*PodGC) ProtoMessage() {}
 func (*PodGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{97}
+	return fileDescriptor_724696e352c3df5f, []int{108}
 }
 func (m *PodGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2780,10 +3088,38 @@ func (m *PodGC) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PodGC proto.InternalMessageInfo
 
+func (m *Preprocessor) Reset()      { *m = Preprocessor{} }
+func (*Preprocessor) ProtoMessage() {}
+func (*Preprocessor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{109}
+}
+func (m *Preprocessor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Preprocessor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Preprocessor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Preprocessor.Merge(m, src)
+}
+func (m *Preprocessor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Preprocessor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Preprocessor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Preprocessor proto.InternalMessageInfo
+
 func (m *Prometheus) Reset()      { *m = Prometheus{} }
 func (*Prometheus) ProtoMessage() {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{98}
+	return fileDescriptor_724696e352c3df5f, []int{110}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2811,7 +3147,7 @@ var xxx_messageInfo_Prometheus proto.InternalMessageInfo
 func (m *RawArtifact) Reset()      { *m = RawArtifact{} }
 func (*RawArtifact) ProtoMessage() {}
 func (*RawArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{99}
+	return fileDescriptor_724696e352c3df5f, []int{111}
 }
 func (m *RawArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2839,7 +3175,7 @@ var xxx_messageInfo_RawArtifact proto.InternalMessageInfo
 func (m *ResourceTemplate) Reset()      { *m = ResourceTemplate{} }
 func (*ResourceTemplate) ProtoMessage() {}
 func (*ResourceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{100}
+	return fileDescriptor_724696e352c3df5f, []int{112}
 }
 func (m *ResourceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2867,7 +3203,7 @@ var xxx_messageInfo_ResourceTemplate proto.InternalMessageInfo
 func (m *RetryAffinity) Reset()      { *m = RetryAffinity{} }
 func (*RetryAffinity) ProtoMessage() {}
 func (*RetryAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{101}
+	return fileDescriptor_724696e352c3df5f, []int{113}
 }
 func (m *RetryAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2895,7 +3231,7 @@ var xxx_messageInfo_RetryAffinity proto.InternalMessageInfo
 func (m *RetryNodeAntiAffinity) Reset()      { *m = RetryNodeAntiAffinity{} }
 func (*RetryNodeAntiAffinity) ProtoMessage() {}
 func (*RetryNodeAntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{102}
+	return fileDescriptor_724696e352c3df5f, []int{114}
 }
 func (m *RetryNodeAntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2920,10 +3256,38 @@ func (m *RetryNodeAntiAffinity) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RetryNodeAntiAffinity proto.InternalMessageInfo
 
+func (m *RetryOn) Reset()      { *m = RetryOn{} }
+func (*RetryOn) ProtoMessage() {}
+func (*RetryOn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{115}
+}
+func (m *RetryOn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryOn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RetryOn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryOn.Merge(m, src)
+}
+func (m *RetryOn) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryOn) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryOn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryOn proto.InternalMessageInfo
+
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{103}
+	return fileDescriptor_724696e352c3df5f, []int{116}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2951,7 +3315,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{104}
+	return fileDescriptor_724696e352c3df5f, []int{117}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2979,7 +3343,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3ArtifactRepository) Reset()      { *m = S3ArtifactRepository{} }
 func (*S3ArtifactRepository) ProtoMessage() {}
 func (*S3ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{105}
+	return fileDescriptor_724696e352c3df5f, []int{118}
 }
 func (m *S3ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3007,7 +3371,7 @@ var xxx_messageInfo_S3ArtifactRepository proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{106}
+	return fileDescriptor_724696e352c3df5f, []int{119}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3035,7 +3399,7 @@ var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 func (m *S3EncryptionOptions) Reset()      { *m = S3EncryptionOptions{} }
 func (*S3EncryptionOptions) ProtoMessage() {}
 func (*S3EncryptionOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{107}
+	return fileDescriptor_724696e352c3df5f, []int{120}
 }
 func (m *S3EncryptionOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3063,7 +3427,7 @@ var xxx_messageInfo_S3EncryptionOptions proto.InternalMessageInfo
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{108}
+	return fileDescriptor_724696e352c3df5f, []int{121}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3091,7 +3455,7 @@ var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 func (m *SemaphoreHolding) Reset()      { *m = SemaphoreHolding{} }
 func (*SemaphoreHolding) ProtoMessage() {}
 func (*SemaphoreHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{109}
+	return fileDescriptor_724696e352c3df5f, []int{122}
 }
 func (m *SemaphoreHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3119,7 +3483,7 @@ var xxx_messageInfo_SemaphoreHolding proto.InternalMessageInfo
 func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
 func (*SemaphoreRef) ProtoMessage() {}
 func (*SemaphoreRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{110}
+	return fileDescriptor_724696e352c3df5f, []int{123}
 }
 func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3147,7 +3511,7 @@ var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
 func (m *SemaphoreStatus) Reset()      { *m = SemaphoreStatus{} }
 func (*SemaphoreStatus) ProtoMessage() {}
 func (*SemaphoreStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{111}
+	return fileDescriptor_724696e352c3df5f, []int{124}
 }
 func (m *SemaphoreStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3175,7 +3539,7 @@ var xxx_messageInfo_SemaphoreStatus proto.InternalMessageInfo
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{112}
+	return fileDescriptor_724696e352c3df5f, []int{125}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3203,7 +3567,7 @@ var xxx_messageInfo_Sequence proto.InternalMessageInfo
 func (m *StopStrategy) Reset()      { *m = StopStrategy{} }
 func (*StopStrategy) ProtoMessage() {}
 func (*StopStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{113}
+	return fileDescriptor_724696e352c3df5f, []int{126}
 }
 func (m *StopStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3231,7 +3595,7 @@ var xxx_messageInfo_StopStrategy proto.InternalMessageInfo
 func (m *Submit) Reset()      { *m = Submit{} }
 func (*Submit) ProtoMessage() {}
 func (*Submit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{114}
+	return fileDescriptor_724696e352c3df5f, []int{127}
 }
 func (m *Submit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3259,7 +3623,7 @@ var xxx_messageInfo_Submit proto.InternalMessageInfo
 func (m *SubmitOpts) Reset()      { *m = SubmitOpts{} }
 func (*SubmitOpts) ProtoMessage() {}
 func (*SubmitOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{115}
+	return fileDescriptor_724696e352c3df5f, []int{128}
 }
 func (m *SubmitOpts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3287,7 +3651,7 @@ var xxx_messageInfo_SubmitOpts proto.InternalMessageInfo
 func (m *SuppliedValueFrom) Reset()      { *m = SuppliedValueFrom{} }
 func (*SuppliedValueFrom) ProtoMessage() {}
 func (*SuppliedValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{116}
+	return fileDescriptor_724696e352c3df5f, []int{129}
 }
 func (m *SuppliedValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3315,7 +3679,7 @@ var xxx_messageInfo_SuppliedValueFrom proto.InternalMessageInfo
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{117}
+	return fileDescriptor_724696e352c3df5f, []int{130}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3343,7 +3707,7 @@ var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 func (m *SyncDatabaseRef) Reset()      { *m = SyncDatabaseRef{} }
 func (*SyncDatabaseRef) ProtoMessage() {}
 func (*SyncDatabaseRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{118}
+	return fileDescriptor_724696e352c3df5f, []int{131}
 }
 func (m *SyncDatabaseRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3371,7 +3735,7 @@ var xxx_messageInfo_SyncDatabaseRef proto.InternalMessageInfo
 func (m *Synchronization) Reset()      { *m = Synchronization{} }
 func (*Synchronization) ProtoMessage() {}
 func (*Synchronization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{119}
+	return fileDescriptor_724696e352c3df5f, []int{132}
 }
 func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3399,7 +3763,7 @@ var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
 func (*SynchronizationStatus) ProtoMessage() {}
 func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{120}
+	return fileDescriptor_724696e352c3df5f, []int{133}
 }
 func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3427,7 +3791,7 @@ var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{121}
+	return fileDescriptor_724696e352c3df5f, []int{134}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3455,7 +3819,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
 func (*TarStrategy) ProtoMessage() {}
 func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{122}
+	return fileDescriptor_724696e352c3df5f, []int{135}
 }
 func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3483,7 +3847,7 @@ var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{123}
+	return fileDescriptor_724696e352c3df5f, []int{136}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3511,7 +3875,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{124}
+	return fileDescriptor_724696e352c3df5f, []int{137}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3539,7 +3903,7 @@ var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 func (m *TransformationStep) Reset()      { *m = TransformationStep{} }
 func (*TransformationStep) ProtoMessage() {}
 func (*TransformationStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{125}
+	return fileDescriptor_724696e352c3df5f, []int{138}
 }
 func (m *TransformationStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3567,7 +3931,7 @@ var xxx_messageInfo_TransformationStep proto.InternalMessageInfo
 func (m *UserContainer) Reset()      { *m = UserContainer{} }
 func (*UserContainer) ProtoMessage() {}
 func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{126}
+	return fileDescriptor_724696e352c3df5f, []int{139}
 }
 func (m *UserContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3595,7 +3959,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{127}
+	return fileDescriptor_724696e352c3df5f, []int{140}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3623,7 +3987,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *Version) Reset()      { *m = Version{} }
 func (*Version) ProtoMessage() {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{128}
+	return fileDescriptor_724696e352c3df5f, []int{141}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3651,7 +4015,7 @@ var xxx_messageInfo_Version proto.InternalMessageInfo
 func (m *VolumeClaimGC) Reset()      { *m = VolumeClaimGC{} }
 func (*VolumeClaimGC) ProtoMessage() {}
 func (*VolumeClaimGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{129}
+	return fileDescriptor_724696e352c3df5f, []int{142}
 }
 func (m *VolumeClaimGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3676,10 +4040,94 @@ func (m *VolumeClaimGC) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VolumeClaimGC proto.InternalMessageInfo
 
+func (m *WaitForResource) Reset()      { *m = WaitForResource{} }
+func (*WaitForResource) ProtoMessage() {}
+func (*WaitForResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{143}
+}
+func (m *WaitForResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WaitForResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WaitForResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitForResource.Merge(m, src)
+}
+func (m *WaitForResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *WaitForResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitForResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitForResource proto.InternalMessageInfo
+
+func (m *WebhookEventSource) Reset()      { *m = WebhookEventSource{} }
+func (*WebhookEventSource) ProtoMessage() {}
+func (*WebhookEventSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{144}
+}
+func (m *WebhookEventSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookEventSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookEventSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookEventSource.Merge(m, src)
+}
+func (m *WebhookEventSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookEventSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookEventSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookEventSource proto.InternalMessageInfo
+
+func (m *WithParamArtifact) Reset()      { *m = WithParamArtifact{} }
+func (*WithParamArtifact) ProtoMessage() {}
+func (*WithParamArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{145}
+}
+func (m *WithParamArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithParamArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WithParamArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithParamArtifact.Merge(m, src)
+}
+func (m *WithParamArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithParamArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithParamArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithParamArtifact proto.InternalMessageInfo
+
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{130}
+	return fileDescriptor_724696e352c3df5f, []int{146}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3707,7 +4155,7 @@ var xxx_messageInfo_Workflow proto.InternalMessageInfo
 func (m *WorkflowArtifactGCTask) Reset()      { *m = WorkflowArtifactGCTask{} }
 func (*WorkflowArtifactGCTask) ProtoMessage() {}
 func (*WorkflowArtifactGCTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{131}
+	return fileDescriptor_724696e352c3df5f, []int{147}
 }
 func (m *WorkflowArtifactGCTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3735,7 +4183,7 @@ var xxx_messageInfo_WorkflowArtifactGCTask proto.InternalMessageInfo
 func (m *WorkflowArtifactGCTaskList) Reset()      { *m = WorkflowArtifactGCTaskList{} }
 func (*WorkflowArtifactGCTaskList) ProtoMessage() {}
 func (*WorkflowArtifactGCTaskList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{132}
+	return fileDescriptor_724696e352c3df5f, []int{148}
 }
 func (m *WorkflowArtifactGCTaskList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3763,7 +4211,7 @@ var xxx_messageInfo_WorkflowArtifactGCTaskList proto.InternalMessageInfo
 func (m *WorkflowEventBinding) Reset()      { *m = WorkflowEventBinding{} }
 func (*WorkflowEventBinding) ProtoMessage() {}
 func (*WorkflowEventBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{133}
+	return fileDescriptor_724696e352c3df5f, []int{149}
 }
 func (m *WorkflowEventBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3791,7 +4239,7 @@ var xxx_messageInfo_WorkflowEventBinding proto.InternalMessageInfo
 func (m *WorkflowEventBindingList) Reset()      { *m = WorkflowEventBindingList{} }
 func (*WorkflowEventBindingList) ProtoMessage() {}
 func (*WorkflowEventBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{134}
+	return fileDescriptor_724696e352c3df5f, []int{150}
 }
 func (m *WorkflowEventBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3816,10 +4264,38 @@ func (m *WorkflowEventBindingList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WorkflowEventBindingList proto.InternalMessageInfo
 
+func (m *WorkflowEventBindingRef) Reset()      { *m = WorkflowEventBindingRef{} }
+func (*WorkflowEventBindingRef) ProtoMessage() {}
+func (*WorkflowEventBindingRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{151}
+}
+func (m *WorkflowEventBindingRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowEventBindingRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowEventBindingRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowEventBindingRef.Merge(m, src)
+}
+func (m *WorkflowEventBindingRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowEventBindingRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowEventBindingRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowEventBindingRef proto.InternalMessageInfo
+
 func (m *WorkflowEventBindingSpec) Reset()      { *m = WorkflowEventBindingSpec{} }
 func (*WorkflowEventBindingSpec) ProtoMessage() {}
 func (*WorkflowEventBindingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{135}
+	return fileDescriptor_724696e352c3df5f, []int{152}
 }
 func (m *WorkflowEventBindingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3844,10 +4320,94 @@ func (m *WorkflowEventBindingSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WorkflowEventBindingSpec proto.InternalMessageInfo
 
+func (m *WorkflowEventSource) Reset()      { *m = WorkflowEventSource{} }
+func (*WorkflowEventSource) ProtoMessage() {}
+func (*WorkflowEventSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{153}
+}
+func (m *WorkflowEventSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowEventSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowEventSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowEventSource.Merge(m, src)
+}
+func (m *WorkflowEventSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowEventSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowEventSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowEventSource proto.InternalMessageInfo
+
+func (m *WorkflowEventSourceList) Reset()      { *m = WorkflowEventSourceList{} }
+func (*WorkflowEventSourceList) ProtoMessage() {}
+func (*WorkflowEventSourceList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{154}
+}
+func (m *WorkflowEventSourceList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowEventSourceList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowEventSourceList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowEventSourceList.Merge(m, src)
+}
+func (m *WorkflowEventSourceList) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowEventSourceList) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowEventSourceList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowEventSourceList proto.InternalMessageInfo
+
+func (m *WorkflowEventSourceSpec) Reset()      { *m = WorkflowEventSourceSpec{} }
+func (*WorkflowEventSourceSpec) ProtoMessage() {}
+func (*WorkflowEventSourceSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{155}
+}
+func (m *WorkflowEventSourceSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowEventSourceSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowEventSourceSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowEventSourceSpec.Merge(m, src)
+}
+func (m *WorkflowEventSourceSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowEventSourceSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowEventSourceSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowEventSourceSpec proto.InternalMessageInfo
+
 func (m *WorkflowLevelArtifactGC) Reset()      { *m = WorkflowLevelArtifactGC{} }
 func (*WorkflowLevelArtifactGC) ProtoMessage() {}
 func (*WorkflowLevelArtifactGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{136}
+	return fileDescriptor_724696e352c3df5f, []int{156}
 }
 func (m *WorkflowLevelArtifactGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3875,7 +4435,7 @@ var xxx_messageInfo_WorkflowLevelArtifactGC proto.InternalMessageInfo
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{137}
+	return fileDescriptor_724696e352c3df5f, []int{157}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3903,7 +4463,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowMetadata) Reset()      { *m = WorkflowMetadata{} }
 func (*WorkflowMetadata) ProtoMessage() {}
 func (*WorkflowMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{138}
+	return fileDescriptor_724696e352c3df5f, []int{158}
 }
 func (m *WorkflowMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3928,10 +4488,38 @@ func (m *WorkflowMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WorkflowMetadata proto.InternalMessageInfo
 
+func (m *WorkflowScopedAntiAffinity) Reset()      { *m = WorkflowScopedAntiAffinity{} }
+func (*WorkflowScopedAntiAffinity) ProtoMessage() {}
+func (*WorkflowScopedAntiAffinity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{159}
+}
+func (m *WorkflowScopedAntiAffinity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowScopedAntiAffinity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowScopedAntiAffinity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowScopedAntiAffinity.Merge(m, src)
+}
+func (m *WorkflowScopedAntiAffinity) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowScopedAntiAffinity) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowScopedAntiAffinity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowScopedAntiAffinity proto.InternalMessageInfo
+
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{139}
+	return fileDescriptor_724696e352c3df5f, []int{160}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3959,7 +4547,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{140}
+	return fileDescriptor_724696e352c3df5f, []int{161}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3987,7 +4575,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{141}
+	return fileDescriptor_724696e352c3df5f, []int{162}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4015,7 +4603,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTaskResult) Reset()      { *m = WorkflowTaskResult{} }
 func (*WorkflowTaskResult) ProtoMessage() {}
 func (*WorkflowTaskResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{142}
+	return fileDescriptor_724696e352c3df5f, []int{163}
 }
 func (m *WorkflowTaskResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4043,7 +4631,7 @@ var xxx_messageInfo_WorkflowTaskResult proto.InternalMessageInfo
 func (m *WorkflowTaskResultList) Reset()      { *m = WorkflowTaskResultList{} }
 func (*WorkflowTaskResultList) ProtoMessage() {}
 func (*WorkflowTaskResultList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{143}
+	return fileDescriptor_724696e352c3df5f, []int{164}
 }
 func (m *WorkflowTaskResultList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4071,7 +4659,7 @@ var xxx_messageInfo_WorkflowTaskResultList proto.InternalMessageInfo
 func (m *WorkflowTaskSet) Reset()      { *m = WorkflowTaskSet{} }
 func (*WorkflowTaskSet) ProtoMessage() {}
 func (*WorkflowTaskSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{144}
+	return fileDescriptor_724696e352c3df5f, []int{165}
 }
 func (m *WorkflowTaskSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4099,7 +4687,7 @@ var xxx_messageInfo_WorkflowTaskSet proto.InternalMessageInfo
 func (m *WorkflowTaskSetList) Reset()      { *m = WorkflowTaskSetList{} }
 func (*WorkflowTaskSetList) ProtoMessage() {}
 func (*WorkflowTaskSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{145}
+	return fileDescriptor_724696e352c3df5f, []int{166}
 }
 func (m *WorkflowTaskSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4127,7 +4715,7 @@ var xxx_messageInfo_WorkflowTaskSetList proto.InternalMessageInfo
 func (m *WorkflowTaskSetSpec) Reset()      { *m = WorkflowTaskSetSpec{} }
 func (*WorkflowTaskSetSpec) ProtoMessage() {}
 func (*WorkflowTaskSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{146}
+	return fileDescriptor_724696e352c3df5f, []int{167}
 }
 func (m *WorkflowTaskSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4155,7 +4743,7 @@ var xxx_messageInfo_WorkflowTaskSetSpec proto.InternalMessageInfo
 func (m *WorkflowTaskSetStatus) Reset()      { *m = WorkflowTaskSetStatus{} }
 func (*WorkflowTaskSetStatus) ProtoMessage() {}
 func (*WorkflowTaskSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{147}
+	return fileDescriptor_724696e352c3df5f, []int{168}
 }
 func (m *WorkflowTaskSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4183,7 +4771,7 @@ var xxx_messageInfo_WorkflowTaskSetStatus proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{148}
+	return fileDescriptor_724696e352c3df5f, []int{169}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4211,7 +4799,7 @@ var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{149}
+	return fileDescriptor_724696e352c3df5f, []int{170}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4239,7 +4827,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateRef) Reset()      { *m = WorkflowTemplateRef{} }
 func (*WorkflowTemplateRef) ProtoMessage() {}
 func (*WorkflowTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{150}
+	return fileDescriptor_724696e352c3df5f, []int{171}
 }
 func (m *WorkflowTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4267,7 +4855,7 @@ var xxx_messageInfo_WorkflowTemplateRef proto.InternalMessageInfo
 func (m *ZipStrategy) Reset()      { *m = ZipStrategy{} }
 func (*ZipStrategy) ProtoMessage() {}
 func (*ZipStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{151}
+	return fileDescriptor_724696e352c3df5f, []int{172}
 }
 func (m *ZipStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4294,6 +4882,7 @@ var xxx_messageInfo_ZipStrategy proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Amount)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Amount")
+	proto.RegisterType((*AnnotationValueFrom)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AnnotationValueFrom")
 	proto.RegisterType((*ArchiveStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArchiveStrategy")
 	proto.RegisterType((*Arguments)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Arguments")
 	proto.RegisterType((*ArtGCStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtGCStatus")
@@ -4306,9 +4895,11 @@ func init() {
 	proto.RegisterType((*ArtifactGCStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactGCStatus")
 	proto.RegisterMapType((map[string]ArtifactResultNodeStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactGCStatus.ArtifactResultsByNodeEntry")
 	proto.RegisterType((*ArtifactLocation)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactLocation")
+	proto.RegisterType((*ArtifactNaming)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactNaming")
 	proto.RegisterType((*ArtifactNodeSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactNodeSpec")
 	proto.RegisterMapType((map[string]Artifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactNodeSpec.ArtifactsEntry")
 	proto.RegisterType((*ArtifactPaths)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactPaths")
+	proto.RegisterType((*ArtifactRenamePolicy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRenamePolicy")
 	proto.RegisterType((*ArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRepository")
 	proto.RegisterType((*ArtifactRepositoryRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRepositoryRef")
 	proto.RegisterType((*ArtifactRepositoryRefStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRepositoryRefStatus")
@@ -4348,7 +4939,9 @@ func init() {
 	proto.RegisterType((*DAGTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTemplate")
 	proto.RegisterType((*Data)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Data")
 	proto.RegisterType((*DataSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DataSource")
+	proto.RegisterType((*EntrypointRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.EntrypointRef")
 	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Event")
+	proto.RegisterType((*EventSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.EventSource")
 	proto.RegisterType((*ExecutorConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ExecutorConfig")
 	proto.RegisterType((*GCSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSArtifact")
 	proto.RegisterType((*GCSArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSArtifactRepository")
@@ -4365,8 +4958,11 @@ func init() {
 	proto.RegisterType((*HTTPBodySource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPBodySource")
 	proto.RegisterType((*HTTPHeader)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPHeader")
 	proto.RegisterType((*HTTPHeaderSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPHeaderSource")
+	proto.RegisterType((*HTTPTLSConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPTLSConfig")
 	proto.RegisterType((*Header)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Header")
 	proto.RegisterType((*Histogram)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Histogram")
+	proto.RegisterType((*ImageLabelSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ImageLabelSource")
+	proto.RegisterType((*ImageRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ImageRef")
 	proto.RegisterType((*Inputs)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Inputs")
 	proto.RegisterType((*Item)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Item")
 	proto.RegisterType((*LabelKeys)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.LabelKeys")
@@ -4385,6 +4981,7 @@ func init() {
 	proto.RegisterType((*Mutex)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Mutex")
 	proto.RegisterType((*MutexHolding)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.MutexHolding")
 	proto.RegisterType((*MutexStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.MutexStatus")
+	proto.RegisterType((*NamedPipeArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NamedPipeArtifact")
 	proto.RegisterType((*NodeFlag)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeFlag")
 	proto.RegisterType((*NodeResult)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeResult")
 	proto.RegisterType((*NodeStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeStatus")
@@ -4398,16 +4995,20 @@ func init() {
 	proto.RegisterType((*OSSBucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OSSBucket")
 	proto.RegisterType((*OSSLifecycleRule)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OSSLifecycleRule")
 	proto.RegisterType((*Object)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Object")
+	proto.RegisterType((*OutputParameterRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OutputParameterRef")
 	proto.RegisterType((*Outputs)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Outputs")
 	proto.RegisterType((*ParallelSteps)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParallelSteps")
 	proto.RegisterType((*Parameter)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Parameter")
+	proto.RegisterType((*PersistentVolumeClaimSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PersistentVolumeClaimSource")
 	proto.RegisterType((*Plugin)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Plugin")
 	proto.RegisterType((*PodGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PodGC")
+	proto.RegisterType((*Preprocessor)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Preprocessor")
 	proto.RegisterType((*Prometheus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Prometheus")
 	proto.RegisterType((*RawArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RawArtifact")
 	proto.RegisterType((*ResourceTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceTemplate")
 	proto.RegisterType((*RetryAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryAffinity")
 	proto.RegisterType((*RetryNodeAntiAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryNodeAntiAffinity")
+	proto.RegisterType((*RetryOn)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryOn")
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryStrategy")
 	proto.RegisterType((*S3Artifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3Artifact")
 	proto.RegisterType((*S3ArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3ArtifactRepository")
@@ -4437,18 +5038,26 @@ func init() {
 	proto.RegisterType((*ValueFrom)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ValueFrom")
 	proto.RegisterType((*Version)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Version")
 	proto.RegisterType((*VolumeClaimGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.VolumeClaimGC")
+	proto.RegisterType((*WaitForResource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WaitForResource")
+	proto.RegisterType((*WebhookEventSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WebhookEventSource")
+	proto.RegisterType((*WithParamArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WithParamArtifact")
 	proto.RegisterType((*Workflow)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Workflow")
 	proto.RegisterType((*WorkflowArtifactGCTask)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowArtifactGCTask")
 	proto.RegisterType((*WorkflowArtifactGCTaskList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowArtifactGCTaskList")
 	proto.RegisterType((*WorkflowEventBinding)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowEventBinding")
 	proto.RegisterType((*WorkflowEventBindingList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowEventBindingList")
+	proto.RegisterType((*WorkflowEventBindingRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowEventBindingRef")
 	proto.RegisterType((*WorkflowEventBindingSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowEventBindingSpec")
+	proto.RegisterType((*WorkflowEventSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowEventSource")
+	proto.RegisterType((*WorkflowEventSourceList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowEventSourceList")
+	proto.RegisterType((*WorkflowEventSourceSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowEventSourceSpec")
 	proto.RegisterType((*WorkflowLevelArtifactGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowLevelArtifactGC")
 	proto.RegisterType((*WorkflowList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowList")
 	proto.RegisterType((*WorkflowMetadata)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowMetadata")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowMetadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowMetadata.LabelsEntry")
 	proto.RegisterMapType((map[string]LabelValueFrom)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowMetadata.LabelsFromEntry")
+	proto.RegisterType((*WorkflowScopedAntiAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowScopedAntiAffinity")
 	proto.RegisterType((*WorkflowSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowSpec")
 	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowSpec.HooksEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowSpec.NodeSelectorEntry")
@@ -4478,712 +5087,824 @@ func init() {
 }
 
 var fileDescriptor_724696e352c3df5f = []byte{
-	// 11278 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x6b, 0x70, 0x64, 0xc7,
-	0x75, 0x18, 0xcc, 0x3b, 0xc0, 0xe0, 0x71, 0x06, 0xc0, 0x62, 0x7b, 0x5f, 0x43, 0x90, 0x5c, 0xd0,
-	0x97, 0x22, 0x3f, 0xd2, 0xa6, 0xb0, 0xe6, 0x52, 0xfa, 0xc2, 0x48, 0x89, 0x24, 0x3c, 0x16, 0xd8,
-	0x25, 0x80, 0x05, 0xd8, 0x83, 0xe5, 0x9a, 0x14, 0x2d, 0xe9, 0x62, 0xa6, 0x31, 0x73, 0x89, 0x99,
-	0x7b, 0x87, 0xf7, 0xde, 0x01, 0x16, 0x7c, 0x48, 0x0a, 0xf5, 0xa2, 0x62, 0xd9, 0x8a, 0x65, 0x4a,
-	0x96, 0xe4, 0x24, 0xa5, 0x28, 0x52, 0xa2, 0x92, 0x5d, 0x49, 0xd9, 0xbf, 0x12, 0xfb, 0x5f, 0x7e,
-	0xb8, 0x94, 0x72, 0x2a, 0xb1, 0x2b, 0x4a, 0x59, 0x3f, 0x62, 0x30, 0x82, 0x13, 0x55, 0x2a, 0x29,
-	0xfd, 0xb0, 0x2a, 0x4e, 0xe2, 0xcd, 0xa3, 0x52, 0xfd, 0xbc, 0xdd, 0x77, 0xee, 0x60, 0x01, 0x6c,
-	0x03, 0xab, 0xb2, 0x7f, 0x01, 0x73, 0xfa, 0xf4, 0x39, 0xdd, 0x7d, 0xbb, 0x4f, 0x9f, 0x3e, 0xe7,
-	0xf4, 0x69, 0x58, 0xad, 0xfb, 0x49, 0xa3, 0xb3, 0x3e, 0x55, 0x0d, 0x5b, 0x97, 0xbc, 0xa8, 0x1e,
-	0xb6, 0xa3, 0xf0, 0x65, 0xf6, 0xcf, 0xbb, 0xb7, 0xc3, 0x68, 0x73, 0xa3, 0x19, 0x6e, 0xc7, 0x97,
-	0xb6, 0x9e, 0xbe, 0xd4, 0xde, 0xac, 0x5f, 0xf2, 0xda, 0x7e, 0x7c, 0x49, 0x42, 0x2f, 0x6d, 0x3d,
-	0xe5, 0x35, 0xdb, 0x0d, 0xef, 0xa9, 0x4b, 0x75, 0x12, 0x90, 0xc8, 0x4b, 0x48, 0x6d, 0xaa, 0x1d,
-	0x85, 0x49, 0x88, 0x3e, 0x94, 0x52, 0x9c, 0x92, 0x14, 0xd9, 0x3f, 0x1f, 0x55, 0x14, 0xa7, 0xb6,
-	0x9e, 0x9e, 0x6a, 0x6f, 0xd6, 0xa7, 0x28, 0xc5, 0x29, 0x09, 0x9d, 0x92, 0x14, 0x27, 0xde, 0xad,
-	0xb5, 0xa9, 0x1e, 0xd6, 0xc3, 0x4b, 0x8c, 0xf0, 0x7a, 0x67, 0x83, 0xfd, 0x62, 0x3f, 0xd8, 0x7f,
-	0x9c, 0xe1, 0x84, 0xbb, 0xf9, 0x4c, 0x3c, 0xe5, 0x87, 0xb4, 0x7d, 0x97, 0xaa, 0x61, 0x44, 0x2e,
-	0x6d, 0x75, 0x35, 0x6a, 0xe2, 0x5d, 0x1a, 0x4e, 0x3b, 0x6c, 0xfa, 0xd5, 0x9d, 0x3c, 0xac, 0xf7,
-	0xa4, 0x58, 0x2d, 0xaf, 0xda, 0xf0, 0x03, 0x12, 0xed, 0xa4, 0x5d, 0x6f, 0x91, 0xc4, 0xcb, 0xab,
-	0x75, 0xa9, 0x57, 0xad, 0xa8, 0x13, 0x24, 0x7e, 0x8b, 0x74, 0x55, 0xf8, 0xff, 0xef, 0x54, 0x21,
-	0xae, 0x36, 0x48, 0xcb, 0xeb, 0xaa, 0xf7, 0x74, 0xaf, 0x7a, 0x9d, 0xc4, 0x6f, 0x5e, 0xf2, 0x83,
-	0x24, 0x4e, 0xa2, 0x6c, 0x25, 0xf7, 0x0a, 0x0c, 0x4c, 0xb7, 0xc2, 0x4e, 0x90, 0xa0, 0xf7, 0x43,
-	0x71, 0xcb, 0x6b, 0x76, 0x48, 0xd9, 0x79, 0xd8, 0x79, 0x7c, 0x78, 0xe6, 0xd1, 0xef, 0xed, 0x4e,
-	0xde, 0xb7, 0xb7, 0x3b, 0x59, 0x7c, 0x9e, 0x02, 0x6f, 0xef, 0x4e, 0x9e, 0x25, 0x41, 0x35, 0xac,
-	0xf9, 0x41, 0xfd, 0xd2, 0xcb, 0x71, 0x18, 0x4c, 0x5d, 0xef, 0xb4, 0xd6, 0x49, 0x84, 0x79, 0x1d,
-	0xf7, 0xdf, 0x16, 0xe0, 0xd4, 0x74, 0x54, 0x6d, 0xf8, 0x5b, 0xa4, 0x92, 0x50, 0xfa, 0xf5, 0x1d,
-	0xd4, 0x80, 0xbe, 0xc4, 0x8b, 0x18, 0xb9, 0xd2, 0xe5, 0xe5, 0xa9, 0xbb, 0xfd, 0xee, 0x53, 0x6b,
-	0x5e, 0x24, 0x69, 0xcf, 0x0c, 0xee, 0xed, 0x4e, 0xf6, 0xad, 0x79, 0x11, 0xa6, 0x2c, 0x50, 0x13,
-	0xfa, 0x83, 0x30, 0x20, 0xe5, 0x02, 0x63, 0x75, 0xfd, 0xee, 0x59, 0x5d, 0x0f, 0x03, 0xd5, 0x8f,
-	0x99, 0xa1, 0xbd, 0xdd, 0xc9, 0x7e, 0x0a, 0xc1, 0x8c, 0x0b, 0xed, 0xd7, 0xab, 0x7e, 0xbb, 0xdc,
-	0x67, 0xab, 0x5f, 0x2f, 0xfa, 0x6d, 0xb3, 0x5f, 0x2f, 0xfa, 0x6d, 0x4c, 0x59, 0xb8, 0x9f, 0x2f,
-	0xc0, 0xf0, 0x74, 0x54, 0xef, 0xb4, 0x48, 0x90, 0xc4, 0xe8, 0x13, 0x00, 0x6d, 0x2f, 0xf2, 0x5a,
-	0x24, 0x21, 0x51, 0x5c, 0x76, 0x1e, 0xee, 0x7b, 0xbc, 0x74, 0x79, 0xf1, 0xee, 0xd9, 0xaf, 0x4a,
-	0x9a, 0x33, 0x48, 0x7c, 0x72, 0x50, 0xa0, 0x18, 0x6b, 0x2c, 0xd1, 0x6b, 0x30, 0xec, 0x45, 0x89,
-	0xbf, 0xe1, 0x55, 0x93, 0xb8, 0x5c, 0x60, 0xfc, 0x9f, 0xbd, 0x7b, 0xfe, 0xd3, 0x82, 0xe4, 0xcc,
-	0x69, 0xc1, 0x7e, 0x58, 0x42, 0x62, 0x9c, 0xf2, 0x73, 0x7f, 0xb7, 0x1f, 0x4a, 0xd3, 0x51, 0xb2,
-	0x30, 0x5b, 0x49, 0xbc, 0xa4, 0x13, 0xa3, 0x3f, 0x70, 0xe0, 0x4c, 0xcc, 0x87, 0xcd, 0x27, 0xf1,
-	0x6a, 0x14, 0x56, 0x49, 0x1c, 0x93, 0x9a, 0x18, 0x97, 0x0d, 0x2b, 0xed, 0x92, 0xcc, 0xa6, 0x2a,
-	0xdd, 0x8c, 0xae, 0x04, 0x49, 0xb4, 0x33, 0xf3, 0x94, 0x68, 0xf3, 0x99, 0x1c, 0x8c, 0x37, 0xdf,
-	0x99, 0x44, 0xb2, 0x2b, 0x94, 0x12, 0xff, 0xc4, 0x38, 0xaf, 0xd5, 0xe8, 0x6b, 0x0e, 0x8c, 0xb4,
-	0xc3, 0x5a, 0x8c, 0x49, 0x35, 0xec, 0xb4, 0x49, 0x4d, 0x0c, 0xef, 0x47, 0xed, 0x76, 0x63, 0x55,
-	0xe3, 0xc0, 0xdb, 0x7f, 0x56, 0xb4, 0x7f, 0x44, 0x2f, 0xc2, 0x46, 0x53, 0xd0, 0x33, 0x30, 0x12,
-	0x84, 0x49, 0xa5, 0x4d, 0xaa, 0xfe, 0x86, 0x4f, 0x6a, 0x6c, 0xe2, 0x0f, 0xa5, 0x35, 0xaf, 0x6b,
-	0x65, 0xd8, 0xc0, 0x9c, 0x98, 0x87, 0x72, 0xaf, 0x91, 0x43, 0xe3, 0xd0, 0xb7, 0x49, 0x76, 0xb8,
-	0xb0, 0xc1, 0xf4, 0x5f, 0x74, 0x56, 0x0a, 0x20, 0xba, 0x8c, 0x87, 0x84, 0x64, 0x79, 0x5f, 0xe1,
-	0x19, 0x67, 0xe2, 0x83, 0x70, 0xba, 0xab, 0xe9, 0x87, 0x21, 0xe0, 0x7e, 0x6e, 0x10, 0x86, 0xe4,
-	0xa7, 0x40, 0x0f, 0x43, 0x7f, 0xe0, 0xb5, 0xa4, 0x9c, 0x1b, 0x11, 0xfd, 0xe8, 0xbf, 0xee, 0xb5,
-	0xe8, 0x0a, 0xf7, 0x5a, 0x84, 0x62, 0xb4, 0xbd, 0xa4, 0xc1, 0xe8, 0x68, 0x18, 0xab, 0x5e, 0xd2,
-	0xc0, 0xac, 0x04, 0x3d, 0x08, 0xfd, 0xad, 0xb0, 0x46, 0xd8, 0x58, 0x14, 0xb9, 0x84, 0x58, 0x0e,
-	0x6b, 0x04, 0x33, 0x28, 0xad, 0xbf, 0x11, 0x85, 0xad, 0x72, 0xbf, 0x59, 0x7f, 0x3e, 0x0a, 0x5b,
-	0x98, 0x95, 0xa0, 0xaf, 0x3a, 0x30, 0x2e, 0xe7, 0xf6, 0x52, 0x58, 0xf5, 0x12, 0x3f, 0x0c, 0xca,
-	0x45, 0x26, 0x51, 0xb0, 0xbd, 0x25, 0x25, 0x29, 0xcf, 0x94, 0x45, 0x13, 0xc6, 0xb3, 0x25, 0xb8,
-	0xab, 0x15, 0xe8, 0x32, 0x40, 0xbd, 0x19, 0xae, 0x7b, 0x4d, 0x3a, 0x20, 0xe5, 0x01, 0xd6, 0x05,
-	0x25, 0x19, 0x16, 0x54, 0x09, 0xd6, 0xb0, 0xd0, 0x2d, 0x18, 0xf4, 0xb8, 0xf4, 0x2f, 0x0f, 0xb2,
-	0x4e, 0x3c, 0x67, 0xa3, 0x13, 0xc6, 0x76, 0x32, 0x53, 0xda, 0xdb, 0x9d, 0x1c, 0x14, 0x40, 0x2c,
-	0xd9, 0xa1, 0x27, 0x61, 0x28, 0x6c, 0xd3, 0x76, 0x7b, 0xcd, 0xf2, 0x10, 0x9b, 0x98, 0xe3, 0xa2,
-	0xad, 0x43, 0x2b, 0x02, 0x8e, 0x15, 0x06, 0x7a, 0x02, 0x06, 0xe3, 0xce, 0x3a, 0xfd, 0x8e, 0xe5,
-	0x61, 0xd6, 0xb1, 0x53, 0x02, 0x79, 0xb0, 0xc2, 0xc1, 0x58, 0x96, 0xa3, 0xf7, 0x42, 0x29, 0x22,
-	0xd5, 0x4e, 0x14, 0x13, 0xfa, 0x61, 0xcb, 0xc0, 0x68, 0x9f, 0x11, 0xe8, 0x25, 0x9c, 0x16, 0x61,
-	0x1d, 0x0f, 0x7d, 0x00, 0xc6, 0xe8, 0x07, 0xbe, 0x72, 0xab, 0x1d, 0x91, 0x38, 0xa6, 0x5f, 0xb5,
-	0xc4, 0x18, 0x9d, 0x17, 0x35, 0xc7, 0xe6, 0x8d, 0x52, 0x9c, 0xc1, 0x46, 0xaf, 0x03, 0x78, 0x4a,
-	0x66, 0x94, 0x47, 0xd8, 0x60, 0x2e, 0xd9, 0x9b, 0x11, 0x0b, 0xb3, 0x33, 0x63, 0xf4, 0x3b, 0xa6,
-	0xbf, 0xb1, 0xc6, 0x8f, 0x8e, 0x4f, 0x8d, 0x34, 0x49, 0x42, 0x6a, 0xe5, 0x51, 0xd6, 0x61, 0x35,
-	0x3e, 0x73, 0x1c, 0x8c, 0x65, 0x39, 0x1d, 0x9f, 0x76, 0x44, 0xb6, 0x7c, 0xb2, 0xcd, 0x86, 0x73,
-	0x8c, 0xf5, 0x52, 0x8d, 0xcf, 0x6a, 0x5a, 0x84, 0x75, 0x3c, 0xf7, 0x37, 0x0a, 0xa0, 0x31, 0x47,
-	0x33, 0x30, 0x24, 0xc4, 0xa1, 0x58, 0xc9, 0x33, 0x8f, 0xc9, 0xcf, 0x27, 0x3f, 0xfc, 0xed, 0xdd,
-	0x5c, 0x31, 0xaa, 0xea, 0xa1, 0x37, 0xa0, 0xd4, 0x0e, 0x6b, 0xcb, 0x24, 0xf1, 0x6a, 0x5e, 0xe2,
-	0x09, 0x25, 0xc0, 0xc2, 0xc6, 0x24, 0x29, 0xce, 0x9c, 0x62, 0x3d, 0x4a, 0x59, 0x60, 0x9d, 0x1f,
-	0x7a, 0x16, 0x50, 0x4c, 0xa2, 0x2d, 0xbf, 0x4a, 0xa6, 0xab, 0x55, 0xaa, 0x49, 0xb1, 0x75, 0xd3,
-	0xc7, 0x3a, 0x33, 0x21, 0x3a, 0x83, 0x2a, 0x5d, 0x18, 0x38, 0xa7, 0x96, 0xfb, 0xfd, 0x02, 0x8c,
-	0x69, 0x7d, 0x6d, 0x93, 0x2a, 0xfa, 0x8e, 0x03, 0xa7, 0xd4, 0x2e, 0x38, 0xb3, 0x73, 0x9d, 0x4e,
-	0x46, 0xbe, 0xc7, 0x11, 0x9b, 0xd3, 0x82, 0xf2, 0x52, 0x3f, 0x05, 0x1f, 0xbe, 0x45, 0x5c, 0x10,
-	0x7d, 0x38, 0x95, 0x29, 0xc5, 0xd9, 0x66, 0x4d, 0x7c, 0xc5, 0x81, 0xb3, 0x79, 0x24, 0x72, 0x44,
-	0x75, 0x43, 0x17, 0xd5, 0x56, 0x65, 0x1e, 0xe5, 0x4a, 0x3b, 0xa3, 0x8b, 0xff, 0xff, 0x5b, 0x80,
-	0x71, 0x7d, 0x0a, 0x31, 0x05, 0xe2, 0x5f, 0x38, 0x70, 0x4e, 0xf6, 0x00, 0x93, 0xb8, 0xd3, 0xcc,
-	0x0c, 0x6f, 0xcb, 0xea, 0xf0, 0xf2, 0x0d, 0x78, 0x3a, 0x8f, 0x1f, 0x1f, 0xe6, 0x87, 0xc4, 0x30,
-	0x9f, 0xcb, 0xc5, 0xc1, 0xf9, 0x4d, 0x9d, 0xf8, 0x96, 0x03, 0x13, 0xbd, 0x89, 0xe6, 0x0c, 0x7c,
-	0xdb, 0x1c, 0xf8, 0x17, 0xed, 0x75, 0x92, 0xb3, 0x67, 0xc3, 0xcf, 0x3a, 0xab, 0x7f, 0x80, 0xdf,
-	0x1a, 0x82, 0xae, 0xad, 0x07, 0x3d, 0x05, 0x25, 0x21, 0xc5, 0x97, 0xc2, 0x7a, 0xcc, 0x1a, 0x39,
-	0xc4, 0xd7, 0xda, 0x74, 0x0a, 0xc6, 0x3a, 0x0e, 0xaa, 0x41, 0x21, 0x7e, 0x5a, 0x34, 0xdd, 0x82,
-	0x54, 0xac, 0x3c, 0xad, 0x94, 0xcf, 0x81, 0xbd, 0xdd, 0xc9, 0x42, 0xe5, 0x69, 0x5c, 0x88, 0x9f,
-	0xa6, 0x0a, 0x7e, 0xdd, 0x4f, 0xec, 0x29, 0xf8, 0x0b, 0x7e, 0xa2, 0xf8, 0x30, 0x05, 0x7f, 0xc1,
-	0x4f, 0x30, 0x65, 0x41, 0x0f, 0x2e, 0x8d, 0x24, 0x69, 0x33, 0x45, 0xc1, 0xca, 0xc1, 0xe5, 0xea,
-	0xda, 0xda, 0xaa, 0xe2, 0xc5, 0xd4, 0x12, 0x0a, 0xc1, 0x8c, 0x0b, 0x7a, 0xcb, 0xa1, 0x23, 0xce,
-	0x0b, 0xc3, 0x68, 0x47, 0xe8, 0x1b, 0x37, 0xec, 0x4d, 0x81, 0x30, 0xda, 0x51, 0xcc, 0xc5, 0x87,
-	0x54, 0x05, 0x58, 0x67, 0xcd, 0x3a, 0x5e, 0xdb, 0x88, 0x99, 0x7a, 0x61, 0xa7, 0xe3, 0x73, 0xf3,
-	0x95, 0x4c, 0xc7, 0xe7, 0xe6, 0x2b, 0x98, 0x71, 0xa1, 0x1f, 0x34, 0xf2, 0xb6, 0x85, 0x6a, 0x62,
-	0xe1, 0x83, 0x62, 0x6f, 0xdb, 0xfc, 0xa0, 0xd8, 0xdb, 0xc6, 0x94, 0x05, 0xe5, 0x14, 0xc6, 0x31,
-	0xd3, 0x44, 0xac, 0x70, 0x5a, 0xa9, 0x54, 0x4c, 0x4e, 0x2b, 0x95, 0x0a, 0xa6, 0x2c, 0xd8, 0x24,
-	0xad, 0xc6, 0x4c, 0x8d, 0xb1, 0x33, 0x49, 0x67, 0x33, 0x9c, 0x16, 0x66, 0x2b, 0x98, 0xb2, 0xa0,
-	0x22, 0xc3, 0x7b, 0xb5, 0x13, 0x71, 0x1d, 0xa8, 0x74, 0x79, 0xc5, 0xc2, 0x7c, 0xa1, 0xe4, 0x14,
-	0xb7, 0xe1, 0xbd, 0xdd, 0xc9, 0x22, 0x03, 0x61, 0xce, 0xc8, 0xfd, 0xfd, 0xbe, 0x54, 0x5c, 0x48,
-	0x79, 0x8e, 0x7e, 0x95, 0x6d, 0x84, 0x42, 0x16, 0x08, 0x8d, 0xd9, 0x39, 0x36, 0x8d, 0xf9, 0x0c,
-	0xdf, 0xf1, 0x0c, 0x76, 0x38, 0xcb, 0x1f, 0x7d, 0xc9, 0xe9, 0x3e, 0x12, 0x7b, 0xf6, 0xf7, 0xb2,
-	0x74, 0x63, 0xe6, 0x7b, 0xc5, 0xbe, 0x27, 0xe5, 0x89, 0xb7, 0x9c, 0x54, 0x89, 0x88, 0x7b, 0xed,
-	0x03, 0x1f, 0x33, 0xf7, 0x01, 0x8b, 0xe7, 0x78, 0x5d, 0xee, 0x7f, 0xde, 0x81, 0x51, 0x09, 0xa7,
-	0xea, 0x5f, 0x8c, 0x6e, 0xc1, 0x90, 0x6c, 0xa9, 0xf8, 0x7a, 0x36, 0x4d, 0x08, 0x4a, 0xf7, 0x57,
-	0x8d, 0x51, 0xdc, 0xdc, 0xef, 0x0c, 0x00, 0x4a, 0xf7, 0xaa, 0x76, 0x18, 0xfb, 0x4c, 0x12, 0x1d,
-	0x61, 0x17, 0x0a, 0xb4, 0x5d, 0xe8, 0x79, 0x9b, 0xbb, 0x50, 0xda, 0x2c, 0x63, 0x3f, 0xfa, 0x52,
-	0x46, 0x6e, 0xf3, 0x8d, 0xe9, 0xa3, 0xc7, 0x22, 0xb7, 0xb5, 0x26, 0xec, 0x2f, 0xc1, 0xb7, 0x84,
-	0x04, 0xe7, 0x5b, 0xd7, 0x2f, 0xd8, 0x95, 0xe0, 0x5a, 0x2b, 0xb2, 0xb2, 0x3c, 0xe2, 0x12, 0x96,
-	0xef, 0x5d, 0x37, 0xad, 0x4a, 0x58, 0x8d, 0xab, 0x29, 0x6b, 0x23, 0x2e, 0x6b, 0x07, 0x6c, 0xf1,
-	0xd4, 0x64, 0x6d, 0x96, 0xa7, 0x92, 0xba, 0xaf, 0x4a, 0xa9, 0xcb, 0x77, 0xad, 0x17, 0x2c, 0x4b,
-	0x5d, 0x8d, 0x6f, 0xb7, 0xfc, 0x7d, 0x05, 0xce, 0x75, 0xe3, 0x61, 0xb2, 0x81, 0x2e, 0xc1, 0x70,
-	0x35, 0x0c, 0x36, 0xfc, 0xfa, 0xb2, 0xd7, 0x16, 0xe7, 0x35, 0x25, 0x8b, 0x66, 0x65, 0x01, 0x4e,
-	0x71, 0xd0, 0x43, 0x5c, 0xf0, 0x70, 0x43, 0x4a, 0x49, 0xa0, 0xf6, 0x2d, 0x92, 0x1d, 0x26, 0x85,
-	0xde, 0x37, 0xf4, 0xd5, 0x6f, 0x4c, 0xde, 0xf7, 0xc9, 0x7f, 0xff, 0xf0, 0x7d, 0xee, 0x1f, 0xf5,
-	0xc1, 0x03, 0xb9, 0x3c, 0x85, 0xb6, 0xfe, 0x5b, 0x86, 0xb6, 0xae, 0x95, 0x0b, 0x29, 0x72, 0xd3,
-	0xa6, 0x22, 0xab, 0x91, 0xcf, 0xd3, 0xcb, 0xb5, 0x62, 0x9c, 0xdf, 0x28, 0x3a, 0x50, 0x81, 0xd7,
-	0x22, 0x71, 0xdb, 0xab, 0x12, 0xd1, 0x7b, 0x35, 0x50, 0xd7, 0x65, 0x01, 0x4e, 0x71, 0xf8, 0xc9,
-	0x7b, 0xc3, 0xeb, 0x34, 0x13, 0x61, 0x5f, 0xd3, 0x4e, 0xde, 0x0c, 0x8c, 0x65, 0x39, 0xfa, 0xbb,
-	0x0e, 0xa0, 0x6e, 0xae, 0x62, 0x21, 0xae, 0x1d, 0xc7, 0x38, 0xcc, 0x9c, 0xdf, 0xd3, 0x0e, 0xe1,
-	0x5a, 0x4f, 0x73, 0xda, 0xa1, 0x7d, 0xd3, 0x8f, 0xa7, 0xfb, 0x10, 0x3f, 0x1c, 0x1c, 0xc0, 0xf4,
-	0xc6, 0x2c, 0x34, 0xd5, 0x2a, 0x89, 0x63, 0x6e, 0xc5, 0xd3, 0x2d, 0x34, 0x0c, 0x8c, 0x65, 0x39,
-	0x9a, 0x84, 0x22, 0x89, 0xa2, 0x30, 0x12, 0x67, 0x6d, 0x36, 0x8d, 0xaf, 0x50, 0x00, 0xe6, 0x70,
-	0xf7, 0x47, 0x05, 0x28, 0xf7, 0x3a, 0x9d, 0xa0, 0xdf, 0xd1, 0xce, 0xd5, 0xe2, 0xe4, 0x24, 0x0e,
-	0x7e, 0xe1, 0xf1, 0x9d, 0x89, 0xb2, 0x07, 0xc0, 0x1e, 0x27, 0x6c, 0x51, 0x8a, 0xb3, 0x0d, 0x9c,
-	0x78, 0x5b, 0x3b, 0x61, 0xeb, 0x24, 0x72, 0x36, 0xf8, 0x0d, 0x73, 0x83, 0x5f, 0xb5, 0xdd, 0x29,
-	0x7d, 0x9b, 0xff, 0x93, 0x22, 0x9c, 0x91, 0xa5, 0x15, 0x42, 0xb7, 0xca, 0xe7, 0x3a, 0x24, 0xda,
-	0x41, 0x7f, 0xec, 0xc0, 0x59, 0x2f, 0x6b, 0xba, 0xf1, 0xc9, 0x31, 0x0c, 0xb4, 0xc6, 0x75, 0x6a,
-	0x3a, 0x87, 0x23, 0x1f, 0xe8, 0xcb, 0x62, 0xa0, 0xcf, 0xe6, 0xa1, 0xf4, 0x30, 0xd7, 0xe7, 0x76,
-	0x00, 0x3d, 0x03, 0x23, 0x12, 0xce, 0xcc, 0x3d, 0x7c, 0x89, 0x2b, 0x9b, 0xf8, 0xb4, 0x56, 0x86,
-	0x0d, 0x4c, 0x5a, 0x33, 0x21, 0xad, 0x76, 0xd3, 0x4b, 0x88, 0x66, 0x28, 0x52, 0x35, 0xd7, 0xb4,
-	0x32, 0x6c, 0x60, 0xa2, 0xc7, 0x60, 0x20, 0x08, 0x6b, 0xe4, 0x5a, 0x4d, 0xd8, 0x95, 0xc7, 0x44,
-	0x9d, 0x81, 0xeb, 0x0c, 0x8a, 0x45, 0x29, 0x7a, 0x34, 0x35, 0xe2, 0x15, 0xd9, 0x12, 0x2a, 0xe5,
-	0x1a, 0xf0, 0xfe, 0x81, 0x03, 0xc3, 0xb4, 0xc6, 0xda, 0x4e, 0x9b, 0xd0, 0xbd, 0x8d, 0x7e, 0x91,
-	0xda, 0xf1, 0x7c, 0x91, 0xeb, 0x92, 0x8d, 0x69, 0xea, 0x18, 0x56, 0xf0, 0x37, 0xdf, 0x99, 0x1c,
-	0x92, 0x3f, 0x70, 0xda, 0xaa, 0x89, 0x05, 0xb8, 0xbf, 0xe7, 0xd7, 0x3c, 0x94, 0x07, 0xe1, 0x6f,
-	0xc0, 0x98, 0xd9, 0x88, 0x43, 0xb9, 0x0f, 0xfe, 0x99, 0xb6, 0xec, 0x78, 0xbf, 0x84, 0x3c, 0xbb,
-	0x67, 0xda, 0xac, 0x9a, 0x0c, 0x73, 0x62, 0xea, 0x99, 0x93, 0x61, 0x4e, 0x4c, 0x86, 0x39, 0xf7,
-	0x0f, 0x9c, 0x74, 0x69, 0x6a, 0x6a, 0x1e, 0xdd, 0x98, 0x3b, 0x51, 0x53, 0x08, 0x62, 0xb5, 0x31,
-	0xdf, 0xc0, 0x4b, 0x98, 0xc2, 0xd1, 0xdb, 0x9a, 0x74, 0xa4, 0xd5, 0x3a, 0xc2, 0x1b, 0x62, 0xc9,
-	0xb2, 0x6f, 0x10, 0xee, 0x96, 0x7f, 0xa2, 0x00, 0x67, 0x9b, 0xe0, 0x7e, 0xa9, 0x00, 0x0f, 0xed,
-	0xab, 0xb4, 0xe6, 0x36, 0xdc, 0xb9, 0xe7, 0x0d, 0xa7, 0xdb, 0x5a, 0x44, 0xda, 0xe1, 0x0d, 0xbc,
-	0x24, 0xbe, 0x97, 0xda, 0xd6, 0x30, 0x07, 0x63, 0x59, 0x4e, 0x55, 0x87, 0x4d, 0xb2, 0x33, 0x1f,
-	0x46, 0x2d, 0x2f, 0x11, 0xd2, 0x41, 0xa9, 0x0e, 0x8b, 0xb2, 0x00, 0xa7, 0x38, 0xee, 0x1f, 0x3b,
-	0x90, 0x6d, 0x00, 0xf2, 0x60, 0xac, 0x13, 0x93, 0x88, 0x6e, 0xa9, 0x15, 0x52, 0x8d, 0x88, 0x9c,
-	0x9e, 0x8f, 0x4e, 0xf1, 0x20, 0x01, 0xda, 0xc3, 0xa9, 0x6a, 0x18, 0x91, 0xa9, 0xad, 0xa7, 0xa6,
-	0x38, 0xc6, 0x22, 0xd9, 0xa9, 0x90, 0x26, 0xa1, 0x34, 0x66, 0xd0, 0xde, 0xee, 0xe4, 0xd8, 0x0d,
-	0x83, 0x00, 0xce, 0x10, 0xa4, 0x2c, 0xda, 0x5e, 0x1c, 0x6f, 0x87, 0x51, 0x4d, 0xb0, 0x28, 0x1c,
-	0x9a, 0xc5, 0xaa, 0x41, 0x00, 0x67, 0x08, 0xba, 0xdf, 0xa7, 0xc7, 0x47, 0x5d, 0x6b, 0x45, 0xdf,
-	0xa0, 0xba, 0x0f, 0x85, 0xcc, 0x34, 0xc3, 0xf5, 0xd9, 0x30, 0x48, 0x3c, 0x3f, 0x20, 0x32, 0xc6,
-	0x60, 0xcd, 0x92, 0x8e, 0x6c, 0xd0, 0x4e, 0x6d, 0xf8, 0xdd, 0x65, 0x38, 0xa7, 0x2d, 0x54, 0xc7,
-	0x59, 0x6f, 0x86, 0xeb, 0x59, 0xe7, 0x21, 0x45, 0xc2, 0xac, 0xc4, 0xfd, 0x89, 0x03, 0x17, 0x7a,
-	0x28, 0xe3, 0xe8, 0x2b, 0x0e, 0x8c, 0xae, 0xff, 0x54, 0xf4, 0xcd, 0x6c, 0x06, 0xfa, 0x00, 0x8c,
-	0x51, 0x00, 0xdd, 0x89, 0xc4, 0xdc, 0x2c, 0x98, 0x8e, 0xad, 0x19, 0xa3, 0x14, 0x67, 0xb0, 0xdd,
-	0x5f, 0x2b, 0x40, 0x0e, 0x17, 0xf4, 0x24, 0x0c, 0x91, 0xa0, 0xd6, 0x0e, 0xfd, 0x20, 0x11, 0xc2,
-	0x48, 0x49, 0xbd, 0x2b, 0x02, 0x8e, 0x15, 0x86, 0x38, 0x7f, 0x88, 0x81, 0x29, 0x74, 0x9d, 0x3f,
-	0x44, 0xcb, 0x53, 0x1c, 0x54, 0x87, 0x71, 0x8f, 0xfb, 0x57, 0xd8, 0xdc, 0x63, 0xd3, 0xb4, 0xef,
-	0x30, 0xd3, 0xf4, 0x2c, 0xf3, 0x9a, 0x66, 0x48, 0xe0, 0x2e, 0xa2, 0xe8, 0xbd, 0x50, 0xea, 0xc4,
-	0xa4, 0x32, 0xb7, 0x38, 0x1b, 0x91, 0x1a, 0x3f, 0x15, 0x6b, 0xee, 0xc2, 0x1b, 0x69, 0x11, 0xd6,
-	0xf1, 0xdc, 0x3f, 0x75, 0x60, 0x70, 0xc6, 0xab, 0x6e, 0x86, 0x1b, 0x1b, 0x74, 0x28, 0x6a, 0x9d,
-	0x28, 0x35, 0x6c, 0x69, 0x43, 0x31, 0x27, 0xe0, 0x58, 0x61, 0xa0, 0x35, 0x18, 0xe0, 0x0b, 0x5e,
-	0x2c, 0xbb, 0x9f, 0xd7, 0xfa, 0xa3, 0xc2, 0x7f, 0xd8, 0x74, 0xe8, 0x24, 0x7e, 0x73, 0x8a, 0x87,
-	0xff, 0x4c, 0x5d, 0x0b, 0x92, 0x95, 0xa8, 0x92, 0x44, 0x7e, 0x50, 0x9f, 0x01, 0xba, 0x5d, 0xcc,
-	0x33, 0x1a, 0x58, 0xd0, 0xa2, 0xdd, 0x68, 0x79, 0xb7, 0x24, 0x3b, 0x21, 0x7e, 0x54, 0x37, 0x96,
-	0xd3, 0x22, 0xac, 0xe3, 0xd1, 0xdd, 0xa4, 0xea, 0xb5, 0x85, 0x5e, 0xa2, 0x76, 0x93, 0x59, 0xaf,
-	0x8d, 0x29, 0xdc, 0xfd, 0x23, 0x07, 0x86, 0x67, 0xbc, 0xd8, 0xaf, 0xfe, 0x25, 0x92, 0x4d, 0x1f,
-	0x81, 0xe2, 0xac, 0x57, 0x6d, 0x10, 0x74, 0x23, 0x7b, 0x26, 0x2e, 0x5d, 0x7e, 0x3c, 0x8f, 0x8d,
-	0x3a, 0x1f, 0xeb, 0x9c, 0x46, 0x7b, 0x9d, 0x9c, 0xdd, 0x77, 0x1c, 0x18, 0x9b, 0x6d, 0xfa, 0x24,
-	0x48, 0x66, 0x49, 0x94, 0xb0, 0x81, 0xab, 0xc3, 0x78, 0x55, 0x41, 0x8e, 0x32, 0x74, 0x6c, 0x32,
-	0xcf, 0x66, 0x48, 0xe0, 0x2e, 0xa2, 0xa8, 0x06, 0xa7, 0x38, 0x2c, 0x5d, 0x34, 0x87, 0x1a, 0x3f,
-	0x66, 0x3c, 0x9d, 0x35, 0x29, 0xe0, 0x2c, 0x49, 0xf7, 0xc7, 0x0e, 0x5c, 0x98, 0x6d, 0x76, 0xe2,
-	0x84, 0x44, 0x37, 0x85, 0xb0, 0x92, 0xda, 0x2f, 0xfa, 0x18, 0x0c, 0xb5, 0xa4, 0x43, 0xd7, 0xb9,
-	0xc3, 0xfc, 0x66, 0xe2, 0x8e, 0x62, 0xd3, 0xc6, 0xac, 0xac, 0xbf, 0x4c, 0xaa, 0xc9, 0x32, 0x49,
-	0xbc, 0x34, 0x68, 0x21, 0x85, 0x61, 0x45, 0x15, 0xb5, 0xa1, 0x3f, 0x6e, 0x93, 0xaa, 0xbd, 0x98,
-	0x31, 0xd9, 0x87, 0x4a, 0x9b, 0x54, 0x53, 0xb1, 0xcf, 0x5c, 0x91, 0x8c, 0x93, 0xfb, 0xbf, 0x1c,
-	0x78, 0xa0, 0x47, 0x7f, 0x97, 0xfc, 0x38, 0x41, 0x2f, 0x75, 0xf5, 0x79, 0xea, 0x60, 0x7d, 0xa6,
-	0xb5, 0x59, 0x8f, 0x95, 0xbc, 0x90, 0x10, 0xad, 0xbf, 0x1f, 0x87, 0xa2, 0x9f, 0x90, 0x96, 0xb4,
-	0x52, 0x5b, 0xb0, 0x27, 0xf5, 0xe8, 0xcb, 0xcc, 0xa8, 0x8c, 0x1c, 0xbc, 0x46, 0xf9, 0x61, 0xce,
-	0xd6, 0xdd, 0x84, 0x81, 0xd9, 0xb0, 0xd9, 0x69, 0x05, 0x07, 0x8b, 0xbf, 0x49, 0x76, 0xda, 0x24,
-	0xbb, 0x85, 0xb2, 0xd3, 0x01, 0x2b, 0x91, 0x76, 0xa5, 0xbe, 0x7c, 0xbb, 0x92, 0xfb, 0x2f, 0x1d,
-	0xa0, 0xab, 0xaa, 0xe6, 0x0b, 0x47, 0x23, 0x27, 0xc7, 0x19, 0x3e, 0xa4, 0x93, 0xbb, 0xbd, 0x3b,
-	0x39, 0xaa, 0x10, 0x35, 0xfa, 0x1f, 0x81, 0x81, 0x98, 0x9d, 0xd8, 0x45, 0x1b, 0xe6, 0xa5, 0x7a,
-	0xcd, 0xcf, 0xf1, 0xb7, 0x77, 0x27, 0x0f, 0x14, 0x0c, 0x3a, 0xa5, 0x68, 0x0b, 0x9f, 0xa8, 0xa0,
-	0x4a, 0xf5, 0xc1, 0x16, 0x89, 0x63, 0xaf, 0x2e, 0x0f, 0x80, 0x4a, 0x1f, 0x5c, 0xe6, 0x60, 0x2c,
-	0xcb, 0xdd, 0x2f, 0x3b, 0x30, 0xaa, 0xf6, 0x36, 0xaa, 0xdd, 0xa3, 0xeb, 0xfa, 0x2e, 0xc8, 0x67,
-	0xca, 0x43, 0x3d, 0x24, 0x8e, 0xd8, 0xe7, 0xf7, 0xdf, 0x24, 0xdf, 0x03, 0x23, 0x35, 0xd2, 0x26,
-	0x41, 0x8d, 0x04, 0x55, 0x7a, 0x3a, 0xa7, 0x33, 0x64, 0x78, 0x66, 0x9c, 0x1e, 0x47, 0xe7, 0x34,
-	0x38, 0x36, 0xb0, 0xdc, 0x6f, 0x3a, 0x70, 0xbf, 0x22, 0x57, 0x21, 0x09, 0x26, 0x49, 0xb4, 0xa3,
-	0x82, 0x3f, 0x0f, 0xb7, 0x99, 0xdd, 0xa4, 0xea, 0x71, 0x12, 0x71, 0xe6, 0x47, 0xdb, 0xcd, 0x4a,
-	0x5c, 0x99, 0x66, 0x44, 0xb0, 0xa4, 0xe6, 0xfe, 0x4a, 0x1f, 0x9c, 0xd5, 0x1b, 0xa9, 0x04, 0xcc,
-	0xa7, 0x1c, 0x00, 0x35, 0x02, 0x74, 0xbf, 0xee, 0xb3, 0xe3, 0xda, 0x32, 0xbe, 0x54, 0x2a, 0x82,
-	0x14, 0x38, 0xc6, 0x1a, 0x5b, 0xf4, 0x02, 0x8c, 0x6c, 0xd1, 0x45, 0x41, 0x96, 0xa9, 0x36, 0x11,
-	0x97, 0xfb, 0x58, 0x33, 0x26, 0xf3, 0x3e, 0xe6, 0xf3, 0x29, 0x5e, 0x6a, 0x2d, 0xd0, 0x80, 0x31,
-	0x36, 0x48, 0xd1, 0x83, 0xd0, 0x68, 0xa4, 0x7f, 0x12, 0x61, 0x32, 0xff, 0xb0, 0xc5, 0x3e, 0x66,
-	0xbf, 0xfa, 0xcc, 0xe9, 0xbd, 0xdd, 0xc9, 0x51, 0x03, 0x84, 0xcd, 0x46, 0xb8, 0x2f, 0x00, 0x1b,
-	0x0b, 0x3f, 0xe8, 0x90, 0x95, 0x00, 0x3d, 0x22, 0x4d, 0x78, 0xdc, 0xed, 0xa2, 0x24, 0x87, 0x6e,
-	0xc6, 0xa3, 0x47, 0xdd, 0x0d, 0xcf, 0x6f, 0xb2, 0xa0, 0x48, 0x8a, 0xa5, 0x8e, 0xba, 0xf3, 0x0c,
-	0x8a, 0x45, 0xa9, 0x3b, 0x05, 0x83, 0xb3, 0xb4, 0xef, 0x24, 0xa2, 0x74, 0xf5, 0x58, 0xe6, 0x51,
-	0x23, 0x96, 0x59, 0xc6, 0x2c, 0xaf, 0xc1, 0xb9, 0xd9, 0x88, 0x78, 0x09, 0xa9, 0x3c, 0x3d, 0xd3,
-	0xa9, 0x6e, 0x92, 0x84, 0x07, 0x8c, 0xc5, 0xe8, 0xfd, 0x30, 0x1a, 0xb2, 0x2d, 0x63, 0x29, 0xac,
-	0x6e, 0xfa, 0x41, 0x5d, 0x58, 0x64, 0xcf, 0x09, 0x2a, 0xa3, 0x2b, 0x7a, 0x21, 0x36, 0x71, 0xdd,
-	0xff, 0x58, 0x80, 0x91, 0xd9, 0x28, 0x0c, 0xa4, 0x58, 0x3c, 0x81, 0xad, 0x2c, 0x31, 0xb6, 0x32,
-	0x0b, 0xde, 0x50, 0xbd, 0xfd, 0xbd, 0xb6, 0x33, 0xf4, 0xba, 0x12, 0x91, 0x7d, 0xb6, 0x4e, 0x28,
-	0x06, 0x5f, 0x46, 0x3b, 0xfd, 0xd8, 0xa6, 0x00, 0x75, 0xff, 0x93, 0x03, 0xe3, 0x3a, 0xfa, 0x09,
-	0xec, 0xa0, 0xb1, 0xb9, 0x83, 0x5e, 0xb7, 0xdb, 0xdf, 0x1e, 0xdb, 0xe6, 0x3b, 0x83, 0x66, 0x3f,
-	0x99, 0x2b, 0xfc, 0xab, 0x0e, 0x8c, 0x6c, 0x6b, 0x00, 0xd1, 0x59, 0xdb, 0x4a, 0xcc, 0xbb, 0xa4,
-	0x98, 0xd1, 0xa1, 0xb7, 0x33, 0xbf, 0xb1, 0xd1, 0x12, 0x2a, 0xf7, 0xe3, 0x6a, 0x83, 0xd4, 0x3a,
-	0x4d, 0xb9, 0x7d, 0xab, 0x21, 0xad, 0x08, 0x38, 0x56, 0x18, 0xe8, 0x25, 0x38, 0x5d, 0x0d, 0x83,
-	0x6a, 0x27, 0x8a, 0x48, 0x50, 0xdd, 0x59, 0x65, 0x37, 0x2f, 0xc4, 0x86, 0x38, 0x25, 0xaa, 0x9d,
-	0x9e, 0xcd, 0x22, 0xdc, 0xce, 0x03, 0xe2, 0x6e, 0x42, 0xdc, 0x97, 0x10, 0xd3, 0x2d, 0x4b, 0x9c,
-	0xc7, 0x34, 0x5f, 0x02, 0x03, 0x63, 0x59, 0x8e, 0x6e, 0xc0, 0x85, 0x38, 0xf1, 0xa2, 0xc4, 0x0f,
-	0xea, 0x73, 0xc4, 0xab, 0x35, 0xfd, 0x80, 0x1e, 0x25, 0xc2, 0xa0, 0xc6, 0x3d, 0x8d, 0x7d, 0x33,
-	0x0f, 0xec, 0xed, 0x4e, 0x5e, 0xa8, 0xe4, 0xa3, 0xe0, 0x5e, 0x75, 0xd1, 0x47, 0x60, 0x42, 0x78,
-	0x2b, 0x36, 0x3a, 0xcd, 0x67, 0xc3, 0xf5, 0xf8, 0xaa, 0x1f, 0xd3, 0x63, 0xfe, 0x92, 0xdf, 0xf2,
-	0x13, 0xe6, 0x4f, 0x2c, 0xce, 0x5c, 0xdc, 0xdb, 0x9d, 0x9c, 0xa8, 0xf4, 0xc4, 0xc2, 0xfb, 0x50,
-	0x40, 0x18, 0xce, 0x73, 0xe1, 0xd7, 0x45, 0x7b, 0x90, 0xd1, 0x9e, 0xd8, 0xdb, 0x9d, 0x3c, 0x3f,
-	0x9f, 0x8b, 0x81, 0x7b, 0xd4, 0xa4, 0x5f, 0x30, 0xf1, 0x5b, 0xe4, 0xd5, 0x30, 0x20, 0x2c, 0x8e,
-	0x45, 0xfb, 0x82, 0x6b, 0x02, 0x8e, 0x15, 0x06, 0x7a, 0x39, 0x9d, 0x89, 0x74, 0xb9, 0x88, 0x78,
-	0x94, 0xc3, 0x4b, 0x38, 0x76, 0x34, 0xb9, 0xa9, 0x51, 0x62, 0x81, 0x96, 0x06, 0x6d, 0xf4, 0x69,
-	0x07, 0x46, 0xe2, 0x24, 0x54, 0xb7, 0x25, 0x44, 0x40, 0x8a, 0x85, 0x69, 0x5f, 0xd1, 0xa8, 0x72,
-	0xc5, 0x47, 0x87, 0x60, 0x83, 0x2b, 0xfa, 0x39, 0x18, 0x96, 0x13, 0x38, 0x2e, 0x97, 0x98, 0xae,
-	0xc4, 0x8e, 0x71, 0x72, 0x7e, 0xc7, 0x38, 0x2d, 0xa7, 0xaa, 0xec, 0x76, 0x83, 0x04, 0x2c, 0x92,
-	0x57, 0x53, 0x65, 0x6f, 0x36, 0x48, 0x80, 0x59, 0x89, 0xfb, 0xa3, 0x3e, 0x40, 0xdd, 0x82, 0x0f,
-	0x2d, 0xc2, 0x80, 0x57, 0x4d, 0xfc, 0x2d, 0x19, 0x8e, 0xf8, 0x48, 0x9e, 0x52, 0xc0, 0x07, 0x10,
-	0x93, 0x0d, 0x42, 0xe7, 0x3d, 0x49, 0xa5, 0xe5, 0x34, 0xab, 0x8a, 0x05, 0x09, 0x14, 0xc2, 0xe9,
-	0xa6, 0x17, 0x27, 0xb2, 0x85, 0x35, 0xfa, 0x21, 0xc5, 0x76, 0xf1, 0xb3, 0x07, 0xfb, 0x54, 0xb4,
-	0xc6, 0xcc, 0x39, 0xba, 0x1e, 0x97, 0xb2, 0x84, 0x70, 0x37, 0x6d, 0xf4, 0x09, 0xa6, 0x5d, 0x71,
-	0xd5, 0x57, 0xaa, 0x35, 0x8b, 0x56, 0x34, 0x0f, 0x4e, 0xd3, 0xd0, 0xac, 0x04, 0x1b, 0xac, 0xb1,
-	0x44, 0x97, 0x60, 0x98, 0xad, 0x1b, 0x52, 0x23, 0x7c, 0xf5, 0xf7, 0xa5, 0x4a, 0x70, 0x45, 0x16,
-	0xe0, 0x14, 0x47, 0xd3, 0x32, 0xf8, 0x82, 0xef, 0xa1, 0x65, 0xa0, 0x67, 0xa0, 0xd8, 0x6e, 0x78,
-	0xb1, 0x8c, 0x8c, 0x77, 0xa5, 0xd4, 0x5e, 0xa5, 0x40, 0x26, 0x9a, 0xb4, 0x6f, 0xc9, 0x80, 0x98,
-	0x57, 0x70, 0xff, 0x15, 0xc0, 0xe0, 0xdc, 0xf4, 0xc2, 0x9a, 0x17, 0x6f, 0x1e, 0xe0, 0x0c, 0x44,
-	0x97, 0xa1, 0x50, 0x56, 0xb3, 0x82, 0x54, 0x2a, 0xb1, 0x58, 0x61, 0xa0, 0x00, 0x06, 0xfc, 0x80,
-	0x4a, 0x1e, 0x16, 0x88, 0x6d, 0xc5, 0x0d, 0xa1, 0xce, 0x73, 0xcc, 0x4e, 0x74, 0x8d, 0x51, 0xc7,
-	0x82, 0x0b, 0x7a, 0x1d, 0x86, 0x3d, 0x79, 0x31, 0x49, 0xec, 0xff, 0x8b, 0x36, 0xec, 0xeb, 0x82,
-	0xa4, 0x1e, 0xe1, 0x24, 0x40, 0x38, 0x65, 0x88, 0x3e, 0xe9, 0x40, 0x49, 0x76, 0x1d, 0x93, 0x0d,
-	0xe1, 0xfa, 0x5e, 0xb6, 0xd7, 0x67, 0x4c, 0x36, 0x78, 0xf8, 0x8b, 0x06, 0xc0, 0x3a, 0xcb, 0xae,
-	0x33, 0x53, 0xf1, 0x20, 0x67, 0x26, 0xb4, 0x0d, 0xc3, 0xdb, 0x7e, 0xd2, 0x60, 0x3b, 0xbc, 0x70,
-	0xb9, 0xcd, 0xdf, 0x7d, 0xab, 0x29, 0xb9, 0x74, 0xc4, 0x6e, 0x4a, 0x06, 0x38, 0xe5, 0x45, 0x97,
-	0x03, 0xfd, 0xc1, 0x2e, 0x76, 0xb1, 0xbd, 0x61, 0xd8, 0xac, 0xc0, 0x0a, 0x70, 0x8a, 0x43, 0x87,
-	0x78, 0x84, 0xfe, 0xaa, 0x90, 0x57, 0x3a, 0x54, 0xb4, 0x88, 0x90, 0x46, 0x0b, 0xf3, 0x4a, 0x52,
-	0xe4, 0x83, 0x75, 0x53, 0xe3, 0x81, 0x0d, 0x8e, 0x4a, 0x74, 0x0e, 0xf7, 0x12, 0x9d, 0xe8, 0x75,
-	0x7e, 0x86, 0xe3, 0x87, 0x09, 0xb1, 0x1b, 0x2c, 0xd9, 0x39, 0xdf, 0x70, 0x9a, 0xfc, 0xb2, 0x44,
-	0xfa, 0x1b, 0x6b, 0xfc, 0xa8, 0xc4, 0x08, 0x83, 0x2b, 0xb7, 0xfc, 0x44, 0x5c, 0xf1, 0x50, 0x12,
-	0x63, 0x85, 0x41, 0xb1, 0x28, 0xe5, 0xa1, 0x1d, 0x74, 0x12, 0xc4, 0x62, 0x17, 0xd0, 0x42, 0x3b,
-	0x18, 0x18, 0xcb, 0x72, 0xf4, 0xf7, 0x1c, 0x28, 0x36, 0xc2, 0x70, 0x33, 0x2e, 0x8f, 0xb2, 0xc9,
-	0x61, 0x41, 0xa7, 0x16, 0x12, 0x67, 0xea, 0x2a, 0x25, 0x6b, 0x5e, 0x5a, 0x2b, 0x32, 0xd8, 0xed,
-	0xdd, 0xc9, 0xb1, 0x25, 0x7f, 0x83, 0x54, 0x77, 0xaa, 0x4d, 0xc2, 0x20, 0x6f, 0xbe, 0xa3, 0x41,
-	0xae, 0x6c, 0x91, 0x20, 0xc1, 0xbc, 0x55, 0x13, 0x9f, 0x77, 0x00, 0x52, 0x42, 0x39, 0x3e, 0x54,
-	0x62, 0x46, 0x1d, 0x58, 0x38, 0x50, 0x1b, 0x4d, 0xd3, 0x9d, 0xb2, 0xff, 0xc6, 0x81, 0x12, 0xed,
-	0x9c, 0x14, 0x81, 0x8f, 0xc1, 0x40, 0xe2, 0x45, 0x75, 0x22, 0xfd, 0x08, 0xea, 0x73, 0xac, 0x31,
-	0x28, 0x16, 0xa5, 0x28, 0x80, 0x62, 0xe2, 0xc5, 0x9b, 0x52, 0x8d, 0xbf, 0x66, 0x6d, 0x88, 0x53,
-	0x0d, 0x9e, 0xfe, 0x8a, 0x31, 0x67, 0x83, 0x1e, 0x87, 0x21, 0xba, 0x75, 0xcc, 0x7b, 0xb1, 0x0c,
-	0xed, 0x19, 0xa1, 0x42, 0x7c, 0x5e, 0xc0, 0xb0, 0x2a, 0x75, 0x7f, 0xad, 0x00, 0xfd, 0x73, 0xfc,
-	0x40, 0x37, 0x10, 0x87, 0x9d, 0xa8, 0x4a, 0x84, 0x62, 0x6f, 0x61, 0x4e, 0x53, 0xba, 0x15, 0x46,
-	0x53, 0x3b, 0x52, 0xb1, 0xdf, 0x58, 0xf0, 0x42, 0x6f, 0x3b, 0x30, 0x96, 0x44, 0x5e, 0x10, 0x6f,
-	0x30, 0x8f, 0x8d, 0x1f, 0x06, 0x62, 0x88, 0x2c, 0xcc, 0xc2, 0x35, 0x83, 0x6e, 0x25, 0x21, 0xed,
-	0xd4, 0x71, 0x64, 0x96, 0xe1, 0x4c, 0x1b, 0xdc, 0x5f, 0x77, 0x00, 0xd2, 0xd6, 0xa3, 0xb7, 0x1c,
-	0x18, 0xf5, 0xf4, 0x90, 0x52, 0x31, 0x46, 0x2b, 0xf6, 0xdc, 0xbb, 0x8c, 0x2c, 0xb7, 0x65, 0x18,
-	0x20, 0x6c, 0x32, 0x76, 0xdf, 0x0b, 0x45, 0xb6, 0x3a, 0xd8, 0xa1, 0x47, 0xd8, 0xbe, 0xb3, 0xc6,
-	0x2e, 0x69, 0x13, 0xc7, 0x0a, 0xc3, 0x7d, 0x09, 0xc6, 0xae, 0xdc, 0x22, 0xd5, 0x4e, 0x12, 0x46,
-	0xdc, 0xf2, 0xdf, 0xe3, 0x0a, 0x91, 0x73, 0xa4, 0x2b, 0x44, 0xdf, 0x75, 0xa0, 0xa4, 0xc5, 0x17,
-	0xd2, 0x9d, 0xba, 0x3e, 0x5b, 0xe1, 0x06, 0x0e, 0x31, 0x54, 0x8b, 0x56, 0x22, 0x18, 0x39, 0xc9,
-	0x74, 0x1b, 0x51, 0x20, 0x9c, 0x32, 0xbc, 0x43, 0xfc, 0x9f, 0xfb, 0xfb, 0x0e, 0x9c, 0xcb, 0x0d,
-	0x86, 0xbc, 0xc7, 0xcd, 0x36, 0x7c, 0xf0, 0x85, 0x03, 0xf8, 0xe0, 0x7f, 0xdb, 0x81, 0x94, 0x12,
-	0x15, 0x45, 0xeb, 0x69, 0xcb, 0x35, 0x51, 0x24, 0x38, 0x89, 0x52, 0xf4, 0x3a, 0x5c, 0x30, 0xbf,
-	0xe0, 0x11, 0xfd, 0x2d, 0xfc, 0x70, 0x9a, 0x4f, 0x09, 0xf7, 0x62, 0xe1, 0x7e, 0xcd, 0x81, 0xe2,
-	0x82, 0xd7, 0xa9, 0x93, 0x03, 0x99, 0xcb, 0xa8, 0x1c, 0x8b, 0x88, 0xd7, 0x4c, 0xe4, 0xd1, 0x41,
-	0xc8, 0x31, 0x2c, 0x60, 0x58, 0x95, 0xa2, 0x69, 0x18, 0x0e, 0xdb, 0xc4, 0x70, 0x21, 0x3e, 0x22,
-	0x47, 0x6f, 0x45, 0x16, 0xd0, 0x6d, 0x87, 0x71, 0x57, 0x10, 0x9c, 0xd6, 0x72, 0xbf, 0x3e, 0x00,
-	0x25, 0xed, 0xda, 0x0c, 0xd5, 0x05, 0x22, 0xd2, 0x0e, 0xb3, 0xfa, 0x32, 0x9d, 0x30, 0x98, 0x95,
-	0xd0, 0x35, 0x18, 0x91, 0x2d, 0x3f, 0xe6, 0x62, 0xcb, 0x58, 0x83, 0x58, 0xc0, 0xb1, 0xc2, 0x40,
-	0x93, 0x50, 0xac, 0x91, 0x76, 0xd2, 0x60, 0xcd, 0xeb, 0xe7, 0xb1, 0x83, 0x73, 0x14, 0x80, 0x39,
-	0x9c, 0x22, 0x6c, 0x90, 0xa4, 0xda, 0x60, 0x96, 0x61, 0x11, 0x5c, 0x38, 0x4f, 0x01, 0x98, 0xc3,
-	0x73, 0xbc, 0x98, 0xc5, 0xe3, 0xf7, 0x62, 0x0e, 0x58, 0xf6, 0x62, 0xa2, 0x36, 0x9c, 0x89, 0xe3,
-	0xc6, 0x6a, 0xe4, 0x6f, 0x79, 0x09, 0x49, 0x67, 0xdf, 0xe0, 0x61, 0xf8, 0x5c, 0x60, 0xf7, 0xdf,
-	0x2b, 0x57, 0xb3, 0x54, 0x70, 0x1e, 0x69, 0x54, 0x81, 0x73, 0x7e, 0x10, 0x93, 0x6a, 0x27, 0x22,
-	0xd7, 0xea, 0x41, 0x18, 0x91, 0xab, 0x61, 0x4c, 0xc9, 0x89, 0xdb, 0xbb, 0x2a, 0xdc, 0xf6, 0x5a,
-	0x1e, 0x12, 0xce, 0xaf, 0x8b, 0x16, 0xe0, 0x74, 0xcd, 0x8f, 0xbd, 0xf5, 0x26, 0xa9, 0x74, 0xd6,
-	0x5b, 0x21, 0x3f, 0x9a, 0x0f, 0x33, 0x82, 0xf7, 0x4b, 0x3b, 0xd2, 0x5c, 0x16, 0x01, 0x77, 0xd7,
-	0x41, 0xcf, 0xc0, 0x48, 0xec, 0x07, 0xf5, 0x26, 0x99, 0x89, 0xbc, 0xa0, 0xda, 0x10, 0xd7, 0x7e,
-	0x95, 0xbd, 0xbd, 0xa2, 0x95, 0x61, 0x03, 0x93, 0xad, 0x79, 0x5e, 0x27, 0xa3, 0x0d, 0x0a, 0x6c,
-	0x51, 0x8a, 0xa6, 0xe1, 0x94, 0xec, 0x43, 0x65, 0xd3, 0x6f, 0xaf, 0x2d, 0x55, 0x98, 0x56, 0x38,
-	0x94, 0x06, 0x13, 0x5d, 0x33, 0x8b, 0x71, 0x16, 0xdf, 0xfd, 0x81, 0x03, 0x23, 0x7a, 0xb4, 0x3c,
-	0x55, 0xd6, 0xa1, 0x31, 0x37, 0x5f, 0xe1, 0xdb, 0x89, 0x3d, 0xa5, 0xe1, 0xaa, 0xa2, 0x99, 0x9e,
-	0xb7, 0x53, 0x18, 0xd6, 0x78, 0x1e, 0xe0, 0xca, 0xfc, 0x23, 0x50, 0xdc, 0x08, 0xa9, 0x4e, 0xd3,
-	0x67, 0xda, 0xfa, 0xe7, 0x29, 0x10, 0xf3, 0x32, 0xf7, 0xbf, 0x39, 0x70, 0x3e, 0xff, 0x22, 0xc0,
-	0x4f, 0x43, 0x27, 0x2f, 0x03, 0xd0, 0xae, 0x18, 0xfb, 0x82, 0x96, 0x34, 0x43, 0x96, 0x60, 0x0d,
-	0xeb, 0x60, 0xdd, 0xfe, 0xd7, 0x05, 0xd0, 0x78, 0xa2, 0x2f, 0x38, 0x30, 0x4a, 0xd9, 0x2e, 0x46,
-	0xeb, 0x46, 0x6f, 0x57, 0xec, 0xf4, 0x56, 0x91, 0x4d, 0x5d, 0x1a, 0x06, 0x18, 0x9b, 0xcc, 0xd1,
-	0xcf, 0xc1, 0xb0, 0x57, 0xab, 0x45, 0x24, 0x8e, 0x95, 0x73, 0x90, 0x19, 0xbc, 0xa6, 0x25, 0x10,
-	0xa7, 0xe5, 0x54, 0x0e, 0x37, 0x6a, 0x1b, 0x31, 0x15, 0x6d, 0x42, 0xf6, 0x2b, 0x39, 0x4c, 0x99,
-	0x50, 0x38, 0x56, 0x18, 0xe8, 0x79, 0x38, 0x5f, 0xf3, 0x12, 0x8f, 0xab, 0x80, 0x24, 0x5a, 0x8d,
-	0xc2, 0x84, 0x54, 0xd9, 0xbe, 0xc1, 0x63, 0x49, 0x2e, 0x8a, 0xba, 0xe7, 0xe7, 0x72, 0xb1, 0x70,
-	0x8f, 0xda, 0xee, 0x2f, 0xf7, 0x83, 0xd9, 0x27, 0x54, 0x83, 0x53, 0x9b, 0xd1, 0xfa, 0x2c, 0x8b,
-	0xd9, 0x38, 0x4a, 0xec, 0x04, 0x8b, 0x69, 0x58, 0x34, 0x29, 0xe0, 0x2c, 0x49, 0xc1, 0x65, 0x91,
-	0xec, 0x24, 0xde, 0xfa, 0x91, 0x23, 0x27, 0x16, 0x4d, 0x0a, 0x38, 0x4b, 0x12, 0xbd, 0x17, 0x4a,
-	0x9b, 0xd1, 0xba, 0xdc, 0x3d, 0xb2, 0x51, 0x3a, 0x8b, 0x69, 0x11, 0xd6, 0xf1, 0xe8, 0xa7, 0xd9,
-	0x8c, 0xd6, 0xe9, 0x86, 0x2d, 0x53, 0x53, 0xa8, 0x4f, 0xb3, 0x28, 0xe0, 0x58, 0x61, 0xa0, 0x36,
-	0xa0, 0x4d, 0x39, 0x7a, 0x2a, 0x42, 0x45, 0x6c, 0x72, 0x07, 0x0f, 0x70, 0x61, 0x37, 0x07, 0x16,
-	0xbb, 0xe8, 0xe0, 0x1c, 0xda, 0xe8, 0x05, 0xb8, 0xb0, 0x19, 0xad, 0x0b, 0x3d, 0x66, 0x35, 0xf2,
-	0x83, 0xaa, 0xdf, 0x36, 0xd2, 0x50, 0x4c, 0x8a, 0xe6, 0x5e, 0x58, 0xcc, 0x47, 0xc3, 0xbd, 0xea,
-	0xbb, 0xbf, 0xd3, 0x0f, 0xec, 0x26, 0x2c, 0x15, 0xd3, 0x2d, 0x92, 0x34, 0xc2, 0x5a, 0x56, 0x35,
-	0x5b, 0x66, 0x50, 0x2c, 0x4a, 0x65, 0x7c, 0x6c, 0xa1, 0x47, 0x7c, 0xec, 0x36, 0x0c, 0x36, 0x88,
-	0x57, 0x23, 0x91, 0x34, 0x6e, 0x2e, 0xd9, 0xb9, 0xbb, 0x7b, 0x95, 0x11, 0x4d, 0x2d, 0x04, 0xfc,
-	0x77, 0x8c, 0x25, 0x37, 0xf4, 0x3e, 0x18, 0xa3, 0x3a, 0x56, 0xd8, 0x49, 0xa4, 0x7f, 0x82, 0x1b,
-	0x37, 0xd9, 0x66, 0xbf, 0x66, 0x94, 0xe0, 0x0c, 0x26, 0x9a, 0x83, 0x71, 0xe1, 0x4b, 0x50, 0x46,
-	0x53, 0x31, 0xb0, 0x2a, 0x3f, 0x48, 0x25, 0x53, 0x8e, 0xbb, 0x6a, 0xb0, 0xf8, 0xc6, 0xb0, 0xc6,
-	0xdd, 0xc9, 0x7a, 0x7c, 0x63, 0x58, 0xdb, 0xc1, 0xac, 0x04, 0xbd, 0x0a, 0x43, 0xf4, 0xef, 0x7c,
-	0x14, 0xb6, 0x84, 0xd9, 0x68, 0xd5, 0xce, 0xe8, 0x50, 0x1e, 0xe2, 0x10, 0xcb, 0x74, 0xcf, 0x19,
-	0xc1, 0x05, 0x2b, 0x7e, 0xf4, 0x28, 0xa5, 0x6f, 0x97, 0xcf, 0x93, 0xc8, 0xdf, 0xd8, 0x61, 0xfa,
-	0xcc, 0x50, 0x7a, 0x94, 0xba, 0xd6, 0x85, 0x81, 0x73, 0x6a, 0xb9, 0x5f, 0x28, 0xc0, 0x88, 0x7e,
-	0xa1, 0xfa, 0x4e, 0x41, 0xd3, 0x71, 0x3a, 0x29, 0xf8, 0xc1, 0xf9, 0xaa, 0x85, 0x6e, 0xdf, 0x69,
-	0x42, 0x34, 0xa0, 0xdf, 0xeb, 0x08, 0x45, 0xd6, 0x8a, 0x7d, 0x8e, 0xf5, 0xb8, 0x93, 0x34, 0xf8,
-	0xcd, 0x3b, 0x16, 0xce, 0xcc, 0x38, 0xb8, 0x9f, 0xe9, 0x83, 0x21, 0x59, 0x88, 0x3e, 0xed, 0x00,
-	0xa4, 0x71, 0x63, 0x42, 0x94, 0xae, 0xda, 0x08, 0x2a, 0xd2, 0x43, 0xde, 0x34, 0x33, 0xbf, 0x82,
-	0x63, 0x8d, 0x2f, 0x4a, 0x60, 0x20, 0xa4, 0x8d, 0xbb, 0x6c, 0x2f, 0x29, 0xc0, 0x0a, 0x65, 0x7c,
-	0x99, 0x71, 0x4f, 0x2d, 0x7a, 0x0c, 0x86, 0x05, 0x2f, 0x7a, 0x38, 0x5d, 0x97, 0xe1, 0x8c, 0xf6,
-	0xac, 0xdf, 0x2a, 0x42, 0x32, 0x3d, 0x6b, 0x2a, 0x10, 0x4e, 0x19, 0xba, 0x4f, 0xc1, 0x98, 0xb9,
-	0x18, 0xe8, 0x61, 0x65, 0x7d, 0x27, 0x21, 0xdc, 0x14, 0x32, 0xc2, 0x0f, 0x2b, 0x33, 0x14, 0x80,
-	0x39, 0xdc, 0xfd, 0xbe, 0x03, 0x90, 0x8a, 0x97, 0x03, 0x78, 0x1f, 0x1e, 0xd1, 0xed, 0x78, 0xbd,
-	0x4e, 0x84, 0x9f, 0x80, 0x61, 0xf6, 0x0f, 0x5b, 0xe8, 0x7d, 0xb6, 0x82, 0x0f, 0xd2, 0x76, 0x8a,
-	0xa5, 0xce, 0x74, 0x8d, 0xe7, 0x25, 0x23, 0x9c, 0xf2, 0x74, 0x43, 0x18, 0xcf, 0x62, 0xa3, 0x0f,
-	0xc3, 0x48, 0x2c, 0xb7, 0xd5, 0xf4, 0x7a, 0xe0, 0x01, 0xb7, 0x5f, 0xee, 0xfa, 0xd3, 0xaa, 0x63,
-	0x83, 0x98, 0xbb, 0x02, 0x03, 0x56, 0x87, 0xd0, 0xfd, 0xb6, 0x03, 0xc3, 0xcc, 0xfb, 0x5a, 0x8f,
-	0xbc, 0x56, 0x5a, 0xa5, 0x6f, 0x9f, 0x51, 0x8f, 0x61, 0x90, 0x9b, 0x0f, 0x64, 0xd4, 0x92, 0x05,
-	0x29, 0xc3, 0x53, 0x00, 0xa6, 0x52, 0x86, 0xdb, 0x29, 0x62, 0x2c, 0x39, 0xb9, 0x9f, 0x2d, 0xc0,
-	0xc0, 0xb5, 0xa0, 0xdd, 0xf9, 0x2b, 0x9f, 0x86, 0x6e, 0x19, 0xfa, 0xaf, 0x25, 0xa4, 0x65, 0x66,
-	0x4b, 0x1c, 0x99, 0x79, 0x54, 0xcf, 0x94, 0x58, 0x36, 0x33, 0x25, 0x62, 0x6f, 0x5b, 0x06, 0xf5,
-	0x09, 0xf3, 0x75, 0x7a, 0x45, 0xf2, 0x49, 0x18, 0x5e, 0xf2, 0xd6, 0x49, 0x73, 0x91, 0xec, 0xb0,
-	0x0b, 0x8d, 0x3c, 0xc0, 0xc4, 0x49, 0x6d, 0x0e, 0x46, 0x30, 0xc8, 0x1c, 0x8c, 0x31, 0x6c, 0xb5,
-	0x18, 0xe8, 0x89, 0x84, 0xa4, 0xa9, 0xa6, 0x1c, 0xf3, 0x44, 0xa2, 0xa5, 0x99, 0xd2, 0xb0, 0xdc,
-	0x29, 0x28, 0xa5, 0x54, 0x0e, 0xc0, 0xf5, 0x27, 0x05, 0x18, 0x35, 0xac, 0xf0, 0x86, 0x6f, 0xd2,
-	0xb9, 0xa3, 0x6f, 0xd2, 0xf0, 0x15, 0x16, 0xee, 0xb5, 0xaf, 0xb0, 0xef, 0xe4, 0x7d, 0x85, 0xe6,
-	0x47, 0xea, 0x3f, 0xd0, 0x47, 0x7a, 0xdb, 0x81, 0xfe, 0x25, 0x3f, 0xd8, 0x3c, 0x98, 0xa0, 0x89,
-	0xab, 0x61, 0xbb, 0x4b, 0xd0, 0x54, 0x28, 0x10, 0xf3, 0x32, 0xa9, 0xba, 0xf4, 0xf5, 0x50, 0x5d,
-	0x52, 0xe7, 0x49, 0xff, 0x7e, 0xce, 0x13, 0xf7, 0xd3, 0x0e, 0x8c, 0x2c, 0x7b, 0x81, 0xbf, 0x41,
-	0xe2, 0x84, 0x4d, 0xc0, 0xe4, 0x58, 0x6f, 0xc0, 0x8d, 0xf4, 0xc8, 0xe5, 0xf0, 0xa6, 0x03, 0xa7,
-	0x97, 0x49, 0x2b, 0xf4, 0x5f, 0xf5, 0xd2, 0xe0, 0x5a, 0xda, 0xc7, 0x86, 0x9f, 0x88, 0x58, 0x42,
-	0xd5, 0xc7, 0xab, 0x7e, 0x82, 0x29, 0xfc, 0x0e, 0xb6, 0x68, 0x76, 0xb7, 0x84, 0x9e, 0xe4, 0xb4,
-	0x5b, 0x99, 0x69, 0xd8, 0xac, 0x2c, 0xc0, 0x29, 0x8e, 0xfb, 0xbb, 0x0e, 0x0c, 0xf2, 0x46, 0xa8,
-	0x78, 0x64, 0xa7, 0x07, 0xed, 0x06, 0x14, 0x59, 0x3d, 0x31, 0xfd, 0x17, 0x2c, 0xe8, 0x49, 0x94,
-	0x1c, 0x5f, 0xac, 0xec, 0x5f, 0xcc, 0x19, 0xb0, 0xf3, 0x8d, 0x77, 0x6b, 0x5a, 0xc5, 0x15, 0xa7,
-	0xe7, 0x1b, 0x06, 0xc5, 0xa2, 0xd4, 0xfd, 0x7a, 0x1f, 0x0c, 0xa9, 0x14, 0x66, 0x2c, 0xc1, 0x44,
-	0x10, 0x84, 0x89, 0xc7, 0xe3, 0x35, 0xb8, 0x50, 0xff, 0xb0, 0xbd, 0x14, 0x6a, 0x53, 0xd3, 0x29,
-	0x75, 0xee, 0x83, 0x54, 0xa7, 0x55, 0xad, 0x04, 0xeb, 0x8d, 0x40, 0x1f, 0x87, 0x81, 0x26, 0x15,
-	0x53, 0x52, 0xc6, 0x3f, 0x6f, 0xb1, 0x39, 0x4c, 0xfe, 0x89, 0x96, 0xa8, 0x11, 0xe2, 0x40, 0x2c,
-	0xb8, 0x4e, 0x7c, 0x00, 0xc6, 0xb3, 0xad, 0xbe, 0xd3, 0xa5, 0xd1, 0x61, 0xfd, 0xca, 0xe9, 0x5f,
-	0x17, 0x62, 0xf6, 0xf0, 0x55, 0xdd, 0xe7, 0xa0, 0xb4, 0x4c, 0x92, 0xc8, 0xaf, 0x32, 0x02, 0x77,
-	0x9a, 0x5c, 0x07, 0x52, 0x34, 0x3e, 0xc7, 0x26, 0x2b, 0xa5, 0x19, 0xa3, 0xd7, 0x01, 0xda, 0x51,
-	0x48, 0x0f, 0xba, 0xa4, 0x23, 0x3f, 0xb6, 0x05, 0xc5, 0x79, 0x55, 0xd1, 0xe4, 0x6e, 0xf3, 0xf4,
-	0x37, 0xd6, 0xf8, 0xb9, 0x6f, 0x39, 0x50, 0x5c, 0xee, 0x24, 0xe4, 0xd6, 0x01, 0x44, 0xdb, 0xa1,
-	0xd3, 0x28, 0x3c, 0x09, 0x43, 0xf4, 0x03, 0xaf, 0x7b, 0xb1, 0x34, 0xb8, 0xa5, 0x61, 0xe7, 0x02,
-	0x8e, 0x15, 0x86, 0xfb, 0x61, 0x18, 0x61, 0x2d, 0xb9, 0x1a, 0x36, 0xe9, 0x76, 0x4d, 0x47, 0xb2,
-	0x45, 0x7f, 0x67, 0xfd, 0x20, 0x0c, 0x09, 0xf3, 0x32, 0xba, 0xc2, 0x1a, 0x61, 0xb3, 0xa6, 0x2e,
-	0xa0, 0xa9, 0xf9, 0x73, 0x95, 0x41, 0xb1, 0x28, 0x75, 0x3f, 0x55, 0x80, 0x12, 0xab, 0x28, 0xa4,
-	0xd3, 0x0e, 0x0c, 0x36, 0x38, 0x1f, 0x31, 0xe4, 0x16, 0xe2, 0xd6, 0xf4, 0xd6, 0x6b, 0x67, 0x44,
-	0x0e, 0xc0, 0x92, 0x1f, 0x65, 0xbd, 0xed, 0xf9, 0x09, 0x65, 0x5d, 0x38, 0x5e, 0xd6, 0x37, 0x39,
-	0x1b, 0x2c, 0xf9, 0xb9, 0xbf, 0x08, 0xec, 0x62, 0xf7, 0x7c, 0xd3, 0xab, 0xf3, 0x91, 0x0b, 0x37,
-	0x49, 0x4d, 0x88, 0x68, 0x6d, 0xe4, 0x28, 0x14, 0x8b, 0x52, 0x7e, 0x59, 0x36, 0x89, 0x7c, 0x15,
-	0xf1, 0xad, 0x5d, 0x96, 0x65, 0x60, 0x19, 0xdf, 0x5f, 0x73, 0xbf, 0x5c, 0x00, 0x60, 0xf9, 0xf1,
-	0xf8, 0x7d, 0xec, 0x9f, 0x97, 0xc1, 0x59, 0xa6, 0xef, 0x54, 0x05, 0x67, 0xb1, 0x1b, 0xe7, 0x7a,
-	0x50, 0x96, 0x7e, 0x11, 0xa3, 0xb0, 0xff, 0x45, 0x0c, 0xd4, 0x86, 0xc1, 0xb0, 0x93, 0x50, 0x1d,
-	0x58, 0x28, 0x11, 0x16, 0x42, 0x07, 0x56, 0x38, 0x41, 0x7e, 0x7b, 0x41, 0xfc, 0xc0, 0x92, 0x0d,
-	0x7a, 0x06, 0x86, 0xda, 0x51, 0x58, 0xa7, 0x3a, 0x81, 0xd8, 0x97, 0x1f, 0x94, 0xb3, 0x79, 0x55,
-	0xc0, 0x6f, 0x6b, 0xff, 0x63, 0x85, 0xed, 0xfe, 0xfd, 0xd3, 0x7c, 0x5c, 0xc4, 0xdc, 0x9b, 0x80,
-	0x82, 0x2f, 0x2d, 0x5e, 0x20, 0x48, 0x14, 0xae, 0xcd, 0xe1, 0x82, 0x5f, 0x53, 0xab, 0xb0, 0xd0,
-	0x73, 0x15, 0xbe, 0x17, 0x4a, 0x35, 0x3f, 0x6e, 0x37, 0xbd, 0x9d, 0xeb, 0x39, 0xe6, 0xc6, 0xb9,
-	0xb4, 0x08, 0xeb, 0x78, 0xe8, 0x49, 0x71, 0xed, 0xa6, 0xdf, 0x30, 0x31, 0xc9, 0x6b, 0x37, 0xe9,
-	0x7d, 0x7f, 0x7e, 0xe3, 0x26, 0x9b, 0x17, 0xa1, 0x78, 0xe0, 0xbc, 0x08, 0x59, 0x0d, 0x6f, 0xe0,
-	0xe4, 0x35, 0xbc, 0xf7, 0xc3, 0xa8, 0xfc, 0xc9, 0xb4, 0xae, 0xf2, 0x59, 0xd6, 0x7a, 0x65, 0x5e,
-	0x5f, 0xd3, 0x0b, 0xb1, 0x89, 0x9b, 0x4e, 0xda, 0xc1, 0x83, 0x4e, 0xda, 0xcb, 0x00, 0xeb, 0x61,
-	0x27, 0xa8, 0x79, 0xd1, 0xce, 0xb5, 0x39, 0x11, 0xa4, 0xab, 0x14, 0xca, 0x19, 0x55, 0x82, 0x35,
-	0x2c, 0x7d, 0xa2, 0x0f, 0xdf, 0x61, 0xa2, 0x7f, 0x18, 0x86, 0x59, 0x40, 0x33, 0xa9, 0x4d, 0x27,
-	0x22, 0xaa, 0xea, 0x30, 0x51, 0xa2, 0x69, 0x9c, 0xa5, 0x24, 0x82, 0x53, 0x7a, 0xe8, 0x23, 0x00,
-	0x1b, 0x7e, 0xe0, 0xc7, 0x0d, 0x46, 0xbd, 0x74, 0x68, 0xea, 0xaa, 0x9f, 0xf3, 0x8a, 0x0a, 0xd6,
-	0x28, 0xa2, 0x97, 0xe0, 0x34, 0x89, 0x13, 0xbf, 0xe5, 0x25, 0xa4, 0xa6, 0xee, 0xb1, 0x96, 0x99,
-	0x8d, 0x54, 0x85, 0x94, 0x5f, 0xc9, 0x22, 0xdc, 0xce, 0x03, 0xe2, 0x6e, 0x42, 0xc6, 0x8a, 0x9c,
-	0x38, 0xcc, 0x8a, 0x44, 0xff, 0xd3, 0x81, 0xd3, 0x11, 0xe1, 0xa1, 0x36, 0xb1, 0x6a, 0xd8, 0x39,
-	0x26, 0x8e, 0xab, 0x36, 0x32, 0xd6, 0xab, 0x1c, 0x33, 0x38, 0xcb, 0x85, 0xeb, 0x39, 0x44, 0xf6,
-	0xbe, 0xab, 0xfc, 0x76, 0x1e, 0xf0, 0xcd, 0x77, 0x26, 0x27, 0xbb, 0x5f, 0x4e, 0x50, 0xc4, 0xe9,
-	0xca, 0xfb, 0xdb, 0xef, 0x4c, 0x8e, 0xcb, 0xdf, 0xe9, 0xa0, 0x75, 0x75, 0x92, 0x6e, 0xab, 0xed,
-	0xb0, 0x76, 0x6d, 0x55, 0x84, 0xbf, 0xa9, 0x6d, 0x75, 0x95, 0x02, 0x31, 0x2f, 0x43, 0x8f, 0xd3,
-	0x9d, 0x9b, 0xb4, 0xc2, 0x40, 0xe5, 0x1e, 0x1e, 0xe1, 0xbb, 0x36, 0x87, 0x61, 0x55, 0x4a, 0x8f,
-	0x1c, 0x81, 0xd8, 0x52, 0xca, 0x0f, 0xd8, 0x3a, 0x72, 0xc8, 0x4d, 0x8a, 0x73, 0x95, 0xbf, 0xb0,
-	0xe2, 0x84, 0x9a, 0x30, 0xe0, 0x33, 0x03, 0x88, 0x88, 0xb0, 0xb5, 0x60, 0x75, 0xe1, 0x06, 0x15,
-	0x19, 0x5f, 0xcb, 0x44, 0xbf, 0xe0, 0xa1, 0xef, 0x35, 0xa7, 0x4e, 0x66, 0xaf, 0x79, 0x1c, 0x86,
-	0xaa, 0x0d, 0xbf, 0x59, 0x8b, 0x48, 0x50, 0x1e, 0x67, 0x96, 0x00, 0x36, 0x12, 0xb3, 0x02, 0x86,
-	0x55, 0x29, 0xfa, 0x6b, 0x30, 0x1a, 0x76, 0x12, 0x26, 0x5a, 0xe8, 0x38, 0xc5, 0xe5, 0xd3, 0x0c,
-	0x9d, 0xc5, 0x4b, 0xad, 0xe8, 0x05, 0xd8, 0xc4, 0xa3, 0x22, 0xbe, 0x11, 0xc6, 0x2c, 0x1d, 0x12,
-	0x13, 0xf1, 0xe7, 0x4d, 0x11, 0x7f, 0x55, 0x2b, 0xc3, 0x06, 0x26, 0xfa, 0xaa, 0x03, 0xa7, 0x5b,
-	0xd9, 0xf3, 0x5e, 0xf9, 0x02, 0x1b, 0x99, 0x8a, 0x8d, 0x73, 0x41, 0x86, 0x34, 0x8f, 0x74, 0xef,
-	0x02, 0xe3, 0xee, 0x46, 0xb0, 0xc4, 0x64, 0xf1, 0x4e, 0x50, 0x6d, 0x44, 0x61, 0x60, 0x36, 0xef,
-	0x7e, 0x5b, 0xf7, 0xed, 0xd8, 0xda, 0xce, 0x63, 0x31, 0x73, 0xff, 0xde, 0xee, 0xe4, 0xb9, 0xdc,
-	0x22, 0x9c, 0xdf, 0x28, 0xf4, 0x21, 0x18, 0x4f, 0xbc, 0x78, 0x93, 0xeb, 0x4b, 0xb4, 0x26, 0xa9,
-	0x95, 0x1f, 0xe4, 0x41, 0x0e, 0x7b, 0xbb, 0x93, 0xe3, 0x6b, 0x99, 0x32, 0xdc, 0x85, 0x3d, 0x31,
-	0x07, 0xe7, 0xf3, 0x25, 0xcc, 0x9d, 0x8e, 0x38, 0x7d, 0xfa, 0x11, 0x67, 0x1e, 0xee, 0xef, 0xd9,
-	0x2d, 0xba, 0x57, 0x49, 0x7d, 0xd5, 0x31, 0xf7, 0xaa, 0x2e, 0xfd, 0x72, 0x0c, 0x46, 0xf4, 0xc7,
-	0x3a, 0xdc, 0xff, 0xd3, 0x07, 0x90, 0x5a, 0xf0, 0x91, 0x07, 0x63, 0xdc, 0x5b, 0x70, 0x6d, 0xee,
-	0xc8, 0xb9, 0x06, 0x66, 0x0d, 0x02, 0x38, 0x43, 0x10, 0xb5, 0x00, 0x71, 0x08, 0xff, 0x7d, 0x14,
-	0xaf, 0x2f, 0x73, 0x92, 0xce, 0x76, 0x11, 0xc1, 0x39, 0x84, 0x69, 0x8f, 0x92, 0x70, 0x93, 0x04,
-	0x37, 0xf0, 0xd2, 0x51, 0xf2, 0x59, 0x70, 0x3f, 0xa1, 0x41, 0x00, 0x67, 0x08, 0x22, 0x17, 0x06,
-	0x98, 0xd1, 0x48, 0x46, 0xb5, 0x33, 0x01, 0xc5, 0x74, 0x95, 0x18, 0x8b, 0x12, 0xf4, 0x65, 0x07,
-	0xc6, 0x64, 0x5a, 0x0e, 0x66, 0xa7, 0x95, 0xf1, 0xec, 0x37, 0x6c, 0x79, 0x60, 0xae, 0xe8, 0xd4,
-	0xd3, 0x68, 0x51, 0x03, 0x1c, 0xe3, 0x4c, 0x23, 0xdc, 0x17, 0xe0, 0x4c, 0x4e, 0x75, 0x2b, 0x47,
-	0xe8, 0xef, 0x3a, 0x50, 0xd2, 0xb2, 0x45, 0xa2, 0xd7, 0x61, 0x38, 0xac, 0x58, 0x0f, 0x51, 0x5c,
-	0xa9, 0x74, 0x85, 0x28, 0x2a, 0x10, 0x4e, 0x19, 0x1e, 0x24, 0xb2, 0x32, 0x37, 0xb5, 0xe5, 0x3d,
-	0x6e, 0xf6, 0xa1, 0x23, 0x2b, 0x7f, 0xb9, 0x08, 0x29, 0xa5, 0x43, 0xa6, 0x8b, 0x49, 0xe3, 0x30,
-	0x0b, 0xfb, 0xc6, 0x61, 0xd6, 0xe0, 0x94, 0xc7, 0xbc, 0xdc, 0x47, 0x4c, 0x12, 0xc3, 0x93, 0x05,
-	0x9b, 0x14, 0x70, 0x96, 0x24, 0xe5, 0x12, 0xa7, 0x55, 0x19, 0x97, 0xfe, 0x43, 0x73, 0xa9, 0x98,
-	0x14, 0x70, 0x96, 0x24, 0x7a, 0x09, 0xca, 0x55, 0x76, 0xab, 0x99, 0xf7, 0xf1, 0xda, 0xc6, 0xf5,
-	0x30, 0x59, 0x8d, 0x48, 0x4c, 0x82, 0x44, 0xa4, 0x83, 0x7b, 0x58, 0x8c, 0x42, 0x79, 0xb6, 0x07,
-	0x1e, 0xee, 0x49, 0x81, 0x1e, 0x74, 0x98, 0x9b, 0xdc, 0x4f, 0x76, 0x98, 0x10, 0x11, 0xf1, 0x03,
-	0xea, 0xa0, 0x53, 0xd1, 0x0b, 0xb1, 0x89, 0x8b, 0x7e, 0xc9, 0x81, 0xd1, 0xa6, 0x74, 0x24, 0xe0,
-	0x4e, 0x53, 0xe6, 0x36, 0xc5, 0x56, 0xa6, 0xdf, 0x92, 0x4e, 0x99, 0x6b, 0x23, 0x06, 0x08, 0x9b,
-	0xbc, 0xb3, 0x19, 0x7b, 0x86, 0x0e, 0x98, 0xb1, 0xe7, 0xfb, 0x0e, 0x8c, 0x67, 0xb9, 0xa1, 0x4d,
-	0x78, 0xa8, 0xe5, 0x45, 0x9b, 0xd7, 0x82, 0x8d, 0x88, 0xdd, 0x5e, 0x49, 0xf8, 0x64, 0x98, 0xde,
-	0x48, 0x48, 0x34, 0xe7, 0xed, 0x70, 0xc7, 0x6c, 0x51, 0xbd, 0xa9, 0xf5, 0xd0, 0xf2, 0x7e, 0xc8,
-	0x78, 0x7f, 0x5a, 0xa8, 0x02, 0xe7, 0x28, 0x02, 0x4b, 0xe8, 0xe7, 0x87, 0x41, 0xca, 0xa4, 0xc0,
-	0x98, 0xa8, 0x08, 0xca, 0xe5, 0x3c, 0x24, 0x9c, 0x5f, 0xd7, 0xbd, 0x02, 0x03, 0xfc, 0x32, 0xe1,
-	0x5d, 0x79, 0xb6, 0xdc, 0x7f, 0x57, 0x00, 0xa9, 0x5a, 0xfe, 0xd5, 0x76, 0x14, 0xd2, 0x4d, 0x34,
-	0x62, 0x6a, 0x93, 0xb0, 0x97, 0xb0, 0x4d, 0x54, 0xa4, 0xce, 0x14, 0x25, 0x54, 0xe7, 0x26, 0xb7,
-	0xfc, 0x64, 0x36, 0xac, 0x49, 0x2b, 0x09, 0xd3, 0xb9, 0xaf, 0x08, 0x18, 0x56, 0xa5, 0xee, 0xa7,
-	0x1d, 0x18, 0xa5, 0xbd, 0x6c, 0x36, 0x49, 0xb3, 0x92, 0x90, 0x76, 0x8c, 0x62, 0x28, 0xc6, 0xf4,
-	0x1f, 0x7b, 0xc6, 0xc4, 0xf4, 0x02, 0x2a, 0x69, 0x6b, 0x5e, 0x24, 0xca, 0x04, 0x73, 0x5e, 0xee,
-	0x77, 0xfa, 0x60, 0x58, 0x0d, 0xf6, 0x01, 0xec, 0xb7, 0x97, 0xd3, 0xac, 0xb6, 0x5c, 0x02, 0x97,
-	0xb5, 0x8c, 0xb6, 0xb7, 0xe9, 0xd0, 0x05, 0x3b, 0x3c, 0x7f, 0x47, 0x9a, 0xde, 0xf6, 0x49, 0xd3,
-	0x09, 0x7e, 0x5e, 0x9f, 0x7f, 0x1a, 0xbe, 0xf0, 0x86, 0xdf, 0xd2, 0x63, 0x10, 0xfa, 0x6d, 0xed,
-	0x66, 0xca, 0xc1, 0xda, 0x3b, 0xf8, 0x20, 0xf3, 0x4e, 0x52, 0xf1, 0x40, 0xef, 0x24, 0x3d, 0x01,
-	0xfd, 0x24, 0xe8, 0xb4, 0x98, 0xaa, 0x34, 0xcc, 0x0e, 0x19, 0xfd, 0x57, 0x82, 0x4e, 0xcb, 0xec,
-	0x19, 0x43, 0x41, 0x1f, 0x80, 0x52, 0x8d, 0xc4, 0xd5, 0xc8, 0x67, 0x49, 0x29, 0x84, 0x6d, 0xe8,
-	0x41, 0x66, 0x70, 0x4b, 0xc1, 0x66, 0x45, 0xbd, 0x82, 0xfb, 0x2a, 0x0c, 0xac, 0x36, 0x3b, 0x75,
-	0x3f, 0x40, 0x6d, 0x18, 0xe0, 0x29, 0x2a, 0xc4, 0x6e, 0x6f, 0xe1, 0xe4, 0xca, 0x45, 0x85, 0x16,
-	0x1f, 0xc3, 0xef, 0x21, 0x0b, 0x3e, 0xee, 0xa7, 0x0a, 0x40, 0x0f, 0xf7, 0x0b, 0xb3, 0xe8, 0x6f,
-	0x76, 0xbd, 0xef, 0xf3, 0x33, 0x39, 0xef, 0xfb, 0x8c, 0x32, 0xe4, 0x9c, 0xa7, 0x7d, 0x9a, 0x30,
-	0xca, 0xbc, 0x31, 0x72, 0x0f, 0x14, 0x6a, 0xf5, 0xd3, 0x07, 0xcc, 0xea, 0xa0, 0x57, 0x15, 0x3b,
-	0x82, 0x0e, 0xc2, 0x26, 0x71, 0xb4, 0x0c, 0x67, 0x78, 0x72, 0xd4, 0x39, 0xd2, 0xf4, 0x76, 0x32,
-	0x49, 0xd0, 0x1e, 0x90, 0x2f, 0xbd, 0xcd, 0x75, 0xa3, 0xe0, 0xbc, 0x7a, 0xee, 0xef, 0xf5, 0x83,
-	0xe6, 0x03, 0x39, 0xc0, 0x6a, 0x79, 0x25, 0xe3, 0xf1, 0x5a, 0xb6, 0xe2, 0xf1, 0x92, 0x6e, 0x24,
-	0x2e, 0x81, 0x4c, 0x27, 0x17, 0x6d, 0x54, 0x83, 0x34, 0xdb, 0xa2, 0x8f, 0xaa, 0x51, 0x57, 0x49,
-	0xb3, 0x8d, 0x59, 0x89, 0xba, 0x85, 0xd9, 0xdf, 0xf3, 0x16, 0x66, 0x03, 0x8a, 0x75, 0xaf, 0x53,
-	0x27, 0x22, 0x36, 0xd4, 0x82, 0x73, 0x93, 0xdd, 0x0b, 0xe1, 0xce, 0x4d, 0xf6, 0x2f, 0xe6, 0x0c,
-	0xe8, 0x62, 0x6f, 0xc8, 0x60, 0x19, 0x61, 0xe6, 0xb5, 0xb0, 0xd8, 0x55, 0xfc, 0x0d, 0x5f, 0xec,
-	0xea, 0x27, 0x4e, 0x99, 0xa1, 0x36, 0x0c, 0x56, 0x79, 0x6e, 0x19, 0xa1, 0xb3, 0x5c, 0xb3, 0x71,
-	0xcd, 0x94, 0x11, 0xe4, 0xf6, 0x18, 0xf1, 0x03, 0x4b, 0x36, 0xee, 0x25, 0x28, 0x69, 0xcf, 0x8c,
-	0xd0, 0xcf, 0xa0, 0xd2, 0x9a, 0x68, 0x9f, 0x61, 0xce, 0x4b, 0x3c, 0xcc, 0x4a, 0xdc, 0x6f, 0xf6,
-	0x83, 0xb2, 0xc6, 0xe9, 0x97, 0x22, 0xbd, 0xaa, 0x96, 0x84, 0xc9, 0x48, 0x10, 0x10, 0x06, 0x58,
-	0x94, 0x52, 0xbd, 0xae, 0x45, 0xa2, 0xba, 0x3a, 0x47, 0x0b, 0x71, 0xad, 0xf4, 0xba, 0x65, 0xbd,
-	0x10, 0x9b, 0xb8, 0x54, 0x29, 0x6f, 0x89, 0x98, 0x80, 0x6c, 0xc8, 0xb7, 0x8c, 0x15, 0xc0, 0x0a,
-	0x83, 0x65, 0x71, 0x68, 0x69, 0x21, 0x04, 0x22, 0x44, 0xd4, 0x86, 0x4b, 0x4a, 0xa3, 0xca, 0x43,
-	0xb9, 0x74, 0x08, 0x36, 0xb8, 0xa2, 0x05, 0x38, 0x1d, 0x93, 0x64, 0x65, 0x3b, 0x20, 0x91, 0xca,
-	0x9f, 0x20, 0xd2, 0x84, 0xa8, 0x2b, 0x23, 0x95, 0x2c, 0x02, 0xee, 0xae, 0x93, 0x1b, 0x55, 0x5b,
-	0x3c, 0x74, 0x54, 0xed, 0x1c, 0x8c, 0x6f, 0x78, 0x7e, 0xb3, 0x13, 0x91, 0x9e, 0xb1, 0xb9, 0xf3,
-	0x99, 0x72, 0xdc, 0x55, 0x83, 0xdd, 0x5a, 0x6a, 0x7a, 0xf5, 0xb8, 0x3c, 0xa8, 0xdd, 0x5a, 0xa2,
-	0x00, 0xcc, 0xe1, 0xee, 0x6f, 0x3a, 0xc0, 0xf3, 0x33, 0x4d, 0x6f, 0x6c, 0xf8, 0x81, 0x9f, 0xec,
-	0xa0, 0xaf, 0x39, 0x30, 0x1e, 0x84, 0x35, 0x32, 0x1d, 0x24, 0xbe, 0x04, 0xda, 0xcb, 0xa9, 0xcf,
-	0x78, 0x5d, 0xcf, 0x90, 0xe7, 0xa6, 0xa6, 0x2c, 0x14, 0x77, 0x35, 0xc3, 0xbd, 0x00, 0xe7, 0x72,
-	0x09, 0xb8, 0xdf, 0xef, 0x03, 0x33, 0xcd, 0x14, 0x7a, 0x0e, 0x8a, 0x4d, 0x96, 0xf8, 0xc4, 0x39,
-	0x62, 0xfe, 0x30, 0x36, 0x56, 0x3c, 0x33, 0x0a, 0xa7, 0x84, 0xe6, 0xa0, 0xc4, 0x72, 0x57, 0x89,
-	0xb4, 0x34, 0x05, 0x23, 0xdf, 0x43, 0x09, 0xa7, 0x45, 0xb7, 0xcd, 0x9f, 0x58, 0xaf, 0x86, 0x5e,
-	0x83, 0xc1, 0x75, 0x9e, 0xe0, 0xd3, 0x9e, 0xd7, 0x50, 0x64, 0x0c, 0x65, 0xba, 0x91, 0x4c, 0x1f,
-	0x7a, 0x3b, 0xfd, 0x17, 0x4b, 0x8e, 0x68, 0x07, 0x86, 0x3c, 0xf9, 0x4d, 0xfb, 0x6d, 0x5d, 0x21,
-	0x31, 0xe6, 0x8f, 0x08, 0xd1, 0x91, 0xdf, 0x50, 0xb1, 0xcb, 0x04, 0x3d, 0x15, 0x0f, 0x14, 0xf4,
-	0xf4, 0x6d, 0x07, 0x20, 0x7d, 0x0d, 0x05, 0xdd, 0x82, 0xa1, 0xf8, 0x69, 0xc3, 0x50, 0x61, 0x23,
-	0xfd, 0x80, 0xa0, 0xa8, 0x5d, 0xd1, 0x15, 0x10, 0xac, 0xb8, 0xdd, 0xc9, 0xb8, 0xf2, 0x13, 0x07,
-	0xce, 0xe6, 0xbd, 0xda, 0x72, 0x0f, 0x5b, 0x7c, 0x58, 0xbb, 0x8a, 0xa8, 0xb0, 0x1a, 0x91, 0x0d,
-	0xff, 0x56, 0x4e, 0x9a, 0x69, 0x5e, 0x80, 0x53, 0x1c, 0xf7, 0xcf, 0x06, 0x41, 0x31, 0x3e, 0x26,
-	0x3b, 0xcc, 0x63, 0xf4, 0xcc, 0x54, 0x4f, 0x75, 0x2e, 0x85, 0x87, 0x19, 0x14, 0x8b, 0x52, 0x7a,
-	0x6e, 0x92, 0xe1, 0xfa, 0x42, 0x64, 0xb3, 0x59, 0x28, 0xc3, 0xfa, 0xb1, 0x2a, 0xcd, 0xb3, 0xec,
-	0x14, 0x4f, 0xc4, 0xb2, 0x33, 0x60, 0xdf, 0xb2, 0xd3, 0x02, 0x14, 0xf3, 0x85, 0xc2, 0xcc, 0x29,
-	0x82, 0xd1, 0xc8, 0xa1, 0x0d, 0xcd, 0x95, 0x2e, 0x22, 0x38, 0x87, 0x30, 0x8b, 0xc2, 0x08, 0x9b,
-	0x64, 0x1a, 0x5f, 0x17, 0x87, 0x8f, 0x34, 0x0a, 0x83, 0x83, 0xb1, 0x2c, 0x3f, 0xa2, 0x29, 0x05,
-	0xfd, 0xb6, 0xb3, 0x8f, 0xad, 0x6a, 0xd8, 0xd6, 0x16, 0x94, 0x9b, 0xe3, 0x8f, 0x9d, 0xa4, 0x8e,
-	0x62, 0x00, 0xfb, 0xba, 0x03, 0xa7, 0x49, 0x50, 0x8d, 0x76, 0x18, 0x1d, 0x41, 0x4d, 0x38, 0xc9,
-	0x6f, 0xd8, 0x58, 0xeb, 0x57, 0xb2, 0xc4, 0xb9, 0x2f, 0xaa, 0x0b, 0x8c, 0xbb, 0x9b, 0x81, 0x56,
-	0x60, 0xa8, 0xea, 0x89, 0x79, 0x51, 0x3a, 0xcc, 0xbc, 0xe0, 0xae, 0xbe, 0x69, 0x31, 0x1b, 0x14,
-	0x11, 0xf7, 0x47, 0x05, 0x38, 0x93, 0xd3, 0x24, 0x76, 0x93, 0xac, 0x45, 0x17, 0xc0, 0xb5, 0x5a,
-	0x76, 0xf9, 0x2f, 0x0a, 0x38, 0x56, 0x18, 0x68, 0x15, 0xce, 0x6e, 0xb6, 0xe2, 0x94, 0xca, 0x6c,
-	0x18, 0x24, 0xe4, 0x96, 0x14, 0x06, 0xd2, 0x81, 0x7e, 0x76, 0x31, 0x07, 0x07, 0xe7, 0xd6, 0xa4,
-	0xda, 0x12, 0x09, 0xbc, 0xf5, 0x26, 0x49, 0x8b, 0x44, 0xb8, 0x97, 0xd2, 0x96, 0xae, 0x64, 0xca,
-	0x71, 0x57, 0x0d, 0xf4, 0x96, 0x03, 0x0f, 0xc4, 0x24, 0xda, 0x22, 0x51, 0xc5, 0xaf, 0x91, 0xd9,
-	0x4e, 0x9c, 0x84, 0x2d, 0x12, 0x1d, 0xd1, 0x3a, 0x3b, 0xb9, 0xb7, 0x3b, 0xf9, 0x40, 0xa5, 0x37,
-	0x35, 0xbc, 0x1f, 0x2b, 0xf7, 0x2d, 0x07, 0xc6, 0x2a, 0xec, 0xec, 0xae, 0x54, 0x77, 0xdb, 0x59,
-	0x5e, 0x1f, 0x53, 0x49, 0x45, 0x32, 0x42, 0xd8, 0x4c, 0x03, 0xe2, 0xbe, 0x0c, 0xe3, 0x15, 0xd2,
-	0xf2, 0xda, 0x0d, 0x76, 0xbf, 0x9a, 0x07, 0x90, 0x5d, 0x82, 0xe1, 0x58, 0xc2, 0xb2, 0xef, 0x3e,
-	0x29, 0x64, 0x9c, 0xe2, 0xa0, 0x47, 0x79, 0xb0, 0x9b, 0xbc, 0x0a, 0x35, 0xcc, 0x0f, 0x39, 0x3c,
-	0x42, 0x2e, 0xc6, 0xb2, 0xcc, 0xfd, 0x76, 0x01, 0x46, 0xd2, 0xfa, 0x64, 0x03, 0xd5, 0xe1, 0x54,
-	0x55, 0xbb, 0x46, 0x98, 0x5e, 0xe0, 0x38, 0xf8, 0x8d, 0x43, 0x9e, 0x7c, 0xda, 0x24, 0x82, 0xb3,
-	0x54, 0x0f, 0x1f, 0x59, 0xf8, 0x5a, 0x26, 0xb2, 0xd0, 0xca, 0x83, 0x12, 0x95, 0x9d, 0xa0, 0xaa,
-	0xe2, 0x12, 0xc9, 0x86, 0x0c, 0x79, 0xe8, 0x0a, 0x54, 0xfc, 0x62, 0x01, 0x4e, 0xa9, 0x71, 0x12,
-	0x4e, 0xd2, 0x37, 0xb2, 0xf1, 0x84, 0xd8, 0x46, 0x6e, 0x26, 0xf3, 0xc3, 0xef, 0x13, 0x53, 0xf8,
-	0x46, 0x36, 0xa6, 0xf0, 0x58, 0xd9, 0x77, 0xf9, 0x7d, 0xbf, 0x5d, 0x80, 0x21, 0x95, 0x29, 0xea,
-	0x39, 0x28, 0xb2, 0x63, 0xf3, 0xdd, 0x29, 0xff, 0xec, 0x08, 0x8e, 0x39, 0x25, 0x4a, 0x92, 0xc5,
-	0x2c, 0x1d, 0x39, 0x1f, 0xf1, 0x30, 0x37, 0x9e, 0x7a, 0x51, 0x82, 0x39, 0x25, 0xb4, 0x08, 0x7d,
-	0x24, 0xa8, 0x89, 0xc9, 0x73, 0x78, 0x82, 0xec, 0x79, 0xb8, 0x2b, 0x41, 0x0d, 0x53, 0x2a, 0x2c,
-	0x5d, 0x1d, 0x57, 0xf6, 0x32, 0x01, 0xfb, 0x42, 0xd3, 0x13, 0xa5, 0xee, 0x0c, 0x18, 0xa9, 0x0c,
-	0x8f, 0x74, 0x61, 0xe4, 0x97, 0xfa, 0x60, 0xa0, 0xd2, 0x59, 0xa7, 0x67, 0xa2, 0x6f, 0x39, 0x70,
-	0x66, 0x3b, 0x93, 0xf0, 0x3b, 0x5d, 0xa4, 0x37, 0xec, 0x19, 0xa1, 0xf5, 0xd8, 0x3b, 0x65, 0x7a,
-	0xcb, 0x29, 0xc4, 0x79, 0xcd, 0x31, 0x72, 0xee, 0xf6, 0x1d, 0x4b, 0xce, 0xdd, 0x5b, 0xc7, 0x7c,
-	0xa9, 0x65, 0xb4, 0xd7, 0x85, 0x16, 0xf7, 0xf7, 0x8a, 0x00, 0xfc, 0x6b, 0xac, 0xb4, 0x93, 0x83,
-	0x98, 0x15, 0x9f, 0x81, 0x91, 0x3a, 0x09, 0x48, 0x24, 0x23, 0x2b, 0x33, 0x6f, 0x55, 0x2d, 0x68,
-	0x65, 0xd8, 0xc0, 0x64, 0x93, 0x25, 0x48, 0xa2, 0x1d, 0xae, 0xe7, 0x67, 0x2f, 0xae, 0xa8, 0x12,
-	0xac, 0x61, 0xa1, 0x29, 0xc3, 0xeb, 0xc3, 0x03, 0x08, 0xc6, 0xf6, 0x71, 0xd2, 0x7c, 0x00, 0xc6,
-	0xcc, 0x04, 0x35, 0x42, 0xdb, 0x54, 0x0e, 0x7f, 0x33, 0xaf, 0x0d, 0xce, 0x60, 0xd3, 0x85, 0x50,
-	0x8b, 0x76, 0x70, 0x27, 0x10, 0x6a, 0xa7, 0x5a, 0x08, 0x73, 0x0c, 0x8a, 0x45, 0x29, 0xcb, 0xec,
-	0xc1, 0x36, 0x60, 0x0e, 0x17, 0xd9, 0x41, 0xd2, 0xcc, 0x1e, 0x5a, 0x19, 0x36, 0x30, 0x29, 0x07,
-	0x61, 0x96, 0x05, 0x73, 0xa9, 0x65, 0x6c, 0xa9, 0x6d, 0x18, 0x0b, 0x4d, 0x73, 0x12, 0xd7, 0xc1,
-	0xde, 0x73, 0xc0, 0xa9, 0x67, 0xd4, 0xe5, 0x81, 0x1a, 0x19, 0xeb, 0x53, 0x86, 0x3e, 0xd5, 0xbb,
-	0xf5, 0x6b, 0x1b, 0x23, 0x66, 0x60, 0x6e, 0xcf, 0x9b, 0x15, 0xab, 0x70, 0xb6, 0x1d, 0xd6, 0x56,
-	0x23, 0x3f, 0x8c, 0xfc, 0x64, 0x67, 0xb6, 0xe9, 0xc5, 0x31, 0x9b, 0x18, 0xa3, 0xa6, 0x3e, 0xb6,
-	0x9a, 0x83, 0x83, 0x73, 0x6b, 0xd2, 0x03, 0x59, 0x5b, 0x00, 0x59, 0x78, 0x5c, 0x91, 0xef, 0x64,
-	0x12, 0x11, 0xab, 0x52, 0xf7, 0x0c, 0x9c, 0xae, 0x74, 0xda, 0xed, 0xa6, 0x4f, 0x6a, 0xca, 0xab,
-	0xe2, 0x7e, 0x10, 0x4e, 0x89, 0x8c, 0xbc, 0x4a, 0xfb, 0x39, 0x54, 0xfe, 0x78, 0xf7, 0xe7, 0xe1,
-	0x54, 0x66, 0x2b, 0xbd, 0x43, 0xc4, 0x87, 0xfb, 0x9f, 0xfb, 0x78, 0x15, 0x2d, 0xf8, 0x08, 0xbd,
-	0x96, 0xd5, 0x72, 0xec, 0xe4, 0x96, 0xd5, 0xf4, 0x1b, 0x91, 0x28, 0x36, 0x4f, 0x63, 0x6a, 0xc8,
-	0xbb, 0x07, 0xd6, 0xae, 0x08, 0xb1, 0x08, 0x7d, 0xbe, 0x0f, 0x19, 0x17, 0x18, 0x3e, 0x0e, 0xa0,
-	0xd8, 0xca, 0xf4, 0x05, 0xb6, 0xfb, 0xc9, 0x56, 0xbc, 0x82, 0xc4, 0x58, 0xe3, 0x88, 0x02, 0x18,
-	0x64, 0x0d, 0x21, 0xf2, 0x02, 0xab, 0xb5, 0xbe, 0x32, 0x25, 0x73, 0x99, 0xd3, 0xc6, 0x92, 0x89,
-	0xfb, 0xb9, 0x02, 0xe4, 0xc7, 0xc8, 0xa1, 0x8f, 0x77, 0x7f, 0xf0, 0xe7, 0x2c, 0x0e, 0x84, 0x08,
-	0xd2, 0xeb, 0xfd, 0xcd, 0x03, 0xf3, 0x9b, 0x2f, 0x5b, 0x1a, 0x07, 0xc1, 0xb7, 0xeb, 0xcb, 0xbb,
-	0xff, 0xc3, 0x81, 0xd2, 0xda, 0xda, 0x92, 0x52, 0x06, 0x30, 0x9c, 0x8f, 0x79, 0x6e, 0x08, 0x16,
-	0x08, 0x30, 0x1b, 0xb6, 0xda, 0x3c, 0x2e, 0x40, 0xc4, 0x2b, 0xb0, 0xf4, 0xd1, 0x95, 0x5c, 0x0c,
-	0xdc, 0xa3, 0x26, 0xba, 0x06, 0x67, 0xf4, 0x92, 0x8a, 0xf6, 0x98, 0x67, 0x51, 0xa4, 0x8a, 0xea,
-	0x2e, 0xc6, 0x79, 0x75, 0xb2, 0xa4, 0x84, 0xfd, 0x9b, 0x6d, 0xe8, 0x39, 0xa4, 0x44, 0x31, 0xce,
-	0xab, 0xe3, 0xae, 0x40, 0x69, 0xcd, 0x8b, 0x54, 0xc7, 0x3f, 0x04, 0xe3, 0xd5, 0xb0, 0x25, 0x15,
-	0x9c, 0x25, 0xb2, 0x45, 0x9a, 0xa2, 0xcb, 0xfc, 0x89, 0x9c, 0x4c, 0x19, 0xee, 0xc2, 0x76, 0x7f,
-	0xe3, 0x61, 0x50, 0x77, 0x5d, 0x0f, 0xb0, 0x07, 0xb7, 0x55, 0xf4, 0x70, 0xd1, 0x72, 0xf4, 0xb0,
-	0xda, 0x8d, 0x32, 0x11, 0xc4, 0x49, 0x1a, 0x41, 0x3c, 0x60, 0x3b, 0x82, 0x58, 0xa9, 0xe5, 0x5d,
-	0x51, 0xc4, 0x5f, 0x71, 0x60, 0x24, 0x08, 0x6b, 0x44, 0x39, 0x6c, 0x07, 0xd9, 0x0a, 0x7f, 0xc9,
-	0xde, 0x65, 0x0c, 0x1e, 0x0d, 0x2b, 0xc8, 0xf3, 0xc8, 0x76, 0xb5, 0x89, 0xeb, 0x45, 0xd8, 0x68,
-	0x07, 0x9a, 0xd7, 0x2c, 0xe1, 0xdc, 0xe1, 0xf4, 0x60, 0xde, 0x89, 0xf2, 0x8e, 0x66, 0xed, 0x5b,
-	0x9a, 0x66, 0x39, 0x6c, 0xcb, 0xc2, 0x2b, 0xef, 0x25, 0x6a, 0x7e, 0x33, 0x99, 0x01, 0x3d, 0xd5,
-	0x38, 0x5d, 0x18, 0xe0, 0x21, 0xf0, 0x22, 0x29, 0x19, 0x73, 0xe7, 0xf2, 0xf0, 0x78, 0x2c, 0x4a,
-	0x50, 0x22, 0x83, 0x42, 0x4a, 0xb6, 0xde, 0x33, 0x31, 0x82, 0x4e, 0xf2, 0xa3, 0x42, 0xd0, 0xb3,
-	0xba, 0xa5, 0x62, 0xe4, 0x20, 0x96, 0x8a, 0xd1, 0x9e, 0x56, 0x8a, 0x2f, 0x38, 0x30, 0x52, 0xd5,
-	0xde, 0x17, 0x29, 0x3f, 0x6e, 0xeb, 0x99, 0xf5, 0xbc, 0x67, 0x60, 0xb8, 0x97, 0xd0, 0x78, 0xcf,
-	0xc4, 0xe0, 0xce, 0x32, 0xb1, 0x32, 0xb3, 0x0c, 0x53, 0x8e, 0xac, 0x64, 0x38, 0x31, 0xcd, 0x3c,
-	0x32, 0xb8, 0x96, 0xc2, 0xb0, 0xe0, 0x85, 0x5e, 0x87, 0x21, 0x79, 0x8b, 0x42, 0xdc, 0x36, 0xc0,
-	0x36, 0xdc, 0x36, 0xa6, 0x6f, 0x58, 0xa6, 0x6f, 0xe4, 0x50, 0xac, 0x38, 0xa2, 0x06, 0xf4, 0xd5,
-	0xbc, 0xba, 0xb8, 0x77, 0xb0, 0x6c, 0x27, 0x3d, 0xae, 0xe4, 0xc9, 0x0e, 0xb1, 0x73, 0xd3, 0x0b,
-	0x98, 0xb2, 0x40, 0xb7, 0xd2, 0x07, 0x1a, 0xc6, 0xad, 0xed, 0xbe, 0xa6, 0x22, 0xc9, 0x75, 0x82,
-	0xae, 0xf7, 0x1e, 0x6a, 0xc2, 0x9d, 0xfe, 0xff, 0x31, 0xb6, 0xf3, 0x76, 0xf2, 0xeb, 0xf2, 0x8c,
-	0x39, 0xa9, 0x4b, 0x9e, 0x72, 0x69, 0x24, 0x49, 0xbb, 0xfc, 0xb3, 0xb6, 0xb8, 0xb0, 0xbc, 0x2f,
-	0xfc, 0x45, 0xfc, 0xb5, 0xb5, 0x55, 0xcc, 0xa8, 0xa3, 0x26, 0x0c, 0xb4, 0x59, 0xa4, 0x4f, 0xf9,
-	0xe7, 0x6c, 0xed, 0x2d, 0x3c, 0x72, 0x88, 0xcf, 0x4d, 0xfe, 0x3f, 0x16, 0x3c, 0xd0, 0x15, 0x18,
-	0xe4, 0xef, 0x0c, 0xf1, 0x7b, 0x1f, 0xa5, 0xcb, 0x13, 0xbd, 0x5f, 0x2b, 0x4a, 0x37, 0x0a, 0xfe,
-	0x3b, 0xc6, 0xb2, 0x2e, 0xfa, 0xa2, 0x03, 0x63, 0x54, 0xa2, 0xa6, 0x0f, 0x23, 0x95, 0x91, 0x2d,
-	0x99, 0x75, 0x23, 0xa6, 0x1a, 0x89, 0x94, 0x35, 0xea, 0x20, 0x79, 0xcd, 0x60, 0x87, 0x33, 0xec,
-	0xd1, 0x1b, 0x30, 0x14, 0xfb, 0x35, 0x52, 0xf5, 0xa2, 0xb8, 0x7c, 0xe6, 0x78, 0x9a, 0x92, 0x3a,
-	0xf0, 0x04, 0x23, 0xac, 0x58, 0xa2, 0x5f, 0x65, 0x0f, 0xd7, 0x56, 0x1b, 0xfe, 0x16, 0x59, 0x0a,
-	0xab, 0xfc, 0xe0, 0x73, 0xd6, 0xd6, 0xda, 0x97, 0xae, 0x4a, 0x49, 0x59, 0xf8, 0xb5, 0x4c, 0x76,
-	0x38, 0xcb, 0x1f, 0xfd, 0x2d, 0x07, 0xce, 0xf1, 0x17, 0x24, 0xb2, 0x8f, 0xa2, 0x9c, 0x3b, 0xa2,
-	0x11, 0x8b, 0x5d, 0x58, 0x99, 0xce, 0x23, 0x89, 0xf3, 0x39, 0xb1, 0x7c, 0xcf, 0xe6, 0x3b, 0x56,
-	0xe7, 0xad, 0x3a, 0xb2, 0x0f, 0xfe, 0x76, 0x15, 0x7a, 0x0a, 0x4a, 0x6d, 0xb1, 0x1d, 0xfa, 0x71,
-	0x8b, 0x5d, 0x3f, 0xea, 0xe3, 0x17, 0x43, 0x57, 0x53, 0x30, 0xd6, 0x71, 0x8c, 0xe4, 0xdf, 0x4f,
-	0xec, 0x97, 0xfc, 0x1b, 0xdd, 0x80, 0x52, 0x12, 0x36, 0x45, 0xfe, 0xdb, 0xb8, 0x5c, 0x66, 0x33,
-	0xf0, 0x62, 0xde, 0xda, 0x5a, 0x53, 0x68, 0xe9, 0x59, 0x3f, 0x85, 0xc5, 0x58, 0xa7, 0xc3, 0x02,
-	0xb6, 0xc5, 0xcb, 0x1c, 0x11, 0x3b, 0xe4, 0xdf, 0x9f, 0x09, 0xd8, 0xd6, 0x0b, 0xb1, 0x89, 0x8b,
-	0x16, 0xe0, 0x74, 0xbb, 0xcb, 0x4a, 0xc0, 0xaf, 0x3d, 0xaa, 0x18, 0x99, 0x6e, 0x13, 0x41, 0x77,
-	0x9d, 0x1e, 0x09, 0xae, 0x1f, 0x3c, 0x4a, 0x82, 0x6b, 0x54, 0x83, 0x07, 0xbd, 0x4e, 0x12, 0xb2,
-	0x8c, 0x45, 0x66, 0x15, 0x1e, 0x91, 0xfe, 0x30, 0x0f, 0x72, 0xdf, 0xdb, 0x9d, 0x7c, 0x70, 0x7a,
-	0x1f, 0x3c, 0xbc, 0x2f, 0x15, 0xf4, 0x2a, 0x0c, 0x11, 0x91, 0xa4, 0xbb, 0xfc, 0x33, 0xb6, 0xb6,
-	0x7e, 0x33, 0xed, 0xb7, 0x0c, 0xf6, 0xe5, 0x30, 0xac, 0xf8, 0xa1, 0x35, 0x28, 0x35, 0xc2, 0x38,
-	0x99, 0x6e, 0xfa, 0x5e, 0x4c, 0xe2, 0xf2, 0x43, 0x6c, 0x2a, 0xe4, 0x6a, 0x54, 0x57, 0x25, 0x5a,
-	0x3a, 0x13, 0xae, 0xa6, 0x35, 0xb1, 0x4e, 0x06, 0x11, 0xe6, 0xa4, 0x66, 0xe1, 0xf8, 0xd2, 0x01,
-	0x77, 0x91, 0x75, 0xec, 0xb1, 0x3c, 0xca, 0xab, 0x61, 0xad, 0x62, 0x62, 0x2b, 0x2f, 0xb5, 0x0e,
-	0xc4, 0x59, 0x9a, 0xe8, 0x19, 0x18, 0x69, 0x87, 0xb5, 0x4a, 0x9b, 0x54, 0x57, 0xbd, 0xa4, 0xda,
-	0x28, 0x4f, 0x9a, 0xd6, 0xc6, 0x55, 0xad, 0x0c, 0x1b, 0x98, 0xa8, 0x0d, 0x83, 0x2d, 0x9e, 0xa1,
-	0xa2, 0xfc, 0x88, 0xad, 0x13, 0x8b, 0x48, 0x79, 0x21, 0x2c, 0x03, 0xfc, 0x07, 0x96, 0x6c, 0xd0,
-	0x3f, 0x74, 0xe0, 0x54, 0xe6, 0x9a, 0x5c, 0xf9, 0x5d, 0x36, 0x7d, 0x3b, 0x1a, 0xe1, 0x99, 0xc7,
-	0xd8, 0xf0, 0x99, 0xc0, 0xdb, 0xdd, 0x20, 0x9c, 0x6d, 0x11, 0x1f, 0x17, 0x96, 0x66, 0xa6, 0xfc,
-	0xa8, 0xbd, 0x71, 0x61, 0x04, 0xe5, 0xb8, 0xb0, 0x1f, 0x58, 0xb2, 0x41, 0x4f, 0xc0, 0xa0, 0x48,
-	0x1d, 0x59, 0x7e, 0xcc, 0x74, 0xfd, 0x8b, 0x0c, 0x93, 0x58, 0x96, 0x77, 0xa5, 0x8e, 0x79, 0xd2,
-	0x56, 0xea, 0x18, 0x75, 0xde, 0x3b, 0x7c, 0xea, 0x98, 0x89, 0x0f, 0xc2, 0xe9, 0xae, 0x53, 0xe2,
-	0xa1, 0x72, 0xb7, 0xdc, 0x65, 0xee, 0x17, 0xf7, 0xd7, 0x1d, 0xd0, 0x93, 0x05, 0x58, 0x7f, 0xee,
-	0xe7, 0x19, 0x18, 0xa9, 0xf2, 0xd7, 0x57, 0x79, 0xba, 0x81, 0x7e, 0xd3, 0x98, 0x3d, 0xab, 0x95,
-	0x61, 0x03, 0xd3, 0xbd, 0x0a, 0xa8, 0xfb, 0x2d, 0x86, 0x23, 0x79, 0x85, 0xfe, 0xb1, 0x03, 0xa3,
-	0x86, 0x7a, 0x63, 0xdd, 0x63, 0x3d, 0x0f, 0xa8, 0xe5, 0x47, 0x51, 0x18, 0xe9, 0xcf, 0x5c, 0x8a,
-	0x94, 0x20, 0x2c, 0x92, 0x65, 0xb9, 0xab, 0x14, 0xe7, 0xd4, 0x70, 0xff, 0x69, 0x3f, 0xa4, 0x21,
-	0xfc, 0x2a, 0x53, 0xb5, 0xd3, 0x33, 0x53, 0xf5, 0x93, 0x30, 0xf4, 0x72, 0x1c, 0x06, 0xab, 0x69,
-	0x3e, 0x6b, 0xf5, 0x2d, 0x9e, 0xad, 0xac, 0x5c, 0x67, 0x98, 0x0a, 0x83, 0x61, 0xbf, 0x32, 0xef,
-	0x37, 0x93, 0xee, 0x84, 0xc7, 0xcf, 0x3e, 0xc7, 0xe1, 0x58, 0x61, 0xb0, 0x17, 0x2f, 0xb7, 0x88,
-	0xf2, 0x72, 0xa4, 0x2f, 0x5e, 0xf2, 0x67, 0x56, 0x58, 0x19, 0xba, 0x04, 0xc3, 0xca, 0x43, 0x22,
-	0xdc, 0x2e, 0x6a, 0xa4, 0x94, 0x1b, 0x05, 0xa7, 0x38, 0x4c, 0x77, 0x15, 0x56, 0x75, 0x61, 0xed,
-	0xa9, 0xd8, 0x38, 0x49, 0x65, 0xec, 0xf4, 0x7c, 0xc3, 0x92, 0x60, 0xac, 0x58, 0xe6, 0x79, 0xed,
-	0x87, 0x8f, 0xc5, 0x6b, 0xaf, 0xdd, 0x27, 0x29, 0x1e, 0xf4, 0x3e, 0x89, 0x39, 0xb7, 0x87, 0x0e,
-	0x34, 0xb7, 0x3f, 0xd3, 0x07, 0x83, 0xcf, 0x93, 0x88, 0x3d, 0x15, 0xf0, 0x04, 0x0c, 0x6e, 0xf1,
-	0x7f, 0xb3, 0x97, 0x91, 0x05, 0x06, 0x96, 0xe5, 0xf4, 0xbb, 0xad, 0x77, 0xfc, 0x66, 0x6d, 0x2e,
-	0x5d, 0xc5, 0x69, 0x2a, 0x4f, 0x59, 0x80, 0x53, 0x1c, 0x5a, 0xa1, 0x4e, 0x0f, 0x21, 0xad, 0x96,
-	0x9f, 0x64, 0x83, 0xf0, 0x16, 0x64, 0x01, 0x4e, 0x71, 0xd0, 0x63, 0x30, 0x50, 0xf7, 0x93, 0x35,
-	0xaf, 0x9e, 0x75, 0xfb, 0x2e, 0x30, 0x28, 0x16, 0xa5, 0xcc, 0xe7, 0xe7, 0x27, 0x6b, 0x11, 0x61,
-	0x46, 0xe8, 0xae, 0x6c, 0x2a, 0x0b, 0x5a, 0x19, 0x36, 0x30, 0x59, 0x93, 0x42, 0xd1, 0x33, 0x11,
-	0x81, 0x9c, 0x36, 0x49, 0x16, 0xe0, 0x14, 0x87, 0xce, 0xff, 0x6a, 0xd8, 0x6a, 0xfb, 0x4d, 0x11,
-	0x1b, 0xaf, 0xcd, 0xff, 0x59, 0x01, 0xc7, 0x0a, 0x83, 0x62, 0x53, 0x11, 0x46, 0xc5, 0x4f, 0xf6,
-	0x75, 0xc1, 0x55, 0x01, 0xc7, 0x0a, 0xc3, 0x7d, 0x1e, 0x46, 0xf9, 0x4a, 0x9e, 0x6d, 0x7a, 0x7e,
-	0x6b, 0x61, 0x16, 0x5d, 0xe9, 0xba, 0x4f, 0xf2, 0x44, 0xce, 0x7d, 0x92, 0x73, 0x46, 0xa5, 0xee,
-	0x7b, 0x25, 0xee, 0x0f, 0x0a, 0x30, 0x74, 0x82, 0x0f, 0xb4, 0x9e, 0xf8, 0x5b, 0xe3, 0xe8, 0x56,
-	0xe6, 0x71, 0xd6, 0x55, 0x9b, 0xd7, 0xc3, 0xf6, 0x7d, 0x98, 0xf5, 0xbf, 0x14, 0xe0, 0xbc, 0x44,
-	0x95, 0xc7, 0xce, 0x85, 0x59, 0xf6, 0xe8, 0xdd, 0xf1, 0x0f, 0x74, 0x64, 0x0c, 0xf4, 0xaa, 0xbd,
-	0x83, 0xf3, 0xc2, 0x6c, 0xcf, 0xa1, 0x7e, 0x35, 0x33, 0xd4, 0xd8, 0x2a, 0xd7, 0xfd, 0x07, 0xfb,
-	0x2f, 0x1c, 0x98, 0xc8, 0x1f, 0xec, 0x13, 0x78, 0x0f, 0xf7, 0x0d, 0xf3, 0x3d, 0xdc, 0x5f, 0xb0,
-	0x37, 0xc5, 0xcc, 0xae, 0xf4, 0x78, 0x19, 0xf7, 0xbf, 0x3b, 0x70, 0x56, 0x56, 0x60, 0xbb, 0xe7,
-	0x8c, 0x1f, 0xb0, 0xc8, 0xa4, 0xe3, 0x9f, 0x66, 0xaf, 0x1b, 0xd3, 0xec, 0x45, 0x7b, 0x1d, 0xd7,
-	0xfb, 0xd1, 0x6b, 0xc2, 0xb9, 0x7f, 0xee, 0x40, 0x39, 0xaf, 0xc2, 0x09, 0x7c, 0xf2, 0xd7, 0xcc,
-	0x4f, 0xfe, 0xfc, 0xf1, 0xf4, 0xbc, 0xf7, 0x07, 0x2f, 0xf7, 0x1a, 0x28, 0xd4, 0x94, 0x7a, 0x95,
-	0x63, 0xcb, 0x7d, 0xce, 0x59, 0xe4, 0x2b, 0x68, 0x4d, 0x18, 0x88, 0x59, 0x08, 0x8e, 0x98, 0x02,
-	0x57, 0x6d, 0x68, 0x5b, 0x94, 0x9e, 0x70, 0x07, 0xb0, 0xff, 0xb1, 0xe0, 0xe1, 0xfe, 0x66, 0x01,
-	0x2e, 0xa8, 0x77, 0xae, 0xc9, 0x16, 0x69, 0xa6, 0xeb, 0x83, 0xbd, 0x8a, 0xe2, 0xa9, 0x9f, 0xf6,
-	0x5e, 0x45, 0x49, 0x59, 0xa4, 0x6b, 0x21, 0x85, 0x61, 0x8d, 0x27, 0xaa, 0xc0, 0x39, 0xf6, 0x8a,
-	0xc9, 0xbc, 0x1f, 0x78, 0x4d, 0xff, 0x55, 0x12, 0x61, 0xd2, 0x0a, 0xb7, 0xbc, 0xa6, 0xd0, 0xd4,
-	0xd5, 0x7d, 0xf4, 0xf9, 0x3c, 0x24, 0x9c, 0x5f, 0xb7, 0xcb, 0x8c, 0xd0, 0x77, 0x50, 0x33, 0x82,
-	0xfb, 0x27, 0x0e, 0x8c, 0x9c, 0xe0, 0xab, 0xe0, 0xa1, 0xb9, 0x24, 0x9e, 0xb5, 0xb7, 0x24, 0x7a,
-	0x2c, 0x83, 0xdd, 0x22, 0x74, 0x3d, 0x94, 0x8c, 0x3e, 0xeb, 0xa8, 0x20, 0x25, 0x1e, 0x0c, 0xfa,
-	0x11, 0x7b, 0xed, 0x38, 0x4c, 0xd6, 0x54, 0xf4, 0xf5, 0x8c, 0x3d, 0xa0, 0x60, 0x2b, 0xc1, 0x59,
-	0x57, 0x6b, 0x8e, 0x90, 0x52, 0xf6, 0x2b, 0x0e, 0x00, 0x6f, 0xa7, 0x48, 0x59, 0x4f, 0xdb, 0xb6,
-	0x7e, 0x6c, 0x23, 0x45, 0x99, 0xf0, 0xa6, 0xa9, 0x25, 0x94, 0x16, 0x60, 0xad, 0x25, 0x77, 0x91,
-	0x2b, 0xf6, 0xae, 0xd3, 0xd4, 0x7e, 0xd1, 0x81, 0x53, 0x99, 0xe6, 0xe6, 0xd4, 0xdf, 0x30, 0xdf,
-	0xf5, 0xb4, 0xa0, 0x59, 0x99, 0x89, 0xcc, 0x75, 0xe3, 0xc9, 0x3f, 0x77, 0xc1, 0x78, 0x61, 0x1e,
-	0xbd, 0x06, 0xc3, 0xd2, 0xf2, 0x21, 0xa7, 0xb7, 0xcd, 0xf7, 0x8d, 0xd5, 0xf1, 0x46, 0x42, 0x62,
-	0x9c, 0xf2, 0xcb, 0xc4, 0x40, 0x16, 0x0e, 0x14, 0x03, 0x79, 0x6f, 0x5f, 0x47, 0xce, 0x37, 0xb6,
-	0xf7, 0x1f, 0x8b, 0xb1, 0xfd, 0x41, 0xeb, 0xc6, 0xf6, 0x87, 0x4e, 0xd8, 0xd8, 0xae, 0xf9, 0x33,
-	0x8b, 0x77, 0xe1, 0xcf, 0x7c, 0x0d, 0xce, 0x6e, 0xa5, 0x87, 0x4e, 0x35, 0x93, 0x44, 0x52, 0xac,
-	0x27, 0x72, 0x4d, 0xec, 0xf4, 0x00, 0x1d, 0x27, 0x24, 0x48, 0xb4, 0xe3, 0x6a, 0x1a, 0x7e, 0xf9,
-	0x7c, 0x0e, 0x39, 0x9c, 0xcb, 0x24, 0xeb, 0x98, 0x1a, 0x3c, 0x80, 0x63, 0xea, 0x3b, 0x0e, 0x9c,
-	0xf3, 0xba, 0x2e, 0x30, 0x62, 0xb2, 0x21, 0xa2, 0x63, 0x6e, 0xda, 0x53, 0x21, 0x0c, 0xf2, 0xc2,
-	0x03, 0x98, 0x57, 0x84, 0xf3, 0x1b, 0x84, 0x1e, 0x4d, 0xa3, 0x04, 0x78, 0xd0, 0x6e, 0xbe, 0x4b,
-	0xff, 0xeb, 0xd9, 0xd0, 0x23, 0x60, 0x43, 0xff, 0x31, 0xbb, 0xa7, 0x6d, 0x0b, 0xe1, 0x47, 0xa5,
-	0xbb, 0x08, 0x3f, 0xca, 0x78, 0x09, 0x47, 0x2c, 0x79, 0x09, 0x03, 0x18, 0xf7, 0x5b, 0x5e, 0x9d,
-	0xac, 0x76, 0x9a, 0x4d, 0x7e, 0x23, 0x49, 0xbe, 0x40, 0x9d, 0x6b, 0xc1, 0x5b, 0x0a, 0xab, 0x5e,
-	0x53, 0xe4, 0xfc, 0x50, 0x01, 0xcb, 0xea, 0xe6, 0xd5, 0xb5, 0x0c, 0x25, 0xdc, 0x45, 0x9b, 0x4e,
-	0x58, 0x96, 0xdf, 0x91, 0x24, 0x74, 0xb4, 0x59, 0x8c, 0xcb, 0x10, 0x9f, 0xb0, 0x57, 0x53, 0x30,
-	0xd6, 0x71, 0xd0, 0x22, 0x0c, 0xd7, 0x82, 0x58, 0xdc, 0xc5, 0x3e, 0xc5, 0x84, 0xd9, 0xbb, 0xa9,
-	0x08, 0x9c, 0xbb, 0x5e, 0x51, 0xb7, 0xb0, 0x1f, 0xcc, 0x49, 0x58, 0xaa, 0xca, 0x71, 0x5a, 0x1f,
-	0x2d, 0x33, 0x62, 0xe2, 0x6d, 0x3d, 0x1e, 0x7a, 0xf2, 0x70, 0x0f, 0x2f, 0xd8, 0xdc, 0x75, 0xf9,
-	0x3a, 0xe0, 0xa8, 0x60, 0x27, 0x1e, 0xc9, 0x4b, 0x29, 0x68, 0x2f, 0x81, 0x9f, 0xde, 0xf7, 0x25,
-	0x70, 0x96, 0xa9, 0x38, 0x69, 0x2a, 0x4f, 0xf6, 0x45, 0x6b, 0x99, 0x8a, 0xd3, 0xa0, 0x4e, 0x91,
-	0xa9, 0x38, 0x05, 0x60, 0x9d, 0x25, 0x5a, 0xe9, 0xe5, 0xd1, 0x3f, 0xc3, 0x84, 0xc6, 0xe1, 0xfd,
-	0xf3, 0x7a, 0xe8, 0xf7, 0xd9, 0xfd, 0x42, 0xbf, 0xbb, 0x5d, 0xd1, 0xe7, 0x0e, 0xe1, 0x8a, 0x6e,
-	0xb0, 0x1c, 0xb2, 0x0b, 0xb3, 0xc2, 0xfb, 0x6f, 0xe1, 0x7c, 0xc7, 0x72, 0xce, 0xf0, 0x20, 0x59,
-	0xf6, 0x2f, 0xe6, 0x0c, 0x7a, 0x46, 0xc7, 0x5f, 0x38, 0x72, 0x74, 0x7c, 0xc6, 0x9f, 0x7b, 0xff,
-	0xb1, 0xf9, 0x73, 0x27, 0x4e, 0xc0, 0x9f, 0xfb, 0xc0, 0x81, 0xfd, 0xb9, 0xb7, 0xe0, 0x4c, 0x3b,
-	0xac, 0xcd, 0xf9, 0x71, 0xd4, 0x61, 0xf7, 0x2d, 0x67, 0x3a, 0xb5, 0x3a, 0x49, 0x98, 0x43, 0xb8,
-	0x74, 0xf9, 0xdd, 0x7a, 0x23, 0xdb, 0x6c, 0x55, 0xca, 0x05, 0x97, 0xa9, 0xc0, 0xec, 0x20, 0x2c,
-	0xda, 0x37, 0xa7, 0x10, 0xe7, 0xb1, 0xd0, 0x3d, 0xc9, 0x0f, 0x9f, 0x8c, 0x27, 0xf9, 0x43, 0x30,
-	0x14, 0x37, 0x3a, 0x49, 0x2d, 0xdc, 0x0e, 0x58, 0xb8, 0xc0, 0xf0, 0xcc, 0xbb, 0x94, 0x5d, 0x5a,
-	0xc0, 0x6f, 0xef, 0x4e, 0x8e, 0xcb, 0xff, 0x35, 0x93, 0xb4, 0x80, 0xa0, 0x6f, 0xf4, 0xb8, 0x59,
-	0xe5, 0x1e, 0xe7, 0xcd, 0xaa, 0x0b, 0x87, 0xba, 0x55, 0x95, 0xe7, 0x2e, 0x7f, 0xe4, 0xa7, 0xce,
-	0x5d, 0xfe, 0x35, 0x07, 0x46, 0xb7, 0x74, 0xfb, 0xbf, 0x70, 0xe9, 0x5b, 0x08, 0x18, 0x32, 0xdc,
-	0x0a, 0x33, 0x2e, 0x15, 0x5a, 0x06, 0xe8, 0x76, 0x16, 0x80, 0xcd, 0x96, 0xe4, 0x04, 0x33, 0x3d,
-	0x7a, 0xaf, 0x82, 0x99, 0xde, 0x80, 0x52, 0x3b, 0xac, 0xc9, 0x13, 0x2b, 0xf3, 0xf3, 0xdb, 0x8d,
-	0x65, 0xe6, 0xfa, 0x67, 0xca, 0x02, 0xeb, 0xfc, 0xd0, 0x17, 0x1c, 0x18, 0x97, 0x87, 0x2c, 0xe1,
-	0xbf, 0x8b, 0x45, 0x34, 0xa6, 0xcd, 0xb3, 0x1d, 0x4f, 0x6a, 0x9c, 0xe1, 0x83, 0xbb, 0x38, 0x53,
-	0x85, 0x44, 0x05, 0xbf, 0xd5, 0x63, 0x16, 0x74, 0x2c, 0x14, 0x92, 0xe9, 0x14, 0x8c, 0x75, 0x1c,
-	0xf4, 0x4d, 0x07, 0x8a, 0x8d, 0x30, 0xdc, 0x8c, 0xcb, 0x4f, 0x30, 0x81, 0xfe, 0x82, 0x65, 0x45,
-	0xf3, 0x2a, 0xa5, 0xcd, 0x35, 0xcc, 0xa7, 0xa4, 0x21, 0x88, 0xc1, 0x6e, 0xef, 0x4e, 0x8e, 0x19,
-	0xef, 0x71, 0xc5, 0x6f, 0xbe, 0xa3, 0x41, 0x84, 0xa1, 0x92, 0x35, 0x0d, 0xbd, 0xed, 0xc0, 0xf8,
-	0x76, 0xc6, 0x3a, 0x21, 0xc2, 0x51, 0xb1, 0x7d, 0xbb, 0x07, 0x1f, 0xee, 0x2c, 0x14, 0x77, 0xb5,
-	0x00, 0x7d, 0xde, 0xb4, 0x5a, 0xf2, 0xb8, 0x55, 0x8b, 0x03, 0x98, 0xb1, 0x92, 0xf2, 0xeb, 0x48,
-	0xf9, 0xe6, 0xcb, 0xbb, 0x0f, 0x16, 0xa1, 0x9d, 0x49, 0x3f, 0x56, 0x4e, 0x55, 0x62, 0x1a, 0x4f,
-	0x2c, 0x2c, 0x76, 0xe3, 0xf3, 0xeb, 0xb6, 0x93, 0xb7, 0xcf, 0xc3, 0x98, 0xe9, 0xa8, 0x43, 0xef,
-	0x31, 0xdf, 0x44, 0xb9, 0x98, 0x7d, 0x5e, 0x62, 0x54, 0xe2, 0x1b, 0x4f, 0x4c, 0x18, 0x6f, 0x40,
-	0x14, 0x8e, 0xf5, 0x0d, 0x88, 0xbe, 0x93, 0x79, 0x03, 0x62, 0xfc, 0x38, 0xde, 0x80, 0x38, 0x7d,
-	0xa8, 0x37, 0x20, 0xb4, 0x37, 0x38, 0xfa, 0xef, 0xf0, 0x06, 0xc7, 0x34, 0x9c, 0x92, 0x77, 0x8e,
-	0x88, 0x48, 0xb3, 0xcf, 0x7d, 0xf8, 0xea, 0x99, 0xf8, 0x59, 0xb3, 0x18, 0x67, 0xf1, 0xe9, 0x22,
-	0x2b, 0x06, 0xac, 0xe6, 0x80, 0xad, 0xa0, 0x2c, 0x73, 0x6a, 0xb1, 0xb3, 0xb0, 0x10, 0x51, 0x32,
-	0xca, 0xba, 0xc8, 0x60, 0xb7, 0xe5, 0x3f, 0x98, 0xb7, 0x00, 0xbd, 0x04, 0xe5, 0x70, 0x63, 0xa3,
-	0x19, 0x7a, 0xb5, 0xf4, 0xa1, 0x0a, 0x19, 0x64, 0xc0, 0x6f, 0xd5, 0xaa, 0xac, 0xc4, 0x2b, 0x3d,
-	0xf0, 0x70, 0x4f, 0x0a, 0xe8, 0x3b, 0x54, 0x31, 0x49, 0xc2, 0x88, 0xd4, 0x52, 0xc3, 0xcb, 0x30,
-	0xeb, 0x33, 0xb1, 0xde, 0xe7, 0x8a, 0xc9, 0x87, 0xf7, 0x5e, 0x7d, 0x94, 0x4c, 0x29, 0xce, 0x36,
-	0x0b, 0x45, 0x70, 0xbe, 0x9d, 0x67, 0xf7, 0x89, 0xc5, 0x4d, 0xa9, 0xfd, 0xac, 0x4f, 0xea, 0x31,
-	0xf4, 0x5c, 0xcb, 0x51, 0x8c, 0x7b, 0x50, 0xd6, 0x1f, 0x93, 0x18, 0x3a, 0x99, 0xc7, 0x24, 0x3e,
-	0x01, 0x50, 0x95, 0x49, 0xe9, 0xa4, 0x25, 0x61, 0xd1, 0xca, 0x15, 0x1e, 0x4e, 0x53, 0x7b, 0x17,
-	0x58, 0xb1, 0xc1, 0x1a, 0x4b, 0xf4, 0xbf, 0x73, 0x5f, 0x5b, 0xe1, 0xe6, 0x92, 0xba, 0xf5, 0x39,
-	0xf1, 0x53, 0xf7, 0xe2, 0xca, 0x3f, 0x72, 0x60, 0x82, 0xcf, 0xbc, 0xac, 0x72, 0x4f, 0x55, 0x0b,
-	0x71, 0xa7, 0xc8, 0x76, 0x1c, 0x0a, 0x4f, 0x2e, 0x65, 0x70, 0x65, 0x5e, 0xeb, 0x7d, 0x5a, 0x82,
-	0xbe, 0x92, 0x73, 0xa4, 0x38, 0x65, 0xcb, 0x00, 0x99, 0xff, 0x66, 0xc6, 0x99, 0xbd, 0x83, 0x9c,
-	0x22, 0xfe, 0x49, 0x4f, 0xfb, 0x28, 0x62, 0xcd, 0xfb, 0xc5, 0x63, 0xb2, 0x8f, 0xea, 0x0f, 0x7b,
-	0x1c, 0xca, 0x4a, 0xfa, 0x45, 0x07, 0xc6, 0xbd, 0x4c, 0xdc, 0x08, 0x33, 0xea, 0x58, 0x31, 0x30,
-	0x4d, 0x47, 0x69, 0x30, 0x0a, 0x53, 0xf2, 0xb2, 0x21, 0x2a, 0xb8, 0x8b, 0x39, 0xfa, 0x81, 0x03,
-	0x0f, 0xa4, 0xaf, 0x87, 0xc4, 0xe9, 0x1d, 0x61, 0xd1, 0xb8, 0xb3, 0x6c, 0x35, 0xbe, 0x62, 0x7d,
-	0x35, 0xae, 0xf5, 0xe6, 0xc9, 0xd7, 0xe5, 0x23, 0x62, 0x5d, 0x3e, 0xb0, 0x0f, 0x26, 0xde, 0xaf,
-	0xe9, 0x13, 0x9f, 0x75, 0xf8, 0xf3, 0x6a, 0x3d, 0x55, 0xbe, 0x75, 0x53, 0xe5, 0x5b, 0xb2, 0xf9,
-	0xc0, 0x93, 0xae, 0x7b, 0xfe, 0x8a, 0x03, 0x67, 0xf3, 0x76, 0xa4, 0x9c, 0x26, 0x7d, 0xcc, 0x6c,
-	0x92, 0xc5, 0x53, 0x96, 0xde, 0x20, 0x2b, 0xaf, 0xc3, 0x4c, 0x5c, 0x87, 0x87, 0xef, 0xf4, 0x15,
-	0xef, 0x44, 0x6f, 0x48, 0x57, 0x8b, 0xff, 0x7c, 0x58, 0x73, 0x29, 0x26, 0xa4, 0x6d, 0x3d, 0x20,
-	0x3b, 0x80, 0x01, 0x3f, 0x68, 0xfa, 0x01, 0x11, 0xf7, 0x44, 0x6d, 0x9e, 0x61, 0xc5, 0xfb, 0x50,
-	0x94, 0x3a, 0x16, 0x5c, 0xee, 0xb1, 0x87, 0x31, 0xfb, 0xe2, 0x5e, 0xff, 0xc9, 0xbf, 0xb8, 0xb7,
-	0x0d, 0xc3, 0xdb, 0x7e, 0xd2, 0x60, 0x91, 0x11, 0xc2, 0x71, 0x67, 0xe1, 0x7e, 0x25, 0x25, 0x97,
-	0xf6, 0xfd, 0xa6, 0x64, 0x80, 0x53, 0x5e, 0xe8, 0x12, 0x67, 0xcc, 0xc2, 0xb0, 0xb3, 0xf1, 0xb1,
-	0x37, 0x65, 0x01, 0x4e, 0x71, 0xe8, 0x60, 0x8d, 0xd0, 0x5f, 0x32, 0x5b, 0x95, 0x48, 0x20, 0x6d,
-	0x23, 0x31, 0xa8, 0xa0, 0xc8, 0x6f, 0x31, 0xdf, 0xd4, 0x78, 0x60, 0x83, 0xa3, 0xca, 0xe1, 0x3d,
-	0xd4, 0x33, 0x87, 0xf7, 0xeb, 0x4c, 0x61, 0x4b, 0xfc, 0xa0, 0x43, 0x56, 0x02, 0x11, 0xbc, 0xbd,
-	0x64, 0xe7, 0xce, 0x35, 0xa7, 0xc9, 0x8f, 0xe0, 0xe9, 0x6f, 0xac, 0xf1, 0xd3, 0xfc, 0x27, 0xa5,
-	0x7d, 0xfd, 0x27, 0xa9, 0xc9, 0x65, 0xc4, 0xba, 0xc9, 0x25, 0x21, 0x6d, 0x2b, 0x26, 0x97, 0x9f,
-	0x2a, 0x73, 0xc0, 0x5f, 0x38, 0x80, 0x94, 0xde, 0xa5, 0x04, 0xea, 0x09, 0x44, 0x48, 0x7e, 0xd2,
-	0x01, 0x08, 0xd4, 0xbb, 0xac, 0x76, 0x77, 0x41, 0x4e, 0x33, 0x6d, 0x40, 0x0a, 0xc3, 0x1a, 0x4f,
-	0xf7, 0xcf, 0x9c, 0x34, 0x10, 0x39, 0xed, 0xfb, 0x09, 0x44, 0x84, 0xed, 0x98, 0x11, 0x61, 0x6b,
-	0x16, 0x4d, 0xf7, 0xaa, 0x1b, 0x3d, 0x62, 0xc3, 0x7e, 0x5c, 0x80, 0x53, 0x3a, 0x72, 0x85, 0x9c,
-	0xc4, 0xc7, 0xde, 0x36, 0xc2, 0x61, 0x6f, 0xd8, 0xed, 0x6f, 0x45, 0x78, 0x80, 0xf2, 0x42, 0xaf,
-	0x3f, 0x91, 0x09, 0xbd, 0xbe, 0x69, 0x9f, 0xf5, 0xfe, 0xf1, 0xd7, 0xff, 0xd5, 0x81, 0x33, 0x99,
-	0x1a, 0x27, 0x30, 0xc1, 0xb6, 0xcc, 0x09, 0xf6, 0x9c, 0xf5, 0x5e, 0xf7, 0x98, 0x5d, 0xdf, 0x2a,
-	0x74, 0xf5, 0x96, 0x1d, 0xe2, 0x3e, 0xe3, 0x40, 0x91, 0x6a, 0xcb, 0x32, 0x38, 0xeb, 0x63, 0xc7,
-	0x32, 0x03, 0x98, 0x5e, 0x2f, 0xa4, 0xb3, 0x6a, 0x1f, 0x83, 0x61, 0xce, 0x7d, 0xe2, 0xd3, 0x0e,
-	0x40, 0x8a, 0x74, 0xaf, 0x54, 0x60, 0xf7, 0xbb, 0x05, 0x38, 0x97, 0x3b, 0x8d, 0xd0, 0xe7, 0x94,
-	0x45, 0xce, 0xb1, 0x1d, 0x7a, 0x68, 0x30, 0xd2, 0x0d, 0x73, 0xa3, 0x86, 0x61, 0x4e, 0xd8, 0xe3,
-	0xee, 0xd5, 0x01, 0x46, 0x88, 0x69, 0x6d, 0xb0, 0x7e, 0xe4, 0xa4, 0xd1, 0xac, 0x2a, 0x9f, 0xd2,
-	0x5f, 0xc2, 0x1b, 0x39, 0xee, 0x8f, 0xb5, 0xeb, 0x0a, 0xb2, 0xa3, 0x27, 0x20, 0x2b, 0xb6, 0x4d,
-	0x59, 0x81, 0xed, 0xfb, 0x91, 0x7b, 0x08, 0x8b, 0x57, 0x20, 0xcf, 0xb1, 0x7c, 0xb0, 0x74, 0x95,
-	0xc6, 0xdd, 0xd6, 0xc2, 0x81, 0xef, 0xb6, 0x8e, 0x42, 0xe9, 0x45, 0x5f, 0xa5, 0x3a, 0x9d, 0x99,
-	0xfa, 0xde, 0x0f, 0x2f, 0xde, 0xf7, 0x87, 0x3f, 0xbc, 0x78, 0xdf, 0x0f, 0x7e, 0x78, 0xf1, 0xbe,
-	0x4f, 0xee, 0x5d, 0x74, 0xbe, 0xb7, 0x77, 0xd1, 0xf9, 0xc3, 0xbd, 0x8b, 0xce, 0x0f, 0xf6, 0x2e,
-	0x3a, 0xff, 0x61, 0xef, 0xa2, 0xf3, 0x77, 0xfe, 0xf4, 0xe2, 0x7d, 0x2f, 0x0e, 0xc9, 0x8e, 0xfd,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x33, 0xae, 0x81, 0xfe, 0xec, 0xda, 0x00, 0x00,
+	// 13063 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x6b, 0x6c, 0x24, 0xc9,
+	0x79, 0xd8, 0xf5, 0x0c, 0x9f, 0xc5, 0xe7, 0xf6, 0xbe, 0xfa, 0x78, 0x7b, 0xcb, 0x75, 0x9f, 0xee,
+	0x7c, 0x92, 0x4e, 0x5c, 0xdd, 0x9e, 0x94, 0x9c, 0xad, 0x58, 0x16, 0x1f, 0x4b, 0x2e, 0x6f, 0x1f,
+	0xe4, 0x7d, 0xc3, 0xbd, 0xf5, 0xe9, 0x64, 0x49, 0xcd, 0x99, 0x22, 0xd9, 0xe2, 0xcc, 0xf4, 0x5c,
+	0x77, 0x0f, 0xb9, 0xbc, 0x87, 0xa4, 0xc8, 0x91, 0x6d, 0xc9, 0xb6, 0xe4, 0x87, 0x7c, 0x91, 0xe4,
+	0x04, 0xb1, 0x1d, 0x25, 0x31, 0xec, 0xc0, 0x40, 0xfc, 0x27, 0x86, 0x81, 0xfc, 0x49, 0x00, 0xc3,
+	0x41, 0x82, 0xc4, 0x46, 0x1c, 0x58, 0x01, 0xe2, 0xbd, 0x78, 0x9d, 0x08, 0x46, 0x02, 0xfd, 0xb0,
+	0x92, 0x38, 0xf6, 0xe6, 0x81, 0xe0, 0xab, 0x57, 0x57, 0x75, 0xf7, 0x70, 0x49, 0x6e, 0x71, 0x4f,
+	0xb0, 0x7f, 0x91, 0xf3, 0xd5, 0x57, 0xdf, 0x57, 0x55, 0x5d, 0x8f, 0xaf, 0xbe, 0x57, 0x91, 0xd5,
+	0xcd, 0x30, 0xdd, 0xea, 0xae, 0xcf, 0xd4, 0xa3, 0xd6, 0xc5, 0x20, 0xde, 0x8c, 0x3a, 0x71, 0xf4,
+	0x29, 0xf6, 0xcf, 0xfb, 0x76, 0xa3, 0x78, 0x7b, 0xa3, 0x19, 0xed, 0x26, 0x17, 0x77, 0x9e, 0xbb,
+	0xd8, 0xd9, 0xde, 0xbc, 0x18, 0x74, 0xc2, 0xe4, 0xa2, 0x84, 0x5e, 0xdc, 0x79, 0x36, 0x68, 0x76,
+	0xb6, 0x82, 0x67, 0x2f, 0x6e, 0xd2, 0x36, 0x8d, 0x83, 0x94, 0x36, 0x66, 0x3a, 0x71, 0x94, 0x46,
+	0xee, 0x47, 0x32, 0x8a, 0x33, 0x92, 0x22, 0xfb, 0xe7, 0x13, 0x8a, 0xe2, 0xcc, 0xce, 0x73, 0x33,
+	0x9d, 0xed, 0xcd, 0x19, 0xa4, 0x38, 0x23, 0xa1, 0x33, 0x92, 0xe2, 0xd4, 0xfb, 0xb4, 0x36, 0x6d,
+	0x46, 0x9b, 0xd1, 0x45, 0x46, 0x78, 0xbd, 0xbb, 0xc1, 0x7e, 0xb1, 0x1f, 0xec, 0x3f, 0xce, 0x70,
+	0xca, 0xdf, 0x7e, 0x3e, 0x99, 0x09, 0x23, 0x6c, 0xdf, 0xc5, 0x7a, 0x14, 0xd3, 0x8b, 0x3b, 0x85,
+	0x46, 0x4d, 0xbd, 0x4b, 0xc3, 0xe9, 0x44, 0xcd, 0xb0, 0xbe, 0x57, 0x86, 0xf5, 0x81, 0x0c, 0xab,
+	0x15, 0xd4, 0xb7, 0xc2, 0x36, 0x8d, 0xf7, 0xb2, 0xae, 0xb7, 0x68, 0x1a, 0x94, 0xd5, 0xba, 0xd8,
+	0xab, 0x56, 0xdc, 0x6d, 0xa7, 0x61, 0x8b, 0x16, 0x2a, 0xfc, 0xb5, 0xfb, 0x55, 0x48, 0xea, 0x5b,
+	0xb4, 0x15, 0x14, 0xea, 0x3d, 0xd7, 0xab, 0x5e, 0x37, 0x0d, 0x9b, 0x17, 0xc3, 0x76, 0x9a, 0xa4,
+	0x71, 0xbe, 0x92, 0x7f, 0x99, 0x0c, 0xcc, 0xb6, 0xa2, 0x6e, 0x3b, 0x75, 0x3f, 0x44, 0xfa, 0x77,
+	0x82, 0x66, 0x97, 0x7a, 0xce, 0x05, 0xe7, 0xe9, 0xe1, 0xb9, 0x27, 0x7f, 0xe7, 0xce, 0xf4, 0x23,
+	0x77, 0xef, 0x4c, 0xf7, 0xbf, 0x84, 0xc0, 0x7b, 0x77, 0xa6, 0x4f, 0xd1, 0x76, 0x3d, 0x6a, 0x84,
+	0xed, 0xcd, 0x8b, 0x9f, 0x4a, 0xa2, 0xf6, 0xcc, 0x8d, 0x6e, 0x6b, 0x9d, 0xc6, 0xc0, 0xeb, 0xf8,
+	0xcb, 0xe4, 0xe4, 0x6c, 0xbb, 0x1d, 0xa5, 0x41, 0x1a, 0x46, 0x6d, 0x56, 0x63, 0x31, 0x8e, 0x5a,
+	0xee, 0x25, 0x42, 0x02, 0x05, 0x16, 0x84, 0x5d, 0x41, 0x98, 0x64, 0x15, 0x40, 0xc3, 0xf2, 0xff,
+	0x5d, 0x85, 0x4c, 0xcc, 0xc6, 0xf5, 0xad, 0x70, 0x87, 0xd6, 0x52, 0x6c, 0xea, 0xe6, 0x9e, 0xbb,
+	0x45, 0xaa, 0x69, 0x10, 0x33, 0x02, 0x23, 0x97, 0xae, 0xcf, 0x3c, 0xe8, 0x14, 0x9a, 0x59, 0x0b,
+	0x62, 0x49, 0x7b, 0x6e, 0xf0, 0xee, 0x9d, 0xe9, 0xea, 0x5a, 0x10, 0x03, 0xb2, 0x70, 0x9b, 0xa4,
+	0xaf, 0x1d, 0xb5, 0xa9, 0x57, 0x61, 0xac, 0x6e, 0x3c, 0x38, 0xab, 0x1b, 0x51, 0x5b, 0xf5, 0x63,
+	0x6e, 0xe8, 0xee, 0x9d, 0xe9, 0x3e, 0x84, 0x00, 0xe3, 0x82, 0xfd, 0x7a, 0x2d, 0xec, 0x78, 0x55,
+	0x5b, 0xfd, 0xfa, 0x68, 0xd8, 0x31, 0xfb, 0xf5, 0xd1, 0xb0, 0x03, 0xc8, 0xc2, 0xff, 0x42, 0x85,
+	0x0c, 0xcf, 0xc6, 0x9b, 0xdd, 0x16, 0x6d, 0xa7, 0x89, 0xfb, 0x19, 0x42, 0x3a, 0x41, 0x1c, 0xb4,
+	0x68, 0x4a, 0xe3, 0xc4, 0x73, 0x2e, 0x54, 0x9f, 0x1e, 0xb9, 0x74, 0xf5, 0xc1, 0xd9, 0xaf, 0x4a,
+	0x9a, 0xd9, 0x47, 0x56, 0xa0, 0x04, 0x34, 0x96, 0xee, 0xeb, 0x64, 0x38, 0x88, 0xd3, 0x70, 0x23,
+	0xa8, 0xa7, 0x89, 0x57, 0x61, 0xfc, 0x5f, 0x78, 0x70, 0xfe, 0xb3, 0x82, 0xe4, 0xdc, 0x09, 0xc1,
+	0x7e, 0x58, 0x42, 0x12, 0xc8, 0xf8, 0xf9, 0xbf, 0xd5, 0x47, 0x46, 0x66, 0xe3, 0x74, 0x69, 0xbe,
+	0x96, 0x06, 0x69, 0x37, 0x71, 0xff, 0x95, 0x43, 0x4e, 0x26, 0x7c, 0xd8, 0x42, 0x9a, 0xac, 0xc6,
+	0x51, 0x9d, 0x26, 0x09, 0x6d, 0x88, 0x71, 0xd9, 0xb0, 0xd2, 0x2e, 0xc9, 0x6c, 0xa6, 0x56, 0x64,
+	0x74, 0xb9, 0x9d, 0xc6, 0x7b, 0x73, 0xcf, 0x8a, 0x36, 0x9f, 0x2c, 0xc1, 0xf8, 0xdc, 0xdb, 0xd3,
+	0xae, 0xec, 0xca, 0xd2, 0xbc, 0x40, 0xd8, 0x83, 0xb2, 0x56, 0xbb, 0x5f, 0x73, 0xc8, 0x68, 0x27,
+	0x6a, 0x24, 0x40, 0xeb, 0x51, 0xb7, 0x43, 0x1b, 0x62, 0x78, 0x3f, 0x61, 0xb7, 0x1b, 0xab, 0x1a,
+	0x07, 0xde, 0xfe, 0x53, 0xa2, 0xfd, 0xa3, 0x7a, 0x11, 0x18, 0x4d, 0x71, 0x9f, 0x27, 0xa3, 0xed,
+	0x28, 0xad, 0x75, 0x68, 0x3d, 0xdc, 0x08, 0x69, 0x83, 0x4d, 0xfc, 0xa1, 0xac, 0xe6, 0x0d, 0xad,
+	0x0c, 0x0c, 0xcc, 0xa9, 0x45, 0xe2, 0xf5, 0x1a, 0x39, 0x77, 0x92, 0x54, 0xb7, 0xe9, 0x1e, 0xdf,
+	0x5e, 0x00, 0xff, 0x75, 0x4f, 0xc9, 0xbd, 0x0c, 0x97, 0xf1, 0x90, 0xd8, 0xa4, 0xbe, 0xbf, 0xf2,
+	0xbc, 0x33, 0xf5, 0x83, 0xe4, 0x44, 0xa1, 0xe9, 0x87, 0x21, 0xe0, 0x7f, 0x67, 0x94, 0x0c, 0xc9,
+	0x4f, 0xe1, 0x5e, 0x20, 0x7d, 0xed, 0xa0, 0x25, 0xb7, 0xcc, 0x51, 0xd1, 0x8f, 0xbe, 0x1b, 0x41,
+	0x0b, 0x57, 0x78, 0xd0, 0xa2, 0x88, 0xd1, 0x09, 0xd2, 0x2d, 0xaf, 0x62, 0x62, 0xac, 0x06, 0xe9,
+	0x16, 0xb0, 0x12, 0xf7, 0x19, 0x32, 0xb4, 0xd9, 0x8c, 0xd6, 0x11, 0xe2, 0x9d, 0x60, 0x58, 0x93,
+	0x02, 0x6b, 0x68, 0x49, 0xc0, 0x41, 0x61, 0xb8, 0xd3, 0xa4, 0x1f, 0x6b, 0x25, 0x9e, 0x7b, 0xa1,
+	0xfa, 0xf4, 0xf0, 0xdc, 0x30, 0xee, 0xd0, 0x58, 0x90, 0x00, 0x87, 0xbb, 0xe7, 0x48, 0x5f, 0x2b,
+	0x6a, 0x50, 0x36, 0xb4, 0xfd, 0x7c, 0xc3, 0xb9, 0x1e, 0x35, 0x28, 0x30, 0x28, 0x36, 0x67, 0x23,
+	0x8e, 0x5a, 0x5e, 0x9f, 0xd9, 0x1c, 0xdc, 0xac, 0x81, 0x95, 0xb8, 0x5f, 0x75, 0xc8, 0xa4, 0x5c,
+	0x2a, 0xd7, 0xa2, 0x3a, 0xdf, 0xb9, 0xfb, 0xd9, 0x06, 0x05, 0xf6, 0x56, 0xa8, 0xa4, 0x3c, 0xe7,
+	0x89, 0x26, 0x4c, 0xe6, 0x4b, 0xa0, 0xd0, 0x0a, 0x3c, 0x4d, 0x70, 0x1c, 0x82, 0x26, 0x8e, 0xaf,
+	0x37, 0x60, 0x9e, 0x26, 0x4b, 0xaa, 0x04, 0x34, 0x2c, 0xf7, 0x36, 0x19, 0x0c, 0xf8, 0x61, 0xe2,
+	0x0d, 0xb2, 0x4e, 0xbc, 0x68, 0xa3, 0x13, 0xc6, 0xe9, 0x34, 0x37, 0x72, 0xf7, 0xce, 0xf4, 0xa0,
+	0x00, 0x82, 0x64, 0x87, 0xdf, 0x35, 0xea, 0x60, 0xbb, 0x83, 0xa6, 0x37, 0xc4, 0xe6, 0xb9, 0xfa,
+	0xae, 0x2b, 0x02, 0x0e, 0x0a, 0xc3, 0x7d, 0x37, 0x19, 0x4c, 0xba, 0x7c, 0x12, 0x0c, 0xb3, 0x8e,
+	0x4d, 0x08, 0xe4, 0xc1, 0x1a, 0x07, 0x83, 0x2c, 0x77, 0x3f, 0x48, 0x46, 0x62, 0x5a, 0xef, 0xc6,
+	0x09, 0xc5, 0x0f, 0xeb, 0x11, 0x46, 0xfb, 0xa4, 0x40, 0x1f, 0x81, 0xac, 0x08, 0x74, 0x3c, 0xf7,
+	0xc3, 0x64, 0x1c, 0x3f, 0xf0, 0xe5, 0xdb, 0x9d, 0x98, 0x26, 0x09, 0x7e, 0xd5, 0x11, 0xc6, 0xe8,
+	0x8c, 0xa8, 0x39, 0xbe, 0x68, 0x94, 0x42, 0x0e, 0xdb, 0x7d, 0x83, 0x90, 0x40, 0x6d, 0x41, 0xde,
+	0x28, 0x1b, 0xcc, 0x6b, 0xf6, 0x66, 0xc4, 0xd2, 0xfc, 0xdc, 0x38, 0x93, 0x0a, 0xd4, 0x6f, 0xd0,
+	0xf8, 0xe1, 0xf8, 0x34, 0x68, 0x93, 0xa6, 0xb4, 0xe1, 0x8d, 0xb1, 0x0e, 0xab, 0xf1, 0x59, 0xe0,
+	0x60, 0x90, 0xe5, 0x38, 0x3e, 0x9d, 0x98, 0xee, 0x84, 0x74, 0x97, 0x0d, 0xe7, 0x38, 0xeb, 0xa5,
+	0x1a, 0x9f, 0xd5, 0xac, 0x08, 0x74, 0x3c, 0xf7, 0x8b, 0x0e, 0x99, 0xac, 0x47, 0x2d, 0xd5, 0x7f,
+	0x9c, 0x73, 0xde, 0x04, 0xeb, 0xe6, 0x15, 0x0b, 0xdd, 0x64, 0x42, 0xd6, 0xdc, 0x29, 0x9c, 0xea,
+	0xf3, 0x39, 0x2e, 0x50, 0xe0, 0xeb, 0xde, 0x22, 0xc3, 0xf4, 0x76, 0x27, 0x8c, 0x69, 0x32, 0x9b,
+	0x7a, 0x93, 0xac, 0x11, 0xef, 0x99, 0xe1, 0xf2, 0xdd, 0x8c, 0x2e, 0xdf, 0x65, 0x2c, 0x51, 0xfc,
+	0x9c, 0xd9, 0x79, 0x76, 0x66, 0x2d, 0x6c, 0xd1, 0xb9, 0x31, 0x3c, 0xfb, 0x2e, 0x4b, 0x02, 0x90,
+	0xd1, 0x72, 0x53, 0x32, 0xd0, 0x0e, 0x5a, 0x61, 0x7b, 0xd3, 0x3b, 0xc9, 0xa8, 0xae, 0xda, 0xfb,
+	0x82, 0x37, 0x18, 0xdd, 0x39, 0x72, 0xf7, 0xce, 0xf4, 0x00, 0xff, 0x1f, 0x04, 0x2f, 0xf7, 0x27,
+	0x1d, 0x32, 0x1a, 0x53, 0xdc, 0x10, 0x57, 0x99, 0x74, 0xed, 0x9d, 0x62, 0xcc, 0x5f, 0xb2, 0xc7,
+	0x1c, 0x34, 0xea, 0x73, 0x93, 0x78, 0x98, 0xe8, 0x10, 0x30, 0xb8, 0xbb, 0x9f, 0x75, 0xc8, 0x30,
+	0xfe, 0x6c, 0xac, 0x86, 0x1d, 0xea, 0x9d, 0x66, 0x6d, 0xa9, 0x59, 0x10, 0xf5, 0x24, 0x49, 0x25,
+	0x87, 0xb0, 0xef, 0xa0, 0xc0, 0x90, 0x31, 0xc5, 0xdd, 0xa1, 0xdb, 0xee, 0x04, 0xf5, 0xed, 0xb5,
+	0xc8, 0x3b, 0x63, 0xee, 0xfa, 0x37, 0x05, 0x1c, 0x14, 0x86, 0xff, 0x0b, 0x15, 0xa2, 0x2d, 0x0c,
+	0x77, 0x8e, 0x0c, 0x89, 0x93, 0x5f, 0x1c, 0x5a, 0x73, 0x4f, 0xc9, 0xca, 0x72, 0x53, 0xba, 0x77,
+	0xa7, 0x54, 0x62, 0x50, 0xf5, 0xdc, 0x37, 0xc9, 0x48, 0x27, 0x6a, 0x5c, 0xa7, 0x69, 0xd0, 0x08,
+	0xd2, 0x40, 0xc8, 0xbb, 0x16, 0x64, 0x30, 0x49, 0x71, 0x6e, 0x82, 0xad, 0xb6, 0x8c, 0x05, 0xe8,
+	0xfc, 0xdc, 0x17, 0x88, 0x9b, 0xd0, 0x78, 0x27, 0xac, 0xd3, 0xd9, 0x7a, 0x1d, 0x97, 0x06, 0xdb,
+	0xd3, 0xab, 0xac, 0x33, 0x53, 0xa2, 0x33, 0x6e, 0xad, 0x80, 0x01, 0x25, 0xb5, 0xfc, 0xdf, 0xaf,
+	0x90, 0x71, 0xad, 0xaf, 0x1d, 0x5a, 0x77, 0x7f, 0xc5, 0x21, 0x13, 0x4a, 0xe0, 0x9b, 0xdb, 0xbb,
+	0x81, 0x1b, 0x25, 0x17, 0xe7, 0xa8, 0xcd, 0x2d, 0x0b, 0x79, 0xcd, 0xcc, 0x9a, 0x7c, 0xb8, 0x34,
+	0x74, 0x56, 0xf4, 0x61, 0x22, 0x57, 0x0a, 0xf9, 0x66, 0x4d, 0xbd, 0xe5, 0x90, 0x53, 0x65, 0x24,
+	0x4a, 0xa4, 0x92, 0x2d, 0x5d, 0x2a, 0xb1, 0x7a, 0x1e, 0x23, 0x57, 0xec, 0x8c, 0x2e, 0xe9, 0xfc,
+	0xbf, 0x0a, 0x99, 0xd4, 0xa7, 0x10, 0x93, 0x95, 0xff, 0xb9, 0x43, 0x4e, 0x07, 0x6a, 0xcd, 0x25,
+	0xdd, 0x66, 0x6e, 0x78, 0x5b, 0x56, 0x87, 0x97, 0xf1, 0x9c, 0x99, 0x2d, 0xe3, 0xc7, 0x87, 0xf9,
+	0x71, 0x31, 0xcc, 0xa7, 0x4b, 0x71, 0xa0, 0xbc, 0xa9, 0x53, 0xdf, 0x70, 0xc8, 0x54, 0x6f, 0xa2,
+	0x25, 0x03, 0xdf, 0x31, 0x07, 0xfe, 0xa3, 0x36, 0xf7, 0x2d, 0x64, 0xcf, 0x86, 0x9f, 0x75, 0x56,
+	0xff, 0x00, 0x7f, 0x6f, 0x84, 0x14, 0xc4, 0x22, 0xf7, 0x59, 0x32, 0x22, 0x24, 0x8c, 0x6b, 0xd1,
+	0x66, 0xc2, 0x1a, 0x39, 0xc4, 0xd7, 0xda, 0x6c, 0x06, 0x06, 0x1d, 0xc7, 0x6d, 0x90, 0x4a, 0xf2,
+	0x9c, 0x57, 0xb1, 0x75, 0x62, 0xd7, 0x9e, 0x53, 0xfb, 0xdb, 0xc0, 0xdd, 0x3b, 0xd3, 0x95, 0xda,
+	0x73, 0x50, 0x49, 0x9e, 0xc3, 0xbb, 0xec, 0x66, 0x98, 0xda, 0xbb, 0xcb, 0x2e, 0x85, 0xa9, 0xe2,
+	0xc3, 0xee, 0xb2, 0x4b, 0x61, 0x0a, 0xc8, 0x02, 0xef, 0xe8, 0x5b, 0x69, 0xda, 0xf1, 0xfa, 0x6c,
+	0xdd, 0xd1, 0xaf, 0xac, 0xad, 0xad, 0x2a, 0x5e, 0x4c, 0x64, 0x46, 0x08, 0x30, 0x2e, 0xee, 0x8f,
+	0x3b, 0x38, 0xe2, 0xbc, 0x30, 0x8a, 0xf7, 0x84, 0x2c, 0x7c, 0xd3, 0xde, 0x14, 0x88, 0xe2, 0x3d,
+	0xc5, 0x5c, 0x7c, 0x48, 0x55, 0x00, 0x3a, 0x6b, 0xd6, 0xf1, 0xc6, 0x46, 0xe2, 0x0d, 0x58, 0xeb,
+	0xf8, 0xc2, 0x62, 0x2d, 0xd7, 0xf1, 0x85, 0xc5, 0x1a, 0x30, 0x2e, 0xf8, 0x41, 0xe3, 0x60, 0xd7,
+	0x1b, 0xb4, 0xf5, 0x41, 0x21, 0xd8, 0x35, 0x3f, 0x28, 0x04, 0xbb, 0x80, 0x2c, 0x90, 0x53, 0x94,
+	0x24, 0xde, 0x90, 0x2d, 0x4e, 0x2b, 0xb5, 0x9a, 0xc9, 0x69, 0xa5, 0x56, 0x03, 0x64, 0xc1, 0x26,
+	0x69, 0x3d, 0xf1, 0x86, 0x6d, 0x71, 0x5a, 0x9a, 0xcf, 0x71, 0x5a, 0x9a, 0xaf, 0x01, 0xb2, 0xc0,
+	0x2d, 0x23, 0x78, 0xad, 0x1b, 0x73, 0xf9, 0x7c, 0xe4, 0xd2, 0x8a, 0x85, 0xf9, 0x82, 0xe4, 0x14,
+	0x37, 0x76, 0xf3, 0x63, 0x20, 0xe0, 0x8c, 0xdc, 0x2f, 0x39, 0x5c, 0xc2, 0x5f, 0x6e, 0x05, 0x9b,
+	0xf4, 0x5a, 0xb0, 0x4e, 0x9b, 0xde, 0x88, 0xad, 0x73, 0x22, 0xa3, 0x59, 0x8b, 0xba, 0x71, 0x9d,
+	0xce, 0xb9, 0xf2, 0xc6, 0x90, 0x95, 0x40, 0x8e, 0xbb, 0x7b, 0x91, 0x0c, 0x6f, 0xd3, 0xbd, 0xd5,
+	0x98, 0x6e, 0x84, 0xb7, 0xd9, 0x85, 0x61, 0x38, 0x53, 0xcc, 0x5c, 0x95, 0x05, 0x90, 0xe1, 0xb8,
+	0xbf, 0xee, 0x90, 0xd3, 0x1d, 0x1a, 0x27, 0x61, 0x92, 0xd2, 0x76, 0xfa, 0x52, 0xd4, 0xec, 0xb6,
+	0xe8, 0x7c, 0x33, 0x08, 0x5b, 0x4c, 0xe6, 0x1f, 0xb9, 0xf4, 0xc3, 0x16, 0x54, 0x54, 0x65, 0xe4,
+	0x45, 0x9f, 0x1e, 0xc5, 0x83, 0xa4, 0x14, 0x01, 0xca, 0x9b, 0xe5, 0xff, 0x50, 0x26, 0x78, 0x70,
+	0x89, 0xd7, 0x5d, 0x2c, 0x88, 0x66, 0xef, 0x29, 0x11, 0xcd, 0xce, 0x98, 0xb5, 0x8a, 0xe2, 0x99,
+	0xff, 0xdb, 0xd5, 0x6c, 0xef, 0x97, 0x87, 0xb3, 0xfb, 0x33, 0x4c, 0xaa, 0x11, 0x1b, 0x7b, 0x3d,
+	0x53, 0xaa, 0x1e, 0xcf, 0xd5, 0xfc, 0x24, 0x17, 0x5f, 0x0c, 0x76, 0x90, 0xe7, 0xef, 0xfe, 0xac,
+	0x53, 0x54, 0xe5, 0x05, 0xf6, 0x05, 0x13, 0x05, 0x48, 0xf8, 0xc1, 0xbf, 0xaf, 0x86, 0x6f, 0xea,
+	0xc7, 0x1d, 0x32, 0x6e, 0x56, 0x28, 0x39, 0xd4, 0x3f, 0x69, 0x1e, 0xea, 0x16, 0xf5, 0x8f, 0xfa,
+	0x21, 0xfe, 0x05, 0x87, 0x8c, 0x49, 0x38, 0x53, 0xd4, 0xb8, 0xb7, 0xc9, 0x90, 0x6c, 0xa9, 0xe7,
+	0xd8, 0x66, 0x9d, 0x5d, 0x23, 0x54, 0x63, 0x14, 0x37, 0xff, 0x52, 0x26, 0x69, 0xea, 0xb7, 0x23,
+	0x77, 0x8a, 0x54, 0xd2, 0x48, 0x4c, 0x57, 0x22, 0xea, 0x57, 0xd6, 0x22, 0xa8, 0xa4, 0x91, 0xff,
+	0x2b, 0x03, 0xc4, 0xcd, 0x2a, 0x75, 0xa2, 0x24, 0x64, 0x47, 0xd1, 0x11, 0xc4, 0x90, 0xb6, 0x26,
+	0x86, 0xbc, 0x64, 0x53, 0x0c, 0xc9, 0x9a, 0x65, 0x08, 0x24, 0x3f, 0x9b, 0x3b, 0xb8, 0xb9, 0x64,
+	0xf2, 0x89, 0x63, 0x39, 0xb8, 0xb5, 0x26, 0xec, 0x7f, 0x84, 0xef, 0x88, 0x23, 0x9c, 0xcb, 0x2e,
+	0x3f, 0x64, 0xf7, 0x08, 0xd7, 0x5a, 0x91, 0x3f, 0xcc, 0x63, 0x7e, 0xc4, 0x72, 0xe1, 0xe5, 0x96,
+	0xd5, 0x23, 0x56, 0xe3, 0x6a, 0x1e, 0xb6, 0x31, 0x3f, 0x6c, 0x07, 0x6c, 0xf1, 0x5c, 0x9a, 0xef,
+	0xc9, 0x53, 0x1d, 0xbb, 0xaf, 0xc9, 0x63, 0x97, 0x8b, 0x2d, 0x2f, 0x5b, 0x3e, 0x76, 0x35, 0xbe,
+	0x85, 0x03, 0xd8, 0x7f, 0x95, 0x9c, 0x2e, 0xe2, 0x01, 0xdd, 0xc0, 0x83, 0xb0, 0x1e, 0xb5, 0x37,
+	0xc2, 0xcd, 0xeb, 0x41, 0x47, 0x2c, 0x33, 0xb5, 0x7f, 0xcd, 0xcb, 0x02, 0xc8, 0x70, 0xdc, 0xc7,
+	0xf9, 0x66, 0xc5, 0x95, 0xc6, 0x23, 0x02, 0xb5, 0x7a, 0x95, 0xee, 0xb1, 0x9d, 0xeb, 0xfb, 0x87,
+	0xbe, 0xfa, 0x8b, 0xd3, 0x8f, 0x7c, 0xf6, 0x3f, 0x5e, 0x78, 0xc4, 0xff, 0xbd, 0x2a, 0x79, 0xac,
+	0x94, 0xa7, 0xb8, 0xae, 0xfd, 0x63, 0xe3, 0xba, 0xa6, 0x95, 0x7b, 0x8e, 0xad, 0xaf, 0x52, 0xca,
+	0xbe, 0xec, 0x62, 0xa6, 0x15, 0xc3, 0xe9, 0xa0, 0xd7, 0x40, 0xe1, 0xb6, 0x94, 0x74, 0x82, 0x3a,
+	0xf5, 0x2a, 0xe6, 0x40, 0xdd, 0x90, 0x05, 0x90, 0xe1, 0x70, 0xb5, 0xe0, 0x46, 0xd0, 0x6d, 0xa6,
+	0x5e, 0x35, 0xaf, 0x16, 0x64, 0x60, 0x90, 0xe5, 0xee, 0xdf, 0x71, 0x88, 0x5b, 0xe4, 0x2a, 0x16,
+	0xe2, 0xda, 0x71, 0x8c, 0xc3, 0xdc, 0x99, 0xbb, 0x9a, 0x16, 0x46, 0xeb, 0x69, 0x49, 0x3b, 0xb4,
+	0x6f, 0xfa, 0x69, 0x32, 0x6e, 0xde, 0x0e, 0x0f, 0x60, 0x66, 0x60, 0xea, 0xe3, 0x3a, 0x1a, 0x45,
+	0xbc, 0x8a, 0x39, 0x0e, 0x35, 0x0e, 0x06, 0x59, 0x8e, 0x16, 0x04, 0x1a, 0xc7, 0x51, 0x2c, 0x94,
+	0x2d, 0x6c, 0x1a, 0x5f, 0x46, 0x00, 0x70, 0xb8, 0xff, 0xad, 0x0a, 0xf1, 0x7a, 0x5d, 0x4f, 0xdd,
+	0xdf, 0xd0, 0x14, 0x2b, 0xbc, 0x50, 0xda, 0x0f, 0xa3, 0xe3, 0xbb, 0x14, 0xe7, 0x0a, 0x92, 0x1e,
+	0x2a, 0x16, 0x51, 0x0a, 0xf9, 0x06, 0x4e, 0x7d, 0xc5, 0xd1, 0x0f, 0xbe, 0x8c, 0x44, 0x89, 0x50,
+	0xb0, 0x61, 0x0a, 0x05, 0xab, 0xb6, 0x3b, 0xa5, 0x8b, 0x06, 0x7f, 0xd8, 0x4f, 0x4e, 0xca, 0xd2,
+	0x1a, 0xc5, 0xa3, 0xf2, 0xc5, 0x2e, 0x8d, 0xf7, 0xdc, 0x3f, 0x70, 0xc8, 0xa9, 0x20, 0xaf, 0xbb,
+	0x0b, 0xe9, 0x31, 0x0c, 0xb4, 0xc6, 0x75, 0x66, 0xb6, 0x84, 0x23, 0x1f, 0xe8, 0x4b, 0x62, 0xa0,
+	0x4f, 0x95, 0xa1, 0xf4, 0x30, 0x4d, 0x96, 0x76, 0x00, 0xed, 0x7f, 0x41, 0x26, 0xf9, 0xca, 0x25,
+	0xae, 0xec, 0x7f, 0x9a, 0x54, 0x4c, 0xc1, 0xc0, 0xc4, 0x9a, 0x29, 0x6d, 0x75, 0x9a, 0x41, 0x4a,
+	0x35, 0x4d, 0xa1, 0xaa, 0xb9, 0xa6, 0x95, 0x81, 0x81, 0xe9, 0x3e, 0x45, 0x06, 0xda, 0x51, 0x83,
+	0x2e, 0x37, 0x84, 0xd1, 0x6b, 0x5c, 0xd4, 0x19, 0xb8, 0xc1, 0xa0, 0x20, 0x4a, 0xdd, 0x27, 0x33,
+	0x0b, 0x43, 0x3f, 0x5b, 0x42, 0x23, 0xa5, 0xd6, 0x85, 0x5f, 0x42, 0xdd, 0x71, 0xd4, 0xa0, 0x6b,
+	0x7b, 0x1d, 0x8a, 0x67, 0x1b, 0x7e, 0x91, 0xc6, 0xf1, 0x7c, 0x91, 0x1b, 0x92, 0x8d, 0xa9, 0xeb,
+	0x1a, 0x56, 0xf0, 0xcf, 0xbd, 0x3d, 0x3d, 0x24, 0x7f, 0x40, 0xd6, 0xaa, 0xa9, 0x25, 0xf2, 0x68,
+	0xcf, 0xaf, 0x79, 0x28, 0x6b, 0xe9, 0xdf, 0x20, 0xe3, 0x66, 0x23, 0x0e, 0x65, 0x2a, 0xfd, 0x4d,
+	0x6d, 0xd9, 0xf1, 0x7e, 0x89, 0xfd, 0xec, 0x1d, 0x93, 0x80, 0xd5, 0x64, 0x58, 0xf0, 0x2a, 0x25,
+	0x93, 0x61, 0x41, 0x4c, 0x86, 0x05, 0x1f, 0x5d, 0x02, 0x4a, 0xc4, 0x3c, 0x3c, 0x98, 0xbb, 0x71,
+	0xd3, 0x73, 0xcc, 0x83, 0xf9, 0x26, 0x5c, 0x03, 0x84, 0xbb, 0x5f, 0xd1, 0x76, 0x47, 0xac, 0xd6,
+	0x15, 0x96, 0x5f, 0x4b, 0x66, 0x47, 0x83, 0x70, 0x71, 0xff, 0x13, 0x05, 0x90, 0x6f, 0x82, 0xff,
+	0xb3, 0x15, 0xf2, 0xf8, 0xbe, 0x42, 0x6b, 0x69, 0xc3, 0x9d, 0x77, 0xbc, 0xe1, 0x78, 0xac, 0xc5,
+	0xb4, 0x13, 0xdd, 0x84, 0x6b, 0xe2, 0x7b, 0xa9, 0x63, 0x0d, 0x38, 0x18, 0x64, 0xb9, 0x50, 0x36,
+	0x2c, 0x46, 0x71, 0x2b, 0x48, 0xbd, 0xaa, 0x29, 0x3a, 0x5c, 0x95, 0x05, 0x90, 0xe1, 0xf8, 0x7f,
+	0xe0, 0x90, 0x7c, 0x03, 0xdc, 0x80, 0x8c, 0x77, 0x13, 0x1a, 0xe3, 0x91, 0x5a, 0xa3, 0xf5, 0x98,
+	0xca, 0xe9, 0xf9, 0xa4, 0x66, 0x7b, 0x9b, 0xa9, 0x47, 0x31, 0x45, 0x4b, 0x1b, 0xc7, 0xb8, 0x4a,
+	0xf7, 0x6a, 0xb4, 0x49, 0x91, 0x06, 0x57, 0x8a, 0xdc, 0x34, 0x08, 0x40, 0x8e, 0x20, 0xb2, 0xe8,
+	0x04, 0x49, 0xb2, 0x1b, 0xc5, 0x0d, 0xc1, 0xa2, 0x72, 0x68, 0x16, 0xab, 0x06, 0x01, 0xc8, 0x11,
+	0xf4, 0x7f, 0x1f, 0xaf, 0x9c, 0xba, 0xd4, 0xea, 0xfe, 0x22, 0xca, 0x3e, 0x08, 0x99, 0x6b, 0x46,
+	0xeb, 0xf3, 0x51, 0x3b, 0x0d, 0xd0, 0x7a, 0xe8, 0x39, 0xd6, 0x64, 0x9f, 0x02, 0xed, 0xcc, 0x88,
+	0x53, 0x2c, 0x83, 0x92, 0xb6, 0xa0, 0x8c, 0xb3, 0xde, 0x8c, 0xd6, 0xf3, 0x8e, 0x12, 0x88, 0x04,
+	0xac, 0xc4, 0xff, 0x8e, 0x43, 0xce, 0xf6, 0x10, 0xc6, 0xdd, 0xb7, 0x1c, 0x32, 0xb6, 0xfe, 0x5d,
+	0xd1, 0x37, 0xb3, 0x19, 0x68, 0x75, 0x47, 0x00, 0x9e, 0x44, 0x62, 0x6e, 0x56, 0x4c, 0xab, 0xfb,
+	0x9c, 0x51, 0x0a, 0x39, 0x6c, 0xff, 0xe7, 0x2a, 0xa4, 0x84, 0x0b, 0x9a, 0x0f, 0x69, 0xbb, 0xd1,
+	0x89, 0xc2, 0x76, 0x2a, 0x36, 0x23, 0xb5, 0xeb, 0x5d, 0x16, 0x70, 0x50, 0x18, 0xe2, 0xfe, 0x21,
+	0x06, 0xa6, 0x52, 0xb8, 0x7f, 0x88, 0x96, 0x67, 0x38, 0xee, 0x26, 0x99, 0x0c, 0xb8, 0x81, 0x8d,
+	0xcd, 0x3d, 0x36, 0x4d, 0xab, 0x87, 0x99, 0xa6, 0xcc, 0xce, 0x3d, 0x9b, 0x23, 0x01, 0x05, 0xa2,
+	0x68, 0xab, 0xef, 0x26, 0xb4, 0xb6, 0x70, 0x75, 0x3e, 0xa6, 0x0d, 0x7e, 0x2b, 0xd6, 0x7c, 0x19,
+	0x6e, 0x66, 0x45, 0xa0, 0xe3, 0xf9, 0x3f, 0x51, 0x21, 0x83, 0x73, 0x41, 0x7d, 0x3b, 0xda, 0xd8,
+	0xc0, 0xa1, 0x68, 0x74, 0x63, 0xdd, 0xc3, 0x50, 0x0d, 0xc5, 0x82, 0x80, 0x83, 0xc2, 0x70, 0xd7,
+	0xc8, 0x00, 0x5f, 0xf0, 0x62, 0xd9, 0xbd, 0xbf, 0xa7, 0x55, 0x1d, 0xbd, 0x26, 0x67, 0xb8, 0xd7,
+	0xe4, 0xcc, 0x72, 0x3b, 0x5d, 0x89, 0x6b, 0x69, 0xac, 0xec, 0xdb, 0x8b, 0x8c, 0x06, 0x08, 0x5a,
+	0xd8, 0x8d, 0x56, 0x70, 0x5b, 0xb2, 0x13, 0xdb, 0x8f, 0xea, 0xc6, 0xf5, 0xac, 0x08, 0x74, 0x3c,
+	0x3c, 0x4d, 0xea, 0x41, 0xc7, 0xeb, 0x33, 0x4f, 0x93, 0xf9, 0xa0, 0x03, 0x08, 0xc7, 0xc3, 0xea,
+	0x53, 0x61, 0x9a, 0xd2, 0xd8, 0xeb, 0x37, 0x0f, 0xab, 0x17, 0x18, 0x14, 0x44, 0xa9, 0xff, 0x7b,
+	0x0e, 0x19, 0x9e, 0x0b, 0x92, 0xb0, 0xfe, 0x97, 0x68, 0x0f, 0xfb, 0x38, 0xe9, 0x9f, 0x0f, 0xea,
+	0x5b, 0xd4, 0xbd, 0x99, 0xbf, 0x3b, 0x8f, 0x5c, 0x7a, 0xba, 0x8c, 0x8d, 0xba, 0x47, 0xeb, 0x9c,
+	0xc6, 0x7a, 0xdd, 0xb0, 0xfd, 0xb7, 0x1d, 0x32, 0x3e, 0xdf, 0x0c, 0x69, 0x3b, 0x9d, 0xa7, 0x71,
+	0xca, 0x06, 0x6e, 0x93, 0x4c, 0xd6, 0x15, 0xe4, 0x28, 0x43, 0xc7, 0x9d, 0x3b, 0x72, 0x24, 0xa0,
+	0x40, 0xd4, 0x6d, 0x90, 0x09, 0x0e, 0xcb, 0x16, 0xd7, 0xa1, 0xc6, 0x8f, 0x29, 0x66, 0xe7, 0x4d,
+	0x0a, 0x90, 0x27, 0xe9, 0x7f, 0xdb, 0x21, 0x67, 0xe7, 0x9b, 0xdd, 0x24, 0xa5, 0xf1, 0x2d, 0xb1,
+	0xa9, 0x49, 0x29, 0xd9, 0xfd, 0x24, 0x19, 0x6a, 0x49, 0xcb, 0xbf, 0x73, 0x9f, 0x75, 0x60, 0x78,
+	0x97, 0xac, 0xac, 0x7f, 0x8a, 0xd6, 0x53, 0xb4, 0xe2, 0x67, 0x9e, 0x57, 0x19, 0x0c, 0x14, 0x55,
+	0xb7, 0x43, 0xfa, 0x92, 0x0e, 0xad, 0xdb, 0xf3, 0xa3, 0x95, 0x7d, 0x40, 0x65, 0x70, 0x76, 0x3c,
+	0xe0, 0x2f, 0x60, 0x9c, 0xfc, 0xff, 0xed, 0x90, 0xc7, 0x7a, 0xf4, 0xf7, 0x5a, 0x98, 0xa4, 0xee,
+	0xc7, 0x0a, 0x7d, 0x9e, 0x39, 0x58, 0x9f, 0xb1, 0x36, 0xeb, 0xb1, 0xda, 0x57, 0x24, 0x44, 0xeb,
+	0xef, 0xa7, 0x49, 0x7f, 0x98, 0xd2, 0x96, 0xd4, 0x80, 0x5b, 0xd0, 0x3b, 0xf5, 0xe8, 0xcb, 0xdc,
+	0x98, 0x74, 0xcc, 0x5e, 0x46, 0x7e, 0xc0, 0xd9, 0xfa, 0xdb, 0x64, 0x60, 0x1e, 0x0d, 0x13, 0xed,
+	0x83, 0xf9, 0x24, 0xa6, 0x7b, 0x1d, 0x9a, 0x3f, 0x6a, 0xd9, 0x2d, 0x82, 0x95, 0x48, 0xfd, 0x53,
+	0xb5, 0x5c, 0xff, 0xe4, 0xff, 0x4b, 0x87, 0xe0, 0xaa, 0x6a, 0x84, 0xc2, 0x22, 0xcd, 0xc9, 0x71,
+	0x86, 0x8f, 0xeb, 0xe4, 0xee, 0xdd, 0x99, 0x1e, 0x53, 0x88, 0x1a, 0xfd, 0x8f, 0x93, 0x81, 0x84,
+	0xdd, 0xec, 0x45, 0x1b, 0x16, 0xe5, 0xce, 0xc6, 0xef, 0xfb, 0xf7, 0xee, 0x4c, 0x1f, 0xc8, 0xd7,
+	0x7e, 0x46, 0xd1, 0xe6, 0xf5, 0x40, 0x50, 0x45, 0xb9, 0xb1, 0x45, 0x93, 0x24, 0xd8, 0x94, 0x17,
+	0x45, 0x25, 0x37, 0x5e, 0xe7, 0x60, 0x90, 0xe5, 0xfe, 0xcf, 0x3b, 0x64, 0x4c, 0x9d, 0x81, 0x78,
+	0x0b, 0x70, 0x6f, 0xe8, 0xa7, 0x25, 0x9f, 0x29, 0x8f, 0xf7, 0xd8, 0x71, 0x38, 0xd2, 0x7d, 0x0e,
+	0xd3, 0x0f, 0x90, 0xd1, 0x06, 0xed, 0xd0, 0x76, 0x83, 0xb6, 0xeb, 0x21, 0xe5, 0x33, 0x64, 0x98,
+	0xfb, 0x28, 0x2d, 0x68, 0x70, 0x30, 0xb0, 0xfc, 0x5f, 0x76, 0xc8, 0xa3, 0x8a, 0x5c, 0x8d, 0xa6,
+	0x40, 0xd3, 0x78, 0x4f, 0x39, 0xc4, 0x1f, 0xee, 0xd0, 0xbb, 0x85, 0x62, 0x74, 0x1a, 0x73, 0xe6,
+	0x47, 0x3b, 0xf5, 0x46, 0xb8, 0xd0, 0xcd, 0x88, 0x80, 0xa4, 0xe6, 0x7f, 0xa9, 0x4a, 0x4e, 0xe9,
+	0x8d, 0x54, 0x1b, 0xcc, 0x8f, 0x38, 0x84, 0xa8, 0x11, 0xc0, 0x73, 0xbd, 0x6a, 0xc7, 0x06, 0x6a,
+	0x7c, 0xa9, 0x6c, 0x0b, 0x52, 0xe0, 0x04, 0x34, 0xb6, 0xee, 0xcb, 0x64, 0x74, 0x87, 0x59, 0xeb,
+	0xae, 0xa3, 0xd4, 0x91, 0x78, 0x55, 0xd6, 0x8c, 0xe9, 0xb2, 0x8f, 0xf9, 0x52, 0x86, 0x97, 0x69,
+	0x15, 0x34, 0x60, 0x02, 0x06, 0x29, 0xbc, 0x30, 0x8d, 0xc5, 0xfa, 0x27, 0x11, 0xaa, 0xf5, 0x57,
+	0x2c, 0xf6, 0x31, 0xff, 0xd5, 0xe7, 0x4e, 0xdc, 0xbd, 0x33, 0x3d, 0x66, 0x80, 0xc0, 0x6c, 0x84,
+	0xff, 0x32, 0x61, 0x63, 0x11, 0xb6, 0xbb, 0x74, 0xa5, 0xed, 0x3e, 0x21, 0x55, 0x7d, 0xdc, 0x3c,
+	0xa3, 0x76, 0x0e, 0x5d, 0xdd, 0x87, 0x52, 0xc6, 0x46, 0x10, 0x36, 0x99, 0xa3, 0x38, 0x62, 0x29,
+	0x29, 0x63, 0x91, 0x41, 0x41, 0x94, 0xfa, 0x33, 0x64, 0x70, 0x1e, 0xfb, 0x4e, 0x63, 0xa4, 0xab,
+	0x87, 0x8a, 0x8c, 0x19, 0xa1, 0x22, 0x32, 0x24, 0x64, 0x8d, 0x9c, 0x9e, 0x8f, 0x69, 0x90, 0xd2,
+	0xda, 0x73, 0x73, 0xdd, 0xfa, 0x36, 0x4d, 0xb9, 0xd7, 0x6b, 0xe2, 0x7e, 0x88, 0x8c, 0x45, 0xec,
+	0xc8, 0xb8, 0x16, 0xd5, 0xb7, 0xd1, 0x13, 0x91, 0x6b, 0x6e, 0x4f, 0x0b, 0x2a, 0x63, 0x2b, 0x7a,
+	0x21, 0x98, 0xb8, 0xfe, 0x7f, 0xae, 0x90, 0xd1, 0xf9, 0x38, 0x6a, 0xcb, 0x6d, 0xf1, 0x21, 0x1c,
+	0x65, 0xa9, 0x71, 0x94, 0x59, 0xb0, 0xb4, 0xea, 0xed, 0xef, 0x75, 0x9c, 0xb9, 0x6f, 0xa8, 0x2d,
+	0xb2, 0x6a, 0xeb, 0x26, 0x63, 0xf0, 0x65, 0xb4, 0xb3, 0x8f, 0x6d, 0x6e, 0xa0, 0xfe, 0x7f, 0x71,
+	0xc8, 0xa4, 0x8e, 0xfe, 0x10, 0x4e, 0xd0, 0xc4, 0x3c, 0x41, 0x6f, 0xd8, 0xed, 0x6f, 0x8f, 0x63,
+	0xf3, 0xed, 0x41, 0xb3, 0x9f, 0xcc, 0xcc, 0xfe, 0x55, 0x87, 0x8c, 0xee, 0x6a, 0x00, 0xd1, 0x59,
+	0xdb, 0x42, 0xcc, 0xbb, 0xe4, 0x36, 0xa3, 0x43, 0xef, 0xe5, 0x7e, 0x83, 0xd1, 0x12, 0xdc, 0xf7,
+	0x31, 0xfa, 0xab, 0xd1, 0x6d, 0xca, 0xe3, 0x5b, 0x0d, 0x69, 0x4d, 0xc0, 0x41, 0x61, 0xb8, 0x1f,
+	0x23, 0x27, 0xea, 0x51, 0xbb, 0xde, 0x8d, 0x63, 0xda, 0xae, 0xef, 0x09, 0xd7, 0x5b, 0x7e, 0x20,
+	0xce, 0x88, 0x6a, 0x27, 0xe6, 0xf3, 0x08, 0xf7, 0xca, 0x80, 0x50, 0x24, 0xc4, 0x6d, 0x0e, 0x09,
+	0x1e, 0x59, 0xe2, 0xde, 0xa6, 0xd9, 0x1c, 0x18, 0x18, 0x64, 0xb9, 0x7b, 0x93, 0x9c, 0x4d, 0xd2,
+	0x20, 0x4e, 0xc3, 0xf6, 0xe6, 0x02, 0x0d, 0x1a, 0xcd, 0xb0, 0x8d, 0x57, 0x89, 0xa8, 0xdd, 0xe0,
+	0x16, 0xc9, 0xea, 0xdc, 0x63, 0x77, 0xef, 0x4c, 0x9f, 0xad, 0x95, 0xa3, 0x40, 0xaf, 0xba, 0xee,
+	0xc7, 0xc9, 0x94, 0xb0, 0x6a, 0x6c, 0x74, 0x9b, 0x2f, 0x44, 0xeb, 0xc9, 0x95, 0x30, 0x41, 0x75,
+	0xc0, 0xb5, 0xb0, 0x15, 0xa6, 0xcc, 0xee, 0xd8, 0x3f, 0x77, 0xfe, 0xee, 0x9d, 0xe9, 0xa9, 0x5a,
+	0x4f, 0x2c, 0xd8, 0x87, 0x82, 0x0b, 0xe4, 0x0c, 0xdf, 0xfc, 0x0a, 0xb4, 0x07, 0x19, 0xed, 0xa9,
+	0xbb, 0x77, 0xa6, 0xcf, 0x2c, 0x96, 0x62, 0x40, 0x8f, 0x9a, 0xf8, 0x05, 0xd3, 0xb0, 0x45, 0x5f,
+	0xc3, 0x20, 0xb3, 0x21, 0xf3, 0x0b, 0xae, 0x09, 0x38, 0x28, 0x0c, 0xf7, 0x53, 0xd9, 0x4c, 0xc4,
+	0xe5, 0xe2, 0x0d, 0x1f, 0x71, 0x87, 0x63, 0x57, 0x93, 0x5b, 0x1a, 0x25, 0xe6, 0x91, 0x6b, 0xd0,
+	0x76, 0xff, 0x96, 0x43, 0x46, 0x93, 0x34, 0x52, 0x11, 0x64, 0x1e, 0xb1, 0x35, 0xed, 0x6b, 0x1a,
+	0x55, 0x2e, 0xf8, 0xe8, 0x10, 0x30, 0xb8, 0xba, 0xef, 0x25, 0xc3, 0x72, 0x02, 0x27, 0xde, 0x08,
+	0x93, 0x95, 0xd8, 0x35, 0x4e, 0xce, 0xef, 0x04, 0xb2, 0x72, 0x14, 0x65, 0x77, 0xb7, 0x68, 0x5b,
+	0x78, 0x17, 0xa9, 0x7d, 0xf4, 0xd6, 0x16, 0x6d, 0x03, 0x2b, 0xf1, 0xbf, 0x55, 0x25, 0x6e, 0x71,
+	0xe3, 0x73, 0xaf, 0x92, 0x81, 0xa0, 0x9e, 0x62, 0x58, 0x08, 0x37, 0xaa, 0x3c, 0x51, 0x26, 0x14,
+	0xf0, 0x01, 0x04, 0xba, 0x41, 0x71, 0xde, 0xd3, 0x6c, 0xb7, 0x9c, 0x65, 0x55, 0x41, 0x90, 0x70,
+	0x23, 0x72, 0xa2, 0x19, 0x24, 0xa9, 0x6c, 0x61, 0x03, 0x3f, 0xa4, 0x57, 0x39, 0xb4, 0xd7, 0xfe,
+	0x69, 0x5c, 0x8f, 0xd7, 0xf2, 0x84, 0xa0, 0x48, 0x1b, 0xe3, 0xf7, 0xea, 0x52, 0xf4, 0x95, 0x62,
+	0xcd, 0x55, 0x2b, 0x92, 0x07, 0xa7, 0x69, 0x48, 0x56, 0x82, 0x0d, 0x68, 0x2c, 0x51, 0xa3, 0xc4,
+	0xd6, 0x0d, 0x6d, 0x50, 0xbe, 0xfa, 0xab, 0x99, 0x10, 0x5c, 0x93, 0x05, 0x90, 0xe1, 0x68, 0x52,
+	0x06, 0x5f, 0xf0, 0x3d, 0xa4, 0x0c, 0xf7, 0x79, 0xd2, 0xdf, 0xd9, 0x0a, 0x12, 0x19, 0xde, 0xe3,
+	0xcb, 0x5d, 0x7b, 0x15, 0x81, 0x6c, 0x6b, 0xd2, 0xbe, 0x25, 0x03, 0x02, 0xaf, 0xe0, 0xff, 0xf1,
+	0x18, 0x19, 0x5c, 0x98, 0x5d, 0x5a, 0x0b, 0x92, 0xed, 0x03, 0xdc, 0x81, 0x70, 0x19, 0x0a, 0x61,
+	0x35, 0xbf, 0x91, 0x4a, 0x21, 0x16, 0x14, 0x86, 0xdb, 0x26, 0x03, 0x61, 0x1b, 0x77, 0x1e, 0x6f,
+	0xdc, 0x96, 0xb9, 0x42, 0xdd, 0xe7, 0x98, 0x3e, 0x69, 0x99, 0x51, 0x07, 0xc1, 0xc5, 0x7d, 0x03,
+	0x7d, 0xaa, 0x44, 0xb0, 0xa6, 0x38, 0xff, 0xaf, 0xda, 0xd0, 0xc3, 0x0b, 0x92, 0xba, 0xf7, 0x94,
+	0x00, 0x41, 0xc6, 0x10, 0xc3, 0x23, 0x46, 0x64, 0xd7, 0xd1, 0x55, 0xa0, 0xcf, 0x5a, 0xd8, 0x6d,
+	0x46, 0x94, 0xbb, 0xc9, 0x68, 0x00, 0xd0, 0x59, 0x16, 0xee, 0x4c, 0xfd, 0x07, 0xb9, 0x33, 0xb9,
+	0xbb, 0x64, 0x78, 0x37, 0x4c, 0xb7, 0xd8, 0x09, 0x2f, 0x4c, 0x73, 0x8b, 0x16, 0x7c, 0x1f, 0x53,
+	0xda, 0xca, 0x46, 0xec, 0x96, 0x64, 0x00, 0x19, 0x2f, 0x5c, 0x0e, 0xf8, 0x83, 0x05, 0xbb, 0x7a,
+	0x83, 0xa6, 0x82, 0xf5, 0x96, 0x2c, 0x80, 0x0c, 0x07, 0x45, 0x8c, 0x13, 0xea, 0x97, 0xd4, 0x67,
+	0x7b, 0x27, 0x6c, 0x45, 0xa2, 0xdc, 0xca, 0x93, 0xe6, 0x7b, 0x4b, 0x01, 0x0c, 0xc5, 0x46, 0xe0,
+	0xd7, 0x1f, 0x45, 0x68, 0x8d, 0xbe, 0xda, 0xc5, 0x5d, 0xcf, 0x1b, 0xb2, 0x35, 0xe5, 0x25, 0x45,
+	0xfe, 0x1d, 0x6f, 0x69, 0x3c, 0xc0, 0xe0, 0xa8, 0x76, 0xf5, 0xe1, 0x5e, 0xbb, 0x3a, 0x06, 0xa3,
+	0xd5, 0xd5, 0x3d, 0xc7, 0x23, 0xb6, 0x5c, 0xdb, 0xb3, 0xbb, 0x13, 0x0f, 0x46, 0xcb, 0x7e, 0x83,
+	0xc6, 0x0f, 0x37, 0xb3, 0xa8, 0x7d, 0xf9, 0x76, 0x98, 0x8a, 0x10, 0x3a, 0xb5, 0x99, 0xad, 0x30,
+	0x28, 0x88, 0x52, 0xee, 0x9d, 0x82, 0xf3, 0x33, 0x11, 0x07, 0x94, 0xe6, 0x9d, 0xc2, 0xc0, 0x20,
+	0xcb, 0xdd, 0xbf, 0xeb, 0x90, 0xfe, 0xad, 0x28, 0xda, 0x4e, 0xbc, 0xb1, 0x0b, 0x55, 0x3b, 0xe2,
+	0xbe, 0xd8, 0x0c, 0x67, 0xae, 0x20, 0x59, 0x33, 0xc6, 0xb8, 0x9f, 0xc1, 0xee, 0xdd, 0x99, 0x1e,
+	0xbf, 0x16, 0x6e, 0xd0, 0xfa, 0x5e, 0xbd, 0x49, 0x19, 0xe4, 0x73, 0x6f, 0x6b, 0x90, 0xcb, 0x3b,
+	0xb4, 0x9d, 0x02, 0x6f, 0x15, 0xee, 0x48, 0x51, 0x5b, 0x88, 0x51, 0x22, 0x2a, 0xce, 0xc2, 0x75,
+	0xde, 0xe0, 0xce, 0x8f, 0xf9, 0x15, 0xc9, 0x05, 0x32, 0x86, 0x9c, 0x3b, 0x9e, 0x14, 0xe8, 0xd9,
+	0x35, 0x79, 0xac, 0xdc, 0x05, 0x17, 0xc8, 0x18, 0x4e, 0x7d, 0xc1, 0x21, 0x24, 0x1b, 0xc4, 0x12,
+	0x13, 0x38, 0x35, 0x9d, 0x46, 0x6c, 0x37, 0x4d, 0xb7, 0xa9, 0xff, 0x5b, 0x87, 0x8c, 0xe0, 0x87,
+	0x95, 0x27, 0xd3, 0x53, 0x64, 0x20, 0x0d, 0xe2, 0x4d, 0x2a, 0xcd, 0x40, 0x6a, 0x2a, 0xae, 0x31,
+	0x28, 0x88, 0x52, 0xb7, 0x4d, 0xfa, 0xd3, 0x20, 0xd9, 0x96, 0xb7, 0xab, 0x65, 0x6b, 0xd3, 0x2b,
+	0xbb, 0x58, 0xe1, 0xaf, 0x04, 0x38, 0x1b, 0xf7, 0x69, 0x32, 0x84, 0x27, 0xfa, 0x62, 0x90, 0x48,
+	0xcf, 0xac, 0x51, 0x3c, 0x5b, 0x17, 0x05, 0x0c, 0x54, 0x29, 0x5a, 0xb8, 0xfa, 0x16, 0xf8, 0x3d,
+	0x7b, 0x20, 0x61, 0x8e, 0xd8, 0x9e, 0x63, 0x6b, 0x3d, 0x23, 0x5d, 0xe1, 0xdc, 0x9d, 0xdd, 0x74,
+	0xd9, 0x6f, 0x10, 0xbc, 0x50, 0x91, 0x33, 0x9e, 0xc6, 0x41, 0x3b, 0xd9, 0x60, 0x06, 0x37, 0x54,
+	0xa8, 0x55, 0x6c, 0xad, 0xc0, 0x35, 0x83, 0x6e, 0x2d, 0xa5, 0x9d, 0xcc, 0xee, 0x67, 0x96, 0x41,
+	0xae, 0x0d, 0xfe, 0x97, 0x2a, 0x84, 0x64, 0xad, 0xc7, 0x20, 0x94, 0xb1, 0x40, 0xf7, 0x22, 0xf6,
+	0x1c, 0x5b, 0x53, 0xcd, 0x70, 0x4e, 0xe6, 0x2a, 0x26, 0x03, 0x04, 0x26, 0x63, 0x54, 0xdf, 0xa8,
+	0x44, 0x0e, 0x9a, 0x13, 0x8f, 0x52, 0xdf, 0xac, 0xea, 0x85, 0x60, 0xe2, 0x16, 0x1c, 0x80, 0xaa,
+	0x07, 0x75, 0x00, 0xf2, 0x7f, 0xc4, 0x21, 0x63, 0x6c, 0xfd, 0x71, 0xe3, 0x26, 0xdd, 0x70, 0x17,
+	0xc8, 0xe4, 0x6e, 0x4e, 0x39, 0x2e, 0x16, 0x81, 0x0a, 0x2a, 0xcf, 0x2b, 0xcf, 0xa1, 0x50, 0xe3,
+	0x70, 0x82, 0xa0, 0xff, 0x41, 0xd2, 0xcf, 0xb6, 0x45, 0xac, 0x96, 0x08, 0x7b, 0x4c, 0x5e, 0x01,
+	0x2b, 0xed, 0x34, 0xa0, 0x30, 0xfc, 0x2f, 0x3a, 0x64, 0x84, 0xd5, 0x13, 0x9f, 0xf3, 0x75, 0x32,
+	0xb8, 0x4b, 0xd7, 0x71, 0x67, 0xb5, 0x67, 0xa7, 0xbe, 0xc5, 0x09, 0x6a, 0x6c, 0xb8, 0xd2, 0x56,
+	0xc0, 0x41, 0x72, 0xf4, 0xff, 0x99, 0x43, 0xc6, 0x2f, 0xdf, 0xa6, 0xf5, 0x6e, 0x1a, 0xc5, 0xdc,
+	0x36, 0xd6, 0x23, 0x1a, 0xd3, 0x39, 0x4a, 0x34, 0x26, 0x2a, 0x07, 0x43, 0x8c, 0x01, 0x11, 0xa3,
+	0x99, 0xe9, 0x5d, 0x10, 0x08, 0xbc, 0xcc, 0xfd, 0x7e, 0x32, 0x1e, 0xb6, 0xeb, 0xcd, 0x6e, 0x83,
+	0xd6, 0xea, 0x71, 0xd8, 0x11, 0x52, 0xee, 0x10, 0x37, 0x0d, 0x2e, 0x1b, 0x25, 0x90, 0xc3, 0xf4,
+	0x7f, 0xd5, 0x21, 0x23, 0x9a, 0x2b, 0x30, 0x1e, 0x0e, 0x9b, 0xf3, 0x35, 0xae, 0x63, 0xf4, 0x1c,
+	0x5b, 0xc2, 0xf2, 0x92, 0x24, 0x99, 0x49, 0x72, 0x0a, 0x04, 0x19, 0xc3, 0xfb, 0xb8, 0xea, 0xfa,
+	0xbf, 0xed, 0x90, 0xd3, 0xa5, 0x7e, 0xcb, 0xef, 0x70, 0xb3, 0x0d, 0x77, 0x99, 0xca, 0x01, 0xdc,
+	0x65, 0x3e, 0x5b, 0x21, 0x19, 0x25, 0x3c, 0x76, 0xd6, 0xb3, 0x96, 0x6b, 0xc7, 0x8e, 0xe0, 0x24,
+	0x4a, 0xdd, 0x37, 0xc8, 0x59, 0x73, 0x8a, 0x1c, 0xd1, 0xe4, 0xc9, 0xf5, 0x43, 0xe5, 0x94, 0xa0,
+	0x17, 0x0b, 0xf7, 0x3a, 0x39, 0xd9, 0x4d, 0x28, 0x6e, 0x02, 0xcd, 0x28, 0x68, 0x2c, 0x37, 0x68,
+	0x3b, 0x0d, 0xd3, 0x3d, 0x31, 0xd5, 0x1e, 0x93, 0xf9, 0x56, 0x6e, 0x16, 0x51, 0xa0, 0xac, 0x9e,
+	0xff, 0x35, 0x87, 0xf4, 0x2f, 0x05, 0xdd, 0x4d, 0x7a, 0x20, 0x05, 0x38, 0x1e, 0x81, 0x31, 0x0d,
+	0x9a, 0xa9, 0x54, 0x06, 0x88, 0x23, 0x10, 0x04, 0x0c, 0x54, 0xa9, 0x3b, 0x4b, 0x86, 0xa3, 0x0e,
+	0x35, 0x9c, 0x07, 0x9e, 0x90, 0x1f, 0x63, 0x45, 0x16, 0xa0, 0xb4, 0xc6, 0xb8, 0x2b, 0x08, 0x64,
+	0xb5, 0xfc, 0xaf, 0x0f, 0x90, 0x11, 0x2d, 0x62, 0x12, 0x45, 0xe8, 0x98, 0x76, 0xa2, 0xfc, 0x0d,
+	0x18, 0xe7, 0x1f, 0xb0, 0x12, 0xdc, 0xc1, 0x62, 0xba, 0x13, 0x26, 0xfc, 0xc4, 0x33, 0x76, 0x30,
+	0x10, 0x70, 0x50, 0x18, 0xe8, 0x35, 0xdc, 0xa0, 0x9d, 0x74, 0x8b, 0x35, 0xaf, 0x8f, 0x7b, 0x0d,
+	0x2f, 0x20, 0x00, 0x38, 0x1c, 0x11, 0x36, 0x68, 0x5a, 0xdf, 0x62, 0xb6, 0x1e, 0xe1, 0x56, 0xbc,
+	0x88, 0x00, 0xe0, 0xf0, 0x12, 0xbf, 0x84, 0xfe, 0xe3, 0xf7, 0x4b, 0x18, 0xb0, 0xec, 0x97, 0xe0,
+	0x76, 0xc8, 0xc9, 0x24, 0xd9, 0x5a, 0x8d, 0xc3, 0x9d, 0x20, 0xa5, 0xd9, 0x64, 0x1e, 0x3c, 0x0c,
+	0x9f, 0xb3, 0x2c, 0xcb, 0x4f, 0xed, 0x4a, 0x9e, 0x0a, 0x94, 0x91, 0x76, 0x6b, 0xe4, 0x74, 0xd8,
+	0x4e, 0x68, 0xbd, 0x1b, 0xd3, 0xe5, 0xcd, 0x76, 0x14, 0xd3, 0x2b, 0x51, 0x82, 0xe4, 0x44, 0x52,
+	0x11, 0xe5, 0x68, 0xbf, 0x5c, 0x86, 0x04, 0xe5, 0x75, 0xdd, 0x25, 0x72, 0xa2, 0x11, 0x26, 0xc1,
+	0x7a, 0x93, 0xd6, 0xba, 0xeb, 0xad, 0x88, 0x2b, 0xdb, 0x86, 0x19, 0xc1, 0x47, 0xa5, 0x66, 0x78,
+	0x21, 0x8f, 0x00, 0xc5, 0x3a, 0x78, 0xa0, 0x27, 0x61, 0x7b, 0xb3, 0x49, 0xe7, 0xe2, 0xa0, 0x5d,
+	0xdf, 0x12, 0xd9, 0x48, 0xd4, 0x81, 0x5e, 0xd3, 0xca, 0xc0, 0xc0, 0x64, 0x5b, 0x08, 0xaf, 0x93,
+	0xbb, 0x44, 0x09, 0x6c, 0x51, 0xea, 0xce, 0x92, 0x09, 0xd9, 0x87, 0xda, 0x76, 0xd8, 0x59, 0xbb,
+	0x56, 0x63, 0x97, 0xa9, 0xa1, 0xcc, 0x8d, 0x70, 0xd9, 0x2c, 0x86, 0x3c, 0xbe, 0xff, 0x4d, 0x87,
+	0x8c, 0xea, 0x71, 0x32, 0x78, 0xc7, 0x25, 0x5b, 0x0b, 0x8b, 0x35, 0x7e, 0xfc, 0xd9, 0x93, 0x37,
+	0xaf, 0x28, 0x9a, 0x99, 0x06, 0x2d, 0x83, 0x81, 0xc6, 0xf3, 0x00, 0x89, 0x81, 0x9e, 0x20, 0xfd,
+	0x1b, 0x11, 0x8a, 0xc3, 0x55, 0xd3, 0x7a, 0xb7, 0x88, 0x40, 0xe0, 0x65, 0xfe, 0xff, 0x70, 0xc8,
+	0x99, 0xf2, 0x10, 0xa0, 0xef, 0x86, 0x4e, 0x5e, 0xc2, 0x3c, 0x63, 0xe9, 0x96, 0x71, 0xcc, 0x68,
+	0xa9, 0xc1, 0x64, 0x09, 0x68, 0x58, 0x07, 0xeb, 0xf6, 0xbf, 0xa9, 0x10, 0x8d, 0x27, 0xe6, 0x17,
+	0x19, 0x43, 0xb6, 0x57, 0xe3, 0x75, 0xa3, 0xb7, 0x2b, 0x76, 0x7a, 0xab, 0xc8, 0x66, 0x52, 0xae,
+	0x01, 0x06, 0x93, 0x39, 0xaa, 0xb0, 0x83, 0x46, 0x23, 0xa6, 0x49, 0xa2, 0xcc, 0xfd, 0xec, 0x76,
+	0x39, 0x2b, 0x81, 0x90, 0x95, 0xe3, 0x3e, 0x8c, 0x11, 0x5a, 0xb8, 0xb5, 0x79, 0x55, 0x73, 0x1f,
+	0x46, 0x26, 0x08, 0x07, 0x85, 0xe1, 0xbe, 0x44, 0xce, 0xa0, 0xea, 0x9e, 0xdf, 0x1e, 0x68, 0xbc,
+	0x1a, 0x47, 0x29, 0xad, 0xb3, 0x73, 0x83, 0x7b, 0x91, 0x9d, 0x17, 0x75, 0xcf, 0x2c, 0x94, 0x62,
+	0x41, 0x8f, 0xda, 0xfe, 0x4f, 0xf5, 0x11, 0xb3, 0x4f, 0xe8, 0xa5, 0xb4, 0x1d, 0xaf, 0xcf, 0x33,
+	0x2f, 0xac, 0xa3, 0x78, 0x43, 0x31, 0x2f, 0xa5, 0xab, 0x26, 0x05, 0xc8, 0x93, 0x14, 0x5c, 0xae,
+	0xd2, 0xbd, 0x34, 0x58, 0x3f, 0xb2, 0x2f, 0xd4, 0x55, 0x93, 0x02, 0xe4, 0x49, 0xa2, 0x7f, 0xde,
+	0x76, 0xbc, 0x2e, 0x4f, 0x8f, 0xbc, 0x7f, 0xde, 0xd5, 0xac, 0x08, 0x74, 0x3c, 0xfc, 0x34, 0xdb,
+	0xf1, 0x3a, 0x1e, 0xd8, 0x32, 0x63, 0x96, 0xfa, 0x34, 0x57, 0x05, 0x1c, 0x14, 0x86, 0xdb, 0x21,
+	0xee, 0xb6, 0x1c, 0x3d, 0xe5, 0x73, 0xe6, 0xf5, 0x1f, 0xd2, 0x65, 0x8d, 0xc5, 0x0c, 0x5d, 0x2d,
+	0xd0, 0x81, 0x12, 0xda, 0xee, 0xcb, 0xe4, 0xec, 0x76, 0xbc, 0x2e, 0xc4, 0xa2, 0xd5, 0x38, 0x6c,
+	0xd7, 0xc3, 0x8e, 0x91, 0x1d, 0x6b, 0x5a, 0x34, 0xf7, 0xec, 0xd5, 0x72, 0x34, 0xe8, 0x55, 0xdf,
+	0xff, 0x8d, 0x3e, 0xc2, 0x92, 0x20, 0xe0, 0x36, 0xdd, 0xa2, 0xe9, 0x56, 0xd4, 0xc8, 0x4b, 0x7a,
+	0xd7, 0x19, 0x14, 0x44, 0xa9, 0xf4, 0x8c, 0xaf, 0xf4, 0xf0, 0x8c, 0xdf, 0x25, 0x83, 0x5b, 0x34,
+	0x68, 0xd0, 0x58, 0x9a, 0x2b, 0xae, 0xd9, 0x49, 0xdb, 0x70, 0x85, 0x11, 0xcd, 0x14, 0x6b, 0xfc,
+	0x77, 0x02, 0x92, 0x1b, 0xde, 0x34, 0x50, 0xc6, 0x8a, 0xba, 0xa9, 0xb4, 0x38, 0x72, 0x73, 0x05,
+	0x3b, 0xec, 0xd7, 0x8c, 0x12, 0xc8, 0x61, 0xe2, 0x0d, 0x53, 0x58, 0x07, 0x95, 0x19, 0xc4, 0x1b,
+	0x30, 0x6f, 0x98, 0xb5, 0x5c, 0x39, 0x14, 0x6a, 0x30, 0xcf, 0xe6, 0xa8, 0xb1, 0xe7, 0xf5, 0x9b,
+	0x3b, 0xfd, 0x5c, 0xd4, 0xd8, 0x03, 0x56, 0xe2, 0xbe, 0x46, 0x86, 0xf0, 0x2f, 0x86, 0xd3, 0x7b,
+	0x43, 0xb6, 0xe2, 0x8e, 0x70, 0x74, 0x90, 0x87, 0xb8, 0x0b, 0x32, 0xd9, 0x73, 0x4e, 0x70, 0x01,
+	0xc5, 0x0f, 0xaf, 0x7e, 0xfa, 0x71, 0xf9, 0x12, 0x8d, 0xc3, 0x8d, 0x3d, 0x26, 0xcf, 0x0c, 0x65,
+	0x57, 0xbf, 0xe5, 0x02, 0x06, 0x94, 0xd4, 0xf2, 0xbf, 0x58, 0x25, 0xa3, 0x7a, 0x2e, 0x8d, 0xfb,
+	0x85, 0x4b, 0x24, 0xd9, 0xa4, 0xe0, 0x3a, 0x17, 0x0b, 0x89, 0xb6, 0xee, 0x3b, 0x21, 0xb6, 0x48,
+	0x5f, 0xd0, 0x15, 0x82, 0xac, 0x15, 0xb5, 0x36, 0xeb, 0x31, 0xc6, 0x35, 0xb0, 0x98, 0x5b, 0xfc,
+	0x0f, 0x18, 0x07, 0xbc, 0xe1, 0xa5, 0xcd, 0x44, 0x1c, 0x48, 0x7d, 0xd6, 0x0e, 0xa4, 0xb5, 0xb5,
+	0xd5, 0xb5, 0x6b, 0xf2, 0x04, 0x66, 0xe7, 0x8a, 0xfa, 0x09, 0x19, 0x43, 0xff, 0xf3, 0x55, 0x32,
+	0x24, 0x9b, 0x86, 0xb6, 0x5d, 0x92, 0xf9, 0xa1, 0x7a, 0x8e, 0xad, 0x49, 0x66, 0xba, 0xd0, 0x6a,
+	0x66, 0x43, 0x05, 0x07, 0x8d, 0x2f, 0xaa, 0xf8, 0x22, 0x1c, 0x9a, 0x4b, 0xf6, 0xb2, 0xd1, 0xac,
+	0x20, 0xe3, 0x4b, 0x8c, 0x7b, 0xa6, 0x86, 0x67, 0x30, 0x10, 0xbc, 0xf0, 0x3b, 0xac, 0x4b, 0xf7,
+	0x68, 0x7b, 0xd6, 0x34, 0xe5, 0x71, 0x9d, 0x5d, 0x9c, 0x15, 0x08, 0x32, 0x86, 0xfe, 0xb3, 0x64,
+	0xdc, 0x5c, 0x8a, 0x78, 0x55, 0x5a, 0xdf, 0x4b, 0x29, 0xd7, 0xe1, 0x8d, 0xf2, 0xab, 0xd2, 0x1c,
+	0x02, 0x80, 0xc3, 0x31, 0x80, 0x83, 0x64, 0x9b, 0xdb, 0x01, 0xac, 0x99, 0x4f, 0xe8, 0x0a, 0xe8,
+	0x5e, 0xf7, 0xd1, 0xcf, 0x90, 0xe1, 0x1d, 0x99, 0x99, 0xd7, 0xab, 0xda, 0x72, 0x66, 0xca, 0xda,
+	0x29, 0x36, 0x1a, 0x36, 0x23, 0x55, 0x0a, 0x60, 0xc8, 0x78, 0xfa, 0x11, 0x99, 0xcc, 0x63, 0xbb,
+	0xaf, 0x90, 0xd1, 0x44, 0x1e, 0xea, 0x59, 0x58, 0xf2, 0x01, 0x0f, 0x7f, 0xee, 0x4a, 0xa0, 0x55,
+	0x07, 0x83, 0x98, 0xff, 0x0a, 0x19, 0x33, 0x56, 0x4b, 0x8f, 0xcd, 0xce, 0x39, 0xd2, 0x66, 0xb7,
+	0x42, 0x06, 0xac, 0x7e, 0x1f, 0xff, 0x1f, 0x38, 0x64, 0x98, 0xb9, 0x8a, 0x6c, 0xa2, 0x85, 0x50,
+	0x55, 0xa9, 0xee, 0xf3, 0x49, 0x13, 0x32, 0xc8, 0x15, 0x2d, 0xd2, 0xc5, 0xd2, 0x5e, 0xa6, 0x42,
+	0xb5, 0x81, 0x72, 0x8d, 0x4e, 0x02, 0x92, 0x93, 0xff, 0x31, 0x32, 0x99, 0x4f, 0x07, 0x93, 0x29,
+	0xfd, 0x9c, 0x7d, 0x94, 0x7e, 0x4f, 0x90, 0xfe, 0x26, 0xd6, 0xc9, 0x8f, 0x02, 0x23, 0x04, 0xbc,
+	0xcc, 0xff, 0x39, 0x87, 0x0c, 0xf1, 0x5a, 0x74, 0x03, 0x05, 0x9c, 0x7a, 0xb9, 0x1b, 0xb4, 0xe7,
+	0x98, 0x02, 0x4e, 0x0f, 0x6f, 0x69, 0xe8, 0x55, 0x1f, 0x65, 0x3b, 0xd6, 0xaa, 0xab, 0x4a, 0x79,
+	0xa7, 0x64, 0xbb, 0x65, 0x01, 0x07, 0x85, 0xe1, 0xff, 0x68, 0x85, 0x0c, 0x2c, 0xb7, 0x3b, 0xdd,
+	0xbf, 0xf2, 0xb9, 0x93, 0xaf, 0x93, 0x3e, 0x34, 0x79, 0x9b, 0xd9, 0xc2, 0x47, 0xe7, 0x9e, 0xd4,
+	0x33, 0x85, 0x7b, 0x66, 0xa6, 0x70, 0x08, 0x76, 0xa5, 0xd7, 0xb5, 0x30, 0x64, 0x65, 0xb1, 0xee,
+	0xcf, 0x90, 0x61, 0xf6, 0xf5, 0xaf, 0xd2, 0x3d, 0x16, 0x99, 0xce, 0x3d, 0x00, 0x9d, 0x4c, 0x85,
+	0x64, 0x78, 0xeb, 0x2d, 0x90, 0x71, 0x86, 0x6d, 0x24, 0x18, 0xa7, 0x59, 0x42, 0xd3, 0x5c, 0x82,
+	0x71, 0x2d, 0x99, 0xa9, 0x86, 0xe5, 0xcf, 0x90, 0x91, 0x8c, 0xca, 0x01, 0xb8, 0x7e, 0xa7, 0x42,
+	0xc6, 0x0c, 0x7b, 0x9c, 0x61, 0x33, 0x70, 0xee, 0xeb, 0x3c, 0x62, 0x38, 0x73, 0x54, 0xde, 0x69,
+	0x67, 0x8e, 0xea, 0xc3, 0x77, 0xe6, 0x30, 0x3f, 0x52, 0xdf, 0x81, 0x3e, 0xd2, 0x57, 0x1c, 0xd2,
+	0x77, 0x2d, 0x6c, 0x6f, 0x1f, 0x6c, 0x73, 0x4d, 0xea, 0x51, 0xa7, 0xb0, 0xb9, 0xd6, 0x10, 0x08,
+	0xbc, 0x4c, 0x4a, 0xa2, 0xd5, 0x1e, 0x92, 0x68, 0x66, 0x46, 0xed, 0xdb, 0xcf, 0x8c, 0xea, 0xa3,
+	0x8f, 0xdc, 0xf5, 0xa0, 0x1d, 0x6e, 0xd0, 0x24, 0x65, 0x13, 0x30, 0x3d, 0xd6, 0x50, 0xe6, 0xd1,
+	0x1e, 0x89, 0x7c, 0x3e, 0xe7, 0x90, 0x13, 0xd7, 0x69, 0x2b, 0x0a, 0x5f, 0x0b, 0xb2, 0xe8, 0x07,
+	0xec, 0xe3, 0x56, 0x98, 0x8a, 0xe3, 0x4c, 0xf5, 0xf1, 0x0a, 0xa6, 0xcd, 0xdb, 0x0a, 0xef, 0x67,
+	0xa9, 0x60, 0x41, 0x82, 0x78, 0x31, 0xd7, 0xec, 0x72, 0x59, 0x5c, 0x83, 0x2c, 0x80, 0x0c, 0xc7,
+	0xff, 0x2d, 0x87, 0x0c, 0xf2, 0x46, 0xa8, 0x80, 0x11, 0xa7, 0x07, 0xed, 0x2d, 0xd2, 0xcf, 0xea,
+	0x89, 0xe9, 0xbf, 0x64, 0x41, 0xf0, 0x44, 0x72, 0x7c, 0xb1, 0xb2, 0x7f, 0x81, 0x33, 0x60, 0xd7,
+	0xd5, 0xe0, 0xf6, 0xac, 0x0a, 0xfc, 0xc8, 0xae, 0xab, 0x0c, 0x0a, 0xa2, 0xd4, 0xff, 0x7a, 0x95,
+	0x0c, 0xa9, 0x64, 0xa4, 0x2c, 0x53, 0x90, 0x7a, 0x81, 0x40, 0x6e, 0xea, 0xaf, 0xd8, 0x4b, 0x86,
+	0x3a, 0x93, 0xbd, 0x75, 0x20, 0x3c, 0x31, 0x94, 0xf2, 0x41, 0x2b, 0x01, 0xbd, 0x11, 0xee, 0xa7,
+	0xc9, 0x00, 0x3b, 0x11, 0xe5, 0x1e, 0xff, 0x92, 0xc5, 0xe6, 0xb0, 0xfd, 0x4f, 0xb4, 0x44, 0x8d,
+	0x10, 0x07, 0x82, 0xe0, 0x3a, 0xf5, 0x61, 0x32, 0x99, 0x6f, 0xf5, 0xfd, 0xa2, 0xff, 0x87, 0xf5,
+	0xdc, 0x01, 0xdf, 0x27, 0xb6, 0xd9, 0xc3, 0x57, 0xf5, 0x5f, 0x24, 0x23, 0xd7, 0x69, 0x1a, 0x87,
+	0x75, 0x46, 0xe0, 0x7e, 0x93, 0xeb, 0x40, 0xc2, 0xd5, 0x8f, 0xb1, 0xc9, 0x8a, 0x34, 0xd1, 0x9b,
+	0x84, 0x74, 0xe2, 0x08, 0xf5, 0x16, 0xb4, 0x2b, 0x3f, 0xb6, 0x85, 0x9b, 0xc8, 0xaa, 0xa2, 0xc9,
+	0x9d, 0x87, 0xb2, 0xdf, 0xa0, 0xf1, 0xf3, 0x7f, 0xdc, 0x21, 0xfd, 0xd7, 0xbb, 0x29, 0xbd, 0x7d,
+	0x80, 0xad, 0xed, 0xd0, 0xf9, 0x70, 0x30, 0x2e, 0x28, 0x48, 0x83, 0xf5, 0x20, 0x91, 0xfa, 0xd3,
+	0x2c, 0x2e, 0x48, 0xc0, 0x41, 0x61, 0xf8, 0xaf, 0x90, 0x51, 0xd6, 0x92, 0x2b, 0x51, 0x13, 0x8f,
+	0x6b, 0x1c, 0xc9, 0x16, 0xfe, 0xce, 0x4b, 0x71, 0x0c, 0x09, 0x78, 0x19, 0xae, 0xb0, 0xad, 0xa8,
+	0xd9, 0x50, 0x91, 0xc4, 0x6a, 0xfe, 0x5c, 0x61, 0x50, 0x10, 0xa5, 0xfe, 0x8f, 0x54, 0xc8, 0x08,
+	0xab, 0x28, 0x76, 0xa7, 0x3d, 0x32, 0xb8, 0xc5, 0xf9, 0x88, 0x21, 0xb7, 0xe0, 0x58, 0xac, 0xb7,
+	0x5e, 0xbb, 0xf2, 0x73, 0x00, 0x48, 0x7e, 0xc8, 0x7a, 0x37, 0x08, 0xd1, 0x83, 0xdc, 0xab, 0x1c,
+	0x2f, 0xeb, 0x5b, 0x9c, 0x0d, 0x48, 0x7e, 0xfe, 0x07, 0xc9, 0x89, 0x42, 0x5a, 0x68, 0xa5, 0xfb,
+	0x77, 0x7a, 0xe9, 0xfe, 0xfd, 0x1f, 0x26, 0x2c, 0xb1, 0xc7, 0x62, 0x33, 0xd8, 0xe4, 0x03, 0x1e,
+	0x6d, 0xd3, 0x86, 0xd8, 0xd9, 0xb5, 0x01, 0x47, 0x28, 0x88, 0x52, 0x9e, 0x2c, 0x21, 0x8d, 0x43,
+	0x15, 0xc9, 0xa3, 0x25, 0x4b, 0x60, 0x60, 0x19, 0xb7, 0xd5, 0xf0, 0x7f, 0xbe, 0x42, 0x08, 0xd2,
+	0x17, 0xf9, 0x38, 0xde, 0x2f, 0x9d, 0x6e, 0x4d, 0x8b, 0xbf, 0x72, 0xba, 0x65, 0x19, 0x47, 0x74,
+	0x67, 0x5b, 0x3d, 0xc0, 0xae, 0xb2, 0x7f, 0x80, 0x9d, 0xdb, 0x21, 0x83, 0x51, 0x37, 0x45, 0xd1,
+	0x59, 0xc8, 0x1e, 0x16, 0x7c, 0x8f, 0x56, 0x38, 0x41, 0xee, 0xe0, 0x20, 0x7e, 0x80, 0x64, 0xe3,
+	0x3e, 0x4f, 0x86, 0x3a, 0x71, 0xb4, 0x89, 0xa2, 0x84, 0x38, 0xce, 0xcf, 0xc9, 0x45, 0xb0, 0x2a,
+	0xe0, 0xf7, 0xb4, 0xff, 0x41, 0x61, 0xfb, 0x3f, 0xe9, 0xf2, 0x71, 0x11, 0x53, 0x76, 0x8a, 0x54,
+	0xc2, 0x46, 0x3e, 0x2f, 0xde, 0xf2, 0x02, 0x54, 0xc2, 0x86, 0x5a, 0xbc, 0x95, 0x9e, 0x8b, 0xf7,
+	0x83, 0x64, 0xa4, 0x11, 0x26, 0x9d, 0x66, 0xb0, 0x77, 0xa3, 0x44, 0xe9, 0xbc, 0x90, 0x15, 0x81,
+	0x8e, 0xe7, 0x3e, 0x23, 0xc2, 0x29, 0xfb, 0x0c, 0x45, 0xa3, 0x0c, 0xa7, 0xcc, 0xf2, 0xbd, 0x30,
+	0xac, 0x42, 0x5e, 0x9c, 0xfe, 0x03, 0xe7, 0xc5, 0xc9, 0x0b, 0x86, 0x03, 0x0f, 0x5f, 0x30, 0xfc,
+	0x10, 0x19, 0x93, 0x3f, 0x99, 0xb0, 0xe6, 0x9d, 0x32, 0x5d, 0x89, 0xd6, 0xf4, 0x42, 0x30, 0x71,
+	0xb3, 0x49, 0x3b, 0x78, 0xd0, 0x49, 0x7b, 0x89, 0x90, 0xf5, 0xa8, 0xdb, 0x6e, 0x04, 0xf1, 0xde,
+	0xf2, 0x82, 0x37, 0x64, 0xca, 0xa1, 0x73, 0xaa, 0x04, 0x34, 0x2c, 0x7d, 0xa2, 0x0f, 0xdf, 0x67,
+	0xa2, 0xbf, 0x42, 0x86, 0x59, 0xa0, 0x0a, 0x6d, 0xcc, 0xa6, 0x1e, 0x39, 0xb4, 0xf7, 0x7f, 0xe6,
+	0x3f, 0x2f, 0x89, 0x40, 0x46, 0xcf, 0xfd, 0x38, 0x21, 0x1b, 0x61, 0x3b, 0x4c, 0xb6, 0x18, 0xf5,
+	0x91, 0x43, 0x53, 0x57, 0xfd, 0x5c, 0x54, 0x54, 0x40, 0xa3, 0x88, 0xa1, 0x42, 0x34, 0x49, 0xc3,
+	0x56, 0x90, 0xd2, 0x86, 0xca, 0x63, 0xe0, 0x31, 0x4d, 0xb9, 0x0a, 0x15, 0xba, 0x9c, 0x47, 0xb8,
+	0x57, 0x06, 0x84, 0x22, 0x21, 0x63, 0x45, 0x4e, 0x1d, 0x66, 0x45, 0xba, 0x7f, 0xee, 0x90, 0x13,
+	0x31, 0xe5, 0xbe, 0x7a, 0x89, 0x6a, 0xd8, 0x69, 0xb6, 0x8b, 0xd7, 0x6d, 0xbc, 0xce, 0x24, 0x17,
+	0xfb, 0x0c, 0xe4, 0xb9, 0x70, 0xf1, 0x88, 0xca, 0xde, 0x17, 0xca, 0xef, 0x95, 0x01, 0x3f, 0xf7,
+	0xf6, 0xf4, 0x74, 0xf1, 0xc1, 0x31, 0x45, 0x1c, 0x57, 0xde, 0x17, 0xdf, 0x9e, 0x9e, 0x94, 0xbf,
+	0xb3, 0x41, 0x2b, 0x74, 0x12, 0x4f, 0xe3, 0x4e, 0xd4, 0x58, 0x5e, 0xf5, 0x46, 0xcd, 0xd3, 0x78,
+	0x15, 0x81, 0xc0, 0xcb, 0xd0, 0xc9, 0xa4, 0x11, 0xd0, 0x56, 0xd4, 0x56, 0x0f, 0x63, 0x8c, 0xf2,
+	0xc3, 0x9e, 0xc3, 0x40, 0x95, 0xe2, 0x4d, 0xa5, 0x2d, 0x8e, 0x14, 0xef, 0x31, 0x5b, 0x37, 0x15,
+	0x79, 0x48, 0x71, 0xae, 0xf2, 0x17, 0x28, 0x4e, 0x6e, 0x13, 0x23, 0x27, 0xd8, 0xe6, 0x3f, 0x6e,
+	0xeb, 0x29, 0x0d, 0xae, 0x87, 0x91, 0x71, 0x13, 0xf8, 0x3f, 0x08, 0x1e, 0xfa, 0x59, 0x33, 0xf1,
+	0x70, 0xce, 0x9a, 0xa7, 0xc9, 0x50, 0x7d, 0x2b, 0x6c, 0x36, 0x62, 0xda, 0xf6, 0x26, 0x99, 0x02,
+	0x81, 0x8d, 0xc4, 0xbc, 0x80, 0x81, 0x2a, 0x75, 0xff, 0x3a, 0x19, 0x8b, 0xba, 0x29, 0xdb, 0x5a,
+	0x70, 0x9c, 0x12, 0xef, 0x04, 0x43, 0x67, 0x0e, 0x97, 0x2b, 0x7a, 0x01, 0x98, 0x78, 0xb8, 0xc5,
+	0x6f, 0x45, 0x09, 0x4b, 0x87, 0xc7, 0xb6, 0xf8, 0x33, 0xe6, 0x16, 0x7f, 0x45, 0x2b, 0x03, 0x03,
+	0x93, 0x45, 0x19, 0xb4, 0xf2, 0xd7, 0x44, 0xef, 0xac, 0xad, 0x28, 0x83, 0xc2, 0x0d, 0x94, 0x47,
+	0x19, 0x14, 0xc0, 0x50, 0x6c, 0x04, 0x4b, 0x4c, 0x99, 0xec, 0xb5, 0xeb, 0x5b, 0x71, 0xd4, 0x36,
+	0x9b, 0xf7, 0xa8, 0xad, 0x38, 0x6a, 0xb6, 0xb6, 0xcb, 0x58, 0xf0, 0x44, 0xcf, 0xa5, 0x45, 0x50,
+	0xde, 0x28, 0xf7, 0x23, 0x64, 0x32, 0x0d, 0x92, 0x6d, 0x2e, 0x2f, 0x61, 0x4d, 0xda, 0xf0, 0xce,
+	0x71, 0x57, 0x17, 0xb4, 0x02, 0xae, 0xe5, 0xca, 0xa0, 0x80, 0x8d, 0x6e, 0x2c, 0x72, 0x89, 0xbf,
+	0x44, 0x63, 0xa6, 0x09, 0x79, 0x9c, 0x7d, 0x48, 0xe5, 0xc6, 0x02, 0x66, 0x31, 0xe4, 0xf1, 0xf5,
+	0x80, 0xcb, 0xf3, 0xfb, 0x07, 0x5c, 0x4e, 0x2d, 0x90, 0x33, 0xe5, 0xfb, 0xd9, 0xfd, 0xee, 0x61,
+	0x55, 0xfd, 0x1e, 0xb6, 0x48, 0x1e, 0xed, 0x39, 0x88, 0xd8, 0x1a, 0x29, 0x54, 0x3b, 0xe6, 0xc9,
+	0x58, 0x10, 0x82, 0xc7, 0xc9, 0xa8, 0xfe, 0x0c, 0x9e, 0xff, 0x7f, 0xab, 0x84, 0x64, 0x76, 0x1b,
+	0x74, 0xdb, 0xe2, 0x36, 0xa2, 0xe5, 0x85, 0x23, 0x67, 0xac, 0x99, 0x37, 0x08, 0x40, 0x8e, 0xa0,
+	0xdb, 0x22, 0x2e, 0x87, 0xf0, 0xdf, 0x47, 0xf1, 0x34, 0x60, 0x86, 0xf9, 0xf9, 0x02, 0x11, 0x28,
+	0x21, 0x8c, 0x3d, 0x4a, 0xa3, 0x6d, 0xda, 0xbe, 0x09, 0xd7, 0x8e, 0x92, 0x3d, 0x89, 0xdb, 0xa6,
+	0x0d, 0x02, 0x90, 0x23, 0xe8, 0xfa, 0x64, 0x80, 0x69, 0xb6, 0x64, 0x6c, 0x14, 0xdb, 0x0e, 0x99,
+	0x64, 0x84, 0x51, 0xdc, 0xec, 0xaf, 0xfb, 0xf3, 0x0e, 0x19, 0x97, 0x49, 0xa0, 0x98, 0x32, 0x59,
+	0x46, 0x45, 0xdd, 0xb4, 0x65, 0x77, 0xbb, 0xac, 0x53, 0xcf, 0x9c, 0xdb, 0x0d, 0x70, 0x02, 0xb9,
+	0x46, 0xf8, 0x2f, 0x93, 0x93, 0x25, 0xd5, 0xad, 0xdc, 0xf3, 0xd1, 0x39, 0x58, 0xcb, 0x4d, 0xcc,
+	0x22, 0x47, 0x6a, 0xd6, 0xbd, 0x6c, 0x57, 0x6a, 0x05, 0x2f, 0x5b, 0x05, 0x82, 0x8c, 0xe1, 0x41,
+	0x9c, 0x83, 0x4b, 0x13, 0x29, 0xbf, 0xc3, 0xcd, 0x3e, 0xb4, 0x73, 0xf0, 0x4f, 0xf5, 0x93, 0x8c,
+	0xd2, 0x21, 0x93, 0x93, 0x65, 0xae, 0xc4, 0x95, 0x7d, 0x5d, 0x89, 0x1b, 0x64, 0x22, 0x60, 0x9e,
+	0x15, 0x47, 0x4c, 0x49, 0xc6, 0xd3, 0xd9, 0x9b, 0x14, 0x20, 0x4f, 0x12, 0xb9, 0x24, 0x59, 0x55,
+	0xc6, 0xa5, 0xef, 0xd0, 0x5c, 0x6a, 0x26, 0x05, 0xc8, 0x93, 0x74, 0x3f, 0x46, 0xbc, 0x7a, 0x4c,
+	0x83, 0x94, 0xf2, 0x3e, 0x2e, 0x6f, 0xdc, 0x88, 0xd2, 0xd5, 0x98, 0x26, 0xb4, 0x9d, 0x8a, 0xe4,
+	0xa3, 0x17, 0xc4, 0x28, 0x78, 0xf3, 0x3d, 0xf0, 0xa0, 0x27, 0x05, 0xbc, 0x56, 0x31, 0x6b, 0x65,
+	0x98, 0xee, 0xb1, 0x4d, 0xc4, 0x1b, 0x30, 0xaf, 0x55, 0x35, 0xbd, 0x10, 0x4c, 0x5c, 0xf7, 0x27,
+	0x1c, 0x32, 0xd6, 0x94, 0xd6, 0x0e, 0xe8, 0x36, 0xf9, 0xfd, 0xca, 0x8a, 0xa9, 0x78, 0xa5, 0x56,
+	0xbb, 0xa6, 0x53, 0xe6, 0xb2, 0x8f, 0x01, 0x02, 0x93, 0x77, 0x3e, 0x3f, 0xdc, 0xd0, 0x01, 0xf3,
+	0xc3, 0xfd, 0xbe, 0x43, 0x26, 0xf3, 0xdc, 0xdc, 0x6d, 0xf2, 0x78, 0x2b, 0x88, 0xb7, 0x97, 0xdb,
+	0x1b, 0x31, 0x0b, 0x34, 0x4c, 0xf9, 0x64, 0x98, 0xdd, 0x48, 0x69, 0xbc, 0x10, 0xec, 0x71, 0x73,
+	0x7c, 0xbf, 0x7a, 0xf8, 0xf6, 0xf1, 0xeb, 0xfb, 0x21, 0xc3, 0xfe, 0xb4, 0xd0, 0x6b, 0x17, 0x11,
+	0x58, 0xfa, 0xd8, 0x30, 0x6a, 0x67, 0x4c, 0x2a, 0x8c, 0x89, 0xf2, 0xda, 0xbd, 0x5e, 0x86, 0x04,
+	0xe5, 0x75, 0xf1, 0xb1, 0x5e, 0x1e, 0x92, 0xfe, 0x40, 0xe6, 0x37, 0x7f, 0x93, 0xb8, 0x5c, 0x8e,
+	0x55, 0x06, 0x46, 0xbc, 0x8c, 0x5f, 0x20, 0x7d, 0x49, 0x4a, 0x3b, 0x79, 0xa5, 0x14, 0x46, 0x2d,
+	0x01, 0x2b, 0xc1, 0x6d, 0x41, 0x99, 0x21, 0xf3, 0xdb, 0x42, 0x46, 0x2a, 0xc3, 0xf1, 0xff, 0x7d,
+	0x85, 0x48, 0x89, 0xf9, 0xaf, 0xb6, 0xd9, 0x14, 0x4f, 0xeb, 0x98, 0x49, 0x83, 0x42, 0x0d, 0xc4,
+	0x4e, 0x6b, 0x91, 0x11, 0x5a, 0x94, 0xe0, 0x55, 0x82, 0xde, 0x0e, 0xd3, 0x79, 0x7c, 0x4c, 0x4b,
+	0xbc, 0xcf, 0xc9, 0xb6, 0x4c, 0x01, 0x03, 0x55, 0x8a, 0x56, 0x28, 0x16, 0x66, 0xd5, 0x6c, 0xd2,
+	0x26, 0x7e, 0x9f, 0x04, 0x93, 0xa7, 0xe0, 0x27, 0x4a, 0xec, 0xa9, 0x56, 0xb3, 0x7c, 0x09, 0xb4,
+	0xa3, 0xd9, 0xd4, 0x90, 0x09, 0x70, 0x5e, 0xfe, 0x9f, 0xf7, 0x91, 0xec, 0xbb, 0x1f, 0x40, 0x9b,
+	0x7d, 0x29, 0x4b, 0xd6, 0xce, 0x67, 0x8f, 0xa7, 0x25, 0x6a, 0x47, 0x8d, 0xcd, 0x6c, 0x7b, 0x8f,
+	0xa7, 0x9b, 0xca, 0xb2, 0xb6, 0xa3, 0xa3, 0x3a, 0xff, 0x57, 0x7b, 0xb8, 0x92, 0x6b, 0x62, 0x32,
+	0x47, 0xf5, 0x3c, 0x02, 0x14, 0xeb, 0xb8, 0xcf, 0x98, 0xfe, 0x14, 0x67, 0xf4, 0x15, 0xa3, 0x31,
+	0xe6, 0x48, 0xee, 0x6d, 0xdd, 0x57, 0xa6, 0xcf, 0xd6, 0xf9, 0xab, 0xec, 0xd6, 0xbd, 0x9d, 0x64,
+	0x72, 0x8f, 0x9c, 0xf6, 0x1f, 0xe8, 0x91, 0xd3, 0x77, 0x93, 0x3e, 0xda, 0xee, 0xb6, 0x98, 0x70,
+	0x37, 0xcc, 0x2e, 0x61, 0x7d, 0x97, 0xdb, 0xdd, 0x96, 0xd9, 0x33, 0x86, 0xe2, 0x7e, 0x98, 0x8c,
+	0x34, 0x68, 0xc2, 0x22, 0xa9, 0x70, 0x24, 0xb9, 0xee, 0xec, 0x1c, 0x53, 0x48, 0x66, 0x60, 0xb3,
+	0xa2, 0x5e, 0x81, 0x39, 0x92, 0x6d, 0xc4, 0x51, 0x8b, 0x2f, 0x6b, 0x6f, 0xc8, 0x56, 0xf4, 0x5a,
+	0x71, 0x43, 0xe2, 0x46, 0x94, 0x45, 0xc5, 0x0b, 0x34, 0xbe, 0xfe, 0x1e, 0x79, 0x6c, 0x9f, 0x97,
+	0x7c, 0x98, 0x2d, 0x13, 0x7f, 0x6a, 0x61, 0x6c, 0x99, 0x2d, 0x53, 0x16, 0x40, 0x86, 0xa3, 0x3f,
+	0xbf, 0x5a, 0xd9, 0xff, 0xf9, 0x55, 0xff, 0x35, 0x32, 0xb0, 0xda, 0xec, 0x6e, 0x86, 0x6d, 0xb7,
+	0x43, 0x06, 0x78, 0x72, 0x2a, 0xcf, 0xb1, 0xa5, 0xdb, 0xe0, 0xdb, 0xbb, 0xe6, 0xc9, 0xc6, 0x7e,
+	0x83, 0xe0, 0xe3, 0x7f, 0xb9, 0x4a, 0x50, 0xfd, 0xb3, 0x34, 0xef, 0xfe, 0x40, 0xe1, 0x9d, 0xa1,
+	0xef, 0x29, 0x79, 0x67, 0x68, 0x8c, 0x21, 0x97, 0xbc, 0xfe, 0xd8, 0x24, 0x63, 0xcc, 0xcc, 0x27,
+	0xe5, 0x16, 0x71, 0x15, 0x7a, 0xee, 0x80, 0xf9, 0x9c, 0xf4, 0xaa, 0xe2, 0x14, 0xd7, 0x41, 0x60,
+	0x12, 0xc7, 0x38, 0x2c, 0x9e, 0x3e, 0x7d, 0x81, 0x36, 0x83, 0xbd, 0x5c, 0x9a, 0x54, 0x15, 0x87,
+	0xb5, 0x50, 0x44, 0x81, 0xb2, 0x7a, 0xfc, 0x01, 0xdc, 0x34, 0x08, 0xdb, 0x2c, 0x1f, 0x19, 0x5b,
+	0x9e, 0xfd, 0xfa, 0x03, 0xb8, 0xaa, 0x08, 0x74, 0x3c, 0x3c, 0x92, 0xb7, 0x29, 0xed, 0xf0, 0x84,
+	0x23, 0xab, 0x51, 0xa6, 0xe6, 0xec, 0x37, 0x12, 0x17, 0x9e, 0xbe, 0x5a, 0x86, 0x04, 0xe5, 0x75,
+	0xfd, 0x0f, 0x92, 0xd1, 0xd5, 0x98, 0x76, 0xf8, 0x83, 0xd4, 0x51, 0x8c, 0x59, 0xe4, 0xeb, 0x51,
+	0xab, 0x15, 0xb4, 0x1b, 0xc2, 0x9f, 0x84, 0xa9, 0x8d, 0xe6, 0x39, 0x08, 0x64, 0x99, 0xff, 0x4b,
+	0xfd, 0x44, 0xb3, 0x0f, 0x1e, 0x60, 0xef, 0x7c, 0x35, 0x67, 0x0d, 0xbe, 0x6e, 0xc5, 0x1a, 0x2c,
+	0x4d, 0xac, 0xfc, 0x3c, 0x32, 0x0d, 0xc0, 0xd8, 0xa8, 0x2d, 0xda, 0xec, 0x78, 0x55, 0xb3, 0x51,
+	0x57, 0x68, 0xb3, 0x03, 0xac, 0x44, 0xe5, 0x69, 0xe8, 0xeb, 0x99, 0xa7, 0x61, 0x8b, 0xf4, 0x6f,
+	0x62, 0xcc, 0x9a, 0xd7, 0x6f, 0xcb, 0xf0, 0xcf, 0x42, 0xe0, 0xb8, 0xe1, 0x9f, 0xfd, 0x0b, 0x9c,
+	0x01, 0xee, 0xd8, 0x5b, 0xd2, 0x79, 0xce, 0x1b, 0xb0, 0xb5, 0x63, 0x2b, 0x7f, 0x3c, 0xbe, 0x63,
+	0xab, 0x9f, 0x90, 0x31, 0x43, 0xa5, 0x63, 0x9d, 0x27, 0xc6, 0xf3, 0x06, 0x6d, 0x29, 0x1d, 0x45,
+	0xa6, 0x3d, 0x39, 0x7b, 0xd8, 0x0f, 0x90, 0x6c, 0xdc, 0x06, 0x19, 0xed, 0x74, 0x93, 0xad, 0x65,
+	0xfc, 0xb1, 0x23, 0x9e, 0x97, 0x3e, 0x70, 0x32, 0x36, 0x39, 0x75, 0xb9, 0xf7, 0xe4, 0xaa, 0x46,
+	0x07, 0x0c, 0xaa, 0xfe, 0x45, 0x32, 0xa2, 0x3d, 0xd9, 0x87, 0x1f, 0x5b, 0x65, 0x7e, 0xd3, 0x3e,
+	0x36, 0x9a, 0x95, 0x81, 0x95, 0xf8, 0x7f, 0x38, 0x40, 0x94, 0x62, 0x5b, 0x4f, 0x50, 0x10, 0xd4,
+	0xb5, 0x3c, 0x95, 0x46, 0x0e, 0xa5, 0xa8, 0x0d, 0xa2, 0x14, 0x2f, 0x2d, 0x2d, 0x1a, 0x6f, 0x2a,
+	0x25, 0x51, 0x3e, 0xac, 0xfc, 0xba, 0x5e, 0x08, 0x26, 0x2e, 0xde, 0x38, 0x5b, 0xc2, 0x2b, 0x27,
+	0x1f, 0x43, 0x23, 0xbd, 0x75, 0x40, 0x61, 0xb0, 0x44, 0x57, 0x2d, 0xcd, 0x89, 0x47, 0x8c, 0x9f,
+	0x0d, 0xa3, 0xb0, 0x46, 0x95, 0x8f, 0xaf, 0x0e, 0x01, 0x83, 0x2b, 0x8a, 0x36, 0x09, 0x4d, 0x57,
+	0x76, 0xdb, 0x34, 0x56, 0x29, 0xa6, 0x44, 0x26, 0x35, 0x25, 0xda, 0xd4, 0xf2, 0x08, 0x50, 0xac,
+	0x53, 0x1a, 0xa6, 0xd0, 0x7f, 0xe8, 0x30, 0x85, 0x05, 0x32, 0xb9, 0xc1, 0x13, 0x5e, 0xf4, 0x0c,
+	0x76, 0x58, 0xcc, 0x95, 0x43, 0xa1, 0x06, 0x0b, 0x03, 0x6d, 0x06, 0x9b, 0x89, 0x37, 0xa8, 0x85,
+	0x81, 0x22, 0x00, 0x38, 0x1c, 0x33, 0xfb, 0xac, 0xf3, 0xcc, 0xdd, 0x3c, 0x93, 0xda, 0x30, 0xdb,
+	0xbd, 0xd9, 0x58, 0xcd, 0x69, 0x70, 0x30, 0xb0, 0x70, 0x8d, 0x89, 0xdf, 0x1e, 0xb1, 0xb5, 0xc6,
+	0x04, 0x3b, 0xbe, 0xc6, 0xc4, 0x0f, 0x90, 0x6c, 0xdc, 0x9f, 0x76, 0xc8, 0x04, 0xea, 0x2f, 0x17,
+	0xa3, 0x58, 0xce, 0x69, 0x6f, 0xc4, 0xd6, 0x8b, 0x08, 0xb7, 0x4c, 0xc2, 0x5c, 0x6b, 0x90, 0x03,
+	0x42, 0x9e, 0xbd, 0xff, 0x6b, 0x0e, 0xe1, 0xd9, 0x3f, 0x67, 0x37, 0xd0, 0x72, 0x97, 0xee, 0xb9,
+	0x5f, 0x73, 0xc8, 0x24, 0x9a, 0x5a, 0x66, 0xdb, 0x69, 0x28, 0x81, 0xf6, 0x5e, 0x76, 0x62, 0xbc,
+	0x6e, 0xe4, 0xc8, 0x73, 0x85, 0x77, 0x1e, 0x0a, 0x85, 0x66, 0xf8, 0x67, 0xc9, 0xe9, 0x52, 0x02,
+	0xfe, 0x35, 0xc2, 0x1c, 0x12, 0xf6, 0x56, 0xda, 0xa8, 0x14, 0x67, 0x79, 0x2f, 0x50, 0x81, 0xca,
+	0x92, 0x92, 0xca, 0x97, 0xe4, 0x94, 0x52, 0x7c, 0xcd, 0x2c, 0x86, 0x3c, 0xbe, 0xff, 0xdb, 0x7d,
+	0xc4, 0x4c, 0x89, 0xea, 0xbe, 0x48, 0xfa, 0x9b, 0x6c, 0x6a, 0x39, 0x47, 0xcc, 0x75, 0xcb, 0x26,
+	0x2d, 0x9f, 0x85, 0x9c, 0x92, 0xbb, 0xc0, 0x24, 0x8e, 0x58, 0xa6, 0x50, 0xac, 0x18, 0xb9, 0xc9,
+	0x46, 0x20, 0x2b, 0xba, 0x67, 0xfe, 0x04, 0xbd, 0x1a, 0x66, 0x7d, 0x90, 0x93, 0xb8, 0x6a, 0x7b,
+	0x12, 0x9f, 0xd1, 0x26, 0xf1, 0xbd, 0xb2, 0xf9, 0xbc, 0x47, 0x86, 0x02, 0x39, 0x43, 0xac, 0xc5,
+	0xa2, 0x18, 0xb3, 0x51, 0x78, 0x2b, 0x8a, 0x5f, 0xa0, 0xd8, 0xe5, 0xfc, 0x3f, 0xfb, 0x0f, 0xe2,
+	0xff, 0x89, 0x0b, 0x3e, 0xe6, 0x93, 0xc4, 0x1b, 0xb0, 0x35, 0x56, 0x62, 0xd6, 0x65, 0xb9, 0x8c,
+	0xf7, 0x56, 0xda, 0x20, 0xd9, 0xa0, 0xfb, 0x3d, 0xc9, 0xde, 0x14, 0xc4, 0x37, 0x6a, 0x92, 0xe7,
+	0x0c, 0x05, 0xac, 0x8d, 0x0c, 0x58, 0x82, 0xa2, 0x96, 0x2c, 0x44, 0x40, 0x40, 0x71, 0xbb, 0x9f,
+	0xd2, 0xf8, 0x3b, 0x0e, 0x39, 0x55, 0xf6, 0xf6, 0xe1, 0x3b, 0xd8, 0xe2, 0xc3, 0xea, 0x8b, 0xcd,
+	0x97, 0x61, 0xab, 0xf7, 0x7f, 0x19, 0xd6, 0xff, 0xd3, 0x41, 0xa2, 0x18, 0x1f, 0x93, 0x7e, 0xf9,
+	0x29, 0x54, 0xd1, 0x6c, 0x66, 0xf7, 0x12, 0x85, 0x07, 0x0c, 0x0a, 0xa2, 0x14, 0xd5, 0x34, 0x32,
+	0x1a, 0x44, 0x9c, 0xd6, 0x6c, 0xde, 0xcb, 0xa8, 0x11, 0x50, 0xa5, 0x65, 0x1a, 0xeb, 0xfe, 0x87,
+	0xa2, 0xb1, 0x1e, 0xb0, 0xaf, 0xb1, 0x6e, 0x61, 0x86, 0x18, 0xb6, 0x34, 0x99, 0x9a, 0x58, 0x30,
+	0x1a, 0x3d, 0xb4, 0x01, 0xad, 0x56, 0x20, 0x02, 0x25, 0x84, 0x99, 0x2f, 0x5b, 0xd4, 0xa4, 0xb3,
+	0x70, 0xc3, 0x1b, 0x34, 0xef, 0xe3, 0xc0, 0xc1, 0x20, 0xcb, 0x8f, 0xa8, 0x22, 0x76, 0xff, 0x89,
+	0xb3, 0x8f, 0x0e, 0x7e, 0xd8, 0xd6, 0x11, 0x5a, 0x9a, 0x01, 0x7b, 0xee, 0xdc, 0x11, 0x15, 0xfb,
+	0x5f, 0x77, 0xc8, 0x09, 0xda, 0xae, 0xc7, 0x7b, 0x8c, 0x8e, 0xa0, 0x26, 0x04, 0xa2, 0x9b, 0x36,
+	0xd6, 0xfa, 0xe5, 0x3c, 0x71, 0x6e, 0xd1, 0x2f, 0x80, 0xa1, 0xd8, 0x0c, 0x77, 0x85, 0x0c, 0xd5,
+	0x03, 0x31, 0x2f, 0x46, 0x0e, 0x33, 0x2f, 0xb8, 0xc3, 0xc4, 0xac, 0x98, 0x0d, 0x8a, 0x08, 0xbe,
+	0x43, 0x78, 0xb2, 0xa4, 0x49, 0x2c, 0x2a, 0xbb, 0x85, 0x0b, 0x60, 0xb9, 0x91, 0x5f, 0xfe, 0x57,
+	0x05, 0x1c, 0x14, 0x86, 0xbb, 0x4a, 0x4e, 0x6d, 0xb7, 0x92, 0x8c, 0x0a, 0xa6, 0xf4, 0xa3, 0xb7,
+	0xe5, 0x66, 0x20, 0xdd, 0x90, 0x4e, 0x5d, 0x2d, 0xc1, 0x81, 0xd2, 0x9a, 0x28, 0x28, 0xd3, 0x76,
+	0xb0, 0xde, 0xa4, 0x59, 0x91, 0xf0, 0xb5, 0x55, 0x82, 0xf2, 0xe5, 0x5c, 0x39, 0x14, 0x6a, 0x60,
+	0x46, 0xaf, 0xc7, 0x12, 0x1a, 0xef, 0xd0, 0xb8, 0x16, 0x36, 0xe8, 0x7c, 0x37, 0x49, 0xa3, 0x16,
+	0x8d, 0x8f, 0x68, 0x75, 0x9a, 0xbe, 0x7b, 0x67, 0xfa, 0xb1, 0x5a, 0x6f, 0x6a, 0xb0, 0x1f, 0x2b,
+	0xff, 0x5f, 0x54, 0xc9, 0x38, 0x4f, 0xae, 0xa4, 0x6e, 0x6d, 0xb6, 0xdf, 0x40, 0x78, 0x4a, 0xe5,
+	0x76, 0xcb, 0x6d, 0xc2, 0xb9, 0x6c, 0x6c, 0xa9, 0x88, 0xca, 0xca, 0x22, 0x55, 0x5e, 0xb0, 0xf4,
+	0x78, 0x39, 0x6a, 0x14, 0x47, 0x55, 0x74, 0x17, 0xba, 0x22, 0x2a, 0x4e, 0xcc, 0xe6, 0xd5, 0xd1,
+	0xb4, 0x38, 0x32, 0x9a, 0xee, 0x86, 0x0d, 0xa7, 0xf0, 0x8c, 0xac, 0x96, 0x23, 0x4d, 0x67, 0x06,
+	0x26, 0x6f, 0xdc, 0xd0, 0x42, 0xbc, 0x83, 0x77, 0x62, 0x9a, 0xbd, 0xfd, 0xa3, 0x36, 0xb4, 0xe5,
+	0xac, 0x08, 0x74, 0x3c, 0xff, 0x53, 0x64, 0xb2, 0x46, 0x5b, 0x41, 0x67, 0x8b, 0xa5, 0x79, 0xe1,
+	0x8e, 0xcf, 0x98, 0xa6, 0x57, 0xc2, 0xf2, 0x7a, 0x50, 0x85, 0x0c, 0x19, 0x0e, 0xaa, 0xaf, 0xb8,
+	0xfb, 0xb6, 0xcc, 0x5b, 0x31, 0x22, 0x1d, 0xaa, 0x79, 0x0c, 0x35, 0xff, 0xc7, 0xff, 0x93, 0x0a,
+	0x19, 0xcd, 0xea, 0xd3, 0x0d, 0x77, 0x93, 0x4c, 0xd4, 0xb5, 0x6c, 0x06, 0x59, 0x24, 0xe7, 0xc1,
+	0x13, 0x1f, 0xf0, 0x57, 0x6d, 0x4c, 0x22, 0x90, 0xa7, 0x7a, 0x78, 0x8f, 0xf8, 0xd7, 0x73, 0x1e,
+	0xf1, 0x56, 0xee, 0x6f, 0xe8, 0x11, 0xa3, 0xfc, 0xe9, 0xe5, 0xc4, 0x2a, 0x3a, 0xd8, 0xbb, 0xb3,
+	0x2c, 0x0b, 0x62, 0xdc, 0xce, 0x3c, 0x91, 0xa5, 0x75, 0x71, 0x68, 0x51, 0xc0, 0xef, 0xb1, 0x5b,
+	0xbe, 0x18, 0x4a, 0x09, 0x04, 0x55, 0xcd, 0xff, 0x72, 0x85, 0x4c, 0xa8, 0x72, 0xe1, 0x7a, 0xf3,
+	0x66, 0xde, 0x95, 0xde, 0x82, 0x71, 0x36, 0x3f, 0x77, 0xf6, 0x71, 0xa7, 0x7f, 0x33, 0xef, 0x4e,
+	0x7f, 0xac, 0xec, 0x0b, 0xde, 0x44, 0xff, 0xa1, 0x42, 0x86, 0x54, 0xaa, 0xd8, 0x17, 0x49, 0x3f,
+	0xd3, 0x8a, 0x3d, 0xd8, 0x65, 0x8f, 0x6b, 0x8b, 0x39, 0x25, 0x24, 0xc9, 0xfc, 0x6e, 0xbd, 0xca,
+	0x83, 0x90, 0x64, 0x5e, 0xbc, 0xc0, 0x29, 0xb9, 0x57, 0x49, 0x15, 0xbd, 0xb6, 0xaa, 0x47, 0x24,
+	0xc8, 0x9e, 0xb8, 0xbe, 0xdc, 0x6e, 0x00, 0x52, 0x61, 0xa9, 0xb4, 0xb9, 0xa8, 0x9d, 0x8b, 0x55,
+	0x13, 0x72, 0xb6, 0x28, 0x65, 0x96, 0xa4, 0x48, 0xc5, 0xcb, 0xe6, 0x2d, 0x49, 0xaa, 0x04, 0x34,
+	0x2c, 0x7f, 0x8e, 0x18, 0xa9, 0xd9, 0x8f, 0x14, 0x5f, 0xf9, 0x13, 0x55, 0x32, 0x80, 0x19, 0xa2,
+	0xc2, 0xd4, 0xfd, 0x86, 0x43, 0x4e, 0xe6, 0x33, 0x2e, 0x66, 0x7b, 0xc3, 0x4d, 0x7b, 0x56, 0x4a,
+	0x8d, 0x78, 0x66, 0x50, 0x28, 0x29, 0x84, 0xb2, 0xe6, 0x18, 0x6f, 0x88, 0x54, 0x8f, 0xe5, 0x0d,
+	0x91, 0xdb, 0xc7, 0x1c, 0x03, 0x3a, 0xd6, 0x2b, 0xfe, 0xd3, 0x7f, 0x6b, 0x80, 0x10, 0xfe, 0x35,
+	0x56, 0x3a, 0xe9, 0x41, 0x2c, 0x0d, 0xcf, 0x93, 0xd1, 0x4d, 0xda, 0xa6, 0xb1, 0x8c, 0x28, 0xc8,
+	0xbd, 0xd1, 0xbb, 0xa4, 0x95, 0x81, 0x81, 0xc9, 0x26, 0x8b, 0xca, 0xd0, 0x59, 0x88, 0xf3, 0x54,
+	0x25, 0xa0, 0x61, 0xb9, 0x33, 0x86, 0x5b, 0x00, 0x77, 0x65, 0x1b, 0xdf, 0xc7, 0x8a, 0xff, 0x61,
+	0x32, 0x6e, 0x66, 0xfb, 0x13, 0xf7, 0x03, 0xe5, 0x7a, 0x66, 0x26, 0x09, 0x84, 0x1c, 0x36, 0x2e,
+	0x9e, 0x46, 0xbc, 0x07, 0xdd, 0xb6, 0xb8, 0x28, 0xa8, 0xc5, 0xb3, 0xc0, 0xa0, 0x20, 0x4a, 0x71,
+	0x14, 0xb8, 0xc8, 0xc4, 0xe1, 0x22, 0x37, 0x5a, 0x96, 0xd7, 0x4c, 0x2b, 0x03, 0x03, 0x13, 0x39,
+	0x08, 0x4b, 0x0d, 0x31, 0x97, 0x67, 0xce, 0xbc, 0xd2, 0x21, 0xe3, 0x91, 0xa9, 0xfb, 0xe5, 0x52,
+	0xf3, 0x07, 0x0e, 0x38, 0xf5, 0x8c, 0xba, 0xdc, 0x65, 0xd0, 0x84, 0x41, 0x8e, 0x3e, 0x0a, 0x16,
+	0x7a, 0x94, 0xe3, 0xa8, 0x29, 0x58, 0xf4, 0x0c, 0x44, 0x5c, 0x25, 0xa7, 0x3a, 0x51, 0x63, 0x35,
+	0x0e, 0x23, 0xf4, 0x12, 0x9a, 0x6f, 0x06, 0x49, 0xc2, 0x26, 0xc6, 0x98, 0x29, 0x41, 0xaf, 0x96,
+	0xe0, 0x40, 0x69, 0x4d, 0xbc, 0x42, 0x77, 0x04, 0x90, 0xb9, 0x85, 0xf7, 0xf3, 0x03, 0x54, 0x22,
+	0x82, 0x2a, 0xc5, 0x04, 0x00, 0xd9, 0xc7, 0x47, 0xad, 0x79, 0x96, 0x58, 0x69, 0xc2, 0x4c, 0x00,
+	0xb0, 0x5a, 0x8e, 0x06, 0xbd, 0xea, 0xfb, 0x27, 0xc9, 0x89, 0x5a, 0xb7, 0xd3, 0x69, 0x86, 0xb4,
+	0xa1, 0x0c, 0xf1, 0xfe, 0x0f, 0x92, 0x09, 0xe1, 0x4b, 0xab, 0x27, 0x0a, 0x38, 0xf8, 0x53, 0x5b,
+	0xfe, 0xfb, 0xc9, 0x44, 0x4e, 0x38, 0xb8, 0x8f, 0x5b, 0xa3, 0xff, 0x27, 0x55, 0x32, 0x91, 0xf3,
+	0xb0, 0x45, 0x5f, 0x15, 0x53, 0x6e, 0xb3, 0xf3, 0x0c, 0x87, 0x26, 0xb1, 0x89, 0x37, 0x35, 0xca,
+	0x64, 0xc0, 0x2d, 0x19, 0x05, 0x68, 0x2d, 0x58, 0x97, 0xc5, 0xca, 0xf1, 0x63, 0xd1, 0x08, 0x25,
+	0xfc, 0x34, 0x21, 0x8a, 0xad, 0xcc, 0x0b, 0x65, 0xbb, 0x9f, 0x6c, 0x33, 0x51, 0x90, 0x04, 0x34,
+	0x8e, 0x6e, 0x9b, 0x0c, 0xb2, 0x86, 0x50, 0x29, 0xf0, 0x5b, 0xeb, 0x2b, 0x13, 0x9b, 0xaf, 0x73,
+	0xda, 0x20, 0x99, 0xf8, 0x3f, 0x56, 0x21, 0xe5, 0x6e, 0xe7, 0xee, 0xa7, 0x8b, 0x1f, 0xfc, 0x45,
+	0x8b, 0x03, 0xc1, 0xb9, 0xec, 0xf3, 0xcd, 0xdb, 0xe6, 0x37, 0xbf, 0x6e, 0x69, 0x1c, 0x04, 0xdf,
+	0xc2, 0x97, 0xf7, 0xff, 0x97, 0x43, 0x46, 0xd6, 0xd6, 0xae, 0x29, 0x39, 0x03, 0xc8, 0x99, 0x84,
+	0x27, 0xdd, 0x62, 0xde, 0x6e, 0xf3, 0x51, 0xab, 0xc3, 0x9d, 0xdf, 0x3c, 0x27, 0x7b, 0x69, 0xa7,
+	0x56, 0x8a, 0x01, 0x3d, 0x6a, 0xba, 0xcb, 0xe4, 0xa4, 0x5e, 0x22, 0x93, 0xd7, 0x73, 0x07, 0x3c,
+	0x9e, 0x83, 0xb3, 0x58, 0x0c, 0x65, 0x75, 0xf2, 0xa4, 0x64, 0x26, 0xfa, 0x6a, 0x39, 0x29, 0x51,
+	0x0c, 0x65, 0x75, 0xfc, 0x15, 0x32, 0xb2, 0x16, 0xc4, 0xaa, 0xe3, 0x1f, 0x21, 0x93, 0xf5, 0xa8,
+	0x25, 0x65, 0xa7, 0x6b, 0x74, 0x87, 0x36, 0x45, 0x97, 0xf9, 0x6b, 0xa2, 0xb9, 0x32, 0x28, 0x60,
+	0xfb, 0xff, 0xfa, 0x49, 0xa2, 0xb2, 0x4e, 0x1c, 0xe0, 0x78, 0xef, 0xa8, 0x80, 0x9c, 0x7e, 0xcb,
+	0x01, 0x39, 0xea, 0xa0, 0xcb, 0x05, 0xe5, 0xa4, 0x59, 0x50, 0xce, 0x80, 0xed, 0xa0, 0x1c, 0x75,
+	0x4b, 0x28, 0x04, 0xe6, 0xbc, 0xe5, 0x90, 0x51, 0xb4, 0x49, 0x29, 0x0f, 0x97, 0x41, 0xb6, 0xc2,
+	0x3f, 0x66, 0x2f, 0xbe, 0x71, 0xe6, 0x86, 0x46, 0x9e, 0x07, 0x8b, 0x29, 0xf9, 0x40, 0x2f, 0x02,
+	0xa3, 0x1d, 0xee, 0xa2, 0x66, 0x88, 0xe1, 0x86, 0xe7, 0x73, 0x65, 0x77, 0xe4, 0xfb, 0x5a, 0x55,
+	0x6e, 0x6b, 0x42, 0xeb, 0xb0, 0x2d, 0x55, 0x89, 0xcc, 0x10, 0xa0, 0xd9, 0xcf, 0x05, 0x44, 0x13,
+	0x66, 0x7d, 0x32, 0xc0, 0xa3, 0xca, 0x44, 0xb6, 0x57, 0xe6, 0x3c, 0xc2, 0x23, 0xce, 0x40, 0x94,
+	0xb8, 0xa9, 0x74, 0x48, 0x1c, 0xb1, 0xf5, 0xf4, 0xa3, 0xe1, 0xf0, 0x58, 0xee, 0x91, 0xe8, 0xbe,
+	0xa0, 0xab, 0xad, 0x46, 0x0f, 0xa2, 0xb6, 0x1a, 0xeb, 0xa9, 0xb2, 0xfa, 0x49, 0x87, 0x8c, 0xd6,
+	0xb5, 0xa7, 0x18, 0xbd, 0xa7, 0x2f, 0x38, 0x76, 0xd2, 0x30, 0x94, 0xbd, 0x98, 0xc9, 0x2d, 0xe0,
+	0x7a, 0x09, 0x18, 0xdc, 0xd9, 0xeb, 0x08, 0x4c, 0x47, 0xe7, 0x8d, 0xd9, 0x4a, 0xde, 0x66, 0xea,
+	0xfc, 0x64, 0x04, 0x09, 0xc2, 0x40, 0xf0, 0x72, 0x7f, 0x80, 0x4c, 0x44, 0x3b, 0x34, 0x8e, 0x51,
+	0x6f, 0x28, 0xdc, 0x9a, 0x9e, 0x65, 0x32, 0x3a, 0xd3, 0xd6, 0xac, 0x98, 0x45, 0x90, 0xc7, 0x45,
+	0xd1, 0x31, 0x68, 0x36, 0xa3, 0xdd, 0x1c, 0xa2, 0x77, 0x89, 0xcd, 0x1b, 0x25, 0x3a, 0xce, 0x96,
+	0xe0, 0x40, 0x69, 0x4d, 0xf7, 0x0d, 0xcc, 0x5a, 0x2d, 0x54, 0x89, 0xe3, 0xb6, 0x1c, 0xd3, 0xf3,
+	0x4e, 0x2b, 0x32, 0x51, 0x37, 0x87, 0x82, 0xe2, 0xe8, 0x6e, 0x91, 0x6a, 0x23, 0xd8, 0xf4, 0x26,
+	0x6c, 0x1d, 0x92, 0xda, 0x4b, 0x1e, 0xfc, 0x92, 0xbf, 0x30, 0xbb, 0x04, 0xc8, 0xc2, 0xbd, 0x9d,
+	0xc5, 0x7a, 0x4d, 0x5a, 0x13, 0x07, 0x4c, 0xc9, 0x96, 0x0b, 0x29, 0x85, 0xb7, 0xfa, 0x1a, 0xc2,
+	0xcf, 0xe7, 0x7b, 0x2f, 0x38, 0x76, 0xde, 0x4f, 0x42, 0x59, 0x98, 0xe7, 0x46, 0xcc, 0x7c, 0x85,
+	0x90, 0xcb, 0x56, 0x9a, 0x76, 0xbc, 0xf7, 0xd8, 0xe2, 0xc2, 0x72, 0xec, 0x31, 0x2e, 0xf8, 0x1f,
+	0x30, 0xea, 0x18, 0x7d, 0xda, 0x61, 0xbe, 0x9a, 0xde, 0x7b, 0x6d, 0x1d, 0x76, 0xdc, 0xf7, 0x93,
+	0x2f, 0x16, 0xfe, 0x3f, 0x08, 0x1e, 0xee, 0x65, 0x32, 0xc8, 0xdf, 0x88, 0xe5, 0xb1, 0x9d, 0x23,
+	0x97, 0xa6, 0x7a, 0xbf, 0x34, 0x9b, 0x9d, 0x5c, 0xfc, 0x77, 0x02, 0xb2, 0xae, 0xfb, 0x65, 0x07,
+	0x1f, 0x47, 0x40, 0x57, 0x6f, 0xf5, 0x7e, 0xae, 0x6b, 0x6b, 0x13, 0xc5, 0xd4, 0xb6, 0xd9, 0xe6,
+	0xa7, 0x2e, 0xcd, 0xcb, 0x06, 0x3b, 0xc8, 0xb1, 0x77, 0xdf, 0x24, 0x43, 0x49, 0xd8, 0xa0, 0xf5,
+	0x20, 0x4e, 0xbc, 0x93, 0xc7, 0xd3, 0x94, 0xcc, 0xbc, 0x2c, 0x18, 0x81, 0x62, 0xe9, 0xfe, 0x8c,
+	0x43, 0x26, 0x82, 0xb8, 0xbe, 0x15, 0xee, 0xd0, 0x6b, 0x51, 0x9d, 0xdf, 0xc4, 0x4e, 0xd9, 0x5a,
+	0xfb, 0xd2, 0x90, 0x2e, 0x29, 0x0b, 0xab, 0xab, 0xc9, 0x0e, 0xf2, 0xfc, 0xdd, 0xbf, 0xe9, 0x90,
+	0xd3, 0xfc, 0xf5, 0xbf, 0xfc, 0x83, 0x96, 0xa7, 0x8f, 0xa8, 0xe4, 0x63, 0x41, 0xa9, 0xb3, 0x65,
+	0x24, 0xa1, 0x9c, 0x13, 0x7b, 0x14, 0xc6, 0x7c, 0x83, 0xf8, 0x8c, 0x55, 0xc7, 0x8e, 0x83, 0xbf,
+	0x3b, 0xec, 0x3e, 0x4b, 0x46, 0x3a, 0xe2, 0x7c, 0x0e, 0x93, 0x16, 0x0b, 0x31, 0xae, 0xf2, 0xe4,
+	0x0f, 0xab, 0x19, 0x18, 0x74, 0x1c, 0xe3, 0x85, 0xa0, 0x77, 0xef, 0xf7, 0x42, 0x90, 0x7b, 0x93,
+	0x8c, 0xa4, 0x51, 0x53, 0xbc, 0x74, 0x90, 0x78, 0x1e, 0x9b, 0x81, 0xe7, 0xcb, 0xd6, 0xd6, 0x9a,
+	0x42, 0xcb, 0xf4, 0x1a, 0x19, 0x2c, 0x01, 0x9d, 0x8e, 0xfb, 0x53, 0x0e, 0x79, 0x34, 0x8d, 0x3a,
+	0x51, 0x33, 0xda, 0xdc, 0xab, 0x75, 0x62, 0x1a, 0x34, 0xe6, 0xa3, 0x76, 0x92, 0xc6, 0x01, 0x7e,
+	0x1c, 0xef, 0x39, 0xc6, 0xe5, 0x99, 0x72, 0x2e, 0xe5, 0x95, 0x94, 0x0b, 0xf6, 0xa3, 0xbd, 0x30,
+	0x12, 0xe8, 0xcd, 0x91, 0x85, 0x6d, 0x89, 0x57, 0x1e, 0xf9, 0xc3, 0x3a, 0x8f, 0xe6, 0xc2, 0xb6,
+	0xf4, 0x42, 0x30, 0x71, 0xd1, 0x99, 0xb0, 0x53, 0xd0, 0xd0, 0x4c, 0x99, 0x71, 0x12, 0x45, 0xf5,
+	0x4c, 0xb1, 0x0e, 0x5e, 0x48, 0xe2, 0x6e, 0x3b, 0x0d, 0x5b, 0x54, 0xc1, 0xbc, 0x8b, 0x5c, 0x05,
+	0x88, 0x17, 0x12, 0xc8, 0x95, 0x41, 0x01, 0xbb, 0xc7, 0x63, 0x32, 0xe7, 0x8e, 0xf4, 0x98, 0x4c,
+	0x83, 0x9c, 0x0b, 0xba, 0x69, 0xc4, 0x52, 0x52, 0x9a, 0x55, 0x78, 0x64, 0xdb, 0x05, 0x1e, 0x2c,
+	0x77, 0xf7, 0xce, 0xf4, 0xb9, 0xd9, 0x7d, 0xf0, 0x60, 0x5f, 0x2a, 0x98, 0x7f, 0x99, 0x8a, 0x07,
+	0x71, 0xbc, 0xef, 0xb1, 0x25, 0x5d, 0x99, 0x4f, 0xec, 0xc8, 0x58, 0x1e, 0x0e, 0x03, 0xc5, 0xcf,
+	0x5d, 0x23, 0x23, 0x5b, 0x51, 0x92, 0xce, 0x36, 0xc3, 0x20, 0xa1, 0x89, 0xf7, 0xf8, 0x85, 0x6a,
+	0x2f, 0xa1, 0xf5, 0x8a, 0x44, 0xcb, 0xe6, 0xf6, 0x95, 0xac, 0x26, 0xe8, 0x64, 0xdc, 0x06, 0x19,
+	0x97, 0x42, 0x0b, 0x8b, 0x77, 0x48, 0xbc, 0xf7, 0x33, 0xc2, 0xef, 0x2a, 0x23, 0xbc, 0x1a, 0x35,
+	0x40, 0x47, 0xce, 0xce, 0x05, 0x03, 0x9c, 0x40, 0x8e, 0xa6, 0x7b, 0x95, 0x0c, 0x37, 0xda, 0x89,
+	0x70, 0x8a, 0x7b, 0x1f, 0xfb, 0xc0, 0xef, 0x43, 0x79, 0x7a, 0xe1, 0x46, 0x4d, 0xb9, 0xc3, 0x9d,
+	0x2b, 0xc9, 0x86, 0xa1, 0xca, 0x21, 0xab, 0xef, 0x5e, 0x67, 0xc4, 0xf8, 0x68, 0x79, 0x33, 0xec,
+	0x2b, 0x5c, 0xe8, 0xd1, 0xda, 0x85, 0x1b, 0x46, 0xfa, 0x63, 0xf5, 0x13, 0x32, 0x0a, 0x2e, 0x25,
+	0x13, 0x32, 0xb0, 0x51, 0x9a, 0xfc, 0xcf, 0x33, 0xa2, 0x4f, 0xf5, 0x20, 0x5a, 0x33, 0xb1, 0x95,
+	0x5f, 0x8c, 0x0e, 0x84, 0x3c, 0x4d, 0xd4, 0x13, 0x77, 0xa2, 0x06, 0xbe, 0xcd, 0xbc, 0x1a, 0xe0,
+	0xeb, 0x27, 0xd3, 0xa6, 0xb6, 0x7c, 0x55, 0x2b, 0x03, 0x03, 0x13, 0x97, 0x09, 0x4e, 0xca, 0xa4,
+	0x1e, 0x34, 0xa9, 0x1c, 0xe7, 0xc4, 0xfb, 0x80, 0x99, 0x8b, 0x76, 0xb6, 0x80, 0x01, 0x25, 0xb5,
+	0xd0, 0x5b, 0xae, 0xc5, 0x93, 0x9b, 0x79, 0x4f, 0xd8, 0xba, 0x62, 0x8b, 0x6c, 0x69, 0x42, 0x95,
+	0xc5, 0x7f, 0x80, 0x64, 0xe3, 0xfe, 0x7d, 0x87, 0x4c, 0xe4, 0x52, 0x25, 0x78, 0xef, 0xb2, 0x69,
+	0x5e, 0xd5, 0x08, 0xcf, 0x3d, 0xc5, 0x3e, 0x85, 0x09, 0xbc, 0x57, 0x04, 0x41, 0xbe, 0x45, 0x7c,
+	0x5c, 0x58, 0x86, 0x42, 0xef, 0x49, 0x7b, 0xe3, 0xc2, 0x08, 0xca, 0x71, 0x61, 0x3f, 0x40, 0xb2,
+	0x41, 0xc7, 0x25, 0x91, 0x44, 0xde, 0x7b, 0xca, 0x74, 0x5c, 0x12, 0xb9, 0xe6, 0x41, 0x96, 0x17,
+	0xb2, 0x0e, 0x3e, 0x63, 0x2b, 0xeb, 0xa0, 0x52, 0x50, 0x1c, 0x3e, 0xeb, 0xe0, 0xd4, 0x0f, 0x92,
+	0x13, 0x05, 0xb5, 0xc6, 0xa1, 0xd2, 0xfe, 0x3d, 0x60, 0xda, 0x40, 0xff, 0x6f, 0xa3, 0x66, 0x50,
+	0xb3, 0xcd, 0xd9, 0x7e, 0xca, 0xf7, 0x79, 0x32, 0x2a, 0x52, 0x02, 0xf3, 0x94, 0x53, 0x7d, 0xa6,
+	0x61, 0x67, 0x5e, 0x2b, 0x03, 0x03, 0xd3, 0xbf, 0x42, 0xdc, 0xe2, 0x83, 0x7e, 0x47, 0xb2, 0x90,
+	0xfe, 0x23, 0x87, 0x8c, 0x19, 0xe2, 0xaf, 0x75, 0x7f, 0x9b, 0x45, 0xe2, 0xb6, 0xc2, 0x38, 0x8e,
+	0x62, 0x7e, 0xbb, 0xb8, 0x8e, 0x67, 0x5d, 0x22, 0xd2, 0xc2, 0x31, 0x3f, 0xbc, 0xeb, 0x85, 0x52,
+	0x28, 0xa9, 0xe1, 0xff, 0xe6, 0x00, 0xc9, 0xc2, 0x14, 0xef, 0x9f, 0xb7, 0x0e, 0xbf, 0x05, 0x06,
+	0x1d, 0x6b, 0x81, 0x74, 0xea, 0x5b, 0xbc, 0x50, 0x5b, 0xb9, 0xc1, 0x30, 0x15, 0x06, 0xc3, 0x7e,
+	0x75, 0x31, 0x6c, 0xa6, 0xc5, 0xa7, 0x4f, 0x5e, 0x78, 0x91, 0xc3, 0x41, 0x61, 0x14, 0xde, 0x0e,
+	0x1c, 0x3d, 0xe8, 0xdb, 0x81, 0x98, 0x39, 0x82, 0xee, 0x50, 0x65, 0x2b, 0x54, 0xba, 0x23, 0xf1,
+	0xc2, 0x29, 0x2b, 0x33, 0xa3, 0x9b, 0xfb, 0xee, 0x1f, 0xdd, 0xcc, 0x6e, 0x45, 0xc2, 0x80, 0xe4,
+	0x0d, 0xd8, 0xca, 0xa9, 0x53, 0x30, 0x49, 0x71, 0xc1, 0x41, 0x82, 0x41, 0xb1, 0x2c, 0x73, 0xb9,
+	0x19, 0x3e, 0x16, 0x97, 0x1b, 0x2d, 0x6c, 0xb7, 0xff, 0xa0, 0x61, 0xbb, 0xe6, 0xaa, 0x18, 0x3a,
+	0x90, 0xcb, 0xf7, 0xcf, 0x38, 0x64, 0x1c, 0x43, 0x3c, 0xb3, 0xed, 0xc3, 0x9e, 0x6b, 0x63, 0x46,
+	0x33, 0x1b, 0x58, 0x66, 0x32, 0x5d, 0x34, 0x18, 0x42, 0xae, 0x01, 0xee, 0xf7, 0x29, 0x5f, 0x8b,
+	0x11, 0x23, 0xc8, 0x52, 0xf8, 0x5a, 0xe0, 0x21, 0xa4, 0x08, 0x9a, 0xee, 0x17, 0xf8, 0xfe, 0xc2,
+	0xa0, 0x96, 0xb9, 0x67, 0x87, 0xff, 0x9b, 0xcf, 0x95, 0x23, 0x30, 0x40, 0x96, 0xe3, 0x34, 0x5c,
+	0xef, 0x86, 0xcd, 0xc6, 0x42, 0xb6, 0x9d, 0x65, 0xef, 0x0b, 0xc8, 0x02, 0xc8, 0x70, 0xb0, 0xc2,
+	0x26, 0xde, 0xd6, 0x5b, 0x18, 0xf2, 0x90, 0xf3, 0xa5, 0x5e, 0x92, 0x05, 0x90, 0xe1, 0xa0, 0x81,
+	0x7a, 0x33, 0x4c, 0xd7, 0x82, 0xcd, 0xbc, 0xff, 0xc8, 0x12, 0x83, 0x82, 0x28, 0x65, 0x8e, 0x00,
+	0x61, 0xba, 0x16, 0x53, 0x66, 0x3e, 0x2a, 0xa4, 0x16, 0x5c, 0xd2, 0xca, 0xc0, 0xc0, 0x64, 0x4d,
+	0x8a, 0x44, 0xcf, 0xbc, 0x81, 0x5c, 0x93, 0x64, 0x01, 0x64, 0x38, 0xb8, 0x11, 0xa0, 0x5d, 0x23,
+	0x6c, 0x8a, 0x18, 0x3a, 0x6d, 0x23, 0x98, 0x17, 0x70, 0x50, 0x18, 0x88, 0x8d, 0x7b, 0x39, 0x8e,
+	0xb3, 0x37, 0x64, 0x62, 0xaf, 0x0a, 0x38, 0x28, 0x0c, 0xff, 0x25, 0x32, 0xa6, 0x05, 0x08, 0x2f,
+	0xcd, 0xbb, 0x97, 0x0b, 0xa1, 0xb3, 0xef, 0x2e, 0x09, 0x9d, 0x3d, 0x6d, 0x54, 0x2a, 0x86, 0xd0,
+	0xfa, 0xbf, 0x54, 0x21, 0xf9, 0x90, 0x1d, 0x63, 0xfb, 0x73, 0xee, 0xbb, 0xfd, 0x1d, 0xe8, 0xd5,
+	0x86, 0x9b, 0x99, 0x40, 0x51, 0x3d, 0x52, 0x98, 0xdf, 0x48, 0xa9, 0xf0, 0x81, 0x21, 0x84, 0x51,
+	0xb3, 0xa9, 0x42, 0x08, 0xfb, 0x1e, 0x20, 0x84, 0x50, 0xa3, 0x03, 0x06, 0x55, 0x7c, 0xf7, 0xd4,
+	0x2d, 0xbe, 0x4b, 0xca, 0xce, 0x91, 0x48, 0x3c, 0x43, 0xd2, 0xaf, 0x9d, 0x23, 0x51, 0x9c, 0x02,
+	0x2b, 0x71, 0x01, 0xed, 0xa1, 0xcc, 0x73, 0x95, 0x6e, 0x1c, 0x2e, 0x4d, 0x93, 0xb0, 0x71, 0x8a,
+	0xba, 0x90, 0x91, 0xf1, 0x3f, 0xef, 0x90, 0xe2, 0x0b, 0xe8, 0x07, 0x48, 0x7b, 0x71, 0x81, 0xf4,
+	0xa5, 0x41, 0xb2, 0x9d, 0xcf, 0xf4, 0xc9, 0x72, 0x7e, 0xb1, 0x12, 0xfc, 0xec, 0x2a, 0x09, 0x78,
+	0xee, 0x1c, 0x2b, 0x49, 0xde, 0xfd, 0xcd, 0x0a, 0x19, 0x92, 0xae, 0x49, 0x86, 0xeb, 0x91, 0x73,
+	0x2c, 0xae, 0x47, 0x1d, 0xd2, 0x97, 0x74, 0x68, 0x5d, 0x8c, 0xa2, 0xcd, 0xcc, 0x10, 0x1d, 0x5a,
+	0xd7, 0x06, 0xac, 0x43, 0xeb, 0xc0, 0x38, 0xb9, 0xb7, 0xc9, 0x40, 0xc2, 0x73, 0xb9, 0x55, 0x6d,
+	0x5d, 0xa6, 0x15, 0x4f, 0x46, 0x57, 0x73, 0x1f, 0x66, 0xbf, 0x41, 0xf0, 0xf3, 0xff, 0x6b, 0x85,
+	0x9c, 0x91, 0xa8, 0x72, 0xe4, 0x97, 0xe6, 0xf1, 0x4b, 0x3d, 0x84, 0x81, 0x8e, 0x8d, 0x81, 0x5e,
+	0xb5, 0xa7, 0x9a, 0x5c, 0x9a, 0xef, 0x39, 0xd4, 0xaf, 0xe5, 0x86, 0x1a, 0xac, 0x72, 0xdd, 0x7f,
+	0xb0, 0xff, 0xc2, 0x21, 0x53, 0xe5, 0x83, 0x7d, 0x2d, 0x4c, 0x30, 0xc7, 0x51, 0x7e, 0xc0, 0x0f,
+	0xb8, 0xbb, 0x60, 0x6d, 0x36, 0xdc, 0x6a, 0x11, 0x49, 0x88, 0x36, 0xd8, 0x6f, 0xca, 0x57, 0x1b,
+	0xb8, 0x07, 0xea, 0x0f, 0xd9, 0x9b, 0x62, 0x66, 0x57, 0xb4, 0xa7, 0x4c, 0xf4, 0x37, 0x21, 0xfe,
+	0xcc, 0x21, 0xa7, 0x64, 0x05, 0xb6, 0xb3, 0xcd, 0x85, 0x6d, 0xe6, 0x1b, 0x7b, 0xfc, 0xd3, 0xec,
+	0x0d, 0x63, 0x9a, 0x7d, 0xd4, 0x5e, 0xc7, 0xf5, 0x7e, 0xf4, 0x9a, 0x70, 0xfe, 0xff, 0x74, 0x88,
+	0x57, 0x56, 0xe1, 0x21, 0x7c, 0xf2, 0xd7, 0xcd, 0x4f, 0xfe, 0xd2, 0xf1, 0xf4, 0xbc, 0xc7, 0x07,
+	0xff, 0x10, 0x39, 0x5b, 0x86, 0x7d, 0xa0, 0x1b, 0xaa, 0xff, 0x67, 0x3d, 0x06, 0x0d, 0xc7, 0xd5,
+	0x6d, 0xca, 0xcb, 0x89, 0x63, 0xcb, 0xdd, 0x8a, 0xb3, 0x28, 0xbf, 0xe5, 0x34, 0xc9, 0x40, 0xc2,
+	0xbc, 0x41, 0xbd, 0x8a, 0x2d, 0x8b, 0x18, 0xf7, 0x2e, 0x15, 0xe6, 0x63, 0xf6, 0x3f, 0x08, 0x1e,
+	0xf8, 0x3a, 0xe9, 0x49, 0xa3, 0xe3, 0x42, 0x00, 0x38, 0xfe, 0x55, 0xf2, 0xba, 0xb1, 0x4a, 0x5e,
+	0xb6, 0x3c, 0x57, 0x78, 0x37, 0x7a, 0x2e, 0x92, 0xff, 0xee, 0x90, 0xb3, 0x25, 0xf8, 0x0f, 0x61,
+	0x8d, 0xbc, 0x66, 0xae, 0x91, 0x9b, 0xc7, 0xd2, 0xef, 0x1e, 0x4b, 0xe4, 0x5b, 0x95, 0xd2, 0x5e,
+	0xb3, 0x49, 0xde, 0x55, 0xf1, 0x3f, 0x8e, 0x2d, 0xdb, 0xb9, 0xde, 0xa0, 0x5e, 0xe1, 0x44, 0x3f,
+	0xe6, 0x90, 0xa1, 0x75, 0xbe, 0xd6, 0xe4, 0x90, 0xbc, 0x7c, 0x3c, 0xdb, 0x06, 0xba, 0x1a, 0xaa,
+	0x0f, 0x23, 0x60, 0x09, 0x28, 0xe6, 0x3d, 0x8c, 0x22, 0xd5, 0xa3, 0x18, 0x45, 0xfc, 0x5f, 0xd3,
+	0x06, 0x9a, 0xf9, 0x80, 0x65, 0x47, 0x16, 0x7b, 0xf4, 0x37, 0x50, 0x3f, 0xed, 0x3d, 0xfa, 0x9b,
+	0xb1, 0xc8, 0x16, 0x5e, 0x06, 0x03, 0x8d, 0x27, 0xe6, 0xd9, 0x61, 0x8f, 0xf4, 0x2e, 0x86, 0xed,
+	0xa0, 0x19, 0xbe, 0x46, 0x63, 0xa0, 0xad, 0x08, 0xef, 0x18, 0x15, 0xf3, 0xc1, 0xea, 0xc5, 0x32,
+	0x24, 0x28, 0xaf, 0x5b, 0xd0, 0xb3, 0x57, 0x0f, 0xaa, 0x67, 0xf7, 0xff, 0xd0, 0x21, 0xa3, 0x6a,
+	0xb4, 0x8e, 0x7f, 0x05, 0x46, 0xe6, 0x0a, 0x7c, 0xc1, 0xde, 0x74, 0xeb, 0xb1, 0xec, 0xee, 0xf4,
+	0x93, 0x49, 0x89, 0xa2, 0x5e, 0xb4, 0xf9, 0x51, 0x47, 0x79, 0xa1, 0xf3, 0x08, 0xa1, 0x8f, 0xdb,
+	0x6b, 0xc7, 0x61, 0x5e, 0x91, 0xc1, 0x90, 0x55, 0x43, 0xc9, 0x5d, 0xb1, 0x95, 0xb9, 0xbd, 0xd0,
+	0x9a, 0x23, 0x3c, 0xb1, 0xf3, 0x96, 0x43, 0x08, 0x6f, 0xa7, 0x78, 0x13, 0x11, 0xdb, 0xb6, 0x7e,
+	0x6c, 0x23, 0x85, 0x4c, 0x78, 0xd3, 0xd4, 0x12, 0xca, 0x0a, 0x40, 0x6b, 0xc9, 0x03, 0xbc, 0x9d,
+	0xf3, 0xc0, 0xcf, 0xf6, 0x7c, 0xd9, 0x21, 0x13, 0xb9, 0xe6, 0x96, 0xd4, 0xdf, 0xd0, 0xeb, 0x5b,
+	0xb9, 0xec, 0x98, 0x0f, 0xbb, 0xe9, 0x16, 0x81, 0x8f, 0x65, 0xd7, 0x0c, 0xa6, 0x88, 0x6f, 0xe8,
+	0xd9, 0x44, 0x30, 0x20, 0x64, 0xd7, 0x28, 0x15, 0x19, 0x44, 0x94, 0x0d, 0xd3, 0xac, 0x0b, 0x39,
+	0x6c, 0xff, 0xf3, 0xef, 0xc9, 0xb6, 0x07, 0x76, 0x54, 0xbd, 0x4e, 0x86, 0xa5, 0xb1, 0x40, 0x2e,
+	0x9e, 0x17, 0xec, 0xd9, 0x64, 0x32, 0x45, 0x98, 0x84, 0x24, 0x90, 0xf1, 0xcb, 0x85, 0xd0, 0x54,
+	0x0e, 0x14, 0x42, 0x63, 0xbc, 0x2f, 0x57, 0x7d, 0xd8, 0xef, 0xcb, 0x95, 0x1f, 0x6c, 0x7d, 0xc7,
+	0x62, 0xed, 0x3f, 0x67, 0xdd, 0xda, 0xff, 0xf8, 0x43, 0xb6, 0xf6, 0x6b, 0x2e, 0x62, 0xfd, 0x0f,
+	0xe0, 0x22, 0xf6, 0x3a, 0x39, 0xb5, 0x93, 0xa9, 0x27, 0xd5, 0x4c, 0x12, 0xd9, 0xbd, 0xdf, 0x5d,
+	0x6a, 0xe1, 0x2e, 0x4b, 0x97, 0x98, 0xb9, 0x60, 0xbe, 0x54, 0x42, 0x0e, 0x4a, 0x99, 0xe4, 0x7d,
+	0x7d, 0x06, 0x0f, 0xe0, 0xeb, 0xf3, 0x2b, 0xe8, 0x2d, 0x55, 0xc8, 0x58, 0x82, 0xba, 0xbd, 0x21,
+	0x5b, 0x99, 0x16, 0x66, 0xcb, 0xc8, 0x0b, 0xa7, 0xaa, 0xb2, 0x22, 0x28, 0x6f, 0x10, 0x46, 0x40,
+	0x4b, 0xc7, 0x4b, 0x1e, 0xf3, 0x55, 0xee, 0x25, 0xf9, 0xf5, 0xbc, 0x7b, 0x39, 0x61, 0x43, 0xff,
+	0x49, 0xbb, 0xea, 0x35, 0x0b, 0x2e, 0xe6, 0x23, 0x0f, 0xe0, 0x62, 0xfe, 0x0b, 0x0e, 0x99, 0xe8,
+	0x44, 0xc6, 0x7e, 0xeb, 0xbd, 0xff, 0x82, 0x63, 0xc7, 0x8d, 0xbe, 0xf7, 0x9e, 0xce, 0xed, 0x52,
+	0xab, 0x26, 0x63, 0xc8, 0xb7, 0x24, 0xef, 0x16, 0x36, 0x6a, 0xc9, 0x2d, 0xec, 0x2d, 0x87, 0x9c,
+	0xeb, 0x44, 0x8d, 0x9e, 0x2e, 0x5c, 0xde, 0x07, 0x8e, 0xe0, 0x19, 0xf6, 0x2e, 0xc1, 0xf6, 0xdc,
+	0xea, 0x3e, 0x94, 0x61, 0x5f, 0xbe, 0x6e, 0x9b, 0x4c, 0xb2, 0x8c, 0x05, 0xab, 0xdd, 0x66, 0x93,
+	0x2b, 0xb8, 0x13, 0x6f, 0xec, 0x42, 0xb5, 0x97, 0xc5, 0x0f, 0x5d, 0x15, 0x9b, 0x22, 0x7f, 0xa8,
+	0x0a, 0x13, 0x54, 0x19, 0x2a, 0x96, 0x73, 0x94, 0xa0, 0x40, 0x1b, 0xd7, 0x39, 0x7b, 0x4d, 0x84,
+	0xa6, 0xf8, 0xf1, 0x98, 0xb7, 0xf5, 0xd0, 0xdc, 0x84, 0x74, 0x3b, 0x12, 0x60, 0xd0, 0x71, 0x4c,
+	0x87, 0xa0, 0x09, 0x9b, 0x0e, 0x41, 0x93, 0x0f, 0xec, 0x10, 0xf4, 0x14, 0x19, 0x88, 0xda, 0x98,
+	0x4c, 0xd9, 0x3b, 0x61, 0x9a, 0xbd, 0x56, 0x18, 0x14, 0x44, 0x29, 0x7f, 0x17, 0x2b, 0x6d, 0x2a,
+	0x9f, 0xca, 0xf3, 0xd6, 0xde, 0xc5, 0xca, 0xe2, 0x9d, 0xc4, 0xbb, 0x58, 0x19, 0x00, 0x74, 0x96,
+	0xee, 0x4a, 0x2f, 0xdf, 0xd2, 0x93, 0x6c, 0xaf, 0x3d, 0xbc, 0xa7, 0xa8, 0x1e, 0x70, 0x79, 0x6a,
+	0xdf, 0x80, 0xcb, 0x82, 0x13, 0xe2, 0xe9, 0x43, 0x38, 0x21, 0x6e, 0xb1, 0x17, 0x8b, 0x96, 0xe6,
+	0x85, 0x1f, 0xaa, 0x05, 0x55, 0x16, 0xcb, 0x5f, 0xcb, 0xe3, 0xc7, 0xd8, 0xbf, 0xc0, 0x19, 0xf4,
+	0x8c, 0x49, 0x3d, 0x7b, 0xe4, 0x98, 0xd4, 0x4f, 0x90, 0x47, 0x1b, 0x62, 0xd4, 0x8a, 0x64, 0x67,
+	0x0c, 0xe3, 0xef, 0xa3, 0x0b, 0xbd, 0x10, 0xa1, 0x37, 0x0d, 0xf7, 0x4d, 0xf2, 0x44, 0xbe, 0xf0,
+	0x72, 0x52, 0x0f, 0x9a, 0x6c, 0xdb, 0x59, 0xdb, 0x8a, 0x69, 0x82, 0xf9, 0x15, 0x84, 0xaf, 0xe5,
+	0x7b, 0x05, 0xab, 0x27, 0x16, 0xee, 0x5f, 0x05, 0x0e, 0x42, 0xb7, 0xd4, 0xaf, 0xf3, 0x99, 0x43,
+	0xf9, 0x75, 0xa2, 0xbb, 0x71, 0x26, 0x76, 0xe2, 0xe1, 0xfd, 0x3e, 0x5b, 0xee, 0xc6, 0x97, 0x75,
+	0xb2, 0xdc, 0xdd, 0xd8, 0x00, 0x81, 0xc9, 0x38, 0xef, 0x34, 0xf9, 0xe8, 0x71, 0x39, 0x4d, 0x5e,
+	0x3a, 0x06, 0xa7, 0xc9, 0x12, 0xc7, 0xc4, 0xa9, 0x87, 0xe0, 0x98, 0xf8, 0xd8, 0x81, 0x1d, 0x13,
+	0x3f, 0x44, 0xc6, 0x3a, 0x51, 0x03, 0x3f, 0xb9, 0xc8, 0xe8, 0xf6, 0x9c, 0xb9, 0x05, 0xac, 0xea,
+	0x85, 0x60, 0xe2, 0xba, 0xb7, 0xc9, 0xc9, 0x4e, 0xd4, 0x58, 0x08, 0x93, 0xb8, 0xcb, 0xf2, 0x1c,
+	0xcd, 0x75, 0x1b, 0x9b, 0x34, 0x65, 0x6e, 0x91, 0x23, 0x97, 0xde, 0xa7, 0xf7, 0xb0, 0xc3, 0x76,
+	0x79, 0xb9, 0x81, 0xe7, 0x2a, 0x30, 0xd5, 0x2a, 0x0b, 0xac, 0x2c, 0x29, 0x84, 0x32, 0x16, 0xba,
+	0x0f, 0xe4, 0x85, 0x87, 0xe3, 0x03, 0xf9, 0x11, 0x32, 0x94, 0x6c, 0x75, 0xd3, 0x46, 0xb4, 0xdb,
+	0x66, 0x6e, 0xc3, 0xc3, 0xea, 0x98, 0x1f, 0xaa, 0x09, 0xf8, 0x3d, 0xcc, 0xbd, 0x2a, 0xfe, 0xd7,
+	0x7c, 0x08, 0x04, 0xc4, 0xfd, 0xc5, 0x1e, 0xf9, 0x31, 0xfc, 0xe3, 0xcc, 0x8f, 0x71, 0xf6, 0x50,
+	0xb9, 0x31, 0xca, 0x1c, 0x3d, 0x9f, 0xf8, 0xae, 0x73, 0xf4, 0xfc, 0x9a, 0x43, 0xc6, 0x76, 0x74,
+	0x87, 0x0d, 0xef, 0x5d, 0xb6, 0xf6, 0x26, 0xc3, 0x0f, 0x64, 0xce, 0xc7, 0x15, 0x60, 0x80, 0xee,
+	0xe5, 0x01, 0x60, 0xb6, 0xa4, 0x24, 0x4c, 0xe3, 0xc9, 0x77, 0x2a, 0x4c, 0xe3, 0x4d, 0x32, 0xd2,
+	0x89, 0x1a, 0x52, 0x2d, 0xc5, 0x3c, 0x54, 0xed, 0x86, 0x8d, 0xf2, 0x6b, 0x60, 0xc6, 0x02, 0x74,
+	0x7e, 0x18, 0x52, 0x39, 0x29, 0x75, 0x1d, 0xc2, 0x7f, 0x2c, 0xf1, 0xbe, 0xd7, 0x56, 0x23, 0x94,
+	0x8a, 0x85, 0x3f, 0xc9, 0x96, 0xe3, 0x03, 0x05, 0xce, 0x28, 0xe0, 0xaa, 0xb0, 0x9e, 0xcd, 0xc4,
+	0x7b, 0x3a, 0x13, 0x70, 0x67, 0x33, 0x30, 0xe8, 0x38, 0xee, 0x2f, 0x3b, 0xa4, 0x1f, 0xbd, 0x59,
+	0x12, 0xef, 0xdd, 0xb6, 0xad, 0x09, 0xec, 0xbe, 0x87, 0x4f, 0xfa, 0x0a, 0xf5, 0xe5, 0xb3, 0x52,
+	0xdb, 0xcb, 0x60, 0xf7, 0xee, 0x4c, 0x8f, 0xab, 0xe7, 0x6c, 0x18, 0xe4, 0x73, 0x6f, 0x6b, 0x10,
+	0x61, 0xe3, 0x63, 0x4d, 0x73, 0xbf, 0xe2, 0x90, 0xc9, 0xdd, 0x9c, 0x0a, 0xd2, 0x7b, 0x8f, 0x2d,
+	0xff, 0x80, 0xbc, 0x72, 0x93, 0x0f, 0x77, 0x1e, 0x0a, 0x85, 0x16, 0xb8, 0x5f, 0x30, 0x4d, 0x13,
+	0xef, 0xb5, 0x6d, 0x99, 0xcb, 0x99, 0x42, 0x78, 0xe6, 0x87, 0x1e, 0x36, 0x8a, 0x79, 0x72, 0x82,
+	0x85, 0x97, 0xd2, 0x86, 0x4a, 0x1a, 0x96, 0x88, 0xc8, 0x56, 0x96, 0xf1, 0x70, 0x36, 0x5f, 0x08,
+	0x45, 0xfc, 0x07, 0xf7, 0x95, 0xc6, 0x11, 0xc9, 0xbe, 0x78, 0x49, 0x55, 0x6a, 0xaa, 0x59, 0x2d,
+	0xec, 0x18, 0xc6, 0x1c, 0xd2, 0xb5, 0xac, 0xdf, 0xf0, 0xc8, 0xb8, 0xe9, 0x65, 0xe3, 0x7e, 0xc0,
+	0x7c, 0x16, 0xfa, 0x7c, 0xfe, 0x85, 0xdd, 0x31, 0x89, 0x6f, 0xbc, 0xb2, 0x6b, 0x3c, 0x83, 0x5b,
+	0x39, 0xd6, 0x67, 0x70, 0xab, 0xd6, 0x9f, 0xc1, 0x5d, 0x23, 0x43, 0xaf, 0x76, 0x69, 0x97, 0x51,
+	0x3f, 0x73, 0x68, 0xea, 0xec, 0x52, 0xf5, 0xa2, 0xa8, 0x0f, 0x8a, 0x52, 0xf9, 0xe3, 0xba, 0x93,
+	0xc7, 0xf1, 0xb8, 0xee, 0x89, 0x43, 0x3d, 0xae, 0xab, 0x3d, 0x6e, 0xdc, 0x77, 0x9f, 0xc7, 0x8d,
+	0x67, 0xd1, 0xdb, 0x98, 0x67, 0x9e, 0xa0, 0xe2, 0xfd, 0xd2, 0x7e, 0xf3, 0xf9, 0xca, 0x79, 0xb3,
+	0x18, 0xf2, 0xf8, 0xb8, 0xfe, 0xfb, 0xdb, 0x51, 0x43, 0xa9, 0x29, 0x5f, 0xb1, 0xed, 0x16, 0xc6,
+	0xb4, 0x65, 0x62, 0xf7, 0x94, 0xd2, 0x78, 0x3f, 0x83, 0xdd, 0x93, 0xff, 0x00, 0x6f, 0x01, 0x3e,
+	0xc0, 0x16, 0x6d, 0x6c, 0x34, 0xa3, 0xa0, 0x91, 0xbd, 0x00, 0x2c, 0x1d, 0x56, 0x79, 0xda, 0x26,
+	0xf5, 0x00, 0xdb, 0x4a, 0x0f, 0x3c, 0xe8, 0x49, 0x01, 0xd5, 0x9d, 0x13, 0x49, 0x1a, 0xc5, 0xb4,
+	0x91, 0xa9, 0x66, 0x87, 0x59, 0x9f, 0xa9, 0xf5, 0x3e, 0xd7, 0x4c, 0x3e, 0xbc, 0xf7, 0xea, 0xa3,
+	0xe4, 0x4a, 0x21, 0xdf, 0x2c, 0x37, 0x26, 0x67, 0x3a, 0x65, 0x9a, 0xe1, 0xc4, 0x1b, 0xbc, 0xaf,
+	0x7e, 0x5a, 0x6e, 0x08, 0x67, 0x4a, 0x75, 0xcb, 0x09, 0xf4, 0xa0, 0xac, 0xbf, 0xd2, 0x3b, 0xf4,
+	0x70, 0x5e, 0xe9, 0xfd, 0x0c, 0x21, 0x75, 0xf9, 0x44, 0x81, 0x54, 0x9a, 0x5d, 0xb5, 0x92, 0xc8,
+	0x81, 0xd3, 0xcc, 0xf6, 0x15, 0x05, 0x4a, 0x40, 0x63, 0xe9, 0xfe, 0x9f, 0xd2, 0x67, 0xac, 0xb9,
+	0xca, 0x72, 0xd3, 0xfa, 0x9c, 0xf8, 0xae, 0x7b, 0xca, 0xfa, 0x1f, 0x3a, 0x64, 0x8a, 0xcf, 0xbc,
+	0xfc, 0xbd, 0x03, 0xa5, 0x1e, 0x6f, 0xfc, 0x58, 0x5c, 0x53, 0x79, 0xbe, 0x69, 0x83, 0x2b, 0xc2,
+	0x61, 0x9f, 0x96, 0xa0, 0xf2, 0xb7, 0x70, 0xdb, 0x99, 0xb0, 0x65, 0xa2, 0x28, 0x7f, 0x8c, 0xf8,
+	0xe4, 0xdd, 0x83, 0x5c, 0x70, 0x7e, 0xbd, 0xa7, 0x05, 0xc5, 0x65, 0xcd, 0xfb, 0xe1, 0x63, 0xb2,
+	0xa0, 0xe8, 0x2f, 0x26, 0x1f, 0xca, 0x8e, 0xf2, 0x65, 0x87, 0x4c, 0x06, 0x39, 0x57, 0x52, 0xef,
+	0xa4, 0x2d, 0x5d, 0xea, 0x6c, 0xac, 0x88, 0x72, 0xf9, 0x33, 0xef, 0xb5, 0x0a, 0x05, 0xe6, 0xee,
+	0x37, 0x1d, 0xf2, 0x58, 0xf6, 0x2c, 0x73, 0x92, 0x65, 0x8a, 0x12, 0x8d, 0x3b, 0xc5, 0x56, 0xe3,
+	0xab, 0xd6, 0x57, 0xe3, 0x5a, 0x6f, 0x9e, 0x7c, 0x5d, 0x3e, 0x21, 0xd6, 0xe5, 0x63, 0xfb, 0x60,
+	0xc2, 0x7e, 0x4d, 0x77, 0xff, 0xa9, 0x43, 0xa6, 0x83, 0x1d, 0x1a, 0x07, 0x9b, 0x54, 0x0e, 0x84,
+	0x96, 0x39, 0x0a, 0x70, 0x0a, 0x79, 0xa7, 0x6d, 0xf9, 0xfb, 0xcd, 0x32, 0xcb, 0xea, 0xdc, 0x13,
+	0x77, 0xef, 0x4c, 0x4f, 0xcf, 0xee, 0xcf, 0x14, 0xee, 0xd7, 0xaa, 0xa9, 0x1f, 0x75, 0x08, 0xc9,
+	0x8e, 0xed, 0x12, 0x11, 0x78, 0xdd, 0x14, 0x81, 0xaf, 0xd9, 0x7c, 0xf3, 0x5f, 0x97, 0xc5, 0xbf,
+	0x84, 0xcf, 0x2a, 0x94, 0x9c, 0xa5, 0x25, 0x4d, 0xfa, 0xa4, 0xd9, 0x24, 0x8b, 0x57, 0x57, 0xbd,
+	0x41, 0x56, 0x9e, 0xf0, 0x9e, 0xba, 0x41, 0x2e, 0xdc, 0x6f, 0xfe, 0xdd, 0x8f, 0xde, 0x90, 0x7e,
+	0x4d, 0xf8, 0xe9, 0x11, 0xcd, 0x5d, 0x42, 0x44, 0x47, 0x58, 0x8d, 0xcf, 0x6c, 0x63, 0x7e, 0x32,
+	0x54, 0x66, 0x7b, 0x63, 0xb6, 0x47, 0x97, 0xbb, 0xa8, 0x2e, 0x33, 0xea, 0x20, 0xb8, 0xbc, 0xc3,
+	0xde, 0x13, 0xcc, 0xd8, 0xa4, 0x29, 0xfe, 0xfa, 0xac, 0x19, 0x9b, 0x32, 0xa2, 0xc2, 0xd8, 0x94,
+	0x01, 0x40, 0x67, 0xe9, 0xee, 0x92, 0xe1, 0xdd, 0x30, 0xdd, 0x62, 0x3e, 0x65, 0xc2, 0x29, 0xc1,
+	0x42, 0x3a, 0x1e, 0x24, 0x97, 0xf5, 0xfd, 0x96, 0x64, 0x00, 0x19, 0x2f, 0x8c, 0x12, 0xdb, 0x95,
+	0xe1, 0x38, 0xf9, 0x28, 0x31, 0x15, 0xa7, 0x03, 0x19, 0x8e, 0xfb, 0x55, 0x87, 0x9c, 0xd8, 0xcd,
+	0x07, 0xf0, 0x78, 0xe3, 0xb6, 0x42, 0x2f, 0x0b, 0xb1, 0x41, 0x5c, 0x15, 0x50, 0x00, 0x43, 0xb1,
+	0x11, 0xf8, 0x1d, 0x47, 0x11, 0x2a, 0xf3, 0x52, 0x7b, 0x83, 0xb6, 0x26, 0xaf, 0xa4, 0xc8, 0x63,
+	0xad, 0x6e, 0x69, 0x3c, 0xc0, 0xe0, 0xa8, 0x1e, 0xe3, 0x1b, 0xea, 0xf9, 0x18, 0xdf, 0x1b, 0x4c,
+	0x0a, 0x4e, 0xc3, 0x76, 0x97, 0xae, 0xb4, 0xbd, 0x61, 0x5b, 0xfb, 0xe9, 0xbc, 0xa2, 0xc9, 0x55,
+	0x2e, 0xd9, 0x6f, 0xd0, 0xf8, 0x69, 0xf6, 0xd7, 0x91, 0x7d, 0xed, 0xaf, 0x99, 0x8a, 0x6d, 0xd4,
+	0xba, 0x8a, 0x2d, 0xa5, 0x1d, 0x2b, 0x2a, 0xb6, 0xef, 0x2a, 0xcd, 0xcd, 0x5f, 0x60, 0x90, 0x9d,
+	0x14, 0x66, 0xd5, 0x5e, 0xff, 0x10, 0x7c, 0xec, 0xd1, 0xd7, 0x18, 0xaf, 0xd3, 0x9c, 0xa1, 0xdd,
+	0x03, 0x9a, 0xd3, 0xcc, 0x1a, 0x90, 0xc1, 0x40, 0xe3, 0xe9, 0xff, 0xa9, 0x43, 0xce, 0x14, 0xfb,
+	0xfe, 0x10, 0xdc, 0x7c, 0xf7, 0x4c, 0x37, 0xdf, 0x35, 0x8b, 0xa6, 0x1a, 0xd5, 0x8d, 0x1e, 0x0e,
+	0xbf, 0xdf, 0xc6, 0xc0, 0x53, 0x0d, 0xb9, 0x46, 0x1f, 0xc6, 0xc7, 0xde, 0x35, 0x02, 0x2a, 0x6e,
+	0xda, 0xed, 0x6f, 0x4d, 0x58, 0xfc, 0xca, 0x42, 0xdc, 0x3e, 0x93, 0x0b, 0x71, 0xbb, 0x65, 0x9f,
+	0xf5, 0xfe, 0x71, 0x6e, 0xff, 0x4d, 0x0b, 0x62, 0x11, 0x35, 0x1e, 0xc2, 0x04, 0xdb, 0x31, 0x27,
+	0xd8, 0x8b, 0xd6, 0x7b, 0xdd, 0x63, 0x76, 0x7d, 0xa3, 0x52, 0xe8, 0x2d, 0xbb, 0x19, 0x7f, 0xde,
+	0x21, 0xfd, 0x78, 0x05, 0x91, 0x3e, 0xb1, 0x9f, 0x3c, 0x96, 0x19, 0xc0, 0x2e, 0x4b, 0x62, 0x77,
+	0x56, 0xed, 0x63, 0x30, 0xe0, 0xdc, 0xa7, 0xf0, 0x01, 0xea, 0x0c, 0xe9, 0x9d, 0x92, 0xce, 0xfd,
+	0x5f, 0xad, 0x90, 0xd3, 0xa5, 0xd3, 0x08, 0x63, 0x4e, 0x84, 0x9a, 0xd3, 0xb1, 0xed, 0x4f, 0x6e,
+	0x30, 0xd2, 0xb5, 0x9d, 0x63, 0x86, 0xb6, 0x53, 0x28, 0x39, 0xdf, 0xa9, 0xbb, 0x95, 0xd8, 0xa6,
+	0xb5, 0xc1, 0xfa, 0x96, 0x93, 0x85, 0x28, 0xc8, 0xc1, 0xfc, 0xcb, 0x18, 0xf9, 0xec, 0x7f, 0x5b,
+	0x0b, 0x0b, 0x95, 0x1d, 0x7d, 0x08, 0x7b, 0xc5, 0xae, 0xb9, 0x57, 0x80, 0x7d, 0xbf, 0x81, 0x1e,
+	0x9b, 0xc5, 0xab, 0xa4, 0xcc, 0x91, 0xe0, 0x60, 0x8f, 0x4c, 0x18, 0x59, 0x78, 0x2a, 0x07, 0xce,
+	0xc2, 0x33, 0x46, 0x46, 0x3e, 0x1a, 0xaa, 0x07, 0x4a, 0xe6, 0x66, 0x7e, 0xe7, 0x8f, 0xce, 0x3f,
+	0xf2, 0xbb, 0x7f, 0x74, 0xfe, 0x91, 0x6f, 0xfe, 0xd1, 0xf9, 0x47, 0x3e, 0x7b, 0xf7, 0xbc, 0xf3,
+	0x3b, 0x77, 0xcf, 0x3b, 0xbf, 0x7b, 0xf7, 0xbc, 0xf3, 0xcd, 0xbb, 0xe7, 0x9d, 0xff, 0x74, 0xf7,
+	0xbc, 0xf3, 0xd3, 0x7f, 0x7c, 0xfe, 0x91, 0x8f, 0x0e, 0xc9, 0x8e, 0xfd, 0xff, 0x01, 0x00, 0xc5,
+	0x96, 0xf0, 0x95, 0xd1, 0xfc, 0x00, 0x00,
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -5214,6 +5935,34 @@ func (m *Amount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AnnotationValueFrom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnnotationValueFrom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnnotationValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Annotation)
+	copy(dAtA[i:], m.Annotation)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Annotation)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArchiveStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5429,6 +6178,99 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.UnpackTo)
+	copy(dAtA[i:], m.UnpackTo)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.UnpackTo)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if m.NamedPipe != nil {
+		{
+			size, err := m.NamedPipe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.RenamePolicy != nil {
+		{
+			size, err := m.RenamePolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Naming != nil {
+		{
+			size, err := m.Naming.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	i -= len(m.GlobPath)
+	copy(dAtA[i:], m.GlobPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GlobPath)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.ExpiresAt != nil {
+		{
+			size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.CompressionRatio != nil {
+		{
+			size, err := m.CompressionRatio.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	i -= len(m.PreviewPath)
 	copy(dAtA[i:], m.PreviewPath)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PreviewPath)))
@@ -5699,6 +6541,35 @@ func (m *ArtifactLocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PersistentVolumeClaim != nil {
+		{
+			size, err := m.PersistentVolumeClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	i -= len(m.KeyPrefix)
+	copy(dAtA[i:], m.KeyPrefix)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyPrefix)))
+	i--
+	dAtA[i] = 0x62
+	if m.FromImageLabel != nil {
+		{
+			size, err := m.FromImageLabel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Azure != nil {
 		{
 			size, err := m.Azure.MarshalToSizedBuffer(dAtA[:i])
@@ -5820,6 +6691,34 @@ func (m *ArtifactLocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtifactNaming) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactNaming) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactNaming) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Strategy)
+	copy(dAtA[i:], m.Strategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Strategy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArtifactNodeSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5917,6 +6816,34 @@ func (m *ArtifactPaths) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtifactRenamePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactRenamePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactRenamePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.To)
+	copy(dAtA[i:], m.To)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.To)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArtifactRepository) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6625,6 +7552,11 @@ func (m *Backoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Jitter)
+	copy(dAtA[i:], m.Jitter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Jitter)))
+	i--
+	dAtA[i] = 0x2a
 	i -= len(m.Cap)
 	copy(dAtA[i:], m.Cap)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cap)))
@@ -7496,6 +8428,46 @@ func (m *DAGTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WithParamArtifact != nil {
+		{
+			size, err := m.WithParamArtifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.OnFailure != nil {
+		{
+			size, err := m.OnFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.OnSuccess != nil {
+		{
+			size, err := m.OnSuccess.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.Inline != nil {
 		{
 			size, err := m.Inline.MarshalToSizedBuffer(dAtA[:i])
@@ -7758,6 +8730,16 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ArtifactName)
+	copy(dAtA[i:], m.ArtifactName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ArtifactName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.ParameterName)
+	copy(dAtA[i:], m.ParameterName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ParameterName)))
+	i--
+	dAtA[i] = 0x12
 	if m.ArtifactPaths != nil {
 		{
 			size, err := m.ArtifactPaths.MarshalToSizedBuffer(dAtA[:i])
@@ -7773,6 +8755,39 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EntrypointRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntrypointRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntrypointRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.WorkflowTemplate)
+	copy(dAtA[i:], m.WorkflowTemplate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WorkflowTemplate)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Event) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7801,6 +8816,41 @@ func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Webhook != nil {
+		{
+			size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExecutorConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7821,6 +8871,21 @@ func (m *ExecutorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IncludeScripts != nil {
+		i--
+		if *m.IncludeScripts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	i -= len(m.Image)
+	copy(dAtA[i:], m.Image)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
+	i--
+	dAtA[i] = 0x12
 	i -= len(m.ServiceAccountName)
 	copy(dAtA[i:], m.ServiceAccountName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
@@ -7925,6 +8990,14 @@ func (m *GCSBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.UseWorkloadIdentity {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
 	if m.ServiceAccountKeySecret != nil {
 		{
 			size, err := m.ServiceAccountKeySecret.MarshalToSizedBuffer(dAtA[:i])
@@ -8428,6 +9501,18 @@ func (m *HTTPArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TLSConfig != nil {
+		{
+			size, err := m.TLSConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Auth != nil {
 		{
 			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
@@ -8625,6 +9710,37 @@ func (m *HTTPHeaderSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPTLSConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPTLSConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPTLSConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.InsecureSkipVerify {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Header) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8700,6 +9816,72 @@ func (m *Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ImageLabelSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageLabelSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageLabelSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Label)
+	copy(dAtA[i:], m.Label)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Label)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Image)
+	copy(dAtA[i:], m.Image)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ImageRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImageRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImageRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ImageKey)
+	copy(dAtA[i:], m.ImageKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ImageKey)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ClusterWorkflowTemplate)
+	copy(dAtA[i:], m.ClusterWorkflowTemplate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterWorkflowTemplate)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Inputs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9358,6 +10540,34 @@ func (m *MutexStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NamedPipeArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamedPipeArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamedPipeArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *NodeFlag) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9467,6 +10677,23 @@ func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Suspend {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf0
+	i -= len(m.ResourceVersion)
+	copy(dAtA[i:], m.ResourceVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResourceVersion)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
 	if m.TaskResultSynced != nil {
 		i--
 		if *m.TaskResultSynced {
@@ -10098,6 +11325,39 @@ func (m *Object) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OutputParameterRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputParameterRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutputParameterRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Parameter)
+	copy(dAtA[i:], m.Parameter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parameter)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Step)
+	copy(dAtA[i:], m.Step)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Step)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Outputs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10220,6 +11480,23 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.DefaultExpression)
+	copy(dAtA[i:], m.DefaultExpression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DefaultExpression)))
+	i--
+	dAtA[i] = 0x4a
+	if m.FromOutput != nil {
+		{
+			size, err := m.FromOutput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Description != nil {
 		i -= len(*m.Description)
 		copy(dAtA[i:], *m.Description)
@@ -10275,6 +11552,39 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PersistentVolumeClaimSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PersistentVolumeClaimSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PersistentVolumeClaimSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.SubPath)
+	copy(dAtA[i:], m.SubPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SubPath)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ClaimName)
+	copy(dAtA[i:], m.ClaimName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClaimName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Plugin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10328,6 +11638,14 @@ func (m *PodGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.KeepFailedPodDuration)
+	copy(dAtA[i:], m.KeepFailedPodDuration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeepFailedPodDuration)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RetainCount))
+	i--
+	dAtA[i] = 0x20
 	i -= len(m.DeleteDelayDuration)
 	copy(dAtA[i:], m.DeleteDelayDuration)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeleteDelayDuration)))
@@ -10353,6 +11671,38 @@ func (m *PodGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Preprocessor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Preprocessor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Preprocessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Command) > 0 {
+		for iNdEx := len(m.Command) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Command[iNdEx])
+			copy(dAtA[i:], m.Command[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Command[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Prometheus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10373,6 +11723,18 @@ func (m *Prometheus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PushInterval != nil {
+		{
+			size, err := m.PushInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Counter != nil {
 		{
 			size, err := m.Counter.MarshalToSizedBuffer(dAtA[:i])
@@ -10489,6 +11851,35 @@ func (m *ResourceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WaitForResource != nil {
+		{
+			size, err := m.WaitForResource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Backoff != nil {
+		{
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.BackoffLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.BackoffLimit))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.ManifestFrom != nil {
 		{
 			size, err := m.ManifestFrom.MarshalToSizedBuffer(dAtA[:i])
@@ -10604,6 +11995,37 @@ func (m *RetryNodeAntiAffinity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetryOn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryOn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryOn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.TransientErrors {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10624,6 +12046,18 @@ func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryOn != nil {
+		{
+			size, err := m.RetryOn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	i -= len(m.Expression)
 	copy(dAtA[i:], m.Expression)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
@@ -10960,6 +12394,37 @@ func (m *ScriptTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Interpreter)
+	copy(dAtA[i:], m.Interpreter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interpreter)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Preprocessors) > 0 {
+		for iNdEx := len(m.Preprocessors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Preprocessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ImageRef != nil {
+		{
+			size, err := m.ImageRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	i -= len(m.Source)
 	copy(dAtA[i:], m.Source)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Source)))
@@ -11035,6 +12500,11 @@ func (m *SemaphoreRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Fairness)
+	copy(dAtA[i:], m.Fairness)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Fairness)))
+	i--
+	dAtA[i] = 0x22
 	if m.Database != nil {
 		{
 			size, err := m.Database.MarshalToSizedBuffer(dAtA[:i])
@@ -11138,6 +12608,11 @@ func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.GoTemplate)
+	copy(dAtA[i:], m.GoTemplate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GoTemplate)))
+	i--
+	dAtA[i] = 0x2a
 	i -= len(m.Format)
 	copy(dAtA[i:], m.Format)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
@@ -11285,6 +12760,11 @@ func (m *SubmitOpts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ParametersFromConfigMap)
+	copy(dAtA[i:], m.ParametersFromConfigMap)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ParametersFromConfigMap)))
+	i--
+	dAtA[i] = 0x7a
 	if m.Priority != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
 		i--
@@ -11652,6 +13132,101 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.AutoscaleResources {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xa0
+	if len(m.TopologySpreadConstraints) > 0 {
+		for iNdEx := len(m.TopologySpreadConstraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopologySpreadConstraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	i--
+	if m.AllowOverrideCommand {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x90
+	if len(m.OverrideCommand) > 0 {
+		for iNdEx := len(m.OverrideCommand) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OverrideCommand[iNdEx])
+			copy(dAtA[i:], m.OverrideCommand[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.OverrideCommand[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ResourceClaims) > 0 {
+		for iNdEx := len(m.ResourceClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.RuntimeClassName != nil {
+		i -= len(*m.RuntimeClassName)
+		copy(dAtA[i:], *m.RuntimeClassName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.RuntimeClassName)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.DNSConfig != nil {
+		{
+			size, err := m.DNSConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.DNSPolicy != nil {
+		i -= len(*m.DNSPolicy)
+		copy(dAtA[i:], *m.DNSPolicy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.DNSPolicy)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
 	if len(m.Annotations) > 0 {
 		keysForAnnotations := make([]string, 0, len(m.Annotations))
 		for k := range m.Annotations {
@@ -12284,6 +13859,28 @@ func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ArtifactName)
+	copy(dAtA[i:], m.ArtifactName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ArtifactName)))
+	i--
+	dAtA[i] = 0x62
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x5a
+	if m.FromAnnotation != nil {
+		{
+			size, err := m.FromAnnotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.ConfigMapKeyRef != nil {
 		{
 			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
@@ -12439,6 +14036,139 @@ func (m *VolumeClaimGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WaitForResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WaitForResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WaitForResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PollInterval != nil {
+		{
+			size, err := m.PollInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.JSONPath)
+	copy(dAtA[i:], m.JSONPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPath)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WebhookEventSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookEventSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SecretRef != nil {
+		{
+			size, err := m.SecretRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Port))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *WithParamArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithParamArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithParamArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Artifact)
+	copy(dAtA[i:], m.Artifact)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Artifact)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Task)
+	copy(dAtA[i:], m.Task)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Task)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Step)
+	copy(dAtA[i:], m.Step)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Step)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Workflow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12682,6 +14412,34 @@ func (m *WorkflowEventBindingList) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowEventBindingRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowEventBindingRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowEventBindingRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *WorkflowEventBindingSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12727,6 +14485,148 @@ func (m *WorkflowEventBindingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowEventSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowEventSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowEventSourceList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowEventSourceList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowEventSourceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowEventSourceSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowEventSourceSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowEventSourceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ServiceAccountName)
+	copy(dAtA[i:], m.ServiceAccountName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bindings) > 0 {
+		for iNdEx := len(m.Bindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *WorkflowLevelArtifactGC) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12920,6 +14820,37 @@ func (m *WorkflowMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowScopedAntiAffinity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowScopedAntiAffinity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowScopedAntiAffinity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.WorkflowScoped {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *WorkflowSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12940,6 +14871,107 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PodTopologySpreadConstraints) > 0 {
+		for iNdEx := len(m.PodTopologySpreadConstraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PodTopologySpreadConstraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	i -= len(m.PodNameFormat)
+	copy(dAtA[i:], m.PodNameFormat)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodNameFormat)))
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x9a
+	if len(m.ResourceClaims) > 0 {
+		for iNdEx := len(m.ResourceClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.AllowedNamespaces) > 0 {
+		for iNdEx := len(m.AllowedNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedNamespaces[iNdEx])
+			copy(dAtA[i:], m.AllowedNamespaces[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.AllowedNamespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.PodAntiAffinity != nil {
+		{
+			size, err := m.PodAntiAffinity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x82
+	}
+	i -= len(m.DeadlinePriorityEscalationThreshold)
+	copy(dAtA[i:], m.DeadlinePriorityEscalationThreshold)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeadlinePriorityEscalationThreshold)))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xfa
+	i -= len(m.DeadlinePriorityClassName)
+	copy(dAtA[i:], m.DeadlinePriorityClassName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeadlinePriorityClassName)))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xf2
+	if m.EntrypointRef != nil {
+		{
+			size, err := m.EntrypointRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.RuntimeClassName != nil {
+		i -= len(*m.RuntimeClassName)
+		copy(dAtA[i:], *m.RuntimeClassName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.RuntimeClassName)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe2
+	}
 	if m.ArtifactGC != nil {
 		{
 			size, err := m.ArtifactGC.MarshalToSizedBuffer(dAtA[:i])
@@ -13463,6 +15495,34 @@ func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.QueuedAt != nil {
+		{
+			size, err := m.QueuedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.AverageArtifactCompressionRatio != nil {
+		{
+			size, err := m.AverageArtifactCompressionRatio.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
 	if len(m.TaskResultsCompletionStatus) > 0 {
 		keysForTaskResultsCompletionStatus := make([]string, 0, len(m.TaskResultsCompletionStatus))
 		for k := range m.TaskResultsCompletionStatus {
@@ -13739,6 +15799,18 @@ func (m *WorkflowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WithParamArtifact != nil {
+		{
+			size, err := m.WithParamArtifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Inline != nil {
 		{
 			size, err := m.Inline.MarshalToSizedBuffer(dAtA[:i])
@@ -14333,6 +16405,17 @@ func (m *Amount) Size() (n int) {
 	return n
 }
 
+func (m *AnnotationValueFrom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Annotation)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ArchiveStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14437,6 +16520,36 @@ func (m *Artifact) Size() (n int) {
 	n += 2
 	l = len(m.PreviewPath)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.CompressionRatio != nil {
+		l = m.CompressionRatio.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = m.ExpiresAt.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.GlobPath)
+	n += 2 + l + sovGenerated(uint64(l))
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Naming != nil {
+		l = m.Naming.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.RenamePolicy != nil {
+		l = m.RenamePolicy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.NamedPipe != nil {
+		l = m.NamedPipe.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.UnpackTo)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -14538,6 +16651,27 @@ func (m *ArtifactLocation) Size() (n int) {
 		l = m.Azure.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.FromImageLabel != nil {
+		l = m.FromImageLabel.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.KeyPrefix)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PersistentVolumeClaim != nil {
+		l = m.PersistentVolumeClaim.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ArtifactNaming) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -14574,6 +16708,17 @@ func (m *ArtifactPaths) Size() (n int) {
 	return n
 }
 
+func (m *ArtifactRenamePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.To)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ArtifactRepository) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14827,6 +16972,8 @@ func (m *Backoff) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Cap)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Jitter)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -15187,6 +17334,18 @@ func (m *DAGTask) Size() (n int) {
 		l = m.Inline.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.OnSuccess != nil {
+		l = m.OnSuccess.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OnFailure != nil {
+		l = m.OnFailure.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.WithParamArtifact != nil {
+		l = m.WithParamArtifact.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15237,6 +17396,23 @@ func (m *DataSource) Size() (n int) {
 		l = m.ArtifactPaths.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.ParameterName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ArtifactName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *EntrypointRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowTemplate)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -15251,6 +17427,19 @@ func (m *Event) Size() (n int) {
 	return n
 }
 
+func (m *EventSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Webhook != nil {
+		l = m.Webhook.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ExecutorConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15259,6 +17448,11 @@ func (m *ExecutorConfig) Size() (n int) {
 	_ = l
 	l = len(m.ServiceAccountName)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.IncludeScripts != nil {
+		n += 2
+	}
 	return n
 }
 
@@ -15300,6 +17494,7 @@ func (m *GCSBucket) Size() (n int) {
 		l = m.ServiceAccountKeySecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 2
 	return n
 }
 
@@ -15484,6 +17679,10 @@ func (m *HTTPArtifact) Size() (n int) {
 		l = m.Auth.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.TLSConfig != nil {
+		l = m.TLSConfig.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -15545,6 +17744,16 @@ func (m *HTTPHeaderSource) Size() (n int) {
 	return n
 }
 
+func (m *HTTPTLSConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+
 func (m *Header) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15575,6 +17784,32 @@ func (m *Histogram) Size() (n int) {
 	return n
 }
 
+func (m *ImageLabelSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Label)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ImageRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterWorkflowTemplate)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ImageKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Inputs) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15831,6 +18066,17 @@ func (m *MutexStatus) Size() (n int) {
 	return n
 }
 
+func (m *NamedPipeArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *NodeFlag) Size() (n int) {
 	if m == nil {
 		return 0
@@ -15946,6 +18192,9 @@ func (m *NodeStatus) Size() (n int) {
 	if m.TaskResultSynced != nil {
 		n += 3
 	}
+	l = len(m.ResourceVersion)
+	n += 2 + l + sovGenerated(uint64(l))
+	n += 3
 	return n
 }
 
@@ -16094,6 +18343,19 @@ func (m *Object) Size() (n int) {
 	return n
 }
 
+func (m *OutputParameterRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Step)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Parameter)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Outputs) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16170,6 +18432,25 @@ func (m *Parameter) Size() (n int) {
 		l = len(*m.Description)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.FromOutput != nil {
+		l = m.FromOutput.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.DefaultExpression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *PersistentVolumeClaimSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClaimName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SubPath)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16198,6 +18479,24 @@ func (m *PodGC) Size() (n int) {
 	}
 	l = len(m.DeleteDelayDuration)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.RetainCount))
+	l = len(m.KeepFailedPodDuration)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Preprocessor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -16231,6 +18530,10 @@ func (m *Prometheus) Size() (n int) {
 		l = m.Counter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.PushInterval != nil {
+		l = m.PushInterval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16272,6 +18575,17 @@ func (m *ResourceTemplate) Size() (n int) {
 		l = m.ManifestFrom.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.BackoffLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.BackoffLimit))
+	}
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.WaitForResource != nil {
+		l = m.WaitForResource.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16297,6 +18611,16 @@ func (m *RetryNodeAntiAffinity) Size() (n int) {
 	return n
 }
 
+func (m *RetryOn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+
 func (m *RetryStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16319,6 +18643,10 @@ func (m *RetryStrategy) Size() (n int) {
 	}
 	l = len(m.Expression)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.RetryOn != nil {
+		l = m.RetryOn.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16423,6 +18751,18 @@ func (m *ScriptTemplate) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Source)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.ImageRef != nil {
+		l = m.ImageRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Preprocessors) > 0 {
+		for _, e := range m.Preprocessors {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Interpreter)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16459,6 +18799,8 @@ func (m *SemaphoreRef) Size() (n int) {
 		l = m.Database.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Fairness)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16503,6 +18845,8 @@ func (m *Sequence) Size() (n int) {
 	}
 	l = len(m.Format)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GoTemplate)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16569,6 +18913,8 @@ func (m *SubmitOpts) Size() (n int) {
 	if m.Priority != nil {
 		n += 1 + sovGenerated(uint64(*m.Priority))
 	}
+	l = len(m.ParametersFromConfigMap)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16839,6 +19185,38 @@ func (m *Template) Size() (n int) {
 			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.DNSPolicy != nil {
+		l = len(*m.DNSPolicy)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.DNSConfig != nil {
+		l = m.DNSConfig.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.RuntimeClassName != nil {
+		l = len(*m.RuntimeClassName)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ResourceClaims) > 0 {
+		for _, e := range m.ResourceClaims {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.OverrideCommand) > 0 {
+		for _, s := range m.OverrideCommand {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 3
+	if len(m.TopologySpreadConstraints) > 0 {
+		for _, e := range m.TopologySpreadConstraints {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 3
 	return n
 }
 
@@ -16911,6 +19289,14 @@ func (m *ValueFrom) Size() (n int) {
 		l = m.ConfigMapKeyRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.FromAnnotation != nil {
+		l = m.FromAnnotation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ArtifactName)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -16950,6 +19336,56 @@ func (m *VolumeClaimGC) Size() (n int) {
 	return n
 }
 
+func (m *WaitForResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JSONPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PollInterval != nil {
+		l = m.PollInterval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WebhookEventSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Port))
+	if m.SecretRef != nil {
+		l = m.SecretRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WithParamArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Step)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Task)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Artifact)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Workflow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17027,6 +19463,17 @@ func (m *WorkflowEventBindingList) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowEventBindingRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *WorkflowEventBindingSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17042,6 +19489,55 @@ func (m *WorkflowEventBindingSpec) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowEventSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WorkflowEventSourceList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkflowEventSourceSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Source.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.ServiceAccountName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *WorkflowLevelArtifactGC) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17107,6 +19603,16 @@ func (m *WorkflowMetadata) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowScopedAntiAffinity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+
 func (m *WorkflowSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17275,6 +19781,42 @@ func (m *WorkflowSpec) Size() (n int) {
 		l = m.ArtifactGC.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.RuntimeClassName != nil {
+		l = len(*m.RuntimeClassName)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.EntrypointRef != nil {
+		l = m.EntrypointRef.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.DeadlinePriorityClassName)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.DeadlinePriorityEscalationThreshold)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.PodAntiAffinity != nil {
+		l = m.PodAntiAffinity.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.AllowedNamespaces) > 0 {
+		for _, s := range m.AllowedNamespaces {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ResourceClaims) > 0 {
+		for _, e := range m.ResourceClaims {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.PodNameFormat)
+	n += 2 + l + sovGenerated(uint64(l))
+	if len(m.PodTopologySpreadConstraints) > 0 {
+		for _, e := range m.PodTopologySpreadConstraints {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17365,6 +19907,14 @@ func (m *WorkflowStatus) Size() (n int) {
 			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.AverageArtifactCompressionRatio != nil {
+		l = m.AverageArtifactCompressionRatio.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.QueuedAt != nil {
+		l = m.QueuedAt.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17417,6 +19967,10 @@ func (m *WorkflowStep) Size() (n int) {
 		l = m.Inline.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.WithParamArtifact != nil {
+		l = m.WithParamArtifact.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17585,6 +20139,16 @@ func (this *Amount) String() string {
 	}, "")
 	return s
 }
+func (this *AnnotationValueFrom) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnnotationValueFrom{`,
+		`Annotation:` + fmt.Sprintf("%v", this.Annotation) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ArchiveStrategy) String() string {
 	if this == nil {
 		return "nil"
@@ -17669,6 +20233,14 @@ func (this *Artifact) String() string {
 		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
 		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
 		`PreviewPath:` + fmt.Sprintf("%v", this.PreviewPath) + `,`,
+		`CompressionRatio:` + strings.Replace(this.CompressionRatio.String(), "Amount", "Amount", 1) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Time", "v1.Time", 1) + `,`,
+		`GlobPath:` + fmt.Sprintf("%v", this.GlobPath) + `,`,
+		`Paths:` + fmt.Sprintf("%v", this.Paths) + `,`,
+		`Naming:` + strings.Replace(this.Naming.String(), "ArtifactNaming", "ArtifactNaming", 1) + `,`,
+		`RenamePolicy:` + strings.Replace(this.RenamePolicy.String(), "ArtifactRenamePolicy", "ArtifactRenamePolicy", 1) + `,`,
+		`NamedPipe:` + strings.Replace(this.NamedPipe.String(), "NamedPipeArtifact", "NamedPipeArtifact", 1) + `,`,
+		`UnpackTo:` + fmt.Sprintf("%v", this.UnpackTo) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17740,6 +20312,19 @@ func (this *ArtifactLocation) String() string {
 		`OSS:` + strings.Replace(this.OSS.String(), "OSSArtifact", "OSSArtifact", 1) + `,`,
 		`GCS:` + strings.Replace(this.GCS.String(), "GCSArtifact", "GCSArtifact", 1) + `,`,
 		`Azure:` + strings.Replace(this.Azure.String(), "AzureArtifact", "AzureArtifact", 1) + `,`,
+		`FromImageLabel:` + strings.Replace(this.FromImageLabel.String(), "ImageLabelSource", "ImageLabelSource", 1) + `,`,
+		`KeyPrefix:` + fmt.Sprintf("%v", this.KeyPrefix) + `,`,
+		`PersistentVolumeClaim:` + strings.Replace(this.PersistentVolumeClaim.String(), "PersistentVolumeClaimSource", "PersistentVolumeClaimSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactNaming) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactNaming{`,
+		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17775,6 +20360,16 @@ func (this *ArtifactPaths) String() string {
 	}, "")
 	return s
 }
+func (this *ArtifactRenamePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactRenamePolicy{`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ArtifactRepository) String() string {
 	if this == nil {
 		return "nil"
@@ -17897,8 +20492,8 @@ func (this *ArtifactoryAuth) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ArtifactoryAuth{`,
-		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17932,7 +20527,7 @@ func (this *AzureBlobContainer) String() string {
 	s := strings.Join([]string{`&AzureBlobContainer{`,
 		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
 		`Container:` + fmt.Sprintf("%v", this.Container) + `,`,
-		`AccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccountKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`AccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccountKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`UseSDKCreds:` + fmt.Sprintf("%v", this.UseSDKCreds) + `,`,
 		`}`,
 	}, "")
@@ -17947,6 +20542,7 @@ func (this *Backoff) String() string {
 		`Factor:` + strings.Replace(fmt.Sprintf("%v", this.Factor), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`MaxDuration:` + fmt.Sprintf("%v", this.MaxDuration) + `,`,
 		`Cap:` + fmt.Sprintf("%v", this.Cap) + `,`,
+		`Jitter:` + fmt.Sprintf("%v", this.Jitter) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17956,8 +20552,8 @@ func (this *BasicAuth) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&BasicAuth{`,
-		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17967,7 +20563,7 @@ func (this *Cache) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Cache{`,
-		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "ConfigMapKeySelector", "v11.ConfigMapKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17977,8 +20573,8 @@ func (this *ClientCertAuth) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ClientCertAuth{`,
-		`ClientCertSecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`ClientKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ClientCertSecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`ClientKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17988,7 +20584,7 @@ func (this *ClusterWorkflowTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ClusterWorkflowTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -18004,7 +20600,7 @@ func (this *ClusterWorkflowTemplateList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ClusterWorkflowTemplateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -18039,7 +20635,7 @@ func (this *ContainerNode) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ContainerNode{`,
-		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v11.Container", 1), `&`, ``, 1) + `,`,
 		`Dependencies:` + fmt.Sprintf("%v", this.Dependencies) + `,`,
 		`}`,
 	}, "")
@@ -18114,7 +20710,7 @@ func (this *CronWorkflow) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&CronWorkflow{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "CronWorkflowSpec", "CronWorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "CronWorkflowStatus", "CronWorkflowStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -18131,7 +20727,7 @@ func (this *CronWorkflowList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&CronWorkflowList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -18150,7 +20746,7 @@ func (this *CronWorkflowSpec) String() string {
 		`SuccessfulJobsHistoryLimit:` + valueToStringGenerated(this.SuccessfulJobsHistoryLimit) + `,`,
 		`FailedJobsHistoryLimit:` + valueToStringGenerated(this.FailedJobsHistoryLimit) + `,`,
 		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
-		`WorkflowMetadata:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowMetadata), "ObjectMeta", "v11.ObjectMeta", 1) + `,`,
+		`WorkflowMetadata:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowMetadata), "ObjectMeta", "v1.ObjectMeta", 1) + `,`,
 		`StopStrategy:` + strings.Replace(this.StopStrategy.String(), "StopStrategy", "StopStrategy", 1) + `,`,
 		`Schedules:` + fmt.Sprintf("%v", this.Schedules) + `,`,
 		`When:` + fmt.Sprintf("%v", this.When) + `,`,
@@ -18174,7 +20770,7 @@ func (this *CronWorkflowStatus) String() string {
 	repeatedStringForConditions += "}"
 	s := strings.Join([]string{`&CronWorkflowStatus{`,
 		`Active:` + repeatedStringForActive + `,`,
-		`LastScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledTime), "Time", "v11.Time", 1) + `,`,
+		`LastScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledTime), "Time", "v1.Time", 1) + `,`,
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
 		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
@@ -18217,6 +20813,9 @@ func (this *DAGTask) String() string {
 		`Depends:` + fmt.Sprintf("%v", this.Depends) + `,`,
 		`Hooks:` + mapStringForHooks + `,`,
 		`Inline:` + strings.Replace(this.Inline.String(), "Template", "Template", 1) + `,`,
+		`OnSuccess:` + strings.Replace(this.OnSuccess.String(), "LifecycleHook", "LifecycleHook", 1) + `,`,
+		`OnFailure:` + strings.Replace(this.OnFailure.String(), "LifecycleHook", "LifecycleHook", 1) + `,`,
+		`WithParamArtifact:` + strings.Replace(this.WithParamArtifact.String(), "WithParamArtifact", "WithParamArtifact", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18260,6 +20859,19 @@ func (this *DataSource) String() string {
 	}
 	s := strings.Join([]string{`&DataSource{`,
 		`ArtifactPaths:` + strings.Replace(this.ArtifactPaths.String(), "ArtifactPaths", "ArtifactPaths", 1) + `,`,
+		`ParameterName:` + fmt.Sprintf("%v", this.ParameterName) + `,`,
+		`ArtifactName:` + fmt.Sprintf("%v", this.ArtifactName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EntrypointRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EntrypointRef{`,
+		`WorkflowTemplate:` + fmt.Sprintf("%v", this.WorkflowTemplate) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18274,12 +20886,24 @@ func (this *Event) String() string {
 	}, "")
 	return s
 }
+func (this *EventSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventSource{`,
+		`Webhook:` + strings.Replace(this.Webhook.String(), "WebhookEventSource", "WebhookEventSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ExecutorConfig) String() string {
 	if this == nil {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ExecutorConfig{`,
 		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`IncludeScripts:` + valueToStringGenerated(this.IncludeScripts) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18312,7 +20936,8 @@ func (this *GCSBucket) String() string {
 	}
 	s := strings.Join([]string{`&GCSBucket{`,
 		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
-		`ServiceAccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ServiceAccountKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ServiceAccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ServiceAccountKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`UseWorkloadIdentity:` + fmt.Sprintf("%v", this.UseWorkloadIdentity) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18338,9 +20963,9 @@ func (this *GitArtifact) String() string {
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
 		`Depth:` + valueToStringGenerated(this.Depth) + `,`,
 		`Fetch:` + fmt.Sprintf("%v", this.Fetch) + `,`,
-		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SSHPrivateKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SSHPrivateKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`SSHPrivateKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SSHPrivateKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`InsecureIgnoreHostKey:` + fmt.Sprintf("%v", this.InsecureIgnoreHostKey) + `,`,
 		`DisableSubmodules:` + fmt.Sprintf("%v", this.DisableSubmodules) + `,`,
 		`SingleBranch:` + fmt.Sprintf("%v", this.SingleBranch) + `,`,
@@ -18392,11 +21017,11 @@ func (this *HDFSKrbConfig) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&HDFSKrbConfig{`,
-		`KrbCCacheSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbCCacheSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`KrbKeytabSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbKeytabSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`KrbCCacheSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbCCacheSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`KrbKeytabSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbKeytabSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`KrbUsername:` + fmt.Sprintf("%v", this.KrbUsername) + `,`,
 		`KrbRealm:` + fmt.Sprintf("%v", this.KrbRealm) + `,`,
-		`KrbConfigConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.KrbConfigConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`KrbConfigConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.KrbConfigConfigMap), "ConfigMapKeySelector", "v11.ConfigMapKeySelector", 1) + `,`,
 		`KrbServicePrincipalName:` + fmt.Sprintf("%v", this.KrbServicePrincipalName) + `,`,
 		`}`,
 	}, "")
@@ -18437,6 +21062,7 @@ func (this *HTTPArtifact) String() string {
 		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
 		`Headers:` + repeatedStringForHeaders + `,`,
 		`Auth:` + strings.Replace(this.Auth.String(), "HTTPAuth", "HTTPAuth", 1) + `,`,
+		`TLSConfig:` + strings.Replace(this.TLSConfig.String(), "HTTPTLSConfig", "HTTPTLSConfig", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18480,7 +21106,17 @@ func (this *HTTPHeaderSource) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&HTTPHeaderSource{`,
-		`SecretKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeyRef), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SecretKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeyRef), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPTLSConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPTLSConfig{`,
+		`InsecureSkipVerify:` + fmt.Sprintf("%v", this.InsecureSkipVerify) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18512,6 +21148,28 @@ func (this *Histogram) String() string {
 	}, "")
 	return s
 }
+func (this *ImageLabelSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageLabelSource{`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`Label:` + fmt.Sprintf("%v", this.Label) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageRef{`,
+		`ClusterWorkflowTemplate:` + fmt.Sprintf("%v", this.ClusterWorkflowTemplate) + `,`,
+		`ImageKey:` + fmt.Sprintf("%v", this.ImageKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Inputs) String() string {
 	if this == nil {
 		return "nil"
@@ -18724,6 +21382,16 @@ func (this *MutexStatus) String() string {
 	}, "")
 	return s
 }
+func (this *NamedPipeArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamedPipeArtifact{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NodeFlag) String() string {
 	if this == nil {
 		return "nil"
@@ -18772,8 +21440,8 @@ func (this *NodeStatus) String() string {
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`BoundaryID:` + fmt.Sprintf("%v", this.BoundaryID) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`PodIP:` + fmt.Sprintf("%v", this.PodIP) + `,`,
 		`Daemoned:` + valueToStringGenerated(this.Daemoned) + `,`,
 		`Inputs:` + strings.Replace(this.Inputs.String(), "Inputs", "Inputs", 1) + `,`,
@@ -18789,6 +21457,8 @@ func (this *NodeStatus) String() string {
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
 		`NodeFlag:` + strings.Replace(this.NodeFlag.String(), "NodeFlag", "NodeFlag", 1) + `,`,
 		`TaskResultSynced:` + valueToStringGenerated(this.TaskResultSynced) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
+		`Suspend:` + fmt.Sprintf("%v", this.Suspend) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18822,9 +21492,9 @@ func (this *OAuth2Auth) String() string {
 	}
 	repeatedStringForEndpointParams += "}"
 	s := strings.Join([]string{`&OAuth2Auth{`,
-		`ClientIDSecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientIDSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`ClientSecretSecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientSecretSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`TokenURLSecret:` + strings.Replace(fmt.Sprintf("%v", this.TokenURLSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ClientIDSecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientIDSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`ClientSecretSecret:` + strings.Replace(fmt.Sprintf("%v", this.ClientSecretSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`TokenURLSecret:` + strings.Replace(fmt.Sprintf("%v", this.TokenURLSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`Scopes:` + fmt.Sprintf("%v", this.Scopes) + `,`,
 		`EndpointParams:` + repeatedStringForEndpointParams + `,`,
 		`}`,
@@ -18871,8 +21541,8 @@ func (this *OSSBucket) String() string {
 	s := strings.Join([]string{`&OSSBucket{`,
 		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
 		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
-		`AccessKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`AccessKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`CreateBucketIfNotPresent:` + fmt.Sprintf("%v", this.CreateBucketIfNotPresent) + `,`,
 		`SecurityToken:` + fmt.Sprintf("%v", this.SecurityToken) + `,`,
 		`LifecycleRule:` + strings.Replace(this.LifecycleRule.String(), "OSSLifecycleRule", "OSSLifecycleRule", 1) + `,`,
@@ -18902,6 +21572,17 @@ func (this *Object) String() string {
 	}, "")
 	return s
 }
+func (this *OutputParameterRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OutputParameterRef{`,
+		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
+		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Outputs) String() string {
 	if this == nil {
 		return "nil"
@@ -18952,6 +21633,19 @@ func (this *Parameter) String() string {
 		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
 		`Enum:` + fmt.Sprintf("%v", this.Enum) + `,`,
 		`Description:` + valueToStringGenerated(this.Description) + `,`,
+		`FromOutput:` + strings.Replace(this.FromOutput.String(), "OutputParameterRef", "OutputParameterRef", 1) + `,`,
+		`DefaultExpression:` + fmt.Sprintf("%v", this.DefaultExpression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PersistentVolumeClaimSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PersistentVolumeClaimSource{`,
+		`ClaimName:` + fmt.Sprintf("%v", this.ClaimName) + `,`,
+		`SubPath:` + fmt.Sprintf("%v", this.SubPath) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18972,8 +21666,20 @@ func (this *PodGC) String() string {
 	}
 	s := strings.Join([]string{`&PodGC{`,
 		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
-		`LabelSelector:` + strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v11.LabelSelector", 1) + `,`,
+		`LabelSelector:` + strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`DeleteDelayDuration:` + fmt.Sprintf("%v", this.DeleteDelayDuration) + `,`,
+		`RetainCount:` + fmt.Sprintf("%v", this.RetainCount) + `,`,
+		`KeepFailedPodDuration:` + fmt.Sprintf("%v", this.KeepFailedPodDuration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Preprocessor) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Preprocessor{`,
+		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18995,6 +21701,7 @@ func (this *Prometheus) String() string {
 		`Gauge:` + strings.Replace(this.Gauge.String(), "Gauge", "Gauge", 1) + `,`,
 		`Histogram:` + strings.Replace(this.Histogram.String(), "Histogram", "Histogram", 1) + `,`,
 		`Counter:` + strings.Replace(this.Counter.String(), "Counter", "Counter", 1) + `,`,
+		`PushInterval:` + strings.Replace(fmt.Sprintf("%v", this.PushInterval), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19022,6 +21729,9 @@ func (this *ResourceTemplate) String() string {
 		`FailureCondition:` + fmt.Sprintf("%v", this.FailureCondition) + `,`,
 		`Flags:` + fmt.Sprintf("%v", this.Flags) + `,`,
 		`ManifestFrom:` + strings.Replace(this.ManifestFrom.String(), "ManifestFrom", "ManifestFrom", 1) + `,`,
+		`BackoffLimit:` + valueToStringGenerated(this.BackoffLimit) + `,`,
+		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
+		`WaitForResource:` + strings.Replace(this.WaitForResource.String(), "WaitForResource", "WaitForResource", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19045,6 +21755,16 @@ func (this *RetryNodeAntiAffinity) String() string {
 	}, "")
 	return s
 }
+func (this *RetryOn) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryOn{`,
+		`TransientErrors:` + fmt.Sprintf("%v", this.TransientErrors) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RetryStrategy) String() string {
 	if this == nil {
 		return "nil"
@@ -19055,6 +21775,7 @@ func (this *RetryStrategy) String() string {
 		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
 		`Affinity:` + strings.Replace(this.Affinity.String(), "RetryAffinity", "RetryAffinity", 1) + `,`,
 		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`RetryOn:` + strings.Replace(this.RetryOn.String(), "RetryOn", "RetryOn", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19091,14 +21812,14 @@ func (this *S3Bucket) String() string {
 		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
 		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
 		`Insecure:` + valueToStringGenerated(this.Insecure) + `,`,
-		`AccessKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`AccessKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
 		`UseSDKCreds:` + fmt.Sprintf("%v", this.UseSDKCreds) + `,`,
 		`CreateBucketIfNotPresent:` + strings.Replace(this.CreateBucketIfNotPresent.String(), "CreateS3BucketOptions", "CreateS3BucketOptions", 1) + `,`,
 		`EncryptionOptions:` + strings.Replace(this.EncryptionOptions.String(), "S3EncryptionOptions", "S3EncryptionOptions", 1) + `,`,
-		`CASecret:` + strings.Replace(fmt.Sprintf("%v", this.CASecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SessionTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.SessionTokenSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`CASecret:` + strings.Replace(fmt.Sprintf("%v", this.CASecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`SessionTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.SessionTokenSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19111,7 +21832,7 @@ func (this *S3EncryptionOptions) String() string {
 		`KmsKeyId:` + fmt.Sprintf("%v", this.KmsKeyId) + `,`,
 		`KmsEncryptionContext:` + fmt.Sprintf("%v", this.KmsEncryptionContext) + `,`,
 		`EnableEncryption:` + fmt.Sprintf("%v", this.EnableEncryption) + `,`,
-		`ServerSideCustomerKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ServerSideCustomerKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ServerSideCustomerKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ServerSideCustomerKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19120,9 +21841,17 @@ func (this *ScriptTemplate) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForPreprocessors := "[]Preprocessor{"
+	for _, f := range this.Preprocessors {
+		repeatedStringForPreprocessors += strings.Replace(strings.Replace(f.String(), "Preprocessor", "Preprocessor", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPreprocessors += "}"
 	s := strings.Join([]string{`&ScriptTemplate{`,
-		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v11.Container", 1), `&`, ``, 1) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`ImageRef:` + strings.Replace(this.ImageRef.String(), "ImageRef", "ImageRef", 1) + `,`,
+		`Preprocessors:` + repeatedStringForPreprocessors + `,`,
+		`Interpreter:` + fmt.Sprintf("%v", this.Interpreter) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19143,9 +21872,10 @@ func (this *SemaphoreRef) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&SemaphoreRef{`,
-		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v11.ConfigMapKeySelector", 1) + `,`,
 		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`Database:` + strings.Replace(this.Database.String(), "SyncDatabaseRef", "SyncDatabaseRef", 1) + `,`,
+		`Fairness:` + fmt.Sprintf("%v", this.Fairness) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19180,6 +21910,7 @@ func (this *Sequence) String() string {
 		`Start:` + strings.Replace(fmt.Sprintf("%v", this.Start), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`End:` + strings.Replace(fmt.Sprintf("%v", this.End), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`GoTemplate:` + fmt.Sprintf("%v", this.GoTemplate) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19201,7 +21932,7 @@ func (this *Submit) String() string {
 	s := strings.Join([]string{`&Submit{`,
 		`WorkflowTemplateRef:` + strings.Replace(strings.Replace(this.WorkflowTemplateRef.String(), "WorkflowTemplateRef", "WorkflowTemplateRef", 1), `&`, ``, 1) + `,`,
 		`Arguments:` + strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1) + `,`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19219,10 +21950,11 @@ func (this *SubmitOpts) String() string {
 		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
 		`ServerDryRun:` + fmt.Sprintf("%v", this.ServerDryRun) + `,`,
 		`Labels:` + fmt.Sprintf("%v", this.Labels) + `,`,
-		`OwnerReference:` + strings.Replace(fmt.Sprintf("%v", this.OwnerReference), "OwnerReference", "v11.OwnerReference", 1) + `,`,
+		`OwnerReference:` + strings.Replace(fmt.Sprintf("%v", this.OwnerReference), "OwnerReference", "v1.OwnerReference", 1) + `,`,
 		`Annotations:` + fmt.Sprintf("%v", this.Annotations) + `,`,
 		`PodPriorityClassName:` + fmt.Sprintf("%v", this.PodPriorityClassName) + `,`,
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
+		`ParametersFromConfigMap:` + fmt.Sprintf("%v", this.ParametersFromConfigMap) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19346,6 +22078,16 @@ func (this *Template) String() string {
 		repeatedStringForHostAliases += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForHostAliases += "}"
+	repeatedStringForResourceClaims := "[]PodResourceClaim{"
+	for _, f := range this.ResourceClaims {
+		repeatedStringForResourceClaims += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForResourceClaims += "}"
+	repeatedStringForTopologySpreadConstraints := "[]TopologySpreadConstraint{"
+	for _, f := range this.TopologySpreadConstraints {
+		repeatedStringForTopologySpreadConstraints += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForTopologySpreadConstraints += "}"
 	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
 	for k := range this.NodeSelector {
 		keysForNodeSelector = append(keysForNodeSelector, k)
@@ -19371,11 +22113,11 @@ func (this *Template) String() string {
 		`Inputs:` + strings.Replace(strings.Replace(this.Inputs.String(), "Inputs", "Inputs", 1), `&`, ``, 1) + `,`,
 		`Outputs:` + strings.Replace(strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1), `&`, ``, 1) + `,`,
 		`NodeSelector:` + mapStringForNodeSelector + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v11.Affinity", 1) + `,`,
 		`Metadata:` + strings.Replace(strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1), `&`, ``, 1) + `,`,
 		`Daemon:` + valueToStringGenerated(this.Daemon) + `,`,
 		`Steps:` + repeatedStringForSteps + `,`,
-		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1) + `,`,
+		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v11.Container", 1) + `,`,
 		`Script:` + strings.Replace(this.Script.String(), "ScriptTemplate", "ScriptTemplate", 1) + `,`,
 		`Resource:` + strings.Replace(this.Resource.String(), "ResourceTemplate", "ResourceTemplate", 1) + `,`,
 		`DAG:` + strings.Replace(this.DAG.String(), "DAGTemplate", "DAGTemplate", 1) + `,`,
@@ -19392,7 +22134,7 @@ func (this *Template) String() string {
 		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
 		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
 		`HostAliases:` + repeatedStringForHostAliases + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v11.PodSecurityContext", 1) + `,`,
 		`PodSpecPatch:` + fmt.Sprintf("%v", this.PodSpecPatch) + `,`,
 		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
 		`Executor:` + strings.Replace(this.Executor.String(), "ExecutorConfig", "ExecutorConfig", 1) + `,`,
@@ -19406,6 +22148,14 @@ func (this *Template) String() string {
 		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTP", "HTTP", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "Plugin", "Plugin", 1) + `,`,
 		`Annotations:` + mapStringForAnnotations + `,`,
+		`DNSPolicy:` + valueToStringGenerated(this.DNSPolicy) + `,`,
+		`DNSConfig:` + strings.Replace(fmt.Sprintf("%v", this.DNSConfig), "PodDNSConfig", "v11.PodDNSConfig", 1) + `,`,
+		`RuntimeClassName:` + valueToStringGenerated(this.RuntimeClassName) + `,`,
+		`ResourceClaims:` + repeatedStringForResourceClaims + `,`,
+		`OverrideCommand:` + fmt.Sprintf("%v", this.OverrideCommand) + `,`,
+		`AllowOverrideCommand:` + fmt.Sprintf("%v", this.AllowOverrideCommand) + `,`,
+		`TopologySpreadConstraints:` + repeatedStringForTopologySpreadConstraints + `,`,
+		`AutoscaleResources:` + fmt.Sprintf("%v", this.AutoscaleResources) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19437,7 +22187,7 @@ func (this *UserContainer) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&UserContainer{`,
-		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v11.Container", 1), `&`, ``, 1) + `,`,
 		`MirrorVolumeMounts:` + valueToStringGenerated(this.MirrorVolumeMounts) + `,`,
 		`}`,
 	}, "")
@@ -19456,7 +22206,10 @@ func (this *ValueFrom) String() string {
 		`Supplied:` + strings.Replace(this.Supplied.String(), "SuppliedValueFrom", "SuppliedValueFrom", 1) + `,`,
 		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
 		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
-		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v11.ConfigMapKeySelector", 1) + `,`,
+		`FromAnnotation:` + strings.Replace(this.FromAnnotation.String(), "AnnotationValueFrom", "AnnotationValueFrom", 1) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`ArtifactName:` + fmt.Sprintf("%v", this.ArtifactName) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19488,12 +22241,48 @@ func (this *VolumeClaimGC) String() string {
 	}, "")
 	return s
 }
+func (this *WaitForResource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WaitForResource{`,
+		`JSONPath:` + fmt.Sprintf("%v", this.JSONPath) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v1.Duration", 1) + `,`,
+		`PollInterval:` + strings.Replace(fmt.Sprintf("%v", this.PollInterval), "Duration", "v1.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookEventSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookEventSource{`,
+		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
+		`SecretRef:` + strings.Replace(fmt.Sprintf("%v", this.SecretRef), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WithParamArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WithParamArtifact{`,
+		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
+		`Task:` + fmt.Sprintf("%v", this.Task) + `,`,
+		`Artifact:` + fmt.Sprintf("%v", this.Artifact) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Workflow) String() string {
 	if this == nil {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Workflow{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WorkflowStatus", "WorkflowStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -19505,7 +22294,7 @@ func (this *WorkflowArtifactGCTask) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WorkflowArtifactGCTask{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ArtifactGCSpec", "ArtifactGCSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ArtifactGCStatus", "ArtifactGCStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -19522,7 +22311,7 @@ func (this *WorkflowArtifactGCTaskList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowArtifactGCTaskList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -19533,7 +22322,7 @@ func (this *WorkflowEventBinding) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WorkflowEventBinding{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowEventBindingSpec", "WorkflowEventBindingSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -19549,12 +22338,22 @@ func (this *WorkflowEventBindingList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowEventBindingList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
 	return s
 }
+func (this *WorkflowEventBindingRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowEventBindingRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *WorkflowEventBindingSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -19566,6 +22365,50 @@ func (this *WorkflowEventBindingSpec) String() string {
 	}, "")
 	return s
 }
+func (this *WorkflowEventSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowEventSource{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowEventSourceSpec", "WorkflowEventSourceSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowEventSourceList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]WorkflowEventSource{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "WorkflowEventSource", "WorkflowEventSource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&WorkflowEventSourceList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowEventSourceSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForBindings := "[]WorkflowEventBindingRef{"
+	for _, f := range this.Bindings {
+		repeatedStringForBindings += strings.Replace(strings.Replace(f.String(), "WorkflowEventBindingRef", "WorkflowEventBindingRef", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForBindings += "}"
+	s := strings.Join([]string{`&WorkflowEventSourceSpec{`,
+		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "EventSource", "EventSource", 1), `&`, ``, 1) + `,`,
+		`Bindings:` + repeatedStringForBindings + `,`,
+		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *WorkflowLevelArtifactGC) String() string {
 	if this == nil {
 		return "nil"
@@ -19588,7 +22431,7 @@ func (this *WorkflowList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -19636,6 +22479,16 @@ func (this *WorkflowMetadata) String() string {
 	}, "")
 	return s
 }
+func (this *WorkflowScopedAntiAffinity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowScopedAntiAffinity{`,
+		`WorkflowScoped:` + fmt.Sprintf("%v", this.WorkflowScoped) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *WorkflowSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -19670,6 +22523,16 @@ func (this *WorkflowSpec) String() string {
 		repeatedStringForHostAliases += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForHostAliases += "}"
+	repeatedStringForResourceClaims := "[]PodResourceClaim{"
+	for _, f := range this.ResourceClaims {
+		repeatedStringForResourceClaims += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForResourceClaims += "}"
+	repeatedStringForPodTopologySpreadConstraints := "[]TopologySpreadConstraint{"
+	for _, f := range this.PodTopologySpreadConstraints {
+		repeatedStringForPodTopologySpreadConstraints += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForPodTopologySpreadConstraints += "}"
 	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
 	for k := range this.NodeSelector {
 		keysForNodeSelector = append(keysForNodeSelector, k)
@@ -19701,12 +22564,12 @@ func (this *WorkflowSpec) String() string {
 		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRef", "ArtifactRepositoryRef", 1) + `,`,
 		`Suspend:` + valueToStringGenerated(this.Suspend) + `,`,
 		`NodeSelector:` + mapStringForNodeSelector + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v11.Affinity", 1) + `,`,
 		`Tolerations:` + repeatedStringForTolerations + `,`,
 		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
 		`HostNetwork:` + valueToStringGenerated(this.HostNetwork) + `,`,
 		`DNSPolicy:` + valueToStringGenerated(this.DNSPolicy) + `,`,
-		`DNSConfig:` + strings.Replace(fmt.Sprintf("%v", this.DNSConfig), "PodDNSConfig", "v1.PodDNSConfig", 1) + `,`,
+		`DNSConfig:` + strings.Replace(fmt.Sprintf("%v", this.DNSConfig), "PodDNSConfig", "v11.PodDNSConfig", 1) + `,`,
 		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
 		`ActiveDeadlineSeconds:` + valueToStringGenerated(this.ActiveDeadlineSeconds) + `,`,
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
@@ -19714,7 +22577,7 @@ func (this *WorkflowSpec) String() string {
 		`PodGC:` + strings.Replace(this.PodGC.String(), "PodGC", "PodGC", 1) + `,`,
 		`PodPriorityClassName:` + fmt.Sprintf("%v", this.PodPriorityClassName) + `,`,
 		`HostAliases:` + repeatedStringForHostAliases + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v11.PodSecurityContext", 1) + `,`,
 		`PodSpecPatch:` + fmt.Sprintf("%v", this.PodSpecPatch) + `,`,
 		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
 		`Executor:` + strings.Replace(this.Executor.String(), "ExecutorConfig", "ExecutorConfig", 1) + `,`,
@@ -19732,6 +22595,15 @@ func (this *WorkflowSpec) String() string {
 		`Hooks:` + mapStringForHooks + `,`,
 		`WorkflowMetadata:` + strings.Replace(this.WorkflowMetadata.String(), "WorkflowMetadata", "WorkflowMetadata", 1) + `,`,
 		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "WorkflowLevelArtifactGC", "WorkflowLevelArtifactGC", 1) + `,`,
+		`RuntimeClassName:` + valueToStringGenerated(this.RuntimeClassName) + `,`,
+		`EntrypointRef:` + strings.Replace(this.EntrypointRef.String(), "EntrypointRef", "EntrypointRef", 1) + `,`,
+		`DeadlinePriorityClassName:` + fmt.Sprintf("%v", this.DeadlinePriorityClassName) + `,`,
+		`DeadlinePriorityEscalationThreshold:` + fmt.Sprintf("%v", this.DeadlinePriorityEscalationThreshold) + `,`,
+		`PodAntiAffinity:` + strings.Replace(this.PodAntiAffinity.String(), "WorkflowScopedAntiAffinity", "WorkflowScopedAntiAffinity", 1) + `,`,
+		`AllowedNamespaces:` + fmt.Sprintf("%v", this.AllowedNamespaces) + `,`,
+		`ResourceClaims:` + repeatedStringForResourceClaims + `,`,
+		`PodNameFormat:` + fmt.Sprintf("%v", this.PodNameFormat) + `,`,
+		`PodTopologySpreadConstraints:` + repeatedStringForPodTopologySpreadConstraints + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19792,8 +22664,8 @@ func (this *WorkflowStatus) String() string {
 	mapStringForTaskResultsCompletionStatus += "}"
 	s := strings.Join([]string{`&WorkflowStatus{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`CompressedNodes:` + fmt.Sprintf("%v", this.CompressedNodes) + `,`,
 		`Nodes:` + mapStringForNodes + `,`,
@@ -19810,6 +22682,8 @@ func (this *WorkflowStatus) String() string {
 		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRefStatus", "ArtifactRepositoryRefStatus", 1) + `,`,
 		`ArtifactGCStatus:` + strings.Replace(this.ArtifactGCStatus.String(), "ArtGCStatus", "ArtGCStatus", 1) + `,`,
 		`TaskResultsCompletionStatus:` + mapStringForTaskResultsCompletionStatus + `,`,
+		`AverageArtifactCompressionRatio:` + strings.Replace(this.AverageArtifactCompressionRatio.String(), "Amount", "Amount", 1) + `,`,
+		`QueuedAt:` + strings.Replace(fmt.Sprintf("%v", this.QueuedAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19846,6 +22720,7 @@ func (this *WorkflowStep) String() string {
 		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
 		`Hooks:` + mapStringForHooks + `,`,
 		`Inline:` + strings.Replace(this.Inline.String(), "Template", "Template", 1) + `,`,
+		`WithParamArtifact:` + strings.Replace(this.WithParamArtifact.String(), "WithParamArtifact", "WithParamArtifact", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19855,7 +22730,7 @@ func (this *WorkflowTaskResult) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WorkflowTaskResult{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`NodeResult:` + strings.Replace(strings.Replace(this.NodeResult.String(), "NodeResult", "NodeResult", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -19871,7 +22746,7 @@ func (this *WorkflowTaskResultList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowTaskResultList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -19882,7 +22757,7 @@ func (this *WorkflowTaskSet) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WorkflowTaskSet{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowTaskSetSpec", "WorkflowTaskSetSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WorkflowTaskSetStatus", "WorkflowTaskSetStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -19899,7 +22774,7 @@ func (this *WorkflowTaskSetList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowTaskSetList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -19950,7 +22825,7 @@ func (this *WorkflowTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WorkflowTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -19966,7 +22841,7 @@ func (this *WorkflowTemplateList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowTemplateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -20082,6 +22957,88 @@ func (m *Amount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AnnotationValueFrom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnnotationValueFrom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnnotationValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -21096,61 +24053,11 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			}
 			m.PreviewPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArtifactGC) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactGC: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactGC: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionRatio", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21160,27 +24067,31 @@ func (m *ArtifactGC) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = ArtifactGCStrategy(dAtA[iNdEx:postIndex])
+			if m.CompressionRatio == nil {
+				m.CompressionRatio = &Amount{}
+			}
+			if err := m.CompressionRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21207,16 +24118,16 @@ func (m *ArtifactGC) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PodMetadata == nil {
-				m.PodMetadata = &Metadata{}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = &v1.Time{}
 			}
-			if err := m.PodMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21244,61 +24155,383 @@ func (m *ArtifactGC) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.GlobPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArtifactGCSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactGCSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactGCSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactsByNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Naming", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Naming == nil {
+				m.Naming = &ArtifactNaming{}
+			}
+			if err := m.Naming.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenamePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RenamePolicy == nil {
+				m.RenamePolicy = &ArtifactRenamePolicy{}
+			}
+			if err := m.RenamePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamedPipe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NamedPipe == nil {
+				m.NamedPipe = &NamedPipeArtifact{}
+			}
+			if err := m.NamedPipe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnpackTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactGC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactGC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactGC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = ArtifactGCStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodMetadata == nil {
+				m.PodMetadata = &Metadata{}
+			}
+			if err := m.PodMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactGCSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactGCSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactGCSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactsByNode", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21999,6 +25232,192 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromImageLabel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FromImageLabel == nil {
+				m.FromImageLabel = &ImageLabelSource{}
+			}
+			if err := m.FromImageLabel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistentVolumeClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PersistentVolumeClaim == nil {
+				m.PersistentVolumeClaim = &PersistentVolumeClaimSource{}
+			}
+			if err := m.PersistentVolumeClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactNaming) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactNaming: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactNaming: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = ArtifactNamingStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22318,6 +25737,88 @@ func (m *ArtifactPaths) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ArtifactRenamePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactRenamePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactRenamePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -24037,7 +27538,7 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
+				m.UsernameSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24073,7 +27574,7 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+				m.PasswordSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24453,7 +27954,7 @@ func (m *AzureBlobContainer) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.AccountKeySecret == nil {
-				m.AccountKeySecret = &v1.SecretKeySelector{}
+				m.AccountKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.AccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24661,6 +28162,38 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			}
 			m.Cap = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24741,7 +28274,7 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
+				m.UsernameSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24777,7 +28310,7 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+				m.PasswordSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24863,7 +28396,7 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ConfigMap == nil {
-				m.ConfigMap = &v1.ConfigMapKeySelector{}
+				m.ConfigMap = &v11.ConfigMapKeySelector{}
 			}
 			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24949,7 +28482,7 @@ func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ClientCertSecret == nil {
-				m.ClientCertSecret = &v1.SecretKeySelector{}
+				m.ClientCertSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.ClientCertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24985,7 +28518,7 @@ func (m *ClientCertAuth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ClientKeySecret == nil {
-				m.ClientKeySecret = &v1.SecretKeySelector{}
+				m.ClientKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.ClientKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -25828,7 +29361,7 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
+			m.VolumeMounts = append(m.VolumeMounts, v11.VolumeMount{})
 			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -26700,7 +30233,7 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.WorkflowMetadata == nil {
-				m.WorkflowMetadata = &v11.ObjectMeta{}
+				m.WorkflowMetadata = &v1.ObjectMeta{}
 			}
 			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -26885,7 +30418,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Active = append(m.Active, v1.ObjectReference{})
+			m.Active = append(m.Active, v11.ObjectReference{})
 			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -26920,7 +30453,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.LastScheduledTime == nil {
-				m.LastScheduledTime = &v11.Time{}
+				m.LastScheduledTime = &v1.Time{}
 			}
 			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -27644,6 +31177,114 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnSuccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OnSuccess == nil {
+				m.OnSuccess = &LifecycleHook{}
+			}
+			if err := m.OnSuccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OnFailure == nil {
+				m.OnFailure = &LifecycleHook{}
+			}
+			if err := m.OnFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithParamArtifact", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithParamArtifact == nil {
+				m.WithParamArtifact = &WithParamArtifact{}
+			}
+			if err := m.WithParamArtifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27984,6 +31625,70 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParameterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParameterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArtifactName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28005,7 +31710,7 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event) Unmarshal(dAtA []byte) error {
+func (m *EntrypointRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28028,15 +31733,15 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+			return fmt.Errorf("proto: EntrypointRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EntrypointRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28064,7 +31769,39 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
+			m.WorkflowTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28087,7 +31824,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
+func (m *Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28110,15 +31847,15 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28146,7 +31883,7 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.Selector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28169,7 +31906,7 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
+func (m *EventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28192,15 +31929,15 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28227,41 +31964,12 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Webhook == nil {
+				m.Webhook = &WebhookEventSource{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28284,7 +31992,7 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28307,17 +32015,17 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28327,28 +32035,278 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeScripts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.IncludeScripts = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCSArtifactRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28490,12 +32448,32 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ServiceAccountKeySecret == nil {
-				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
+				m.ServiceAccountKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseWorkloadIdentity", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseWorkloadIdentity = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28827,7 +32805,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
+				m.UsernameSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -28863,7 +32841,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+				m.PasswordSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -28899,7 +32877,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SSHPrivateKeySecret == nil {
-				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+				m.SSHPrivateKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29546,7 +33524,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.KrbCCacheSecret == nil {
-				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+				m.KrbCCacheSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29582,7 +33560,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.KrbKeytabSecret == nil {
-				m.KrbKeytabSecret = &v1.SecretKeySelector{}
+				m.KrbKeytabSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29682,7 +33660,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.KrbConfigConfigMap == nil {
-				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
+				m.KrbConfigConfigMap = &v11.ConfigMapKeySelector{}
 			}
 			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -30160,6 +34138,42 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLSConfig == nil {
+				m.TLSConfig = &HTTPTLSConfig{}
+			}
+			if err := m.TLSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30623,7 +34637,7 @@ func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SecretKeyRef == nil {
-				m.SecretKeyRef = &v1.SecretKeySelector{}
+				m.SecretKeyRef = &v11.SecretKeySelector{}
 			}
 			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -30650,6 +34664,76 @@ func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HTTPTLSConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPTLSConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPTLSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipVerify = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Header) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -30880,7 +34964,7 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Inputs) Unmarshal(dAtA []byte) error {
+func (m *ImageLabelSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30903,17 +34987,17 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageLabelSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageLabelSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30923,31 +35007,29 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30957,25 +35039,23 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30998,7 +35078,7 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Item) Unmarshal(dAtA []byte) error {
+func (m *ImageRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31021,17 +35101,17 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterWorkflowTemplate", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31041,79 +35121,27 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.ClusterWorkflowTemplate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LabelKeys) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LabelKeys: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelKeys: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31141,7 +35169,7 @@ func (m *LabelKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			m.ImageKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31164,7 +35192,7 @@ func (m *LabelKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LabelValueFrom) Unmarshal(dAtA []byte) error {
+func (m *Inputs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31187,17 +35215,17 @@ func (m *LabelValueFrom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LabelValueFrom: wiretype end group for non-group")
+			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31207,23 +35235,307 @@ func (m *LabelValueFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Item) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelValueFrom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelValueFrom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32927,7 +37239,7 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeFlag) Unmarshal(dAtA []byte) error {
+func (m *NamedPipeArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32950,17 +37262,17 @@ func (m *NodeFlag) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeFlag: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamedPipeArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeFlag: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamedPipeArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hooked", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32970,32 +37282,114 @@ func (m *NodeFlag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hooked = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retried", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Retried = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeFlag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeFlag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeFlag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hooked = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retried", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retried = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34132,6 +38526,58 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.TaskResultSynced = &b
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspend = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34344,7 +38790,7 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ClientIDSecret == nil {
-				m.ClientIDSecret = &v1.SecretKeySelector{}
+				m.ClientIDSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.ClientIDSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -34380,7 +38826,7 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ClientSecretSecret == nil {
-				m.ClientSecretSecret = &v1.SecretKeySelector{}
+				m.ClientSecretSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.ClientSecretSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -34416,7 +38862,7 @@ func (m *OAuth2Auth) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.TokenURLSecret == nil {
-				m.TokenURLSecret = &v1.SecretKeySelector{}
+				m.TokenURLSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.TokenURLSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -34976,7 +39422,7 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.AccessKeySecret == nil {
-				m.AccessKeySecret = &v1.SecretKeySelector{}
+				m.AccessKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -35012,7 +39458,7 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
+				m.SecretKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -35319,7 +39765,7 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Outputs) Unmarshal(dAtA []byte) error {
+func (m *OutputParameterRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35342,17 +39788,17 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutputParameterRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutputParameterRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35362,29 +39808,109 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Step = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Outputs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35411,16 +39937,16 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35430,28 +39956,62 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Result = &s
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Result = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35847,61 +40407,47 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			s := AnyString(dAtA[iNdEx:postIndex])
 			m.Description = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromOutput", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Plugin) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.FromOutput == nil {
+				m.FromOutput = &OutputParameterRef{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.FromOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultExpression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35911,24 +40457,23 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DefaultExpression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35951,7 +40496,7 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodGC) Unmarshal(dAtA []byte) error {
+func (m *PersistentVolumeClaimSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35974,15 +40519,15 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
+			return fmt.Errorf("proto: PersistentVolumeClaimSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PersistentVolumeClaimSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36010,13 +40555,13 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
+			m.ClaimName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36026,33 +40571,79 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LabelSelector == nil {
-				m.LabelSelector = &v11.LabelSelector{}
-			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SubPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Plugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDelayDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36062,23 +40653,24 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeleteDelayDuration = string(dAtA[iNdEx:postIndex])
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36101,7 +40693,7 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Prometheus) Unmarshal(dAtA []byte) error {
+func (m *PodGC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36124,15 +40716,15 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36160,11 +40752,11 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36191,14 +40783,297 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, &MetricLabel{})
-			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LabelSelector == nil {
+				m.LabelSelector = &v1.LabelSelector{}
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDelayDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeleteDelayDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainCount", wireType)
+			}
+			m.RetainCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetainCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepFailedPodDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeepFailedPodDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Preprocessor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Preprocessor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Preprocessor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Prometheus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, &MetricLabel{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36368,6 +41243,42 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PushInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PushInterval == nil {
+				m.PushInterval = &v1.Duration{}
+			}
+			if err := m.PushInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36748,6 +41659,98 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BackoffLimit = &v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitForResource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WaitForResource == nil {
+				m.WaitForResource = &WaitForResource{}
+			}
+			if err := m.WaitForResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36905,7 +41908,77 @@ func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+func (m *RetryOn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryOn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryOn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransientErrors", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TransientErrors = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37106,6 +42179,42 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			}
 			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryOn == nil {
+				m.RetryOn = &RetryOn{}
+			}
+			if err := m.RetryOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37565,7 +42674,7 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.AccessKeySecret == nil {
-				m.AccessKeySecret = &v1.SecretKeySelector{}
+				m.AccessKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -37601,7 +42710,7 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
+				m.SecretKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -37761,7 +42870,7 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.CASecret == nil {
-				m.CASecret = &v1.SecretKeySelector{}
+				m.CASecret = &v11.SecretKeySelector{}
 			}
 			if err := m.CASecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -37797,7 +42906,7 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SessionTokenSecret == nil {
-				m.SessionTokenSecret = &v1.SecretKeySelector{}
+				m.SessionTokenSecret = &v11.SecretKeySelector{}
 			}
 			if err := m.SessionTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -37967,7 +43076,7 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ServerSideCustomerKeySecret == nil {
-				m.ServerSideCustomerKeySecret = &v1.SecretKeySelector{}
+				m.ServerSideCustomerKeySecret = &v11.SecretKeySelector{}
 			}
 			if err := m.ServerSideCustomerKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -38088,173 +43197,9 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 			}
 			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreHolding: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreHolding: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Semaphore = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38281,18 +43226,18 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMapKeyRef == nil {
-				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			if m.ImageRef == nil {
+				m.ImageRef = &ImageRef{}
 			}
-			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ImageRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preprocessors", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38302,29 +43247,31 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Preprocessors = append(m.Preprocessors, Preprocessor{})
+			if err := m.Preprocessors[len(m.Preprocessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interpreter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38334,27 +43281,23 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Database == nil {
-				m.Database = &SyncDatabaseRef{}
-			}
-			if err := m.Database.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Interpreter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38377,7 +43320,7 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
+func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38400,17 +43343,17 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SemaphoreHolding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SemaphoreHolding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38420,31 +43363,29 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holding = append(m.Holding, SemaphoreHolding{})
-			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Semaphore = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38454,25 +43395,23 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = append(m.Waiting, SemaphoreHolding{})
-			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38495,7 +43434,7 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sequence) Unmarshal(dAtA []byte) error {
+func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38518,15 +43457,15 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38553,18 +43492,18 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Count == nil {
-				m.Count = &intstr.IntOrString{}
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v11.ConfigMapKeySelector{}
 			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38574,31 +43513,27 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Start == nil {
-				m.Start = &intstr.IntOrString{}
-			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38625,16 +43560,16 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.End == nil {
-				m.End = &intstr.IntOrString{}
+			if m.Database == nil {
+				m.Database = &SyncDatabaseRef{}
 			}
-			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Database.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fairness", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38662,7 +43597,7 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = string(dAtA[iNdEx:postIndex])
+			m.Fairness = SemaphoreFairness(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38685,7 +43620,7 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopStrategy) Unmarshal(dAtA []byte) error {
+func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38708,17 +43643,17 @@ func (m *StopStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: SemaphoreStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SemaphoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38728,23 +43663,59 @@ func (m *StopStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.Holding = append(m.Holding, SemaphoreHolding{})
+			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waiting = append(m.Waiting, SemaphoreHolding{})
+			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38767,7 +43738,7 @@ func (m *StopStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Submit) Unmarshal(dAtA []byte) error {
+func (m *Sequence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38790,15 +43761,15 @@ func (m *Submit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Submit: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Submit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38825,13 +43796,16 @@ func (m *Submit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Count == nil {
+				m.Count = &intstr.IntOrString{}
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38858,16 +43832,16 @@ func (m *Submit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Arguments == nil {
-				m.Arguments = &Arguments{}
+			if m.Start == nil {
+				m.Start = &intstr.IntOrString{}
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38894,63 +43868,16 @@ func (m *Submit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.End == nil {
+				m.End = &intstr.IntOrString{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SubmitOpts: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmitOpts: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38978,11 +43905,359 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Format = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenerateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Submit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Submit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Submit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Arguments == nil {
+				m.Arguments = &Arguments{}
+			}
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitOpts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitOpts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenerateName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39210,7 +44485,7 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.OwnerReference == nil {
-				m.OwnerReference = &v11.OwnerReference{}
+				m.OwnerReference = &v1.OwnerReference{}
 			}
 			if err := m.OwnerReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -39300,6 +44575,38 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Priority = &v
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParametersFromConfigMap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParametersFromConfigMap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40311,7 +45618,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
+				m.Affinity = &v11.Affinity{}
 			}
 			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -40435,7 +45742,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Container == nil {
-				m.Container = &v1.Container{}
+				m.Container = &v11.Container{}
 			}
 			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -40614,7 +45921,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
+			m.Volumes = append(m.Volumes, v11.Volume{})
 			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -40844,7 +46151,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			m.Tolerations = append(m.Tolerations, v11.Toleration{})
 			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -40974,7 +46281,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAliases = append(m.HostAliases, v1.HostAlias{})
+			m.HostAliases = append(m.HostAliases, v11.HostAlias{})
 			if err := m.HostAliases[len(m.HostAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -41009,7 +46316,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
+				m.SecurityContext = &v11.PodSecurityContext{}
 			}
 			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -41536,6 +46843,248 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			}
 			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNSPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := k8s_io_api_core_v1.DNSPolicy(dAtA[iNdEx:postIndex])
+			m.DNSPolicy = &s
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNSConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DNSConfig == nil {
+				m.DNSConfig = &v11.PodDNSConfig{}
+			}
+			if err := m.DNSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RuntimeClassName = &s
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceClaims = append(m.ResourceClaims, v11.PodResourceClaim{})
+			if err := m.ResourceClaims[len(m.ResourceClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverrideCommand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OverrideCommand = append(m.OverrideCommand, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowOverrideCommand", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowOverrideCommand = bool(v != 0)
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopologySpreadConstraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopologySpreadConstraints = append(m.TopologySpreadConstraints, v11.TopologySpreadConstraint{})
+			if err := m.TopologySpreadConstraints[len(m.TopologySpreadConstraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoscaleResources", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoscaleResources = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -42197,67 +47746,17 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ConfigMapKeyRef == nil {
-				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+				m.ConfigMapKeyRef = &v11.ConfigMapKeySelector{}
 			}
 			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Version) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Version: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAnnotation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42267,27 +47766,177 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if m.FromAnnotation == nil {
+				m.FromAnnotation = &AnnotationValueFrom{}
+			}
+			if err := m.FromAnnotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = ValueFromFormat(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArtifactName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Version) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42612,7 +48261,7 @@ func (m *VolumeClaimGC) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Workflow) Unmarshal(dAtA []byte) error {
+func (m *WaitForResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42635,15 +48284,983 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Workflow: wiretype end group for non-group")
+			return fmt.Errorf("proto: WaitForResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Workflow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WaitForResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &v1.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollInterval == nil {
+				m.PollInterval = &v1.Duration{}
+			}
+			if err := m.PollInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebhookEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebhookEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebhookEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretRef == nil {
+				m.SecretRef = &v11.SecretKeySelector{}
+			}
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithParamArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithParamArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithParamArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Step = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artifact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Workflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Workflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Workflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowArtifactGCTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowArtifactGCTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowArtifactGCTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowArtifactGCTaskList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowArtifactGCTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, WorkflowArtifactGCTask{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowEventBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowEventBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowEventBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowEventBindingList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowEventBindingList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowEventBindingList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42670,46 +49287,13 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42736,7 +49320,8 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, WorkflowEventBinding{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -42761,7 +49346,7 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowArtifactGCTask) Unmarshal(dAtA []byte) error {
+func (m *WorkflowEventBindingRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42784,83 +49369,17 @@ func (m *WorkflowArtifactGCTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowArtifactGCTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowEventBindingRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowArtifactGCTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowEventBindingRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42870,24 +49389,23 @@ func (m *WorkflowArtifactGCTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42910,7 +49428,7 @@ func (m *WorkflowArtifactGCTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
+func (m *WorkflowEventBindingSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42933,15 +49451,15 @@ func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowArtifactGCTaskList: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowEventBindingSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowArtifactGCTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowEventBindingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42968,13 +49486,13 @@ func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43001,8 +49519,10 @@ func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, WorkflowArtifactGCTask{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Submit == nil {
+				m.Submit = &Submit{}
+			}
+			if err := m.Submit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -43027,7 +49547,7 @@ func (m *WorkflowArtifactGCTaskList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowEventBinding) Unmarshal(dAtA []byte) error {
+func (m *WorkflowEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43050,10 +49570,10 @@ func (m *WorkflowEventBinding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowEventBinding: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowEventBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -43143,7 +49663,7 @@ func (m *WorkflowEventBinding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowEventBindingList) Unmarshal(dAtA []byte) error {
+func (m *WorkflowEventSourceList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43166,10 +49686,10 @@ func (m *WorkflowEventBindingList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowEventBindingList: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowEventSourceList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowEventBindingList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowEventSourceList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -43234,7 +49754,7 @@ func (m *WorkflowEventBindingList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, WorkflowEventBinding{})
+			m.Items = append(m.Items, WorkflowEventSource{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -43260,7 +49780,7 @@ func (m *WorkflowEventBindingList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkflowEventBindingSpec) Unmarshal(dAtA []byte) error {
+func (m *WorkflowEventSourceSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43283,15 +49803,15 @@ func (m *WorkflowEventBindingSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowEventBindingSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowEventSourceSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowEventBindingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowEventSourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43318,13 +49838,13 @@ func (m *WorkflowEventBindingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43351,13 +49871,43 @@ func (m *WorkflowEventBindingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Submit == nil {
-				m.Submit = &Submit{}
-			}
-			if err := m.Submit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bindings = append(m.Bindings, WorkflowEventBindingRef{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44064,6 +50614,76 @@ func (m *WorkflowMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkflowScopedAntiAffinity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowScopedAntiAffinity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowScopedAntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowScoped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WorkflowScoped = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -44253,7 +50873,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
+			m.Volumes = append(m.Volumes, v11.Volume{})
 			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -44287,7 +50907,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeClaimTemplates = append(m.VolumeClaimTemplates, v1.PersistentVolumeClaim{})
+			m.VolumeClaimTemplates = append(m.VolumeClaimTemplates, v11.PersistentVolumeClaim{})
 			if err := m.VolumeClaimTemplates[len(m.VolumeClaimTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -44526,7 +51146,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
+				m.Affinity = &v11.Affinity{}
 			}
 			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -44561,7 +51181,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			m.Tolerations = append(m.Tolerations, v11.Toleration{})
 			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -44595,7 +51215,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
+			m.ImagePullSecrets = append(m.ImagePullSecrets, v11.LocalObjectReference{})
 			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -44684,7 +51304,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.DNSConfig == nil {
-				m.DNSConfig = &v1.PodDNSConfig{}
+				m.DNSConfig = &v11.PodDNSConfig{}
 			}
 			if err := m.DNSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -44891,7 +51511,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAliases = append(m.HostAliases, v1.HostAlias{})
+			m.HostAliases = append(m.HostAliases, v11.HostAlias{})
 			if err := m.HostAliases[len(m.HostAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -44926,7 +51546,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
+				m.SecurityContext = &v11.PodSecurityContext{}
 			}
 			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -45427,109 +52047,382 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hooks == nil {
-				m.Hooks = make(LifecycleHooks)
+			if m.Hooks == nil {
+				m.Hooks = make(LifecycleHooks)
+			}
+			var mapkey LifecycleEvent
+			mapvalue := &LifecycleHook{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = LifecycleEvent(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LifecycleHook{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hooks[LifecycleEvent(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowMetadata == nil {
+				m.WorkflowMetadata = &WorkflowMetadata{}
+			}
+			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactGC == nil {
+				m.ArtifactGC = &WorkflowLevelArtifactGC{}
+			}
+			if err := m.ArtifactGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RuntimeClassName = &s
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntrypointRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EntrypointRef == nil {
+				m.EntrypointRef = &EntrypointRef{}
+			}
+			if err := m.EntrypointRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlinePriorityClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadlinePriorityClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlinePriorityEscalationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadlinePriorityEscalationThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodAntiAffinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodAntiAffinity == nil {
+				m.PodAntiAffinity = &WorkflowScopedAntiAffinity{}
 			}
-			var mapkey LifecycleEvent
-			mapvalue := &LifecycleHook{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.PodAntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedNamespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = LifecycleEvent(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &LifecycleHook{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Hooks[LifecycleEvent(mapkey)] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedNamespaces = append(m.AllowedNamespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 42:
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceClaims", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45556,16 +52449,46 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowMetadata == nil {
-				m.WorkflowMetadata = &WorkflowMetadata{}
-			}
-			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ResourceClaims = append(m.ResourceClaims, v11.PodResourceClaim{})
+			if err := m.ResourceClaims[len(m.ResourceClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 43:
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGC", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNameFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodNameFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTopologySpreadConstraints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45592,10 +52515,8 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArtifactGC == nil {
-				m.ArtifactGC = &WorkflowLevelArtifactGC{}
-			}
-			if err := m.ArtifactGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PodTopologySpreadConstraints = append(m.PodTopologySpreadConstraints, v11.TopologySpreadConstraint{})
+			if err := m.PodTopologySpreadConstraints[len(m.PodTopologySpreadConstraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -45969,7 +52890,7 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PersistentVolumeClaims = append(m.PersistentVolumeClaims, v1.Volume{})
+			m.PersistentVolumeClaims = append(m.PersistentVolumeClaims, v11.Volume{})
 			if err := m.PersistentVolumeClaims[len(m.PersistentVolumeClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -46628,6 +53549,78 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.TaskResultsCompletionStatus[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageArtifactCompressionRatio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AverageArtifactCompressionRatio == nil {
+				m.AverageArtifactCompressionRatio = &Amount{}
+			}
+			if err := m.AverageArtifactCompressionRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueuedAt == nil {
+				m.QueuedAt = &v1.Time{}
+			}
+			if err := m.QueuedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47178,6 +54171,42 @@ func (m *WorkflowStep) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithParamArtifact", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithParamArtifact == nil {
+				m.WithParamArtifact = &WithParamArtifact{}
+			}
+			if err := m.WithParamArtifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36,6 +36,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&WorkflowList{},
 		&WorkflowEventBinding{},
 		&WorkflowEventBindingList{},
+		&WorkflowEventSource{},
+		&WorkflowEventSourceList{},
 		&WorkflowTemplate{},
 		&WorkflowTemplateList{},
 		&CronWorkflow{},
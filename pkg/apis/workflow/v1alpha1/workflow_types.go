@@ -95,12 +95,15 @@ const (
 	ArtifactGCOnWorkflowCompletion ArtifactGCStrategy = "OnWorkflowCompletion"
 	ArtifactGCOnWorkflowDeletion   ArtifactGCStrategy = "OnWorkflowDeletion"
 	ArtifactGCNever                ArtifactGCStrategy = "Never"
-	ArtifactGCStrategyUndefined    ArtifactGCStrategy = ""
+	// ArtifactGCDryRun reports the artifacts that would be deleted on workflow completion, without deleting them
+	ArtifactGCDryRun            ArtifactGCStrategy = "DryRun"
+	ArtifactGCStrategyUndefined ArtifactGCStrategy = ""
 )
 
 var AnyArtifactGCStrategy = map[ArtifactGCStrategy]bool{
 	ArtifactGCOnWorkflowCompletion: true,
 	ArtifactGCOnWorkflowDeletion:   true,
+	ArtifactGCDryRun:               true,
 }
 
 // PodGCStrategy is the strategy when to delete completed pods for GC.
@@ -111,6 +114,7 @@ func (s PodGCStrategy) IsValid() bool {
 	case PodGCOnPodNone,
 		PodGCOnPodCompletion,
 		PodGCOnPodSuccess,
+		PodGCOnPodFailure,
 		PodGCOnWorkflowCompletion,
 		PodGCOnWorkflowSuccess:
 		return true
@@ -123,6 +127,7 @@ const (
 	PodGCOnPodNone            PodGCStrategy = ""
 	PodGCOnPodCompletion      PodGCStrategy = "OnPodCompletion"
 	PodGCOnPodSuccess         PodGCStrategy = "OnPodSuccess"
+	PodGCOnPodFailure         PodGCStrategy = "OnPodFailure"
 	PodGCOnWorkflowCompletion PodGCStrategy = "OnWorkflowCompletion"
 	PodGCOnWorkflowSuccess    PodGCStrategy = "OnWorkflowSuccess"
 )
@@ -316,14 +321,25 @@ type WorkflowSpec struct {
 	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,10,opt,name=nodeSelector"`
 
 	// Affinity sets the scheduling constraints for all pods in the workflow.
-	// Can be overridden by an affinity specified in the template
+	// It is merged with any affinity specified in the template: a node must satisfy the required node affinity of both,
+	// and the other terms of both are appended together.
 	Affinity *apiv1.Affinity `json:"affinity,omitempty" protobuf:"bytes,11,opt,name=affinity"`
 
+	// PodAntiAffinity adds pod anti-affinity rules to all pods in the workflow, on top of the affinity above.
+	PodAntiAffinity *WorkflowScopedAntiAffinity `json:"podAntiAffinity,omitempty" protobuf:"bytes,48,opt,name=podAntiAffinity"`
+
 	// Tolerations to apply to workflow pods.
 	// +patchStrategy=merge
 	// +patchMergeKey=key
 	Tolerations []apiv1.Toleration `json:"tolerations,omitempty" patchStrategy:"merge" patchMergeKey:"key" protobuf:"bytes,12,opt,name=tolerations"`
 
+	// PodTopologySpreadConstraints describes how all pods in the workflow are spread across topology domains.
+	// They are combined with the constraints specified in the template, which take precedence for the same
+	// topologyKey and whenUnsatisfiable.
+	// +patchStrategy=merge
+	// +patchMergeKey=topologyKey
+	PodTopologySpreadConstraints []apiv1.TopologySpreadConstraint `json:"podTopologySpreadConstraints,omitempty" patchStrategy:"merge" patchMergeKey:"topologyKey" protobuf:"bytes,52,rep,name=podTopologySpreadConstraints"`
+
 	// ImagePullSecrets is a list of references to secrets in the same namespace to use for pulling any images
 	// in pods that reference this ServiceAccount. ImagePullSecrets are distinct from Secrets because Secrets
 	// can be mounted in the pod, but ImagePullSecrets are only accessed by the kubelet.
@@ -378,10 +394,39 @@ type WorkflowSpec struct {
 	// PriorityClassName to apply to workflow pods.
 	PodPriorityClassName string `json:"podPriorityClassName,omitempty" protobuf:"bytes,23,opt,name=podPriorityClassName"`
 
+	// DeadlinePriorityClassName is the PriorityClassName applied to pods created once the workflow is within
+	// deadlinePriorityEscalationThreshold of its activeDeadlineSeconds, so critical workflows are less likely to be preempted.
+	// It takes precedence over podPriorityClassName and the template's priorityClassName.
+	// Pods that already exist keep their priority class, which Kubernetes does not allow to be changed.
+	DeadlinePriorityClassName string `json:"deadlinePriorityClassName,omitempty" protobuf:"bytes,46,opt,name=deadlinePriorityClassName"`
+
+	// DeadlinePriorityEscalationThreshold is the remaining time before the workflow's deadline below which
+	// deadlinePriorityClassName is applied. Default unit is seconds, but could also be a duration (e.g. "2m", "1h").
+	DeadlinePriorityEscalationThreshold string `json:"deadlinePriorityEscalationThreshold,omitempty" protobuf:"bytes,47,opt,name=deadlinePriorityEscalationThreshold"`
+
+	// RuntimeClassName to apply to workflow pods, e.g. to run them in gVisor or Kata Containers.
+	// Will be overridden if the template's runtimeClassName is set.
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty" protobuf:"bytes,44,opt,name=runtimeClassName"`
+
+	// EntrypointRef uses a template of a WorkflowTemplate as the entrypoint.
+	// The spec of the WorkflowTemplate is merged under this spec, so templates may still be defined or overridden here.
+	// It may not be used together with entrypoint or workflowTemplateRef.
+	EntrypointRef *EntrypointRef `json:"entrypointRef,omitempty" protobuf:"bytes,45,opt,name=entrypointRef"`
+
 	// +patchStrategy=merge
 	// +patchMergeKey=ip
 	HostAliases []apiv1.HostAlias `json:"hostAliases,omitempty" patchStrategy:"merge" patchMergeKey:"ip" protobuf:"bytes,25,opt,name=hostAliases"`
 
+	// ResourceClaims are the Dynamic Resource Allocation claims added to every pod of the workflow, which containers use
+	// through resources.claims. They are merged by name with each template's resourceClaims, the template's claim winning.
+	// Requires the ARGO_DYNAMIC_RESOURCE_ALLOCATION environment variable to be set to true on the controller.
+	// +patchStrategy=merge
+	// +patchMergeKey=name
+	// +listType=map
+	// +listMapKey=name
+	ResourceClaims []apiv1.PodResourceClaim `json:"resourceClaims,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,50,rep,name=resourceClaims"`
+
 	// SecurityContext holds pod-level security attributes and common container settings.
 	// Optional: Defaults to empty.  See type description for default values of each field.
 	// +optional
@@ -391,6 +436,12 @@ type WorkflowSpec struct {
 	// container fields which are not strings (e.g. resource limits).
 	PodSpecPatch string `json:"podSpecPatch,omitempty" protobuf:"bytes,27,opt,name=podSpecPatch"`
 
+	// PodNameFormat is the format of the names of the workflow's pods, e.g. "acme-{{workflow.name}}-{{template.name}}".
+	// The {{workflow.name}}, {{template.name}} and {{node.id}} tokens are replaced, and a hash of the node name is
+	// appended so that each pod has its own name. Names are truncated to 63 characters.
+	// Defaults to the pod name version of the controller.
+	PodNameFormat string `json:"podNameFormat,omitempty" protobuf:"bytes,51,opt,name=podNameFormat"`
+
 	// PodDisruptionBudget holds the number of concurrent disruptions that you allow for Workflow's Pods.
 	// Controller will automatically add the selector with workflow name, if selector is empty.
 	// Optional: Defaults to empty.
@@ -400,7 +451,8 @@ type WorkflowSpec struct {
 	// Metrics are a list of metrics emitted from this Workflow
 	Metrics *Metrics `json:"metrics,omitempty" protobuf:"bytes,32,opt,name=metrics"`
 
-	// Shutdown will shutdown the workflow according to its ShutdownStrategy
+	// Shutdown will shutdown the workflow according to its ShutdownStrategy.
+	// A workflow that is still draining when it reaches activeDeadlineSeconds is terminated.
 	Shutdown ShutdownStrategy `json:"shutdown,omitempty" protobuf:"bytes,33,opt,name=shutdown,casttype=ShutdownStrategy"`
 
 	// WorkflowTemplateRef holds a reference to a WorkflowTemplate for execution
@@ -434,6 +486,11 @@ type WorkflowSpec struct {
 	// ArtifactGC describes the strategy to use when deleting artifacts from completed or deleted workflows (applies to all output Artifacts
 	// unless Artifact.ArtifactGC is specified, which overrides this)
 	ArtifactGC *WorkflowLevelArtifactGC `json:"artifactGC,omitempty" protobuf:"bytes,43,opt,name=artifactGC"`
+
+	// AllowedNamespaces lists the namespaces whose workflows may reference this ClusterWorkflowTemplate.
+	// Only used by ClusterWorkflowTemplates. Empty allows all namespaces.
+	// +listType=atomic
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty" protobuf:"bytes,49,rep,name=allowedNamespaces"`
 }
 
 type LabelValueFrom struct {
@@ -464,7 +521,23 @@ func (wfs *WorkflowSpec) HasExitHook() bool {
 	return (wfs.Hooks != nil && wfs.Hooks.HasExitHook()) || wfs.OnExit != ""
 }
 
+// GetWorkflowTemplateRef returns the WorkflowTemplate whose spec this spec is merged on top of, if any.
+func (wfs *WorkflowSpec) GetWorkflowTemplateRef() *WorkflowTemplateRef {
+	if wfs.EntrypointRef != nil {
+		return &WorkflowTemplateRef{Name: wfs.EntrypointRef.WorkflowTemplate}
+	}
+	return wfs.WorkflowTemplateRef
+}
+
 // GetVolumeClaimGC returns the VolumeClaimGC that was defined in the workflow spec.  If none was provided, a default value is returned.
+// GetDeadlinePriorityEscalationThreshold returns the parsed deadlinePriorityEscalationThreshold, or zero if it is not set.
+func (wfs *WorkflowSpec) GetDeadlinePriorityEscalationThreshold() (time.Duration, error) {
+	if wfs.DeadlinePriorityEscalationThreshold == "" {
+		return 0, nil
+	}
+	return ParseStringToDuration(wfs.DeadlinePriorityEscalationThreshold)
+}
+
 func (wfs WorkflowSpec) GetVolumeClaimGC() *VolumeClaimGC {
 	// If no volumeClaimGC strategy was provided, we default to the equivalent of "OnSuccess"
 	// to match the existing behavior for back-compat
@@ -495,7 +568,7 @@ func (w *Workflow) GetSemaphoreKeys() []string {
 	keyMap := make(map[string]bool)
 	namespace := w.Namespace
 	var templates []Template
-	if w.Spec.WorkflowTemplateRef == nil {
+	if w.Spec.GetWorkflowTemplateRef() == nil {
 		templates = w.Spec.Templates
 		if w.Spec.Synchronization != nil {
 			for _, configMapRef := range w.Spec.Synchronization.getSemaphoreConfigMapRefs() {
@@ -533,7 +606,9 @@ type ShutdownStrategy string
 const (
 	ShutdownStrategyTerminate ShutdownStrategy = "Terminate"
 	ShutdownStrategyStop      ShutdownStrategy = "Stop"
-	ShutdownStrategyNone      ShutdownStrategy = ""
+	// ShutdownStrategyDrain stops new pods from being created, but lets running pods complete before stopping the workflow
+	ShutdownStrategyDrain ShutdownStrategy = "Drain"
+	ShutdownStrategyNone  ShutdownStrategy = ""
 )
 
 func (s ShutdownStrategy) Enabled() bool {
@@ -544,13 +619,18 @@ func (s ShutdownStrategy) ShouldExecute(isOnExitPod bool) bool {
 	switch s {
 	case ShutdownStrategyTerminate:
 		return false
-	case ShutdownStrategyStop:
+	case ShutdownStrategyStop, ShutdownStrategyDrain:
 		return isOnExitPod
 	default:
 		return true
 	}
 }
 
+// ShouldTerminate returns whether a pod that has already been created should be terminated
+func (s ShutdownStrategy) ShouldTerminate(isOnExitPod bool) bool {
+	return s != ShutdownStrategyDrain && !s.ShouldExecute(isOnExitPod)
+}
+
 // swagger:ignore
 type ParallelSteps struct {
 	// Note: the `json:"steps"` part exists to workaround kubebuilder limitations.
@@ -626,7 +706,7 @@ type Template struct {
 	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,7,opt,name=nodeSelector"`
 
 	// Affinity sets the pod's scheduling constraints
-	// Overrides the affinity set at the workflow level (if any)
+	// Merged with the affinity set at the workflow level (if any)
 	Affinity *apiv1.Affinity `json:"affinity,omitempty" protobuf:"bytes,8,opt,name=affinity"`
 
 	// Metdata sets the pods's metadata, i.e. annotations and labels
@@ -647,6 +727,13 @@ type Template struct {
 	// Script runs a portion of code against an interpreter
 	Script *ScriptTemplate `json:"script,omitempty" protobuf:"bytes,13,opt,name=script"`
 
+	// OverrideCommand is prepended to the command of the main containers, so that they can be run by a wrapper such
+	// as `strace` or `perf`. It requires allowOverrideCommand to be true.
+	OverrideCommand []string `json:"overrideCommand,omitempty" protobuf:"bytes,49,rep,name=overrideCommand"`
+
+	// AllowOverrideCommand must be true for overrideCommand to be used
+	AllowOverrideCommand bool `json:"allowOverrideCommand,omitempty" protobuf:"varint,50,opt,name=allowOverrideCommand"`
+
 	// Resource template subtype which can run k8s resources
 	Resource *ResourceTemplate `json:"resource,omitempty" protobuf:"bytes,14,opt,name=resource"`
 
@@ -712,6 +799,12 @@ type Template struct {
 	// +patchMergeKey=key
 	Tolerations []apiv1.Toleration `json:"tolerations,omitempty" patchStrategy:"merge" patchMergeKey:"key" protobuf:"bytes,24,opt,name=tolerations"`
 
+	// TopologySpreadConstraints describes how the pod is spread across topology domains.
+	// Combined with the podTopologySpreadConstraints set at the workflow level (if any)
+	// +patchStrategy=merge
+	// +patchMergeKey=topologyKey
+	TopologySpreadConstraints []apiv1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty" patchStrategy:"merge" patchMergeKey:"topologyKey" protobuf:"bytes,51,rep,name=topologySpreadConstraints"`
+
 	// If specified, the pod will be dispatched by specified scheduler.
 	// Or it will be dispatched by workflow scope scheduler if specified.
 	// If neither specified, the pod will be dispatched by default scheduler.
@@ -721,6 +814,10 @@ type Template struct {
 	// PriorityClassName to apply to workflow pods.
 	PriorityClassName string `json:"priorityClassName,omitempty" protobuf:"bytes,26,opt,name=priorityClassName"`
 
+	// RuntimeClassName to apply to the pod, overriding the workflow's spec.runtimeClassName.
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty" protobuf:"bytes,47,opt,name=runtimeClassName"`
+
 	// ServiceAccountName to apply to workflow pods
 	ServiceAccountName string `json:"serviceAccountName,omitempty" protobuf:"bytes,28,opt,name=serviceAccountName"`
 
@@ -736,6 +833,22 @@ type Template struct {
 	// +patchMergeKey=ip
 	HostAliases []apiv1.HostAlias `json:"hostAliases,omitempty" patchStrategy:"merge" patchMergeKey:"ip" protobuf:"bytes,29,opt,name=hostAliases"`
 
+	// ResourceClaims are the Dynamic Resource Allocation claims added to the template's pod, which containers use
+	// through resources.claims. They override the workflow's spec.resourceClaims of the same name.
+	// Requires the ARGO_DYNAMIC_RESOURCE_ALLOCATION environment variable to be set to true on the controller.
+	// +patchStrategy=merge
+	// +patchMergeKey=name
+	// +listType=map
+	// +listMapKey=name
+	ResourceClaims []apiv1.PodResourceClaim `json:"resourceClaims,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,48,rep,name=resourceClaims"`
+
+	// DNSPolicy sets the DNS policy for the pod, overriding the workflow's spec.dnsPolicy.
+	// Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.
+	DNSPolicy *apiv1.DNSPolicy `json:"dnsPolicy,omitempty" protobuf:"bytes,45,opt,name=dnsPolicy"`
+
+	// DNSConfig defines the DNS parameters of the pod, overriding the workflow's spec.dnsConfig.
+	DNSConfig *apiv1.PodDNSConfig `json:"dnsConfig,omitempty" protobuf:"bytes,46,opt,name=dnsConfig"`
+
 	// SecurityContext holds pod-level security attributes and common container settings.
 	// Optional: Defaults to empty.  See type description for default values of each field.
 	// +optional
@@ -745,6 +858,11 @@ type Template struct {
 	// container fields which are not strings (e.g. resource limits).
 	PodSpecPatch string `json:"podSpecPatch,omitempty" protobuf:"bytes,31,opt,name=podSpecPatch"`
 
+	// AutoscaleResources sets the resources of the main containers from the recommendation of the
+	// VerticalPodAutoscaler in the namespace of the workflow that is labelled workflows.argoproj.io/template=<template name>.
+	// Requests are set to the target of the recommendation, and any limits that are set to its upper bound.
+	AutoscaleResources bool `json:"autoscaleResources,omitempty" protobuf:"varint,52,opt,name=autoscaleResources"`
+
 	// Metrics are a list of metrics emitted from this template
 	Metrics *Metrics `json:"metrics,omitempty" protobuf:"bytes,35,opt,name=metrics"`
 
@@ -826,6 +944,14 @@ func (tmpl *Template) GetOutputs() *Outputs {
 	return nil
 }
 
+// GetArtifactByName returns the output artifact of the template with the name, or else its input artifact
+func (tmpl *Template) GetArtifactByName(name string) *Artifact {
+	if art := tmpl.Outputs.GetArtifactByName(name); art != nil {
+		return art
+	}
+	return tmpl.Inputs.GetArtifactByName(name)
+}
+
 func (tmpl *Template) GetAnnotations() map[string]string {
 	if tmpl != nil {
 		return tmpl.Annotations
@@ -891,6 +1017,11 @@ type Parameter struct {
 	// Default is the default value to use for an input parameter if a value was not supplied
 	Default *AnyString `json:"default,omitempty" protobuf:"bytes,2,opt,name=default"`
 
+	// DefaultExpression is an expression (https://github.com/expr-lang/expr) that is evaluated to give the value of an
+	// input parameter that was not supplied, when the template is executed. It can reference global variables,
+	// e.g. `sprig.trunc(10, workflow.creationTimestamp.RFC3339)`, and cannot be used together with default.
+	DefaultExpression string `json:"defaultExpression,omitempty" protobuf:"bytes,9,opt,name=defaultExpression"`
+
 	// Value is the literal value to use for the parameter.
 	// If specified in the context of an input parameter, any passed values take precedence over the specified value
 	Value *AnyString `json:"value,omitempty" protobuf:"bytes,3,opt,name=value"`
@@ -907,6 +1038,20 @@ type Parameter struct {
 
 	// Description is the parameter description
 	Description *AnyString `json:"description,omitempty" protobuf:"bytes,7,opt,name=description"`
+
+	// FromOutput takes the value of an output parameter of a previous step or DAG task.
+	// It is a typed alternative to '{{steps.STEP.outputs.parameters.PARAM}}' that is checked when the workflow is validated,
+	// and is only allowed in the arguments of a step or DAG task.
+	FromOutput *OutputParameterRef `json:"fromOutput,omitempty" protobuf:"bytes,8,opt,name=fromOutput"`
+}
+
+// OutputParameterRef references an output parameter of a previous step or DAG task
+type OutputParameterRef struct {
+	// Step is the name of the step, or DAG task, that produces the output parameter
+	Step string `json:"step" protobuf:"bytes,1,opt,name=step"`
+
+	// Parameter is the name of the output parameter
+	Parameter string `json:"parameter" protobuf:"bytes,2,opt,name=parameter"`
 }
 
 // ValueFrom describes a location in which to obtain the value to a parameter
@@ -917,9 +1062,14 @@ type ValueFrom struct {
 	// JSONPath of a resource to retrieve an output parameter value from in resource templates
 	JSONPath string `json:"jsonPath,omitempty" protobuf:"bytes,2,opt,name=jsonPath"`
 
-	// JQFilter expression against the resource object in resource templates
+	// JQFilter expression against the resource object in resource templates,
+	// or against the JSON artifact named by artifactName in container and script templates
 	JQFilter string `json:"jqFilter,omitempty" protobuf:"bytes,3,opt,name=jqFilter"`
 
+	// ArtifactName is the name of an input or output artifact of the template containing a JSON document,
+	// to which the jqFilter is applied to get the output parameter value in container and script templates
+	ArtifactName string `json:"artifactName,omitempty" protobuf:"bytes,12,opt,name=artifactName"`
+
 	// Selector (https://github.com/expr-lang/expr) that is evaluated against the event to get the value of the parameter. E.g. `payload.message`
 	Event string `json:"event,omitempty" protobuf:"bytes,7,opt,name=event"`
 
@@ -938,8 +1088,25 @@ type ValueFrom struct {
 
 	// Expression, if defined, is evaluated to specify the value for the parameter
 	Expression string `json:"expression,omitempty" protobuf:"bytes,8,rep,name=expression"`
+
+	// FromAnnotation reads the output parameter value from an annotation on the pod in container templates
+	FromAnnotation *AnnotationValueFrom `json:"fromAnnotation,omitempty" protobuf:"bytes,10,opt,name=fromAnnotation"`
+
+	// Format is a transformation applied to an output parameter value in container templates before it is saved.
+	// One of: trim (remove leading and trailing whitespace), base64 (standard base64 encoding), base64url (URL-safe base64 encoding)
+	// +kubebuilder:validation:Enum="";trim;base64;base64url
+	Format ValueFromFormat `json:"format,omitempty" protobuf:"bytes,11,opt,name=format,casttype=ValueFromFormat"`
 }
 
+// ValueFromFormat is a transformation applied to an output parameter value
+type ValueFromFormat string
+
+const (
+	ValueFromFormatTrim      ValueFromFormat = "trim"
+	ValueFromFormatBase64    ValueFromFormat = "base64"
+	ValueFromFormatBase64URL ValueFromFormat = "base64url"
+)
+
 func (p *Parameter) HasValue() bool {
 	return p.Value != nil || p.Default != nil || p.ValueFrom != nil
 }
@@ -954,6 +1121,12 @@ func (p *Parameter) GetValue() string {
 	return ""
 }
 
+// AnnotationValueFrom selects a pod annotation to retrieve an output parameter value from
+type AnnotationValueFrom struct {
+	// Annotation is the key of the pod annotation, e.g. `my.annotation`
+	Annotation string `json:"annotation" protobuf:"bytes,1,opt,name=annotation"`
+}
+
 // SuppliedValueFrom is a placeholder for a value to be filled in directly, either through the CLI, API, etc.
 type SuppliedValueFrom struct{}
 
@@ -965,6 +1138,15 @@ type Artifact struct {
 	// Path is the container path to the artifact
 	Path string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
 
+	// GlobPath is an alternative to path for output artifacts, a pattern in filepath.Match syntax.
+	// Each matching container path is saved as its own artifact named <name>-<index>.
+	GlobPath string `json:"globPath,omitempty" protobuf:"bytes,17,opt,name=globPath"`
+
+	// Paths is an alternative to path for output artifacts, a list of container paths that are saved together as a
+	// single tarball. Each path is added at the top level of the tarball under its base name.
+	// +listType=atomic
+	Paths []string `json:"paths,omitempty" protobuf:"bytes,18,rep,name=paths"`
+
 	// mode bits to use on this file, must be a value between 0 and 0777
 	// set when loading input artifacts.
 	Mode *int32 `json:"mode,omitempty" protobuf:"varint,3,opt,name=mode"`
@@ -1002,6 +1184,63 @@ type Artifact struct {
 
 	// PreviewPath specifies the relative path within the artifact to use for HTML preview
 	PreviewPath string `json:"previewPath,omitempty" protobuf:"bytes,14,opt,name=previewPath"`
+
+	// CompressionRatio is the size of the saved archive divided by the size of the files it contains.
+	// It is set when the artifact is saved with the tar or zip archive strategy.
+	CompressionRatio *Amount `json:"compressionRatio,omitempty" protobuf:"bytes,15,opt,name=compressionRatio"`
+
+	// ExpiresAt is the time after which the artifact is deleted by artifact garbage collection,
+	// whatever its artifactGC strategy, and even if the workflow has not been deleted
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty" protobuf:"bytes,16,opt,name=expiresAt"`
+
+	// Naming controls the key of an output artifact saved to the artifact repository
+	Naming *ArtifactNaming `json:"naming,omitempty" protobuf:"bytes,19,opt,name=naming"`
+
+	// RenamePolicy controls the file name of the key of an output artifact saved to the artifact repository
+	RenamePolicy *ArtifactRenamePolicy `json:"renamePolicy,omitempty" protobuf:"bytes,20,opt,name=renamePolicy"`
+
+	// NamedPipe is an alternative to path for output artifacts, a named pipe that the container writes the artifact
+	// to. The artifact is uploaded while it is written, without being saved to disk first.
+	NamedPipe *NamedPipeArtifact `json:"namedPipe,omitempty" protobuf:"bytes,21,opt,name=namedPipe"`
+
+	// UnpackTo is a container directory that an input artifact is extracted into. The artifact must be a tar, tar.gz,
+	// tar.zst or zip archive, and the directory must be in a volume mounted by the container. The artifact is still
+	// loaded to its path, but is not extracted there.
+	UnpackTo string `json:"unpackTo,omitempty" protobuf:"bytes,22,opt,name=unpackTo"`
+}
+
+// ArtifactNamingStrategy is the strategy used to make the key of an output artifact unique
+type ArtifactNamingStrategy string
+
+// ArtifactNamingStrategy
+const (
+	ArtifactNamingHash       ArtifactNamingStrategy = "hash"
+	ArtifactNamingSequential ArtifactNamingStrategy = "sequential"
+	ArtifactNamingTimestamp  ArtifactNamingStrategy = "timestamp"
+)
+
+// ArtifactNaming controls the key of an output artifact saved to the artifact repository
+type ArtifactNaming struct {
+	// Strategy adds a suffix to the file name of the key.
+	// hash adds the SHA256 of the saved file, sequential adds one more than the highest number already saved for the
+	// artifact in the archive location, starting at 1, and timestamp adds the time the artifact was saved, in
+	// nanoseconds since the Unix epoch.
+	// +kubebuilder:validation:Enum=hash;sequential;timestamp
+	Strategy ArtifactNamingStrategy `json:"strategy,omitempty" protobuf:"bytes,1,opt,name=strategy,casttype=ArtifactNamingStrategy"`
+}
+
+// ArtifactRenamePolicy controls the file name of the key of an output artifact saved to the artifact repository
+type ArtifactRenamePolicy struct {
+	// To is the file name of the key, used instead of the base name of the path, or of the archive named after the
+	// artifact, e.g. "report.csv". Any naming strategy suffix is added to it.
+	To string `json:"to" protobuf:"bytes,1,opt,name=to"`
+}
+
+// NamedPipeArtifact is a named pipe that a container writes an output artifact to
+type NamedPipeArtifact struct {
+	// Path is the container path of the named pipe. It must be in a volume mounted by the container, so that the
+	// wait container can read it. The pipe is created by the wait container, unless the container already created it.
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
 }
 
 // ArtifactGC returns the ArtifactGC that was defined by the artifact.  If none was provided, a default value is returned.
@@ -1055,12 +1294,18 @@ func (a *Artifact) CleanPath() error {
 
 // PodGC describes how to delete completed pods as they complete
 type PodGC struct {
-	// Strategy is the strategy to use. One of "OnPodCompletion", "OnPodSuccess", "OnWorkflowCompletion", "OnWorkflowSuccess". If unset, does not delete Pods
+	// Strategy is the strategy to use. One of "OnPodCompletion", "OnPodSuccess", "OnPodFailure", "OnWorkflowCompletion", "OnWorkflowSuccess". If unset, does not delete Pods
 	Strategy PodGCStrategy `json:"strategy,omitempty" protobuf:"bytes,1,opt,name=strategy,casttype=PodGCStrategy"`
 	// LabelSelector is the label selector to check if the pods match the labels before being added to the pod GC queue.
 	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty" protobuf:"bytes,2,opt,name=labelSelector"`
 	// DeleteDelayDuration specifies the duration before pods in the GC queue get deleted.
 	DeleteDelayDuration string `json:"deleteDelayDuration,omitempty" protobuf:"bytes,3,opt,name=deleteDelayDuration"`
+	// RetainCount is the number of most recently created pods to keep when pods are deleted on workflow
+	// completion. Only used with the "OnWorkflowCompletion" and "OnWorkflowSuccess" strategies.
+	RetainCount int32 `json:"retainCount,omitempty" protobuf:"varint,4,opt,name=retainCount"`
+	// KeepFailedPodDuration is how long failed pods are kept before they are deleted. Only used with the
+	// "OnPodFailure" strategy. If unset, failed pods are kept until the workflow is deleted.
+	KeepFailedPodDuration string `json:"keepFailedPodDuration,omitempty" protobuf:"bytes,5,opt,name=keepFailedPodDuration"`
 }
 
 // GetLabelSelector gets the label selector from podGC.
@@ -1088,6 +1333,13 @@ func (podGC *PodGC) GetDeleteDelayDuration() (time.Duration, error) {
 	return ParseStringToDuration(podGC.DeleteDelayDuration)
 }
 
+func (podGC *PodGC) GetKeepFailedPodDuration() (time.Duration, error) {
+	if podGC == nil || podGC.KeepFailedPodDuration == "" {
+		return -1, nil // negative return means the field was omitted
+	}
+	return ParseStringToDuration(podGC.KeepFailedPodDuration)
+}
+
 // WorkflowLevelArtifactGC describes how to delete artifacts from completed Workflows - this spec is used on the Workflow level
 type WorkflowLevelArtifactGC struct {
 	// ArtifactGC is an embedded struct
@@ -1103,7 +1355,8 @@ type WorkflowLevelArtifactGC struct {
 // ArtifactGC describes how to delete artifacts from completed Workflows - this is embedded into the WorkflowLevelArtifactGC, and also used for individual Artifacts to override that as needed
 type ArtifactGC struct {
 	// Strategy is the strategy to use.
-	// +kubebuilder:validation:Enum="";OnWorkflowCompletion;OnWorkflowDeletion;Never
+	// DryRun logs the artifacts that would be deleted on workflow completion, and reports them in an event, without deleting them.
+	// +kubebuilder:validation:Enum="";OnWorkflowCompletion;OnWorkflowDeletion;Never;DryRun
 	Strategy ArtifactGCStrategy `json:"strategy,omitempty" protobuf:"bytes,1,opt,name=strategy,casttype=ArtifactGCStategy"`
 
 	// PodMetadata is an optional field for specifying the Labels and Annotations that should be assigned to the Pod doing the deletion
@@ -1169,7 +1422,9 @@ type ArtifactLocationType interface {
 // It is also used to describe the location of multiple artifacts such as the archive location
 // of a single workflow step, which the executor will use as a default location to store its files.
 type ArtifactLocation struct {
-	// ArchiveLogs indicates if the container logs should be archived
+	// ArchiveLogs indicates if the container logs should be archived.
+	// On an output artifact with its own location, it indicates if the main container's logs should be archived next
+	// to the artifact, and defaults to the template's archiveLogs.
 	ArchiveLogs *bool `json:"archiveLogs,omitempty" protobuf:"varint,1,opt,name=archiveLogs"`
 
 	// S3 contains S3 artifact location details
@@ -1198,6 +1453,37 @@ type ArtifactLocation struct {
 
 	// Azure contains Azure Storage artifact location details
 	Azure *AzureArtifact `json:"azure,omitempty" protobuf:"bytes,10,opt,name=azure"`
+
+	// FromImageLabel reads the artifact's URL from a label of an OCI image when the pod starts.
+	// The URL is used by the http location, or the artifactory location if one is set.
+	FromImageLabel *ImageLabelSource `json:"fromImageLabel,omitempty" protobuf:"bytes,11,opt,name=fromImageLabel"`
+
+	// KeyPrefix is only used in a template's archiveLocation. When set, the template's artifacts and logs are stored
+	// under "<keyPrefix>/<templateName>" instead of the artifact repository's key format. The artifact repository
+	// itself is still used if no other location is set.
+	KeyPrefix string `json:"keyPrefix,omitempty" protobuf:"bytes,12,opt,name=keyPrefix"`
+
+	// PersistentVolumeClaim saves an output artifact from a persistent volume claim mounted in the main container,
+	// instead of from a path. The artifact is saved to its other location if one is set, or to the artifact repository.
+	PersistentVolumeClaim *PersistentVolumeClaimSource `json:"persistentVolumeClaim,omitempty" protobuf:"bytes,13,opt,name=persistentVolumeClaim"`
+}
+
+// PersistentVolumeClaimSource is a path in a persistent volume claim
+type PersistentVolumeClaimSource struct {
+	// ClaimName is the name of the persistent volume claim, which must be mounted in the main container
+	ClaimName string `json:"claimName" protobuf:"bytes,1,opt,name=claimName"`
+
+	// SubPath is the path within the volume. Defaults to the root of the volume.
+	SubPath string `json:"subPath,omitempty" protobuf:"bytes,2,opt,name=subPath"`
+}
+
+// ImageLabelSource is a label of an OCI image whose value is an artifact's URL
+type ImageLabelSource struct {
+	// Image is the image to read the label from. Defaults to the image of the template's container or script.
+	Image string `json:"image,omitempty" protobuf:"bytes,1,opt,name=image"`
+
+	// Label is the name of the label
+	Label string `json:"label" protobuf:"bytes,2,opt,name=label"`
 }
 
 func (a *ArtifactLocation) Get() (ArtifactLocationType, error) {
@@ -1251,8 +1537,9 @@ func (a *ArtifactLocation) SetType(x ArtifactLocationType) error {
 	return nil
 }
 
+// HasLocationOrKey returns whether or not an artifact has a location or a key, or its location is read from an image label.
 func (a *ArtifactLocation) HasLocationOrKey() bool {
-	return a.HasLocation() || a.HasKey()
+	return a.HasLocation() || a.HasKey() || a.FromImageLabel != nil
 }
 
 // HasKey returns whether or not an artifact has a key. They may or may not also HasLocation.
@@ -1314,6 +1601,29 @@ func (a *ArtifactLocation) GetKey() (string, error) {
 	return v.GetKey()
 }
 
+// StorageURI returns a URI for the artifact's storage location, e.g. "s3://my-bucket/my-key".
+// Other storage is described by its URL or key.
+func (a *ArtifactLocation) StorageURI() string {
+	key, _ := a.GetKey()
+	switch {
+	case a.S3 != nil:
+		return fmt.Sprintf("s3://%s/%s", a.S3.Bucket, key)
+	case a.GCS != nil:
+		return fmt.Sprintf("gs://%s/%s", a.GCS.Bucket, key)
+	case a.Azure != nil:
+		return fmt.Sprintf("azure://%s/%s", a.Azure.Container, key)
+	case a.OSS != nil:
+		return fmt.Sprintf("oss://%s/%s", a.OSS.Bucket, key)
+	case a.HDFS != nil:
+		return "hdfs://" + key
+	case a.HTTP != nil:
+		return a.HTTP.URL
+	case a.Artifactory != nil:
+		return a.Artifactory.URL
+	}
+	return key
+}
+
 // +protobuf.options.(gogoproto.goproto_stringer)=false
 type ArtifactRepositoryRef struct {
 	// The name of the config map. Defaults to "artifact-repositories".
@@ -1553,6 +1863,10 @@ type WorkflowStep struct {
 	// which is expected to be a JSON list.
 	WithParam string `json:"withParam,omitempty" protobuf:"bytes,6,opt,name=withParam"`
 
+	// WithParamArtifact expands a step into multiple parallel steps from the content of an output artifact of a
+	// previous step, which is expected to be a JSON list.
+	WithParamArtifact *WithParamArtifact `json:"withParamArtifact,omitempty" protobuf:"bytes,14,opt,name=withParamArtifact"`
+
 	// WithSequence expands a step into a numeric sequence
 	WithSequence *Sequence `json:"withSequence,omitempty" protobuf:"bytes,7,opt,name=withSequence"`
 
@@ -1586,10 +1900,34 @@ func (s *WorkflowStep) IsWorkflowStep() bool {
 	return true
 }
 
+// WithParamArtifact references an output artifact of a step or task. Set step in a steps template and task in
+// a DAG template.
+type WithParamArtifact struct {
+	// Step is the name of a previous step
+	Step string `json:"step,omitempty" protobuf:"bytes,1,opt,name=step"`
+
+	// Task is the name of a task this task depends on
+	Task string `json:"task,omitempty" protobuf:"bytes,2,opt,name=task"`
+
+	// Artifact is the name of the output artifact
+	Artifact string `json:"artifact" protobuf:"bytes,3,opt,name=artifact"`
+}
+
+// Reference returns the variable that refers to the artifact in a steps or DAG template
+func (a *WithParamArtifact) Reference() string {
+	if a.Task != "" {
+		return fmt.Sprintf("tasks.%s.outputs.artifacts.%s", a.Task, a.Artifact)
+	}
+	return fmt.Sprintf("steps.%s.outputs.artifacts.%s", a.Step, a.Artifact)
+}
+
 type LifecycleEvent string
 
 const (
 	ExitLifecycleEvent = "exit"
+	// SuccessLifecycleEvent and FailureLifecycleEvent name the hooks created from a DAG task's onSuccess and onFailure.
+	SuccessLifecycleEvent = "onSuccess"
+	FailureLifecycleEvent = "onFailure"
 )
 
 type LifecycleHooks map[LifecycleEvent]LifecycleHook
@@ -1655,7 +1993,7 @@ func (s *WorkflowStep) GetTemplateRef() *TemplateRef {
 }
 
 func (s *WorkflowStep) ShouldExpand() bool {
-	return len(s.WithItems) != 0 || s.WithParam != "" || s.WithSequence != nil
+	return len(s.WithItems) != 0 || s.WithParam != "" || s.WithParamArtifact != nil || s.WithSequence != nil
 }
 
 // Sequence expands a workflow step into numeric range
@@ -1671,6 +2009,10 @@ type Sequence struct {
 
 	// Format is a printf format string to format the value in the sequence
 	Format string `json:"format,omitempty" protobuf:"bytes,4,opt,name=format"`
+
+	// GoTemplate is a Go text/template (e.g. `{{printf "%03d" .}}`) to format the value in the sequence.
+	// The value is available as ".". Not to be used with Format
+	GoTemplate string `json:"goTemplate,omitempty" protobuf:"bytes,5,opt,name=goTemplate"`
 }
 
 // TemplateRef is a reference of template resource.
@@ -1721,8 +2063,21 @@ type SemaphoreRef struct {
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
 	// SyncDatabaseRef is a database reference for Semaphore configuration
 	Database *SyncDatabaseRef `json:"database,omitempty" protobuf:"bytes,3,opt,name=database"`
+	// Fairness is the order in which waiting workflows are granted the semaphore, one of: FIFO, Priority.
+	// FIFO grants it in the order workflows started waiting. Priority, the default, grants it by
+	// workflow.spec.priority and then creation time. Only supported by ConfigMap semaphores.
+	// +kubebuilder:validation:Enum="";FIFO;Priority
+	Fairness SemaphoreFairness `json:"fairness,omitempty" protobuf:"bytes,4,opt,name=fairness,casttype=SemaphoreFairness"`
 }
 
+// SemaphoreFairness is the order in which a semaphore is granted to waiting workflows
+type SemaphoreFairness string
+
+const (
+	SemaphoreFairnessFIFO     SemaphoreFairness = "FIFO"
+	SemaphoreFairnessPriority SemaphoreFairness = "Priority"
+)
+
 // Mutex holds Mutex configuration
 type Mutex struct {
 	// name of the mutex
@@ -1741,6 +2096,14 @@ type WorkflowTemplateRef struct {
 	ClusterScope bool `json:"clusterScope,omitempty" protobuf:"varint,2,opt,name=clusterScope"`
 }
 
+// EntrypointRef is a reference to the template of a WorkflowTemplate to use as a workflow's entrypoint.
+type EntrypointRef struct {
+	// WorkflowTemplate is the resource name of the workflow template.
+	WorkflowTemplate string `json:"workflowTemplate" protobuf:"bytes,1,opt,name=workflowTemplate"`
+	// Template is the name of the template to use as the entrypoint.
+	Template string `json:"template" protobuf:"bytes,2,opt,name=template"`
+}
+
 func (ref *WorkflowTemplateRef) ToTemplateRef(template string) *TemplateRef {
 	return &TemplateRef{
 		Name:         ref.Name,
@@ -1972,6 +2335,10 @@ type WorkflowStatus struct {
 	// Time at which this workflow completed
 	FinishedAt metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,3,opt,name=finishedAt"`
 
+	// Time at which the controller first saw this workflow. The time between this and startedAt is the time the
+	// workflow waited before it started running, e.g. because of parallelism limits.
+	QueuedAt *metav1.Time `json:"queuedAt,omitempty" protobuf:"bytes,22,opt,name=queuedAt"`
+
 	// EstimatedDuration in seconds.
 	EstimatedDuration EstimatedDuration `json:"estimatedDuration,omitempty" protobuf:"varint,16,opt,name=estimatedDuration,casttype=EstimatedDuration"`
 
@@ -2021,6 +2388,9 @@ type WorkflowStatus struct {
 
 	// TaskResultsCompletionStatus tracks task result completion status (mapped by node ID). Used to prevent premature archiving and garbage collection.
 	TaskResultsCompletionStatus map[string]bool `json:"taskResultsCompletionStatus,omitempty" protobuf:"bytes,20,opt,name=taskResultsCompletionStatus"`
+
+	// AverageArtifactCompressionRatio is the average compressionRatio of the output artifacts of the workflow's nodes
+	AverageArtifactCompressionRatio *Amount `json:"averageArtifactCompressionRatio,omitempty" protobuf:"bytes,21,opt,name=averageArtifactCompressionRatio"`
 }
 
 // MarkTaskResultIncomplete sets either the task results completion field
@@ -2135,12 +2505,28 @@ type Backoff struct {
 	// multiplication by the factor parameter would make the duration
 	// exceed the cap then the duration is set to the cap
 	Cap string `json:"cap,omitempty" protobuf:"varint,4,opt,name=cap"`
+	// Jitter is the maximum random amount of time added to each backoff duration, after the cap is applied,
+	// so that many nodes failing together are not all retried at the same time.
+	// Default unit is seconds, but could also be a duration (e.g. "2m", "1h")
+	Jitter string `json:"jitter,omitempty" protobuf:"bytes,5,opt,name=jitter"`
 }
 
 // RetryNodeAntiAffinity is a placeholder for future expansion, only empty nodeAntiAffinity is allowed.
 // In order to prevent running steps on the same host, it uses "kubernetes.io/hostname".
 type RetryNodeAntiAffinity struct{}
 
+// WorkflowScopedAntiAffinity is a shorthand for common pod anti-affinity rules.
+type WorkflowScopedAntiAffinity struct {
+	// WorkflowScoped prevents two pods of this workflow from being scheduled on the same node.
+	// It uses "kubernetes.io/hostname" as the topology key.
+	WorkflowScoped bool `json:"workflowScoped,omitempty" protobuf:"varint,1,opt,name=workflowScoped"`
+}
+
+// IsWorkflowScoped returns whether pods of the workflow must not share a node.
+func (a *WorkflowScopedAntiAffinity) IsWorkflowScoped() bool {
+	return a != nil && a.WorkflowScoped
+}
+
 // RetryAffinity prevents running steps on the same host.
 type RetryAffinity struct {
 	NodeAntiAffinity *RetryNodeAntiAffinity `json:"nodeAntiAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAntiAffinity"`
@@ -2164,6 +2550,22 @@ type RetryStrategy struct {
 	// Expression is a condition expression for when a node will be retried. If it evaluates to false, the node will not
 	// be retried and the retry strategy will be ignored
 	Expression string `json:"expression,omitempty" protobuf:"bytes,5,opt,name=expression"`
+
+	// RetryOn restricts retries to the failures that match
+	RetryOn *RetryOn `json:"retryOn,omitempty" protobuf:"bytes,6,opt,name=retryOn"`
+}
+
+// RetryOn restricts retries to the failures that match
+type RetryOn struct {
+	// TransientErrors only retries a node that failed with a known transient error, such as its container being OOM
+	// killed (exit code 137), its pod being evicted, or its node being preempted. Operators can add to the known
+	// errors with `retryTransientErrors` in the workflow controller ConfigMap.
+	TransientErrors bool `json:"transientErrors,omitempty" protobuf:"varint,1,opt,name=transientErrors"`
+}
+
+// OnlyTransientErrors returns whether only transient errors are retried
+func (s RetryStrategy) OnlyTransientErrors() bool {
+	return s.RetryOn != nil && s.RetryOn.TransientErrors
 }
 
 // RetryPolicyActual gets the active retry policy for a strategy.
@@ -2306,6 +2708,10 @@ const (
 	ConditionTypeMetricsError ConditionType = "MetricsError"
 	// ConditionTypeArtifactGCError is an error on artifact garbage collection
 	ConditionTypeArtifactGCError ConditionType = "ArtifactGCError"
+	// ConditionTypeArtifactsCollected is whether the outputs, including artifacts, of all the workflow pods have been reported
+	ConditionTypeArtifactsCollected ConditionType = "ArtifactsCollected"
+	// ConditionTypeSuspendResolved is whether the workflow and all its suspend nodes have been resumed
+	ConditionTypeSuspendResolved ConditionType = "SuspendResolved"
 )
 
 type Condition struct {
@@ -2415,6 +2821,14 @@ type NodeStatus struct {
 
 	// TaskResultSynced is used to determine if the node's output has been received
 	TaskResultSynced *bool `json:"taskResultSynced,omitempty" protobuf:"bytes,28,opt,name=taskResultSynced"`
+
+	// ResourceVersion is the resource version of the workflow that this node's status was last computed from.
+	// The controller uses it to detect another controller updating the same node concurrently.
+	ResourceVersion string `json:"resourceVersion,omitempty" protobuf:"bytes,29,opt,name=resourceVersion"`
+
+	// Suspend pauses the main container process of a running pod node in place.
+	// The executor sends SIGSTOP when it is set and SIGCONT when it is cleared.
+	Suspend bool `json:"suspend,omitempty" protobuf:"varint,30,opt,name=suspend"`
 }
 
 // Completed is used to determine if this node can proceed
@@ -2967,7 +3381,8 @@ type HTTPAuth struct {
 	BasicAuth  BasicAuth      `json:"basicAuth,omitempty" protobuf:"bytes,3,opt,name=basicAuth"`
 }
 
-// HTTPArtifact allows a file served on HTTP to be placed as an input artifact in a container
+// HTTPArtifact allows a file served on HTTP to be placed as an input artifact in a container,
+// or an output artifact to be saved to an HTTP server with a PUT request
 type HTTPArtifact struct {
 	// URL of the artifact
 	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
@@ -2977,6 +3392,15 @@ type HTTPArtifact struct {
 
 	// Auth contains information for client authentication
 	Auth *HTTPAuth `json:"auth,omitempty" protobuf:"bytes,3,opt,name=auth"`
+
+	// TLSConfig configures the TLS connection to the HTTP server
+	TLSConfig *HTTPTLSConfig `json:"tlsConfig,omitempty" protobuf:"bytes,4,opt,name=tlsConfig"`
+}
+
+// HTTPTLSConfig configures the TLS connection for HTTP artifacts
+type HTTPTLSConfig struct {
+	// InsecureSkipVerify skips verification of the server's certificate chain and host name
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty" protobuf:"varint,1,opt,name=insecureSkipVerify"`
 }
 
 func (h *HTTPArtifact) GetKey() (string, error) {
@@ -3008,6 +3432,10 @@ type GCSBucket struct {
 
 	// ServiceAccountKeySecret is the secret selector to the bucket's service account key
 	ServiceAccountKeySecret *apiv1.SecretKeySelector `json:"serviceAccountKeySecret,omitempty" protobuf:"bytes,2,opt,name=serviceAccountKeySecret"`
+
+	// UseWorkloadIdentity tells the driver to use Application Default Credentials, such as GKE Workload Identity,
+	// instead of a service account key. ServiceAccountKeySecret is ignored when this is set.
+	UseWorkloadIdentity bool `json:"useWorkloadIdentity,omitempty" protobuf:"varint,3,opt,name=useWorkloadIdentity"`
 }
 
 // GCSArtifact is the location of a GCS artifact
@@ -3092,6 +3520,20 @@ func (o *OSSArtifact) HasLocation() bool {
 type ExecutorConfig struct {
 	// ServiceAccountName specifies the service account name of the executor container.
 	ServiceAccountName string `json:"serviceAccountName,omitempty" protobuf:"bytes,1,opt,name=serviceAccountName"`
+
+	// Image overrides the executor image configured in the workflow controller, e.g. to use a custom build of
+	// argoexec with additional tooling. It is used for the init and wait containers.
+	Image string `json:"image,omitempty" protobuf:"bytes,2,opt,name=image"`
+
+	// IncludeScripts includes the source of script templates in the template passed to the executor. Defaults to true.
+	// When false, the source is stored in a ConfigMap named "<node ID>-script" that is mounted into the pod instead,
+	// so that large scripts do not make the pod spec exceed the size limits of the API server.
+	IncludeScripts *bool `json:"includeScripts,omitempty" protobuf:"varint,3,opt,name=includeScripts"`
+}
+
+// ShouldIncludeScripts returns whether the source of script templates is included in the template passed to the executor
+func (e *ExecutorConfig) ShouldIncludeScripts() bool {
+	return e == nil || e.IncludeScripts == nil || *e.IncludeScripts
 }
 
 // ScriptTemplate is a template subtype to enable scripting through code steps
@@ -3101,6 +3543,36 @@ type ScriptTemplate struct {
 	// Source contains the source code of the script to execute
 	// +optional
 	Source string `json:"source" protobuf:"bytes,2,opt,name=source"`
+
+	// ImageRef resolves the image from a parameter of a ClusterWorkflowTemplate, so that a base image can be
+	// updated in one place. It takes precedence over image.
+	// +optional
+	ImageRef *ImageRef `json:"imageRef,omitempty" protobuf:"bytes,3,opt,name=imageRef"`
+
+	// Preprocessors transform the source in order before the script is run, for example to decrypt it
+	// +optional
+	Preprocessors []Preprocessor `json:"preprocessors,omitempty" protobuf:"bytes,4,rep,name=preprocessors"`
+
+	// Interpreter is the name of an interpreter configured in the controller, for example python3, whose command is
+	// used to run the source. It may not be used together with command.
+	// +optional
+	Interpreter string `json:"interpreter,omitempty" protobuf:"bytes,5,opt,name=interpreter"`
+}
+
+// Preprocessor transforms the source of a script. It is run by the emissary in the main container before the script,
+// so its command must exist in the script's image.
+type Preprocessor struct {
+	// Command is run with the script source as its standard input, and its standard output replaces the source
+	Command []string `json:"command" protobuf:"bytes,1,rep,name=command"`
+}
+
+// ImageRef is a reference to an image stored as a parameter of a ClusterWorkflowTemplate
+type ImageRef struct {
+	// ClusterWorkflowTemplate is the name of the ClusterWorkflowTemplate holding the image
+	ClusterWorkflowTemplate string `json:"clusterWorkflowTemplate" protobuf:"bytes,1,opt,name=clusterWorkflowTemplate"`
+
+	// ImageKey is the name of the spec.arguments.parameters entry whose value is the image
+	ImageKey string `json:"imageKey" protobuf:"bytes,2,opt,name=imageKey"`
 }
 
 // ResourceTemplate is a template subtype to manipulate kubernetes resources
@@ -3110,7 +3582,9 @@ type ResourceTemplate struct {
 	Action string `json:"action" protobuf:"bytes,1,opt,name=action"`
 
 	// MergeStrategy is the strategy used to merge a patch. It defaults to "strategic"
-	// Must be one of: strategic, merge, json
+	// Must be one of: strategic, merge, json, server-side.
+	// server-side applies the manifest using Kubernetes server-side apply, and can only be used with the apply and
+	// patch actions
 	MergeStrategy string `json:"mergeStrategy,omitempty" protobuf:"bytes,2,opt,name=mergeStrategy"`
 
 	// Manifest contains the kubernetes manifest
@@ -3135,7 +3609,72 @@ type ResourceTemplate struct {
 	// flags: [
 	// 	"--validate=false"  # disable resource validation
 	// ]
+	// Only an allow-list of flags may be used, e.g. "--server-side", "--field-manager" and "--force-conflicts"
+	// for server-side apply. Flags that change the cluster or credentials used by kubectl are rejected.
 	Flags []string `json:"flags,omitempty" protobuf:"varint,7,opt,name=flags"`
+
+	// BackoffLimit is the number of times the action is retried after a transient error from the API server, such as
+	// too many requests or a timeout. It defaults to 3.
+	// Unlike the retryStrategy, which retries the whole node, this only retries the action.
+	BackoffLimit *int32 `json:"backoffLimit,omitempty" protobuf:"varint,9,opt,name=backoffLimit"`
+
+	// Backoff is the delay between retries of the action. Only duration and factor are supported.
+	// It defaults to 10ms, multiplied by 5 after each retry.
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,10,opt,name=backoff"`
+
+	// WaitForResource waits, after the action, until a JSON path of the resource has a value. It is checked after the
+	// successCondition and failureCondition.
+	WaitForResource *WaitForResource `json:"waitForResource,omitempty" protobuf:"bytes,11,opt,name=waitForResource"`
+}
+
+// WaitForResource polls a resource until the value at a JSON path is the expected value
+type WaitForResource struct {
+	// JSONPath is a kubectl JSON path expression, e.g. {.status.phase} or .status.phase
+	JSONPath string `json:"jsonPath" protobuf:"bytes,1,opt,name=jsonPath"`
+
+	// Value is the value that the JSON path must have. Multiple results are separated by spaces.
+	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
+
+	// Timeout is how long to wait before the node fails. It defaults to waiting until the template's deadline.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,3,opt,name=timeout"`
+
+	// PollInterval is the time between polls. It defaults to the RESOURCE_STATE_CHECK_INTERVAL of the executor.
+	// +optional
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty" protobuf:"bytes,4,opt,name=pollInterval"`
+}
+
+// GetBackoff returns the backoff used to retry the action, which is the default backoff changed by the backoffLimit and
+// backoff of the template
+func (in *ResourceTemplate) GetBackoff(defaultBackoff wait.Backoff) (wait.Backoff, error) {
+	backoff := defaultBackoff
+	if in == nil {
+		return backoff, nil
+	}
+	if in.BackoffLimit != nil {
+		if *in.BackoffLimit < 0 {
+			return wait.Backoff{}, fmt.Errorf("backoffLimit must not be negative")
+		}
+		backoff.Steps = int(*in.BackoffLimit) + 1
+	}
+	if in.Backoff == nil {
+		return backoff, nil
+	}
+	if in.Backoff.Duration == "" {
+		return wait.Backoff{}, fmt.Errorf("backoff.duration is required")
+	}
+	if in.Backoff.MaxDuration != "" || in.Backoff.Cap != "" || in.Backoff.Jitter != "" {
+		return wait.Backoff{}, fmt.Errorf("backoff: only duration and factor are supported")
+	}
+	duration, err := ParseStringToDuration(in.Backoff.Duration)
+	if err != nil {
+		return wait.Backoff{}, fmt.Errorf("backoff.duration: %w", err)
+	}
+	backoff = wait.Backoff{Steps: backoff.Steps, Duration: duration}
+	if in.Backoff.Factor != nil {
+		backoff.Factor = float64(in.Backoff.Factor.IntValue())
+	}
+	return backoff, nil
 }
 
 type ManifestFrom struct {
@@ -3329,6 +3868,10 @@ type DAGTask struct {
 	// which is expected to be a JSON list.
 	WithParam string `json:"withParam,omitempty" protobuf:"bytes,7,opt,name=withParam"`
 
+	// WithParamArtifact expands a task into multiple parallel tasks from the content of an output artifact of one of
+	// its dependencies, which is expected to be a JSON list.
+	WithParamArtifact *WithParamArtifact `json:"withParamArtifact,omitempty" protobuf:"bytes,17,opt,name=withParamArtifact"`
+
 	// WithSequence expands a task into a numeric sequence
 	WithSequence *Sequence `json:"withSequence,omitempty" protobuf:"bytes,8,opt,name=withSequence"`
 
@@ -3351,6 +3894,14 @@ type DAGTask struct {
 	// Hooks hold the lifecycle hook which is invoked at lifecycle of
 	// task, irrespective of the success, failure, or error status of the primary task
 	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,13,opt,name=hooks"`
+
+	// OnSuccess is a hook which is invoked once the task has succeeded.
+	// Its arguments can reference the task's outputs as `{{tasks.<name>.outputs.*}}`.
+	OnSuccess *LifecycleHook `json:"onSuccess,omitempty" protobuf:"bytes,15,opt,name=onSuccess"`
+
+	// OnFailure is a hook which is invoked once the task has failed or errored.
+	// Its arguments can reference the task's outputs as `{{tasks.<name>.outputs.*}}`.
+	OnFailure *LifecycleHook `json:"onFailure,omitempty" protobuf:"bytes,16,opt,name=onFailure"`
 }
 
 func (t *DAGTask) GetName() string {
@@ -3381,6 +3932,30 @@ func (t *DAGTask) HasExitHook() bool {
 	return (t.Hooks != nil && t.Hooks.HasExitHook()) || t.OnExit != ""
 }
 
+// GetLifecycleHooks returns the task's hooks, including its onSuccess and onFailure hooks, which are
+// triggered by the phase of the task.
+func (t *DAGTask) GetLifecycleHooks() LifecycleHooks {
+	if t.OnSuccess == nil && t.OnFailure == nil {
+		return t.Hooks
+	}
+	hooks := make(LifecycleHooks, len(t.Hooks)+2)
+	for name, hook := range t.Hooks {
+		hooks[name] = hook
+	}
+	status := fmt.Sprintf("tasks[%q].status", t.Name)
+	if t.OnSuccess != nil {
+		hook := t.OnSuccess.DeepCopy()
+		hook.Expression = fmt.Sprintf(`%s == "Succeeded"`, status)
+		hooks[SuccessLifecycleEvent] = *hook
+	}
+	if t.OnFailure != nil {
+		hook := t.OnFailure.DeepCopy()
+		hook.Expression = fmt.Sprintf(`%s == "Failed" || %s == "Error"`, status, status)
+		hooks[FailureLifecycleEvent] = *hook
+	}
+	return hooks
+}
+
 func (t *DAGTask) GetTemplate() *Template {
 	return t.Inline
 }
@@ -3394,7 +3969,7 @@ func (t *DAGTask) GetTemplateRef() *TemplateRef {
 }
 
 func (t *DAGTask) ShouldExpand() bool {
-	return len(t.WithItems) != 0 || t.WithParam != "" || t.WithSequence != nil
+	return len(t.WithItems) != 0 || t.WithParam != "" || t.WithParamArtifact != nil || t.WithSequence != nil
 }
 
 // SuspendTemplate is a template subtype to suspend a workflow at a predetermined point in time
@@ -3706,6 +4281,9 @@ type Prometheus struct {
 	Histogram *Histogram `json:"histogram,omitempty" protobuf:"bytes,6,opt,name=histogram"`
 	// Counter is a counter metric
 	Counter *Counter `json:"counter,omitempty" protobuf:"bytes,7,opt,name=counter"`
+	// PushInterval emits this workflow-level gauge or counter each time the interval elapses while the workflow is running,
+	// as well as on completion. Each emission of a counter adds its value again.
+	PushInterval *metav1.Duration `json:"pushInterval,omitempty" protobuf:"bytes,8,opt,name=pushInterval"`
 }
 
 func (p *Prometheus) GetMetricLabels() map[string]string {
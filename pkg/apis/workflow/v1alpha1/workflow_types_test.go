@@ -598,6 +598,15 @@ func TestArtifactLocation_Key(t *testing.T) {
 	})
 }
 
+func TestArtifactLocation_StorageURI(t *testing.T) {
+	assert.Equal(t, "s3://my-bucket/my-key", (&ArtifactLocation{S3: &S3Artifact{S3Bucket: S3Bucket{Bucket: "my-bucket"}, Key: "my-key"}}).StorageURI())
+	assert.Equal(t, "gs://my-bucket/my-key", (&ArtifactLocation{GCS: &GCSArtifact{GCSBucket: GCSBucket{Bucket: "my-bucket"}, Key: "my-key"}}).StorageURI())
+	assert.Equal(t, "azure://my-container/my-blob", (&ArtifactLocation{Azure: &AzureArtifact{AzureBlobContainer: AzureBlobContainer{Container: "my-container"}, Blob: "my-blob"}}).StorageURI())
+	assert.Equal(t, "oss://my-bucket/my-key", (&ArtifactLocation{OSS: &OSSArtifact{OSSBucket: OSSBucket{Bucket: "my-bucket"}, Key: "my-key"}}).StorageURI())
+	assert.Equal(t, "hdfs:///my-path", (&ArtifactLocation{HDFS: &HDFSArtifact{Path: "/my-path"}}).StorageURI())
+	assert.Equal(t, "http://my-host/my-file", (&ArtifactLocation{HTTP: &HTTPArtifact{URL: "http://my-host/my-file"}}).StorageURI())
+}
+
 func TestArtifactRepositoryRef_GetConfigMapOr(t *testing.T) {
 	var r *ArtifactRepositoryRef
 	assert.Equal(t, "my-cm", r.GetConfigMapOr("my-cm"))
@@ -652,6 +661,7 @@ func TestPodGCStrategy_IsValid(t *testing.T) {
 		PodGCOnPodNone,
 		PodGCOnPodCompletion,
 		PodGCOnPodSuccess,
+		PodGCOnPodFailure,
 		PodGCOnWorkflowCompletion,
 		PodGCOnWorkflowSuccess,
 	} {
@@ -31,6 +31,22 @@ func (in *Amount) DeepCopy() *Amount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnnotationValueFrom) DeepCopyInto(out *AnnotationValueFrom) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnnotationValueFrom.
+func (in *AnnotationValueFrom) DeepCopy() *AnnotationValueFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(AnnotationValueFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArchiveStrategy) DeepCopyInto(out *ArchiveStrategy) {
 	*out = *in
@@ -125,6 +141,11 @@ func (in *ArtGCStatus) DeepCopy() *ArtGCStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Artifact) DeepCopyInto(out *Artifact) {
 	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Mode != nil {
 		in, out := &in.Mode, &out.Mode
 		*out = new(int32)
@@ -141,6 +162,30 @@ func (in *Artifact) DeepCopyInto(out *Artifact) {
 		*out = new(ArtifactGC)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CompressionRatio != nil {
+		in, out := &in.CompressionRatio, &out.CompressionRatio
+		*out = new(Amount)
+		**out = **in
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Naming != nil {
+		in, out := &in.Naming, &out.Naming
+		*out = new(ArtifactNaming)
+		**out = **in
+	}
+	if in.RenamePolicy != nil {
+		in, out := &in.RenamePolicy, &out.RenamePolicy
+		*out = new(ArtifactRenamePolicy)
+		**out = **in
+	}
+	if in.NamedPipe != nil {
+		in, out := &in.NamedPipe, &out.NamedPipe
+		*out = new(NamedPipeArtifact)
+		**out = **in
+	}
 	return
 }
 
@@ -274,6 +319,16 @@ func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 		*out = new(AzureArtifact)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FromImageLabel != nil {
+		in, out := &in.FromImageLabel, &out.FromImageLabel
+		*out = new(ImageLabelSource)
+		**out = **in
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(PersistentVolumeClaimSource)
+		**out = **in
+	}
 	return
 }
 
@@ -287,6 +342,22 @@ func (in *ArtifactLocation) DeepCopy() *ArtifactLocation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactNaming) DeepCopyInto(out *ArtifactNaming) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactNaming.
+func (in *ArtifactNaming) DeepCopy() *ArtifactNaming {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactNaming)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactNodeSpec) DeepCopyInto(out *ArtifactNodeSpec) {
 	*out = *in
@@ -332,6 +403,22 @@ func (in *ArtifactPaths) DeepCopy() *ArtifactPaths {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactRenamePolicy) DeepCopyInto(out *ArtifactRenamePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactRenamePolicy.
+func (in *ArtifactRenamePolicy) DeepCopy() *ArtifactRenamePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactRenamePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactRepository) DeepCopyInto(out *ArtifactRepository) {
 	*out = *in
@@ -1194,6 +1281,11 @@ func (in *DAGTask) DeepCopyInto(out *DAGTask) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WithParamArtifact != nil {
+		in, out := &in.WithParamArtifact, &out.WithParamArtifact
+		*out = new(WithParamArtifact)
+		**out = **in
+	}
 	if in.WithSequence != nil {
 		in, out := &in.WithSequence, &out.WithSequence
 		*out = new(Sequence)
@@ -1211,6 +1303,16 @@ func (in *DAGTask) DeepCopyInto(out *DAGTask) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.OnSuccess != nil {
+		in, out := &in.OnSuccess, &out.OnSuccess
+		*out = new(LifecycleHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = new(LifecycleHook)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1295,6 +1397,22 @@ func (in *DataSource) DeepCopy() *DataSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EntrypointRef) DeepCopyInto(out *EntrypointRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntrypointRef.
+func (in *EntrypointRef) DeepCopy() *EntrypointRef {
+	if in == nil {
+		return nil
+	}
+	out := new(EntrypointRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Event) DeepCopyInto(out *Event) {
 	*out = *in
@@ -1311,9 +1429,35 @@ func (in *Event) DeepCopy() *Event {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSource) DeepCopyInto(out *EventSource) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookEventSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSource.
+func (in *EventSource) DeepCopy() *EventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutorConfig) DeepCopyInto(out *ExecutorConfig) {
 	*out = *in
+	if in.IncludeScripts != nil {
+		in, out := &in.IncludeScripts, &out.IncludeScripts
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1577,6 +1721,11 @@ func (in *HTTPArtifact) DeepCopyInto(out *HTTPArtifact) {
 		*out = new(HTTPAuth)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(HTTPTLSConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -1694,6 +1843,22 @@ func (in HTTPHeaders) DeepCopy() HTTPHeaders {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTLSConfig) DeepCopyInto(out *HTTPTLSConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTLSConfig.
+func (in *HTTPTLSConfig) DeepCopy() *HTTPTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Header) DeepCopyInto(out *Header) {
 	*out = *in
@@ -1731,6 +1896,38 @@ func (in *Histogram) DeepCopy() *Histogram {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageLabelSource) DeepCopyInto(out *ImageLabelSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageLabelSource.
+func (in *ImageLabelSource) DeepCopy() *ImageLabelSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageLabelSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRef) DeepCopyInto(out *ImageRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRef.
+func (in *ImageRef) DeepCopy() *ImageRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Inputs) DeepCopyInto(out *Inputs) {
 	*out = *in
@@ -2078,6 +2275,22 @@ func (in *MutexStatus) DeepCopy() *MutexStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedPipeArtifact) DeepCopyInto(out *NamedPipeArtifact) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedPipeArtifact.
+func (in *NamedPipeArtifact) DeepCopy() *NamedPipeArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedPipeArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeFlag) DeepCopyInto(out *NodeFlag) {
 	*out = *in
@@ -2403,6 +2616,22 @@ func (in *Object) DeepCopy() *Object {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputParameterRef) DeepCopyInto(out *OutputParameterRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputParameterRef.
+func (in *OutputParameterRef) DeepCopy() *OutputParameterRef {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputParameterRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Outputs) DeepCopyInto(out *Outputs) {
 	*out = *in
@@ -2494,6 +2723,11 @@ func (in *Parameter) DeepCopyInto(out *Parameter) {
 		*out = new(AnyString)
 		**out = **in
 	}
+	if in.FromOutput != nil {
+		in, out := &in.FromOutput, &out.FromOutput
+		*out = new(OutputParameterRef)
+		**out = **in
+	}
 	return
 }
 
@@ -2507,6 +2741,22 @@ func (in *Parameter) DeepCopy() *Parameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimSource) DeepCopyInto(out *PersistentVolumeClaimSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimSource.
+func (in *PersistentVolumeClaimSource) DeepCopy() *PersistentVolumeClaimSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Plugin) DeepCopyInto(out *Plugin) {
 	*out = *in
@@ -2545,6 +2795,27 @@ func (in *PodGC) DeepCopy() *PodGC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Preprocessor) DeepCopyInto(out *Preprocessor) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Preprocessor.
+func (in *Preprocessor) DeepCopy() *Preprocessor {
+	if in == nil {
+		return nil
+	}
+	out := new(Preprocessor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Prometheus) DeepCopyInto(out *Prometheus) {
 	*out = *in
@@ -2574,6 +2845,11 @@ func (in *Prometheus) DeepCopyInto(out *Prometheus) {
 		*out = new(Counter)
 		**out = **in
 	}
+	if in.PushInterval != nil {
+		in, out := &in.PushInterval, &out.PushInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -2616,6 +2892,21 @@ func (in *ResourceTemplate) DeepCopyInto(out *ResourceTemplate) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WaitForResource != nil {
+		in, out := &in.WaitForResource, &out.WaitForResource
+		*out = new(WaitForResource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2688,6 +2979,22 @@ func (in *RetryNodeAntiAffinity) DeepCopy() *RetryNodeAntiAffinity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryOn) DeepCopyInto(out *RetryOn) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryOn.
+func (in *RetryOn) DeepCopy() *RetryOn {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryOn)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 	*out = *in
@@ -2706,6 +3013,11 @@ func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 		*out = new(RetryAffinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = new(RetryOn)
+		**out = **in
+	}
 	return
 }
 
@@ -2829,6 +3141,18 @@ func (in *S3EncryptionOptions) DeepCopy() *S3EncryptionOptions {
 func (in *ScriptTemplate) DeepCopyInto(out *ScriptTemplate) {
 	*out = *in
 	in.Container.DeepCopyInto(&out.Container)
+	if in.ImageRef != nil {
+		in, out := &in.ImageRef, &out.ImageRef
+		*out = new(ImageRef)
+		**out = **in
+	}
+	if in.Preprocessors != nil {
+		in, out := &in.Preprocessors, &out.Preprocessors
+		*out = make([]Preprocessor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3239,6 +3563,11 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(ScriptTemplate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OverrideCommand != nil {
+		in, out := &in.OverrideCommand, &out.OverrideCommand
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Resource != nil {
 		in, out := &in.Resource, &out.Resource
 		*out = new(ResourceTemplate)
@@ -3322,6 +3651,18 @@ func (in *Template) DeepCopyInto(out *Template) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
 	if in.AutomountServiceAccountToken != nil {
 		in, out := &in.AutomountServiceAccountToken, &out.AutomountServiceAccountToken
 		*out = new(bool)
@@ -3330,7 +3671,7 @@ func (in *Template) DeepCopyInto(out *Template) {
 	if in.Executor != nil {
 		in, out := &in.Executor, &out.Executor
 		*out = new(ExecutorConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.HostAliases != nil {
 		in, out := &in.HostAliases, &out.HostAliases
@@ -3339,6 +3680,23 @@ func (in *Template) DeepCopyInto(out *Template) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ResourceClaims != nil {
+		in, out := &in.ResourceClaims, &out.ResourceClaims
+		*out = make([]v1.PodResourceClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DNSPolicy != nil {
+		in, out := &in.DNSPolicy, &out.DNSPolicy
+		*out = new(v1.DNSPolicy)
+		**out = **in
+	}
+	if in.DNSConfig != nil {
+		in, out := &in.DNSConfig, &out.DNSConfig
+		*out = new(v1.PodDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SecurityContext != nil {
 		in, out := &in.SecurityContext, &out.SecurityContext
 		*out = new(v1.PodSecurityContext)
@@ -3471,6 +3829,11 @@ func (in *ValueFrom) DeepCopyInto(out *ValueFrom) {
 		*out = new(AnyString)
 		**out = **in
 	}
+	if in.FromAnnotation != nil {
+		in, out := &in.FromAnnotation, &out.FromAnnotation
+		*out = new(AnnotationValueFrom)
+		**out = **in
+	}
 	return
 }
 
@@ -3516,6 +3879,69 @@ func (in *VolumeClaimGC) DeepCopy() *VolumeClaimGC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WaitForResource) DeepCopyInto(out *WaitForResource) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WaitForResource.
+func (in *WaitForResource) DeepCopy() *WaitForResource {
+	if in == nil {
+		return nil
+	}
+	out := new(WaitForResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookEventSource) DeepCopyInto(out *WebhookEventSource) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookEventSource.
+func (in *WebhookEventSource) DeepCopy() *WebhookEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WithParamArtifact) DeepCopyInto(out *WithParamArtifact) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WithParamArtifact.
+func (in *WithParamArtifact) DeepCopy() *WithParamArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(WithParamArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Workflow) DeepCopyInto(out *Workflow) {
 	*out = *in
@@ -3665,6 +4091,22 @@ func (in *WorkflowEventBindingList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowEventBindingRef) DeepCopyInto(out *WorkflowEventBindingRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowEventBindingRef.
+func (in *WorkflowEventBindingRef) DeepCopy() *WorkflowEventBindingRef {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowEventBindingRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowEventBindingSpec) DeepCopyInto(out *WorkflowEventBindingSpec) {
 	*out = *in
@@ -3687,6 +4129,88 @@ func (in *WorkflowEventBindingSpec) DeepCopy() *WorkflowEventBindingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowEventSource) DeepCopyInto(out *WorkflowEventSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowEventSource.
+func (in *WorkflowEventSource) DeepCopy() *WorkflowEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowEventSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowEventSourceList) DeepCopyInto(out *WorkflowEventSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkflowEventSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowEventSourceList.
+func (in *WorkflowEventSourceList) DeepCopy() *WorkflowEventSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowEventSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowEventSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowEventSourceSpec) DeepCopyInto(out *WorkflowEventSourceSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]WorkflowEventBindingRef, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowEventSourceSpec.
+func (in *WorkflowEventSourceSpec) DeepCopy() *WorkflowEventSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowEventSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowLevelArtifactGC) DeepCopyInto(out *WorkflowLevelArtifactGC) {
 	*out = *in
@@ -3774,6 +4298,22 @@ func (in *WorkflowMetadata) DeepCopy() *WorkflowMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowScopedAntiAffinity) DeepCopyInto(out *WorkflowScopedAntiAffinity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowScopedAntiAffinity.
+func (in *WorkflowScopedAntiAffinity) DeepCopy() *WorkflowScopedAntiAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowScopedAntiAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 	*out = *in
@@ -3793,7 +4333,7 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 	if in.Executor != nil {
 		in, out := &in.Executor, &out.Executor
 		*out = new(ExecutorConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
@@ -3836,6 +4376,11 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = new(v1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodAntiAffinity != nil {
+		in, out := &in.PodAntiAffinity, &out.PodAntiAffinity
+		*out = new(WorkflowScopedAntiAffinity)
+		**out = **in
+	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
 		*out = make([]v1.Toleration, len(*in))
@@ -3843,6 +4388,13 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PodTopologySpreadConstraints != nil {
+		in, out := &in.PodTopologySpreadConstraints, &out.PodTopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
 		*out = make([]v1.LocalObjectReference, len(*in))
@@ -3883,6 +4435,16 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = new(PodGC)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EntrypointRef != nil {
+		in, out := &in.EntrypointRef, &out.EntrypointRef
+		*out = new(EntrypointRef)
+		**out = **in
+	}
 	if in.HostAliases != nil {
 		in, out := &in.HostAliases, &out.HostAliases
 		*out = make([]v1.HostAlias, len(*in))
@@ -3890,6 +4452,13 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ResourceClaims != nil {
+		in, out := &in.ResourceClaims, &out.ResourceClaims
+		*out = make([]v1.PodResourceClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SecurityContext != nil {
 		in, out := &in.SecurityContext, &out.SecurityContext
 		*out = new(v1.PodSecurityContext)
@@ -3957,6 +4526,11 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = new(WorkflowLevelArtifactGC)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3975,6 +4549,10 @@ func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 	*out = *in
 	in.StartedAt.DeepCopyInto(&out.StartedAt)
 	in.FinishedAt.DeepCopyInto(&out.FinishedAt)
+	if in.QueuedAt != nil {
+		in, out := &in.QueuedAt, &out.QueuedAt
+		*out = (*in).DeepCopy()
+	}
 	if in.Nodes != nil {
 		in, out := &in.Nodes, &out.Nodes
 		*out = make(Nodes, len(*in))
@@ -4040,6 +4618,11 @@ func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.AverageArtifactCompressionRatio != nil {
+		in, out := &in.AverageArtifactCompressionRatio, &out.AverageArtifactCompressionRatio
+		*out = new(Amount)
+		**out = **in
+	}
 	return
 }
 
@@ -4074,6 +4657,11 @@ func (in *WorkflowStep) DeepCopyInto(out *WorkflowStep) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WithParamArtifact != nil {
+		in, out := &in.WithParamArtifact, &out.WithParamArtifact
+		*out = new(WithParamArtifact)
+		**out = **in
+	}
 	if in.WithSequence != nil {
 		in, out := &in.WithSequence, &out.WithSequence
 		*out = new(Sequence)
@@ -0,0 +1,114 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeWorkflowEventSources implements WorkflowEventSourceInterface
+type FakeWorkflowEventSources struct {
+	Fake *FakeArgoprojV1alpha1
+	ns   string
+}
+
+var workfloweventsourcesResource = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "workfloweventsources"}
+
+var workfloweventsourcesKind = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "WorkflowEventSource"}
+
+// Get takes name of the workflowEventSource, and returns the corresponding workflowEventSource object, and an error if there is any.
+func (c *FakeWorkflowEventSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.WorkflowEventSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(workfloweventsourcesResource, c.ns, name), &v1alpha1.WorkflowEventSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkflowEventSource), err
+}
+
+// List takes label and field selectors, and returns the list of WorkflowEventSources that match those selectors.
+func (c *FakeWorkflowEventSources) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.WorkflowEventSourceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(workfloweventsourcesResource, workfloweventsourcesKind, c.ns, opts), &v1alpha1.WorkflowEventSourceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.WorkflowEventSourceList{ListMeta: obj.(*v1alpha1.WorkflowEventSourceList).ListMeta}
+	for _, item := range obj.(*v1alpha1.WorkflowEventSourceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested workflowEventSources.
+func (c *FakeWorkflowEventSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(workfloweventsourcesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a workflowEventSource and creates it.  Returns the server's representation of the workflowEventSource, and an error, if there is any.
+func (c *FakeWorkflowEventSources) Create(ctx context.Context, workflowEventSource *v1alpha1.WorkflowEventSource, opts v1.CreateOptions) (result *v1alpha1.WorkflowEventSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(workfloweventsourcesResource, c.ns, workflowEventSource), &v1alpha1.WorkflowEventSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkflowEventSource), err
+}
+
+// Update takes the representation of a workflowEventSource and updates it. Returns the server's representation of the workflowEventSource, and an error, if there is any.
+func (c *FakeWorkflowEventSources) Update(ctx context.Context, workflowEventSource *v1alpha1.WorkflowEventSource, opts v1.UpdateOptions) (result *v1alpha1.WorkflowEventSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(workfloweventsourcesResource, c.ns, workflowEventSource), &v1alpha1.WorkflowEventSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkflowEventSource), err
+}
+
+// Delete takes name of the workflowEventSource and deletes it. Returns an error if one occurs.
+func (c *FakeWorkflowEventSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(workfloweventsourcesResource, c.ns, name), &v1alpha1.WorkflowEventSource{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeWorkflowEventSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(workfloweventsourcesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.WorkflowEventSourceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched workflowEventSource.
+func (c *FakeWorkflowEventSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WorkflowEventSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(workfloweventsourcesResource, c.ns, name, pt, data, subresources...), &v1alpha1.WorkflowEventSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkflowEventSource), err
+}
@@ -15,6 +15,7 @@ type ArgoprojV1alpha1Interface interface {
 	WorkflowsGetter
 	WorkflowArtifactGCTasksGetter
 	WorkflowEventBindingsGetter
+	WorkflowEventSourcesGetter
 	WorkflowTaskResultsGetter
 	WorkflowTaskSetsGetter
 	WorkflowTemplatesGetter
@@ -45,6 +46,10 @@ func (c *ArgoprojV1alpha1Client) WorkflowEventBindings(namespace string) Workflo
 	return newWorkflowEventBindings(c, namespace)
 }
 
+func (c *ArgoprojV1alpha1Client) WorkflowEventSources(namespace string) WorkflowEventSourceInterface {
+	return newWorkflowEventSources(c, namespace)
+}
+
 func (c *ArgoprojV1alpha1Client) WorkflowTaskResults(namespace string) WorkflowTaskResultInterface {
 	return newWorkflowTaskResults(c, namespace)
 }
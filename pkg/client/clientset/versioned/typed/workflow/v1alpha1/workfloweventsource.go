@@ -0,0 +1,162 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	scheme "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// WorkflowEventSourcesGetter has a method to return a WorkflowEventSourceInterface.
+// A group's client should implement this interface.
+type WorkflowEventSourcesGetter interface {
+	WorkflowEventSources(namespace string) WorkflowEventSourceInterface
+}
+
+// WorkflowEventSourceInterface has methods to work with WorkflowEventSource resources.
+type WorkflowEventSourceInterface interface {
+	Create(ctx context.Context, workflowEventSource *v1alpha1.WorkflowEventSource, opts v1.CreateOptions) (*v1alpha1.WorkflowEventSource, error)
+	Update(ctx context.Context, workflowEventSource *v1alpha1.WorkflowEventSource, opts v1.UpdateOptions) (*v1alpha1.WorkflowEventSource, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.WorkflowEventSource, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.WorkflowEventSourceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WorkflowEventSource, err error)
+	WorkflowEventSourceExpansion
+}
+
+// workflowEventSources implements WorkflowEventSourceInterface
+type workflowEventSources struct {
+	client rest.Interface
+	ns     string
+}
+
+// newWorkflowEventSources returns a WorkflowEventSources
+func newWorkflowEventSources(c *ArgoprojV1alpha1Client, namespace string) *workflowEventSources {
+	return &workflowEventSources{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the workflowEventSource, and returns the corresponding workflowEventSource object, and an error if there is any.
+func (c *workflowEventSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.WorkflowEventSource, err error) {
+	result = &v1alpha1.WorkflowEventSource{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("workfloweventsources").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of WorkflowEventSources that match those selectors.
+func (c *workflowEventSources) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.WorkflowEventSourceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.WorkflowEventSourceList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("workfloweventsources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested workflowEventSources.
+func (c *workflowEventSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("workfloweventsources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a workflowEventSource and creates it.  Returns the server's representation of the workflowEventSource, and an error, if there is any.
+func (c *workflowEventSources) Create(ctx context.Context, workflowEventSource *v1alpha1.WorkflowEventSource, opts v1.CreateOptions) (result *v1alpha1.WorkflowEventSource, err error) {
+	result = &v1alpha1.WorkflowEventSource{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("workfloweventsources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(workflowEventSource).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a workflowEventSource and updates it. Returns the server's representation of the workflowEventSource, and an error, if there is any.
+func (c *workflowEventSources) Update(ctx context.Context, workflowEventSource *v1alpha1.WorkflowEventSource, opts v1.UpdateOptions) (result *v1alpha1.WorkflowEventSource, err error) {
+	result = &v1alpha1.WorkflowEventSource{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("workfloweventsources").
+		Name(workflowEventSource.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(workflowEventSource).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the workflowEventSource and deletes it. Returns an error if one occurs.
+func (c *workflowEventSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("workfloweventsources").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *workflowEventSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("workfloweventsources").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched workflowEventSource.
+func (c *workflowEventSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WorkflowEventSource, err error) {
+	result = &v1alpha1.WorkflowEventSource{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("workfloweventsources").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
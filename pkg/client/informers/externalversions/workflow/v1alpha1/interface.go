@@ -18,6 +18,8 @@ type Interface interface {
 	WorkflowArtifactGCTasks() WorkflowArtifactGCTaskInformer
 	// WorkflowEventBindings returns a WorkflowEventBindingInformer.
 	WorkflowEventBindings() WorkflowEventBindingInformer
+	// WorkflowEventSources returns a WorkflowEventSourceInformer.
+	WorkflowEventSources() WorkflowEventSourceInformer
 	// WorkflowTaskResults returns a WorkflowTaskResultInformer.
 	WorkflowTaskResults() WorkflowTaskResultInformer
 	// WorkflowTaskSets returns a WorkflowTaskSetInformer.
@@ -62,6 +64,11 @@ func (v *version) WorkflowEventBindings() WorkflowEventBindingInformer {
 	return &workflowEventBindingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// WorkflowEventSources returns a WorkflowEventSourceInformer.
+func (v *version) WorkflowEventSources() WorkflowEventSourceInformer {
+	return &workflowEventSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // WorkflowTaskResults returns a WorkflowTaskResultInformer.
 func (v *version) WorkflowTaskResults() WorkflowTaskResultInformer {
 	return &workflowTaskResultInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -38,6 +38,14 @@ type WorkflowEventBindingListerExpansion interface{}
 // WorkflowEventBindingNamespaceLister.
 type WorkflowEventBindingNamespaceListerExpansion interface{}
 
+// WorkflowEventSourceListerExpansion allows custom methods to be added to
+// WorkflowEventSourceLister.
+type WorkflowEventSourceListerExpansion interface{}
+
+// WorkflowEventSourceNamespaceListerExpansion allows custom methods to be added to
+// WorkflowEventSourceNamespaceLister.
+type WorkflowEventSourceNamespaceListerExpansion interface{}
+
 // WorkflowTaskResultListerExpansion allows custom methods to be added to
 // WorkflowTaskResultLister.
 type WorkflowTaskResultListerExpansion interface{}
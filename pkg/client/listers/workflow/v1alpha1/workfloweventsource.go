@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WorkflowEventSourceLister helps list WorkflowEventSources.
+// All objects returned here must be treated as read-only.
+type WorkflowEventSourceLister interface {
+	// List lists all WorkflowEventSources in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.WorkflowEventSource, err error)
+	// WorkflowEventSources returns an object that can list and get WorkflowEventSources.
+	WorkflowEventSources(namespace string) WorkflowEventSourceNamespaceLister
+	WorkflowEventSourceListerExpansion
+}
+
+// workflowEventSourceLister implements the WorkflowEventSourceLister interface.
+type workflowEventSourceLister struct {
+	indexer cache.Indexer
+}
+
+// NewWorkflowEventSourceLister returns a new WorkflowEventSourceLister.
+func NewWorkflowEventSourceLister(indexer cache.Indexer) WorkflowEventSourceLister {
+	return &workflowEventSourceLister{indexer: indexer}
+}
+
+// List lists all WorkflowEventSources in the indexer.
+func (s *workflowEventSourceLister) List(selector labels.Selector) (ret []*v1alpha1.WorkflowEventSource, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.WorkflowEventSource))
+	})
+	return ret, err
+}
+
+// WorkflowEventSources returns an object that can list and get WorkflowEventSources.
+func (s *workflowEventSourceLister) WorkflowEventSources(namespace string) WorkflowEventSourceNamespaceLister {
+	return workflowEventSourceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// WorkflowEventSourceNamespaceLister helps list and get WorkflowEventSources.
+// All objects returned here must be treated as read-only.
+type WorkflowEventSourceNamespaceLister interface {
+	// List lists all WorkflowEventSources in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.WorkflowEventSource, err error)
+	// Get retrieves the WorkflowEventSource from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.WorkflowEventSource, error)
+	WorkflowEventSourceNamespaceListerExpansion
+}
+
+// workflowEventSourceNamespaceLister implements the WorkflowEventSourceNamespaceLister
+// interface.
+type workflowEventSourceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all WorkflowEventSources in the indexer for a given namespace.
+func (s workflowEventSourceNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.WorkflowEventSource, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.WorkflowEventSource))
+	})
+	return ret, err
+}
+
+// Get retrieves the WorkflowEventSource from the indexer for a given namespace and name.
+func (s workflowEventSourceNamespaceLister) Get(name string) (*v1alpha1.WorkflowEventSource, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("workfloweventsource"), name)
+	}
+	return obj.(*v1alpha1.WorkflowEventSource), nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -535,7 +536,11 @@ func (s *workflowServer) ResumeWorkflow(ctx context.Context, req *workflowpkg.Wo
 		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
 	}
 
-	err = util.ResumeWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), s.hydrator, wf.Name, req.NodeFieldSelector)
+	if req.NodeId != "" {
+		err = util.SetNodeSuspend(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), s.hydrator, wf.Name, req.NodeId, false)
+	} else {
+		err = util.ResumeWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), s.hydrator, wf.Name, req.NodeFieldSelector)
+	}
 	if err != nil {
 		logger := logging.RequireLoggerFromContext(ctx)
 		logger.WithFields(logging.Fields{"name": wf.Name}).WithError(err).Warn(ctx, "Failed to resume")
@@ -564,7 +569,11 @@ func (s *workflowServer) SuspendWorkflow(ctx context.Context, req *workflowpkg.W
 		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
 	}
 
-	err = util.SuspendWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace), wf.Name)
+	if req.NodeId != "" {
+		err = util.SetNodeSuspend(ctx, wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace), s.hydrator, wf.Name, req.NodeId, true)
+	} else {
+		err = util.SuspendWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace), wf.Name)
+	}
 	if err != nil {
 		return nil, sutils.ToStatusError(err, codes.Internal)
 	}
@@ -773,6 +782,40 @@ func getLatestWorkflow(ctx context.Context, wfClient versioned.Interface, namesp
 	return &latest, nil
 }
 
+// withParametersFromConfigMap returns a copy of opts whose parameters are prefixed with every key of
+// opts.ParametersFromConfigMap. Explicitly passed parameters take precedence over the ConfigMap's values.
+func withParametersFromConfigMap(ctx context.Context, namespace string, opts *wfv1.SubmitOpts) (*wfv1.SubmitOpts, error) {
+	if opts == nil || opts.ParametersFromConfigMap == "" {
+		return opts, nil
+	}
+	cm, err := auth.GetKubeClient(ctx).CoreV1().ConfigMaps(namespace).Get(ctx, opts.ParametersFromConfigMap, metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, errors.Errorf(errors.CodeNotFound, "ConfigMap '%s' not found in namespace '%s'", opts.ParametersFromConfigMap, namespace)
+		}
+		return nil, err
+	}
+	passed := make(map[string]bool)
+	for _, p := range opts.Parameters {
+		name, _, _ := strings.Cut(p, "=")
+		passed[name] = true
+	}
+	keys := make([]string, 0, len(cm.Data))
+	for k := range cm.Data {
+		if !passed[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	parameters := make([]string, 0, len(keys)+len(opts.Parameters))
+	for _, k := range keys {
+		parameters = append(parameters, k+"="+cm.Data[k])
+	}
+	opts = opts.DeepCopy()
+	opts.Parameters = append(parameters, opts.Parameters...)
+	return opts, nil
+}
+
 func (s *workflowServer) SubmitWorkflow(ctx context.Context, req *workflowpkg.WorkflowSubmitRequest) (*wfv1.Workflow, error) {
 	wfClient := auth.GetWfClient(ctx)
 	var wf *wfv1.Workflow
@@ -795,7 +838,11 @@ func (s *workflowServer) SubmitWorkflow(ctx context.Context, req *workflowpkg.Wo
 
 	s.instanceIDService.Label(wf)
 	creator.LabelCreator(ctx, wf)
-	err := util.ApplySubmitOpts(wf, req.SubmitOptions)
+	submitOpts, err := withParametersFromConfigMap(ctx, req.Namespace, req.SubmitOptions)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	err = util.ApplySubmitOpts(wf, submitOpts)
 	if err != nil {
 		return nil, sutils.ToStatusError(err, codes.Internal)
 	}
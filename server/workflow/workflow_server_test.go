@@ -994,3 +994,46 @@ func TestSubmitWorkflowFromResource(t *testing.T) {
 		assert.Equal(t, userEmailLabel, wf.Labels[common.LabelKeyCreatorEmail])
 	})
 }
+
+func TestSubmitWorkflowParametersFromConfigMap(t *testing.T) {
+	server, ctx := getWorkflowServer(t)
+	_, err := auth.GetKubeClient(ctx).CoreV1().ConfigMaps("workflows").Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-params"},
+		Data:       map[string]string{"message": "from-configmap", "other": "value"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	t.Run("ConfigMap", func(t *testing.T) {
+		wf, err := server.SubmitWorkflow(ctx, &workflowpkg.WorkflowSubmitRequest{
+			Namespace:     "workflows",
+			ResourceKind:  "workflowtemplate",
+			ResourceName:  "workflow-template-whalesay-template",
+			SubmitOptions: &v1alpha1.SubmitOpts{ParametersFromConfigMap: "my-params"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "from-configmap", wf.Spec.Arguments.GetParameterByName("message").Value.String())
+		assert.Equal(t, "value", wf.Spec.Arguments.GetParameterByName("other").Value.String())
+	})
+	t.Run("ParametersTakePrecedence", func(t *testing.T) {
+		wf, err := server.SubmitWorkflow(ctx, &workflowpkg.WorkflowSubmitRequest{
+			Namespace:    "workflows",
+			ResourceKind: "workflowtemplate",
+			ResourceName: "workflow-template-whalesay-template",
+			SubmitOptions: &v1alpha1.SubmitOpts{
+				ParametersFromConfigMap: "my-params",
+				Parameters:              []string{"message=hello"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "hello", wf.Spec.Arguments.GetParameterByName("message").Value.String())
+		assert.Len(t, wf.Spec.Arguments.Parameters, 2)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := server.SubmitWorkflow(ctx, &workflowpkg.WorkflowSubmitRequest{
+			Namespace:     "workflows",
+			ResourceKind:  "workflowtemplate",
+			ResourceName:  "workflow-template-whalesay-template",
+			SubmitOptions: &v1alpha1.SubmitOpts{ParametersFromConfigMap: "missing"},
+		})
+		require.EqualError(t, err, "rpc error: code = NotFound desc = ConfigMap 'missing' not found in namespace 'workflows'")
+	})
+}
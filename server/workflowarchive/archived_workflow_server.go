@@ -13,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/uuid"
 
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
@@ -20,6 +21,7 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/creator"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
@@ -194,6 +196,42 @@ func (w *archivedWorkflowServer) ListArchivedWorkflowLabelValues(ctx context.Con
 	return labels, nil
 }
 
+// CreateArchivedWorkflow archives a completed workflow that is not in the cluster, such as one exported from another
+// cluster with `argo export --include-status`. The archive is keyed by UID across all namespaces, so the workflow is
+// always given a new UID rather than the one it was sent with.
+func (w *archivedWorkflowServer) CreateArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.CreateArchivedWorkflowRequest) (*wfv1.Workflow, error) {
+	wf := req.Workflow
+	if wf == nil {
+		return nil, status.Error(codes.InvalidArgument, "workflow is required")
+	}
+	if req.Namespace != "" {
+		wf.Namespace = req.Namespace
+	}
+	if !wf.Status.Fulfilled() {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("workflow %q has not completed, only completed workflows can be archived", wf.Name))
+	}
+	allowed, err := auth.CanI(ctx, "create", workflow.WorkflowPlural, wf.Namespace, wf.Name)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	wf.UID = uuid.NewUUID()
+	if wf.CreationTimestamp.IsZero() {
+		wf.CreationTimestamp = wf.Status.StartedAt
+	}
+	if wf.Labels == nil {
+		wf.Labels = map[string]string{}
+	}
+	wf.Labels[common.LabelKeyCompleted] = "true"
+	wf.Labels[common.LabelKeyPhase] = string(wf.Status.Phase)
+	if err := w.wfArchive.ArchiveWorkflow(ctx, wf); err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return wf, nil
+}
+
 func (w *archivedWorkflowServer) ResubmitArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.ResubmitArchivedWorkflowRequest) (*wfv1.Workflow, error) {
 	wfClient := auth.GetWfClient(ctx)
 
@@ -16,6 +16,7 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 
@@ -111,6 +112,7 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		}, nil
 	})
 	repo.On("DeleteWorkflow", mock.Anything, "my-uid").Return(nil)
+	repo.On("ArchiveWorkflow", mock.Anything, mock.Anything).Return(nil)
 	repo.On("ListWorkflowsLabelKeys", mock.Anything).Return(&v1alpha1.LabelKeys{
 		Items: []string{"foo", "bar"},
 	}, nil)
@@ -248,4 +250,47 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, wf)
 	})
+	t.Run("CreateArchivedWorkflow", func(t *testing.T) {
+		_, err := w.CreateArchivedWorkflow(ctx, &workflowarchivepkg.CreateArchivedWorkflowRequest{
+			Workflow: &v1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "running-wf"}, Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowRunning}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		allowed = false
+		_, err = w.CreateArchivedWorkflow(ctx, &workflowarchivepkg.CreateArchivedWorkflowRequest{
+			Workflow: &v1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "imported-wf"}, Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowSucceeded}},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+		wf, err := w.CreateArchivedWorkflow(ctx, &workflowarchivepkg.CreateArchivedWorkflowRequest{
+			Namespace: "my-ns",
+			Workflow: &v1alpha1.Workflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "imported-wf"},
+				Status:     v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowSucceeded, StartedAt: createdTime, FinishedAt: finishedTime},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "my-ns", wf.Namespace)
+		assert.NotEmpty(t, wf.UID)
+		assert.Equal(t, createdTime, wf.CreationTimestamp)
+		assert.Equal(t, "true", wf.Labels[common.LabelKeyCompleted])
+		assert.Equal(t, "Succeeded", wf.Labels[common.LabelKeyPhase])
+		repo.AssertCalled(t, "ArchiveWorkflow", mock.Anything, wf)
+
+		// the same UID sent from another namespace does not replace the archived workflow
+		var uids []types.UID
+		for _, namespace := range []string{"my-ns", "other-ns"} {
+			wf, err := w.CreateArchivedWorkflow(ctx, &workflowarchivepkg.CreateArchivedWorkflowRequest{
+				Namespace: namespace,
+				Workflow: &v1alpha1.Workflow{
+					ObjectMeta: metav1.ObjectMeta{Name: "imported-wf", UID: "my-uid"},
+					Status:     v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowSucceeded},
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, namespace, wf.Namespace)
+			assert.NotEqual(t, types.UID("my-uid"), wf.UID)
+			uids = append(uids, wf.UID)
+		}
+		assert.NotEqual(t, uids[0], uids[1])
+	})
 }
@@ -95,9 +95,10 @@ func (t *Then) ExpectWorkflowNode(selector func(status wfv1.NodeStatus) bool, f
 			if n.Type == wfv1.NodeTypePod {
 				wf := &wfv1.Workflow{
 					ObjectMeta: *metadata,
+					Spec:       t.wf.Spec,
+					Status:     *status,
 				}
-				version := util.GetWorkflowPodNameVersion(wf)
-				podName := util.GeneratePodName(t.wf.Name, n.Name, util.GetTemplateFromNode(*n), n.ID, version)
+				podName := util.GenerateWorkflowPodName(wf, n.Name, util.GetTemplateFromNode(*n), n.ID)
 
 				var err error
 				ctx := logging.TestContext(t.t.Context())
@@ -4,14 +4,17 @@ package e2e
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/test/e2e/fixtures"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
 )
 
 type ResourceTemplateSuite struct {
@@ -199,6 +202,53 @@ spec:
 		})
 }
 
+func (s *ResourceTemplateSuite) TestResourceTemplateOwnerReferenceGarbageCollected() {
+	ctx := logging.TestContext(s.T().Context())
+	configMaps := s.KubeClient.CoreV1().ConfigMaps(fixtures.Namespace)
+	s.Given().
+		Workflow(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: k8s-resource-tmpl-owner-ref
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      resource:
+        action: create
+        setOwnerReference: true
+        manifest: |
+          apiVersion: v1
+          kind: ConfigMap
+          metadata:
+            name: k8s-resource-tmpl-owner-ref-cm
+          data:
+            key: value
+`).
+		When().
+		SubmitWorkflow().
+		WaitForWorkflow(fixtures.ToBeSucceeded).
+		Then().
+		ExpectWorkflow(func(t *testing.T, metadata *metav1.ObjectMeta, _ *wfv1.WorkflowStatus) {
+			cm, err := configMaps.Get(ctx, "k8s-resource-tmpl-owner-ref-cm", metav1.GetOptions{})
+			require.NoError(t, err)
+			require.Len(t, cm.OwnerReferences, 1)
+			assert.Equal(t, metadata.Name, cm.OwnerReferences[0].Name)
+			assert.Equal(t, metadata.UID, cm.OwnerReferences[0].UID)
+		}).
+		When().
+		DeleteWorkflow().
+		WaitForWorkflowDeletion().
+		Then().
+		ExpectWorkflowDeleted()
+
+	assert.Eventually(s.T(), func() bool {
+		_, err := configMaps.Get(ctx, "k8s-resource-tmpl-owner-ref-cm", metav1.GetOptions{})
+		return apierr.IsNotFound(err)
+	}, 30*time.Second, time.Second, "the ConfigMap should be garbage collected with its workflow")
+}
+
 func (s *ResourceTemplateSuite) TestResourceTemplateFailed() {
 	s.Given().
 		Workflow("@testdata/resource-templates/failed.yaml").
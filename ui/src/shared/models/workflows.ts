@@ -779,7 +779,7 @@ export interface Condition {
     message: string;
 }
 
-export type ConditionType = 'Completed' | 'SpecWarning' | 'MetricsError' | 'SubmissionError' | 'SpecError' | 'ArtifactGCError';
+export type ConditionType = 'Completed' | 'SpecWarning' | 'MetricsError' | 'SubmissionError' | 'SpecError' | 'ArtifactGCError' | 'ArtifactsCollected' | 'SuspendResolved';
 export type ConditionStatus = 'True' | 'False' | 'Unknown';
 
 /**
@@ -826,6 +826,10 @@ export interface WorkflowSpec {
         secondsAfterSuccess?: number;
         secondsAfterFailure?: number;
     };
+    /**
+     * PodNameFormat is the format of the names of the workflow's pods
+     */
+    podNameFormat?: string;
     /**
      * PodGC describes the strategy to use when to deleting completed pods
      */
@@ -2,7 +2,18 @@ import {TextEncoder} from 'util';
 
 import {ANNOTATION_KEY_POD_NAME_VERSION} from './annotations';
 import {NodeStatus, Workflow} from './models';
-import {createFNVHash, ensurePodNamePrefixLength, getPodName, getTemplateNameFromNode, k8sNamingHashLength, maxK8sResourceNameLength, POD_NAME_V1, POD_NAME_V2} from './pod-name';
+import {
+    createFNVHash,
+    ensurePodNamePrefixLength,
+    formatPodName,
+    getPodName,
+    getTemplateNameFromNode,
+    k8sNamingHashLength,
+    maxFormattedPodNameLength,
+    maxK8sResourceNameLength,
+    POD_NAME_V1,
+    POD_NAME_V2
+} from './pod-name';
 
 global.TextEncoder = TextEncoder;
 
@@ -88,6 +99,30 @@ describe('pod names', () => {
         expect(name.length).toEqual(maxK8sResourceNameLength);
     });
 
+    test('getPodName with podNameFormat', () => {
+        const node = {
+            name: 'nodename',
+            id: 'my.wf-1',
+            templateName: 'My-Template'
+        } as unknown as NodeStatus;
+        const wf = {
+            metadata: {name: 'my.wf'},
+            spec: {podNameFormat: 'acme-{{workflow.name}}-{{template.name}}'}
+        } as unknown as Workflow;
+        const hash = createFNVHash(node.name);
+
+        expect(getPodName(wf, node)).toEqual(`acme-my-wf-my-template-${hash}`);
+        expect(formatPodName('{{node.id}}-{{template.name}}', 'my.wf', node.name, '', node.id)).toEqual(`my-wf-1-${hash}`);
+
+        // the format from a workflow template is used
+        wf.status = {storedWorkflowTemplateSpec: {podNameFormat: 'team-{{template.name}}'}} as unknown as Workflow['status'];
+        expect(getPodName(wf, node)).toEqual(`team-my-template-${hash}`);
+
+        const name = formatPodName('acme-{{workflow.name}}-{{template.name}}', longWfName, node.name, longTemplateName, node.id);
+        expect(name.length).toEqual(maxFormattedPodNameLength);
+        expect(name.endsWith(`-${hash}`)).toBe(true);
+    });
+
     test('getPodName with multibyte characters', () => {
         const multibyteWfName = '日本語ワークフロー';
         const multibyteTemplateName = 'テンプレート名';
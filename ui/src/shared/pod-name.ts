@@ -7,21 +7,29 @@ export const POD_NAME_V2 = 'v2';
 export const maxK8sResourceNameLength = 253;
 export const k8sNamingHashLength = 10;
 const maxPrefixLength = maxK8sResourceNameLength - k8sNamingHashLength;
+export const maxFormattedPodNameLength = 63;
 
 // getPodName returns a deterministic pod name
+// In case the workflow has a podNameFormat, it will return the formatted name
 // In case templateName is not defined or that version is explicitly set to  POD_NAME_V1, it will return the nodeID (v1)
 // In other cases it will return a combination of workflow name, template name, and a hash (v2)
-// note: this is intended to be equivalent to the server-side Go code in workflow/util/pod_name.go#GeneratePodName
+// note: this is intended to be equivalent to the server-side Go code in workflow/util/pod_name.go#GenerateWorkflowPodName
 export function getPodName(workflow: Workflow, node: NodeStatus): string {
+    const workflowName = workflow.metadata.name;
+    // convert containerSet node name to its corresponding pod node name by removing the ".<containerName>" postfix
+    // this part is from workflow/controller/container_set_template.go#executeContainerSet; the inverse never happens in the back-end, so is unique to the front-end
+    const podNodeName = node.type == 'Container' ? node.name.replace(/\.[^/.]+$/, '') : node.name;
+
+    const podNameFormat = (workflow.status?.storedWorkflowTemplateSpec || workflow.spec)?.podNameFormat;
+    if (podNameFormat) {
+        return formatPodName(podNameFormat, workflowName, podNodeName, getTemplateNameFromNode(node), node.id);
+    }
+
     const version = workflow.metadata?.annotations?.[ANNOTATION_KEY_POD_NAME_VERSION];
     if (version === POD_NAME_V1) {
         return node.id;
     }
 
-    const workflowName = workflow.metadata.name;
-    // convert containerSet node name to its corresponding pod node name by removing the ".<containerName>" postfix
-    // this part is from workflow/controller/container_set_template.go#executeContainerSet; the inverse never happens in the back-end, so is unique to the front-end
-    const podNodeName = node.type == 'Container' ? node.name.replace(/\.[^/.]+$/, '') : node.name;
     if (workflowName === podNodeName) {
         return workflowName;
     }
@@ -37,6 +45,25 @@ export function getPodName(workflow: Workflow, node: NodeStatus): string {
     return `${prefix}-${hash}`;
 }
 
+// formatPodName returns a pod name from a podNameFormat
+// note: this is intended to be equivalent to the server-side Go code in workflow/util/pod_name.go#FormatPodName
+export function formatPodName(format: string, workflowName: string, nodeName: string, templateName: string, nodeId: string): string {
+    // workflow names and node IDs may contain dots, which are not allowed in DNS labels
+    const value = (s: string) => s.toLowerCase().replace(/\./g, '-');
+    let prefix = format
+        .replace(/{{workflow\.name}}/g, value(workflowName))
+        .replace(/{{template\.name}}/g, value(templateName))
+        .replace(/{{node\.id}}/g, value(nodeId));
+
+    const suffix = `-${createFNVHash(nodeName)}`;
+    const maxLength = maxFormattedPodNameLength - suffix.length;
+    if (prefix.length > maxLength) {
+        prefix = prefix.substring(0, maxLength);
+    }
+    // the template name may be empty, and truncation may have ended the prefix with a dash
+    return prefix.replace(/^-+|-+$/g, '') + suffix;
+}
+
 export function ensurePodNamePrefixLength(prefix: string): string {
     if (prefix.length > maxPrefixLength - 1) {
         return prefix.substring(0, maxPrefixLength - 1);
@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
 	"github.com/argoproj/argo-workflows/v3/util"
@@ -20,21 +21,33 @@ type flusher interface {
 
 // TarGzToWriter tar.gz's the source path to the supplied writer
 func TarGzToWriter(ctx context.Context, sourcePath string, level int, w io.Writer) error {
-	sourcePath, err := filepath.Abs(sourcePath)
-	if err != nil {
-		return errors.InternalErrorf("getting absolute path: %v", err)
-	}
+	return TarGzPathsToWriter(ctx, []string{sourcePath}, level, w)
+}
+
+// TarGzPathsToWriter tar.gz's the source paths into a single archive written to the supplied writer.
+// Each source path is added at the top level of the archive under its base name.
+func TarGzPathsToWriter(ctx context.Context, sourcePaths []string, level int, w io.Writer) error {
 	logger := logging.RequireLoggerFromContext(ctx)
-	logger.WithField("source", sourcePath).Info(ctx, "taring")
-	sourceFi, err := os.Stat(sourcePath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return errors.New(errors.CodeNotFound, err.Error())
+	absPaths := make([]string, len(sourcePaths))
+	isDir := make([]bool, len(sourcePaths))
+	for i, sourcePath := range sourcePaths {
+		sourcePath, err := filepath.Abs(sourcePath)
+		if err != nil {
+			return errors.InternalErrorf("getting absolute path: %v", err)
 		}
-		return errors.InternalWrapError(err)
-	}
-	if !sourceFi.Mode().IsRegular() && !sourceFi.IsDir() {
-		return errors.InternalErrorf("%s is not a regular file or directory", sourcePath)
+		logger.WithField("source", sourcePath).Info(ctx, "taring")
+		sourceFi, err := os.Stat(sourcePath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return errors.New(errors.CodeNotFound, err.Error())
+			}
+			return errors.InternalWrapError(err)
+		}
+		if !sourceFi.Mode().IsRegular() && !sourceFi.IsDir() {
+			return errors.InternalErrorf("%s is not a regular file or directory", sourcePath)
+		}
+		absPaths[i] = sourcePath
+		isDir[i] = sourceFi.IsDir()
 	}
 	if flush, ok := w.(flusher); ok {
 		defer func() { _ = flush.Flush() }()
@@ -47,10 +60,17 @@ func TarGzToWriter(ctx context.Context, sourcePath string, level int, w io.Write
 	tw := tar.NewWriter(gzw)
 	defer util.Close(tw)
 
-	if sourceFi.IsDir() {
-		return tarDir(ctx, sourcePath, tw)
+	for i, sourcePath := range absPaths {
+		if isDir[i] {
+			err = tarDir(ctx, sourcePath, tw)
+		} else {
+			err = tarFile(sourcePath, tw)
+		}
+		if err != nil {
+			return err
+		}
 	}
-	return tarFile(sourcePath, tw)
+	return nil
 }
 
 // ZipToWriter zip the source path to the supplied writer
@@ -227,3 +247,44 @@ func zipFile(sourcePath string, zw *zip.Writer) error {
 	_, err = io.Copy(fileWriter, f)
 	return err
 }
+
+// UncompressedSize returns the total size of the regular files in the .zip or .tgz archive at path.
+func UncompressedSize(path string) (int64, error) {
+	var size int64
+	if strings.HasSuffix(path, ".zip") {
+		zr, err := zip.OpenReader(path)
+		if err != nil {
+			return 0, errors.InternalWrapError(err)
+		}
+		defer util.Close(zr)
+		for _, f := range zr.File {
+			if f.Mode().IsRegular() {
+				size += int64(f.UncompressedSize64)
+			}
+		}
+		return size, nil
+	}
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return 0, errors.InternalWrapError(err)
+	}
+	defer util.Close(f)
+	gzr, err := gzip.NewReader(f)
+	if err != nil {
+		return 0, errors.InternalWrapError(err)
+	}
+	defer util.Close(gzr)
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return size, nil
+		}
+		if err != nil {
+			return 0, errors.InternalWrapError(err)
+		}
+		if header.Typeflag == tar.TypeReg {
+			size += header.Size
+		}
+	}
+}
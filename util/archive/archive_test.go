@@ -1,17 +1,21 @@
 package archive
 
 import (
+	"archive/tar"
 	"archive/zip"
 	"bufio"
+	"bytes"
 	"compress/gzip"
 	"crypto/rand"
 	"encoding/hex"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/argoproj/argo-workflows/v3/errors"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 )
 
@@ -142,6 +146,40 @@ func TestTarFile(t *testing.T) {
 	}
 }
 
+func TestTarGzPathsToWriter(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	dir := t.TempDir()
+	reports := filepath.Join(dir, "out", "reports")
+	logs := filepath.Join(dir, "var", "logs")
+	require.NoError(t, os.MkdirAll(filepath.Join(reports, "sub"), 0o700))
+	require.NoError(t, os.MkdirAll(logs, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(reports, "sub", "a.txt"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(logs, "b.log"), []byte("b"), 0o600))
+
+	t.Run("Directories", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, TarGzPathsToWriter(ctx, []string{reports, logs}, gzip.DefaultCompression, &buf))
+		gzr, err := gzip.NewReader(&buf)
+		require.NoError(t, err)
+		tr := tar.NewReader(gzr)
+		var names []string
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			names = append(names, header.Name)
+		}
+		require.Equal(t, []string{"reports", "reports/sub", "reports/sub/a.txt", "logs", "logs/b.log"}, names)
+	})
+	t.Run("Missing", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := TarGzPathsToWriter(ctx, []string{reports, filepath.Join(dir, "missing")}, gzip.DefaultCompression, &buf)
+		require.True(t, errors.IsCode(errors.CodeNotFound, err))
+	})
+}
+
 func TestZipDirectory(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -208,3 +246,37 @@ func TestZipFile(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestUncompressedSize(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src")
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "a.txt"), []byte("hello world"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "sub", "b.txt"), []byte("goodbye"), 0o600))
+
+	t.Run("TarGz", func(t *testing.T) {
+		f, err := os.Create(filepath.Join(dir, "src.tgz"))
+		require.NoError(t, err)
+		require.NoError(t, TarGzToWriter(ctx, src, gzip.DefaultCompression, bufio.NewWriter(f)))
+		require.NoError(t, f.Close())
+		size, err := UncompressedSize(f.Name())
+		require.NoError(t, err)
+		require.Equal(t, int64(18), size)
+	})
+	t.Run("Zip", func(t *testing.T) {
+		f, err := os.Create(filepath.Join(dir, "src.zip"))
+		require.NoError(t, err)
+		zw := zip.NewWriter(f)
+		require.NoError(t, ZipToWriter(ctx, src, zw))
+		require.NoError(t, zw.Close())
+		require.NoError(t, f.Close())
+		size, err := UncompressedSize(f.Name())
+		require.NoError(t, err)
+		require.Equal(t, int64(18), size)
+	})
+	t.Run("NotAnArchive", func(t *testing.T) {
+		_, err := UncompressedSize(filepath.Join(src, "a.txt"))
+		require.Error(t, err)
+	})
+}
@@ -35,6 +35,7 @@ type request interface {
 	GetLogOptions() *corev1.PodLogOptions
 	GetGrep() string
 	GetSelector() string
+	GetNodeStatusFilter() []string
 }
 
 type sender interface {
@@ -62,9 +63,50 @@ func scanLinesOrGiveLong(data []byte, atEOF bool) (advance int, token []byte, er
 	return maxTokenLength, data[0:maxTokenLength], nil
 }
 
+// nodePhaseFilter is the set of node phases whose logs are streamed. An empty filter matches every node.
+type nodePhaseFilter map[wfv1.NodePhase]bool
+
+func newNodePhaseFilter(phases []string) (nodePhaseFilter, error) {
+	filter := nodePhaseFilter{}
+	for _, phase := range phases {
+		switch p := wfv1.NodePhase(phase); p {
+		case wfv1.NodePending, wfv1.NodeRunning, wfv1.NodeSucceeded, wfv1.NodeSkipped, wfv1.NodeFailed, wfv1.NodeError, wfv1.NodeOmitted:
+			filter[p] = true
+		default:
+			return nil, fmt.Errorf("unknown node phase %q", phase)
+		}
+	}
+	return filter, nil
+}
+
+// matches returns whether the pod's node is in one of the filtered phases.
+func (f nodePhaseFilter) matches(wf *wfv1.Workflow, pod *corev1.Pod) bool {
+	if len(f) == 0 {
+		return true
+	}
+	node, ok := wf.Status.Nodes[pod.GetAnnotations()[common.AnnotationKeyNodeID]]
+	return ok && f[node.Phase]
+}
+
+// filterPods returns the pods whose nodes are in one of the filtered phases.
+func (f nodePhaseFilter) filterPods(wf *wfv1.Workflow, pods []corev1.Pod) []corev1.Pod {
+	var filtered []corev1.Pod
+	for _, pod := range pods {
+		if f.matches(wf, &pod) {
+			filtered = append(filtered, pod)
+		}
+	}
+	return filtered
+}
+
 func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient kubernetes.Interface, req request, sender sender) error {
 	wfInterface := wfClient.ArgoprojV1alpha1().Workflows(req.GetNamespace())
-	_, err := wfInterface.Get(ctx, req.GetName(), metav1.GetOptions{})
+	latestWf, err := wfInterface.Get(ctx, req.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	phaseFilter, err := newNodePhaseFilter(req.GetNodeStatusFilter())
 	if err != nil {
 		return err
 	}
@@ -100,6 +142,9 @@ func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient
 	// Keep a track of those we are logging, we also have a mutex to guard reads. Even if we stop streaming, we
 	// keep a marker here so we don't start again.
 	streamedPods := make(map[types.UID]bool)
+	// When filtering by node phase, we keep the latest workflow, and the pods we have not streamed yet because their
+	// nodes did not match, so we can start streaming them once their nodes do.
+	filteredPods := make(map[types.UID]*corev1.Pod)
 	var streamedPodsGuard sync.Mutex
 	var wg sync.WaitGroup
 	// A non-blocking channel for log entries to go down.
@@ -121,6 +166,12 @@ func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient
 		ctx, logger := logger.WithField("podName", pod.GetName()).InContext(ctx)
 		logger.WithFields(logging.Fields{"podPhase": pod.Status.Phase, "alreadyStreaming": streamedPods[pod.UID]}).Debug(ctx, "Ensuring pod logs stream")
 		if pod.Status.Phase != corev1.PodPending && !streamedPods[pod.UID] {
+			if !phaseFilter.matches(latestWf, pod) {
+				logger.Debug(ctx, "Pod node does not match node status filter")
+				filteredPods[pod.UID] = pod
+				return
+			}
+			delete(filteredPods, pod.UID)
 			streamedPods[pod.UID] = true
 			wg.Add(1)
 			go func(podName string) {
@@ -197,7 +248,13 @@ func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient
 		return list.Items[i].Status.StartTime.Before(list.Items[j].Status.StartTime)
 	})
 
-	for _, pod := range list.Items {
+	pods := list.Items
+	if !logOptions.Follow {
+		// the nodes will not change phase while we are streaming, so we only need the matching pods
+		pods = phaseFilter.filterPods(latestWf, pods)
+	}
+
+	for _, pod := range pods {
 		ensureWeAreStreaming(&pod)
 	}
 
@@ -240,6 +297,18 @@ func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient
 						return
 					}
 					logger.WithFields(logging.Fields{"eventType": event.Type, "completed": wf.Status.Fulfilled()}).Debug(ctx, "Workflow event")
+					if len(phaseFilter) > 0 {
+						streamedPodsGuard.Lock()
+						latestWf = wf
+						var pods []*corev1.Pod
+						for _, pod := range filteredPods {
+							pods = append(pods, pod)
+						}
+						streamedPodsGuard.Unlock()
+						for _, pod := range pods {
+							ensureWeAreStreaming(pod)
+						}
+					}
 					if event.Type == watch.Deleted || wf.Status.Fulfilled() {
 						return
 					}
@@ -0,0 +1,102 @@
+package logs
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wffake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+type testSender struct {
+	entries []*workflowpkg.LogEntry
+}
+
+func (s *testSender) Send(entry *workflowpkg.LogEntry) error {
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func TestWorkflowLogsPrevious(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wfClient := wffake.NewSimpleClientset(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns"}})
+	kubeClient := kubefake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyWorkflow: "my-wf"}},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	})
+	var mu sync.Mutex
+	var logOptions []corev1.PodLogOptions
+	kubeClient.PrependReactor("get", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() == "log" {
+			mu.Lock()
+			defer mu.Unlock()
+			logOptions = append(logOptions, *action.(k8stesting.GenericAction).GetValue().(*corev1.PodLogOptions))
+		}
+		return false, nil, nil
+	})
+	sender := &testSender{}
+
+	err := WorkflowLogs(ctx, wfClient, kubeClient, &workflowpkg.WorkflowLogRequest{
+		Name:       "my-wf",
+		Namespace:  "my-ns",
+		PodName:    "my-pod",
+		LogOptions: &corev1.PodLogOptions{Container: "main", Previous: true},
+	}, sender)
+	require.NoError(t, err)
+	require.Len(t, logOptions, 1)
+	assert.True(t, logOptions[0].Previous)
+	assert.Equal(t, "main", logOptions[0].Container)
+	require.Len(t, sender.entries, 1)
+	assert.Equal(t, "my-pod", sender.entries[0].PodName)
+}
+
+func TestNodePhaseFilter(t *testing.T) {
+	wf := &wfv1.Workflow{Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+		"running":   {ID: "running", Phase: wfv1.NodeRunning},
+		"failed":    {ID: "failed", Phase: wfv1.NodeFailed},
+		"errored":   {ID: "errored", Phase: wfv1.NodeError},
+		"succeeded": {ID: "succeeded", Phase: wfv1.NodeSucceeded},
+	}}}
+	pod := func(nodeID string) corev1.Pod {
+		return corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: nodeID + "-pod", Annotations: map[string]string{common.AnnotationKeyNodeID: nodeID}}}
+	}
+	pods := []corev1.Pod{pod("running"), pod("failed"), pod("errored"), pod("succeeded"), pod("unknown")}
+	podNames := func(pods []corev1.Pod) []string {
+		var names []string
+		for _, pod := range pods {
+			names = append(names, pod.Name)
+		}
+		return names
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		filter, err := newNodePhaseFilter(nil)
+		require.NoError(t, err)
+		assert.Equal(t, podNames(pods), podNames(filter.filterPods(wf, pods)))
+	})
+	t.Run("FailedAndError", func(t *testing.T) {
+		filter, err := newNodePhaseFilter([]string{"Failed", "Error"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"failed-pod", "errored-pod"}, podNames(filter.filterPods(wf, pods)))
+	})
+	t.Run("NoMatch", func(t *testing.T) {
+		filter, err := newNodePhaseFilter([]string{"Skipped"})
+		require.NoError(t, err)
+		assert.Empty(t, filter.filterPods(wf, pods))
+	})
+	t.Run("UnknownPhase", func(t *testing.T) {
+		_, err := newNodePhaseFilter([]string{"Failed", "Broken"})
+		require.EqualError(t, err, `unknown node phase "Broken"`)
+	})
+}
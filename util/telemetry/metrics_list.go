@@ -1,6 +1,18 @@
 // Code generated by util/telemetry/builder. DO NOT EDIT.
 package telemetry
 
+var InstrumentArtifactCompressionRatio = BuiltinInstrument{
+	name:        "artifact_compression_ratio",
+	description: "A gauge of the average compression ratio of the output artifacts of workflows, by namespace",
+	unit:        "{ratio}",
+	instType:    Float64ObservableGauge,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+	},
+}
+
 var InstrumentCronworkflowsConcurrencypolicyTriggered = BuiltinInstrument{
 	name:        "cronworkflows_concurrencypolicy_triggered",
 	description: "A counter of the number of times a CronWorkflow has triggered its `concurrencyPolicy` to limit the number of workflows running",
@@ -383,6 +395,30 @@ var InstrumentWorkflowCondition = BuiltinInstrument{
 	},
 }
 
+var InstrumentWorkflowQueueDurationSeconds = BuiltinInstrument{
+	name:        "workflow_queue_duration_seconds",
+	description: "A histogram of the time workflows wait before they start running",
+	unit:        "s",
+	instType:    Float64Histogram,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+	},
+	defaultBuckets: []float64{
+		1.000000,
+		5.000000,
+		10.000000,
+		30.000000,
+		60.000000,
+		120.000000,
+		300.000000,
+		600.000000,
+		1800.000000,
+		3600.000000,
+	},
+}
+
 var InstrumentWorkflowtemplateRuntime = BuiltinInstrument{
 	name:        "workflowtemplate_runtime",
 	description: "A histogram of the runtime of workflows using `workflowTemplateRef` only",
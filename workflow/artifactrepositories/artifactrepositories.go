@@ -3,6 +3,7 @@ package artifactrepositories
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	v1 "k8s.io/api/core/v1"
@@ -16,6 +17,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/retry"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 type Interface interface {
@@ -43,10 +45,17 @@ func (s *artifactRepositories) Resolve(ctx context.Context, ref *wfv1.ArtifactRe
 			{Namespace: s.namespace, ArtifactRepositoryRef: wfv1.ArtifactRepositoryRef{ConfigMap: ref.ConfigMap, Key: ref.Key}},
 		}
 	} else {
-		refs = []*wfv1.ArtifactRepositoryRefStatus{
-			{Namespace: workflowNamespace},
-			{Default: true},
+		configMap, err := s.labelledDefaultConfigMap(ctx, workflowNamespace)
+		if err != nil {
+			return nil, err
 		}
+		if configMap != "" {
+			refs = append(refs, &wfv1.ArtifactRepositoryRefStatus{Namespace: workflowNamespace, ArtifactRepositoryRef: wfv1.ArtifactRepositoryRef{ConfigMap: configMap}})
+		}
+		refs = append(refs,
+			&wfv1.ArtifactRepositoryRefStatus{Namespace: workflowNamespace},
+			&wfv1.ArtifactRepositoryRefStatus{Default: true},
+		)
 	}
 	for _, r := range refs {
 		resolvedRef, err := s.get(ctx, r)
@@ -62,6 +71,33 @@ func (s *artifactRepositories) Resolve(ctx context.Context, ref *wfv1.ArtifactRe
 	return nil, fmt.Errorf(`failed to find any artifact repository for artifact repository ref "%v"`, ref)
 }
 
+// labelledDefaultConfigMap returns the name of the config map in the namespace labelled as the default artifact
+// repositories, or "" if there is none.
+func (s *artifactRepositories) labelledDefaultConfigMap(ctx context.Context, namespace string) (string, error) {
+	var list *v1.ConfigMapList
+	err := waitutil.Backoff(retry.DefaultRetry(ctx), func() (bool, error) {
+		var err error
+		list, err = s.kubernetesInterface.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{LabelSelector: common.LabelKeyDefaultArtifactRepositories + "=true"})
+		return !errorsutil.IsTransientErrQuiet(ctx, err), err
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list default artifact repositories config maps in namespace %q: %w", namespace, err)
+	}
+	var names []string
+	for _, cm := range list.Items {
+		names = append(names, cm.Name)
+	}
+	switch len(names) {
+	case 0:
+		return "", nil
+	case 1:
+		return names[0], nil
+	default:
+		sort.Strings(names)
+		return "", fmt.Errorf("more than one config map in namespace %q is labelled %s=true: %s", namespace, common.LabelKeyDefaultArtifactRepositories, strings.Join(names, ", "))
+	}
+}
+
 func (s *artifactRepositories) Get(ctx context.Context, ref *wfv1.ArtifactRepositoryRefStatus) (*wfv1.ArtifactRepository, error) {
 	ref, err := s.get(ctx, ref)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 func TestArtifactRepositories(t *testing.T) {
@@ -122,6 +123,77 @@ s3:
 		err = k.CoreV1().ConfigMaps("my-wf-ns").Delete(ctx, "artifact-repositories", metav1.DeleteOptions{})
 		require.NoError(t, err)
 	})
+	t.Run("LabelledDefault", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		for _, cm := range []*corev1.ConfigMap{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "artifact-repositories",
+					Annotations: map[string]string{"workflows.argoproj.io/default-artifact-repository": "default-v1"},
+				},
+				Data: map[string]string{"default-v1": "s3: {keyFormat: unlabelled}"},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "team-repositories",
+					Labels:      map[string]string{common.LabelKeyDefaultArtifactRepositories: "true"},
+					Annotations: map[string]string{"workflows.argoproj.io/default-artifact-repository": "team-v1"},
+				},
+				Data: map[string]string{"team-v1": "s3: {keyFormat: labelled}"},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "other-repositories",
+					Labels: map[string]string{common.LabelKeyDefaultArtifactRepositories: "false"},
+				},
+			},
+		} {
+			_, err := k.CoreV1().ConfigMaps("my-wf-ns").Create(ctx, cm, metav1.CreateOptions{})
+			require.NoError(t, err)
+		}
+
+		ref, err := i.Resolve(ctx, nil, "my-wf-ns")
+		require.NoError(t, err)
+		assert.Equal(t, "team-repositories", ref.ConfigMap)
+		assert.Equal(t, "team-v1", ref.Key)
+		assert.Equal(t, &wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{KeyFormat: "labelled"}}, ref.ArtifactRepository)
+
+		// a labelled config map without a default key falls back to the "artifact-repositories" config map
+		cm, err := k.CoreV1().ConfigMaps("my-wf-ns").Get(ctx, "team-repositories", metav1.GetOptions{})
+		require.NoError(t, err)
+		cm.Annotations = nil
+		_, err = k.CoreV1().ConfigMaps("my-wf-ns").Update(ctx, cm, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		ref, err = i.Resolve(ctx, nil, "my-wf-ns")
+		require.NoError(t, err)
+		assert.Equal(t, "artifact-repositories", ref.ConfigMap)
+		assert.Equal(t, "default-v1", ref.Key)
+
+		// an explicit ref ignores the label
+		ref, err = i.Resolve(ctx, &wfv1.ArtifactRepositoryRef{Key: "default-v1"}, "my-wf-ns")
+		require.NoError(t, err)
+		assert.Equal(t, "artifact-repositories", ref.ConfigMap)
+
+		for _, name := range []string{"artifact-repositories", "team-repositories", "other-repositories"} {
+			require.NoError(t, k.CoreV1().ConfigMaps("my-wf-ns").Delete(ctx, name, metav1.DeleteOptions{}))
+		}
+	})
+	t.Run("MultipleLabelledDefaults", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		for _, name := range []string{"b-repositories", "a-repositories"} {
+			_, err := k.CoreV1().ConfigMaps("my-wf-ns").Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{common.LabelKeyDefaultArtifactRepositories: "true"}},
+			}, metav1.CreateOptions{})
+			require.NoError(t, err)
+		}
+
+		_, err := i.Resolve(ctx, nil, "my-wf-ns")
+		require.EqualError(t, err, `more than one config map in namespace "my-wf-ns" is labelled workflows.argoproj.io/default-artifact-repositories=true: a-repositories, b-repositories`)
+
+		for _, name := range []string{"b-repositories", "a-repositories"} {
+			require.NoError(t, k.CoreV1().ConfigMaps("my-wf-ns").Delete(ctx, name, metav1.DeleteOptions{}))
+		}
+	})
 	t.Run("DefaultWithNamespace", func(t *testing.T) {
 		ctx := logging.TestContext(t.Context())
 		_, err := k.CoreV1().ConfigMaps("my-wf-ns").Create(ctx, &corev1.ConfigMap{
@@ -111,6 +111,7 @@ func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 	if art.HTTP != nil {
 		var client *gohttp.Client
 		driver := http.ArtifactDriver{}
+		insecureSkipVerify := art.HTTP.TLSConfig != nil && art.HTTP.TLSConfig.InsecureSkipVerify
 		if art.HTTP.Auth != nil && art.HTTP.Auth.BasicAuth.UsernameSecret != nil {
 			usernameBytes, err := ri.GetSecret(ctx, art.HTTP.Auth.BasicAuth.UsernameSecret.Name, art.HTTP.Auth.BasicAuth.UsernameSecret.Key)
 			if err != nil {
@@ -138,7 +139,7 @@ func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 			if err != nil {
 				return nil, err
 			}
-			client = http.CreateOauth2Client(ctx, clientID, clientSecret, tokenURL, art.HTTP.Auth.OAuth2.Scopes, art.HTTP.Auth.OAuth2.EndpointParams)
+			client = http.CreateOauth2Client(ctx, clientID, clientSecret, tokenURL, art.HTTP.Auth.OAuth2.Scopes, art.HTTP.Auth.OAuth2.EndpointParams, insecureSkipVerify)
 		}
 		if art.HTTP.Auth != nil && art.HTTP.Auth.ClientCert.ClientCertSecret != nil && art.HTTP.Auth.ClientCert.ClientKeySecret != nil {
 			clientCert, err := ri.GetSecret(ctx, art.HTTP.Auth.ClientCert.ClientCertSecret.Name, art.HTTP.Auth.ClientCert.ClientCertSecret.Key)
@@ -149,13 +150,13 @@ func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 			if err != nil {
 				return nil, err
 			}
-			client, err = http.CreateClientWithCertificate([]byte(clientCert), []byte(clientKey))
+			client, err = http.CreateClientWithCertificate([]byte(clientCert), []byte(clientKey), insecureSkipVerify)
 			if err != nil {
 				return nil, err
 			}
 		}
 		if client == nil {
-			client = &gohttp.Client{}
+			client = http.CreateClient(insecureSkipVerify)
 		}
 		driver.Client = client
 		return &driver, nil
@@ -242,8 +243,8 @@ func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 	}
 
 	if art.GCS != nil {
-		driver := gcs.ArtifactDriver{}
-		if art.GCS.ServiceAccountKeySecret != nil && art.GCS.ServiceAccountKeySecret.Name != "" {
+		driver := gcs.ArtifactDriver{UseWorkloadIdentity: art.GCS.UseWorkloadIdentity}
+		if !art.GCS.UseWorkloadIdentity && art.GCS.ServiceAccountKeySecret != nil && art.GCS.ServiceAccountKeySecret.Name != "" {
 			serviceAccountKeyBytes, err := ri.GetSecret(ctx, art.GCS.ServiceAccountKeySecret.Name, art.GCS.ServiceAccountKeySecret.Key)
 			if err != nil {
 				return nil, err
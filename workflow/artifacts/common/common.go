@@ -31,6 +31,18 @@ type ArtifactDriver interface {
 	IsDirectory(ctx context.Context, artifact *v1alpha1.Artifact) (bool, error)
 }
 
+// ArtifactStreamSaver is implemented by artifact drivers that can save an artifact from a stream, without it being
+// on disk
+type ArtifactStreamSaver interface {
+	// SaveStream uploads the contents of the reader to the artifact destination. The reader cannot be read again, so
+	// implementations must not retry.
+	SaveStream(ctx context.Context, reader io.Reader, outputArtifact *v1alpha1.Artifact) error
+}
+
+// ErrSaveStreamNotSupported is returned by artifact drivers that wrap a driver that cannot save an artifact from a
+// stream
+var ErrSaveStreamNotSupported = errors.New("saving an artifact from a stream is not supported for this artifact storage")
+
 // ErrDeleteNotSupported Sentinel error definition for artifact deletion
 var ErrDeleteNotSupported = errors.New("delete not supported for this artifact storage, please check" +
 	" the following issue for details: https://github.com/argoproj/argo-workflows/issues/3102")
@@ -28,12 +28,15 @@ import (
 
 // ArtifactDriver is a driver for GCS
 type ArtifactDriver struct {
-	ServiceAccountKey string
+	ServiceAccountKey   string
+	UseWorkloadIdentity bool
 }
 
 var (
 	_            common.ArtifactDriver = &ArtifactDriver{}
 	defaultRetry                       = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1, Cap: time.Minute * 10}
+	// findDefaultCredentials is replaced in tests
+	findDefaultCredentials = google.FindDefaultCredentials
 )
 
 // from https://github.com/googleapis/google-cloud-go/blob/master/storage/go110.go
@@ -69,6 +72,9 @@ func isTransientGCSErr(ctx context.Context, err error) bool {
 }
 
 func (h *ArtifactDriver) newGCSClient(ctx context.Context) (*storage.Client, error) {
+	if h.UseWorkloadIdentity {
+		return newGCSClientWithWorkloadIdentity(ctx)
+	}
 	if h.ServiceAccountKey != "" {
 		return newGCSClientWithCredential(ctx, h.ServiceAccountKey)
 	}
@@ -88,6 +94,23 @@ func newGCSClientWithCredential(ctx context.Context, serviceAccountJSON string)
 	return client, nil
 }
 
+// newGCSClientWithWorkloadIdentity creates a client from Application Default Credentials. A token is fetched
+// up front so that a missing Workload Identity binding is reported here rather than on the first request.
+func newGCSClientWithWorkloadIdentity(ctx context.Context) (*storage.Client, error) {
+	creds, err := findDefaultCredentials(ctx, storage.ScopeReadWrite)
+	if err != nil {
+		return nil, fmt.Errorf("GCS client FindDefaultCredentials: %w", err)
+	}
+	if _, err := creds.TokenSource.Token(); err != nil {
+		return nil, fmt.Errorf("GCS client workload identity token: %w", err)
+	}
+	client, err := storage.NewClient(ctx, option.WithCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("GCS storage.NewClient with workload identity: %w", err)
+	}
+	return client, nil
+}
+
 func newGCSClientDefault(ctx context.Context) (*storage.Client, error) {
 	client, err := storage.NewClient(ctx)
 	if err != nil {
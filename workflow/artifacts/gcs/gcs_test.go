@@ -1,12 +1,18 @@
 package gcs
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/url"
 	"testing"
 
+	"cloud.google.com/go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
 	"google.golang.org/api/googleapi"
 
 	argoErrors "github.com/argoproj/argo-workflows/v3/errors"
@@ -47,3 +53,55 @@ func TestIsTransientGCSErr(t *testing.T) {
 		}
 	}
 }
+
+type countingTokenSource struct {
+	calls int
+	err   error
+}
+
+func (c *countingTokenSource) Token() (*oauth2.Token, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &oauth2.Token{AccessToken: "fake-token"}, nil
+}
+
+func TestNewGCSClientWithWorkloadIdentity(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	mockDefaultCredentials := func(ts oauth2.TokenSource) {
+		orig := findDefaultCredentials
+		t.Cleanup(func() { findDefaultCredentials = orig })
+		findDefaultCredentials = func(ctx context.Context, scopes ...string) (*google.Credentials, error) {
+			require.Equal(t, []string{storage.ScopeReadWrite}, scopes)
+			return &google.Credentials{TokenSource: ts}, nil
+		}
+	}
+
+	t.Run("UsesDefaultCredentials", func(t *testing.T) {
+		ts := &countingTokenSource{}
+		mockDefaultCredentials(ts)
+		// the key would fail to parse, so the client can only be created if it is ignored
+		driver := &ArtifactDriver{ServiceAccountKey: "not-json", UseWorkloadIdentity: true}
+		client, err := driver.newGCSClient(ctx)
+		require.NoError(t, err)
+		defer client.Close()
+		assert.Equal(t, 1, ts.calls)
+	})
+	t.Run("TokenError", func(t *testing.T) {
+		mockDefaultCredentials(&countingTokenSource{err: errors.New("metadata server unavailable")})
+		driver := &ArtifactDriver{UseWorkloadIdentity: true}
+		_, err := driver.newGCSClient(ctx)
+		require.EqualError(t, err, "GCS client workload identity token: metadata server unavailable")
+	})
+	t.Run("NoDefaultCredentials", func(t *testing.T) {
+		orig := findDefaultCredentials
+		t.Cleanup(func() { findDefaultCredentials = orig })
+		findDefaultCredentials = func(ctx context.Context, scopes ...string) (*google.Credentials, error) {
+			return nil, errors.New("could not find default credentials")
+		}
+		driver := &ArtifactDriver{UseWorkloadIdentity: true}
+		_, err := driver.newGCSClient(ctx)
+		require.EqualError(t, err, "GCS client FindDefaultCredentials: could not find default credentials")
+	})
+}
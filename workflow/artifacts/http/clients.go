@@ -6,25 +6,37 @@ import (
 	"net/http"
 	"net/url"
 
+	"golang.org/x/oauth2"
 	cc "golang.org/x/oauth2/clientcredentials"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
-func CreateClientWithCertificate(clientCert, clientKey []byte) (*http.Client, error) {
+// CreateClient returns a client which skips verification of the server's certificate if insecureSkipVerify is set
+func CreateClient(insecureSkipVerify bool) *http.Client {
+	if !insecureSkipVerify {
+		return &http.Client{}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	return &http.Client{Transport: transport}
+}
+
+func CreateClientWithCertificate(clientCert, clientKey []byte, insecureSkipVerify bool) (*http.Client, error) {
 	cert, err := tls.X509KeyPair(clientCert, clientKey)
 	if err != nil {
 		return nil, err
 	}
 	tlsConfig := &tls.Config{
-		Certificates: []tls.Certificate{cert},
+		Certificates:       []tls.Certificate{cert},
+		InsecureSkipVerify: insecureSkipVerify,
 	}
 	transport := &http.Transport{TLSClientConfig: tlsConfig}
 	client := &http.Client{Transport: transport}
 	return client, err
 }
 
-func CreateOauth2Client(ctx context.Context, clientID, clientSecret, tokenURL string, scopes []string, endpointParams []wfv1.OAuth2EndpointParam) *http.Client {
+func CreateOauth2Client(ctx context.Context, clientID, clientSecret, tokenURL string, scopes []string, endpointParams []wfv1.OAuth2EndpointParam, insecureSkipVerify bool) *http.Client {
 	values := url.Values{}
 	for _, endpointParam := range endpointParams {
 		values.Add(endpointParam.Key, endpointParam.Value)
@@ -36,5 +48,6 @@ func CreateOauth2Client(ctx context.Context, clientID, clientSecret, tokenURL st
 		EndpointParams: values,
 		Scopes:         scopes,
 	}
-	return conf.Client(ctx)
+	// the base client is used both to fetch the token and to make the authenticated requests
+	return conf.Client(context.WithValue(ctx, oauth2.HTTPClient, CreateClient(insecureSkipVerify)))
 }
@@ -13,20 +13,20 @@ import (
 func TestCreateOauth2Client(t *testing.T) {
 	endpointParams := []wfv1.OAuth2EndpointParam{{Key: "key", Value: "value"}}
 	scopes := []string{"some", "scopes"}
-	client := CreateOauth2Client(logging.TestContext(t.Context()), "clientID", "clientSecret", "tokenURL", scopes, endpointParams)
+	client := CreateOauth2Client(logging.TestContext(t.Context()), "clientID", "clientSecret", "tokenURL", scopes, endpointParams, false)
 
 	assert.NotNil(t, client)
 }
 
 func TestCreateClientWithCertificateInvalidCert(t *testing.T) {
-	client, err := CreateClientWithCertificate([]byte("invalidCert"), []byte("invalidKey"))
+	client, err := CreateClientWithCertificate([]byte("invalidCert"), []byte("invalidKey"), false)
 
 	require.Error(t, err)
 	assert.Nil(t, client)
 }
 
 func TestCreateClientWithCertificateValidCert(t *testing.T) {
-	client, err := CreateClientWithCertificate([]byte(CertPem), []byte(KeyPem))
+	client, err := CreateClientWithCertificate([]byte(CertPem), []byte(KeyPem), false)
 
 	require.NoError(t, err)
 	assert.NotNil(t, client)
@@ -21,6 +21,7 @@ type ArtifactDriver struct {
 }
 
 var _ common.ArtifactDriver = &ArtifactDriver{}
+var _ common.ArtifactStreamSaver = &ArtifactDriver{}
 
 func (h *ArtifactDriver) retrieveContent(inputArtifact *wfv1.Artifact) (http.Response, error) {
 	var req *http.Request
@@ -99,18 +100,32 @@ func (h *ArtifactDriver) Save(ctx context.Context, path string, outputArtifact *
 	if err != nil {
 		return err
 	}
+	// we set the GetBody func of the request in order to enable following 307 POST/PUT redirects, needed e.g. for webHDFS
+	return h.put(f, func() (io.ReadCloser, error) {
+		return os.Open(cleanPath)
+	}, "file "+path, outputArtifact)
+}
+
+// SaveStream writes the contents of the reader to the URL. Redirects are not followed, as the reader cannot be read
+// again.
+func (h *ArtifactDriver) SaveStream(ctx context.Context, reader io.Reader, outputArtifact *wfv1.Artifact) error {
+	return h.put(reader, nil, "stream", outputArtifact)
+}
+
+func (h *ArtifactDriver) put(body io.Reader, getBody func() (io.ReadCloser, error), source string, outputArtifact *wfv1.Artifact) error {
 	var req *http.Request
 	var url string
+	var err error
 	if outputArtifact.Artifactory != nil && outputArtifact.HTTP == nil {
 		url = outputArtifact.Artifactory.URL
-		req, err = http.NewRequest(http.MethodPut, url, f)
+		req, err = http.NewRequest(http.MethodPut, url, body)
 		if err != nil {
 			return err
 		}
 		req.SetBasicAuth(h.Username, h.Password)
 	} else {
 		url = outputArtifact.HTTP.URL
-		req, err = http.NewRequest(http.MethodPut, url, f)
+		req, err = http.NewRequest(http.MethodPut, url, body)
 		if err != nil {
 			return err
 		}
@@ -121,10 +136,7 @@ func (h *ArtifactDriver) Save(ctx context.Context, path string, outputArtifact *
 			req.SetBasicAuth(h.Username, h.Password)
 		}
 	}
-	// we set the GetBody func of the request in order to enable following 307 POST/PUT redirects, needed e.g. for webHDFS
-	req.GetBody = func() (io.ReadCloser, error) {
-		return os.Open(cleanPath)
-	}
+	req.GetBody = getBody
 
 	res, err := h.Client.Do(req)
 	if err != nil {
@@ -134,7 +146,7 @@ func (h *ArtifactDriver) Save(ctx context.Context, path string, outputArtifact *
 		_ = res.Body.Close()
 	}()
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		return errors.InternalErrorf("saving file %s to %s failed with reason: %s", path, url, res.Status)
+		return errors.InternalErrorf("saving %s to %s failed with reason: %s", source, url, res.Status)
 	}
 	return nil
 }
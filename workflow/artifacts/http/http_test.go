@@ -144,3 +144,56 @@ func TestSaveHTTPArtifactRedirect(t *testing.T) {
 	})
 
 }
+
+func TestSaveHTTPArtifact(t *testing.T) {
+	tempFile := filepath.Join(t.TempDir(), "tmpfile")
+	content := []byte("temporary file's content")
+	require.NoError(t, os.WriteFile(tempFile, content, 0o600))
+
+	var received []byte
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "my-value", r.Header.Get("X-My-Header"))
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "my-username", username)
+		assert.Equal(t, "my-password", password)
+		buf := new(bytes.Buffer)
+		_, err := buf.ReadFrom(r.Body)
+		assert.NoError(t, err)
+		received = buf.Bytes()
+		w.WriteHeader(http.StatusCreated)
+	})
+	art := func(url string) *wfv1.Artifact {
+		return &wfv1.Artifact{
+			ArtifactLocation: wfv1.ArtifactLocation{
+				HTTP: &wfv1.HTTPArtifact{
+					URL:     url,
+					Headers: []wfv1.Header{{Name: "X-My-Header", Value: "my-value"}},
+				},
+			},
+		}
+	}
+	ctx := logging.TestContext(t.Context())
+
+	t.Run("HTTP", func(t *testing.T) {
+		received = nil
+		svr := httptest.NewServer(handler)
+		defer svr.Close()
+		driver := ArtifactDriver{Username: "my-username", Password: "my-password", Client: CreateClient(false)}
+		require.NoError(t, driver.Save(ctx, tempFile, art(svr.URL)))
+		assert.Equal(t, content, received)
+	})
+
+	t.Run("InsecureSkipVerify", func(t *testing.T) {
+		received = nil
+		svr := httptest.NewTLSServer(handler)
+		defer svr.Close()
+		driver := ArtifactDriver{Username: "my-username", Password: "my-password", Client: CreateClient(false)}
+		require.Error(t, driver.Save(ctx, tempFile, art(svr.URL)))
+
+		driver.Client = CreateClient(true)
+		require.NoError(t, driver.Save(ctx, tempFile, art(svr.URL)))
+		assert.Equal(t, content, received)
+	})
+}
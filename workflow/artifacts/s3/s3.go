@@ -36,10 +36,17 @@ import (
 
 const nullIAMEndpoint = ""
 
+// streamPartSize is the size of the parts of a stream uploaded without a known size. S3 allows 10,000 parts, so
+// this allows streams of up to 640GiB.
+const streamPartSize = 64 * 1024 * 1024
+
 type S3Client interface {
 	// PutFile puts a single file to a bucket at the specified key
 	PutFile(bucket, key, path string) error
 
+	// PutStream puts the contents of the reader to a bucket at the specified key, without knowing its size
+	PutStream(bucket, key string, reader io.Reader) error
+
 	// PutDirectory puts a complete directory into a bucket key prefix, with each file in the directory
 	// a separate key in the bucket.
 	PutDirectory(bucket, key, path string) error
@@ -132,6 +139,7 @@ type ArtifactDriver struct {
 }
 
 var _ artifactscommon.ArtifactDriver = &ArtifactDriver{}
+var _ artifactscommon.ArtifactStreamSaver = &ArtifactDriver{}
 
 // newS3Client instantiates a new S3 client object.
 func (s3Driver *ArtifactDriver) newS3Client(ctx context.Context) (S3Client, error) {
@@ -264,6 +272,27 @@ func (s3Driver *ArtifactDriver) Save(ctx context.Context, path string, outputArt
 	return err
 }
 
+// SaveStream saves the contents of the reader to an S3 compliant storage. The reader cannot be read again, so it is
+// not retried.
+func (s3Driver *ArtifactDriver) SaveStream(ctx context.Context, reader io.Reader, outputArtifact *wfv1.Artifact) error {
+	logging.RequireLoggerFromContext(ctx).WithField("key", outputArtifact.S3.Key).Info(ctx, "S3 Save stream")
+	s3cli, err := s3Driver.newS3Client(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create new S3 client: %v", err)
+	}
+	return saveS3Stream(ctx, s3cli, reader, outputArtifact)
+}
+
+func saveS3Stream(ctx context.Context, s3cli S3Client, reader io.Reader, outputArtifact *wfv1.Artifact) error {
+	if err := createBucketIfNotPresent(ctx, s3cli, outputArtifact); err != nil {
+		return fmt.Errorf("failed to create bucket %s: %v", outputArtifact.S3.Bucket, err)
+	}
+	if err := s3cli.PutStream(outputArtifact.S3.Bucket, outputArtifact.S3.Key, reader); err != nil {
+		return fmt.Errorf("failed to put stream: %v", err)
+	}
+	return nil
+}
+
 // Delete deletes an artifact from an S3 compliant storage
 func (s3Driver *ArtifactDriver) Delete(ctx context.Context, artifact *wfv1.Artifact) error {
 	ctx, cancel := context.WithCancel(ctx)
@@ -307,22 +336,8 @@ func saveS3Artifact(ctx context.Context, s3cli S3Client, path string, outputArti
 	if err != nil {
 		return true, fmt.Errorf("failed to test if %s is a directory: %v", path, err)
 	}
-	log := logging.RequireLoggerFromContext(ctx)
-	createBucketIfNotPresent := outputArtifact.S3.CreateBucketIfNotPresent
-	if createBucketIfNotPresent != nil {
-		log.WithField("bucket", outputArtifact.S3.Bucket).Info(ctx, "creating bucket")
-		err := s3cli.MakeBucket(outputArtifact.S3.Bucket, minio.MakeBucketOptions{
-			Region:        outputArtifact.S3.Region,
-			ObjectLocking: outputArtifact.S3.CreateBucketIfNotPresent.ObjectLocking,
-		})
-		alreadyExists := bucketAlreadyExistsErr(err)
-		log.WithField("bucket", outputArtifact.S3.Bucket).
-			WithField("alreadyExists", alreadyExists).
-			WithError(err).
-			Info(ctx, "create bucket failed")
-		if err != nil && !alreadyExists {
-			return !isTransientS3Err(ctx, err), fmt.Errorf("failed to create bucket %s: %v", outputArtifact.S3.Bucket, err)
-		}
+	if err := createBucketIfNotPresent(ctx, s3cli, outputArtifact); err != nil {
+		return !isTransientS3Err(ctx, err), fmt.Errorf("failed to create bucket %s: %v", outputArtifact.S3.Bucket, err)
 	}
 
 	if isDir {
@@ -337,6 +352,28 @@ func saveS3Artifact(ctx context.Context, s3cli S3Client, path string, outputArti
 	return true, nil
 }
 
+// createBucketIfNotPresent creates the bucket of the output artifact if it asks for it, and it does not exist
+func createBucketIfNotPresent(ctx context.Context, s3cli S3Client, outputArtifact *wfv1.Artifact) error {
+	if outputArtifact.S3.CreateBucketIfNotPresent == nil {
+		return nil
+	}
+	log := logging.RequireLoggerFromContext(ctx)
+	log.WithField("bucket", outputArtifact.S3.Bucket).Info(ctx, "creating bucket")
+	err := s3cli.MakeBucket(outputArtifact.S3.Bucket, minio.MakeBucketOptions{
+		Region:        outputArtifact.S3.Region,
+		ObjectLocking: outputArtifact.S3.CreateBucketIfNotPresent.ObjectLocking,
+	})
+	alreadyExists := bucketAlreadyExistsErr(err)
+	log.WithField("bucket", outputArtifact.S3.Bucket).
+		WithField("alreadyExists", alreadyExists).
+		WithError(err).
+		Info(ctx, "create bucket failed")
+	if err != nil && !alreadyExists {
+		return err
+	}
+	return nil
+}
+
 func bucketAlreadyExistsErr(err error) bool {
 	resp := &minio.ErrorResponse{}
 	// https://docs.aws.amazon.com/AmazonS3/latest/API/ErrorResponses.html
@@ -521,6 +558,18 @@ func (s *s3client) PutFile(bucket, key, path string) error {
 	return nil
 }
 
+// PutStream puts the contents of the reader to a bucket at the specified key, with a multipart upload
+func (s *s3client) PutStream(bucket, key string, reader io.Reader) error {
+	logging.RequireLoggerFromContext(s.ctx).WithFields(logging.Fields{"endpoint": s.Endpoint, "bucket": bucket, "key": key}).Info(s.ctx, "Saving stream to s3")
+	encOpts, err := s.EncryptOpts.buildServerSideEnc(bucket, key)
+	if err != nil {
+		return err
+	}
+	// a size of -1 streams the reader in parts, each of which is buffered in memory
+	_, err = s.minioClient.PutObject(s.ctx, bucket, key, reader, -1, minio.PutObjectOptions{SendContentMd5: s.SendContentMd5, ServerSideEncryption: encOpts, PartSize: streamPartSize})
+	return err
+}
+
 func (s *s3client) BucketExists(bucketName string) (bool, error) {
 	logging.RequireLoggerFromContext(s.ctx).WithField("bucket", bucketName).Info(s.ctx, "Checking if bucket exists")
 	result, err := s.minioClient.BucketExists(s.ctx, bucketName)
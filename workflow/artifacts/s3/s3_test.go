@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 )
@@ -46,6 +47,18 @@ func (s *mockS3Client) PutFile(bucket, key, path string) error {
 	return s.getMockedErr("PutFile")
 }
 
+// PutStream puts the contents of the reader to a bucket at the specified key
+func (s *mockS3Client) PutStream(bucket, key string, reader io.Reader) error {
+	if err := s.getMockedErr("PutStream"); err != nil {
+		return err
+	}
+	_, err := io.Copy(io.Discard, reader)
+	if err == nil {
+		s.files[bucket] = append(s.files[bucket], key)
+	}
+	return err
+}
+
 // PutDirectory puts a complete directory into a bucket key prefix, with each file in the directory
 // a separate key in the bucket.
 func (s *mockS3Client) PutDirectory(bucket, key, path string) error {
@@ -407,6 +420,34 @@ func TestLoadS3Artifact(t *testing.T) {
 	}
 }
 
+// A missing key must be reported as not found, so that the executor can skip optional input artifacts
+func TestLoadS3ArtifactNoSuchKeyIsNotFound(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	s3client := newMockS3Client(
+		map[string][]string{
+			"my-bucket": {
+				"/folder/hello-art-2.tar.gz",
+			},
+		},
+		map[string]error{
+			"GetFile": minio.ErrorResponse{
+				Code: "NoSuchKey",
+			},
+		})
+	_, err := loadS3Artifact(ctx, s3client, &wfv1.Artifact{
+		ArtifactLocation: wfv1.ArtifactLocation{
+			S3: &wfv1.S3Artifact{
+				S3Bucket: wfv1.S3Bucket{
+					Bucket: "my-bucket",
+				},
+				Key: "/folder/hello-art.tar.gz",
+			},
+		},
+	}, "/tmp/hello-art.tar.gz")
+	require.Error(t, err)
+	assert.True(t, argoerrs.IsCode(argoerrs.CodeNotFound, err))
+}
+
 func TestSaveS3Artifact(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
 
@@ -543,6 +584,29 @@ func TestSaveS3Artifact(t *testing.T) {
 	}
 }
 
+func TestSaveS3Stream(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	artifact := &wfv1.Artifact{
+		ArtifactLocation: wfv1.ArtifactLocation{
+			S3: &wfv1.S3Artifact{
+				S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket", CreateBucketIfNotPresent: &wfv1.CreateS3BucketOptions{}},
+				Key:      "folder/hello-art.txt",
+			},
+		},
+	}
+	t.Run("Success", func(t *testing.T) {
+		s3client := newMockS3Client(map[string][]string{"my-bucket": {}}, nil)
+		require.NoError(t, saveS3Stream(ctx, s3client, strings.NewReader("hello"), artifact))
+		assert.Equal(t, []string{"folder/hello-art.txt"}, s3client.(*mockS3Client).files["my-bucket"])
+	})
+	t.Run("Error", func(t *testing.T) {
+		s3client := newMockS3Client(map[string][]string{"my-bucket": {}}, map[string]error{
+			"PutStream": minio.ErrorResponse{Code: "InternalError", Message: "We encountered an internal error, please try again."},
+		})
+		require.EqualError(t, saveS3Stream(ctx, s3client, strings.NewReader("hello"), artifact), "failed to put stream: We encountered an internal error, please try again.")
+	})
+}
+
 func TestListObjects(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
 	tests := map[string]struct {
@@ -740,3 +804,56 @@ func TestDisallowedComboOptions(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+// recordingTransport records the requests made by the minio client and responds with success.
+type recordingTransport struct {
+	requests []*http.Request
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Etag": []string{`"etag"`}},
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+func TestPutFileServerSideEncryption(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0o600))
+	for _, tt := range []struct {
+		name        string
+		encryptOpts EncryptOpts
+		wantHeaders map[string]string
+	}{
+		{name: "SSE-S3", encryptOpts: EncryptOpts{Enabled: true}, wantHeaders: map[string]string{"X-Amz-Server-Side-Encryption": "AES256"}},
+		{name: "SSE-KMS", encryptOpts: EncryptOpts{Enabled: true, KmsKeyID: "my-key"}, wantHeaders: map[string]string{
+			"X-Amz-Server-Side-Encryption":                "aws:kms",
+			"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id": "my-key",
+		}},
+		{name: "Disabled", encryptOpts: EncryptOpts{KmsKeyID: "my-key"}, wantHeaders: map[string]string{"X-Amz-Server-Side-Encryption": ""}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &recordingTransport{}
+			s3cli, err := NewS3Client(logging.TestContext(t.Context()), S3ClientOpts{
+				Endpoint:    "s3.example.com",
+				Region:      "us-east-1",
+				Secure:      true,
+				Transport:   transport,
+				AccessKey:   "key",
+				SecretKey:   "secret",
+				EncryptOpts: tt.encryptOpts,
+			})
+			require.NoError(t, err)
+			require.NoError(t, s3cli.PutFile("my-bucket", "my-key", path))
+			require.Len(t, transport.requests, 1)
+			req := transport.requests[0]
+			assert.Equal(t, http.MethodPut, req.Method)
+			for header, value := range tt.wantHeaders {
+				assert.Equal(t, value, req.Header.Get(header), header)
+			}
+		})
+	}
+}
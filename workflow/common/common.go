@@ -1,6 +1,8 @@
 package common
 
 import (
+	"strings"
+
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
@@ -56,6 +58,10 @@ const (
 	// LabelParallelismLimit is a label applied on namespace objects to control the per namespace parallelism.
 	LabelParallelismLimit = workflow.WorkflowFullName + "/parallelism-limit"
 
+	// AnnotationKeySuspended is set on a pod by the controller while its node is paused, the executor
+	// stops the main container process while it is present
+	AnnotationKeySuspended = workflow.WorkflowFullName + "/suspended"
+
 	// AnnotationKeyPodGCStrategy is listed as an annotation on the Pod
 	// the strategy for the pod, in case the pod is orphaned from its workflow
 	AnnotationKeyPodGCStrategy = workflow.WorkflowFullName + "/pod-gc-strategy"
@@ -85,6 +91,9 @@ const (
 	LabelKeyWorkflowArchivingStatus = workflow.WorkflowFullName + "/workflow-archiving-status"
 	// LabelKeyWorkflow is the pod metadata label to indicate the associated workflow name
 	LabelKeyWorkflow = workflow.WorkflowFullName + "/workflow"
+	// LabelKeyWorkflowUID is the pod metadata label to indicate the associated workflow uid.
+	// It is only set when spec.podAntiAffinity.workflowScoped is enabled.
+	LabelKeyWorkflowUID = workflow.WorkflowFullName + "/workflow-uid"
 	// LabelKeyComponent determines what component within a workflow, intentionally similar to app.kubernetes.io/component.
 	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/common-labels/
 	LabelKeyComponent = workflow.WorkflowFullName + "/component"
@@ -96,6 +105,8 @@ const (
 	LabelKeyCronWorkflow = workflow.WorkflowFullName + "/cron-workflow"
 	// LabelKeyWorkflowTemplate is a label applied to Workflows that are submitted from Workflowtemplate
 	LabelKeyWorkflowTemplate = workflow.WorkflowFullName + "/workflow-template"
+	// LabelKeyAutoscaleTemplate is the label of the VerticalPodAutoscalers that recommend the resources of the template with autoscaleResources set
+	LabelKeyAutoscaleTemplate = workflow.WorkflowFullName + "/template"
 	// LabelKeyWorkflowEventBinding is a label applied to Workflows that are submitted from a WorkflowEventBinding
 	LabelKeyWorkflowEventBinding = workflow.WorkflowFullName + "/workflow-event-binding"
 	// LabelKeyClusterWorkflowTemplate is a label applied to Workflows that are submitted from ClusterWorkflowtemplate
@@ -113,6 +124,14 @@ const (
 	// LabelKeyCronWorkflowBackfill is a label applied to the cron workflow when the workflow is created by backfill
 	LabelKeyCronWorkflowBackfill = workflow.WorkflowFullName + "/backfill"
 
+	// LabelKeyAggregatedPullSecret is a label applied to the image pull secrets that are managed by the controller
+	LabelKeyAggregatedPullSecret = workflow.WorkflowFullName + "/aggregated-pull-secret"
+	// AnnotationKeyPullSecretExpiry is the time that the tokens of a managed image pull secret expire
+	AnnotationKeyPullSecretExpiry = workflow.WorkflowFullName + "/pull-secret-expiry"
+	// AnnotationKeyVaultEnv is a template annotation of environment variables that the executor reads from the Vault
+	// agent, and adds to the main containers
+	AnnotationKeyVaultEnv = workflow.WorkflowFullName + "/vault-env"
+
 	// ExecutorArtifactBaseDir is the base directory in the init container in which artifacts will be copied to.
 	// Each artifact will be named according to its input name (e.g: /argo/inputs/artifacts/CODE)
 	ExecutorArtifactBaseDir = "/argo/inputs/artifacts"
@@ -127,6 +146,11 @@ const (
 	ExecutorStagingEmptyDir = "/argo/staging"
 	// ExecutorScriptSourcePath is the path which init will write the script source file to for script templates
 	ExecutorScriptSourcePath = "/argo/staging/script"
+	// ExecutorScriptConfigMapDir is the path the init container mounts the ConfigMap holding the script source at,
+	// for script templates whose source is not included in the template
+	ExecutorScriptConfigMapDir = "/argo/script"
+	// ScriptConfigMapKey is the key of the script source in that ConfigMap
+	ScriptConfigMapKey = "script"
 	// ExecutorResourceManifestPath is the path which init will write the manifest file to for resource templates
 	ExecutorResourceManifestPath = "/tmp/manifest.yaml"
 
@@ -176,6 +200,10 @@ const (
 	EnvVarPodStatusCaptureFinalizer = "ARGO_POD_STATUS_CAPTURE_FINALIZER"
 	// EnvAgentTaskWorkers is the number of task workers for the agent pod
 	EnvAgentTaskWorkers = "ARGO_AGENT_TASK_WORKERS"
+	// EnvVarConcurrentDownloads is the number of input artifacts the executor downloads at the same time
+	EnvVarConcurrentDownloads = "EXECUTOR_CONCURRENT_DOWNLOADS"
+	// EnvVarArtifactCacheDir is the directory in which the executor caches the input artifacts of a workflow
+	EnvVarArtifactCacheDir = "EXECUTOR_ARTIFACT_CACHE_DIR"
 	// EnvAgentPatchRate is the rate that the Argo Agent will patch the Workflow TaskSet
 	EnvAgentPatchRate = "ARGO_AGENT_PATCH_RATE"
 
@@ -230,6 +258,9 @@ const (
 	LabelValueTypeConfigMapParameter = "Parameter"
 	// LabelValueTypeConfigMapExecutorPlugin is a key for configmaps that contains an executor plugin.
 	LabelValueTypeConfigMapExecutorPlugin = "ExecutorPlugin"
+	// LabelKeyDefaultArtifactRepositories marks a config map as the default artifact repositories of its namespace,
+	// used instead of the "artifact-repositories" config map when a workflow has no artifactRepositoryRef.
+	LabelKeyDefaultArtifactRepositories = "workflows.argoproj.io/default-artifact-repositories"
 
 	// LocalVarPodName is a step level variable that references the name of the pod
 	LocalVarPodName = "pod.name"
@@ -270,12 +301,27 @@ const (
 	// ArgoProgressPath defines the path to a file used for self reporting progress
 	ArgoProgressPath = VarRunArgoPath + "/progress"
 
+	// ArgoAbsentArtifactsPath is the directory in which the init container records the optional input artifacts it did not load
+	ArgoAbsentArtifactsPath = VarRunArgoPath + "/inputs/absent-artifacts"
+
 	ConfigMapName = "workflow-controller-configmap"
 )
 
 // AnnotationKeyKillCmd specifies the command to use to kill to container, useful for injected sidecars
 var AnnotationKeyKillCmd = func(containerName string) string { return workflow.WorkflowFullName + "/kill-cmd-" + containerName }
 
+// EnvVarArtifactPresent is the environment variable that tells the main container whether an optional input artifact
+// was loaded, e.g. ARGO_ARTIFACT_MY_ART_PRESENT for an artifact named my-art
+var EnvVarArtifactPresent = func(artifactName string) string {
+	name := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, artifactName)
+	return "ARGO_ARTIFACT_" + strings.ToUpper(name) + "_PRESENT"
+}
+
 // GlobalVarWorkflowRootTags is a list of root tags in workflow which could be used for variable reference
 var GlobalVarValidWorkflowVariablePrefix = []string{"item.", "steps.", "inputs.", "outputs.", "pod.", "workflow.", "tasks."}
 
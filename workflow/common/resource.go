@@ -0,0 +1,75 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ResourceMergeStrategyServerSide is the merge strategy of a resource template that uses server-side apply
+	ResourceMergeStrategyServerSide = "server-side"
+	// ResourceFieldManager is the field manager of server-side applies, unless the flags set another one
+	ResourceFieldManager = "argo-workflows"
+)
+
+// allowedResourceFlags are the kubectl flags that may be set in a resource template's flags. Flags that change which
+// cluster or credentials kubectl uses, or that conflict with the arguments added by the executor (such as -f and -o),
+// are deliberately not allowed.
+var allowedResourceFlags = map[string]bool{
+	"-A":                 true,
+	"--all":              true,
+	"--all-namespaces":   true,
+	"--cascade":          true,
+	"--dry-run":          true,
+	"--field-manager":    true,
+	"--field-selector":   true,
+	"--force":            true,
+	"--force-conflicts":  true,
+	"--grace-period":     true,
+	"--ignore-not-found": true,
+	"-l":                 true,
+	"--selector":         true,
+	"-n":                 true,
+	"--namespace":        true,
+	"--now":              true,
+	"--overwrite":        true,
+	"-R":                 true,
+	"--recursive":        true,
+	"--save-config":      true,
+	"--server-side":      true,
+	"--subresource":      true,
+	"--timeout":          true,
+	"--validate":         true,
+	"--wait":             true,
+}
+
+// ValidateResourceFlags returns an error if any of the resource template flags is not allowed. Arguments that are not
+// flags, such as the kind and name of the resource to patch or the value of the preceding flag, are allowed.
+func ValidateResourceFlags(flags []string) error {
+	for _, flag := range flags {
+		if !strings.HasPrefix(flag, "-") {
+			continue
+		}
+		name := flag
+		if strings.HasPrefix(flag, "--") {
+			name, _, _ = strings.Cut(flag, "=")
+		} else if len(flag) > 2 {
+			// short flags may have their value attached, e.g. -lapp=foo
+			name = flag[:2]
+		}
+		if !allowedResourceFlags[name] {
+			return fmt.Errorf("flag %q is not allowed", name)
+		}
+	}
+	return nil
+}
+
+// HasResourceFlag returns whether the resource template flags include the flag, with or without a value
+func HasResourceFlag(flags []string, name string) bool {
+	for _, flag := range flags {
+		if flag == name || strings.HasPrefix(flag, name+"=") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateResourceFlags(t *testing.T) {
+	for _, flags := range [][]string{
+		nil,
+		{"--validate=false"},
+		{"--server-side", "--field-manager", "argo", "--force-conflicts"},
+		{"--server-side", "--field-manager=argo"},
+		{"configmap", "--selector", "app=foo"},
+		{"pod", "my-pod", "-lapp=foo", "-n", "my-ns"},
+	} {
+		require.NoError(t, ValidateResourceFlags(flags), flags)
+	}
+	for flags, name := range map[string]string{
+		"--kubeconfig=/tmp/config": "--kubeconfig",
+		"--token":                  "--token",
+		"-o":                       "-o",
+		"-fmanifest.yaml":          "-f",
+		"--server=https://evil":    "--server",
+	} {
+		require.EqualError(t, ValidateResourceFlags([]string{"--server-side", flags}), `flag "`+name+`" is not allowed`)
+	}
+}
@@ -5,9 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/parser"
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes"
@@ -16,6 +19,7 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	exprenv "github.com/argoproj/argo-workflows/v3/util/expr/env"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/template"
 )
@@ -101,6 +105,32 @@ func overwriteWithDefaultParams(inParam *wfv1.Parameter) {
 	}
 }
 
+// overwriteWithDefaultExpression sets the value of an input parameter that was not supplied from its default expression.
+// When validating, global variables are placeholders, so an expression that cannot be evaluated is only checked to be
+// syntactically valid, and the parameter is given a placeholder value.
+func overwriteWithDefaultExpression(inParam *wfv1.Parameter, globalParams Parameters, validateOnly bool) error {
+	if inParam.DefaultExpression == "" || inParam.Value != nil || inParam.ValueFrom != nil {
+		return nil
+	}
+	env := exprenv.GetFuncMap(template.EnvMap(globalParams))
+	program, err := expr.Compile(inParam.DefaultExpression, expr.Env(env))
+	if err == nil {
+		var result interface{}
+		result, err = expr.Run(program, env)
+		if err == nil {
+			inParam.Value = wfv1.AnyStringPtr(result)
+			return nil
+		}
+	}
+	if validateOnly {
+		if _, parseErr := parser.Parse(inParam.DefaultExpression); parseErr == nil {
+			inParam.Value = wfv1.AnyStringPtr(NewPlaceholderGenerator().NextPlaceholder())
+			return nil
+		}
+	}
+	return errors.Errorf(errors.CodeBadRequest, "failed to evaluate inputs.parameters.%s.defaultExpression %q: %v", inParam.Name, inParam.DefaultExpression, err)
+}
+
 func overwriteWithArguments(argParam, inParam *wfv1.Parameter) {
 	if argParam != nil {
 		if argParam.Value != nil {
@@ -154,6 +184,24 @@ func substituteAndGetConfigMapValue(ctx context.Context, inParam *wfv1.Parameter
 	return nil
 }
 
+// ResolveFromOutputs returns a copy of args in which every parameter using fromOutput is given the equivalent
+// '{{<prefix>.STEP.outputs.parameters.PARAM}}' value, so that it is resolved like any other reference
+func ResolveFromOutputs(args wfv1.Arguments, prefix string) wfv1.Arguments {
+	if len(args.Parameters) == 0 {
+		return args
+	}
+	params := make([]wfv1.Parameter, len(args.Parameters))
+	for i, param := range args.Parameters {
+		if ref := param.FromOutput; ref != nil {
+			param.Value = wfv1.AnyStringPtr(fmt.Sprintf("{{%s.%s.outputs.parameters.%s}}", prefix, ref.Step, ref.Parameter))
+			param.FromOutput = nil
+		}
+		params[i] = param
+	}
+	args.Parameters = params
+	return args
+}
+
 // ProcessArgs sets in the inputs, the values either passed via arguments, or the hardwired values
 // It substitutes:
 // * parameters in the template from the arguments
@@ -173,6 +221,11 @@ func ProcessArgs(ctx context.Context, tmpl *wfv1.Template, args wfv1.ArgumentsPr
 		argParam := args.GetParameterByName(inParam.Name)
 		overwriteWithArguments(argParam, &inParam)
 
+		// evaluate the default expression only if no value was supplied
+		if err := overwriteWithDefaultExpression(&inParam, globalParams, validateOnly); err != nil {
+			return nil, err
+		}
+
 		// substitute configmap string and get value from store
 		err := substituteAndGetConfigMapValue(ctx, &inParam, globalParams, namespace, configMapStore)
 		if err != nil {
@@ -208,6 +261,23 @@ func ProcessArgs(ctx context.Context, tmpl *wfv1.Template, args wfv1.ArgumentsPr
 	return SubstituteParams(ctx, newTmpl, globalParams, localParams)
 }
 
+// checkParameterEnum returns an error if the parameter has an enum that does not contain its value. Values that are
+// not known yet, such as unresolved variables and the placeholders used during validation, are not checked.
+func checkParameterEnum(param wfv1.Parameter) error {
+	if len(param.Enum) == 0 || param.Value == nil || slices.Contains(param.Enum, *param.Value) {
+		return nil
+	}
+	value := param.Value.String()
+	if strings.Contains(value, "{{") || NewPlaceholderGenerator().IsPlaceholder(value) {
+		return nil
+	}
+	allowed := make([]string, len(param.Enum))
+	for i, enum := range param.Enum {
+		allowed[i] = enum.String()
+	}
+	return errors.Errorf(errors.CodeBadRequest, "inputs.parameters.%s value '%s' is not one of the allowed values: %s", param.Name, value, strings.Join(allowed, ", "))
+}
+
 // substituteConfigMapKeyRefParam performs template substitution for ConfigMapKeyRef
 func substituteConfigMapKeyRefParam(ctx context.Context, in string, replaceMap map[string]interface{}) (string, error) {
 	tmpl, err := template.NewTemplate(in)
@@ -243,6 +313,9 @@ func SubstituteParams(ctx context.Context, tmpl *wfv1.Template, globalParams, lo
 		if inParam.Value == nil && inParam.ValueFrom == nil {
 			return nil, errors.InternalErrorf("inputs.parameters.%s had no value", inParam.Name)
 		} else if inParam.Value != nil {
+			if err := checkParameterEnum(inParam); err != nil {
+				return nil, err
+			}
 			replaceMap["inputs.parameters."+inParam.Name] = inParam.Value.String()
 		}
 	}
@@ -302,6 +375,28 @@ func GenerateOnExitNodeName(parentNodeName string) string {
 	return fmt.Sprintf("%s.onExit", parentNodeName)
 }
 
+// LivenessProbeRestart returns a message if a main container of the pod has been restarted after its livenessProbe
+// failed, or "" otherwise. The restarted container does not report an exit code, so its node would never complete.
+func LivenessProbeRestart(pod *apiv1.Pod, tmpl *wfv1.Template) string {
+	probed := make(map[string]bool)
+	for _, c := range pod.Spec.Containers {
+		if c.LivenessProbe != nil && tmpl.IsMainContainerName(c.Name) {
+			probed[c.Name] = true
+		}
+	}
+	for _, c := range pod.Status.ContainerStatuses {
+		if !probed[c.Name] || c.RestartCount == 0 {
+			continue
+		}
+		message := fmt.Sprintf("%s: container restarted after its livenessProbe failed", c.Name)
+		if t := c.LastTerminationState.Terminated; t != nil {
+			message = fmt.Sprintf("%s (exit code %d)", message, t.ExitCode)
+		}
+		return message
+	}
+	return ""
+}
+
 func IsDone(un *unstructured.Unstructured) bool {
 	return un.GetDeletionTimestamp() == nil &&
 		un.GetLabels()[LabelKeyCompleted] == "true" &&
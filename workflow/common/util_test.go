@@ -9,6 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 )
@@ -363,3 +364,91 @@ func TestOverridableTemplateInputParamsValueFrom(t *testing.T) {
 	assert.NotNil(t, newTmpl)
 	assert.Equal(t, newTmpl.Inputs.Parameters[0].Value.String(), overrideConfigMapValue)
 }
+
+func TestProcessArgsEnum(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	tmpl := func(enum ...string) *wfv1.Template {
+		param := wfv1.Parameter{Name: "color"}
+		for _, e := range enum {
+			param.Enum = append(param.Enum, wfv1.AnyString(e))
+		}
+		return &wfv1.Template{Name: "paint", Inputs: wfv1.Inputs{Parameters: []wfv1.Parameter{param}}}
+	}
+	args := func(value string) *wfv1.Arguments {
+		return &wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "color", Value: wfv1.AnyStringPtr(value)}}}
+	}
+	t.Run("EmptyEnum", func(t *testing.T) {
+		_, err := ProcessArgs(ctx, tmpl(), args("red"), Parameters{}, Parameters{}, false, "", nil)
+		require.NoError(t, err)
+	})
+	t.Run("SingleValue", func(t *testing.T) {
+		_, err := ProcessArgs(ctx, tmpl("red"), args("red"), Parameters{}, Parameters{}, false, "", nil)
+		require.NoError(t, err)
+		_, err = ProcessArgs(ctx, tmpl("red"), args("blue"), Parameters{}, Parameters{}, false, "", nil)
+		require.EqualError(t, err, "inputs.parameters.color value 'blue' is not one of the allowed values: red")
+	})
+	t.Run("InvalidValue", func(t *testing.T) {
+		_, err := ProcessArgs(ctx, tmpl("red", "green"), args("blue"), Parameters{}, Parameters{}, false, "", nil)
+		require.EqualError(t, err, "inputs.parameters.color value 'blue' is not one of the allowed values: red, green")
+		var argoErr errors.ArgoError
+		require.ErrorAs(t, err, &argoErr)
+		assert.Equal(t, errors.CodeBadRequest, argoErr.Code())
+	})
+	t.Run("GlobalParameter", func(t *testing.T) {
+		_, err := ProcessArgs(ctx, tmpl("red", "green"), args("{{workflow.parameters.color}}"), Parameters{"workflow.parameters.color": "blue"}, Parameters{}, false, "", nil)
+		require.EqualError(t, err, "inputs.parameters.color value 'blue' is not one of the allowed values: red, green")
+	})
+	t.Run("Unresolved", func(t *testing.T) {
+		_, err := ProcessArgs(ctx, tmpl("red", "green"), args("{{steps.pick.outputs.result}}"), Parameters{}, Parameters{}, true, "", nil)
+		require.NoError(t, err)
+	})
+}
+
+func TestProcessArgsDefaultExpression(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	globalParams := Parameters{
+		"workflow.name":                      "my-wf",
+		"workflow.creationTimestamp":         "2024-05-06T07:08:09Z",
+		"workflow.creationTimestamp.RFC3339": "2024-05-06T07:08:09Z",
+		"workflow.creationTimestamp.Y":       "2024",
+	}
+	tmpl := func(expression string) *wfv1.Template {
+		return &wfv1.Template{
+			Name:      "print",
+			Inputs:    wfv1.Inputs{Parameters: []wfv1.Parameter{{Name: "date", DefaultExpression: expression}}},
+			Container: &corev1.Container{Args: []string{"{{inputs.parameters.date}}"}},
+		}
+	}
+	noArgs := &wfv1.Arguments{}
+	t.Run("CreationTimestamp", func(t *testing.T) {
+		newTmpl, err := ProcessArgs(ctx, tmpl("sprig.trunc(10, workflow.creationTimestamp.RFC3339)"), noArgs, globalParams, Parameters{}, false, "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "2024-05-06", newTmpl.Inputs.Parameters[0].Value.String())
+		assert.Equal(t, []string{"2024-05-06"}, newTmpl.Container.Args)
+	})
+	t.Run("NotString", func(t *testing.T) {
+		newTmpl, err := ProcessArgs(ctx, tmpl("asInt(workflow.creationTimestamp.Y) + 1"), noArgs, globalParams, Parameters{}, false, "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "2025", newTmpl.Inputs.Parameters[0].Value.String())
+	})
+	t.Run("Supplied", func(t *testing.T) {
+		// the expression cannot be evaluated, but is not needed
+		args := &wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "date", Value: wfv1.AnyStringPtr("yesterday")}}}
+		newTmpl, err := ProcessArgs(ctx, tmpl("workflow.missing.value"), args, globalParams, Parameters{}, false, "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "yesterday", newTmpl.Inputs.Parameters[0].Value.String())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ProcessArgs(ctx, tmpl("workflow.missing.value"), noArgs, globalParams, Parameters{}, false, "", nil)
+		require.ErrorContains(t, err, `failed to evaluate inputs.parameters.date.defaultExpression "workflow.missing.value"`)
+	})
+	t.Run("ValidateOnly", func(t *testing.T) {
+		placeholders := Parameters{"workflow.name": "placeholder-1"}
+		newTmpl, err := ProcessArgs(ctx, tmpl("sprig.trunc(10, workflow.creationTimestamp.RFC3339)"), noArgs, placeholders, Parameters{}, true, "", nil)
+		require.NoError(t, err)
+		assert.True(t, NewPlaceholderGenerator().IsPlaceholder(newTmpl.Inputs.Parameters[0].Value.String()))
+
+		_, err = ProcessArgs(ctx, tmpl("sprig.trunc(10, "), noArgs, placeholders, Parameters{}, true, "", nil)
+		require.ErrorContains(t, err, "failed to evaluate inputs.parameters.date.defaultExpression")
+	})
+}
@@ -6,6 +6,8 @@ import (
 	"hash/fnv"
 	"slices"
 	"sort"
+	"strings"
+	"time"
 
 	"golang.org/x/exp/maps"
 	corev1 "k8s.io/api/core/v1"
@@ -25,6 +27,10 @@ import (
 
 const artifactGCComponent = "artifact-gc"
 
+// artifactGCOnExpiry is used in place of an ArtifactGCStrategy for the deletion of artifacts that have reached
+// their expiresAt time. It is not a strategy users can choose.
+const artifactGCOnExpiry wfv1.ArtifactGCStrategy = "OnExpiry"
+
 // artifactGCEnabled is a feature flag to globally disabled artifact GC in case of emergency
 var artifactGCEnabled, _ = env.GetBool("ARGO_ARTIFACT_GC_ENABLED", true)
 
@@ -70,7 +76,12 @@ func (woc *wfOperationCtx) garbageCollectArtifacts(ctx context.Context) error {
 		}
 	}
 
-	err := woc.processArtifactGCCompletion(ctx)
+	err := woc.processExpiredArtifacts(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = woc.processArtifactGCCompletion(ctx)
 	if err != nil {
 		return err
 	}
@@ -86,7 +97,7 @@ func (woc *wfOperationCtx) HasArtifactGC() bool {
 	for _, template := range woc.execWf.Spec.Templates {
 		for _, artifact := range template.Outputs.Artifacts {
 			strategy := woc.execWf.GetArtifactGCStrategy(&artifact)
-			if strategy != wfv1.ArtifactGCStrategyUndefined && strategy != wfv1.ArtifactGCNever {
+			if strategy != wfv1.ArtifactGCStrategyUndefined && strategy != wfv1.ArtifactGCNever || artifact.ExpiresAt != nil {
 				return true
 			}
 		}
@@ -96,7 +107,7 @@ func (woc *wfOperationCtx) HasArtifactGC() bool {
 	for _, template := range woc.wf.Status.StoredTemplates {
 		for _, artifact := range template.Outputs.Artifacts {
 			strategy := woc.execWf.GetArtifactGCStrategy(&artifact)
-			if strategy != wfv1.ArtifactGCStrategyUndefined && strategy != wfv1.ArtifactGCNever {
+			if strategy != wfv1.ArtifactGCStrategyUndefined && strategy != wfv1.ArtifactGCNever || artifact.ExpiresAt != nil {
 				return true
 			}
 		}
@@ -113,6 +124,9 @@ func (woc *wfOperationCtx) artifactGCStrategiesReady() map[wfv1.ArtifactGCStrate
 		if !woc.wf.Status.ArtifactGCStatus.IsArtifactGCStrategyProcessed(wfv1.ArtifactGCOnWorkflowCompletion) {
 			strategies[wfv1.ArtifactGCOnWorkflowCompletion] = struct{}{}
 		}
+		if !woc.wf.Status.ArtifactGCStatus.IsArtifactGCStrategyProcessed(wfv1.ArtifactGCDryRun) {
+			strategies[wfv1.ArtifactGCDryRun] = struct{}{}
+		}
 	}
 	if woc.wf.DeletionTimestamp != nil {
 		if !woc.wf.Status.ArtifactGCStatus.IsArtifactGCStrategyProcessed(wfv1.ArtifactGCOnWorkflowDeletion) {
@@ -133,8 +147,6 @@ func (woc *wfOperationCtx) processArtifactGCStrategy(ctx context.Context, strate
 		woc.updated = true
 	}()
 
-	var err error
-
 	woc.log.WithField("strategy", strategy).Debug(ctx, "processing Artifact GC Strategy")
 
 	// Search for artifacts
@@ -144,6 +156,50 @@ func (woc *wfOperationCtx) processArtifactGCStrategy(ctx context.Context, strate
 		return nil
 	}
 
+	return woc.createArtifactGCPods(ctx, strategy, artifactSearchResults)
+}
+
+// processExpiredArtifacts starts up Pods to delete the artifacts which have reached their expiresAt time,
+// and requeues the Workflow for when the next artifact expires
+func (woc *wfOperationCtx) processExpiredArtifacts(ctx context.Context) error {
+	now := nowFn()
+	var expired wfv1.ArtifactSearchResults
+	var nextExpiry time.Time
+	for _, n := range woc.wf.Status.Nodes {
+		if n.Type != wfv1.NodeTypePod {
+			continue
+		}
+		for _, a := range n.GetOutputs().GetArtifacts() {
+			if a.ExpiresAt == nil || a.Deleted {
+				continue
+			}
+			// artifacts of a strategy which has been processed are already being deleted
+			if woc.wf.Status.ArtifactGCStatus.IsArtifactGCStrategyProcessed(woc.execWf.GetArtifactGCStrategy(&a)) {
+				continue
+			}
+			if a.ExpiresAt.After(now) {
+				if nextExpiry.IsZero() || a.ExpiresAt.Time.Before(nextExpiry) {
+					nextExpiry = a.ExpiresAt.Time
+				}
+				continue
+			}
+			expired = append(expired, wfv1.ArtifactSearchResult{Artifact: a, NodeID: n.ID})
+		}
+	}
+	if !nextExpiry.IsZero() {
+		woc.requeueAfter(nextExpiry.Sub(now))
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	woc.log.WithField("artifacts", len(expired)).Info(ctx, "deleting expired artifacts")
+	return woc.createArtifactGCPods(ctx, artifactGCOnExpiry, expired)
+}
+
+// createArtifactGCPods starts up the Pods and WorkflowArtifactGCTasks to delete the given artifacts
+func (woc *wfOperationCtx) createArtifactGCPods(ctx context.Context, strategy wfv1.ArtifactGCStrategy, artifactSearchResults wfv1.ArtifactSearchResults) error {
+	var err error
+
 	// cache the templates by name so we can find them easily
 	templatesByName := make(map[string]*wfv1.Template)
 
@@ -164,6 +220,10 @@ func (woc *wfOperationCtx) processArtifactGCStrategy(ctx context.Context, strate
 	for _, artifactSearchResult := range artifactSearchResults {
 		// get the permissions required for this artifact and create a unique Pod name from them
 		podInfo = woc.getArtifactGCPodInfo(&artifactSearchResult.Artifact)
+		if strategy == artifactGCOnExpiry {
+			// artifacts expiring at different times are deleted by different Pods
+			podInfo.expiresAt = artifactSearchResult.ExpiresAt.UTC().Format(time.RFC3339)
+		}
 		podName, err = woc.artGCPodName(strategy, podInfo)
 		if err != nil {
 			return err
@@ -237,6 +297,7 @@ type podInfo struct {
 	serviceAccount string
 	podMetadata    wfv1.Metadata
 	podSpecPatch   string
+	expiresAt      string
 }
 
 // get Pod name
@@ -261,6 +322,9 @@ func (woc *wfOperationCtx) artGCPodName(strategy wfv1.ArtifactGCStrategy, podInf
 		_, _ = h.Write([]byte(annotation))
 		_, _ = h.Write([]byte(annotationValue))
 	}
+	if podInfo.expiresAt != "" {
+		_, _ = h.Write([]byte(podInfo.expiresAt))
+	}
 
 	abbreviatedName := ""
 	switch strategy {
@@ -268,6 +332,10 @@ func (woc *wfOperationCtx) artGCPodName(strategy wfv1.ArtifactGCStrategy, podInf
 		abbreviatedName = "wfcomp"
 	case wfv1.ArtifactGCOnWorkflowDeletion:
 		abbreviatedName = "wfdel"
+	case wfv1.ArtifactGCDryRun:
+		abbreviatedName = "dryrun"
+	case artifactGCOnExpiry:
+		abbreviatedName = "exp"
 	default:
 		return "", fmt.Errorf("ArtifactGCStrategy %q not valid", strategy)
 	}
@@ -415,6 +483,11 @@ func (woc *wfOperationCtx) createArtifactGCPod(ctx context.Context, strategy wfv
 
 	volumes, volumeMounts := createSecretVolumesAndMountsFromArtifactLocations(artifactLocations)
 
+	args := append([]string{"artifact", "delete"}, woc.getExecutorLogOpts(ctx)...)
+	if strategy == wfv1.ArtifactGCDryRun {
+		args = append(args, "--dry-run")
+	}
+
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: podName,
@@ -437,7 +510,7 @@ func (woc *wfOperationCtx) createArtifactGCPod(ctx context.Context, strategy wfv
 					Name:            common.MainContainerName,
 					Image:           woc.controller.executorImage(),
 					ImagePullPolicy: woc.controller.executorImagePullPolicy(),
-					Args:            append([]string{"artifact", "delete"}, woc.getExecutorLogOpts(ctx)...),
+					Args:            args,
 					Env: []corev1.EnvVar{
 						{Name: common.EnvVarArtifactGCPodHash, Value: woc.artifactGCPodLabel(podName)},
 					},
@@ -561,14 +634,34 @@ func (woc *wfOperationCtx) allArtifactsDeleted() bool {
 			continue
 		}
 		for _, a := range n.GetOutputs().GetArtifacts() {
+			// a dry run deletes nothing, so it is done once the Pod reporting the artifact has been reconciled
+			if woc.execWf.GetArtifactGCStrategy(&a) == wfv1.ArtifactGCDryRun {
+				if !woc.artifactGCDryRunRecouped(&a) {
+					return false
+				}
+				continue
+			}
 			if !a.Deleted && woc.execWf.GetArtifactGCStrategy(&a) != wfv1.ArtifactGCNever && woc.execWf.GetArtifactGCStrategy(&a) != wfv1.ArtifactGCStrategyUndefined {
 				return false
 			}
+			// keep the Workflow around until its artifacts expire, unless it is being deleted
+			if !a.Deleted && a.ExpiresAt != nil && woc.wf.DeletionTimestamp == nil {
+				return false
+			}
 		}
 	}
 	return true
 }
 
+// artifactGCDryRunRecouped returns whether the DryRun Pod for the artifact has completed and been reconciled
+func (woc *wfOperationCtx) artifactGCDryRunRecouped(artifact *wfv1.Artifact) bool {
+	if !woc.wf.Status.ArtifactGCStatus.IsArtifactGCStrategyProcessed(wfv1.ArtifactGCDryRun) {
+		return false
+	}
+	podName, err := woc.artGCPodName(wfv1.ArtifactGCDryRun, woc.getArtifactGCPodInfo(artifact))
+	return err == nil && woc.wf.Status.ArtifactGCStatus.IsArtifactGCPodRecouped(podName)
+}
+
 func (woc *wfOperationCtx) findArtifactsToGC(strategy wfv1.ArtifactGCStrategy) wfv1.ArtifactSearchResults {
 
 	var results wfv1.ArtifactSearchResults
@@ -637,6 +730,7 @@ func (woc *wfOperationCtx) processCompletedWorkflowArtifactGCTask(ctx context.Co
 	woc.log.WithField("name", artifactGCTask.Name).Debug(ctx, "processing WorkflowArtifactGCTask")
 
 	foundGCFailure := false
+	var dryRunResults []string
 	for nodeName, nodeResult := range artifactGCTask.Status.ArtifactResultsByNode {
 		// find this node result in the Workflow Status
 		wfNode, err := woc.wf.Status.Nodes.Get(nodeName)
@@ -655,8 +749,14 @@ func (woc *wfOperationCtx) processCompletedWorkflowArtifactGCTask(ctx context.Co
 				continue
 			}
 
-			wfNode.Outputs.Artifacts[i].Deleted = artifactResult.Success
-			woc.wf.Status.Nodes.Set(ctx, nodeName, *wfNode)
+			if strategy == wfv1.ArtifactGCDryRun {
+				if artifactResult.Success {
+					dryRunResults = append(dryRunResults, fmt.Sprintf("%s (%s)", wfArtifact.Name, artifactGCTaskStorageURI(artifactGCTask, nodeName, wfArtifact.Name)))
+				}
+			} else {
+				wfNode.Outputs.Artifacts[i].Deleted = artifactResult.Success
+				woc.wf.Status.Nodes.Set(ctx, nodeName, *wfNode)
+			}
 
 			if artifactResult.Error != nil {
 				woc.addArtGCCondition(fmt.Sprintf("%s (artifactGCTask: %s)", *artifactResult.Error, artifactGCTask.Name))
@@ -671,9 +771,25 @@ func (woc *wfOperationCtx) processCompletedWorkflowArtifactGCTask(ctx context.Co
 
 	}
 
+	if len(dryRunResults) > 0 {
+		sort.Strings(dryRunResults)
+		woc.eventRecorder.Event(woc.wf, corev1.EventTypeNormal, "ArtifactGCDryRun",
+			fmt.Sprintf("Artifact Garbage Collection dry run would delete %d artifact(s): %s", len(dryRunResults), strings.Join(dryRunResults, ", ")))
+	}
+
 	return !foundGCFailure, nil
 }
 
+// artifactGCTaskStorageURI returns the storage URI of an artifact in the Spec of a WorkflowArtifactGCTask
+func artifactGCTaskStorageURI(artifactGCTask *wfv1.WorkflowArtifactGCTask, nodeName, artifactName string) string {
+	artifactNodeSpec := artifactGCTask.Spec.ArtifactsByNode[nodeName]
+	artifact := artifactNodeSpec.Artifacts[artifactName]
+	if artifactNodeSpec.ArchiveLocation != nil {
+		_ = artifact.Relocate(artifactNodeSpec.ArchiveLocation)
+	}
+	return artifact.StorageURI()
+}
+
 func (woc *wfOperationCtx) addArtGCCondition(msg string) {
 	woc.wf.Status.Conditions.UpsertCondition(wfv1.Condition{
 		Type:    wfv1.ConditionTypeArtifactGCError,
@@ -3,6 +3,7 @@ package controller
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 var artgcWorkflow = `apiVersion: argoproj.io/v1alpha1
@@ -683,6 +685,13 @@ func TestWorkflowHasArtifactGC(t *testing.T) {
                         strategy: Never`,
 			expectedResult: false,
 		},
+		{
+			name:                      "ArtifactSpecExpiresAt",
+			workflowArtGCStrategySpec: "",
+			artifactGCStrategySpec: `
+                      expiresAt: "2030-01-01T00:00:00Z"`,
+			expectedResult: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -723,3 +732,208 @@ func TestWorkflowHasArtifactGC(t *testing.T) {
 	}
 
 }
+
+var artgcExpiringWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  finalizers:
+  - workflows.argoproj.io/artifact-gc
+  labels:
+    workflows.argoproj.io/completed: "true"
+  name: expiring-artgc
+  namespace: argo
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+    outputs:
+      artifacts:
+      - name: expired
+        path: /tmp/expired
+        expiresAt: "2024-01-01T00:00:00Z"
+      - name: not-expired
+        path: /tmp/not-expired
+        expiresAt: "2024-01-03T00:00:00Z"
+      - name: no-expiry
+        path: /tmp/no-expiry
+status:
+  artifactGCStatus: {}
+  artifactRepositoryRef:
+    artifactRepository:
+      s3:
+        bucket: my-bucket
+        endpoint: minio:9000
+  phase: Succeeded
+  nodes:
+    expiring-artgc:
+      id: expiring-artgc
+      name: expiring-artgc
+      phase: Succeeded
+      templateName: main
+      type: Pod
+      outputs:
+        artifacts:
+        - name: expired
+          path: /tmp/expired
+          expiresAt: "2024-01-01T00:00:00Z"
+          s3:
+            key: expired
+        - name: not-expired
+          path: /tmp/not-expired
+          expiresAt: "2024-01-03T00:00:00Z"
+          s3:
+            key: not-expired
+        - name: no-expiry
+          path: /tmp/no-expiry
+          s3:
+            key: no-expiry
+`
+
+// TestProcessExpiredArtifacts verifies that only expired artifacts are deleted, while the workflow still exists
+func TestProcessExpiredArtifacts(t *testing.T) {
+	defer func() { nowFn = time.Now }()
+	nowFn = func() time.Time { return time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC) }
+	wf := wfv1.MustUnmarshalWorkflow(artgcExpiringWorkflow)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	require.True(t, woc.HasArtifactGC())
+	err := woc.garbageCollectArtifacts(ctx)
+	require.NoError(t, err)
+
+	pods, err := woc.controller.kubeclientset.CoreV1().Pods(woc.wf.GetNamespace()).List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	assert.Equal(t, string(artifactGCOnExpiry), pods.Items[0].Annotations[common.AnnotationKeyArtifactGCStrategy])
+
+	wfats, err := controller.wfclientset.ArgoprojV1alpha1().WorkflowArtifactGCTasks(woc.wf.GetNamespace()).List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, wfats.Items, 1)
+	require.Contains(t, wfats.Items[0].Spec.ArtifactsByNode, "expiring-artgc")
+	artifacts := wfats.Items[0].Spec.ArtifactsByNode["expiring-artgc"].Artifacts
+	assert.Len(t, artifacts, 1)
+	assert.Contains(t, artifacts, "expired")
+
+	// the finalizer is kept until the remaining artifact expires
+	assert.Contains(t, woc.wf.Finalizers, common.FinalizerArtifactGC)
+}
+
+var artgcDryRunWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  finalizers:
+  - workflows.argoproj.io/artifact-gc
+  labels:
+    workflows.argoproj.io/completed: "true"
+  name: dry-run-artgc
+  namespace: argo
+spec:
+  entrypoint: main
+  artifactGC:
+    strategy: DryRun
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+    outputs:
+      artifacts:
+      - name: my-artifact
+        path: /tmp/my-artifact
+status:
+  artifactGCStatus: {}
+  artifactRepositoryRef:
+    artifactRepository:
+      s3:
+        bucket: my-bucket
+        endpoint: minio:9000
+  phase: Succeeded
+  nodes:
+    dry-run-artgc:
+      id: dry-run-artgc
+      name: dry-run-artgc
+      phase: Succeeded
+      templateName: main
+      type: Pod
+      outputs:
+        artifacts:
+        - name: my-artifact
+          path: /tmp/my-artifact
+          s3:
+            key: my-key
+`
+
+// TestArtifactGCDryRun verifies that a dry run reports the artifacts it would delete without marking them deleted
+func TestArtifactGCDryRun(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(artgcDryRunWorkflow)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.artifactRepository = &wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket", Endpoint: "minio:9000"}}}
+	require.True(t, woc.HasArtifactGC())
+	err := woc.garbageCollectArtifacts(ctx)
+	require.NoError(t, err)
+
+	pods, err := woc.controller.kubeclientset.CoreV1().Pods(woc.wf.GetNamespace()).List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	pod := pods.Items[0]
+	assert.Contains(t, pod.Name, "-artgc-dryrun-")
+	assert.Equal(t, string(wfv1.ArtifactGCDryRun), pod.Annotations[common.AnnotationKeyArtifactGCStrategy])
+	assert.Contains(t, pod.Spec.Containers[0].Args, "--dry-run")
+	assert.False(t, woc.allArtifactsDeleted(), "the finalizer is kept until the dry run has completed")
+
+	wfatcs := controller.wfclientset.ArgoprojV1alpha1().WorkflowArtifactGCTasks(woc.wf.GetNamespace())
+	wfats, err := wfatcs.List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, wfats.Items, 1)
+	wfat := wfats.Items[0]
+	wfat.Status.ArtifactResultsByNode = map[string]wfv1.ArtifactResultNodeStatus{
+		"dry-run-artgc": {ArtifactResults: map[string]wfv1.ArtifactResult{"my-artifact": {Name: "my-artifact", Success: true}}},
+	}
+	_, err = wfatcs.Update(ctx, &wfat, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	pod.Status.Phase = corev1.PodSucceeded
+	err = woc.processCompletedArtifactGCPod(ctx, &pod)
+	require.NoError(t, err)
+	woc.wf.Status.ArtifactGCStatus.SetArtifactGCPodRecouped(pod.Name, true)
+
+	artifact := woc.wf.Status.Nodes["dry-run-artgc"].Outputs.Artifacts.GetArtifactByName("my-artifact")
+	require.NotNil(t, artifact)
+	assert.False(t, artifact.Deleted)
+	assert.Equal(t, []string{"Normal ArtifactGCDryRun Artifact Garbage Collection dry run would delete 1 artifact(s): my-artifact (s3://my-bucket/my-key)"},
+		getEventsWithoutAnnotations(controller, 1))
+	assert.True(t, woc.allArtifactsDeleted())
+}
+
+func TestFindArtifactsToGCArtifactStrategy(t *testing.T) {
+	temporary := wfv1.Artifact{
+		Name:       "temporary",
+		ArtifactGC: &wfv1.ArtifactGC{Strategy: wfv1.ArtifactGCOnWorkflowCompletion},
+	}
+	kept := wfv1.Artifact{Name: "kept"}
+	for _, wfStrategy := range []wfv1.ArtifactGCStrategy{wfv1.ArtifactGCStrategyUndefined, wfv1.ArtifactGCNever, wfv1.ArtifactGCOnWorkflowDeletion} {
+		t.Run(string(wfStrategy), func(t *testing.T) {
+			ctx := logging.TestContext(t.Context())
+			wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+			wf.Spec.ArtifactGC = &wfv1.WorkflowLevelArtifactGC{ArtifactGC: wfv1.ArtifactGC{Strategy: wfStrategy}}
+			wf.Status.Nodes = wfv1.Nodes{"node": wfv1.NodeStatus{
+				ID:      "node",
+				Type:    wfv1.NodeTypePod,
+				Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{temporary, kept}},
+			}}
+			woc := newWoc(ctx, *wf)
+
+			// the artifact's own strategy takes priority over the workflow's
+			assert.Equal(t, wfv1.ArtifactSearchResults{{Artifact: temporary, NodeID: "node"}},
+				woc.findArtifactsToGC(wfv1.ArtifactGCOnWorkflowCompletion))
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+var verticalPodAutoscalerResource = schema.GroupVersionResource{Group: "autoscaling.k8s.io", Version: "v1", Resource: "verticalpodautoscalers"}
+
+// resourceRecommendation is the recommended resources of each container of a template, by container name
+type resourceRecommendation map[string]containerRecommendation
+
+// containerRecommendation is the recommendation of a VerticalPodAutoscaler for a container
+type containerRecommendation struct {
+	target     apiv1.ResourceList
+	upperBound apiv1.ResourceList
+}
+
+// apply sets the requests of the container to the target of its recommendation, and the limits that are set to the
+// upper bound, so that a container is never given a limit it did not ask for
+func (r resourceRecommendation) apply(c *apiv1.Container) {
+	rec, ok := r[c.Name]
+	if !ok {
+		return
+	}
+	// the container may share its resources with the template
+	c.Resources = *c.Resources.DeepCopy()
+	for name, quantity := range rec.target {
+		if c.Resources.Requests == nil {
+			c.Resources.Requests = apiv1.ResourceList{}
+		}
+		c.Resources.Requests[name] = quantity
+	}
+	for name, quantity := range rec.upperBound {
+		if _, ok := c.Resources.Limits[name]; ok {
+			c.Resources.Limits[name] = quantity
+		}
+	}
+	// the target can be above a limit that has no upper bound, which the API server would reject
+	for name, limit := range c.Resources.Limits {
+		if request, ok := c.Resources.Requests[name]; ok && request.Cmp(limit) > 0 {
+			c.Resources.Requests[name] = limit
+		}
+	}
+}
+
+// getResourceRecommendation returns the recommendation for the template, which is looked up once and then cached until
+// the workflow completes, so that every pod of the template in the workflow is given the same resources
+func (woc *wfOperationCtx) getResourceRecommendation(ctx context.Context, templateName string) resourceRecommendation {
+	value, _ := woc.controller.resourceRecommendations.LoadOrStore(string(woc.wf.UID), map[string]resourceRecommendation{})
+	recommendations := value.(map[string]resourceRecommendation)
+	if rec, ok := recommendations[templateName]; ok {
+		return rec
+	}
+	rec, err := woc.listResourceRecommendation(ctx, templateName)
+	if err != nil {
+		// the pod is created with the resources of the template, and the recommendation is looked up again for the next pod
+		woc.log.WithError(err).WithField("templateName", templateName).Warn(ctx, "Failed to get the resource recommendation of the template")
+		return nil
+	}
+	recommendations[templateName] = rec
+	return rec
+}
+
+// listResourceRecommendation gets the recommendation of the VerticalPodAutoscaler in the namespace of the workflow
+// that is labelled with the name of the template. A template without a recommendation yet has an empty recommendation.
+func (woc *wfOperationCtx) listResourceRecommendation(ctx context.Context, templateName string) (resourceRecommendation, error) {
+	list, err := woc.controller.dynamicInterface.Resource(verticalPodAutoscalerResource).Namespace(woc.wf.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", common.LabelKeyAutoscaleTemplate, templateName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	rec := resourceRecommendation{}
+	for _, item := range list.Items {
+		containers, _, err := unstructured.NestedSlice(item.Object, "status", "recommendation", "containerRecommendations")
+		if err != nil {
+			return nil, fmt.Errorf("invalid recommendation of vertical pod autoscaler %q: %w", item.GetName(), err)
+		}
+		for _, obj := range containers {
+			container, ok := obj.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _, _ := unstructured.NestedString(container, "containerName")
+			if _, ok := rec[name]; ok || name == "" {
+				continue
+			}
+			target, err := resourceList(container, "target")
+			if err != nil {
+				return nil, fmt.Errorf("invalid recommendation of vertical pod autoscaler %q: %w", item.GetName(), err)
+			}
+			upperBound, err := resourceList(container, "upperBound")
+			if err != nil {
+				return nil, fmt.Errorf("invalid recommendation of vertical pod autoscaler %q: %w", item.GetName(), err)
+			}
+			rec[name] = containerRecommendation{target: target, upperBound: upperBound}
+		}
+	}
+	woc.log.WithFields(logging.Fields{"templateName": templateName, "containers": len(rec)}).Debug(ctx, "Got resource recommendation")
+	return rec, nil
+}
+
+func resourceList(obj map[string]interface{}, field string) (apiv1.ResourceList, error) {
+	values, _, err := unstructured.NestedStringMap(obj, field)
+	if err != nil {
+		return nil, err
+	}
+	list := apiv1.ResourceList{}
+	for name, value := range values {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", field, name, err)
+		}
+		list[apiv1.ResourceName(name)] = quantity
+	}
+	return list, nil
+}
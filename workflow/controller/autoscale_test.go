@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+var autoscaleWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: autoscale
+  namespace: default
+  uid: my-uid
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    autoscaleResources: true
+    container:
+      image: busybox
+      resources:
+        requests:
+          cpu: 100m
+        limits:
+          memory: 1Gi
+`
+
+func newVerticalPodAutoscaler(templateName, cpu, memory string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "autoscaling.k8s.io/v1",
+		"kind":       "VerticalPodAutoscaler",
+		"metadata": map[string]interface{}{
+			"name":      templateName,
+			"namespace": "default",
+			"labels":    map[string]interface{}{"workflows.argoproj.io/template": templateName},
+		},
+		"status": map[string]interface{}{
+			"recommendation": map[string]interface{}{
+				"containerRecommendations": []interface{}{
+					map[string]interface{}{
+						"containerName": "main",
+						"target":        map[string]interface{}{"cpu": cpu, "memory": memory},
+						"upperBound":    map[string]interface{}{"cpu": "2", "memory": "2Gi"},
+					},
+				},
+			},
+		},
+	}}
+}
+
+func TestAutoscaleResources(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(autoscaleWf)
+	woc := newWoc(ctx, *wf)
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{verticalPodAutoscalerResource: "VerticalPodAutoscalerList"},
+		newVerticalPodAutoscaler("main", "250m", "300Mi"))
+	woc.controller.dynamicInterface = dynamicClient
+	tmpl := &woc.execWf.Spec.Templates[0]
+
+	pod, err := woc.createWorkflowPod(ctx, "autoscale-1", []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{})
+	require.NoError(t, err)
+	main := pod.Spec.Containers[1]
+	assert.Equal(t, resource.MustParse("250m"), main.Resources.Requests[apiv1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("300Mi"), main.Resources.Requests[apiv1.ResourceMemory])
+	// only the limits that the template set are changed
+	assert.Equal(t, apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("2Gi")}, main.Resources.Limits)
+	assert.Equal(t, resource.MustParse("1Gi"), tmpl.Container.Resources.Limits[apiv1.ResourceMemory])
+
+	// the recommendation is cached for the lifetime of the workflow
+	_, err = dynamicClient.Resource(verticalPodAutoscalerResource).Namespace("default").Update(ctx, newVerticalPodAutoscaler("main", "500m", "600Mi"), metav1.UpdateOptions{})
+	require.NoError(t, err)
+	pod, err = woc.createWorkflowPod(ctx, "autoscale-2", []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, resource.MustParse("250m"), pod.Spec.Containers[1].Resources.Requests[apiv1.ResourceCPU])
+
+	// a new workflow gets the latest recommendation
+	wf.UID = "other-uid"
+	woc = newWoc(ctx, *wf)
+	woc.controller.dynamicInterface = dynamicClient
+	pod, err = woc.createWorkflowPod(ctx, "autoscale-3", []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, resource.MustParse("500m"), pod.Spec.Containers[1].Resources.Requests[apiv1.ResourceCPU])
+}
+
+func TestAutoscaleResourcesWithoutRecommendation(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(autoscaleWf)
+	woc := newWoc(ctx, *wf)
+	woc.controller.dynamicInterface = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{verticalPodAutoscalerResource: "VerticalPodAutoscalerList"},
+		newVerticalPodAutoscaler("other", "250m", "300Mi"))
+	tmpl := &woc.execWf.Spec.Templates[0]
+
+	pod, err := woc.createWorkflowPod(ctx, "autoscale", []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, tmpl.Container.Resources, pod.Spec.Containers[1].Resources)
+}
+
+func TestResourceRecommendationApply(t *testing.T) {
+	rec := resourceRecommendation{"main": {
+		target:     apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("2")},
+		upperBound: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("1Gi")},
+	}}
+	c := apiv1.Container{Name: "main", Resources: apiv1.ResourceRequirements{
+		Limits: apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("1")},
+	}}
+	rec.apply(&c)
+	// the target is capped by a limit without an upper bound
+	assert.Equal(t, apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("1")}, c.Resources.Requests)
+	assert.Equal(t, apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("1")}, c.Resources.Limits)
+
+	other := apiv1.Container{Name: "other"}
+	rec.apply(&other)
+	assert.Empty(t, other.Resources)
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// updateConditions sets the conditions which summarise the state of the workflow's nodes, so that clients can
+// check them without inspecting every node
+func (woc *wfOperationCtx) updateConditions() {
+	woc.updateCondition(woc.artifactsCollectedCondition())
+	woc.updateCondition(woc.suspendResolvedCondition())
+}
+
+func (woc *wfOperationCtx) updateCondition(condition *wfv1.Condition) {
+	if condition == nil {
+		return
+	}
+	for _, c := range woc.wf.Status.Conditions {
+		if c == *condition {
+			return
+		}
+	}
+	woc.wf.Status.Conditions.UpsertCondition(*condition)
+	woc.updated = true
+}
+
+func (woc *wfOperationCtx) hasCondition(conditionType wfv1.ConditionType) bool {
+	for _, c := range woc.wf.Status.Conditions {
+		if c.Type == conditionType {
+			return true
+		}
+	}
+	return false
+}
+
+// artifactsCollectedCondition is only returned for workflows with output artifacts, and is true once every pod has
+// completed and reported its outputs
+func (woc *wfOperationCtx) artifactsCollectedCondition() *wfv1.Condition {
+	if !woc.hasOutputArtifacts() {
+		return nil
+	}
+	tracked, pending := 0, 0
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypePod {
+			tracked++
+			if !node.Phase.Fulfilled(node.TaskResultSynced) {
+				pending++
+			}
+		}
+	}
+	for _, completed := range woc.wf.Status.TaskResultsCompletionStatus {
+		tracked++
+		if !completed {
+			pending++
+		}
+	}
+	if tracked == 0 {
+		return nil
+	}
+	if pending > 0 {
+		return &wfv1.Condition{
+			Type:    wfv1.ConditionTypeArtifactsCollected,
+			Status:  metav1.ConditionFalse,
+			Message: fmt.Sprintf("waiting for the outputs of %d pod(s)", pending),
+		}
+	}
+	return &wfv1.Condition{Type: wfv1.ConditionTypeArtifactsCollected, Status: metav1.ConditionTrue}
+}
+
+func (woc *wfOperationCtx) hasOutputArtifacts() bool {
+	for _, tmpl := range woc.execWf.Spec.Templates {
+		if len(tmpl.Outputs.Artifacts) > 0 {
+			return true
+		}
+	}
+	for _, tmpl := range woc.wf.Status.StoredTemplates {
+		if len(tmpl.Outputs.Artifacts) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// suspendResolvedCondition is only returned for workflows which have been suspended, or have suspend nodes
+func (woc *wfOperationCtx) suspendResolvedCondition() *wfv1.Condition {
+	hasSuspendNodes := false
+	var waiting []string
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypeSuspend {
+			continue
+		}
+		hasSuspendNodes = true
+		if node.IsActiveSuspendNode() {
+			waiting = append(waiting, node.DisplayName)
+		}
+	}
+	suspended := woc.wf.Spec.Suspend != nil && *woc.wf.Spec.Suspend
+	if !suspended && !hasSuspendNodes && !woc.hasCondition(wfv1.ConditionTypeSuspendResolved) {
+		return nil
+	}
+	switch {
+	case suspended:
+		return &wfv1.Condition{Type: wfv1.ConditionTypeSuspendResolved, Status: metav1.ConditionFalse, Message: "workflow is suspended"}
+	case len(waiting) > 0:
+		sort.Strings(waiting)
+		return &wfv1.Condition{
+			Type:    wfv1.ConditionTypeSuspendResolved,
+			Status:  metav1.ConditionFalse,
+			Message: fmt.Sprintf("waiting for %s to be resumed", strings.Join(waiting, ", ")),
+		}
+	}
+	return &wfv1.Condition{Type: wfv1.ConditionTypeSuspendResolved, Status: metav1.ConditionTrue}
+}
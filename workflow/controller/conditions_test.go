@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func getCondition(wf *wfv1.Workflow, conditionType wfv1.ConditionType) *wfv1.Condition {
+	for _, c := range wf.Status.Conditions {
+		if c.Type == conditionType {
+			return &c
+		}
+	}
+	return nil
+}
+
+const artifactsCollectedWf = `
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: alpine
+      command: [sh, -c]
+      args: ["echo hello > /tmp/hello.txt"]
+    outputs:
+      artifacts:
+      - name: hello
+        path: /tmp/hello.txt
+`
+
+func TestArtifactsCollectedCondition(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("NoOutputArtifacts", func(t *testing.T) {
+		woc := newWoc(ctx)
+		woc.wf.Status.TaskResultsCompletionStatus = map[string]bool{"a": true}
+		woc.updateConditions()
+		assert.Nil(t, getCondition(woc.wf, wfv1.ConditionTypeArtifactsCollected))
+		assert.False(t, woc.updated)
+	})
+	t.Run("Pending", func(t *testing.T) {
+		woc := newWoc(ctx, *wfv1.MustUnmarshalWorkflow(artifactsCollectedWf))
+		woc.wf.Status.TaskResultsCompletionStatus = map[string]bool{"a": true, "b": false}
+		woc.updateConditions()
+		assert.Equal(t, &wfv1.Condition{
+			Type:    wfv1.ConditionTypeArtifactsCollected,
+			Status:  metav1.ConditionFalse,
+			Message: "waiting for the outputs of 1 pod(s)",
+		}, getCondition(woc.wf, wfv1.ConditionTypeArtifactsCollected))
+		assert.True(t, woc.updated)
+	})
+	t.Run("Collected", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(artifactsCollectedWf)
+		cancel, controller := newController(ctx, wf)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, &wfv1.Condition{
+			Type:    wfv1.ConditionTypeArtifactsCollected,
+			Status:  metav1.ConditionFalse,
+			Message: "waiting for the outputs of 1 pod(s)",
+		}, getCondition(woc.wf, wfv1.ConditionTypeArtifactsCollected))
+
+		outputs := wfv1.Outputs{Artifacts: wfv1.Artifacts{{
+			Name:             "hello",
+			ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "my-wf/hello.tgz"}},
+		}}}
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withExitCode(0), withOutputs(ctx, outputs))
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+		assert.Equal(t, &wfv1.Condition{Type: wfv1.ConditionTypeArtifactsCollected, Status: metav1.ConditionTrue},
+			getCondition(woc.wf, wfv1.ConditionTypeArtifactsCollected))
+	})
+}
+
+const suspendResolvedWf = `
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: approve
+        template: approve
+  - name: approve
+    suspend: {}
+`
+
+func TestSuspendResolvedCondition(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("NoSuspend", func(t *testing.T) {
+		woc := newWoc(ctx)
+		woc.updateConditions()
+		assert.Nil(t, getCondition(woc.wf, wfv1.ConditionTypeSuspendResolved))
+	})
+	t.Run("SuspendNode", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(suspendResolvedWf)
+		cancel, controller := newController(ctx, wf)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, &wfv1.Condition{
+			Type:    wfv1.ConditionTypeSuspendResolved,
+			Status:  metav1.ConditionFalse,
+			Message: "waiting for approve to be resumed",
+		}, getCondition(woc.wf, wfv1.ConditionTypeSuspendResolved))
+
+		node := woc.wf.Status.Nodes.FindByDisplayName("approve")
+		require.NotNil(t, node)
+		node.Phase = wfv1.NodeSucceeded
+		woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+		assert.Equal(t, &wfv1.Condition{Type: wfv1.ConditionTypeSuspendResolved, Status: metav1.ConditionTrue},
+			getCondition(woc.wf, wfv1.ConditionTypeSuspendResolved))
+	})
+	t.Run("SuspendedWorkflow", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+		wf.Spec.Suspend = ptr.To(true)
+		woc := newWoc(ctx, *wf)
+		woc.updateConditions()
+		assert.Equal(t, &wfv1.Condition{
+			Type:    wfv1.ConditionTypeSuspendResolved,
+			Status:  metav1.ConditionFalse,
+			Message: "workflow is suspended",
+		}, getCondition(woc.wf, wfv1.ConditionTypeSuspendResolved))
+
+		// the condition is kept up to date after the workflow is resumed
+		woc.wf.Spec.Suspend = nil
+		woc.updateConditions()
+		assert.Equal(t, &wfv1.Condition{Type: wfv1.ConditionTypeSuspendResolved, Status: metav1.ConditionTrue},
+			getCondition(woc.wf, wfv1.ConditionTypeSuspendResolved))
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/sqldb"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/pullsecret"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 )
 
@@ -74,6 +75,27 @@ func (wfc *WorkflowController) updateConfig(ctx context.Context) error {
 		logger.Info(ctx, "Persistence configuration disabled")
 	}
 
+	switch {
+	case wfc.Config.AggregatedPullSecrets == nil:
+		wfc.pullSecretAggregator = nil
+	case wfc.pullSecretAggregator == nil:
+		wfc.pullSecretAggregator, err = pullsecret.New(ctx, wfc.kubeclientset, *wfc.Config.AggregatedPullSecrets)
+		if err != nil {
+			return err
+		}
+		logger.Info(ctx, "Aggregated pull secrets are enabled")
+	default:
+		// keep the aggregator, so that it keeps refreshing the secrets it has written
+		if err := wfc.pullSecretAggregator.Update(ctx, *wfc.Config.AggregatedPullSecrets); err != nil {
+			return err
+		}
+	}
+
+	wfc.transientErrors, err = newTransientErrorMatcher(wfc.Config.RetryTransientErrors)
+	if err != nil {
+		return err
+	}
+
 	wfc.hydrator = hydrator.New(wfc.offloadNodeStatusRepo)
 	wfc.updateEstimatorFactory(ctx)
 	wfc.rateLimiter = wfc.newRateLimiter()
@@ -60,8 +60,10 @@ import (
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/informer"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/pod"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/pullsecret"
 	"github.com/argoproj/argo-workflows/v3/workflow/cron"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
+	"github.com/argoproj/argo-workflows/v3/workflow/eventsource"
 	"github.com/argoproj/argo-workflows/v3/workflow/gccontroller"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
@@ -95,6 +97,10 @@ type WorkflowController struct {
 	artifactRepositories artifactrepositories.Interface
 	// get images
 	entrypoint entrypoint.Interface
+	// pullSecretAggregator manages the aggregated pull secrets, nil unless they are configured
+	pullSecretAggregator *pullsecret.Aggregator
+	// transientErrors matches the failures retried by retryStrategy.retryOn.transientErrors
+	transientErrors *transientErrorMatcher
 
 	// cliExecutorImage is the executor image as specified from the command line
 	cliExecutorImage string
@@ -118,29 +124,31 @@ type WorkflowController struct {
 	maxStackDepth int
 
 	// datastructures to support the processing of workflows and workflow pods
-	wfInformer            cache.SharedIndexInformer
-	nsInformer            cache.SharedIndexInformer
-	wftmplInformer        wfextvv1alpha1.WorkflowTemplateInformer
-	cwftmplInformer       wfextvv1alpha1.ClusterWorkflowTemplateInformer
-	PodController         *pod.Controller // Currently public for woc to access, but would rather an accessor
-	configMapInformer     cache.SharedIndexInformer
-	wfQueue               workqueue.TypedRateLimitingInterface[string]
-	wfArchiveQueue        workqueue.TypedRateLimitingInterface[string]
-	throttler             sync.Throttler
-	workflowKeyLock       syncpkg.KeyLock // used to lock workflows for exclusive modification or access
-	session               db.Session
-	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
-	hydrator              hydrator.Interface
-	wfArchive             sqldb.WorkflowArchive
-	estimatorFactory      estimation.EstimatorFactory
-	syncManager           *sync.Manager
-	metrics               *metrics.Metrics
-	eventRecorderManager  events.EventRecorderManager
-	archiveLabelSelector  labels.Selector
-	cacheFactory          controllercache.Factory
-	wfTaskSetInformer     wfextvv1alpha1.WorkflowTaskSetInformer
-	artGCTaskInformer     wfextvv1alpha1.WorkflowArtifactGCTaskInformer
-	taskResultInformer    cache.SharedIndexInformer
+	wfInformer              cache.SharedIndexInformer
+	nsInformer              cache.SharedIndexInformer
+	wftmplInformer          wfextvv1alpha1.WorkflowTemplateInformer
+	cwftmplInformer         wfextvv1alpha1.ClusterWorkflowTemplateInformer
+	PodController           *pod.Controller // Currently public for woc to access, but would rather an accessor
+	configMapInformer       cache.SharedIndexInformer
+	wfQueue                 workqueue.TypedRateLimitingInterface[string]
+	wfArchiveQueue          workqueue.TypedRateLimitingInterface[string]
+	throttler               sync.Throttler
+	workflowKeyLock         syncpkg.KeyLock // used to lock workflows for exclusive modification or access
+	session                 db.Session
+	offloadNodeStatusRepo   sqldb.OffloadNodeStatusRepo
+	hydrator                hydrator.Interface
+	wfArchive               sqldb.WorkflowArchive
+	estimatorFactory        estimation.EstimatorFactory
+	syncManager             *sync.Manager
+	metrics                 *metrics.Metrics
+	metricPushes            gosync.Map // workflow UID -> map[string]int64 of the last interval each pushInterval metric was emitted for
+	resourceRecommendations gosync.Map // workflow UID -> map[string]resourceRecommendation of each template with autoscaleResources set
+	eventRecorderManager    events.EventRecorderManager
+	archiveLabelSelector    labels.Selector
+	cacheFactory            controllercache.Factory
+	wfTaskSetInformer       wfextvv1alpha1.WorkflowTaskSetInformer
+	artGCTaskInformer       wfextvv1alpha1.WorkflowArtifactGCTaskInformer
+	taskResultInformer      cache.SharedIndexInformer
 
 	// progressPatchTickDuration defines how often the executor will patch pod annotations if an updated progress is found.
 	// Default is 1m and can be configured using the env var ARGO_PROGRESS_PATCH_TICK_DURATION.
@@ -162,6 +170,7 @@ const (
 	clusterWorkflowTemplateResyncPeriod = 20 * time.Minute
 	workflowExistenceCheckPeriod        = 1 * time.Minute
 	workflowTaskSetResyncPeriod         = 20 * time.Minute
+	pullSecretRefreshPeriod             = 1 * time.Minute
 )
 
 var (
@@ -219,10 +228,11 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 		`argo_workflows`,
 		wfc.getMetricsServerConfig(),
 		metrics.Callbacks{
-			PodPhase:          wfc.getPodPhaseMetrics,
-			WorkflowPhase:     wfc.getWorkflowPhaseMetrics,
-			WorkflowCondition: wfc.getWorkflowConditionMetrics,
-			IsLeader:          wfc.IsLeader,
+			PodPhase:                 wfc.getPodPhaseMetrics,
+			WorkflowPhase:            wfc.getWorkflowPhaseMetrics,
+			WorkflowCondition:        wfc.getWorkflowConditionMetrics,
+			IsLeader:                 wfc.IsLeader,
+			ArtifactCompressionRatio: wfc.getArtifactCompressionRatioMetrics,
 		})
 	if err != nil {
 		return nil, err
@@ -267,6 +277,36 @@ func (wfc *WorkflowController) runCronController(ctx context.Context, cronWorkfl
 	cronController.Run(ctx)
 }
 
+func (wfc *WorkflowController) runEventSourceController(ctx context.Context, cfg config.WorkflowEventSources) {
+	defer runtimeutil.HandleCrashWithContext(ctx, runtimeutil.PanicHandlers...)
+
+	eventSourceController := eventsource.NewController(ctx, wfc.restConfig, wfc.wfclientset, wfc.kubeclientset, cfg, wfc.GetManagedNamespace(), wfc.Config.InstanceID, wfc.eventRecorderManager)
+	eventSourceController.Run(ctx)
+}
+
+// refreshPullSecrets refreshes the aggregated pull secrets of the pods that have not completed before they expire, so
+// that pods that are started later, or restart their containers, can still pull their images
+func (wfc *WorkflowController) refreshPullSecrets(ctx context.Context) {
+	aggregator := wfc.pullSecretAggregator
+	if aggregator == nil {
+		return
+	}
+	var pods []*apiv1.Pod
+	for _, phase := range []apiv1.PodPhase{apiv1.PodPending, apiv1.PodRunning} {
+		objs, err := wfc.PodController.GetPodsByIndex(indexes.PodPhaseIndex, string(phase))
+		if err != nil {
+			logging.RequireLoggerFromContext(ctx).WithError(err).Error(ctx, "Failed to list pods to refresh aggregated pull secrets")
+			return
+		}
+		for _, obj := range objs {
+			if pod, ok := obj.(*apiv1.Pod); ok {
+				pods = append(pods, pod)
+			}
+		}
+	}
+	aggregator.Refresh(ctx, pods)
+}
+
 var indexers = cache.Indexers{
 	indexes.ClusterWorkflowTemplateIndex: indexes.MetaNamespaceLabelIndexFunc(common.LabelKeyClusterWorkflowTemplate),
 	indexes.CronWorkflowIndex:            indexes.MetaNamespaceLabelIndexFunc(common.LabelKeyCronWorkflow),
@@ -377,8 +417,12 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 
 	go wfc.runGCcontroller(ctx, workflowTTLWorkers)
 	go wfc.runCronController(ctx, cronWorkflowWorkers)
+	if wfc.Config.WorkflowEventSources != nil {
+		go wfc.runEventSourceController(ctx, *wfc.Config.WorkflowEventSources)
+	}
 
 	go wait.UntilWithContext(ctx, wfc.syncManager.CheckWorkflowExistence, workflowExistenceCheckPeriod)
+	go wait.UntilWithContext(ctx, wfc.refreshPullSecrets, pullSecretRefreshPeriod)
 
 	workerCtx, _ := logger.WithField("component", "workflow_worker").InContext(ctx)
 	for i := 0; i < wfWorkers; i++ {
@@ -1036,6 +1080,8 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 			wf, ok := obj.(*unstructured.Unstructured)
 			if ok { // maybe cache.DeletedFinalStateUnknown
 				wfc.metrics.DeleteRealtimeMetricsForWfUID(string(wf.GetUID()))
+				wfc.metricPushes.Delete(string(wf.GetUID()))
+				wfc.resourceRecommendations.Delete(string(wf.GetUID()))
 			}
 		},
 	})
@@ -1287,6 +1333,39 @@ func (wfc *WorkflowController) getWorkflowConditionMetrics(ctx context.Context)
 	return result
 }
 
+func (wfc *WorkflowController) getArtifactCompressionRatioMetrics(_ context.Context) map[string]float64 {
+	sums := make(map[string]float64)
+	counts := make(map[string]int)
+	if wfc.wfInformer != nil {
+		for _, obj := range wfc.wfInformer.GetStore().List() {
+			un, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			ratio, found, err := unstructured.NestedFieldNoCopy(un.Object, "status", "averageArtifactCompressionRatio")
+			if err != nil || !found {
+				continue
+			}
+			var value float64
+			switch v := ratio.(type) {
+			case float64:
+				value = v
+			case int64:
+				value = float64(v)
+			default:
+				continue
+			}
+			sums[un.GetNamespace()] += value
+			counts[un.GetNamespace()]++
+		}
+	}
+	result := make(map[string]float64, len(sums))
+	for namespace, sum := range sums {
+		result[namespace] = sum / float64(counts[namespace])
+	}
+	return result
+}
+
 func (wfc *WorkflowController) getPodPhaseMetrics(ctx context.Context) map[string]int64 {
 	// During startup we need this callback to exist, but it won't function until the PodController is started
 	if wfc.PodController != nil {
@@ -473,7 +473,7 @@ func withOutputs(ctx context.Context, outputs wfv1.Outputs) with {
 				Outputs: &outputs,
 			},
 		}
-		_, err := woc.controller.wfclientset.ArgoprojV1alpha1().WorkflowTaskResults(woc.wf.Namespace).
+		created, err := woc.controller.wfclientset.ArgoprojV1alpha1().WorkflowTaskResults(woc.wf.Namespace).
 			Create(
 				ctx,
 				taskResult,
@@ -482,6 +482,11 @@ func withOutputs(ctx context.Context, outputs wfv1.Outputs) with {
 		if err != nil {
 			panic(err)
 		}
+		// do not wait for the informer to see the task result
+		err = woc.controller.taskResultInformer.GetStore().Add(created)
+		if err != nil {
+			panic(err)
+		}
 	}
 }
 
@@ -1181,6 +1186,179 @@ spec:
 	assert.Equal(t, 0, controller.PodController.TestingQueueNumRequeues(podCleanupKey))
 }
 
+func TestPodCleanupRetainCount(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: test
+spec:
+  entrypoint: main
+  podGC:
+    strategy: OnWorkflowCompletion
+    deleteDelayDuration: 0s
+    retainCount: 2
+  templates:
+    - name: main
+      steps:
+        - - name: step
+            template: pod
+            withItems: [1, 2, 3, 4, 5]
+    - name: pod
+      container:
+        image: my-image
+  `)
+	cancel, controller := newController(logging.TestContext(t.Context()), wf)
+	defer cancel()
+
+	ctx := logging.TestContext(t.Context())
+	assert.True(t, controller.processNextItem(ctx))
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+
+	var created []string
+	now := time.Now()
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, func(pod *apiv1.Pod, _ *wfOperationCtx) {
+		pod.CreationTimestamp = metav1.NewTime(now.Add(time.Duration(len(created)) * time.Minute))
+		created = append(created, pod.Name)
+	})
+	require.Len(t, created, 5)
+
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	for range created {
+		assert.True(t, controller.PodController.TestingProcessNextItem(ctx))
+	}
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	var remaining []string
+	for _, pod := range pods.Items {
+		remaining = append(remaining, pod.Name)
+	}
+	assert.ElementsMatch(t, created[3:], remaining)
+}
+
+func TestPodCleanupDeleteDelayDuration(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		delay         string
+		remainingPods int
+	}{
+		{"Immediate", "0s", 0},
+		{"Delayed", "1h", 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: test
+spec:
+  entrypoint: main
+  podGC:
+    strategy: OnWorkflowCompletion
+    deleteDelayDuration: ` + tt.delay + `
+  templates:
+    - name: main
+      container:
+        image: my-image
+  `)
+			cancel, controller := newController(logging.TestContext(t.Context()), wf)
+			defer cancel()
+
+			ctx := logging.TestContext(t.Context())
+			assert.True(t, controller.processNextItem(ctx))
+
+			woc := newWorkflowOperationCtx(ctx, wf, controller)
+			woc.operate(ctx)
+			assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+			makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+
+			woc.operate(ctx)
+			assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+			// a delayed deletion is not ready to be processed, so the pod must not be deleted yet
+			if tt.remainingPods == 0 {
+				assert.True(t, controller.PodController.TestingProcessNextItem(ctx))
+			} else {
+				assert.Equal(t, 0, controller.PodController.TestingQueueLen())
+			}
+			pods, err := listPods(ctx, woc)
+			require.NoError(t, err)
+			assert.Len(t, pods.Items, tt.remainingPods)
+		})
+	}
+}
+
+func TestPodCleanupOnPodFailure(t *testing.T) {
+	for _, tt := range []struct {
+		name                  string
+		keepFailedPodDuration string
+		queuedItems           int
+		remainingPods         []string
+	}{
+		// the failed pod is labelled as completed rather than deleted
+		{"KeepUntilWorkflowDeleted", "", 2, []string{"bad"}},
+		{"KeepForDuration", "1h", 1, []string{"bad"}},
+		{"Expired", "0s", 2, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: test
+spec:
+  entrypoint: main
+  podGC:
+    strategy: OnPodFailure
+    deleteDelayDuration: 0s
+    keepFailedPodDuration: "` + tt.keepFailedPodDuration + `"
+  templates:
+    - name: main
+      steps:
+        - - name: good
+            template: good
+          - name: bad
+            template: bad
+    - name: good
+      container:
+        image: my-image
+    - name: bad
+      container:
+        image: my-image
+  `)
+			cancel, controller := newController(logging.TestContext(t.Context()), wf)
+			defer cancel()
+
+			ctx := logging.TestContext(t.Context())
+			assert.True(t, controller.processNextItem(ctx))
+
+			woc := newWorkflowOperationCtx(ctx, wf, controller)
+			woc.operate(ctx)
+			assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+			makePodsPhase(ctx, woc, apiv1.PodSucceeded, func(pod *apiv1.Pod, woc *wfOperationCtx) {
+				if woc.wf.Status.Nodes[woc.nodeID(pod)].TemplateName == "bad" {
+					pod.Status.Phase = apiv1.PodFailed
+				}
+			})
+
+			woc.operate(ctx)
+			assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+			for range tt.queuedItems {
+				assert.True(t, controller.PodController.TestingProcessNextItem(ctx))
+			}
+			// a delayed deletion is not ready to be processed
+			assert.Equal(t, 0, controller.PodController.TestingQueueLen())
+			pods, err := listPods(ctx, woc)
+			require.NoError(t, err)
+			var remaining []string
+			for _, pod := range pods.Items {
+				remaining = append(remaining, woc.wf.Status.Nodes[woc.nodeID(&pod)].TemplateName)
+			}
+			assert.ElementsMatch(t, tt.remainingPods, remaining)
+		})
+	}
+}
+
 func TestPodCleanupDeletePendingPodWhenTerminate(t *testing.T) {
 	wf := wfv1.MustUnmarshalWorkflow(`
 metadata:
@@ -1357,3 +1535,43 @@ func TestPodSpecPatchTemplateLevel(t *testing.T) {
 	require.NotNil(t, mainContainer)
 	assert.Equal(t, "25Mi", mainContainer.Resources.Requests.Memory().String())
 }
+
+func TestArtifactCompressionRatio(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: default
+status:
+  phase: Running
+  nodes:
+    my-wf-1:
+      id: my-wf-1
+      outputs:
+        artifacts:
+        - name: a
+          compressionRatio: 0.2
+        - name: b
+          compressionRatio: 0.4
+        - name: c
+`)
+	other := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: other-wf
+  namespace: other
+status:
+  phase: Succeeded
+  averageArtifactCompressionRatio: 0.5
+`)
+	cancel, controller := newController(ctx, wf, other)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.updateAverageArtifactCompressionRatio()
+	require.NotNil(t, woc.wf.Status.AverageArtifactCompressionRatio)
+	assert.Equal(t, "0.3", string(woc.wf.Status.AverageArtifactCompressionRatio.Value))
+	assert.True(t, woc.updated)
+
+	ratios := controller.getArtifactCompressionRatioMetrics(ctx)
+	assert.Equal(t, map[string]float64{"other": 0.5}, ratios)
+}
@@ -295,7 +295,7 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 				return node, err
 			}
 			scope.addParamToScope(fmt.Sprintf("tasks.%s.status", task.Name), string(taskNode.Phase))
-			_, err = woc.executeTmplLifeCycleHook(ctx, scope, dagCtx.GetTask(ctx, taskName).Hooks, taskNode, dagCtx.boundaryID, dagCtx.tmplCtx, "tasks."+taskName)
+			_, err = woc.executeTmplLifeCycleHook(ctx, scope, dagCtx.GetTask(ctx, taskName).GetLifecycleHooks(), taskNode, dagCtx.boundaryID, dagCtx.tmplCtx, "tasks."+taskName)
 			if err != nil {
 				woc.markNodeError(ctx, node.Name, err)
 				return node, err
@@ -315,8 +315,8 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 	}
 
 	// Check if we are still running any tasks in this dag and return early if we do
-	// We should wait for onExit nodes even if ShutdownStrategy is enabled.
-	dagPhase, err := dagCtx.assessDAGPhase(ctx, targetTasks, woc.wf.Status.Nodes, woc.GetShutdownStrategy().Enabled() && onExitCompleted)
+	// We should wait for onExit nodes even if ShutdownStrategy is enabled, and for running pods when draining.
+	dagPhase, err := dagCtx.assessDAGPhase(ctx, targetTasks, woc.wf.Status.Nodes, woc.GetShutdownStrategy().Enabled() && !woc.isDraining() && onExitCompleted)
 	if err != nil {
 		return nil, err
 	}
@@ -433,7 +433,7 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 			return
 		}
 		scope.addParamToScope(fmt.Sprintf("tasks.%s.status", task.Name), string(node.Phase))
-		hookCompleted, err := woc.executeTmplLifeCycleHook(ctx, scope, dagCtx.GetTask(ctx, taskName).Hooks, node, dagCtx.boundaryID, dagCtx.tmplCtx, "tasks."+taskName)
+		hookCompleted, err := woc.executeTmplLifeCycleHook(ctx, scope, dagCtx.GetTask(ctx, taskName).GetLifecycleHooks(), node, dagCtx.boundaryID, dagCtx.tmplCtx, "tasks."+taskName)
 		if err != nil {
 			woc.markNodeError(ctx, node.Name, err)
 		}
@@ -712,7 +712,9 @@ func (woc *wfOperationCtx) resolveDependencyReferences(ctx context.Context, dagC
 	}
 
 	// Replace task's parameters
-	taskBytes, err := json.Marshal(task)
+	taskCopy := *task
+	taskCopy.Arguments = common.ResolveFromOutputs(task.Arguments, "tasks")
+	taskBytes, err := json.Marshal(taskCopy)
 	if err != nil {
 		return nil, errors.InternalWrapError(err)
 	}
@@ -763,6 +765,14 @@ func (woc *wfOperationCtx) resolveDependencyReferences(ctx context.Context, dagC
 		artifacts = append(artifacts, *resolvedArt)
 	}
 	newTask.Arguments.Artifacts = artifacts
+
+	// read the artifact to expand the task with
+	if newTask.WithParamArtifact != nil {
+		newTask.WithParam, err = woc.resolveWithParamArtifact(ctx, newTask.WithParamArtifact, scope)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &newTask, nil
 }
 
@@ -797,7 +807,7 @@ func expandTask(ctx context.Context, task wfv1.DAGTask) ([]wfv1.DAGTask, error)
 	var items []wfv1.Item
 	if len(task.WithItems) > 0 {
 		items = task.WithItems
-	} else if task.WithParam != "" {
+	} else if task.WithParam != "" || task.WithParamArtifact != nil {
 		err = json.Unmarshal([]byte(task.WithParam), &items)
 		if err != nil {
 			mustExec, mustExecErr := shouldExecute(task.When)
@@ -826,6 +836,7 @@ func expandTask(ctx context.Context, task wfv1.DAGTask) ([]wfv1.DAGTask, error)
 	// very poor performance, so we just nil them out
 	task.WithItems = nil
 	task.WithParam = ""
+	task.WithParamArtifact = nil
 	task.WithSequence = nil
 
 	tmpl, err := template.NewTemplate(string(taskBytes))
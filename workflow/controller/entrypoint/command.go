@@ -0,0 +1,29 @@
+package entrypoint
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// scriptInterpreters are entrypoints that run the script that is their first argument
+var scriptInterpreters = []string{"python", "node", "ruby", "perl", "sh", "bash"}
+
+// CheckCommand returns an error if a command, which replaces the entrypoint of the image, is likely meant to be
+// arguments to the entrypoint. Such a command only fails once the container runs.
+func (i *Image) CheckCommand(command []string) error {
+	if len(command) == 0 || len(i.Entrypoint) == 0 {
+		return nil
+	}
+	first := command[0]
+	if strings.HasPrefix(first, "-") {
+		return fmt.Errorf("command %q starts with a flag, but replaces the image's entrypoint %q, use args to pass arguments to the entrypoint", command, i.Entrypoint)
+	}
+	// e.g. python3.12 is python
+	interpreter := strings.TrimRight(path.Base(i.Entrypoint[0]), "0123456789.")
+	if slices.Contains(scriptInterpreters, interpreter) && path.Ext(first) != "" && !strings.HasPrefix(path.Base(first), interpreter) {
+		return fmt.Errorf("command %q looks like a script for the image's entrypoint %q, which it replaces, use args to pass the script to the entrypoint", command, i.Entrypoint)
+	}
+	return nil
+}
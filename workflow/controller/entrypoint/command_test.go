@@ -0,0 +1,60 @@
+package entrypoint
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// pushImage pushes an image with the entrypoint to the registry, and returns its reference
+func pushImage(t *testing.T, host, repository string, entrypoint []string) string {
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	cfg, err := img.ConfigFile()
+	require.NoError(t, err)
+	cfg.Config.Entrypoint = entrypoint
+	img, err = mutate.ConfigFile(img, cfg)
+	require.NoError(t, err)
+	ref, err := name.ParseReference(host + "/" + repository + ":latest")
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	return ref.String()
+}
+
+func TestCheckCommand(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+	index := New(fake.NewSimpleClientset(), nil)
+
+	lookup := func(repository string, entrypoint []string) *Image {
+		image, err := index.Lookup(ctx, pushImage(t, host, repository, entrypoint), Options{Namespace: "default"})
+		require.NoError(t, err)
+		return image
+	}
+	python := lookup("python", []string{"/usr/local/bin/python3.12"})
+	assert.Equal(t, []string{"/usr/local/bin/python3.12"}, python.Entrypoint)
+	require.NoError(t, python.CheckCommand([]string{"python3", "main.py"}))
+	require.NoError(t, python.CheckCommand([]string{"/bin/sh", "-c", "python3 main.py"}))
+	require.EqualError(t, python.CheckCommand([]string{"main.py"}), `command ["main.py"] looks like a script for the image's entrypoint ["/usr/local/bin/python3.12"], which it replaces, use args to pass the script to the entrypoint`)
+
+	app := lookup("app", []string{"/app"})
+	require.NoError(t, app.CheckCommand([]string{"/bin/server.sh"}))
+	require.EqualError(t, app.CheckCommand([]string{"--port", "8080"}), `command ["--port" "8080"] starts with a flag, but replaces the image's entrypoint ["/app"], use args to pass arguments to the entrypoint`)
+
+	// an image without an entrypoint runs the command
+	none := lookup("none", nil)
+	require.NoError(t, none.CheckCommand([]string{"--port"}))
+}
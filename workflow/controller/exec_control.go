@@ -2,11 +2,15 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync"
 	"time"
 
 	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
@@ -39,10 +43,11 @@ func (woc *wfOperationCtx) applyExecutionControl(ctx context.Context, pod *apiv1
 	case apiv1.PodPending, apiv1.PodRunning:
 		// Check if we are currently shutting down
 		if woc.GetShutdownStrategy().Enabled() {
-			// Only delete pods that are not part of an onExit handler if we are "Stopping" or all pods if we are "Terminating"
+			// Only delete pods that are not part of an onExit handler if we are "Stopping" or all pods if we are "Terminating".
+			// No pods are deleted if we are "Draining".
 			_, onExitPod := pod.Labels[common.LabelKeyOnExit]
 
-			if !woc.GetShutdownStrategy().ShouldExecute(onExitPod) {
+			if woc.GetShutdownStrategy().ShouldTerminate(onExitPod) {
 				woc.log.WithField("podName", pod.Name).
 					WithField("shutdownStrategy", woc.GetShutdownStrategy()).
 					Info(ctx, "Terminating pod as part of workflow shutdown")
@@ -66,9 +71,10 @@ func (woc *wfOperationCtx) applyExecutionControl(ctx context.Context, pod *apiv1
 				return
 			}
 		}
+		woc.applyNodeSuspend(ctx, pod, node.Suspend)
 	}
 	if woc.GetShutdownStrategy().Enabled() {
-		if _, onExitPod := pod.Labels[common.LabelKeyOnExit]; !woc.GetShutdownStrategy().ShouldExecute(onExitPod) {
+		if _, onExitPod := pod.Labels[common.LabelKeyOnExit]; woc.GetShutdownStrategy().ShouldTerminate(onExitPod) {
 			woc.log.WithField("podName", pod.Name).
 				Info(ctx, "Terminating on-exit pod")
 			woc.controller.PodController.TerminateContainers(ctx, pod.Namespace, pod.Name)
@@ -76,6 +82,33 @@ func (woc *wfOperationCtx) applyExecutionControl(ctx context.Context, pod *apiv1
 	}
 }
 
+// applyNodeSuspend mirrors a node's suspend field onto its pod as an annotation, which the executor
+// watches to stop and continue the main container
+func (woc *wfOperationCtx) applyNodeSuspend(ctx context.Context, pod *apiv1.Pod, suspend bool) {
+	if _, suspended := pod.Annotations[common.AnnotationKeySuspended]; suspended == suspend {
+		return
+	}
+	var value *string // a null value removes the annotation
+	if suspend {
+		value = ptr.To("true")
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{common.AnnotationKeySuspended: value},
+		},
+	})
+	if err != nil {
+		woc.log.WithError(err).Error(ctx, "failed to marshal suspend patch")
+		return
+	}
+	_, err = woc.controller.kubeclientset.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		woc.log.WithField("podName", pod.Name).WithError(err).Warn(ctx, "failed to update pod suspend annotation")
+		return
+	}
+	woc.log.WithField("podName", pod.Name).WithField("suspend", suspend).Info(ctx, "Updated pod suspend annotation")
+}
+
 // handleExecutionControlError marks a node as failed with an error message
 func (woc *wfOperationCtx) handleExecutionControlError(ctx context.Context, nodeID string, wfNodesLock *sync.RWMutex, errorMsg string) {
 	wfNodesLock.Lock()
@@ -115,7 +148,7 @@ func (woc *wfOperationCtx) killDaemonedChildren(ctx context.Context, nodeID stri
 		if !childNode.IsDaemoned() {
 			continue
 		}
-		podName := util.GeneratePodName(woc.wf.Name, childNode.Name, util.GetTemplateFromNode(childNode), childNode.ID, util.GetWorkflowPodNameVersion(woc.wf))
+		podName := util.GenerateWorkflowPodName(woc.wf, childNode.Name, util.GetTemplateFromNode(childNode), childNode.ID)
 		woc.controller.PodController.TerminateContainers(ctx, woc.wf.Namespace, podName)
 		childNode.Phase = wfv1.NodeSucceeded
 		childNode.Daemoned = nil
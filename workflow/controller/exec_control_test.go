@@ -5,10 +5,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 func TestKillDaemonChildrenUnmarkPod(t *testing.T) {
@@ -171,3 +175,24 @@ func TestHandleExecutionControlErrorMarksChildNodes(t *testing.T) {
 	assert.Equal(t, v1alpha1.NodeFailed, woc.wf.Status.Nodes[step1NodeName].Phase)
 	assert.Equal(t, v1alpha1.NodeFailed, woc.wf.Status.Nodes[step2NodeName].Phase)
 }
+
+func TestApplyNodeSuspend(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, &v1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns"}}, controller)
+	pods := controller.kubeclientset.CoreV1().Pods("my-ns")
+	pod, err := pods.Create(ctx, &apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "my-ns"}}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	woc.applyNodeSuspend(ctx, pod, true)
+	pod, err = pods.Get(ctx, "my-pod", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "true", pod.Annotations[common.AnnotationKeySuspended])
+
+	woc.applyNodeSuspend(ctx, pod, false)
+	pod, err = pods.Get(ctx, "my-pod", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, pod.Annotations, common.AnnotationKeySuspended)
+}
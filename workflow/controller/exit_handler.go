@@ -56,6 +56,9 @@ func (woc *wfOperationCtx) resolveExitTmplArgument(ctx context.Context, args wfv
 		scope = createScope(nil)
 	}
 	if outputs != nil {
+		if outputs.Result != nil {
+			scope.addParamToScope(fmt.Sprintf("%s.outputs.result", prefix), *outputs.Result)
+		}
 		for _, param := range outputs.Parameters {
 			value := ""
 			if param.Value != nil {
@@ -1258,3 +1258,94 @@ spec:
 	assert.Nil(t, node.NodeFlag)
 	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
 }
+
+const dagTaskPhaseHooksWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-task-phase-hooks
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: A
+        template: produce
+        onSuccess:
+          template: notify
+          arguments:
+            parameters:
+            - name: message
+              value: "{{tasks.A.outputs.parameters.msg}}"
+        onFailure:
+          template: notify
+          arguments:
+            parameters:
+            - name: message
+              value: "{{tasks.A.status}}"
+  - name: produce
+    container:
+      image: argoproj/argosay:v2
+      command: [/argosay]
+    outputs:
+      parameters:
+      - name: msg
+        valueFrom:
+          path: /tmp/msg
+  - name: notify
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: argoproj/argosay:v2
+      command: [/argosay]
+      args: ["{{inputs.parameters.message}}"]
+`
+
+func TestDAGTaskPhaseHooks(t *testing.T) {
+	t.Run("OnSuccess", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wf := wfv1.MustUnmarshalWorkflow(dagTaskPhaseHooksWf)
+		cancel, controller := newController(ctx, wf)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		node, err := woc.wf.GetNodeByName("dag-task-phase-hooks.A")
+		require.NoError(t, err)
+		node.Outputs = &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "msg", Value: wfv1.AnyStringPtr("hello")}}}
+		woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+		woc.operate(ctx)
+
+		node, err = woc.wf.GetNodeByName("dag-task-phase-hooks.A.hooks.onSuccess")
+		require.NoError(t, err)
+		assert.Equal(t, "hello", node.Inputs.GetParameterByName("message").Value.String())
+		assert.True(t, node.NodeFlag.Hooked)
+		_, err = woc.wf.GetNodeByName("dag-task-phase-hooks.A.hooks.onFailure")
+		require.Error(t, err)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	})
+	t.Run("OnFailure", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wf := wfv1.MustUnmarshalWorkflow(dagTaskPhaseHooksWf)
+		cancel, controller := newController(ctx, wf)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodFailed)
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.operate(ctx)
+
+		node, err := woc.wf.GetNodeByName("dag-task-phase-hooks.A.hooks.onFailure")
+		require.NoError(t, err)
+		assert.Equal(t, string(wfv1.NodeFailed), node.Inputs.GetParameterByName("message").Value.String())
+		_, err = woc.wf.GetNodeByName("dag-task-phase-hooks.A.hooks.onSuccess")
+		require.Error(t, err)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	})
+}
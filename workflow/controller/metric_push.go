@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// pushIntervalMetrics emits the workflow-level metrics which set pushInterval while the workflow is running.
+// Each metric is emitted at most once per reconciliation, when its interval has elapsed again since it was last
+// emitted, and the workflow is requeued for the next interval that elapses. Missed intervals are not emitted again.
+func (woc *wfOperationCtx) pushIntervalMetrics(ctx context.Context) {
+	if woc.wf.Status.Phase != wfv1.WorkflowRunning || woc.wf.Status.StartedAt.IsZero() {
+		return
+	}
+	value, _ := woc.controller.metricPushes.LoadOrStore(string(woc.wf.UID), map[string]int64{})
+	pushed := value.(map[string]int64)
+
+	elapsed := time.Since(woc.wf.Status.StartedAt.Time)
+	var due []*wfv1.Prometheus
+	var next time.Duration
+	for _, metric := range woc.execWf.Spec.Metrics.Prometheus {
+		if metric.PushInterval == nil || metric.PushInterval.Duration <= 0 {
+			continue
+		}
+		interval := metric.PushInterval.Duration
+		if count := int64(elapsed / interval); count > pushed[metric.Name] {
+			pushed[metric.Name] = count
+			due = append(due, metric)
+		}
+		if untilNext := interval - elapsed%interval; next == 0 || untilNext < next {
+			next = untilNext
+		}
+	}
+	if len(due) > 0 {
+		localScope, realTimeScope := woc.prepareDefaultMetricScope()
+		localScope[common.LocalVarStatus] = string(wfv1.NodeRunning)
+		woc.computeMetrics(ctx, due, localScope, realTimeScope, false)
+	}
+	if next > 0 {
+		woc.requeueAfter(next)
+	}
+}
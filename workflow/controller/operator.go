@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"math"
+	"math/rand/v2"
 	"os"
 	"reflect"
 	"regexp"
@@ -14,6 +16,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	gotemplate "text/template"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -58,6 +61,7 @@ import (
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/estimation"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
+	"github.com/argoproj/argo-workflows/v3/workflow/data"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
 	"github.com/argoproj/argo-workflows/v3/workflow/progress"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
@@ -111,6 +115,10 @@ type wfOperationCtx struct {
 
 	taskSet map[string]wfv1.Template
 
+	// livenessRestartedPods are the pods whose nodes failed in this operation because a livenessProbe restarted a main
+	// container. Their containers are terminated once the failure is persisted.
+	livenessRestartedPods []string
+
 	// currentStackDepth tracks the depth of the "stack", increased with every nested call to executeTemplate and decreased
 	// when such calls return. This is used to prevent infinite recursion
 	currentStackDepth int
@@ -123,7 +131,7 @@ var (
 	ErrParallelismReached       = errors.New(errors.CodeForbidden, "Max parallelism reached")
 	ErrResourceRateLimitReached = errors.New(errors.CodeForbidden, "resource creation rate-limit reached")
 	// ErrTimeout indicates a specific template timed out
-	ErrTimeout = errors.New(errors.CodeTimeout, "timeout")
+	ErrTimeout = errors.New(errors.CodeTimeout, "NodeTimeout: template timeout exceeded")
 	// ErrMaxDepthExceeded indicates that the maximum recursion depth was exceeded
 	ErrMaxDepthExceeded = errors.New(errors.CodeTimeout, fmt.Sprintf("Maximum recursion depth exceeded. See %s", help.ConfigureMaximumRecursionDepth()))
 )
@@ -134,6 +142,9 @@ var (
 	maxOperationTime = envutil.LookupEnvDurationOr(logging.InitLoggerInContext(), "MAX_OPERATION_TIME", 30*time.Second)
 )
 
+// expose for overriding in tests
+var nowFn = time.Now
+
 // failedNodeStatus is a subset of NodeStatus that is only used to Marshal certain fields into a JSON of failed nodes
 type failedNodeStatus struct {
 	DisplayName  string      `json:"displayName"`
@@ -193,6 +204,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 	defer func() {
 		woc.persistUpdates(ctx)
 	}()
+	defer woc.updateConditions()
 	defer func() {
 		if r := recover(); r != nil {
 			woc.log.WithFields(logging.Fields{"stack": string(debug.Stack()), "r": r}).Error(ctx, "Recovered from panic")
@@ -281,6 +293,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 	if woc.execWf.Spec.Metrics != nil {
 		localScope, realTimeScope := woc.prepareDefaultMetricScope()
 		woc.computeMetrics(ctx, woc.execWf.Spec.Metrics.Prometheus, localScope, realTimeScope, true)
+		woc.pushIntervalMetrics(ctx)
 	}
 
 	if woc.wf.Status.Phase == wfv1.WorkflowUnknown {
@@ -411,7 +424,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 					Message:      node.Message,
 					TemplateName: wfutil.GetTemplateFromNode(node),
 					Phase:        string(node.Phase),
-					PodName:      wfutil.GeneratePodName(woc.wf.Name, node.Name, wfutil.GetTemplateFromNode(node), node.ID, wfutil.GetPodNameVersion()),
+					PodName:      wfutil.GenerateWorkflowPodName(woc.wf, node.Name, wfutil.GetTemplateFromNode(node), node.ID),
 					FinishedAt:   node.FinishedAt,
 				})
 		}
@@ -521,8 +534,10 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 	if !woc.wf.Status.Fulfilled() {
 		return
 	}
+	woc.controller.resourceRecommendations.Delete(string(woc.wf.UID))
 
 	if woc.execWf.Spec.Metrics != nil {
+		woc.controller.metricPushes.Delete(string(woc.wf.UID))
 		woc.globalParams[common.GlobalVarWorkflowStatus] = string(workflowStatus)
 		localScope, realTimeScope := woc.prepareMetricScope(node)
 		woc.computeMetrics(ctx, woc.execWf.Spec.Metrics.Prometheus, localScope, realTimeScope, false)
@@ -616,6 +631,19 @@ func (woc *wfOperationCtx) getWorkflowDeadline() *time.Time {
 	return &deadline
 }
 
+// isDeadlinePriorityEscalated returns true if the workflow is within spec.deadlinePriorityEscalationThreshold of its
+// deadline, in which case new pods are created with spec.deadlinePriorityClassName.
+func (woc *wfOperationCtx) isDeadlinePriorityEscalated() bool {
+	if woc.execWf.Spec.DeadlinePriorityClassName == "" || woc.workflowDeadline == nil {
+		return false
+	}
+	threshold, err := woc.execWf.Spec.GetDeadlinePriorityEscalationThreshold()
+	if err != nil || threshold <= 0 {
+		return false
+	}
+	return woc.workflowDeadline.Sub(nowFn()) < threshold
+}
+
 // setGlobalParameters sets the globalParam map with global parameters
 func (woc *wfOperationCtx) setGlobalParameters(executionParameters wfv1.Arguments) error {
 	woc.globalParams[common.GlobalVarWorkflowName] = woc.wf.Name
@@ -740,6 +768,7 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 		woc.log.WithPanic().Error(ctx, "cannot persist updates with mismatched resource versions")
 	}
 	wfClient := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.Namespace)
+	woc.setNodeResourceVersions()
 	// try and compress nodes if needed
 	nodes := woc.wf.Status.Nodes
 	err := woc.controller.hydrator.Dehydrate(ctx, woc.wf)
@@ -820,6 +849,10 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 		woc.requeueAfter(5 * time.Second)
 	}
 
+	for _, podName := range woc.livenessRestartedPods {
+		woc.controller.PodController.TerminateMainContainers(ctx, woc.wf.Namespace, podName)
+	}
+
 	// It is important that we *never* label pods as completed until we successfully updated the workflow
 	// Failing to do so means we can have inconsistent state.
 	// Pods may be labeled multiple times.
@@ -853,6 +886,21 @@ func (woc *wfOperationCtx) writeBackToInformer() error {
 	return nil
 }
 
+// setNodeResourceVersions records the resource version each changed node was computed from, so that a concurrent
+// update of the same node can be detected by reapplyUpdate. Nodes can only be compared when the original is hydrated.
+func (woc *wfOperationCtx) setNodeResourceVersions() {
+	if !woc.controller.hydrator.IsHydrated(woc.orig) {
+		return
+	}
+	for id, node := range woc.wf.Status.Nodes {
+		if origNode, ok := woc.orig.Status.Nodes[id]; ok && reflect.DeepEqual(origNode, node) {
+			continue
+		}
+		node.ResourceVersion = woc.orig.ResourceVersion
+		woc.wf.Status.Nodes[id] = node
+	}
+}
+
 // persistWorkflowSizeLimitErr will fail a the workflow with an error when we hit the resource size limit
 // See https://github.com/argoproj/argo-workflows/issues/913
 func (woc *wfOperationCtx) persistWorkflowSizeLimitErr(ctx context.Context, wfClient v1alpha1.WorkflowInterface, err error) {
@@ -913,6 +961,10 @@ func (woc *wfOperationCtx) reapplyUpdate(ctx context.Context, wfClient v1alpha1.
 			if (err == nil) && currNode.Fulfilled() && node.Phase != currNode.Phase {
 				return nil, fmt.Errorf("must never update completed node %s", id)
 			}
+			// the node has been updated by someone else since we read it, so our update is based on stale state
+			if origNode := woc.orig.Status.Nodes[id]; (err == nil) && !reflect.DeepEqual(origNode, node) && currNode.ResourceVersion != origNode.ResourceVersion {
+				return nil, fmt.Errorf("node %s was updated concurrently", id)
+			}
 		}
 		currWfBytes, err := json.Marshal(currWf)
 		if err != nil {
@@ -956,6 +1008,17 @@ func (woc *wfOperationCtx) requeue() {
 	woc.controller.wfQueue.AddRateLimited(key)
 }
 
+// backoffJitter returns a uniformly distributed random duration in [0, jitter].
+// It is seeded by the ID of the last retried node, so it stays the same each time the retry node is reconciled.
+func backoffJitter(nodeID string, jitter time.Duration) time.Duration {
+	if jitter <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(nodeID))
+	return time.Duration(rand.New(rand.NewPCG(h.Sum64(), 0)).Int64N(int64(jitter) + 1))
+}
+
 // processNodeRetries updates the retry node state based on the child node state and the retry strategy and returns the node.
 func (woc *wfOperationCtx) processNodeRetries(ctx context.Context, node *wfv1.NodeStatus, retryStrategy wfv1.RetryStrategy, opts *executeTemplateOpts) (*wfv1.NodeStatus, bool, error) {
 	if node.Phase.Fulfilled(node.TaskResultSynced) {
@@ -1052,6 +1115,13 @@ func (woc *wfOperationCtx) processNodeRetries(ctx context.Context, node *wfv1.No
 				timeToWait = capDuration
 			}
 		}
+		if retryStrategy.Backoff.Jitter != "" {
+			jitter, err := wfv1.ParseStringToDuration(retryStrategy.Backoff.Jitter)
+			if err != nil {
+				return nil, false, err
+			}
+			timeToWait += backoffJitter(lastChildNode.ID, jitter)
+		}
 		waitingDeadline := lastChildNode.FinishedAt.Add(timeToWait)
 
 		// If the waiting deadline is after the max duration deadline, then it's futile to wait until then. Stop early
@@ -1100,6 +1170,11 @@ func (woc *wfOperationCtx) processNodeRetries(ctx context.Context, node *wfv1.No
 		return woc.markNodePhase(ctx, node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
 	}
 
+	if retryStrategy.OnlyTransientErrors() && !woc.controller.isTransientFailure(lastChildNode) {
+		woc.log.WithField("message", lastChildNode.Message).Info(ctx, "Node did not fail with a transient error, not retrying")
+		return woc.markNodePhase(ctx, node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
+	}
+
 	if !lastChildNode.CanRetry() {
 		woc.log.Info(ctx, "Node cannot be retried, marking it failed")
 		return woc.markNodePhase(ctx, node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
@@ -1402,6 +1477,9 @@ func (woc *wfOperationCtx) assessNodeStatus(ctx context.Context, pod *apiv1.Pod,
 	case apiv1.PodFailed:
 		// ignore pod failure for daemoned steps
 		new.Phase, new.Message = woc.inferFailedReason(ctx, pod, tmpl)
+		if woc.isTemplateTimeout(pod, tmpl) {
+			new.Message = ErrTimeout.Error()
+		}
 		woc.log.WithFields(logging.Fields{"message": new.Message, "displayName": old.DisplayName, "templateName": wfutil.GetTemplateFromNode(*old), "pod": pod.Name}).Info(ctx, "Pod failed")
 		new.Daemoned = nil
 	case apiv1.PodRunning:
@@ -1423,6 +1501,14 @@ func (woc *wfOperationCtx) assessNodeStatus(ctx context.Context, pod *apiv1.Pod,
 					woc.log.WithField("nodeId", old.ID).Info(ctx, "Node became daemoned")
 				}
 			}
+		} else if message := common.LivenessProbeRestart(pod, tmpl); message != "" {
+			// the restarted container will not report an exit code, so fail the node to allow it to be retried
+			new.Phase = wfv1.NodeFailed
+			new.Message = message
+			// the node stays running until the wait container has saved the outputs, so its message records the failure
+			if old.Message != message {
+				woc.livenessRestartedPods = append(woc.livenessRestartedPods, pod.Name)
+			}
 		} else {
 			new.Phase = wfv1.NodeRunning
 		}
@@ -2413,6 +2499,15 @@ func (woc *wfOperationCtx) checkTemplateTimeout(tmpl *wfv1.Template, node *wfv1.
 	return nil, nil
 }
 
+// isTemplateTimeout returns true if the pod was killed for exceeding the deadline set from its template's timeout,
+// rather than the workflow's deadline
+func (woc *wfOperationCtx) isTemplateTimeout(pod *apiv1.Pod, tmpl *wfv1.Template) bool {
+	if tmpl == nil || tmpl.Timeout == "" || pod.Status.Reason != "DeadlineExceeded" {
+		return false
+	}
+	return woc.workflowDeadline == nil || time.Now().UTC().Before(*woc.workflowDeadline)
+}
+
 // recordWorkflowPhaseChange stores the metrics associated with the workflow phase changing
 func (woc *wfOperationCtx) recordWorkflowPhaseChange(ctx context.Context) {
 	phase := metrics.ConvertWorkflowPhase(woc.wf.Status.Phase)
@@ -2466,10 +2561,15 @@ func (woc *wfOperationCtx) markWorkflowPhase(ctx context.Context, phase wfv1.Wor
 			}
 		}
 	}
+	if woc.wf.Status.StartedAt.IsZero() && woc.wf.Status.QueuedAt == nil {
+		woc.updated = true
+		woc.wf.Status.QueuedAt = &metav1.Time{Time: time.Now().UTC()}
+	}
 	if woc.wf.Status.StartedAt.IsZero() && phase != wfv1.WorkflowPending {
 		woc.updated = true
 		woc.wf.Status.StartedAt = metav1.Time{Time: time.Now().UTC()}
 		woc.wf.Status.EstimatedDuration = woc.estimateWorkflowDuration(ctx)
+		woc.controller.metrics.RecordWorkflowQueueDuration(ctx, woc.wf.Status.StartedAt.Sub(woc.wf.Status.QueuedAt.Time), woc.wf.Namespace)
 	}
 	if woc.wf.Status.Message != message {
 		woc.log.WithFields(logging.Fields{"fromMessage": woc.wf.Status.Message, "toMessage": message}).Info(ctx, "updated message")
@@ -3236,6 +3336,20 @@ func (woc *wfOperationCtx) executeScript(ctx context.Context, nodeName string, t
 	}
 
 	mainCtr := tmpl.Script.Container
+	if tmpl.Script.ImageRef != nil {
+		image, err := woc.resolveImageRef(tmpl.Script.ImageRef)
+		if err != nil {
+			return node, err
+		}
+		mainCtr.Image = image
+	}
+	if name := tmpl.Script.Interpreter; name != "" {
+		command, ok := woc.controller.Config.Interpreters[name]
+		if !ok {
+			return node, fmt.Errorf("script.interpreter %q is not one of the interpreters configured in the controller", name)
+		}
+		mainCtr.Command = command
+	}
 	if len(tmpl.Script.Source) == 0 {
 		woc.log.Warn(ctx, "'script.source' is empty, suggest change template into 'container'")
 	} else {
@@ -3252,6 +3366,23 @@ func (woc *wfOperationCtx) executeScript(ctx context.Context, nodeName string, t
 	return node, err
 }
 
+// resolveImageRef returns the image stored in the referenced ClusterWorkflowTemplate's parameter
+func (woc *wfOperationCtx) resolveImageRef(ref *wfv1.ImageRef) (string, error) {
+	if woc.controller.cwftmplInformer == nil {
+		return "", fmt.Errorf("cannot get resource clusterWorkflowTemplate at cluster scope")
+	}
+	cwftmpl, err := woc.controller.cwftmplInformer.Lister().Get(ref.ClusterWorkflowTemplate)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve script.imageRef: %w", err)
+	}
+	for _, param := range cwftmpl.Spec.Arguments.Parameters {
+		if param.Name == ref.ImageKey && param.Value != nil && param.Value.String() != "" {
+			return param.Value.String(), nil
+		}
+	}
+	return "", fmt.Errorf("failed to resolve script.imageRef: clusterworkflowtemplate %s has no parameter %s with a value", ref.ClusterWorkflowTemplate, ref.ImageKey)
+}
+
 func (woc *wfOperationCtx) requeueIfTransientErr(ctx context.Context, err error, nodeName string) (*wfv1.NodeStatus, error) {
 	if errorsutil.IsTransientErr(ctx, err) || err == ErrResourceRateLimitReached {
 		// Our error was most likely caused by a lack of resources.
@@ -3589,13 +3720,29 @@ func (woc *wfOperationCtx) executeResource(ctx context.Context, nodeName string,
 }
 
 func (woc *wfOperationCtx) executeData(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	// data sourced from the template's inputs is transformed by the controller, so there is no pod or task result
+	fromInputs := tmpl.Data.Source.IsFromInputs()
 	node, err := woc.wf.GetNodeByName(nodeName)
 	if err != nil {
-		node = woc.initializeExecutableNode(ctx, nodeName, wfv1.NodeTypePod, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending, opts.nodeFlag, false)
+		node = woc.initializeExecutableNode(ctx, nodeName, wfv1.NodeTypePod, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending, opts.nodeFlag, fromInputs)
 	} else if !node.Pending() {
 		return node, nil
 	}
 
+	if fromInputs {
+		transformedData, err := data.ProcessInputs(tmpl.Data, &tmpl.Inputs)
+		if err != nil {
+			return node, fmt.Errorf("unable to process data template: %w", err)
+		}
+		out, err := json.Marshal(transformedData)
+		if err != nil {
+			return node, err
+		}
+		node.Outputs = &wfv1.Outputs{Result: ptr.To(string(out))}
+		woc.wf.Status.Nodes.Set(ctx, node.ID, *node)
+		return woc.markNodePhase(ctx, node.Name, wfv1.NodeSucceeded), nil
+	}
+
 	dataTemplate, err := json.Marshal(tmpl.Data)
 	if err != nil {
 		return node, fmt.Errorf("could not marshal data in transformation: %w", err)
@@ -3802,14 +3949,14 @@ func expandSequence(seq *wfv1.Sequence) ([]wfv1.Item, error) {
 	} else {
 		return nil, errors.InternalError("neither end nor count was specified in withSequence")
 	}
-	items := make([]wfv1.Item, 0)
-	format := "%d"
-	if seq.Format != "" {
-		format = seq.Format
+	formatItem, err := sequenceFormatter(seq)
+	if err != nil {
+		return nil, err
 	}
+	items := make([]wfv1.Item, 0)
 	if start <= end {
 		for i := start; i <= end; i++ {
-			item, err := wfv1.ParseItem(`"` + fmt.Sprintf(format, i) + `"`)
+			item, err := formatItem(i)
 			if err != nil {
 				return nil, err
 			}
@@ -3817,7 +3964,7 @@ func expandSequence(seq *wfv1.Sequence) ([]wfv1.Item, error) {
 		}
 	} else {
 		for i := start; i >= end; i-- {
-			item, err := wfv1.ParseItem(`"` + fmt.Sprintf(format, i) + `"`)
+			item, err := formatItem(i)
 			if err != nil {
 				return nil, err
 			}
@@ -3827,6 +3974,34 @@ func expandSequence(seq *wfv1.Sequence) ([]wfv1.Item, error) {
 	return items, nil
 }
 
+// sequenceFormatter returns a function formatting a sequence value as an item, using either the
+// printf style format or the Go text/template of the sequence
+func sequenceFormatter(seq *wfv1.Sequence) (func(int) (wfv1.Item, error), error) {
+	if seq.GoTemplate != "" {
+		if seq.Format != "" {
+			return nil, errors.Errorf(errors.CodeBadRequest, "only one of format or goTemplate can be defined in withSequence")
+		}
+		tmpl, err := gotemplate.New("withSequence").Option("missingkey=error").Parse(seq.GoTemplate)
+		if err != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "withSequence.goTemplate is invalid: %v", err)
+		}
+		return func(i int) (wfv1.Item, error) {
+			var b strings.Builder
+			if err := tmpl.Execute(&b, i); err != nil {
+				return wfv1.Item{}, errors.Errorf(errors.CodeBadRequest, "failed to execute withSequence.goTemplate: %v", err)
+			}
+			return wfv1.ParseItem(strconv.Quote(b.String()))
+		}, nil
+	}
+	format := "%d"
+	if seq.Format != "" {
+		format = seq.Format
+	}
+	return func(i int) (wfv1.Item, error) {
+		return wfv1.ParseItem(`"` + fmt.Sprintf(format, i) + `"`)
+	}, nil
+}
+
 func (woc *wfOperationCtx) substituteParamsInVolumes(ctx context.Context, params map[string]string) error {
 	if woc.volumes == nil {
 		return nil
@@ -4061,23 +4236,31 @@ func (woc *wfOperationCtx) includeScriptOutput(ctx context.Context, nodeName, bo
 }
 
 func (woc *wfOperationCtx) fetchWorkflowSpec(ctx context.Context) (wfv1.WorkflowSpecHolder, error) {
-	if woc.wf.Spec.WorkflowTemplateRef == nil { // not-woc-misuse
+	wftRef := woc.wf.Spec.GetWorkflowTemplateRef() // not-woc-misuse
+	if wftRef == nil {
 		return nil, fmt.Errorf("cannot fetch workflow spec without workflowTemplateRef")
 	}
 
 	var specHolder wfv1.WorkflowSpecHolder
 	var err error
 	// Logic for workflow refers Workflow template
-	if woc.wf.Spec.WorkflowTemplateRef.ClusterScope { // not-woc-misuse
+	if wftRef.ClusterScope {
 		if woc.controller.cwftmplInformer == nil {
 			woc.log.WithError(err).Error(ctx, "clusterWorkflowTemplate RBAC is missing")
 			return nil, fmt.Errorf("cannot get resource clusterWorkflowTemplate at cluster scope")
 		}
-		woc.controller.metrics.CountWorkflowTemplate(ctx, metrics.WorkflowNew, woc.wf.Spec.WorkflowTemplateRef.Name, woc.wf.Namespace, true) // not-woc-misuse
-		specHolder, err = woc.controller.cwftmplInformer.Lister().Get(woc.wf.Spec.WorkflowTemplateRef.Name)                                  // not-woc-misuse
+		woc.controller.metrics.CountWorkflowTemplate(ctx, metrics.WorkflowNew, wftRef.Name, woc.wf.Namespace, true)
+		cwftmpl, err := woc.controller.cwftmplInformer.Lister().Get(wftRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		if err := templateresolution.CheckClusterWorkflowTemplateNamespace(cwftmpl, woc.wf.Namespace); err != nil {
+			return nil, err
+		}
+		specHolder = cwftmpl
 	} else {
-		woc.controller.metrics.CountWorkflowTemplate(ctx, metrics.WorkflowNew, woc.wf.Spec.WorkflowTemplateRef.Name, woc.wf.Namespace, false)  // not-woc-misuse
-		specHolder, err = woc.controller.wftmplInformer.Lister().WorkflowTemplates(woc.wf.Namespace).Get(woc.wf.Spec.WorkflowTemplateRef.Name) // not-woc-misuse
+		woc.controller.metrics.CountWorkflowTemplate(ctx, metrics.WorkflowNew, wftRef.Name, woc.wf.Namespace, false)
+		specHolder, err = woc.controller.wftmplInformer.Lister().WorkflowTemplates(woc.wf.Namespace).Get(wftRef.Name)
 	}
 	if err != nil {
 		return nil, err
@@ -4093,7 +4276,12 @@ func (woc *wfOperationCtx) retryStrategy(tmpl *wfv1.Template) *wfv1.RetryStrateg
 }
 
 func (woc *wfOperationCtx) setExecWorkflow(ctx context.Context) error {
-	if woc.wf.Spec.WorkflowTemplateRef != nil { // not-woc-misuse
+	if woc.wf.Spec.WorkflowTemplateRef == nil && woc.controller.Config.WorkflowRestrictions.MustUseReference() { // not-woc-misuse
+		err := fmt.Errorf("workflows must use workflowTemplateRef to be executed when the controller is in reference mode")
+		woc.markWorkflowError(ctx, err)
+		return err
+	}
+	if woc.wf.Spec.GetWorkflowTemplateRef() != nil { // not-woc-misuse
 		err := woc.setStoredWfSpec(ctx)
 		if err != nil {
 			woc.markWorkflowError(ctx, err)
@@ -4101,10 +4289,6 @@ func (woc *wfOperationCtx) setExecWorkflow(ctx context.Context) error {
 		}
 		woc.execWf = &wfv1.Workflow{Spec: *woc.wf.Status.StoredWorkflowSpec.DeepCopy()}
 		woc.volumes = woc.execWf.Spec.DeepCopy().Volumes
-	} else if woc.controller.Config.WorkflowRestrictions.MustUseReference() {
-		err := fmt.Errorf("workflows must use workflowTemplateRef to be executed when the controller is in reference mode")
-		woc.markWorkflowError(ctx, err)
-		return err
 	} else {
 		err := woc.controller.setWorkflowDefaults(woc.wf)
 		if err != nil {
@@ -4116,7 +4300,11 @@ func (woc *wfOperationCtx) setExecWorkflow(ctx context.Context) error {
 
 	// Perform one-time workflow validation
 	if woc.wf.Status.Phase == wfv1.WorkflowUnknown {
-		validateOpts := validate.ValidateOpts{}
+		validateOpts := validate.ValidateOpts{Interpreters: woc.controller.Config.Interpreters}
+		if validateOpts.Interpreters == nil {
+			// no interpreter is allowed
+			validateOpts.Interpreters = map[string][]string{}
+		}
 		wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(woc.controller.wfclientset.ArgoprojV1alpha1().WorkflowTemplates(woc.wf.Namespace))
 		cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(woc.controller.wfclientset.ArgoprojV1alpha1().ClusterWorkflowTemplates())
 
@@ -4170,9 +4358,26 @@ func (woc *wfOperationCtx) setGlobalRuntimeParameters() {
 }
 
 func (woc *wfOperationCtx) GetShutdownStrategy() wfv1.ShutdownStrategy {
+	// a workflow that has not finished draining by its deadline is terminated
+	if woc.execWf.Spec.Shutdown == wfv1.ShutdownStrategyDrain && woc.workflowDeadline != nil && time.Now().UTC().After(*woc.workflowDeadline) {
+		return wfv1.ShutdownStrategyTerminate
+	}
 	return woc.execWf.Spec.Shutdown
 }
 
+// isDraining returns whether the workflow is being drained and still has pods that have not completed
+func (woc *wfOperationCtx) isDraining() bool {
+	if woc.GetShutdownStrategy() != wfv1.ShutdownStrategyDrain {
+		return false
+	}
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypePod && !node.Fulfilled() {
+			return true
+		}
+	}
+	return false
+}
+
 func (woc *wfOperationCtx) ShouldSuspend() bool {
 	return woc.execWf.Spec.Suspend != nil && *woc.execWf.Spec.Suspend
 }
@@ -4280,11 +4485,10 @@ func (woc *wfOperationCtx) substituteGlobalVariables(ctx context.Context, params
 	return nil
 }
 
-// getPodName gets the appropriate pod name for a workflow based on the
+// getPodName gets the appropriate pod name for a workflow based on its podNameFormat or the
 // POD_NAMES environment variable
 func (woc *wfOperationCtx) getPodName(nodeName, templateName string) string {
-	version := wfutil.GetWorkflowPodNameVersion(woc.wf)
-	return wfutil.GeneratePodName(woc.wf.Name, nodeName, templateName, woc.wf.NodeID(nodeName), version)
+	return wfutil.GenerateWorkflowPodName(woc.wf, nodeName, templateName, woc.wf.NodeID(nodeName))
 }
 
 func (woc *wfOperationCtx) getServiceAccountTokenName(ctx context.Context, name string) (string, error) {
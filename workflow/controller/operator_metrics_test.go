@@ -12,6 +12,7 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 )
 
 var basicMetric = `
@@ -880,3 +881,81 @@ func TestRuntimeMetrics(t *testing.T) {
 	require.NoError(t, err)
 	assert.InDelta(t, float64(1), value, 0.001)
 }
+
+var pushIntervalMetric = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: push-interval-metric
+spec:
+  entrypoint: main
+  metrics:
+    prometheus:
+      - name: push_counter
+        help: "How many times the metric has been pushed"
+        pushInterval: 1m
+        counter:
+          value: "1"
+  templates:
+    - name: main
+      container:
+        image: busybox:latest
+        command: [sleep, "600"]
+`
+
+func TestPushIntervalMetric(t *testing.T) {
+	wf := v1alpha1.MustUnmarshalWorkflow(pushIntervalMetric)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	require.Equal(t, v1alpha1.WorkflowRunning, woc.wf.Status.Phase)
+	assert.False(t, controller.metrics.CustomMetricExists("push_counter"))
+
+	// run the workflow for 3x the interval, reconciling twice per interval
+	startedAt := woc.wf.Status.StartedAt.Time
+	for elapsed := 30 * time.Second; elapsed <= 3*time.Minute+time.Second; elapsed += 30 * time.Second {
+		woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+		woc.wf.Status.StartedAt = metav1.NewTime(startedAt.Add(-elapsed - time.Second))
+		woc.operate(ctx)
+	}
+
+	attribs := attribute.NewSet()
+	val, err := testExporter.GetFloat64CounterValue(ctx, "push_counter", &attribs)
+	require.NoError(t, err)
+	assert.InDelta(t, float64(3), val, 0.001)
+}
+
+func TestWorkflowQueueDurationMetric(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx)
+	defer cancel()
+	wf := v1alpha1.MustUnmarshalWorkflow(helloWorldWf)
+	wf.Namespace = "queue-duration"
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+
+	// postponed, e.g. because of parallelism
+	woc.markWorkflowPhase(ctx, v1alpha1.WorkflowPending, "")
+	require.NotNil(t, woc.wf.Status.QueuedAt)
+	assert.True(t, woc.wf.Status.StartedAt.IsZero())
+	queuedAt := woc.wf.Status.QueuedAt.Add(-time.Minute)
+	woc.wf.Status.QueuedAt = &metav1.Time{Time: queuedAt}
+
+	woc.markWorkflowPhase(ctx, v1alpha1.WorkflowRunning, "")
+	assert.Equal(t, queuedAt, woc.wf.Status.QueuedAt.Time)
+	assert.False(t, woc.wf.Status.StartedAt.IsZero())
+
+	attribs := attribute.NewSet(attribute.String(telemetry.AttribWorkflowNamespace, "queue-duration"))
+	val, err := testExporter.GetFloat64HistogramData(ctx, telemetry.InstrumentWorkflowQueueDurationSeconds.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), val.Count)
+	assert.InDelta(t, 60.0, val.Sum, 1.0)
+
+	// only recorded when the workflow starts
+	woc.markWorkflowPhase(ctx, v1alpha1.WorkflowSucceeded, "")
+	val, err = testExporter.GetFloat64HistogramData(ctx, telemetry.InstrumentWorkflowQueueDurationSeconds.Name(), &attribs)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), val.Count)
+}
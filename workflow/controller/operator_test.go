@@ -32,6 +32,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	intstrutil "github.com/argoproj/argo-workflows/v3/util/intstr"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/strftime"
@@ -116,6 +117,79 @@ func Test_wfOperationCtx_reapplyUpdate(t *testing.T) {
 		_, err := woc.reapplyUpdate(ctx, controller.wfclientset.ArgoprojV1alpha1().Workflows(""), wf.Status.Nodes)
 		require.EqualError(t, err, "must never update completed node my-node")
 	})
+	t.Run("ErrNodeUpdatedConcurrently", func(t *testing.T) {
+		wf := &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf", ResourceVersion: "1"},
+			Status:     wfv1.WorkflowStatus{Nodes: wfv1.Nodes{"my-node": wfv1.NodeStatus{Phase: wfv1.NodePending}}},
+		}
+		currWf := wf.DeepCopy()
+		currWf.Status.Nodes = wfv1.Nodes{"my-node": wfv1.NodeStatus{Phase: wfv1.NodeRunning, ResourceVersion: "1"}}
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx, currWf)
+		defer cancel()
+		controller.hydrator = hydratorfake.Always
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		nodes := wfv1.Nodes{"my-node": wfv1.NodeStatus{Phase: wfv1.NodeRunning, Message: "my-message", ResourceVersion: "1"}}
+		_, err := woc.reapplyUpdate(ctx, controller.wfclientset.ArgoprojV1alpha1().Workflows(""), nodes)
+		require.EqualError(t, err, "node my-node was updated concurrently")
+	})
+}
+
+func Test_wfOperationCtx_persistUpdates_conflict(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		// the node updated by another controller before our update
+		concurrentNode string
+		wantErr        bool
+	}{
+		{name: "OtherNode", concurrentNode: "bar"},
+		{name: "SameNode", concurrentNode: "foo", wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			wf := &wfv1.Workflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-wf", ResourceVersion: "1"},
+				Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowRunning, Nodes: wfv1.Nodes{
+					"foo": wfv1.NodeStatus{Name: "my-foo", Phase: wfv1.NodeRunning},
+					"bar": wfv1.NodeStatus{Name: "my-bar", Phase: wfv1.NodeRunning},
+				}},
+			}
+			ctx := logging.TestContext(t.Context())
+			cancel, controller := newController(ctx, wf)
+			defer cancel()
+			wfClient := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+			currWf := wf.DeepCopy()
+			currWf.ResourceVersion = "2"
+			currWf.Status.Nodes[tt.concurrentNode] = wfv1.NodeStatus{Name: "concurrent", Phase: wfv1.NodeRunning, ResourceVersion: "1"}
+			_, err := wfClient.Update(ctx, currWf, metav1.UpdateOptions{})
+			require.NoError(t, err)
+
+			// the fake clientset does not check resource versions, so reject the first update as the API server would
+			conflicted := false
+			controller.wfclientset.(*fakewfclientset.Clientset).PrependReactor("update", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if conflicted {
+					return false, nil, nil
+				}
+				conflicted = true
+				return true, nil, apierr.NewConflict(schema.GroupResource{Group: workflow.Group, Resource: workflow.WorkflowPlural}, wf.Name, errors.New("the object has been modified"))
+			})
+
+			woc := newWorkflowOperationCtx(ctx, wf, controller)
+			woc.wf.Status.Nodes["foo"] = wfv1.NodeStatus{Name: "my-foo", Phase: wfv1.NodeSucceeded}
+			woc.updated = true
+			woc.persistUpdates(ctx)
+			assert.True(t, conflicted)
+
+			updatedWf, err := wfClient.Get(ctx, wf.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			if tt.wantErr {
+				assert.Equal(t, "concurrent", updatedWf.Status.Nodes["foo"].Name, "the concurrent update is not overwritten")
+				return
+			}
+			assert.Equal(t, wfv1.NodeStatus{Name: "my-foo", Phase: wfv1.NodeSucceeded, ResourceVersion: "1"}, updatedWf.Status.Nodes["foo"])
+			assert.Equal(t, "concurrent", updatedWf.Status.Nodes["bar"].Name, "the concurrent update is kept")
+		})
+	}
 }
 
 func TestResourcesDuration(t *testing.T) {
@@ -750,6 +824,67 @@ func TestProcessNodeRetriesOnTransientErrors(t *testing.T) {
 	assert.Equal(t, wfv1.NodeError, n.Phase)
 }
 
+func TestProcessNodeRetriesOnlyTransientErrors(t *testing.T) {
+	cancel, controller := newController(logging.TestContext(t.Context()))
+	defer cancel()
+	ctx := logging.TestContext(t.Context())
+	retries := wfv1.RetryStrategy{
+		Limit:   intstrutil.ParsePtr("2"),
+		RetryOn: &wfv1.RetryOn{TransientErrors: true},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		exitCode string
+		message  string
+		phase    wfv1.NodePhase
+	}{
+		{"OOMKilled", "137", "OOMKilled (exit code 137)", wfv1.NodeRunning},
+		{"Evicted", "", "Pod was evicted. The node was low on resource: memory.", wfv1.NodeRunning},
+		{"Preempted", "", "Preempted in order to admit critical pod", wfv1.NodeRunning},
+		{"PodDeleted", "", "pod deleted", wfv1.NodeRunning},
+		{"ExitCode", "1", "Error (exit code 1)", wfv1.NodeFailed},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			woc := newWorkflowOperationCtx(ctx, wfv1.MustUnmarshalWorkflow(helloWorldWf), controller)
+			nodeName := "test-node"
+			woc.initializeNode(ctx, nodeName, wfv1.NodeTypeRetry, "", &wfv1.WorkflowStep{}, "", wfv1.NodeRunning, &wfv1.NodeFlag{}, true)
+			childNode := nodeName + "(0)"
+			woc.initializeNode(ctx, childNode, wfv1.NodeTypePod, "", &wfv1.WorkflowStep{}, "", wfv1.NodeRunning, &wfv1.NodeFlag{Retried: true}, true)
+			woc.addChildNode(ctx, nodeName, childNode)
+			child := woc.markNodePhase(ctx, childNode, wfv1.NodeFailed, tt.message)
+			if tt.exitCode != "" {
+				child.Outputs = &wfv1.Outputs{ExitCode: ptr.To(tt.exitCode)}
+				woc.wf.Status.Nodes.Set(ctx, child.ID, *child)
+			}
+
+			n, err := woc.wf.GetNodeByName(nodeName)
+			require.NoError(t, err)
+			n, _, err = woc.processNodeRetries(ctx, n, retries, &executeTemplateOpts{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.phase, n.Phase)
+		})
+	}
+
+	t.Run("Configured", func(t *testing.T) {
+		var err error
+		controller.transientErrors, err = newTransientErrorMatcher(&config.TransientErrors{ExitCodes: []int{3}, MessagePatterns: []string{`connection reset`}})
+		require.NoError(t, err)
+		defer func() { controller.transientErrors = nil }()
+		for _, child := range []wfv1.NodeStatus{
+			{Message: "Error (exit code 3)", Outputs: &wfv1.Outputs{ExitCode: ptr.To("3")}},
+			{Message: "read: connection reset by peer"},
+			{Message: "OOMKilled (exit code 137)", Outputs: &wfv1.Outputs{ExitCode: ptr.To("137")}},
+		} {
+			assert.True(t, controller.isTransientFailure(&child), child.Message)
+		}
+		assert.False(t, controller.isTransientFailure(&wfv1.NodeStatus{Message: "Error (exit code 1)", Outputs: &wfv1.Outputs{ExitCode: ptr.To("1")}}))
+
+		_, err = newTransientErrorMatcher(&config.TransientErrors{MessagePatterns: []string{`(`}})
+		require.Error(t, err)
+	})
+}
+
 func TestProcessNodeRetriesWithBackoff(t *testing.T) {
 	cancel, controller := newController(logging.TestContext(t.Context()))
 	defer cancel()
@@ -897,6 +1032,49 @@ func TestProcessNodeRetriesWithExponentialBackoff(t *testing.T) {
 	require.Equal(wfv1.NodeSucceeded, n.Phase)
 }
 
+// TestProcessNodeRetriesWithBackoffJitter verifies that jitter spreads the backoff of nodes failing together
+func TestProcessNodeRetriesWithBackoffJitter(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx)
+	defer cancel()
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+
+	retries := wfv1.RetryStrategy{
+		Limit:       intstrutil.ParsePtr("3"),
+		RetryPolicy: wfv1.RetryPolicyAlways,
+		Backoff: &wfv1.Backoff{
+			Duration: "5m",
+			Jitter:   "5m",
+		},
+	}
+	backoffs := make(map[int]bool)
+	for i := range 100 {
+		nodeName := fmt.Sprintf("test-node-%d", i)
+		woc.initializeNode(ctx, nodeName, wfv1.NodeTypeRetry, "", &wfv1.WorkflowStep{}, "", wfv1.NodeRunning, &wfv1.NodeFlag{}, true)
+		woc.initializeNode(ctx, nodeName+"(0)", wfv1.NodeTypePod, "", &wfv1.WorkflowStep{}, "", wfv1.NodeFailed, &wfv1.NodeFlag{Retried: true}, true)
+		woc.addChildNode(ctx, nodeName, nodeName+"(0)")
+		n, err := woc.wf.GetNodeByName(nodeName)
+		require.NoError(t, err)
+
+		n, _, err = woc.processNodeRetries(ctx, n, retries, &executeTemplateOpts{})
+		require.NoError(t, err)
+		require.Equal(t, wfv1.NodeRunning, n.Phase)
+		backoff, err := parseRetryMessage(n.Message)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, backoff, 300)
+		require.LessOrEqual(t, backoff, 600)
+		backoffs[backoff] = true
+
+		// the jitter does not change when the node is reconciled again
+		message := n.Message
+		n, _, err = woc.processNodeRetries(ctx, n, retries, &executeTemplateOpts{})
+		require.NoError(t, err)
+		assert.Equal(t, message, n.Message)
+	}
+	assert.Greater(t, len(backoffs), 50, "backoffs should be distributed rather than equal")
+}
+
 // TestProcessNodeRetries tests retrying with Expression
 func TestProcessNodeRetriesWithExpression(t *testing.T) {
 	cancel, controller := newController(logging.TestContext(t.Context()))
@@ -1871,6 +2049,70 @@ func TestAssessNodeStatus(t *testing.T) {
 		node:        &wfv1.NodeStatus{TemplateName: templateName},
 		wantPhase:   wfv1.NodeFailed,
 		wantMessage: "Pod Failed",
+	}, {
+		name: "pod running - main container restarted by liveness probe, wait container running",
+		pod: &apiv1.Pod{
+			Spec: apiv1.PodSpec{Containers: []apiv1.Container{{Name: common.MainContainerName, LivenessProbe: &apiv1.Probe{}}}},
+			Status: apiv1.PodStatus{
+				ContainerStatuses: []apiv1.ContainerStatus{
+					{
+						Name:  common.WaitContainerName,
+						State: apiv1.ContainerState{Running: &apiv1.ContainerStateRunning{}},
+					},
+					{
+						Name:                 common.MainContainerName,
+						RestartCount:         1,
+						State:                apiv1.ContainerState{Running: &apiv1.ContainerStateRunning{}},
+						LastTerminationState: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: 137}},
+					},
+				},
+				Phase: apiv1.PodRunning,
+			},
+		},
+		node:        &wfv1.NodeStatus{TemplateName: templateName, Phase: wfv1.NodeRunning},
+		wantPhase:   wfv1.NodeRunning,
+		wantMessage: "main: container restarted after its livenessProbe failed (exit code 137)",
+	}, {
+		name: "pod running - main container restarted by liveness probe, wait container terminated",
+		pod: &apiv1.Pod{
+			Spec: apiv1.PodSpec{Containers: []apiv1.Container{{Name: common.MainContainerName, LivenessProbe: &apiv1.Probe{}}}},
+			Status: apiv1.PodStatus{
+				ContainerStatuses: []apiv1.ContainerStatus{
+					{
+						Name:  common.WaitContainerName,
+						State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: 1}},
+					},
+					{
+						Name:                 common.MainContainerName,
+						RestartCount:         1,
+						State:                apiv1.ContainerState{Running: &apiv1.ContainerStateRunning{}},
+						LastTerminationState: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: 137}},
+					},
+				},
+				Phase: apiv1.PodRunning,
+			},
+		},
+		node:        &wfv1.NodeStatus{TemplateName: templateName, Phase: wfv1.NodeRunning},
+		wantPhase:   wfv1.NodeFailed,
+		wantMessage: "main: container restarted after its livenessProbe failed (exit code 137)",
+	}, {
+		name: "pod running - main container restarted without liveness probe",
+		pod: &apiv1.Pod{
+			Spec: apiv1.PodSpec{Containers: []apiv1.Container{{Name: common.MainContainerName}}},
+			Status: apiv1.PodStatus{
+				ContainerStatuses: []apiv1.ContainerStatus{
+					{
+						Name:         common.MainContainerName,
+						RestartCount: 1,
+						State:        apiv1.ContainerState{Running: &apiv1.ContainerStateRunning{}},
+					},
+				},
+				Phase: apiv1.PodRunning,
+			},
+		},
+		node:        &wfv1.NodeStatus{TemplateName: templateName, Phase: wfv1.NodeRunning},
+		wantPhase:   wfv1.NodeRunning,
+		wantMessage: "",
 	}}
 
 	nonDaemonWf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
@@ -1894,6 +2136,36 @@ func TestAssessNodeStatus(t *testing.T) {
 	}
 }
 
+// TestLivenessProbeRestartTerminatesOnce verifies that the containers of a pod restarted by its livenessProbe are
+// terminated only when the failure is first recorded, leaving the wait container to save the outputs.
+func TestLivenessProbeRestartTerminatesOnce(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	pod := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pod"},
+		Spec:       apiv1.PodSpec{Containers: []apiv1.Container{{Name: common.MainContainerName, LivenessProbe: &apiv1.Probe{}}}},
+		Status: apiv1.PodStatus{
+			ContainerStatuses: []apiv1.ContainerStatus{
+				{Name: common.WaitContainerName, State: apiv1.ContainerState{Running: &apiv1.ContainerStateRunning{}}},
+				{Name: common.MainContainerName, RestartCount: 1, State: apiv1.ContainerState{Running: &apiv1.ContainerStateRunning{}}},
+			},
+			Phase: apiv1.PodRunning,
+		},
+	}
+	node := &wfv1.NodeStatus{TemplateName: "whalesay", Phase: wfv1.NodeRunning}
+	got := woc.assessNodeStatus(ctx, pod, node)
+	assert.Equal(t, wfv1.NodeRunning, got.Phase)
+	assert.Equal(t, []string{"my-pod"}, woc.livenessRestartedPods)
+
+	// once the failure is recorded, the pod is not terminated again
+	woc = newWorkflowOperationCtx(ctx, wf, controller)
+	woc.assessNodeStatus(ctx, pod, got)
+	assert.Empty(t, woc.livenessRestartedPods)
+}
+
 func getPodTemplate(pod *apiv1.Pod) (*wfv1.Template, error) {
 	tmpl := &wfv1.Template{}
 	for _, c := range pod.Spec.InitContainers {
@@ -2586,6 +2858,171 @@ func TestSequence(t *testing.T) {
 	assert.True(t, found101)
 }
 
+var sequenceGoTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: sequence-go-template
+spec:
+  entrypoint: steps
+  arguments:
+    parameters:
+    - name: prefix
+      value: user
+  templates:
+  - name: steps
+    steps:
+      - - name: step1
+          template: echo
+          arguments:
+            parameters:
+            - name: msg
+              value: "{{item}}"
+          withSequence:
+            start: "9"
+            end: "10"
+            goTemplate: '{{workflow.parameters.prefix}}-{{printf "%03d" .}}'
+
+  - name: echo
+    inputs:
+      parameters:
+      - name: msg
+    container:
+      image: alpine:latest
+      command: [echo, "{{inputs.parameters.msg}}"]
+`
+
+func TestSequenceGoTemplate(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(sequenceGoTemplate)
+	woc := newWoc(ctx, *wf)
+	woc.operate(ctx)
+	var msgs []string
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypePod {
+			msgs = append(msgs, node.Inputs.Parameters[0].Value.String())
+		}
+	}
+	assert.ElementsMatch(t, []string{"user-009", "user-010"}, msgs)
+}
+
+var scriptImageRef = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: script-image-ref
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    script:
+      imageRef:
+        clusterWorkflowTemplate: base-images
+        imageKey: python
+      command: [python]
+      source: print("hello")
+`
+
+var scriptInterpreter = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: script-interpreter
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    script:
+      image: python:3.12
+      interpreter: python3
+      source: print("hello")
+`
+
+func TestScriptInterpreter(t *testing.T) {
+	t.Run("Configured", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wf := wfv1.MustUnmarshalWorkflow(scriptInterpreter)
+		cancel, controller := newController(ctx, wf)
+		defer cancel()
+		controller.Config.Interpreters = map[string][]string{"python3": {"/usr/local/bin/python3"}}
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		ctr := pods.Items[0].Spec.Containers[1]
+		assert.Equal(t, "/usr/local/bin/python3", ctr.Command[len(ctr.Command)-1])
+		assert.Equal(t, []string{common.ExecutorScriptSourcePath}, ctr.Args)
+	})
+	t.Run("NotConfigured", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wf := wfv1.MustUnmarshalWorkflow(scriptInterpreter)
+		cancel, controller := newController(ctx, wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+		assert.Equal(t, `invalid spec: templates.main.script.interpreter "python3" is not one of the interpreters configured in the controller`, woc.wf.Status.Message)
+	})
+}
+
+var baseImages = `
+apiVersion: argoproj.io/v1alpha1
+kind: ClusterWorkflowTemplate
+metadata:
+  name: base-images
+spec:
+  arguments:
+    parameters:
+    - name: python
+      value: python:3.12
+  templates:
+  - name: noop
+    suspend: {}
+`
+
+func TestScriptImageRef(t *testing.T) {
+	t.Run("Resolved", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wf := wfv1.MustUnmarshalWorkflow(scriptImageRef)
+		cancel, controller := newController(ctx, wf, wfv1.MustUnmarshalClusterWorkflowTemplate(baseImages))
+		defer cancel()
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		assert.Equal(t, "python:3.12", pods.Items[0].Spec.Containers[1].Image)
+	})
+	t.Run("MissingTemplate", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wf := wfv1.MustUnmarshalWorkflow(scriptImageRef)
+		cancel, controller := newController(ctx, wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("script-image-ref")
+		require.NotNil(t, node)
+		assert.Equal(t, wfv1.NodeError, node.Phase)
+		assert.Contains(t, node.Message, `failed to resolve script.imageRef: clusterworkflowtemplate.argoproj.io "base-images" not found`)
+	})
+	t.Run("MissingKey", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		wf := wfv1.MustUnmarshalWorkflow(scriptImageRef)
+		wf.Spec.Templates[0].Script.ImageRef.ImageKey = "golang"
+		cancel, controller := newController(ctx, wf, wfv1.MustUnmarshalClusterWorkflowTemplate(baseImages))
+		defer cancel()
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("script-image-ref")
+		require.NotNil(t, node)
+		assert.Equal(t, wfv1.NodeError, node.Phase)
+		assert.Equal(t, "failed to resolve script.imageRef: clusterworkflowtemplate base-images has no parameter golang with a value", node.Message)
+	})
+}
+
 var inputParametersAsJSON = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -3428,6 +3865,43 @@ func TestExpandWithSequence(t *testing.T) {
 	assert.Len(t, items, 10)
 	assert.Equal(t, "testuser01", items[0].GetStrVal())
 	assert.Equal(t, "testuser0A", items[9].GetStrVal())
+
+	seq = wfv1.Sequence{
+		GoTemplate: `testuser{{printf "%02X" .}}`,
+		Count:      intstrutil.ParsePtr("10"),
+		Start:      intstrutil.ParsePtr("1"),
+	}
+	items, err = expandSequence(&seq)
+	require.NoError(t, err)
+	assert.Len(t, items, 10)
+	assert.Equal(t, "testuser01", items[0].GetStrVal())
+	assert.Equal(t, "testuser0A", items[9].GetStrVal())
+
+	seq = wfv1.Sequence{
+		GoTemplate: `"{{.}}"`,
+		Start:      intstrutil.ParsePtr("3"),
+		End:        intstrutil.ParsePtr("1"),
+	}
+	items, err = expandSequence(&seq)
+	require.NoError(t, err)
+	assert.Len(t, items, 3)
+	assert.Equal(t, `"3"`, items[0].GetStrVal())
+	assert.Equal(t, `"1"`, items[2].GetStrVal())
+
+	seq = wfv1.Sequence{
+		GoTemplate: "{{.",
+		Count:      intstrutil.ParsePtr("1"),
+	}
+	_, err = expandSequence(&seq)
+	require.ErrorContains(t, err, "withSequence.goTemplate is invalid")
+
+	seq = wfv1.Sequence{
+		Format:     "%d",
+		GoTemplate: "{{.}}",
+		Count:      intstrutil.ParsePtr("1"),
+	}
+	_, err = expandSequence(&seq)
+	require.EqualError(t, err, "only one of format or goTemplate can be defined in withSequence")
 }
 
 var metadataTemplate = `
@@ -6947,6 +7421,117 @@ func TestFailSuspendedAndPendingNodesAfterShutdown(t *testing.T) {
 	})
 }
 
+var drainWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: drain
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: a
+        template: sleep
+      - name: b
+        template: sleep
+        dependencies: [a]
+  - name: sleep
+    container:
+      image: argoproj/argosay:v2
+      args: [sleep, 1m]
+`
+
+func TestDrainShutdown(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(drainWf)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodRunning)
+
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.wf.Spec.Shutdown = wfv1.ShutdownStrategyDrain
+	woc.operate(ctx)
+	a := woc.wf.Status.Nodes.FindByDisplayName("a")
+	require.NotNil(t, a)
+	assert.Equal(t, wfv1.NodeRunning, a.Phase, "running pods are not terminated")
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	assert.Len(t, pods.Items, 1, "no new pods are created")
+	b := woc.wf.Status.Nodes.FindByDisplayName("b")
+	require.NotNil(t, b)
+	assert.Equal(t, wfv1.NodeFailed, b.Phase)
+	assert.Equal(t, "workflow shutdown with strategy: Drain", b.Message)
+	assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	assert.Equal(t, "Stopped with strategy 'Drain'", woc.wf.Status.Message)
+}
+
+func TestDrainShutdownAfterDeadline(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(drainWf)
+	wf.Spec.Shutdown = wfv1.ShutdownStrategyDrain
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	assert.Equal(t, wfv1.ShutdownStrategyDrain, woc.GetShutdownStrategy())
+	woc.workflowDeadline = ptr.To(time.Now().UTC().Add(-time.Second))
+	assert.Equal(t, wfv1.ShutdownStrategyTerminate, woc.GetShutdownStrategy())
+}
+
+var dataFromInputsWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: data-from-inputs
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: filter
+        template: filter
+        arguments:
+          parameters:
+          - name: numbers
+            value: "[1, 2, 3]"
+  - name: filter
+    inputs:
+      parameters:
+      - name: numbers
+    data:
+      source:
+        parameterName: numbers
+      transformation:
+      - expression: "filter(data, {# > 1})"
+`
+
+func TestDataTemplateFromInputs(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(dataFromInputsWf)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items)
+	node := woc.wf.Status.Nodes.FindByDisplayName("filter")
+	require.NotNil(t, node)
+	assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+	require.NotNil(t, node.Outputs)
+	assert.Equal(t, "[2,3]", *node.Outputs.Result)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+}
+
 func Test_processItem(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
 	task := wfv1.DAGTask{
@@ -7087,6 +7672,96 @@ func TestTemplateTimeoutDuration(t *testing.T) {
 	})
 }
 
+var dagTimeoutContinueOnWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-timeout-continue-on
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: slow
+        template: slow
+        continueOn:
+          failed: true
+      - name: next
+        template: fast
+        dependencies: [slow]
+  - name: slow
+    timeout: 1h
+    container:
+      image: argoproj/argosay:v2
+      args: [sleep, 2h]
+  - name: fast
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestTemplateTimeoutPodDeadline(t *testing.T) {
+	getPodDeadline := func(t *testing.T, wf *wfv1.Workflow) int64 {
+		t.Helper()
+		ctx := logging.TestContext(t.Context())
+		cancel, controller := newController(ctx, wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(ctx, wf, controller)
+		woc.operate(ctx)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		require.NotNil(t, pods.Items[0].Spec.ActiveDeadlineSeconds)
+		return *pods.Items[0].Spec.ActiveDeadlineSeconds
+	}
+	t.Run("TemplateTimeout", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(stepTimeoutWf)
+		wf.Spec.Templates[1].Timeout = "10m"
+		deadline := getPodDeadline(t, wf)
+		assert.LessOrEqual(t, deadline, int64(600))
+		assert.Greater(t, deadline, int64(590))
+	})
+	t.Run("WorkflowDeadlineRemaining", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(stepTimeoutWf)
+		wf.Spec.Templates[1].Timeout = "1h"
+		wf.Spec.ActiveDeadlineSeconds = ptr.To(int64(60))
+		deadline := getPodDeadline(t, wf)
+		assert.LessOrEqual(t, deadline, int64(60))
+		assert.Greater(t, deadline, int64(50))
+	})
+	t.Run("TemplateActiveDeadlineSecondsShorter", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(stepTimeoutWf)
+		wf.Spec.Templates[1].Timeout = "1h"
+		wf.Spec.Templates[1].ActiveDeadlineSeconds = intstrutil.ParsePtr("30")
+		assert.Equal(t, int64(30), getPodDeadline(t, wf))
+	})
+}
+
+func TestTemplateTimeoutContinueOn(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(dagTimeoutContinueOnWf)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	makePodsPhase(ctx, woc, apiv1.PodFailed, func(pod *apiv1.Pod, _ *wfOperationCtx) {
+		pod.Status.Reason = "DeadlineExceeded"
+		pod.Status.Message = "Pod was active on the node longer than the specified deadline"
+	})
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	slow := woc.wf.Status.Nodes.FindByDisplayName("slow")
+	require.NotNil(t, slow)
+	assert.Equal(t, wfv1.NodeFailed, slow.Phase)
+	assert.Equal(t, "NodeTimeout: template timeout exceeded", slow.Message)
+	next := woc.wf.Status.Nodes.FindByDisplayName("next")
+	require.NotNil(t, next)
+	assert.Equal(t, wfv1.NodePending, next.Phase)
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+}
+
 var wfWithPVC = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	intstrutil "github.com/argoproj/argo-workflows/v3/util/intstr"
@@ -258,3 +259,34 @@ func TestSetTemplateDefault(t *testing.T) {
 		assert.Nil(t, tmpl2.Script)
 	})
 }
+
+func TestTemplateDefaultDAGFailFast(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx)
+	defer cancel()
+	wf := wfv1.MustUnmarshalWorkflow(dagWf)
+	wf.Spec.TemplateDefaults = &wfv1.Template{DAG: &wfv1.DAGTemplate{Tasks: []wfv1.DAGTask{}, FailFast: ptr.To(false)}}
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	require.NoError(t, woc.setExecWorkflow(ctx))
+
+	t.Run("Default", func(t *testing.T) {
+		tmpl := woc.execWf.Spec.Templates[0]
+		require.NoError(t, woc.mergedTemplateDefaultsInto(&tmpl))
+		assert.Equal(t, wfv1.TemplateTypeDAG, tmpl.GetType())
+		assert.Len(t, tmpl.DAG.Tasks, 4)
+		assert.Equal(t, ptr.To(false), tmpl.DAG.FailFast)
+	})
+	t.Run("TemplateTakesPrecedence", func(t *testing.T) {
+		tmpl := woc.execWf.Spec.Templates[0]
+		tmpl.DAG = tmpl.DAG.DeepCopy()
+		tmpl.DAG.FailFast = ptr.To(true)
+		require.NoError(t, woc.mergedTemplateDefaultsInto(&tmpl))
+		assert.Equal(t, ptr.To(true), tmpl.DAG.FailFast)
+	})
+	t.Run("NotDAG", func(t *testing.T) {
+		tmpl := woc.execWf.Spec.Templates[3]
+		require.NoError(t, woc.mergedTemplateDefaultsInto(&tmpl))
+		assert.Equal(t, wfv1.TemplateTypeContainer, tmpl.GetType())
+		assert.Nil(t, tmpl.DAG)
+	})
+}
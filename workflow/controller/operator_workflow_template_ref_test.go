@@ -825,3 +825,116 @@ func TestWorkflowTemplateWithPodMetadata(t *testing.T) {
 	assert.Contains(t, pod.Labels, "workflow-template-label")
 	assert.Contains(t, pod.Annotations, "all-pods-should-have-this")
 }
+
+const wfTmplWithSteps = `
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: build
+  namespace: default
+spec:
+  entrypoint: full
+  serviceAccountName: my-sa
+  arguments:
+    parameters:
+    - name: message
+      value: from-template
+  templates:
+  - name: full
+    steps:
+    - - name: compile
+        template: compile
+    - - name: publish
+        template: publish
+  - name: compile-only
+    steps:
+    - - name: compile
+        template: compile
+  - name: compile
+    container:
+      image: compiler
+      command: [compile]
+      args: ["{{workflow.parameters.message}}"]
+  - name: publish
+    container:
+      image: publisher
+      command: [publish]
+`
+
+const wfWithEntrypointRef = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: build-compile-only
+  namespace: default
+spec:
+  entrypointRef:
+    workflowTemplate: build
+    template: compile-only
+  templates:
+  - name: compile
+    container:
+      image: my-compiler
+      command: [compile]
+      args: ["{{workflow.parameters.message}}"]
+`
+
+func TestWorkflowEntrypointRef(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wfv1.MustUnmarshalWorkflow(wfWithEntrypointRef), wfv1.MustUnmarshalWorkflowTemplate(wfTmplWithSteps))
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wfv1.MustUnmarshalWorkflow(wfWithEntrypointRef), controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase, woc.wf.Status.Message)
+	assert.Equal(t, "compile-only", woc.execWf.Spec.Entrypoint)
+	assert.Len(t, woc.execWf.Spec.Templates, 4)
+	// the rest of the workflow template's spec is kept
+	assert.Equal(t, "my-sa", woc.globalParams["workflow.serviceAccountName"])
+	assert.Equal(t, "from-template", woc.globalParams["workflow.parameters.message"])
+
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	// the workflow's template overrides the workflow template's
+	assert.Equal(t, "my-compiler", pods.Items[0].Spec.Containers[1].Image)
+}
+
+const restrictedClusterWorkflowTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: ClusterWorkflowTemplate
+metadata:
+  name: restricted-cluster-template
+spec:
+  entrypoint: main
+  allowedNamespaces: [allowed]
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestWorkflowTemplateRefAllowedNamespaces(t *testing.T) {
+	for namespace, phase := range map[string]wfv1.WorkflowPhase{"allowed": wfv1.WorkflowRunning, "denied": wfv1.WorkflowError} {
+		t.Run(namespace, func(t *testing.T) {
+			wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: restricted
+spec:
+  workflowTemplateRef:
+    name: restricted-cluster-template
+    clusterScope: true
+`)
+			wf.Namespace = namespace
+			ctx := logging.TestContext(t.Context())
+			cancel, controller := newController(ctx, wf, wfv1.MustUnmarshalClusterWorkflowTemplate(restrictedClusterWorkflowTemplate))
+			defer cancel()
+			woc := newWorkflowOperationCtx(ctx, wf, controller)
+			woc.operate(ctx)
+			assert.Equal(t, phase, woc.wf.Status.Phase)
+			if phase == wfv1.WorkflowError {
+				assert.Contains(t, woc.wf.Status.Message, "cluster workflow template restricted-cluster-template may not be used in namespace denied")
+			}
+		})
+	}
+}
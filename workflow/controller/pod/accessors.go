@@ -32,6 +32,11 @@ func (c *Controller) TerminateContainers(ctx context.Context, namespace, name st
 	c.queuePodForCleanup(ctx, namespace, name, terminateContainers)
 }
 
+// TerminateMainContainers terminates the main and sidecar containers of a pod, but not its wait container
+func (c *Controller) TerminateMainContainers(ctx context.Context, namespace, name string) {
+	c.queuePodForCleanup(ctx, namespace, name, terminateMainContainers)
+}
+
 func (c *Controller) DeletePod(ctx context.Context, namespace, name string) {
 	c.queuePodForCleanup(ctx, namespace, name, deletePod)
 }
@@ -56,6 +56,10 @@ func determinePodCleanupAction(
 		return deletePod
 	case strategy == wfv1.PodGCOnPodSuccess && podPhase == apiv1.PodFailed:
 		return labelPodCompleted
+	case strategy == wfv1.PodGCOnPodFailure && podPhase == apiv1.PodSucceeded:
+		return deletePod
+	case strategy == wfv1.PodGCOnPodFailure && podPhase == apiv1.PodFailed:
+		return labelPodCompleted
 	case workflowPhase.Completed():
 		return labelPodCompleted
 	case hasOurFinalizer(finalizers):
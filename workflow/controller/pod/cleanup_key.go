@@ -20,7 +20,10 @@ const (
 	labelPodCompleted   podCleanupAction = "labelPodCompleted"
 	terminateContainers podCleanupAction = "terminateContainers"
 	killContainers      podCleanupAction = "killContainers"
-	removeFinalizer     podCleanupAction = "removeFinalizer"
+	// terminateMainContainers and killMainContainers signal all containers except the wait container
+	terminateMainContainers podCleanupAction = "terminateMainContainers"
+	killMainContainers      podCleanupAction = "killMainContainers"
+	removeFinalizer         podCleanupAction = "removeFinalizer"
 )
 
 func newPodCleanupKey(namespace string, podName string, action podCleanupAction) podCleanupKey {
@@ -58,6 +58,12 @@ func TestDeterminePodCleanupAction(t *testing.T) {
 		{fields{wfv1.PodGCOnPodSuccess, wfv1.WorkflowFailed, apiv1.PodSucceeded, finalizersOurs}, deletePod},
 		{fields{wfv1.PodGCOnPodSuccess, wfv1.WorkflowFailed, apiv1.PodFailed, finalizersOurs}, labelPodCompleted},
 
+		{fields{wfv1.PodGCOnPodFailure, wfv1.WorkflowRunning, apiv1.PodSucceeded, finalizersOurs}, deletePod},
+		{fields{wfv1.PodGCOnPodFailure, wfv1.WorkflowRunning, apiv1.PodFailed, finalizersOurs}, labelPodCompleted},
+		{fields{wfv1.PodGCOnPodFailure, wfv1.WorkflowSucceeded, apiv1.PodSucceeded, finalizersOurs}, deletePod},
+		{fields{wfv1.PodGCOnPodFailure, wfv1.WorkflowFailed, apiv1.PodSucceeded, finalizersOurs}, deletePod},
+		{fields{wfv1.PodGCOnPodFailure, wfv1.WorkflowFailed, apiv1.PodFailed, finalizersOurs}, labelPodCompleted},
+
 		{fields{wfv1.PodGCOnPodCompletion, wfv1.WorkflowRunning, apiv1.PodSucceeded, finalizersOurs}, deletePod},
 		{fields{wfv1.PodGCOnPodCompletion, wfv1.WorkflowRunning, apiv1.PodFailed, finalizersOurs}, deletePod},
 		{fields{wfv1.PodGCOnPodCompletion, wfv1.WorkflowSucceeded, apiv1.PodSucceeded, finalizersOurs}, deletePod},
@@ -211,6 +211,8 @@ func (c *Controller) commonPodEvent(ctx context.Context, pod *apiv1.Pod, deletin
 		case podGC.Strategy == wfv1.PodGCOnPodCompletion:
 		case podGC.Strategy == wfv1.PodGCOnPodSuccess && pod.Status.Phase == apiv1.PodSucceeded:
 			action = deletePod
+		case podGC.Strategy == wfv1.PodGCOnPodFailure && pod.Status.Phase == apiv1.PodSucceeded:
+			action = deletePod
 		}
 	}
 	if action != noAction {
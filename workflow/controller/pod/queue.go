@@ -51,15 +51,15 @@ func (c *Controller) getPodCleanupPatch(pod *apiv1.Pod, labelPodCompleted bool)
 	return un.MarshalJSON()
 }
 
-// signalContainers signals all containers of a pod
-func (c *Controller) signalContainers(ctx context.Context, namespace string, podName string, sig syscall.Signal) (time.Duration, error) {
+// signalContainers signals all containers of a pod, except for those to skip
+func (c *Controller) signalContainers(ctx context.Context, namespace string, podName string, sig syscall.Signal, skip ...string) (time.Duration, error) {
 	pod, err := c.GetPod(namespace, podName)
 	if pod == nil || err != nil {
 		return 0, err
 	}
 
 	for _, container := range pod.Status.ContainerStatuses {
-		if container.State.Running == nil {
+		if container.State.Running == nil || slices.Contains(skip, container.Name) {
 			continue
 		}
 		// problems are already logged at info level, so we just ignore errors here
@@ -128,6 +128,17 @@ func (c *Controller) processNextPodCleanupItem(ctx context.Context) bool {
 			if _, err := c.signalContainers(ctx, namespace, podName, syscall.SIGKILL); err != nil {
 				return err
 			}
+		case terminateMainContainers:
+			// the wait container is left to save the outputs and logs once the other containers have stopped
+			if terminationGracePeriod, err := c.signalContainers(ctx, namespace, podName, syscall.SIGTERM, common.WaitContainerName); err != nil {
+				return err
+			} else if terminationGracePeriod > 0 {
+				c.queuePodForCleanupAfter(ctx, namespace, podName, killMainContainers, terminationGracePeriod)
+			}
+		case killMainContainers:
+			if _, err := c.signalContainers(ctx, namespace, podName, syscall.SIGKILL, common.WaitContainerName); err != nil {
+				return err
+			}
 		case labelPodCompleted:
 			pods := c.kubeclientset.CoreV1().Pods(namespace)
 			if err := c.patchPodForCleanup(ctx, pods, namespace, podName, true); err != nil {
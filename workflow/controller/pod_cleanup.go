@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	apiv1 "k8s.io/api/core/v1"
@@ -22,18 +23,39 @@ func (woc *wfOperationCtx) getPodGCDelay(ctx context.Context, podGC *wfv1.PodGC)
 	return delay
 }
 
+// getKeepFailedPodDuration returns how long failed pods are kept with the OnPodFailure strategy, or a negative
+// duration if they are kept until the workflow is deleted
+func (woc *wfOperationCtx) getKeepFailedPodDuration(ctx context.Context, podGC *wfv1.PodGC) time.Duration {
+	keep, err := podGC.GetKeepFailedPodDuration()
+	if err != nil {
+		woc.log.WithError(err).Warn(ctx, "failed to parse podGC.keepFailedPodDuration")
+		return -1
+	}
+	return keep
+}
+
 func (woc *wfOperationCtx) queuePodsForCleanup(ctx context.Context) {
 	podGC := woc.execWf.Spec.PodGC
 	delay := woc.getPodGCDelay(ctx, podGC)
+	keepFailed := woc.getKeepFailedPodDuration(ctx, podGC)
 	strategy := podGC.GetStrategy()
 	selector, _ := podGC.GetLabelSelector()
 	workflowPhase := woc.wf.Status.Phase
 	objs, _ := woc.controller.PodController.GetPodsByIndex(indexes.WorkflowIndex, woc.wf.Namespace+"/"+woc.wf.Name)
+	retained := retainedPods(podGC, workflowPhase, objs)
 	for _, obj := range objs {
 		pod := obj.(*apiv1.Pod)
 		if _, ok := pod.Labels[common.LabelKeyComponent]; ok { // for these types we don't want to do PodGC
 			continue
 		}
+		podStrategy, podDelay := strategy, delay
+		if retained[pod.Name] {
+			podStrategy = wfv1.PodGCOnPodNone
+		}
+		if strategy == wfv1.PodGCOnPodFailure && pod.Status.Phase == apiv1.PodFailed && keepFailed >= 0 {
+			// failed pods are deleted like any other pod, once they have been kept for long enough
+			podStrategy, podDelay = wfv1.PodGCOnPodCompletion, keepFailed
+		}
 		nodeID := woc.nodeID(pod)
 		node, err := woc.wf.Status.Nodes.Get(nodeID)
 		if err != nil {
@@ -44,6 +66,34 @@ func (woc *wfOperationCtx) queuePodsForCleanup(ctx context.Context) {
 		if !nodePhase.Fulfilled(node.TaskResultSynced) {
 			continue
 		}
-		woc.controller.PodController.EnactAnyPodCleanup(ctx, selector, pod, strategy, workflowPhase, delay)
+		woc.controller.PodController.EnactAnyPodCleanup(ctx, selector, pod, podStrategy, workflowPhase, podDelay)
+	}
+}
+
+// retainedPods returns the names of the podGC.retainCount most recently created pods, which are kept
+// rather than deleted when the workflow completes
+func retainedPods(podGC *wfv1.PodGC, workflowPhase wfv1.WorkflowPhase, objs []interface{}) map[string]bool {
+	if podGC == nil || podGC.RetainCount <= 0 || !workflowPhase.Completed() {
+		return nil
+	}
+	switch podGC.Strategy {
+	case wfv1.PodGCOnWorkflowCompletion, wfv1.PodGCOnWorkflowSuccess:
+	default:
+		return nil
+	}
+	var pods []*apiv1.Pod
+	for _, obj := range objs {
+		pod := obj.(*apiv1.Pod)
+		if _, ok := pod.Labels[common.LabelKeyComponent]; !ok {
+			pods = append(pods, pod)
+		}
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[j].CreationTimestamp.Before(&pods[i].CreationTimestamp)
+	})
+	retained := make(map[string]bool)
+	for i := 0; i < len(pods) && i < int(podGC.RetainCount); i++ {
+		retained[pods[i].Name] = true
 	}
+	return retained
 }
@@ -0,0 +1,81 @@
+package pullsecret
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+// acrUsername is the username to use with an ACR refresh token
+const acrUsername = "00000000-0000-0000-0000-000000000000"
+
+// acrTokenSource exchanges an Azure AD token of the default Azure credentials for an ACR refresh token. The refresh
+// token is valid for longer than the Azure AD token, so the expiry of the Azure AD token is used.
+type acrTokenSource struct {
+	credential azcore.TokenCredential
+	registries []string
+	client     *http.Client
+}
+
+func newACRTokenSource(c config.ACRPullSecret) (*acrTokenSource, error) {
+	credential, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Azure credentials: %w", err)
+	}
+	return &acrTokenSource{credential: credential, registries: c.Registries, client: http.DefaultClient}, nil
+}
+
+func (s *acrTokenSource) Token(ctx context.Context) (map[string]registryAuth, time.Time, error) {
+	aadToken, err := s.credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{"https://management.azure.com/.default"}})
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to get Azure AD token: %w", err)
+	}
+	auths := make(map[string]registryAuth, len(s.registries))
+	for _, registry := range s.registries {
+		refreshToken, err := s.exchange(ctx, registry, aadToken.Token)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		auths[registry] = newRegistryAuth(acrUsername, refreshToken)
+	}
+	return auths, aadToken.ExpiresOn, nil
+}
+
+// exchange exchanges the Azure AD token for a refresh token of the registry
+func (s *acrTokenSource) exchange(ctx context.Context, registry, aadToken string) (string, error) {
+	form := url.Values{
+		"grant_type":   {"access_token"},
+		"service":      {registry},
+		"access_token": {aadToken},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+registry+"/oauth2/exchange", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to exchange Azure AD token for %s: %w", registry, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to exchange Azure AD token for %s: %s", registry, resp.Status)
+	}
+	var body struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode ACR refresh token for %s: %w", registry, err)
+	}
+	return body.RefreshToken, nil
+}
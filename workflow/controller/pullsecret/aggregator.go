@@ -0,0 +1,306 @@
+package pullsecret
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// registryAuth is the credentials for a registry
+type registryAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Auth     string `json:"auth"`
+}
+
+func newRegistryAuth(username, password string) registryAuth {
+	return registryAuth{
+		Username: username,
+		Password: password,
+		Auth:     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+	}
+}
+
+// tokenSource gets short-lived credentials for one or more registries
+type tokenSource interface {
+	// Token returns the credentials by registry, and when they expire
+	Token(ctx context.Context) (map[string]registryAuth, time.Time, error)
+}
+
+// token is the docker config of a pull secret
+type token struct {
+	dockerConfig []byte
+	expiry       time.Time
+}
+
+// secretConfig is the configuration of a managed pull secret
+type secretConfig struct {
+	sources []tokenSource
+	// namespaces are the namespaces that the secret may be created in
+	namespaces map[string]bool
+}
+
+// Aggregator creates the pull secrets configured in the controller, in the namespaces of the workflows that use them,
+// and refreshes them before their tokens expire. The secrets are updated in place, so the imagePullSecrets of a
+// workflow keep pointing at a valid secret for as long as it runs.
+type Aggregator struct {
+	kubeclientset kubernetes.Interface
+	now           func() time.Time
+	// group makes sure that only one caller at a time gets the token of a secret, or writes a secret
+	group singleflight.Group
+	// lock guards the fields below, and is not held while calling the registries or the Kubernetes API
+	lock          sync.Mutex
+	refreshBuffer time.Duration
+	configs       map[string]secretConfig
+	// tokens is the latest token of each secret
+	tokens map[string]token
+	// secrets is the expiry of the token written to each secret, by namespace/name
+	secrets map[string]time.Time
+}
+
+// New creates an aggregator for the configured pull secrets
+func New(ctx context.Context, kubeclientset kubernetes.Interface, cfg config.AggregatedPullSecrets) (*Aggregator, error) {
+	a := &Aggregator{
+		kubeclientset: kubeclientset,
+		now:           time.Now,
+		tokens:        make(map[string]token),
+		secrets:       make(map[string]time.Time),
+	}
+	if err := a.Update(ctx, cfg); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Update replaces the configuration of the aggregator when the controller configuration changes. The secrets that
+// were already written are still refreshed, unless they are no longer configured.
+func (a *Aggregator) Update(ctx context.Context, cfg config.AggregatedPullSecrets) error {
+	configs := make(map[string]secretConfig, len(cfg.Secrets))
+	for _, s := range cfg.Secrets {
+		if s.Name == "" {
+			return fmt.Errorf("aggregated pull secrets must have a name")
+		}
+		if _, ok := configs[s.Name]; ok {
+			return fmt.Errorf("aggregated pull secret %q is defined more than once", s.Name)
+		}
+		var sources []tokenSource
+		if s.ECR != nil {
+			source, err := newECRTokenSource(ctx, *s.ECR)
+			if err != nil {
+				return fmt.Errorf("aggregated pull secret %q: %w", s.Name, err)
+			}
+			sources = append(sources, source)
+		}
+		if s.GCR != nil {
+			sources = append(sources, newGCRTokenSource(*s.GCR))
+		}
+		if s.ACR != nil {
+			source, err := newACRTokenSource(*s.ACR)
+			if err != nil {
+				return fmt.Errorf("aggregated pull secret %q: %w", s.Name, err)
+			}
+			sources = append(sources, source)
+		}
+		if len(sources) == 0 {
+			return fmt.Errorf("aggregated pull secret %q must have at least one of ecr, gcr or acr", s.Name)
+		}
+		if len(s.Namespaces) == 0 {
+			return fmt.Errorf("aggregated pull secret %q must list the namespaces it may be created in", s.Name)
+		}
+		namespaces := make(map[string]bool, len(s.Namespaces))
+		for _, namespace := range s.Namespaces {
+			namespaces[namespace] = true
+		}
+		configs[s.Name] = secretConfig{sources: sources, namespaces: namespaces}
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.refreshBuffer = cfg.GetRefreshBuffer()
+	a.configs = configs
+	// the credentials may have changed
+	a.tokens = make(map[string]token)
+	for key := range a.secrets {
+		if namespace, name, err := cache.SplitMetaNamespaceKey(key); err != nil || !a.allowed(namespace, name) {
+			delete(a.secrets, key)
+		}
+	}
+	return nil
+}
+
+// allowed returns whether the secret is managed by the aggregator in the namespace. The lock must be held.
+func (a *Aggregator) allowed(namespace, name string) bool {
+	c, ok := a.configs[name]
+	return ok && c.namespaces[namespace]
+}
+
+// expiresSoon returns whether the expiry is within the refresh buffer. The lock must be held.
+func (a *Aggregator) expiresSoon(expiry time.Time) bool {
+	return !a.now().Add(a.refreshBuffer).Before(expiry)
+}
+
+// needsRefresh returns whether the secret is managed by the aggregator in the namespace, and has not been written
+// with a token that is still valid
+func (a *Aggregator) needsRefresh(namespace, name string) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if !a.allowed(namespace, name) {
+		return false
+	}
+	expiry, ok := a.secrets[namespace+"/"+name]
+	return !ok || a.expiresSoon(expiry)
+}
+
+// Ensure makes sure that each of the referenced secrets that is managed by the aggregator in the namespace exists,
+// and will not expire within the refresh buffer
+func (a *Aggregator) Ensure(ctx context.Context, namespace string, refs []apiv1.LocalObjectReference) error {
+	for _, ref := range refs {
+		if !a.needsRefresh(namespace, ref.Name) {
+			continue
+		}
+		_, err, _ := a.group.Do("secret/"+namespace+"/"+ref.Name, func() (interface{}, error) {
+			return nil, a.ensureSecret(ctx, namespace, ref.Name)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Refresh refreshes the secrets used by the pods, which are those that have not completed, if they expire within the
+// refresh buffer. The secrets that are no longer used are no longer refreshed.
+func (a *Aggregator) Refresh(ctx context.Context, pods []*apiv1.Pod) {
+	inUse := make(map[string]bool)
+	for _, pod := range pods {
+		for _, ref := range pod.Spec.ImagePullSecrets {
+			inUse[pod.Namespace+"/"+ref.Name] = true
+		}
+	}
+	for key := range inUse {
+		namespace, name, _ := cache.SplitMetaNamespaceKey(key)
+		if err := a.Ensure(ctx, namespace, []apiv1.LocalObjectReference{{Name: name}}); err != nil {
+			logging.RequireLoggerFromContext(ctx).WithError(err).WithFields(logging.Fields{"namespace": namespace, "name": name}).
+				Error(ctx, "Failed to refresh aggregated pull secret")
+		}
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	for key := range a.secrets {
+		if !inUse[key] {
+			delete(a.secrets, key)
+		}
+	}
+}
+
+func (a *Aggregator) setSecretExpiry(key string, expiry time.Time) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.secrets[key] = expiry
+}
+
+func (a *Aggregator) ensureSecret(ctx context.Context, namespace, name string) error {
+	key := namespace + "/" + name
+	secrets := a.kubeclientset.CoreV1().Secrets(namespace)
+	existing, err := secrets.Get(ctx, name, metav1.GetOptions{})
+	if err != nil && !apierr.IsNotFound(err) {
+		return fmt.Errorf("failed to get pull secret %q: %w", key, err)
+	}
+	found := err == nil
+	if found {
+		if existing.Labels[common.LabelKeyAggregatedPullSecret] != "true" {
+			return fmt.Errorf("pull secret %q already exists and is not managed by the controller", key)
+		}
+		// the secret may have been written before the controller restarted
+		if expiry, err := time.Parse(time.RFC3339, existing.Annotations[common.AnnotationKeyPullSecretExpiry]); err == nil {
+			a.lock.Lock()
+			valid := !a.expiresSoon(expiry)
+			a.lock.Unlock()
+			if valid {
+				a.setSecretExpiry(key, expiry)
+				return nil
+			}
+		}
+	}
+	t, err := a.token(ctx, name)
+	if err != nil {
+		return err
+	}
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      map[string]string{common.LabelKeyAggregatedPullSecret: "true"},
+			Annotations: map[string]string{common.AnnotationKeyPullSecretExpiry: t.expiry.UTC().Format(time.RFC3339)},
+		},
+		Type: apiv1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{apiv1.DockerConfigJsonKey: t.dockerConfig},
+	}
+	if found {
+		secret.ResourceVersion = existing.ResourceVersion
+		_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	} else {
+		_, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write pull secret %q: %w", key, err)
+	}
+	logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"namespace": namespace, "name": name, "expiry": t.expiry}).
+		Info(ctx, "Refreshed aggregated pull secret")
+	a.setSecretExpiry(key, t.expiry)
+	return nil
+}
+
+// token returns the latest token of the secret, getting a new one if it expires within the refresh buffer
+func (a *Aggregator) token(ctx context.Context, name string) (token, error) {
+	a.lock.Lock()
+	t, ok := a.tokens[name]
+	valid := ok && !a.expiresSoon(t.expiry)
+	sources := a.configs[name].sources
+	a.lock.Unlock()
+	if valid {
+		return t, nil
+	}
+	v, err, _ := a.group.Do("token/"+name, func() (interface{}, error) {
+		auths := make(map[string]registryAuth)
+		var expiry time.Time
+		for _, source := range sources {
+			sourceAuths, sourceExpiry, err := source.Token(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get token for pull secret %q: %w", name, err)
+			}
+			for registry, auth := range sourceAuths {
+				auths[registry] = auth
+			}
+			if expiry.IsZero() || sourceExpiry.Before(expiry) {
+				expiry = sourceExpiry
+			}
+		}
+		dockerConfig, err := json.Marshal(map[string]interface{}{"auths": auths})
+		if err != nil {
+			return nil, err
+		}
+		t := token{dockerConfig: dockerConfig, expiry: expiry}
+		a.lock.Lock()
+		defer a.lock.Unlock()
+		a.tokens[name] = t
+		return t, nil
+	})
+	if err != nil {
+		return token{}, err
+	}
+	return v.(token), nil
+}
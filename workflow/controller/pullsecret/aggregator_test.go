@@ -0,0 +1,197 @@
+package pullsecret
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// fakeECR serves GetAuthorizationToken, returning a new password, valid for an hour, for each request
+type fakeECR struct {
+	requests atomic.Int32
+	expiry   time.Time
+}
+
+func (f *fakeECR) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasSuffix(r.Header.Get("X-Amz-Target"), ".GetAuthorizationToken") {
+		http.Error(w, "unexpected target "+r.Header.Get("X-Amz-Target"), http.StatusBadRequest)
+		return
+	}
+	n := f.requests.Add(1)
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"authorizationData": []map[string]interface{}{{
+			"authorizationToken": base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("AWS:password-%d", n))),
+			"expiresAt":          f.expiry.Unix(),
+			"proxyEndpoint":      "https://123456789012.dkr.ecr.us-east-1.amazonaws.com",
+		}},
+	})
+}
+
+func newFakeECRAggregator(t *testing.T, objects ...*apiv1.Secret) (*Aggregator, *fakeECR, *fake.Clientset) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "my-access-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "my-secret-key")
+	t.Setenv("AWS_CONFIG_FILE", "/dev/null")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", "/dev/null")
+	ecr := &fakeECR{expiry: time.Now().Add(time.Hour).Truncate(time.Second)}
+	server := httptest.NewServer(ecr)
+	t.Cleanup(server.Close)
+	kube := fake.NewSimpleClientset()
+	for _, s := range objects {
+		_, err := kube.CoreV1().Secrets(s.Namespace).Create(t.Context(), s, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	a, err := New(logging.TestContext(t.Context()), kube, config.AggregatedPullSecrets{
+		RefreshBuffer: &metav1.Duration{Duration: 10 * time.Minute},
+		Secrets: []config.AggregatedPullSecret{{
+			Name:       "ecr",
+			Namespaces: []string{"my-ns", "other-ns"},
+			ECR:        &config.ECRPullSecret{Region: "us-east-1", Endpoint: server.URL},
+		}},
+	})
+	require.NoError(t, err)
+	return a, ecr, kube
+}
+
+func dockerConfigPassword(t *testing.T, secret *apiv1.Secret) string {
+	var dockerConfig struct {
+		Auths map[string]registryAuth `json:"auths"`
+	}
+	require.NoError(t, json.Unmarshal(secret.Data[apiv1.DockerConfigJsonKey], &dockerConfig))
+	auth := dockerConfig.Auths["123456789012.dkr.ecr.us-east-1.amazonaws.com"]
+	assert.Equal(t, "AWS", auth.Username)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("AWS:"+auth.Password)), auth.Auth)
+	return auth.Password
+}
+
+func TestAggregator(t *testing.T) {
+	refs := []apiv1.LocalObjectReference{{Name: "other"}, {Name: "ecr"}}
+
+	t.Run("Create", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		a, ecr, kube := newFakeECRAggregator(t)
+		require.NoError(t, a.Ensure(ctx, "my-ns", refs))
+		secret, err := kube.CoreV1().Secrets("my-ns").Get(ctx, "ecr", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, apiv1.SecretTypeDockerConfigJson, secret.Type)
+		assert.Equal(t, "true", secret.Labels[common.LabelKeyAggregatedPullSecret])
+		assert.Equal(t, ecr.expiry.UTC().Format(time.RFC3339), secret.Annotations[common.AnnotationKeyPullSecretExpiry])
+		assert.Equal(t, "password-1", dockerConfigPassword(t, secret))
+		// secrets that are not managed by the aggregator are left alone
+		_, err = kube.CoreV1().Secrets("my-ns").Get(ctx, "other", metav1.GetOptions{})
+		require.Error(t, err)
+
+		// the token is shared by namespaces
+		require.NoError(t, a.Ensure(ctx, "my-ns", refs))
+		require.NoError(t, a.Ensure(ctx, "other-ns", refs))
+		assert.Equal(t, int32(1), ecr.requests.Load())
+	})
+
+	t.Run("NamespaceNotAllowed", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		a, ecr, kube := newFakeECRAggregator(t)
+		require.NoError(t, a.Ensure(ctx, "tenant-ns", refs))
+		_, err := kube.CoreV1().Secrets("tenant-ns").Get(ctx, "ecr", metav1.GetOptions{})
+		require.Error(t, err)
+		assert.Equal(t, int32(0), ecr.requests.Load())
+	})
+
+	t.Run("Refresh", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		a, ecr, kube := newFakeECRAggregator(t)
+		require.NoError(t, a.Ensure(ctx, "my-ns", refs))
+		pods := []*apiv1.Pod{{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "my-ns"},
+			Spec:       apiv1.PodSpec{ImagePullSecrets: refs},
+		}}
+
+		// not yet within the refresh buffer
+		a.now = func() time.Time { return ecr.expiry.Add(-11 * time.Minute) }
+		a.Refresh(ctx, pods)
+		assert.Equal(t, int32(1), ecr.requests.Load())
+
+		// within the refresh buffer, so the secret is updated with a new token
+		a.now = func() time.Time { return ecr.expiry.Add(-9 * time.Minute) }
+		ecr.expiry = ecr.expiry.Add(time.Hour)
+		a.Refresh(ctx, pods)
+		assert.Equal(t, int32(2), ecr.requests.Load())
+		secret, err := kube.CoreV1().Secrets("my-ns").Get(ctx, "ecr", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "password-2", dockerConfigPassword(t, secret))
+		assert.Equal(t, ecr.expiry.UTC().Format(time.RFC3339), secret.Annotations[common.AnnotationKeyPullSecretExpiry])
+
+		// once no pod uses the secret, it is no longer refreshed
+		a.Refresh(ctx, nil)
+		assert.Empty(t, a.secrets)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		a, _, _ := newFakeECRAggregator(t)
+		require.NoError(t, a.Ensure(ctx, "my-ns", refs))
+		require.NoError(t, a.Ensure(ctx, "other-ns", refs))
+		err := a.Update(ctx, config.AggregatedPullSecrets{Secrets: []config.AggregatedPullSecret{{
+			Name:       "ecr",
+			Namespaces: []string{"my-ns"},
+			ECR:        &config.ECRPullSecret{Region: "us-east-1", Endpoint: "http://localhost"},
+		}}})
+		require.NoError(t, err)
+		// the secret that is still configured is kept
+		assert.Len(t, a.secrets, 1)
+		assert.Contains(t, a.secrets, "my-ns/ecr")
+	})
+
+	t.Run("ExistingManagedSecret", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		existing := &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "ecr",
+				Namespace:   "my-ns",
+				Labels:      map[string]string{common.LabelKeyAggregatedPullSecret: "true"},
+				Annotations: map[string]string{common.AnnotationKeyPullSecretExpiry: time.Now().Add(time.Hour).UTC().Format(time.RFC3339)},
+			},
+		}
+		a, ecr, _ := newFakeECRAggregator(t, existing)
+		// the secret written before the controller restarted is still valid
+		require.NoError(t, a.Ensure(ctx, "my-ns", refs))
+		assert.Equal(t, int32(0), ecr.requests.Load())
+	})
+
+	t.Run("ExistingUnmanagedSecret", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		a, _, _ := newFakeECRAggregator(t, &apiv1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ecr", Namespace: "my-ns"}})
+		err := a.Ensure(ctx, "my-ns", refs)
+		require.EqualError(t, err, `pull secret "my-ns/ecr" already exists and is not managed by the controller`)
+	})
+}
+
+func TestNew(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	_, err := New(ctx, fake.NewSimpleClientset(), config.AggregatedPullSecrets{Secrets: []config.AggregatedPullSecret{{Name: "empty"}}})
+	require.EqualError(t, err, `aggregated pull secret "empty" must have at least one of ecr, gcr or acr`)
+	_, err = New(ctx, fake.NewSimpleClientset(), config.AggregatedPullSecrets{Secrets: []config.AggregatedPullSecret{{Name: "ecr", ECR: &config.ECRPullSecret{}}}})
+	require.EqualError(t, err, `aggregated pull secret "ecr": ecr.region is required`)
+	_, err = New(ctx, fake.NewSimpleClientset(), config.AggregatedPullSecrets{Secrets: []config.AggregatedPullSecret{
+		{Name: "gcr", Namespaces: []string{"my-ns"}, GCR: &config.GCRPullSecret{Registries: []string{"gcr.io"}}},
+		{Name: "gcr", Namespaces: []string{"my-ns"}, GCR: &config.GCRPullSecret{Registries: []string{"gcr.io"}}},
+	}})
+	require.EqualError(t, err, `aggregated pull secret "gcr" is defined more than once`)
+	_, err = New(ctx, fake.NewSimpleClientset(), config.AggregatedPullSecrets{Secrets: []config.AggregatedPullSecret{{Name: "gcr", GCR: &config.GCRPullSecret{Registries: []string{"gcr.io"}}}}})
+	require.EqualError(t, err, `aggregated pull secret "gcr" must list the namespaces it may be created in`)
+}
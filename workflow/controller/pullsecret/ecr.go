@@ -0,0 +1,69 @@
+package pullsecret
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+type ecrAPI interface {
+	GetAuthorizationToken(ctx context.Context, params *ecr.GetAuthorizationTokenInput, optFns ...func(*ecr.Options)) (*ecr.GetAuthorizationTokenOutput, error)
+}
+
+// ecrTokenSource gets authorization tokens from ECR, which are valid for 12 hours
+type ecrTokenSource struct {
+	client      ecrAPI
+	registryIDs []string
+}
+
+func newECRTokenSource(ctx context.Context, c config.ECRPullSecret) (*ecrTokenSource, error) {
+	if c.Region == "" {
+		return nil, fmt.Errorf("ecr.region is required")
+	}
+	awsConfig, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(c.Region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+	client := ecr.NewFromConfig(awsConfig, func(o *ecr.Options) {
+		if c.Endpoint != "" {
+			o.BaseEndpoint = aws.String(c.Endpoint)
+		}
+	})
+	return &ecrTokenSource{client: client, registryIDs: c.RegistryIDs}, nil
+}
+
+func (s *ecrTokenSource) Token(ctx context.Context) (map[string]registryAuth, time.Time, error) {
+	out, err := s.client.GetAuthorizationToken(ctx, &ecr.GetAuthorizationTokenInput{RegistryIds: s.registryIDs})
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to get ECR authorization token: %w", err)
+	}
+	auths := make(map[string]registryAuth, len(out.AuthorizationData))
+	var expiry time.Time
+	for _, data := range out.AuthorizationData {
+		decoded, err := base64.StdEncoding.DecodeString(aws.ToString(data.AuthorizationToken))
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("failed to decode ECR authorization token: %w", err)
+		}
+		username, password, ok := strings.Cut(string(decoded), ":")
+		if !ok {
+			return nil, time.Time{}, fmt.Errorf("malformed ECR authorization token")
+		}
+		registry := strings.TrimPrefix(aws.ToString(data.ProxyEndpoint), "https://")
+		auths[registry] = newRegistryAuth(username, password)
+		if data.ExpiresAt != nil && (expiry.IsZero() || data.ExpiresAt.Before(expiry)) {
+			expiry = *data.ExpiresAt
+		}
+	}
+	if len(auths) == 0 {
+		return nil, time.Time{}, fmt.Errorf("ECR returned no authorization data")
+	}
+	return auths, expiry, nil
+}
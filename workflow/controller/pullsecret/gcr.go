@@ -0,0 +1,46 @@
+package pullsecret
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/oauth2/google"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+// gcrUsername is the username to use with a Google access token
+const gcrUsername = "oauth2accesstoken"
+
+// gcrScopes only allow reading from the registries, as the tokens are written to the namespaces of workflows: GCR
+// stores images in Cloud Storage, and Artifact Registry accepts the read-only Cloud Platform scope
+var gcrScopes = []string{
+	"https://www.googleapis.com/auth/devstorage.read_only",
+	"https://www.googleapis.com/auth/cloud-platform.read-only",
+}
+
+// gcrTokenSource gets access tokens using the application default credentials, which are valid for an hour
+type gcrTokenSource struct {
+	registries []string
+}
+
+func newGCRTokenSource(c config.GCRPullSecret) *gcrTokenSource {
+	return &gcrTokenSource{registries: c.Registries}
+}
+
+func (s *gcrTokenSource) Token(ctx context.Context) (map[string]registryAuth, time.Time, error) {
+	source, err := google.DefaultTokenSource(ctx, gcrScopes...)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to get Google credentials: %w", err)
+	}
+	t, err := source.Token()
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to get Google access token: %w", err)
+	}
+	auths := make(map[string]registryAuth, len(s.registries))
+	for _, registry := range s.registries {
+		auths[registry] = newRegistryAuth(gcrUsername, t.AccessToken)
+	}
+	return auths, t.Expiry, nil
+}
@@ -434,6 +434,7 @@ func (woc *wfOperationCtx) resolveReferences(ctx context.Context, stepGroup []wf
 	// Resolve a Step's References and add it to newStepGroup
 	resolveStepReferences := func(i int, step wfv1.WorkflowStep, newStepGroup []wfv1.WorkflowStep) error {
 		// Step 1: replace all parameter scope references in the step
+		step.Arguments = common.ResolveFromOutputs(step.Arguments, "steps")
 		stepBytes, err := json.Marshal(step)
 		if err != nil {
 			return errors.InternalWrapError(err)
@@ -486,6 +487,14 @@ func (woc *wfOperationCtx) resolveReferences(ctx context.Context, stepGroup []wf
 			artifacts = append(artifacts, *resolvedArt)
 		}
 		newStep.Arguments.Artifacts = artifacts
+
+		// Step 3: read the artifact to expand the step with
+		if newStep.WithParamArtifact != nil {
+			newStep.WithParam, err = woc.resolveWithParamArtifact(ctx, newStep.WithParamArtifact, scope)
+			if err != nil {
+				return err
+			}
+		}
 		newStepGroup[i] = newStep
 		return nil
 	}
@@ -553,7 +562,7 @@ func (woc *wfOperationCtx) expandStep(ctx context.Context, step wfv1.WorkflowSte
 	var items []wfv1.Item
 	if len(step.WithItems) > 0 {
 		items = step.WithItems
-	} else if step.WithParam != "" {
+	} else if step.WithParam != "" || step.WithParamArtifact != nil {
 		err = json.Unmarshal([]byte(step.WithParam), &items)
 		if err != nil {
 			mustExec, mustExecErr := shouldExecute(step.When)
@@ -578,6 +587,7 @@ func (woc *wfOperationCtx) expandStep(ctx context.Context, step wfv1.WorkflowSte
 	// very poor performance, so we just nil them out
 	step.WithItems = nil
 	step.WithParam = ""
+	step.WithParamArtifact = nil
 	step.WithSequence = nil
 
 	stepBytes, err := json.Marshal(step)
@@ -445,3 +445,27 @@ func TestOptionalArgumentUseSubPathInLoop(t *testing.T) {
 	woc.operate(ctx)
 	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
 }
+
+func TestResolveReferencesFromOutput(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	woc := newWoc(ctx)
+	scope := createScope(nil)
+	scope.addParamToScope("steps.generate.outputs.parameters.message", "hello")
+	stepGroup := []wfv1.WorkflowStep{{
+		Name:     "consume",
+		Template: "consume",
+		Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{{
+			Name:       "message",
+			FromOutput: &wfv1.OutputParameterRef{Step: "generate", Parameter: "message"},
+		}}},
+	}}
+
+	newStepGroup, err := woc.resolveReferences(ctx, stepGroup, scope)
+	require.NoError(t, err)
+	require.Len(t, newStepGroup, 1)
+	param := newStepGroup[0].Arguments.GetParameterByName("message")
+	require.NotNil(t, param)
+	assert.Equal(t, "hello", param.Value.String())
+	assert.Nil(t, param.FromOutput)
+	assert.NotNil(t, stepGroup[0].Arguments.Parameters[0].FromOutput, "the original step must not be modified")
+}
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"math"
 	"reflect"
 	"time"
 
@@ -134,4 +135,35 @@ func (woc *wfOperationCtx) taskResultReconciliation(ctx context.Context) {
 			woc.updated = true
 		}
 	}
+	woc.updateAverageArtifactCompressionRatio()
+}
+
+// updateAverageArtifactCompressionRatio sets status.averageArtifactCompressionRatio from the output artifacts of the nodes
+func (woc *wfOperationCtx) updateAverageArtifactCompressionRatio() {
+	var sum float64
+	var count int
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Outputs == nil {
+			continue
+		}
+		for _, art := range node.Outputs.Artifacts {
+			if art.CompressionRatio == nil {
+				continue
+			}
+			ratio, err := art.CompressionRatio.Float64()
+			if err != nil {
+				continue
+			}
+			sum += ratio
+			count++
+		}
+	}
+	if count == 0 {
+		return
+	}
+	average := wfv1.NewAmount(math.Round(sum/float64(count)*10000) / 10000)
+	if old := woc.wf.Status.AverageArtifactCompressionRatio; old == nil || old.Value != average.Value {
+		woc.wf.Status.AverageArtifactCompressionRatio = &average
+		woc.updated = true
+	}
 }
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// defaultTransientErrors are the failures that are always retried by retryStrategy.retryOn.transientErrors
+var defaultTransientErrors = config.TransientErrors{
+	// 137 is SIGKILL, which is how the kubelet stops an OOM killed container, and a container on a preempted node
+	ExitCodes: []int{137},
+	MessagePatterns: []string{
+		`OOMKilled`,
+		`(?i)evict`,
+		`(?i)preempt`,
+		`(?i)node shutdown`,
+		`The node was low on resource`,
+		`^pod deleted$`,
+	},
+}
+
+// transientErrorMatcher matches node failures against the known transient errors
+type transientErrorMatcher struct {
+	exitCodes []int
+	patterns  []*regexp.Regexp
+}
+
+var defaultTransientErrorMatcher, _ = newTransientErrorMatcher(nil)
+
+// isTransientFailure returns whether the node failed with one of the built-in or configured transient errors
+func (wfc *WorkflowController) isTransientFailure(node *wfv1.NodeStatus) bool {
+	if wfc.transientErrors == nil {
+		return defaultTransientErrorMatcher.matches(node)
+	}
+	return wfc.transientErrors.matches(node)
+}
+
+// newTransientErrorMatcher returns a matcher for the built-in transient errors and the extra ones, if any
+func newTransientErrorMatcher(extra *config.TransientErrors) (*transientErrorMatcher, error) {
+	m := &transientErrorMatcher{}
+	for _, errs := range []*config.TransientErrors{&defaultTransientErrors, extra} {
+		if errs == nil {
+			continue
+		}
+		m.exitCodes = append(m.exitCodes, errs.ExitCodes...)
+		for _, pattern := range errs.MessagePatterns {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid transient error message pattern %q: %w", pattern, err)
+			}
+			m.patterns = append(m.patterns, re)
+		}
+	}
+	return m, nil
+}
+
+// matches returns whether the node failed with a transient error
+func (m *transientErrorMatcher) matches(node *wfv1.NodeStatus) bool {
+	if node.Outputs != nil && node.Outputs.ExitCode != nil {
+		exitCode, err := strconv.Atoi(*node.Outputs.ExitCode)
+		if err == nil && slices.Contains(m.exitCodes, exitCode) {
+			return true
+		}
+	}
+	for _, re := range m.patterns {
+		if re.MatchString(node.Message) {
+			return true
+		}
+	}
+	return false
+}
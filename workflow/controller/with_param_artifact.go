@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+)
+
+// maxWithParamArtifactSize is the largest artifact that can be read for withParamArtifact, as its content is kept in
+// memory while the items are expanded
+const maxWithParamArtifactSize = 1024 * 1024
+
+// newArtifactDriver is a variable so that tests can replace the artifact drivers
+var newArtifactDriver artifact.NewDriverFunc = artifact.NewDriver
+
+// artifactResources looks up artifact repository credentials in the workflow's namespace
+type artifactResources struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+}
+
+func (r artifactResources) GetSecret(ctx context.Context, name, key string) (string, error) {
+	secret, err := r.kubeClient.CoreV1().Secrets(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[key]), nil
+}
+
+func (r artifactResources) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
+	configMap, err := r.kubeClient.CoreV1().ConfigMaps(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return configMap.Data[key], nil
+}
+
+// resolveWithParamArtifact reads the output artifact referenced by withParamArtifact, so that it can be expanded in
+// the same way as withParam
+func (woc *wfOperationCtx) resolveWithParamArtifact(ctx context.Context, ref *wfv1.WithParamArtifact, scope *wfScope) (string, error) {
+	art, err := scope.resolveArtifact(ctx, &wfv1.Artifact{From: "{{" + ref.Reference() + "}}"})
+	if err != nil {
+		return "", fmt.Errorf("withParamArtifact: unable to resolve %s: %w", ref.Reference(), err)
+	}
+	if err := art.Relocate(woc.artifactRepository.ToArtifactLocation()); err != nil {
+		return "", fmt.Errorf("withParamArtifact: %w", err)
+	}
+	driver, err := newArtifactDriver(ctx, art, artifactResources{woc.controller.kubeclientset, woc.wf.Namespace})
+	if err != nil {
+		return "", fmt.Errorf("withParamArtifact: failed to get driver for %s: %w", ref.Reference(), err)
+	}
+	stream, err := driver.OpenStream(ctx, art)
+	if err != nil {
+		return "", fmt.Errorf("withParamArtifact: failed to open %s: %w", ref.Reference(), err)
+	}
+	defer func() { _ = stream.Close() }()
+	data, err := readWithParamArtifact(stream)
+	if err != nil {
+		return "", fmt.Errorf("withParamArtifact: failed to read %s: %w", ref.Reference(), err)
+	}
+	return string(data), nil
+}
+
+// readWithParamArtifact returns the content of an artifact, which is the first file of the tarball if the artifact was
+// saved with the default tar archive strategy
+func readWithParamArtifact(r io.Reader) ([]byte, error) {
+	br := bufio.NewReader(r)
+	if magic, err := br.Peek(2); err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gzr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = gzr.Close() }()
+		tr := tar.NewReader(gzr)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				return nil, fmt.Errorf("the archive contains no file")
+			}
+			if err != nil {
+				return nil, err
+			}
+			if header.Typeflag == tar.TypeReg {
+				return readLimited(tr)
+			}
+		}
+	}
+	return readLimited(br)
+}
+
+func readLimited(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxWithParamArtifactSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxWithParamArtifactSize {
+		return nil, fmt.Errorf("the artifact is larger than %d bytes", maxWithParamArtifactSize)
+	}
+	return data, nil
+}
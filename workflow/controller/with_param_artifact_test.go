@@ -0,0 +1,219 @@
+package controller
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+var withParamArtifactSteps = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: with-param-artifact-steps
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: gen
+        template: gen
+    - - name: fan
+        template: print
+        arguments:
+          parameters:
+          - name: item
+            value: "{{item}}"
+        withParamArtifact:
+          step: gen
+          artifact: items
+  - name: gen
+    container:
+      image: alpine
+      command: [sh, -c]
+      args: ["echo '[1, 2]' > /tmp/items.json"]
+    outputs:
+      artifacts:
+      - name: items
+        path: /tmp/items.json
+  - name: print
+    inputs:
+      parameters:
+      - name: item
+    container:
+      image: alpine
+      command: [echo]
+      args: ["{{inputs.parameters.item}}"]
+`
+
+var withParamArtifactDAG = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: with-param-artifact-dag
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: gen
+        template: gen
+      - name: fan
+        depends: gen
+        template: print
+        arguments:
+          parameters:
+          - name: item
+            value: "{{item}}"
+        withParamArtifact:
+          task: gen
+          artifact: items
+  - name: gen
+    container:
+      image: alpine
+      command: [sh, -c]
+      args: ["echo '[1, 2]' > /tmp/items.json"]
+    outputs:
+      artifacts:
+      - name: items
+        path: /tmp/items.json
+  - name: print
+    inputs:
+      parameters:
+      - name: item
+    container:
+      image: alpine
+      command: [echo]
+      args: ["{{inputs.parameters.item}}"]
+`
+
+type withParamArtifactDriver struct {
+	artifactscommon.ArtifactDriver
+	data   []byte
+	opened *wfv1.Artifact
+}
+
+func (d *withParamArtifactDriver) OpenStream(_ context.Context, a *wfv1.Artifact) (io.ReadCloser, error) {
+	d.opened = a
+	return io.NopCloser(bytes.NewReader(d.data)), nil
+}
+
+func mustTarGz(t *testing.T, name string, data []byte) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(data)), Typeflag: tar.TypeReg}))
+	_, err := tw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+	return buf.Bytes()
+}
+
+// runWithParamArtifact runs the workflow until the generating pod has succeeded with the given output artifact, and
+// returns the arguments of the pods created by the fan-out
+func runWithParamArtifact(t *testing.T, manifest string, items wfv1.Artifact) (*wfOperationCtx, []string) {
+	t.Helper()
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(manifest)
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withExitCode(0), withOutputs(ctx, wfv1.Outputs{Artifacts: wfv1.Artifacts{items}}))
+	woc = newWorkflowOperationCtx(ctx, woc.wf, controller)
+	woc.operate(ctx)
+
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	var args []string
+	for _, pod := range pods.Items {
+		if strings.Contains(pod.Name, "print") {
+			args = append(args, pod.Spec.Containers[1].Args...)
+		}
+	}
+	return woc, args
+}
+
+func TestWithParamArtifact(t *testing.T) {
+	for name, manifest := range map[string]string{"Steps": withParamArtifactSteps, "DAG": withParamArtifactDAG} {
+		t.Run(name, func(t *testing.T) {
+			t.Run("Raw", func(t *testing.T) {
+				woc, args := runWithParamArtifact(t, manifest, wfv1.Artifact{
+					Name:             "items",
+					ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: `["a", "b", "c"]`}},
+				})
+				assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+				assert.ElementsMatch(t, []string{"a", "b", "c"}, args)
+			})
+			t.Run("S3", func(t *testing.T) {
+				defer func(f artifact.NewDriverFunc) { newArtifactDriver = f }(newArtifactDriver)
+				driver := &withParamArtifactDriver{data: mustTarGz(t, "items.json", []byte(`[{"x": 1}, {"x": 2}]`))}
+				newArtifactDriver = func(context.Context, *wfv1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+					return driver, nil
+				}
+
+				woc, args := runWithParamArtifact(t, manifest, wfv1.Artifact{
+					Name:             "items",
+					ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "gen/items.tgz"}},
+				})
+				assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+				assert.ElementsMatch(t, []string{`{"x":1}`, `{"x":2}`}, args)
+				// the key is relative to the artifact repository
+				require.NotNil(t, driver.opened)
+				require.NotNil(t, driver.opened.S3)
+				assert.Equal(t, "my-bucket", driver.opened.S3.Bucket)
+				assert.Equal(t, "gen/items.tgz", driver.opened.S3.Key)
+			})
+			t.Run("NotJSON", func(t *testing.T) {
+				woc, args := runWithParamArtifact(t, manifest, wfv1.Artifact{
+					Name:             "items",
+					ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "a b c"}},
+				})
+				assert.True(t, woc.wf.Status.Phase.Completed())
+				var messages []string
+				for _, node := range woc.wf.Status.Nodes {
+					messages = append(messages, node.Message)
+				}
+				assert.Contains(t, messages, "withParam value could not be parsed as a JSON list: a b c: invalid character 'a' looking for beginning of value")
+				assert.Empty(t, args)
+			})
+		})
+	}
+}
+
+func TestReadWithParamArtifact(t *testing.T) {
+	t.Run("Plain", func(t *testing.T) {
+		data, err := readWithParamArtifact(strings.NewReader(`["a"]`))
+		require.NoError(t, err)
+		assert.Equal(t, `["a"]`, string(data))
+	})
+	t.Run("TarGz", func(t *testing.T) {
+		data, err := readWithParamArtifact(bytes.NewReader(mustTarGz(t, "items.json", []byte(`["a"]`))))
+		require.NoError(t, err)
+		assert.Equal(t, `["a"]`, string(data))
+	})
+	t.Run("TooLarge", func(t *testing.T) {
+		_, err := readWithParamArtifact(strings.NewReader(strings.Repeat("a", maxWithParamArtifactSize+1)))
+		require.EqualError(t, err, "the artifact is larger than 1048576 bytes")
+	})
+}
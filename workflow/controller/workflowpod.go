@@ -5,8 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
+	"reflect"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
@@ -161,6 +165,13 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		mainCtrs[i] = c
 	}
 
+	if tmpl.AutoscaleResources {
+		recommendation := woc.getResourceRecommendation(ctx, tmpl.Name)
+		for i := range mainCtrs {
+			recommendation.apply(&mainCtrs[i])
+		}
+	}
+
 	var activeDeadlineSeconds *int64
 	wfDeadline := woc.getWorkflowDeadline()
 	tmplActiveDeadlineSeconds, err := intstr.Int64(tmpl.ActiveDeadlineSeconds)
@@ -170,7 +181,7 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	if wfDeadline == nil || opts.onExitPod { // ignore the workflow deadline for exit handler so they still run if the deadline has passed
 		activeDeadlineSeconds = tmplActiveDeadlineSeconds
 	} else {
-		wfActiveDeadlineSeconds := int64((*wfDeadline).Sub(time.Now().UTC()).Seconds())
+		wfActiveDeadlineSeconds := int64((*wfDeadline).Sub(nowFn().UTC()).Seconds())
 		if wfActiveDeadlineSeconds <= 0 {
 			return nil, nil
 		} else if tmpl.ActiveDeadlineSeconds == nil || wfActiveDeadlineSeconds < *tmplActiveDeadlineSeconds {
@@ -191,7 +202,7 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 
 	pod := &apiv1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      util.GeneratePodName(woc.wf.Name, nodeName, tmpl.Name, nodeID, util.GetWorkflowPodNameVersion(woc.wf)),
+			Name:      util.GenerateWorkflowPodName(woc.wf, nodeName, tmpl.Name, nodeID),
 			Namespace: woc.wf.Namespace,
 			Labels: map[string]string{
 				common.LabelKeyWorkflow:  woc.wf.Name, // Allows filtering by pods related to specific workflow
@@ -226,7 +237,7 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		pod.Spec.HostNetwork = *woc.execWf.Spec.HostNetwork
 	}
 
-	woc.addDNSConfig(pod)
+	woc.addDNSConfig(pod, tmpl)
 
 	if woc.controller.Config.InstanceID != "" {
 		pod.Labels[common.LabelKeyControllerInstanceID] = woc.controller.Config.InstanceID
@@ -239,6 +250,14 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		return nil, err
 	}
 
+	if woc.controller.pullSecretAggregator != nil {
+		// refresh any pull secrets managed by the controller, so that they are valid when the images are pulled
+		err = woc.controller.pullSecretAggregator.Ensure(ctx, pod.Namespace, pod.Spec.ImagePullSecrets)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if (tmpl.GetType() != wfv1.TemplateTypeResource && tmpl.GetType() != wfv1.TemplateTypeData) || (tmpl.GetType() == wfv1.TemplateTypeResource && tmpl.SaveLogsAsArtifact()) {
 		// we do not need the wait container for data templates because
 		// argoexec runs as the main container and will perform the job of
@@ -274,8 +293,14 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	pod.Spec.InitContainers = []apiv1.Container{initCtr}
 
 	woc.addSchedulingConstraints(ctx, pod, wfSpec, tmpl, nodeName)
+	addWorkflowScopedAntiAffinity(pod, wfSpec, woc.wf)
 	woc.addMetadata(pod, tmpl)
 
+	err = addResourceClaims(pod, wfSpec, tmpl)
+	if err != nil {
+		return nil, err
+	}
+
 	// Set initial progress from pod metadata if exists.
 	if x, ok := pod.Annotations[common.AnnotationKeyProgress]; ok {
 		if p, ok := wfv1.ParseProgress(x); ok {
@@ -293,6 +318,11 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		return nil, err
 	}
 
+	err = resolvePersistentVolumeClaimArtifacts(pod, tmpl)
+	if err != nil {
+		return nil, err
+	}
+
 	err = woc.addInputArtifactsVolumes(ctx, pod, tmpl)
 	if err != nil {
 		return nil, err
@@ -415,11 +445,16 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 				if c.Args == nil { // check nil rather than length, as zero-length is valid args
 					c.Args = x.Cmd
 				}
+			} else if woc.controller.Config.ValidateImageEntrypoints && tmpl.IsMainContainerName(c.Name) {
+				woc.checkImageEntrypoint(ctx, tmpl, c)
+			}
+			if len(tmpl.OverrideCommand) > 0 && tmpl.AllowOverrideCommand && tmpl.IsMainContainerName(c.Name) {
+				c.Command = append(slices.Clone(tmpl.OverrideCommand), c.Command...)
 			}
 			execCmd := append(append([]string{common.VarRunArgoPath + "/argoexec", "emissary"}, woc.getExecutorLogOpts(ctx)...), "--")
 			c.Command = append(execCmd, c.Command...)
 		}
-		if c.Image == woc.controller.executorImage() {
+		if c.Image == woc.executorImage(tmpl) {
 			// mount tmp dir to wait container
 			c.VolumeMounts = append(c.VolumeMounts, apiv1.VolumeMount{
 				Name:      volumeTmpDir.Name,
@@ -434,6 +469,12 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		pod.Spec.Containers[i] = c
 	}
 
+	if tmpl.GetType() == wfv1.TemplateTypeScript && !woc.execWf.Spec.Executor.ShouldIncludeScripts() {
+		if err := woc.offloadScript(ctx, pod, nodeName, nodeID); err != nil {
+			return nil, err
+		}
+	}
+
 	offloadEnvVarTemplate := false
 	for _, c := range pod.Spec.InitContainers {
 		for _, e := range c.Env {
@@ -448,33 +489,9 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 
 	if offloadEnvVarTemplate {
 		cmName := pod.Name
-		cm := &apiv1.ConfigMap{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      cmName,
-				Namespace: woc.wf.Namespace,
-				Labels: map[string]string{
-					common.LabelKeyWorkflow: woc.wf.Name,
-				},
-				Annotations: map[string]string{
-					common.AnnotationKeyNodeName: nodeName,
-					common.AnnotationKeyNodeID:   nodeID,
-				},
-				OwnerReferences: []metav1.OwnerReference{
-					*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
-				},
-			},
-			Data: map[string]string{
-				common.EnvVarTemplate: envVarTemplateValue,
-			},
-		}
-		created, err := woc.controller.kubeclientset.CoreV1().ConfigMaps(woc.wf.ObjectMeta.Namespace).Create(ctx, cm, metav1.CreateOptions{})
+		err := woc.createPodConfigMap(ctx, cmName, nodeName, nodeID, map[string]string{common.EnvVarTemplate: envVarTemplateValue})
 		if err != nil {
-			if !apierr.IsAlreadyExists(err) {
-				return nil, err
-			}
-			woc.log.WithField("name", cm.Name).Info(ctx, "Configmap already exists")
-		} else {
-			woc.log.WithField("name", created.Name).Info(ctx, "Created configmap")
+			return nil, err
 		}
 
 		volumeConfig := apiv1.Volume{
@@ -519,7 +536,8 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		return nil, err
 	}
 
-	if templateDeadline != nil && (pod.Spec.ActiveDeadlineSeconds == nil || time.Since(*templateDeadline).Seconds() < float64(*pod.Spec.ActiveDeadlineSeconds)) {
+	// The pod's deadline is the earliest of the template's timeout and the deadline already set from the workflow and template.
+	if templateDeadline != nil && (pod.Spec.ActiveDeadlineSeconds == nil || time.Until(*templateDeadline).Seconds() < float64(*pod.Spec.ActiveDeadlineSeconds)) {
 		newActiveDeadlineSeconds := int64(time.Until(*templateDeadline).Seconds())
 		if newActiveDeadlineSeconds <= 1 {
 			return nil, fmt.Errorf("%s exceeded its deadline", nodeName)
@@ -592,6 +610,77 @@ func (woc *wfOperationCtx) getDeadline(opts *createWorkflowPodOpts) *time.Time {
 	return &deadline
 }
 
+// createPodConfigMap creates a ConfigMap, owned by the workflow, holding data to mount into the pod of a node
+func (woc *wfOperationCtx) createPodConfigMap(ctx context.Context, name, nodeName, nodeID string, data map[string]string) error {
+	cm := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: woc.wf.Namespace,
+			Labels: map[string]string{
+				common.LabelKeyWorkflow: woc.wf.Name,
+			},
+			Annotations: map[string]string{
+				common.AnnotationKeyNodeName: nodeName,
+				common.AnnotationKeyNodeID:   nodeID,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+			},
+		},
+		Data: data,
+	}
+	created, err := woc.controller.kubeclientset.CoreV1().ConfigMaps(woc.wf.ObjectMeta.Namespace).Create(ctx, cm, metav1.CreateOptions{})
+	if err != nil {
+		if !apierr.IsAlreadyExists(err) {
+			return err
+		}
+		woc.log.WithField("name", cm.Name).Info(ctx, "Configmap already exists")
+	} else {
+		woc.log.WithField("name", created.Name).Info(ctx, "Created configmap")
+	}
+	return nil
+}
+
+// offloadScript removes the script source from the template passed to the init containers, and instead stores it in a
+// ConfigMap that is mounted into them, for workflows that do not include scripts in the template
+func (woc *wfOperationCtx) offloadScript(ctx context.Context, pod *apiv1.Pod, nodeName, nodeID string) error {
+	cmName := nodeID + "-script"
+	volume := apiv1.Volume{
+		Name: "argo-script",
+		VolumeSource: apiv1.VolumeSource{
+			ConfigMap: &apiv1.ConfigMapVolumeSource{
+				LocalObjectReference: apiv1.LocalObjectReference{
+					Name: cmName,
+				},
+			},
+		},
+	}
+	volumeMount := apiv1.VolumeMount{
+		Name:      volume.Name,
+		MountPath: common.ExecutorScriptConfigMapDir,
+	}
+	source := ""
+	for i, c := range pod.Spec.InitContainers {
+		for j, e := range c.Env {
+			if e.Name != common.EnvVarTemplate {
+				continue
+			}
+			var tmpl wfv1.Template
+			if err := json.Unmarshal([]byte(e.Value), &tmpl); err != nil {
+				return err
+			}
+			source = tmpl.Script.Source
+			tmpl.Script.Source = ""
+			e.Value = wfv1.MustMarshallJSON(tmpl)
+			c.Env[j] = e
+			c.VolumeMounts = append(c.VolumeMounts, volumeMount)
+		}
+		pod.Spec.InitContainers[i] = c
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, volume)
+	return woc.createPodConfigMap(ctx, cmName, nodeName, nodeID, map[string]string{common.ScriptConfigMapKey: source})
+}
+
 // substitutePodParams returns a pod spec with parameter references substituted as well as pod.name
 func substitutePodParams(ctx context.Context, pod *apiv1.Pod, globalParams common.Parameters, tmpl *wfv1.Template) (*apiv1.Pod, error) {
 	podParams := globalParams.DeepCopy()
@@ -627,6 +716,24 @@ func (woc *wfOperationCtx) newWaitContainer(ctx context.Context, tmpl *wfv1.Temp
 	return ctr
 }
 
+// checkImageEntrypoint emits a warning event if the command of the container is likely meant to be arguments to the
+// entrypoint of its image. The pod is created either way, as the command may be correct.
+func (woc *wfOperationCtx) checkImageEntrypoint(ctx context.Context, tmpl *wfv1.Template, c apiv1.Container) {
+	image, err := woc.controller.entrypoint.Lookup(ctx, c.Image, entrypoint.Options{
+		Namespace: woc.wf.Namespace, ServiceAccountName: woc.execWf.Spec.ServiceAccountName, ImagePullSecrets: woc.execWf.Spec.ImagePullSecrets,
+	})
+	if err != nil {
+		woc.log.WithError(err).WithField("image", c.Image).Warn(ctx, "Failed to look-up entrypoint of image to validate the command")
+		return
+	}
+	if image == nil {
+		return
+	}
+	if err := image.CheckCommand(c.Command); err != nil {
+		woc.eventRecorder.Event(woc.wf, apiv1.EventTypeWarning, "ImageEntrypointMismatch", fmt.Sprintf("template %s: %v", tmpl.Name, err))
+	}
+}
+
 func (woc *wfOperationCtx) getExecutorLogOpts(ctx context.Context) []string {
 	log := logging.RequireLoggerFromContext(ctx)
 	log.WithField("loglevel", string(log.Level())).Info(ctx, "getExecutorLogOpts")
@@ -695,10 +802,22 @@ func (woc *wfOperationCtx) createVolumes(tmpl *wfv1.Template) []apiv1.Volume {
 	return volumes
 }
 
+// executorImage returns the image of the executor containers of a pod, which the template and then the workflow can
+// override
+func (woc *wfOperationCtx) executorImage(tmpl *wfv1.Template) string {
+	if tmpl.Executor != nil && tmpl.Executor.Image != "" {
+		return tmpl.Executor.Image
+	}
+	if woc.execWf.Spec.Executor != nil && woc.execWf.Spec.Executor.Image != "" {
+		return woc.execWf.Spec.Executor.Image
+	}
+	return woc.controller.executorImage()
+}
+
 func (woc *wfOperationCtx) newExecContainer(name string, tmpl *wfv1.Template) *apiv1.Container {
 	exec := apiv1.Container{
 		Name:            name,
-		Image:           woc.controller.executorImage(),
+		Image:           woc.executorImage(tmpl),
 		ImagePullPolicy: woc.controller.executorImagePullPolicy(),
 		Env:             woc.createEnvVars(),
 		Resources:       woc.controller.Config.GetExecutor().Resources,
@@ -768,17 +887,135 @@ func (woc *wfOperationCtx) addMetadata(pod *apiv1.Pod, tmpl *wfv1.Template) {
 	}
 }
 
-// addDNSConfig applies DNSConfig to the pod
-func (woc *wfOperationCtx) addDNSConfig(pod *apiv1.Pod) {
-	if woc.execWf.Spec.DNSPolicy != nil {
+// addDNSConfig applies DNSPolicy and DNSConfig to the pod, either set in the template or the workflow
+func (woc *wfOperationCtx) addDNSConfig(pod *apiv1.Pod, tmpl *wfv1.Template) {
+	if tmpl.DNSPolicy != nil {
+		pod.Spec.DNSPolicy = *tmpl.DNSPolicy
+	} else if woc.execWf.Spec.DNSPolicy != nil {
 		pod.Spec.DNSPolicy = *woc.execWf.Spec.DNSPolicy
 	}
 
-	if woc.execWf.Spec.DNSConfig != nil {
+	if tmpl.DNSConfig != nil {
+		pod.Spec.DNSConfig = tmpl.DNSConfig
+	} else if woc.execWf.Spec.DNSConfig != nil {
 		pod.Spec.DNSConfig = woc.execWf.Spec.DNSConfig
 	}
 }
 
+// mergeAffinity returns the union of the template's and the workflow's affinity terms, template terms first.
+// Both the required and preferred terms of each sub-field are appended, skipping exact duplicates.
+func mergeAffinity(tmplAffinity, wfAffinity *apiv1.Affinity) *apiv1.Affinity {
+	if wfAffinity == nil {
+		return tmplAffinity
+	}
+	merged := tmplAffinity.DeepCopy()
+	wfAffinity = wfAffinity.DeepCopy()
+	if wf := wfAffinity.NodeAffinity; wf != nil {
+		if merged.NodeAffinity == nil {
+			merged.NodeAffinity = &apiv1.NodeAffinity{}
+		}
+		if wf.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+			if merged.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+				merged.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &apiv1.NodeSelector{}
+			}
+			required := merged.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+			required.NodeSelectorTerms = andNodeSelectorTerms(required.NodeSelectorTerms, wf.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+		}
+		merged.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = appendUnique(merged.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution, wf.PreferredDuringSchedulingIgnoredDuringExecution...)
+	}
+	if wf := wfAffinity.PodAffinity; wf != nil {
+		if merged.PodAffinity == nil {
+			merged.PodAffinity = &apiv1.PodAffinity{}
+		}
+		merged.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution = appendUnique(merged.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, wf.RequiredDuringSchedulingIgnoredDuringExecution...)
+		merged.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution = appendUnique(merged.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution, wf.PreferredDuringSchedulingIgnoredDuringExecution...)
+	}
+	if wf := wfAffinity.PodAntiAffinity; wf != nil {
+		if merged.PodAntiAffinity == nil {
+			merged.PodAntiAffinity = &apiv1.PodAntiAffinity{}
+		}
+		merged.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = appendUnique(merged.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, wf.RequiredDuringSchedulingIgnoredDuringExecution...)
+		merged.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = appendUnique(merged.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, wf.PreferredDuringSchedulingIgnoredDuringExecution...)
+	}
+	return merged
+}
+
+// andNodeSelectorTerms returns terms a node must match both of, as node selector terms are ORed together
+// but the requirements within a term are ANDed: each term of one is combined with each term of the other
+func andNodeSelectorTerms(terms, others []apiv1.NodeSelectorTerm) []apiv1.NodeSelectorTerm {
+	if len(terms) == 0 {
+		return others
+	}
+	if len(others) == 0 {
+		return terms
+	}
+	var anded []apiv1.NodeSelectorTerm
+	for _, term := range terms {
+		for _, other := range others {
+			anded = appendUnique(anded, apiv1.NodeSelectorTerm{
+				MatchExpressions: appendUnique(slices.Clone(term.MatchExpressions), other.MatchExpressions...),
+				MatchFields:      appendUnique(slices.Clone(term.MatchFields), other.MatchFields...),
+			})
+		}
+	}
+	return anded
+}
+
+func appendUnique[T any](terms []T, others ...T) []T {
+	for _, other := range others {
+		if !slices.ContainsFunc(terms, func(term T) bool { return reflect.DeepEqual(term, other) }) {
+			terms = append(terms, other)
+		}
+	}
+	return terms
+}
+
+// addWorkflowScopedAntiAffinity labels the pod with the workflow's uid and adds an anti-affinity rule
+// preventing it from running on the same node as any other pod of the workflow,
+// when spec.podAntiAffinity.workflowScoped is enabled
+func addWorkflowScopedAntiAffinity(pod *apiv1.Pod, wfSpec *wfv1.WorkflowSpec, wf *wfv1.Workflow) {
+	if !wfSpec.PodAntiAffinity.IsWorkflowScoped() {
+		return
+	}
+	pod.Labels[common.LabelKeyWorkflowUID] = string(wf.UID)
+	term := apiv1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{common.LabelKeyWorkflowUID: string(wf.UID)},
+		},
+		TopologyKey: apiv1.LabelHostname,
+	}
+	affinity := pod.Spec.Affinity.DeepCopy()
+	if affinity == nil {
+		affinity = &apiv1.Affinity{}
+	}
+	if affinity.PodAntiAffinity == nil {
+		affinity.PodAntiAffinity = &apiv1.PodAntiAffinity{}
+	}
+	affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = appendUnique(affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, term)
+	pod.Spec.Affinity = affinity
+}
+
+// mergeTopologySpreadConstraints returns the workflow's constraints followed by the template's, leaving out any
+// workflow constraint that the template overrides, as a pod cannot have two constraints with the same topologyKey and
+// whenUnsatisfiable
+func mergeTopologySpreadConstraints(tmplConstraints, wfConstraints []apiv1.TopologySpreadConstraint) []apiv1.TopologySpreadConstraint {
+	if len(wfConstraints) == 0 {
+		return tmplConstraints
+	}
+	overridden := func(c apiv1.TopologySpreadConstraint) bool {
+		return slices.ContainsFunc(tmplConstraints, func(t apiv1.TopologySpreadConstraint) bool {
+			return t.TopologyKey == c.TopologyKey && t.WhenUnsatisfiable == c.WhenUnsatisfiable
+		})
+	}
+	var constraints []apiv1.TopologySpreadConstraint
+	for _, c := range wfConstraints {
+		if !overridden(c) {
+			constraints = append(constraints, c)
+		}
+	}
+	return append(constraints, tmplConstraints...)
+}
+
 // addSchedulingConstraints applies any node selectors or affinity rules to the pod, either set in the workflow or the template
 func (woc *wfOperationCtx) addSchedulingConstraints(ctx context.Context, pod *apiv1.Pod, wfSpec *wfv1.WorkflowSpec, tmpl *wfv1.Template, nodeName string) {
 	// Get boundaryNode Template (if specified)
@@ -794,11 +1031,11 @@ func (woc *wfOperationCtx) addSchedulingConstraints(ctx context.Context, pod *ap
 	} else if len(wfSpec.NodeSelector) > 0 {
 		pod.Spec.NodeSelector = wfSpec.NodeSelector
 	}
-	// Set affinity (if specified)
+	// Set affinity (if specified), merging the template's with the workflow's
 	if tmpl.Affinity != nil {
-		pod.Spec.Affinity = tmpl.Affinity
+		pod.Spec.Affinity = mergeAffinity(tmpl.Affinity, wfSpec.Affinity)
 	} else if boundaryTemplate != nil && boundaryTemplate.Affinity != nil {
-		pod.Spec.Affinity = boundaryTemplate.Affinity
+		pod.Spec.Affinity = mergeAffinity(boundaryTemplate.Affinity, wfSpec.Affinity)
 	} else if wfSpec.Affinity != nil {
 		pod.Spec.Affinity = wfSpec.Affinity
 	}
@@ -811,6 +1048,9 @@ func (woc *wfOperationCtx) addSchedulingConstraints(ctx context.Context, pod *ap
 		pod.Spec.Tolerations = wfSpec.Tolerations
 	}
 
+	// Set topology spread constraints (if specified), combining the template's with the workflow's
+	pod.Spec.TopologySpreadConstraints = mergeTopologySpreadConstraints(tmpl.TopologySpreadConstraints, wfSpec.PodTopologySpreadConstraints)
+
 	// Set scheduler name (if specified)
 	if tmpl.SchedulerName != "" {
 		pod.Spec.SchedulerName = tmpl.SchedulerName
@@ -823,6 +1063,15 @@ func (woc *wfOperationCtx) addSchedulingConstraints(ctx context.Context, pod *ap
 	} else if wfSpec.PodPriorityClassName != "" {
 		pod.Spec.PriorityClassName = wfSpec.PodPriorityClassName
 	}
+	if woc.isDeadlinePriorityEscalated() {
+		pod.Spec.PriorityClassName = wfSpec.DeadlinePriorityClassName
+	}
+	// Set runtimeClassName (if specified)
+	if tmpl.RuntimeClassName != nil {
+		pod.Spec.RuntimeClassName = tmpl.RuntimeClassName
+	} else if wfSpec.RuntimeClassName != nil {
+		pod.Spec.RuntimeClassName = wfSpec.RuntimeClassName
+	}
 
 	// set hostaliases
 	pod.Spec.HostAliases = append(pod.Spec.HostAliases, wfSpec.HostAliases...)
@@ -836,6 +1085,27 @@ func (woc *wfOperationCtx) addSchedulingConstraints(ctx context.Context, pod *ap
 	}
 }
 
+// addResourceClaims adds the workflow's and the template's Dynamic Resource Allocation claims to the pod. A template
+// claim replaces a workflow claim of the same name.
+func addResourceClaims(pod *apiv1.Pod, wfSpec *wfv1.WorkflowSpec, tmpl *wfv1.Template) error {
+	if len(wfSpec.ResourceClaims) == 0 && len(tmpl.ResourceClaims) == 0 {
+		return nil
+	}
+	if os.Getenv("ARGO_DYNAMIC_RESOURCE_ALLOCATION") != "true" {
+		return errors.Errorf(errors.CodeBadRequest, "resourceClaims can only be used when ARGO_DYNAMIC_RESOURCE_ALLOCATION is set to true on the controller")
+	}
+	claims := make(map[string]int)
+	for _, claim := range append(append([]apiv1.PodResourceClaim{}, wfSpec.ResourceClaims...), tmpl.ResourceClaims...) {
+		if i, ok := claims[claim.Name]; ok {
+			pod.Spec.ResourceClaims[i] = claim
+			continue
+		}
+		claims[claim.Name] = len(pod.Spec.ResourceClaims)
+		pod.Spec.ResourceClaims = append(pod.Spec.ResourceClaims, claim)
+	}
+	return nil
+}
+
 // GetBoundaryTemplate get a template through the nodeName
 func (woc *wfOperationCtx) GetBoundaryTemplate(ctx context.Context, nodeName string) (*wfv1.Template, error) {
 	node, err := woc.wf.GetNodeByName(nodeName)
@@ -868,6 +1138,52 @@ func (woc *wfOperationCtx) GetTemplateByBoundaryID(ctx context.Context, boundary
 	return boundaryTmpl, templateStored, nil
 }
 
+// resolvePersistentVolumeClaimArtifacts sets the path of each output artifact saved from a persistent volume claim
+// to the path where the claim is mounted in the main container, so that the wait container saves it from the mirrored
+// volume mount without having to look up the volumes of its pod
+func resolvePersistentVolumeClaimArtifacts(pod *apiv1.Pod, tmpl *wfv1.Template) error {
+	for i, art := range tmpl.Outputs.Artifacts {
+		src := art.PersistentVolumeClaim
+		if src == nil {
+			continue
+		}
+		volumes := make(map[string]bool)
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == src.ClaimName {
+				volumes[volume.Name] = true
+			}
+		}
+		mountPath, ok := persistentVolumeClaimMountPath(tmpl.GetVolumeMounts(), volumes, src.SubPath)
+		if !ok {
+			return errors.Errorf(errors.CodeBadRequest, "artifact '%s' persistent volume claim '%s' is not mounted in the main container at subPath '%s'", art.Name, src.ClaimName, src.SubPath)
+		}
+		tmpl.Outputs.Artifacts[i].Path = mountPath
+		tmpl.Outputs.Artifacts[i].PersistentVolumeClaim = nil
+	}
+	return nil
+}
+
+// persistentVolumeClaimMountPath returns where the subPath of a claim is mounted by one of the mounts of its volumes
+func persistentVolumeClaimMountPath(mounts []apiv1.VolumeMount, volumes map[string]bool, subPath string) (string, bool) {
+	subPath = path.Clean("/" + subPath)
+	for _, mnt := range mounts {
+		if !volumes[mnt.Name] {
+			continue
+		}
+		// a mount with a subPath only contains that directory of the volume
+		mntSubPath := path.Clean("/" + mnt.SubPath)
+		switch {
+		case mntSubPath == "/":
+			return path.Join(mnt.MountPath, subPath), true
+		case subPath == mntSubPath:
+			return mnt.MountPath, true
+		case strings.HasPrefix(subPath, mntSubPath+"/"):
+			return path.Join(mnt.MountPath, strings.TrimPrefix(subPath, mntSubPath)), true
+		}
+	}
+	return "", false
+}
+
 // addVolumeReferences adds any volume mounts that a container/sidecar is referencing, to the pod.spec.volumes
 // These are either specified in the workflow.spec.volumes or the workflow.spec.volumeClaimTemplate section
 func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Template, pvcs []apiv1.Volume) error {
@@ -929,7 +1245,14 @@ func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Templat
 	}
 
 	for _, container := range tmpl.InitContainers {
-		err := addVolumeRef(container.VolumeMounts)
+		// mounts of input artifacts are not workflow volumes, see addInitContainers
+		var volMounts []apiv1.VolumeMount
+		for _, volMnt := range container.VolumeMounts {
+			if tmpl.Inputs.GetArtifactByName(volMnt.Name) == nil {
+				volMounts = append(volMounts, volMnt)
+			}
+		}
+		err := addVolumeRef(volMounts)
 		if err != nil {
 			return err
 		}
@@ -984,12 +1307,14 @@ func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Templat
 // the explicit volume mount and the artifact emptydir and prevent all uses of the emptydir for purposes of
 // loading data. The controller will omit mounting the emptydir to the artifact path, and the executor
 // will load the artifact in the in user's volume (as opposed to the emptydir)
+const inputArtifactsVolumeName = "input-artifacts"
+
 func (woc *wfOperationCtx) addInputArtifactsVolumes(ctx context.Context, pod *apiv1.Pod, tmpl *wfv1.Template) error {
 	if len(tmpl.Inputs.Artifacts) == 0 {
 		return nil
 	}
 	artVol := apiv1.Volume{
-		Name: "input-artifacts",
+		Name: inputArtifactsVolumeName,
 		VolumeSource: apiv1.VolumeSource{
 			EmptyDir: &apiv1.EmptyDirVolumeSource{},
 		},
@@ -1093,7 +1418,8 @@ func addOutputArtifactsVolumes(ctx context.Context, pod *apiv1.Pod, tmpl *wfv1.T
 // in the template.
 func (woc *wfOperationCtx) addArchiveLocation(ctx context.Context, tmpl *wfv1.Template) {
 	if tmpl.ArchiveLocation.HasLocation() {
-		// User explicitly set the location. nothing else to do.
+		// User explicitly set the location. nothing else to do but apply any key prefix.
+		addArchiveKeyPrefix(ctx, tmpl)
 		return
 	}
 	archiveLogs := woc.IsArchiveLogs(tmpl)
@@ -1107,8 +1433,25 @@ func (woc *wfOperationCtx) addArchiveLocation(ctx context.Context, tmpl *wfv1.Te
 	if !needLocation {
 		return
 	}
+	var keyPrefix string
+	if tmpl.ArchiveLocation != nil {
+		keyPrefix = tmpl.ArchiveLocation.KeyPrefix
+	}
 	tmpl.ArchiveLocation = woc.artifactRepository.ToArtifactLocation()
 	tmpl.ArchiveLocation.ArchiveLogs = &archiveLogs
+	tmpl.ArchiveLocation.KeyPrefix = keyPrefix
+	addArchiveKeyPrefix(ctx, tmpl)
+}
+
+// addArchiveKeyPrefix replaces the key of the template's archive location with "<keyPrefix>/<templateName>",
+// so that its artifacts are stored as "<keyPrefix>/<templateName>/<artifactName>"
+func addArchiveKeyPrefix(ctx context.Context, tmpl *wfv1.Template) {
+	if tmpl.ArchiveLocation == nil || tmpl.ArchiveLocation.KeyPrefix == "" {
+		return
+	}
+	if err := tmpl.ArchiveLocation.SetKey(path.Join(tmpl.ArchiveLocation.KeyPrefix, tmpl.Name)); err != nil {
+		logging.RequireLoggerFromContext(ctx).WithField("template", tmpl.Name).WithError(err).Warn(ctx, "failed to apply archiveLocation.keyPrefix")
+	}
 }
 
 // IsArchiveLogs determines if container should archive logs
@@ -1212,10 +1555,12 @@ func addScriptStagingVolume(pod *apiv1.Pod) {
 
 // addInitContainers adds all init containers to the pod spec of the step
 // Optionally volume mounts from the main container to the init containers
+// A volume mount named after an input artifact mounts that artifact into the init container
 func addInitContainers(ctx context.Context, pod *apiv1.Pod, tmpl *wfv1.Template) {
 	mainCtr := findMainContainer(pod)
 	for _, ctr := range tmpl.InitContainers {
 		logging.RequireLoggerFromContext(ctx).WithField("name", ctr.Name).Debug(ctx, "Adding init container")
+		ctr.VolumeMounts = inputArtifactVolumeMounts(tmpl, ctr.VolumeMounts)
 		if mainCtr != nil && ctr.MirrorVolumeMounts != nil && *ctr.MirrorVolumeMounts {
 			mirrorVolumeMounts(ctx, mainCtr, &ctr.Container)
 		}
@@ -1223,6 +1568,27 @@ func addInitContainers(ctx context.Context, pod *apiv1.Pod, tmpl *wfv1.Template)
 	}
 }
 
+// inputArtifactVolumeMounts replaces the volume mounts named after input artifacts with mounts of the volume the
+// init container loaded the artifact into.
+func inputArtifactVolumeMounts(tmpl *wfv1.Template, volMounts []apiv1.VolumeMount) []apiv1.VolumeMount {
+	var mounts []apiv1.VolumeMount
+	for _, volMnt := range volMounts {
+		art := tmpl.Inputs.GetArtifactByName(volMnt.Name)
+		if art == nil {
+			mounts = append(mounts, volMnt)
+			continue
+		}
+		mnt := apiv1.VolumeMount{Name: inputArtifactsVolumeName, MountPath: volMnt.MountPath, SubPath: art.Name, ReadOnly: volMnt.ReadOnly}
+		if overlap := common.FindOverlappingVolume(tmpl, art.Path); overlap != nil {
+			// the artifact was loaded into the overlapping volume rather than the artifacts volume
+			mnt.Name = overlap.Name
+			mnt.SubPath = strings.Trim(path.Join(overlap.SubPath, strings.TrimPrefix(art.Path, strings.TrimRight(overlap.MountPath, "/"))), "/")
+		}
+		mounts = append(mounts, mnt)
+	}
+	return mounts
+}
+
 // addSidecars adds all sidecars to the pod spec of the step.
 // Optionally volume mounts from the main container to the sidecar
 func addSidecars(ctx context.Context, pod *apiv1.Pod, tmpl *wfv1.Template) {
@@ -1332,7 +1698,7 @@ func createSecretVolumesFromArtifactLocations(volMap map[string]apiv1.Volume, ar
 		} else if artifactLocation.OSS != nil {
 			createSecretVal(volMap, artifactLocation.OSS.AccessKeySecret, keyMap)
 			createSecretVal(volMap, artifactLocation.OSS.SecretKeySecret, keyMap)
-		} else if artifactLocation.GCS != nil {
+		} else if artifactLocation.GCS != nil && !artifactLocation.GCS.UseWorkloadIdentity {
 			createSecretVal(volMap, artifactLocation.GCS.ServiceAccountKeySecret, keyMap)
 		} else if artifactLocation.HTTP != nil && artifactLocation.HTTP.Auth != nil {
 			createSecretVal(volMap, artifactLocation.HTTP.Auth.BasicAuth.UsernameSecret, keyMap)
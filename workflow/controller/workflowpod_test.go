@@ -92,6 +92,52 @@ func TestScriptTemplateWithVolume(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestScriptTemplateIncludeScripts(t *testing.T) {
+	templateSource := func(t *testing.T, pod *apiv1.Pod) string {
+		t.Helper()
+		for _, e := range pod.Spec.InitContainers[0].Env {
+			if e.Name == common.EnvVarTemplate {
+				tmpl := &wfv1.Template{}
+				require.NoError(t, json.Unmarshal([]byte(e.Value), tmpl))
+				return tmpl.Script.Source
+			}
+		}
+		t.Fatal("template env var not found")
+		return ""
+	}
+	t.Run("Default", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		tmpl := unmarshalTemplate(scriptTemplateWithInputArtifact)
+		woc := newWoc(ctx)
+		pod, err := woc.createWorkflowPod(ctx, tmpl.Name, []apiv1.Container{tmpl.Script.Container}, tmpl, &createWorkflowPodOpts{})
+		require.NoError(t, err)
+		assert.Equal(t, "ls /bin/kubectl\n", templateSource(t, pod))
+		cms, err := woc.controller.kubeclientset.CoreV1().ConfigMaps(woc.wf.Namespace).List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, cms.Items)
+	})
+	t.Run("Excluded", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		tmpl := unmarshalTemplate(scriptTemplateWithInputArtifact)
+		woc := newWoc(ctx)
+		woc.execWf.Spec.Executor = &wfv1.ExecutorConfig{IncludeScripts: ptr.To(false)}
+		pod, err := woc.createWorkflowPod(ctx, tmpl.Name, []apiv1.Container{tmpl.Script.Container}, tmpl, &createWorkflowPodOpts{})
+		require.NoError(t, err)
+		assert.Empty(t, templateSource(t, pod))
+		assert.Contains(t, pod.Spec.InitContainers[0].VolumeMounts, apiv1.VolumeMount{Name: "argo-script", MountPath: common.ExecutorScriptConfigMapDir})
+		cmName := woc.wf.NodeID(tmpl.Name) + "-script"
+		assert.Contains(t, pod.Spec.Volumes, apiv1.Volume{
+			Name: "argo-script",
+			VolumeSource: apiv1.VolumeSource{
+				ConfigMap: &apiv1.ConfigMapVolumeSource{LocalObjectReference: apiv1.LocalObjectReference{Name: cmName}},
+			},
+		})
+		cm, err := woc.controller.kubeclientset.CoreV1().ConfigMaps(woc.wf.Namespace).Get(ctx, cmName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "ls /bin/kubectl\n", cm.Data[common.ScriptConfigMapKey])
+	})
+}
+
 var scriptTemplateWithOptionalInputArtifactProvided = `
 name: script-with-input-artifact
 inputs:
@@ -222,6 +268,53 @@ func TestTmplServiceAccount(t *testing.T) {
 	assert.Equal(t, "tmpl", pod.Spec.ServiceAccountName)
 }
 
+var wfWithTmplServiceAccounts = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: tmpl-service-accounts
+spec:
+  entrypoint: main
+  serviceAccountName: wf-sa
+  templates:
+  - name: main
+    steps:
+    - - name: trusted
+        template: trusted
+      - name: untrusted
+        template: untrusted
+  - name: trusted
+    serviceAccountName: trusted-sa
+    container:
+      image: docker/whalesay:latest
+  - name: untrusted
+    container:
+      image: docker/whalesay:latest
+`
+
+// TestTmplServiceAccountPerTemplate verifies that templates within a single workflow produce pods with their own
+// service account, falling back to workflow.spec.serviceAccountName.
+func TestTmplServiceAccountPerTemplate(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(wfWithTmplServiceAccounts)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 2)
+	serviceAccounts := map[string]string{}
+	for _, pod := range pods.Items {
+		serviceAccounts[pod.Annotations[common.AnnotationKeyNodeName]] = pod.Spec.ServiceAccountName
+	}
+	assert.Equal(t, map[string]string{
+		"tmpl-service-accounts[0].trusted":   "trusted-sa",
+		"tmpl-service-accounts[0].untrusted": "wf-sa",
+	}, serviceAccounts)
+}
+
 // TestWFLevelAutomountServiceAccountToken verifies the ability to carry forward workflow level AutomountServiceAccountToken to Podspec.
 func TestWFLevelAutomountServiceAccountToken(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
@@ -415,6 +508,36 @@ func TestAffinity(t *testing.T) {
 	assert.NotNil(t, pod.Spec.Affinity)
 }
 
+// TestWorkflowScopedAntiAffinity verifies pods of a workflow are kept on different nodes with podAntiAffinity.workflowScoped
+func TestWorkflowScopedAntiAffinity(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	woc := newWoc(ctx)
+	woc.wf.UID = "my-uid"
+	woc.execWf.Spec.PodAntiAffinity = &wfv1.WorkflowScopedAntiAffinity{WorkflowScoped: true}
+	hostTerm := apiv1.PodAffinityTerm{TopologyKey: "kubernetes.io/hostname", LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}}
+	woc.execWf.Spec.Affinity = &apiv1.Affinity{
+		PodAntiAffinity: &apiv1.PodAntiAffinity{RequiredDuringSchedulingIgnoredDuringExecution: []apiv1.PodAffinityTerm{hostTerm}},
+	}
+	tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+	require.NoError(t, err)
+
+	_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+	require.NoError(t, err)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	pod := pods.Items[0]
+	assert.Equal(t, "my-uid", pod.Labels[common.LabelKeyWorkflowUID])
+	require.NotNil(t, pod.Spec.Affinity)
+	require.NotNil(t, pod.Spec.Affinity.PodAntiAffinity)
+	assert.Equal(t, []apiv1.PodAffinityTerm{
+		hostTerm,
+		{TopologyKey: "kubernetes.io/hostname", LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{common.LabelKeyWorkflowUID: "my-uid"}}},
+	}, pod.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+	// the workflow's affinity is not modified
+	assert.Len(t, woc.execWf.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, 1)
+}
+
 // TestTolerations verifies the ability to carry forward tolerations.
 func TestTolerations(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
@@ -437,6 +560,39 @@ func TestTolerations(t *testing.T) {
 	assert.Equal(t, "nvidia.com/gpu", pod.Spec.Tolerations[0].Key)
 }
 
+// TestTopologySpreadConstraints verifies the workflow's and the template's constraints are combined
+func TestTopologySpreadConstraints(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	woc := newWoc(ctx)
+	zone := apiv1.TopologySpreadConstraint{MaxSkew: 1, TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: apiv1.ScheduleAnyway}
+	host := apiv1.TopologySpreadConstraint{MaxSkew: 1, TopologyKey: "kubernetes.io/hostname", WhenUnsatisfiable: apiv1.DoNotSchedule}
+	woc.execWf.Spec.PodTopologySpreadConstraints = []apiv1.TopologySpreadConstraint{zone, host}
+	tmplHost := apiv1.TopologySpreadConstraint{MaxSkew: 2, TopologyKey: "kubernetes.io/hostname", WhenUnsatisfiable: apiv1.DoNotSchedule}
+	woc.execWf.Spec.Templates[0].TopologySpreadConstraints = []apiv1.TopologySpreadConstraint{tmplHost}
+	tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+	require.NoError(t, err)
+
+	_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+	require.NoError(t, err)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	pod := pods.Items[0]
+	// the template's constraint for the same topology key replaces the workflow's
+	assert.Equal(t, []apiv1.TopologySpreadConstraint{zone, tmplHost}, pod.Spec.TopologySpreadConstraints)
+}
+
+func TestMergeTopologySpreadConstraints(t *testing.T) {
+	zone := apiv1.TopologySpreadConstraint{TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: apiv1.ScheduleAnyway}
+	zoneRequired := apiv1.TopologySpreadConstraint{TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: apiv1.DoNotSchedule}
+	host := apiv1.TopologySpreadConstraint{TopologyKey: "kubernetes.io/hostname", WhenUnsatisfiable: apiv1.DoNotSchedule}
+	assert.Nil(t, mergeTopologySpreadConstraints(nil, nil))
+	assert.Equal(t, []apiv1.TopologySpreadConstraint{host}, mergeTopologySpreadConstraints([]apiv1.TopologySpreadConstraint{host}, nil))
+	assert.Equal(t, []apiv1.TopologySpreadConstraint{zone}, mergeTopologySpreadConstraints(nil, []apiv1.TopologySpreadConstraint{zone}))
+	// constraints with different whenUnsatisfiable are both kept
+	assert.Equal(t, []apiv1.TopologySpreadConstraint{zone, zoneRequired, host}, mergeTopologySpreadConstraints([]apiv1.TopologySpreadConstraint{zoneRequired, host}, []apiv1.TopologySpreadConstraint{zone}))
+}
+
 // TestMetadata verifies ability to carry forward annotations and labels
 func TestMetadata(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
@@ -554,6 +710,40 @@ func TestConditionalArchiveLocation(t *testing.T) {
 	assert.Nil(t, tmpl.ArchiveLocation)
 }
 
+// TestArchiveLocationKeyPrefix verifies a template's archiveLocation.keyPrefix replaces the artifact repository's key
+func TestArchiveLocationKeyPrefix(t *testing.T) {
+	for name, archiveLocation := range map[string]*wfv1.ArtifactLocation{
+		"DefaultRepository": {KeyPrefix: "team-a/artifacts"},
+		"ExplicitLocation": {
+			KeyPrefix: "team-a/artifacts",
+			S3:        &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "bar"}, Key: "ignored"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+			wf.Spec.Templates[0].ArchiveLocation = archiveLocation
+			wf.Spec.Templates[0].Outputs = wfv1.Outputs{Artifacts: []wfv1.Artifact{{Name: "foo", Path: "/tmp/file"}}}
+			ctx := logging.TestContext(t.Context())
+			cancel, controller := newController(ctx, wf)
+			defer cancel()
+			woc := newWorkflowOperationCtx(ctx, wf, controller)
+			setArtifactRepository(woc.controller, &wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{
+				S3Bucket:  wfv1.S3Bucket{Bucket: "foo"},
+				KeyFormat: "path/in/bucket",
+			}})
+			woc.operate(ctx)
+			pods, err := listPods(ctx, woc)
+			require.NoError(t, err)
+			require.Len(t, pods.Items, 1)
+			tmpl, err := getPodTemplate(&pods.Items[0])
+			require.NoError(t, err)
+			require.NotNil(t, tmpl.ArchiveLocation)
+			require.NotNil(t, tmpl.ArchiveLocation.S3)
+			assert.Equal(t, "team-a/artifacts/whalesay", tmpl.ArchiveLocation.S3.Key)
+		})
+	}
+}
+
 // TestConditionalAddArchiveLocationTemplateArchiveLogs verifies we do  add archive location if it is needed for logs
 func TestConditionalAddArchiveLocationTemplateArchiveLogs(t *testing.T) {
 	tests := []struct {
@@ -703,6 +893,44 @@ func Test_createWorkflowPod_emissary(t *testing.T) {
 		cmd := append(append(emissaryCmd, woc.getExecutorLogOpts(ctx)...), "--", "bar")
 		assert.Equal(t, cmd, pod.Spec.Containers[1].Command)
 	})
+	t.Run("OverrideCommand", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		tmpl := &wfv1.Template{
+			OverrideCommand:      []string{"strace", "-f"},
+			AllowOverrideCommand: true,
+			Sidecars:             []wfv1.UserContainer{{Container: apiv1.Container{Name: "sidecar", Command: []string{"bar"}}}},
+		}
+		pod, err := woc.createWorkflowPod(ctx, "", []apiv1.Container{{Name: common.MainContainerName, Command: []string{"foo"}}}, tmpl, &createWorkflowPodOpts{})
+		require.NoError(t, err)
+		commands := map[string][]string{}
+		for _, c := range pod.Spec.Containers {
+			commands[c.Name] = c.Command
+		}
+		execCmd := append(emissaryCmd, woc.getExecutorLogOpts(ctx)...)
+		assert.Equal(t, append(append([]string{}, execCmd...), "--", "strace", "-f", "foo"), commands[common.MainContainerName])
+		// sidecars are not wrapped
+		assert.Equal(t, append(append([]string{}, execCmd...), "--", "bar"), commands["sidecar"])
+	})
+	t.Run("OverrideCommandWithImageIndex", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		tmpl := &wfv1.Template{OverrideCommand: []string{"strace"}, AllowOverrideCommand: true}
+		pod, err := woc.createWorkflowPod(ctx, "", []apiv1.Container{{Name: common.MainContainerName, Image: "my-image"}}, tmpl, &createWorkflowPodOpts{})
+		require.NoError(t, err)
+		cmd := append(append(emissaryCmd, woc.getExecutorLogOpts(ctx)...), "--", "strace", "my-entrypoint")
+		assert.Equal(t, cmd, pod.Spec.Containers[1].Command)
+		assert.Equal(t, []string{"my-cmd"}, pod.Spec.Containers[1].Args)
+	})
+	t.Run("OverrideCommandNotAllowed", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		tmpl := &wfv1.Template{OverrideCommand: []string{"strace"}}
+		pod, err := woc.createWorkflowPod(ctx, "", []apiv1.Container{{Name: common.MainContainerName, Command: []string{"foo"}}}, tmpl, &createWorkflowPodOpts{})
+		require.NoError(t, err)
+		cmd := append(append(emissaryCmd, woc.getExecutorLogOpts(ctx)...), "--", "foo")
+		assert.Equal(t, cmd, pod.Spec.Containers[1].Command)
+	})
 }
 
 // TestVolumeAndVolumeMounts verifies the ability to carry forward volumes and volumeMounts from workflow.spec
@@ -890,6 +1118,40 @@ func TestPriorityClass(t *testing.T) {
 	assert.Equal(t, "foo", pod.Spec.PriorityClassName)
 }
 
+// TestDeadlinePriorityClass verifies that pods created near the workflow's deadline use deadlinePriorityClassName
+func TestDeadlinePriorityClass(t *testing.T) {
+	defer func() { nowFn = time.Now }()
+	startedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		name    string
+		elapsed time.Duration
+		want    string
+	}{
+		{"BeforeThreshold", 7 * time.Minute, "foo"},
+		{"AfterThreshold", 9 * time.Minute, "critical"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := logging.TestContext(t.Context())
+			nowFn = func() time.Time { return startedAt.Add(tt.elapsed) }
+			woc := newWoc(ctx)
+			woc.execWf.Spec.ActiveDeadlineSeconds = ptr.To(int64(600))
+			woc.execWf.Spec.PodPriorityClassName = "foo"
+			woc.execWf.Spec.DeadlinePriorityClassName = "critical"
+			woc.execWf.Spec.DeadlinePriorityEscalationThreshold = "2m"
+			woc.wf.Status.StartedAt = metav1.NewTime(startedAt)
+			woc.workflowDeadline = woc.getWorkflowDeadline()
+			tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+			require.NoError(t, err)
+			_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+			require.NoError(t, err)
+			pods, err := listPods(ctx, woc)
+			require.NoError(t, err)
+			require.Len(t, pods.Items, 1)
+			assert.Equal(t, tt.want, pods.Items[0].Spec.PriorityClassName)
+		})
+	}
+}
+
 // TestSchedulerName verifies the ability to carry forward schedulerName.
 func TestSchedulerName(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
@@ -906,6 +1168,182 @@ func TestSchedulerName(t *testing.T) {
 	assert.Equal(t, "foo", pod.Spec.SchedulerName)
 }
 
+func Test_mergeAffinity(t *testing.T) {
+	zoneTerm := func(zone string) apiv1.NodeSelectorTerm {
+		return apiv1.NodeSelectorTerm{MatchExpressions: []apiv1.NodeSelectorRequirement{{Key: "zone", Operator: apiv1.NodeSelectorOpIn, Values: []string{zone}}}}
+	}
+	nodeAffinity := func(terms ...apiv1.NodeSelectorTerm) *apiv1.Affinity {
+		return &apiv1.Affinity{NodeAffinity: &apiv1.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: &apiv1.NodeSelector{NodeSelectorTerms: terms}}}
+	}
+	t.Run("TemplateOnly", func(t *testing.T) {
+		assert.Equal(t, nodeAffinity(zoneTerm("a")), mergeAffinity(nodeAffinity(zoneTerm("a")), nil))
+	})
+	t.Run("Conflicting", func(t *testing.T) {
+		tmplAffinity := nodeAffinity(zoneTerm("a"))
+		wfAffinity := nodeAffinity(zoneTerm("b"))
+		merged := mergeAffinity(tmplAffinity, wfAffinity)
+		// a node must be in both zones, which none is, rather than in either
+		assert.Equal(t, nodeAffinity(apiv1.NodeSelectorTerm{MatchExpressions: []apiv1.NodeSelectorRequirement{
+			zoneTerm("a").MatchExpressions[0],
+			zoneTerm("b").MatchExpressions[0],
+		}}), merged)
+		// the inputs are not modified
+		assert.Equal(t, nodeAffinity(zoneTerm("a")), tmplAffinity)
+		assert.Equal(t, nodeAffinity(zoneTerm("b")), wfAffinity)
+	})
+	t.Run("Duplicate", func(t *testing.T) {
+		merged := mergeAffinity(nodeAffinity(zoneTerm("a")), nodeAffinity(zoneTerm("a")))
+		assert.Equal(t, nodeAffinity(zoneTerm("a")), merged)
+	})
+	t.Run("CrossProduct", func(t *testing.T) {
+		gpuTerm := func(gpu string) apiv1.NodeSelectorTerm {
+			return apiv1.NodeSelectorTerm{MatchExpressions: []apiv1.NodeSelectorRequirement{{Key: "gpu", Operator: apiv1.NodeSelectorOpIn, Values: []string{gpu}}}}
+		}
+		and := func(a, b apiv1.NodeSelectorTerm) apiv1.NodeSelectorTerm {
+			return apiv1.NodeSelectorTerm{MatchExpressions: append(a.MatchExpressions, b.MatchExpressions...)}
+		}
+		// (zone a or zone b) and (gpu x or gpu y)
+		merged := mergeAffinity(nodeAffinity(zoneTerm("a"), zoneTerm("b")), nodeAffinity(gpuTerm("x"), gpuTerm("y")))
+		assert.Equal(t, nodeAffinity(
+			and(zoneTerm("a"), gpuTerm("x")),
+			and(zoneTerm("a"), gpuTerm("y")),
+			and(zoneTerm("b"), gpuTerm("x")),
+			and(zoneTerm("b"), gpuTerm("y")),
+		), merged)
+	})
+	t.Run("DisjointSubFields", func(t *testing.T) {
+		preferred := apiv1.PreferredSchedulingTerm{Weight: 10, Preference: zoneTerm("c")}
+		antiAffinity := apiv1.PodAffinityTerm{TopologyKey: "kubernetes.io/hostname", LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}}
+		tmplAffinity := nodeAffinity(zoneTerm("a"))
+		wfAffinity := &apiv1.Affinity{
+			NodeAffinity:    &apiv1.NodeAffinity{PreferredDuringSchedulingIgnoredDuringExecution: []apiv1.PreferredSchedulingTerm{preferred}},
+			PodAntiAffinity: &apiv1.PodAntiAffinity{RequiredDuringSchedulingIgnoredDuringExecution: []apiv1.PodAffinityTerm{antiAffinity}},
+		}
+		merged := mergeAffinity(tmplAffinity, wfAffinity)
+		assert.Equal(t, []apiv1.NodeSelectorTerm{zoneTerm("a")}, merged.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+		assert.Equal(t, []apiv1.PreferredSchedulingTerm{preferred}, merged.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+		assert.Equal(t, []apiv1.PodAffinityTerm{antiAffinity}, merged.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+		assert.Nil(t, merged.PodAffinity)
+	})
+}
+
+// TestAffinityMerge verifies that the workflow's affinity is merged with the template's rather than replaced by it.
+func TestAffinityMerge(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	woc := newWoc(ctx)
+	woc.execWf.Spec.Affinity = &apiv1.Affinity{PodAffinity: &apiv1.PodAffinity{RequiredDuringSchedulingIgnoredDuringExecution: []apiv1.PodAffinityTerm{{
+		TopologyKey:   "kubernetes.io/hostname",
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{common.LabelKeyWorkflow: woc.wf.Name}},
+	}}}}
+	woc.execWf.Spec.Templates[0].Affinity = &apiv1.Affinity{NodeAffinity: &apiv1.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: &apiv1.NodeSelector{
+		NodeSelectorTerms: []apiv1.NodeSelectorTerm{{MatchExpressions: []apiv1.NodeSelectorRequirement{{Key: "gpu", Operator: apiv1.NodeSelectorOpExists}}}},
+	}}}
+	tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+	require.NoError(t, err)
+	_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+	require.NoError(t, err)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	affinity := pods.Items[0].Spec.Affinity
+	require.NotNil(t, affinity)
+	require.NotNil(t, affinity.NodeAffinity)
+	assert.Equal(t, "gpu", affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0].Key)
+	require.NotNil(t, affinity.PodAffinity)
+	assert.Len(t, affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, 1)
+}
+
+// TestContainerStdin verifies that stdin and stdinOnce on the template's container are carried forward to the main container.
+func TestContainerStdin(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	woc := newWoc(ctx)
+	woc.execWf.Spec.Templates[0].Container.Stdin = true
+	woc.execWf.Spec.Templates[0].Container.StdinOnce = true
+	tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+	require.NoError(t, err)
+	_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+	require.NoError(t, err)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	for _, c := range pods.Items[0].Spec.Containers {
+		if c.Name == common.MainContainerName {
+			assert.True(t, c.Stdin)
+			assert.True(t, c.StdinOnce)
+		} else {
+			assert.False(t, c.Stdin, c.Name)
+		}
+	}
+}
+
+// TestRuntimeClassName verifies that the workflow's runtimeClassName is carried forward to the pod, and that the template's takes precedence.
+func TestRuntimeClassName(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Workflow", func(t *testing.T) {
+		woc := newWoc(ctx)
+		woc.execWf.Spec.RuntimeClassName = ptr.To("gvisor")
+		tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+		require.NoError(t, err)
+		_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+		require.NoError(t, err)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		assert.Equal(t, ptr.To("gvisor"), pods.Items[0].Spec.RuntimeClassName)
+	})
+	t.Run("TemplateOverride", func(t *testing.T) {
+		woc := newWoc(ctx)
+		woc.execWf.Spec.RuntimeClassName = ptr.To("gvisor")
+		woc.execWf.Spec.Templates[0].RuntimeClassName = ptr.To("kata")
+		tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+		require.NoError(t, err)
+		_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+		require.NoError(t, err)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		assert.Equal(t, ptr.To("kata"), pods.Items[0].Spec.RuntimeClassName)
+	})
+}
+
+// TestDNSConfig verifies that the workflow's dnsPolicy and dnsConfig are carried forward to the pod, and that the template's take precedence.
+func TestDNSConfig(t *testing.T) {
+	dnsNone := apiv1.DNSNone
+	dnsDefault := apiv1.DNSDefault
+	runPod := func(t *testing.T, woc *wfOperationCtx) apiv1.Pod {
+		t.Helper()
+		ctx := logging.TestContext(t.Context())
+		tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+		require.NoError(t, err)
+		_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+		require.NoError(t, err)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		return pods.Items[0]
+	}
+	t.Run("Workflow", func(t *testing.T) {
+		woc := newWoc(logging.TestContext(t.Context()))
+		woc.execWf.Spec.DNSPolicy = &dnsNone
+		woc.execWf.Spec.DNSConfig = &apiv1.PodDNSConfig{Nameservers: []string{"10.0.0.10"}}
+		pod := runPod(t, woc)
+		assert.Equal(t, apiv1.DNSNone, pod.Spec.DNSPolicy)
+		require.NotNil(t, pod.Spec.DNSConfig)
+		assert.Equal(t, []string{"10.0.0.10"}, pod.Spec.DNSConfig.Nameservers)
+	})
+	t.Run("TemplateOverride", func(t *testing.T) {
+		woc := newWoc(logging.TestContext(t.Context()))
+		woc.execWf.Spec.DNSPolicy = &dnsNone
+		woc.execWf.Spec.DNSConfig = &apiv1.PodDNSConfig{Nameservers: []string{"10.0.0.10"}}
+		woc.execWf.Spec.Templates[0].DNSPolicy = &dnsDefault
+		woc.execWf.Spec.Templates[0].DNSConfig = &apiv1.PodDNSConfig{Nameservers: []string{"192.168.0.53"}}
+		pod := runPod(t, woc)
+		assert.Equal(t, apiv1.DNSDefault, pod.Spec.DNSPolicy)
+		require.NotNil(t, pod.Spec.DNSConfig)
+		assert.Equal(t, []string{"192.168.0.53"}, pod.Spec.DNSConfig.Nameservers)
+	})
+}
+
 // TestInitContainers verifies the ability to set up initContainers
 func TestInitContainers(t *testing.T) {
 	volumes := []apiv1.Volume{
@@ -970,6 +1408,50 @@ func TestInitContainers(t *testing.T) {
 	assert.Equal(t, "var-run-argo", foo.VolumeMounts[2].Name)
 }
 
+// TestInitContainersInputArtifacts verifies that input artifacts can be mounted into initContainers
+func TestInitContainersInputArtifacts(t *testing.T) {
+	volumes := []apiv1.Volume{{Name: "src", VolumeSource: apiv1.VolumeSource{EmptyDir: &apiv1.EmptyDirVolumeSource{}}}}
+	ctx := logging.TestContext(t.Context())
+	woc := newWoc(ctx)
+	woc.volumes = volumes
+	tmpl := &woc.execWf.Spec.Templates[0]
+	tmpl.Container.VolumeMounts = []apiv1.VolumeMount{{Name: "src", MountPath: "/src"}}
+	tmpl.Inputs.Artifacts = []wfv1.Artifact{
+		{Name: "config", Path: "/config", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "foo"}}},
+		{Name: "code", Path: "/src/code", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "bar"}}},
+	}
+	tmpl.InitContainers = []wfv1.UserContainer{{
+		Container: apiv1.Container{
+			Name: "init-foo",
+			VolumeMounts: []apiv1.VolumeMount{
+				{Name: "config", MountPath: "/etc/config", ReadOnly: true},
+				{Name: "code", MountPath: "/code"},
+				{Name: "src", MountPath: "/src"},
+			},
+		},
+	}}
+
+	tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+	require.NoError(t, err)
+	_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), tmpl, &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+	require.NoError(t, err)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	pod := pods.Items[0]
+	require.Len(t, pod.Spec.InitContainers, 2)
+	foo := pod.Spec.InitContainers[1]
+	assert.Equal(t, "init-foo", foo.Name)
+	assert.Equal(t, []apiv1.VolumeMount{
+		{Name: "input-artifacts", MountPath: "/etc/config", SubPath: "config", ReadOnly: true},
+		{Name: "src", MountPath: "/code", SubPath: "code"},
+		{Name: "src", MountPath: "/src"},
+		volumeMountVarArgo,
+	}, foo.VolumeMounts)
+	// the template's init containers are not modified
+	assert.Equal(t, "config", tmpl.InitContainers[0].VolumeMounts[0].Name)
+}
+
 // TestSidecars verifies the ability to set up sidecars
 func TestSidecars(t *testing.T) {
 	volumes := []apiv1.Volume{
@@ -1085,6 +1567,147 @@ func TestTemplateLocalVolumes(t *testing.T) {
 	}
 }
 
+// TestTemplateEphemeralVolume verifies a generic ephemeral volume is carried forward to the Podspec, so that kubernetes
+// creates a PVC owned by the pod
+func TestTemplateEphemeralVolume(t *testing.T) {
+	scratch := apiv1.Volume{
+		Name: "scratch",
+		VolumeSource: apiv1.VolumeSource{
+			Ephemeral: &apiv1.EphemeralVolumeSource{
+				VolumeClaimTemplate: &apiv1.PersistentVolumeClaimTemplate{
+					Spec: apiv1.PersistentVolumeClaimSpec{
+						AccessModes: []apiv1.PersistentVolumeAccessMode{apiv1.ReadWriteOnce},
+						Resources: apiv1.VolumeResourceRequirements{
+							Requests: apiv1.ResourceList{apiv1.ResourceStorage: resource.MustParse("1Gi")},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ctx := logging.TestContext(t.Context())
+	woc := newWoc(ctx)
+	woc.execWf.Spec.Templates[0].Container.VolumeMounts = []apiv1.VolumeMount{{Name: "scratch", MountPath: "/scratch"}}
+	woc.execWf.Spec.Templates[0].Volumes = []apiv1.Volume{scratch}
+
+	tmplCtx, err := woc.createTemplateContext(ctx, wfv1.ResourceScopeLocal, "")
+	require.NoError(t, err)
+	_, err = woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, tmplCtx.GetTemplateScope(), &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+	require.NoError(t, err)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	pod := pods.Items[0]
+	assert.Contains(t, pod.Spec.Volumes, scratch)
+	// no PVC is created by the controller, kubernetes creates it when the pod is created
+	assert.Empty(t, woc.wf.Status.PersistentVolumeClaims)
+}
+
+// TestTemplateTTY verifies that tty and stdin are passed through to the main container
+func TestTemplateTTY(t *testing.T) {
+	t.Run("Container", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		tmpl := &woc.execWf.Spec.Templates[0]
+		tmpl.Container.TTY = true
+		tmpl.Container.Stdin = true
+		_, err := woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, "", tmpl, &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+		require.NoError(t, err)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		ctr := pods.Items[0].Spec.Containers[1]
+		assert.Equal(t, common.MainContainerName, ctr.Name)
+		assert.True(t, ctr.TTY)
+		assert.True(t, ctr.Stdin)
+	})
+	t.Run("Script", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		tmpl := unmarshalTemplate(scriptTemplateWithInputArtifact)
+		tmpl.Inputs = wfv1.Inputs{}
+		tmpl.Script.TTY = true
+		tmpl.Script.Stdin = true
+		_, err := woc.executeScript(ctx, tmpl.Name, "", tmpl, &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+		require.NoError(t, err)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		ctr := pods.Items[0].Spec.Containers[1]
+		assert.Equal(t, common.MainContainerName, ctr.Name)
+		assert.True(t, ctr.TTY)
+		assert.True(t, ctr.Stdin)
+	})
+}
+
+// TestTemplateEnvFrom verifies that container envFrom sources are carried forward to the main container
+func TestTemplateEnvFrom(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	woc := newWoc(ctx)
+	tmpl := &woc.execWf.Spec.Templates[0]
+	tmpl.Container.EnvFrom = []apiv1.EnvFromSource{
+		{ConfigMapRef: &apiv1.ConfigMapEnvSource{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-config"}}},
+		{Prefix: "SECRET_", SecretRef: &apiv1.SecretEnvSource{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-secret"}}},
+	}
+	_, err := woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, "", tmpl, &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+	require.NoError(t, err)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	ctr := pods.Items[0].Spec.Containers[1]
+	assert.Equal(t, common.MainContainerName, ctr.Name)
+	require.Len(t, ctr.EnvFrom, 2)
+	require.NotNil(t, ctr.EnvFrom[0].ConfigMapRef)
+	assert.Equal(t, "my-config", ctr.EnvFrom[0].ConfigMapRef.Name)
+	require.NotNil(t, ctr.EnvFrom[1].SecretRef)
+	assert.Equal(t, "my-secret", ctr.EnvFrom[1].SecretRef.Name)
+	assert.Equal(t, "SECRET_", ctr.EnvFrom[1].Prefix)
+}
+
+func TestTemplateWorkingDir(t *testing.T) {
+	t.Run("Container", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		tmpl := &woc.execWf.Spec.Templates[0]
+		tmpl.Container.WorkingDir = "/workspace"
+		_, err := woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, "", tmpl, &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+		require.NoError(t, err)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		ctr := pods.Items[0].Spec.Containers[1]
+		assert.Equal(t, common.MainContainerName, ctr.Name)
+		assert.Equal(t, "/workspace", ctr.WorkingDir)
+		// the emissary is injected as the entrypoint without changing the working directory
+		assert.Equal(t, common.VarRunArgoPath+"/argoexec", ctr.Command[0])
+	})
+	t.Run("Script", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		tmpl := &wfv1.Template{
+			Name: "script",
+			Script: &wfv1.ScriptTemplate{
+				Container: apiv1.Container{
+					Image:      "python:alpine3.6",
+					Command:    []string{"python"},
+					WorkingDir: "/workspace",
+				},
+				Source: "print('hello')",
+			},
+		}
+		_, err := woc.executeScript(ctx, "script", "", tmpl, &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+		require.NoError(t, err)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		ctr := pods.Items[0].Spec.Containers[1]
+		assert.Equal(t, common.MainContainerName, ctr.Name)
+		assert.Equal(t, "/workspace", ctr.WorkingDir)
+		assert.Equal(t, common.VarRunArgoPath+"/argoexec", ctr.Command[0])
+	})
+}
+
 // TestWFLevelHostAliases verifies the ability to carry forward workflow level HostAliases to Podspec
 func TestWFLevelHostAliases(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
@@ -1123,6 +1746,61 @@ func TestTmplLevelHostAliases(t *testing.T) {
 	assert.NotNil(t, pod.Spec.HostAliases)
 }
 
+// TestResourceClaims verifies workflow and template level Dynamic Resource Allocation claims are merged into the Podspec
+func TestResourceClaims(t *testing.T) {
+	woc := newWoc(logging.TestContext(t.Context()))
+	woc.execWf.Spec.ResourceClaims = []apiv1.PodResourceClaim{
+		{Name: "gpu", ResourceClaimTemplateName: ptr.To("wf-gpu")},
+		{Name: "fpga", ResourceClaimName: ptr.To("wf-fpga")},
+	}
+	tmpl := &woc.execWf.Spec.Templates[0]
+	tmpl.ResourceClaims = []apiv1.PodResourceClaim{{Name: "gpu", ResourceClaimTemplateName: ptr.To("tmpl-gpu")}}
+	tmpl.Container.Resources.Claims = []apiv1.ResourceClaim{{Name: "gpu"}}
+
+	t.Run("Disabled", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		_, err := woc.createWorkflowPod(ctx, woc.wf.Name, []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{})
+		require.EqualError(t, err, "resourceClaims can only be used when ARGO_DYNAMIC_RESOURCE_ALLOCATION is set to true on the controller")
+	})
+	t.Run("Enabled", func(t *testing.T) {
+		t.Setenv("ARGO_DYNAMIC_RESOURCE_ALLOCATION", "true")
+		ctx := logging.TestContext(t.Context())
+		pod, err := woc.createWorkflowPod(ctx, woc.wf.Name, []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{})
+		require.NoError(t, err)
+		assert.Equal(t, []apiv1.PodResourceClaim{
+			{Name: "gpu", ResourceClaimTemplateName: ptr.To("tmpl-gpu")},
+			{Name: "fpga", ResourceClaimName: ptr.To("wf-fpga")},
+		}, pod.Spec.ResourceClaims)
+		for _, c := range pod.Spec.Containers {
+			if c.Name == common.MainContainerName {
+				assert.Equal(t, []apiv1.ResourceClaim{{Name: "gpu"}}, c.Resources.Claims)
+			}
+		}
+	})
+}
+
+// TestHugePagesResources verifies hugepages resources of a template are passed to the main container
+func TestHugePagesResources(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	woc := newWoc(ctx)
+	tmpl := &woc.execWf.Spec.Templates[0]
+	tmpl.Container.Resources = apiv1.ResourceRequirements{
+		Limits: apiv1.ResourceList{
+			apiv1.ResourceMemory:                  resource.MustParse("100Mi"),
+			apiv1.ResourceHugePagesPrefix + "2Mi": resource.MustParse("80Mi"),
+		},
+	}
+	pod, err := woc.createWorkflowPod(ctx, woc.wf.Name, []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{})
+	require.NoError(t, err)
+	for _, c := range pod.Spec.Containers {
+		if c.Name == common.MainContainerName {
+			hugePages := c.Resources.Limits[apiv1.ResourceName("hugepages-2Mi")]
+			assert.Equal(t, "80Mi", hugePages.String())
+			assert.Equal(t, "100Mi", c.Resources.Limits.Memory().String())
+		}
+	}
+}
+
 // TestWFLevelSecurityContext verifies the ability to carry forward workflow level SecurityContext to Podspec
 func TestWFLevelSecurityContext(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
@@ -1163,6 +1841,43 @@ func TestTmplLevelSecurityContext(t *testing.T) {
 	assert.Equal(t, runAsUser, *pod.Spec.SecurityContext.RunAsUser)
 }
 
+// TestSeccompProfile verifies that seccomp profiles set on the workflow and on the template container reach the pod
+func TestSeccompProfile(t *testing.T) {
+	t.Run("WorkflowLevel", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		woc.execWf.Spec.SecurityContext = &apiv1.PodSecurityContext{
+			SeccompProfile: &apiv1.SeccompProfile{Type: apiv1.SeccompProfileTypeRuntimeDefault},
+		}
+		_, err := woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, "", &woc.execWf.Spec.Templates[0], &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+		require.NoError(t, err)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		pod := pods.Items[0]
+		require.NotNil(t, pod.Spec.SecurityContext)
+		require.NotNil(t, pod.Spec.SecurityContext.SeccompProfile)
+		assert.Equal(t, apiv1.SeccompProfileTypeRuntimeDefault, pod.Spec.SecurityContext.SeccompProfile.Type)
+	})
+	t.Run("ContainerLevel", func(t *testing.T) {
+		ctx := logging.TestContext(t.Context())
+		woc := newWoc(ctx)
+		tmpl := &woc.execWf.Spec.Templates[0]
+		tmpl.Container.SecurityContext = &apiv1.SecurityContext{
+			SeccompProfile: &apiv1.SeccompProfile{Type: apiv1.SeccompProfileTypeLocalhost, LocalhostProfile: ptr.To("profiles/audit.json")},
+		}
+		_, err := woc.executeContainer(ctx, woc.execWf.Spec.Entrypoint, "", tmpl, &wfv1.WorkflowStep{}, &executeTemplateOpts{})
+		require.NoError(t, err)
+		pods, err := listPods(ctx, woc)
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 1)
+		ctr := pods.Items[0].Spec.Containers[1]
+		assert.Equal(t, common.MainContainerName, ctr.Name)
+		require.NotNil(t, ctr.SecurityContext)
+		assert.Equal(t, &apiv1.SeccompProfile{Type: apiv1.SeccompProfileTypeLocalhost, LocalhostProfile: ptr.To("profiles/audit.json")}, ctr.SecurityContext.SeccompProfile)
+	})
+}
+
 func Test_createSecretVolumesFromArtifactLocations_SSECUsed(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
 
@@ -1530,6 +2245,22 @@ func TestPodSpecPatchPodName(t *testing.T) {
 	}
 }
 
+func TestPodNameFormat(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldStepWfWithPatch)
+	wf.Spec.PodNameFormat = "acme-{{workflow.name}}-{{template.name}}"
+	woc := newWoc(ctx, *wf)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	assert.Equal(t, "acme-hello-world-whalesay-3731220306", pods.Items[0].Name)
+	template, err := getPodTemplate(&pods.Items[0])
+	require.NoError(t, err)
+	assert.Equal(t, "acme-hello-world-whalesay-3731220306", template.Outputs.Parameters[0].Value.String())
+}
+
 func TestMainContainerCustomization(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
 	mainCtrSpec := &apiv1.Container{
@@ -1625,6 +2356,45 @@ func TestExecutorContainerCustomization(t *testing.T) {
 	assert.Equal(t, "536870912", waitCtr.Resources.Limits.Memory().AsDec().String())
 }
 
+func TestExecutorImage(t *testing.T) {
+	tests := []struct {
+		name     string
+		wfExec   *wfv1.ExecutorConfig
+		tmplExec *wfv1.ExecutorConfig
+		want     string
+	}{
+		{"Controller", nil, nil, "my-argoexec:config"},
+		{"Workflow", &wfv1.ExecutorConfig{Image: "my-argoexec:wf"}, nil, "my-argoexec:wf"},
+		{"WorkflowWithoutImage", &wfv1.ExecutorConfig{}, nil, "my-argoexec:config"},
+		{"Template", &wfv1.ExecutorConfig{Image: "my-argoexec:wf"}, &wfv1.ExecutorConfig{Image: "my-argoexec:tmpl"}, "my-argoexec:tmpl"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := logging.TestContext(t.Context())
+			woc := newWoc(ctx)
+			woc.controller.Config.Executor = &apiv1.Container{Image: "my-argoexec:config"}
+			woc.execWf.Spec.Executor = tt.wfExec
+			tmpl := &wfv1.Template{
+				Executor: tt.tmplExec,
+				Inputs: wfv1.Inputs{
+					Artifacts: []wfv1.Artifact{{
+						Name:             "foo",
+						Path:             "/tmp/foo",
+						ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "foo"}},
+					}},
+				},
+			}
+
+			pod, err := woc.createWorkflowPod(ctx, "", nil, tmpl, &createWorkflowPodOpts{})
+			require.NoError(t, err)
+			require.Len(t, pod.Spec.InitContainers, 1)
+			assert.Equal(t, tt.want, pod.Spec.InitContainers[0].Image)
+			assert.Equal(t, common.WaitContainerName, pod.Spec.Containers[0].Name)
+			assert.Equal(t, tt.want, pod.Spec.Containers[0].Image)
+		})
+	}
+}
+
 var helloWindowsWf = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -1784,6 +2554,59 @@ func TestPodMetadata(t *testing.T) {
 	assert.Equal(t, "world", pod.Labels["template-level-pod-label"])
 }
 
+var wfWithPodMetadataAcrossSteps = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: pod-metadata-steps
+spec:
+  entrypoint: main
+  podMetadata:
+    annotations:
+      vault.hashicorp.com/agent-inject: "true"
+      vault.hashicorp.com/role: workflow
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        template: plain
+      - name: b
+        template: overridden
+  - name: plain
+    container:
+      image: docker/whalesay:latest
+  - name: overridden
+    metadata:
+      annotations:
+        vault.hashicorp.com/role: overridden
+    container:
+      image: docker/whalesay:latest
+`
+
+// TestPodMetadataAcrossSteps verifies that workflow-level pod annotations reach every pod of the workflow, and that
+// template-level annotations win on conflict.
+func TestPodMetadataAcrossSteps(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(wfWithPodMetadataAcrossSteps)
+	ctx := logging.TestContext(t.Context())
+	cancel, controller := newController(ctx, wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(ctx, wf, controller)
+	woc.operate(ctx)
+
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 2)
+	roles := map[string]string{}
+	for _, pod := range pods.Items {
+		assert.Equal(t, "true", pod.Annotations["vault.hashicorp.com/agent-inject"])
+		roles[pod.Annotations[common.AnnotationKeyNodeName]] = pod.Annotations["vault.hashicorp.com/role"]
+	}
+	assert.Equal(t, map[string]string{
+		"pod-metadata-steps[0].a": "workflow",
+		"pod-metadata-steps[0].b": "overridden",
+	}, roles)
+}
+
 var wfWithContainerSet = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -2138,3 +2961,77 @@ func TestMergeEnvVars(t *testing.T) {
 		})
 	})
 }
+
+func TestValidateImageEntrypoints(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	wf.Spec.Templates[0].Container.Image = "my-image"
+	wf.Spec.Templates[0].Container.Command = []string{"--verbose"}
+	woc := newWoc(ctx, *wf)
+	woc.controller.Config.ValidateImageEntrypoints = true
+	tmpl := &woc.execWf.Spec.Templates[0]
+
+	pod, err := woc.createWorkflowPod(ctx, wf.Name, []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{})
+	require.NoError(t, err)
+	assert.Contains(t, pod.Spec.Containers[1].Command, "--verbose")
+	assert.Equal(t, []string{`Warning ImageEntrypointMismatch template whalesay: command ["--verbose"] starts with a flag, but replaces the image's entrypoint ["my-entrypoint"], use args to pass arguments to the entrypoint`},
+		getEventsWithoutAnnotations(woc.controller, 1))
+}
+
+func Test_resolvePersistentVolumeClaimArtifacts(t *testing.T) {
+	pod := &apiv1.Pod{Spec: apiv1.PodSpec{Volumes: []apiv1.Volume{
+		{Name: "workdir", VolumeSource: apiv1.VolumeSource{PersistentVolumeClaim: &apiv1.PersistentVolumeClaimVolumeSource{ClaimName: "my-pvc"}}},
+		{Name: "reports", VolumeSource: apiv1.VolumeSource{PersistentVolumeClaim: &apiv1.PersistentVolumeClaimVolumeSource{ClaimName: "my-reports-pvc"}}},
+	}}}
+	tests := []struct {
+		name  string
+		src   wfv1.PersistentVolumeClaimSource
+		path  string
+		error string
+	}{
+		{"Root", wfv1.PersistentVolumeClaimSource{ClaimName: "my-pvc"}, "/mnt/work", ""},
+		{"SubPath", wfv1.PersistentVolumeClaimSource{ClaimName: "my-pvc", SubPath: "out/data"}, "/mnt/work/out/data", ""},
+		{"MountSubPath", wfv1.PersistentVolumeClaimSource{ClaimName: "my-reports-pvc", SubPath: "reports"}, "/mnt/reports", ""},
+		{"WithinMountSubPath", wfv1.PersistentVolumeClaimSource{ClaimName: "my-reports-pvc", SubPath: "reports/today"}, "/mnt/reports/today", ""},
+		{"OutsideMountSubPath", wfv1.PersistentVolumeClaimSource{ClaimName: "my-reports-pvc", SubPath: "other"}, "", "artifact 'foo' persistent volume claim 'my-reports-pvc' is not mounted in the main container at subPath 'other'"},
+		{"NotMounted", wfv1.PersistentVolumeClaimSource{ClaimName: "other-pvc"}, "", "artifact 'foo' persistent volume claim 'other-pvc' is not mounted in the main container at subPath ''"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := &wfv1.Template{
+				Container: &apiv1.Container{VolumeMounts: []apiv1.VolumeMount{
+					{Name: "workdir", MountPath: "/mnt/work"},
+					{Name: "reports", MountPath: "/mnt/reports", SubPath: "reports"},
+				}},
+				Outputs: wfv1.Outputs{Artifacts: []wfv1.Artifact{{Name: "foo", ArtifactLocation: wfv1.ArtifactLocation{PersistentVolumeClaim: tt.src.DeepCopy()}}}},
+			}
+			err := resolvePersistentVolumeClaimArtifacts(pod, tmpl)
+			if tt.error != "" {
+				require.EqualError(t, err, tt.error)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.path, tmpl.Outputs.Artifacts[0].Path)
+			assert.Nil(t, tmpl.Outputs.Artifacts[0].PersistentVolumeClaim)
+		})
+	}
+}
+
+// TestPersistentVolumeClaimArtifact verifies that the executor is given the path of an artifact saved from a persistent volume claim.
+func TestPersistentVolumeClaimArtifact(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	woc := newWoc(ctx)
+	setArtifactRepository(woc.controller, &wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "foo"}}})
+	woc.wf.Spec.Volumes = []apiv1.Volume{{Name: "workdir", VolumeSource: apiv1.VolumeSource{PersistentVolumeClaim: &apiv1.PersistentVolumeClaimVolumeSource{ClaimName: "my-pvc"}}}}
+	tmpl := &woc.wf.Spec.Templates[0]
+	tmpl.Container.VolumeMounts = []apiv1.VolumeMount{{Name: "workdir", MountPath: "/mnt/work"}}
+	tmpl.Outputs.Artifacts = []wfv1.Artifact{{Name: "results", ArtifactLocation: wfv1.ArtifactLocation{PersistentVolumeClaim: &wfv1.PersistentVolumeClaimSource{ClaimName: "my-pvc", SubPath: "results"}}}}
+	woc.operate(ctx)
+	pods, err := listPods(ctx, woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	podTmpl, err := getPodTemplate(&pods.Items[0])
+	require.NoError(t, err)
+	assert.Equal(t, "/mnt/work/results", podTmpl.Outputs.Artifacts[0].Path)
+	assert.Nil(t, podTmpl.Outputs.Artifacts[0].PersistentVolumeClaim)
+}
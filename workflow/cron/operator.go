@@ -14,6 +14,7 @@ import (
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 
 	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
 
@@ -48,6 +49,7 @@ type cronWfOperationCtx struct {
 	cronWfIf        typed.CronWorkflowInterface
 	wftmplInformer  wfextvv1alpha1.WorkflowTemplateInformer
 	cwftmplInformer wfextvv1alpha1.ClusterWorkflowTemplateInformer
+	eventRecorder   record.EventRecorder
 	log             logging.Logger
 	metrics         *metrics.Metrics
 	// scheduledTimeFunc returns the last scheduled time when it is called
@@ -58,7 +60,7 @@ type cronWfOperationCtx struct {
 
 func newCronWfOperationCtx(ctx context.Context, cronWorkflow *v1alpha1.CronWorkflow, wfClientset versioned.Interface,
 	metrics *metrics.Metrics, wftmplInformer wfextvv1alpha1.WorkflowTemplateInformer,
-	cwftmplInformer wfextvv1alpha1.ClusterWorkflowTemplateInformer, wfDefaults *v1alpha1.Workflow,
+	cwftmplInformer wfextvv1alpha1.ClusterWorkflowTemplateInformer, wfDefaults *v1alpha1.Workflow, eventRecorder record.EventRecorder,
 ) *cronWfOperationCtx {
 	log := logging.RequireLoggerFromContext(ctx)
 	return &cronWfOperationCtx{
@@ -69,6 +71,7 @@ func newCronWfOperationCtx(ctx context.Context, cronWorkflow *v1alpha1.CronWorkf
 		cronWfIf:        wfClientset.ArgoprojV1alpha1().CronWorkflows(cronWorkflow.Namespace),
 		wftmplInformer:  wftmplInformer,
 		cwftmplInformer: cwftmplInformer,
+		eventRecorder:   eventRecorder,
 		log: log.WithFields(logging.Fields{
 			"workflow":  cronWorkflow.Name,
 			"namespace": cronWorkflow.Namespace,
@@ -333,13 +336,21 @@ func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun(ctx context.Conte
 				return time.Time{}, err
 			}
 
-			var missedExecutionTime time.Time
+			var missedExecutionTime, lastSkippedTime time.Time
+			skipped := 0
 			nextScheduledRunTime := cronSchedule.Next(woc.cronWf.Status.LastScheduledTime.Time)
 			// Workflow should have ran
 			for nextScheduledRunTime.Before(now) {
 				missedExecutionTime = nextScheduledRunTime
+				if woc.cronWf.Spec.StartingDeadlineSeconds != nil && !now.Before(missedExecutionTime.Add(time.Duration(*woc.cronWf.Spec.StartingDeadlineSeconds)*time.Second)) {
+					skipped++
+					lastSkippedTime = missedExecutionTime
+				}
 				nextScheduledRunTime = cronSchedule.Next(missedExecutionTime)
 			}
+			if skipped > 0 {
+				woc.reportSkippedExecutions(ctx, schedule, skipped, lastSkippedTime)
+			}
 
 			// We missed the latest execution time
 			if !missedExecutionTime.IsZero() {
@@ -354,6 +365,17 @@ func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun(ctx context.Conte
 	return time.Time{}, nil
 }
 
+// reportSkippedExecutions records a warning event for the missed executions of the schedule that are older than
+// StartingDeadlineSeconds, and so will never be run
+func (woc *cronWfOperationCtx) reportSkippedExecutions(ctx context.Context, schedule string, skipped int, lastSkippedTime time.Time) {
+	msg := fmt.Sprintf("Skipped %d missed execution(s) of schedule '%s', the latest at %s, as they are more than %ds in the past",
+		skipped, schedule, lastSkippedTime.Format(time.RFC3339), *woc.cronWf.Spec.StartingDeadlineSeconds)
+	woc.log.WithFields(logging.Fields{"schedule": schedule, "skipped": skipped}).Warn(ctx, msg)
+	if woc.eventRecorder != nil {
+		woc.eventRecorder.Event(woc.cronWf, corev1.EventTypeWarning, "MissedSchedule", msg)
+	}
+}
+
 type fulfilledWfsPhase struct {
 	fulfilled bool
 	phase     v1alpha1.WorkflowPhase
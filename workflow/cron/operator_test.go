@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -219,6 +220,35 @@ func TestRunOutstandingWorkflowsAcrossTimezones(t *testing.T) {
 	assert.True(t, missedExecutionTime.IsZero())
 }
 
+func TestRunOutstandingWorkflowsSkipsExecutionsPastDeadline(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	// Avoid crossing a minute mark during the test
+	if _, _, sec := time.Now().Clock(); sec >= 55 {
+		time.Sleep(time.Duration(61-sec) * time.Second)
+	}
+
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(scheduledWf), &cronWf)
+	// The executions at 2 minutes ago, 1 minute ago, and the current minute mark were all missed
+	currentMinute := time.Now().Truncate(time.Minute)
+	cronWf.Status.LastScheduledTime = &v1.Time{Time: currentMinute.Add(-3 * time.Minute)}
+	cronWf.Spec.StartingDeadlineSeconds = ptr.To(int64(60))
+	recorder := record.NewFakeRecorder(4)
+	woc := &cronWfOperationCtx{
+		cronWf:        &cronWf,
+		eventRecorder: recorder,
+		log:           logging.RequireLoggerFromContext(ctx),
+	}
+	woc.cronWf.SetSchedule(woc.cronWf.Spec.GetScheduleWithTimezoneString())
+	missedExecutionTime, err := woc.shouldOutstandingWorkflowsBeRun(ctx)
+	require.NoError(t, err)
+	// Only the execution within the deadline is run
+	assert.Equal(t, currentMinute.Unix(), missedExecutionTime.Unix())
+	require.Len(t, recorder.Events, 1)
+	assert.Equal(t, fmt.Sprintf("Warning MissedSchedule Skipped 2 missed execution(s) of schedule '* * * * *', the latest at %s, as they are more than 60s in the past",
+		currentMinute.Add(-time.Minute).Format(time.RFC3339)), <-recorder.Events)
+}
+
 type fakeLister struct{}
 
 func (f fakeLister) List() ([]*v1alpha1.Workflow, error) {
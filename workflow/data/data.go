@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/expr-lang/expr"
@@ -21,6 +22,48 @@ func ProcessData(ctx context.Context, data *wfv1.Data, processor wfv1.DataSource
 	return transformedData, nil
 }
 
+// ProcessInputs transforms data sourced from the template's inputs. Unlike ProcessData, it doesn't need to read from
+// an artifact repository.
+func ProcessInputs(data *wfv1.Data, inputs *wfv1.Inputs) (interface{}, error) {
+	sourcedData, err := processInputsSource(data.Source, inputs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to process data source: %w", err)
+	}
+	transformedData, err := processTransformation(sourcedData, &data.Transformation)
+	if err != nil {
+		return nil, fmt.Errorf("unable to process data transformation: %w", err)
+	}
+	return transformedData, nil
+}
+
+func processInputsSource(source wfv1.DataSource, inputs *wfv1.Inputs) (interface{}, error) {
+	var value string
+	switch {
+	case source.ParameterName != "":
+		param := inputs.GetParameterByName(source.ParameterName)
+		if param == nil || param.Value == nil {
+			return nil, fmt.Errorf("input parameter %s not supplied", source.ParameterName)
+		}
+		value = param.Value.String()
+	case source.ArtifactName != "":
+		art := inputs.GetArtifactByName(source.ArtifactName)
+		if art == nil {
+			return nil, fmt.Errorf("input artifact %s not supplied", source.ArtifactName)
+		}
+		if art.Raw == nil {
+			return nil, fmt.Errorf("input artifact %s must be a raw artifact", source.ArtifactName)
+		}
+		value = art.Raw.Data
+	default:
+		return nil, fmt.Errorf("no valid source is used for data template")
+	}
+	var data interface{}
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		return nil, fmt.Errorf("unable to parse source as JSON: %w", err)
+	}
+	return data, nil
+}
+
 func processSource(ctx context.Context, source wfv1.DataSource, processor wfv1.DataSourceProcessor) (interface{}, error) {
 	var data interface{}
 	var err error
@@ -96,3 +96,33 @@ func TestProcessTransformation(t *testing.T) {
 	_, err = processTransformation(files, filterFiles)
 	require.Error(t, err)
 }
+
+func TestProcessInputs(t *testing.T) {
+	data := &v1alpha1.Data{
+		Source:         v1alpha1.DataSource{ParameterName: "numbers"},
+		Transformation: v1alpha1.Transformation{{Expression: `filter(data, {# > 1})`}},
+	}
+	inputs := &v1alpha1.Inputs{Parameters: []v1alpha1.Parameter{{Name: "numbers", Value: v1alpha1.AnyStringPtr("[1, 2, 3]")}}}
+	transformed, err := ProcessInputs(data, inputs)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{float64(2), float64(3)}, transformed)
+
+	data.Source = v1alpha1.DataSource{ArtifactName: "numbers"}
+	inputs = &v1alpha1.Inputs{Artifacts: []v1alpha1.Artifact{{Name: "numbers", ArtifactLocation: v1alpha1.ArtifactLocation{Raw: &v1alpha1.RawArtifact{Data: "[0, 5]"}}}}}
+	transformed, err = ProcessInputs(data, inputs)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{float64(5)}, transformed)
+
+	inputs = &v1alpha1.Inputs{Artifacts: []v1alpha1.Artifact{{Name: "numbers", ArtifactLocation: v1alpha1.ArtifactLocation{S3: &v1alpha1.S3Artifact{}}}}}
+	_, err = ProcessInputs(data, inputs)
+	require.EqualError(t, err, "unable to process data source: input artifact numbers must be a raw artifact")
+
+	data.Source = v1alpha1.DataSource{ParameterName: "missing"}
+	_, err = ProcessInputs(data, inputs)
+	require.EqualError(t, err, "unable to process data source: input parameter missing not supplied")
+
+	data.Source = v1alpha1.DataSource{ParameterName: "numbers"}
+	inputs = &v1alpha1.Inputs{Parameters: []v1alpha1.Parameter{{Name: "numbers", Value: v1alpha1.AnyStringPtr("not-json")}}}
+	_, err = ProcessInputs(data, inputs)
+	require.ErrorContains(t, err, "unable to parse source as JSON")
+}
@@ -0,0 +1,326 @@
+package eventsource
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/informers/externalversions"
+	wfextvv1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/client/informers/externalversions/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/event/dispatch"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/events"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+const (
+	eventSourceResyncPeriod = 20 * time.Minute
+	// maxPayloadSize is the largest request body that a webhook accepts
+	maxPayloadSize = 1024 * 1024
+)
+
+// Controller is a controller for workflow event sources. It listens on the port of each webhook source, and
+// dispatches the events it receives to the source's workflow event bindings.
+type Controller struct {
+	wfClientset   versioned.Interface
+	kubeclientset kubernetes.Interface
+	config        config.WorkflowEventSources
+	// newWfClient returns a client that acts as the service account, to submit workflows with
+	newWfClient          func(namespace, serviceAccountName string) (versioned.Interface, error)
+	wfClients            sync.Map
+	managedNamespace     string
+	instanceID           string
+	instanceIDService    instanceid.Service
+	eventRecorderManager events.EventRecorderManager
+	informer             wfextvv1alpha1.WorkflowEventSourceInformer
+	// servers are the running webhook servers, by the key of their event source
+	servers   map[string]*webhookServer
+	serversMu sync.Mutex
+	logger    logging.Logger
+}
+
+type webhookServer struct {
+	port   int32
+	server *http.Server
+	// token is the token that requests must send, which is read from the secret each time the source is synced
+	token []byte
+}
+
+// NewController creates a new workflow event source controller
+func NewController(ctx context.Context, restConfig *rest.Config, wfClientset versioned.Interface, kubeclientset kubernetes.Interface, cfg config.WorkflowEventSources, managedNamespace, instanceID string, eventRecorderManager events.EventRecorderManager) *Controller {
+	_, logger := logging.RequireLoggerFromContext(ctx).WithField("component", "event_source").InContext(ctx)
+	c := &Controller{
+		wfClientset:   wfClientset,
+		kubeclientset: kubeclientset,
+		config:        cfg,
+		newWfClient: func(namespace, serviceAccountName string) (versioned.Interface, error) {
+			impersonating := rest.CopyConfig(restConfig)
+			impersonating.Impersonate = rest.ImpersonationConfig{UserName: fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccountName)}
+			return versioned.NewForConfig(impersonating)
+		},
+		managedNamespace:     managedNamespace,
+		instanceID:           instanceID,
+		instanceIDService:    instanceid.NewService(instanceID),
+		eventRecorderManager: eventRecorderManager,
+		servers:              make(map[string]*webhookServer),
+		logger:               logger,
+	}
+	c.informer = externalversions.NewSharedInformerFactoryWithOptions(
+		wfClientset,
+		eventSourceResyncPeriod,
+		externalversions.WithNamespace(managedNamespace),
+		externalversions.WithTweakListOptions(func(x *metav1.ListOptions) {
+			r := util.InstanceIDRequirement(instanceID)
+			x.LabelSelector = r.String()
+		})).Argoproj().V1alpha1().WorkflowEventSources()
+	return c
+}
+
+// Run starts the workflow event source controller, and stops the webhook servers when the context is done
+func (c *Controller) Run(ctx context.Context) {
+	defer runtimeutil.HandleCrashWithContext(ctx, runtimeutil.PanicHandlers...)
+	ctx = logging.WithLogger(ctx, c.logger)
+	c.logger.WithField("instanceID", c.instanceID).Info(ctx, "Starting WorkflowEventSource controller")
+
+	syncEventSource := func(obj interface{}) {
+		key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+		if err == nil {
+			c.sync(ctx, key)
+		}
+	}
+	//nolint:errcheck // the error only happens if the informer was stopped, and it hasn't even started (https://github.com/kubernetes/client-go/blob/46588f2726fa3e25b1704d6418190f424f95a990/tools/cache/shared_informer.go#L580)
+	c.informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    syncEventSource,
+		UpdateFunc: func(_, obj interface{}) { syncEventSource(obj) },
+		DeleteFunc: syncEventSource,
+	})
+	go c.informer.Informer().Run(ctx.Done())
+
+	<-ctx.Done()
+
+	c.serversMu.Lock()
+	defer c.serversMu.Unlock()
+	for key := range c.servers {
+		c.stop(ctx, key)
+	}
+}
+
+// sync starts, restarts or stops the webhook server of the event source
+func (c *Controller) sync(ctx context.Context, key string) {
+	c.serversMu.Lock()
+	defer c.serversMu.Unlock()
+	source, ok := c.getEventSource(key)
+	running := c.servers[key]
+	if !ok || source.Spec.Source.Webhook == nil {
+		c.stop(ctx, key)
+		return
+	}
+	webhook := source.Spec.Source.Webhook
+	token, err := c.getToken(ctx, source.Namespace, webhook.SecretRef)
+	if err == nil && !c.config.AllowsPort(webhook.Port) {
+		err = fmt.Errorf("port %d is not in the range of ports that webhooks may listen on, %d-%d", webhook.Port, c.config.MinPort, c.config.MaxPort)
+	}
+	if err != nil {
+		c.stop(ctx, key)
+		c.logger.WithError(err).WithField("eventSource", key).Error(ctx, "Failed to start webhook")
+		c.recordError(ctx, source, "failed to start webhook: "+err.Error())
+		return
+	}
+	if running != nil && running.port == webhook.Port {
+		// the server always dispatches to the latest bindings, so it only needs restarting when the port changes
+		running.token = token
+		return
+	}
+	c.stop(ctx, key)
+	if err := c.start(ctx, key, webhook.Port, token); err != nil {
+		c.logger.WithError(err).WithField("eventSource", key).Error(ctx, "Failed to start webhook")
+		c.recordError(ctx, source, "failed to start webhook: "+err.Error())
+	}
+}
+
+// getToken reads the token that requests to the webhook must send
+func (c *Controller) getToken(ctx context.Context, namespace string, ref *corev1.SecretKeySelector) ([]byte, error) {
+	if ref == nil {
+		return nil, fmt.Errorf("webhook.secretRef is required")
+	}
+	secret, err := c.kubeclientset.CoreV1().Secrets(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the webhook secret: %w", err)
+	}
+	token := secret.Data[ref.Key]
+	if len(token) == 0 {
+		return nil, fmt.Errorf("webhook secret %q does not have key %q", ref.Name, ref.Key)
+	}
+	return token, nil
+}
+
+// authorized returns whether the request sends the token of the webhook
+func (c *Controller) authorized(key string, r *http.Request) bool {
+	c.serversMu.Lock()
+	running, ok := c.servers[key]
+	var token []byte
+	if ok {
+		token = running.token
+	}
+	c.serversMu.Unlock()
+	bearer, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && found && subtle.ConstantTimeCompare([]byte(bearer), token) == 1
+}
+
+func (c *Controller) start(ctx context.Context, key string, port int32, token []byte) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Handler:           c.handler(key),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+	c.servers[key] = &webhookServer{port: port, server: server, token: token}
+	c.logger.WithFields(logging.Fields{"eventSource": key, "port": port}).Info(ctx, "Starting webhook")
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			c.logger.WithError(err).WithField("eventSource", key).Error(ctx, "Webhook stopped")
+		}
+	}()
+	return nil
+}
+
+func (c *Controller) stop(ctx context.Context, key string) {
+	running, ok := c.servers[key]
+	if !ok {
+		return
+	}
+	delete(c.servers, key)
+	c.logger.WithFields(logging.Fields{"eventSource": key, "port": running.port}).Info(ctx, "Stopping webhook")
+	if err := running.server.Close(); err != nil {
+		c.logger.WithError(err).WithField("eventSource", key).Warn(ctx, "Failed to stop webhook")
+	}
+}
+
+func (c *Controller) recordError(ctx context.Context, source *wfv1.WorkflowEventSource, message string) {
+	source = source.DeepCopy()
+	source.SetGroupVersionKind(wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowEventSourceKind))
+	c.eventRecorderManager.Get(ctx, source.Namespace).Event(source, corev1.EventTypeWarning, "WorkflowEventSourceError", message)
+}
+
+func (c *Controller) getEventSource(key string) (*wfv1.WorkflowEventSource, bool) {
+	obj, exists, err := c.informer.Informer().GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return nil, false
+	}
+	source, ok := obj.(*wfv1.WorkflowEventSource)
+	return source, ok
+}
+
+// handler dispatches the JSON body of each POST request to the bindings of the event source
+func (c *Controller) handler(key string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if r.Method != http.MethodPost {
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		if !c.authorized(key, r) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		source, ok := c.getEventSource(key)
+		if !ok {
+			http.Error(w, "event source not found", http.StatusNotFound)
+			return
+		}
+		data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		payload := &wfv1.Item{}
+		if err := json.Unmarshal(data, payload); err != nil {
+			http.Error(w, "payload must be JSON: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := c.dispatch(ctx, source, payload, r.Header); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+}
+
+// dispatch dispatches the event to the workflow event bindings of the event source, as the Argo Server does for the
+// events it receives, with the name of the event source as the discriminator
+func (c *Controller) dispatch(ctx context.Context, source *wfv1.WorkflowEventSource, payload *wfv1.Item, header http.Header) error {
+	var bindings []wfv1.WorkflowEventBinding
+	for _, ref := range source.Spec.Bindings {
+		binding, err := c.wfClientset.ArgoprojV1alpha1().WorkflowEventBindings(source.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			c.recordError(ctx, source, fmt.Sprintf("workflow event binding %q not found", ref.Name))
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get workflow event binding %q: %w", ref.Name, err)
+		}
+		// the kind is needed to record events for the binding
+		binding.SetGroupVersionKind(wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowEventBindingKind))
+		bindings = append(bindings, *binding)
+	}
+	// the operation submits workflows with the client in the context, and exposes the X- headers as the metadata
+	md := metadata.MD{}
+	for k, v := range header {
+		md.Append(strings.ToLower(k), v...)
+	}
+	md.Delete("authorization")
+	serviceAccountName := source.Spec.ServiceAccountName
+	if serviceAccountName == "" {
+		serviceAccountName = "default"
+	}
+	wfClient, err := c.getWfClient(source.Namespace, serviceAccountName)
+	if err != nil {
+		return err
+	}
+	ctx = metadata.NewIncomingContext(context.WithValue(ctx, auth.WfKey, wfClient), md)
+	operation, err := dispatch.NewOperation(ctx, c.instanceIDService, c.eventRecorderManager.Get(ctx, source.Namespace), bindings, source.Namespace, source.Name, payload)
+	if err != nil {
+		return err
+	}
+	return operation.Dispatch(ctx)
+}
+
+// getWfClient returns a client that acts as the service account, so that an event source can only submit the workflows
+// that its service account may create
+func (c *Controller) getWfClient(namespace, serviceAccountName string) (versioned.Interface, error) {
+	key := namespace + "/" + serviceAccountName
+	if client, ok := c.wfClients.Load(key); ok {
+		return client.(versioned.Interface), nil
+	}
+	client, err := c.newWfClient(namespace, serviceAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a client for service account %q: %w", key, err)
+	}
+	c.wfClients.Store(key, client)
+	return client, nil
+}
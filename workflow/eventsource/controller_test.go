@@ -0,0 +1,181 @@
+package eventsource
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakekube "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/events"
+)
+
+// freePort returns a port that is not in use
+func freePort(t *testing.T) int32 {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	return int32(listener.Addr().(*net.TCPAddr).Port)
+}
+
+func post(port int32, body string) (int, error) {
+	return postWithToken(port, "my-token", body)
+}
+
+func postWithToken(port int32, token string, body string) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://127.0.0.1:%d/", port), strings.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+func TestController(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	port := freePort(t)
+	source := &wfv1.WorkflowEventSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-source", Namespace: "my-ns"},
+		Spec: wfv1.WorkflowEventSourceSpec{
+			Source: wfv1.EventSource{Webhook: &wfv1.WebhookEventSource{
+				Port: port,
+				SecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+					Key:                  "token",
+				},
+			}},
+			Bindings:           []wfv1.WorkflowEventBindingRef{{Name: "my-binding"}, {Name: "missing"}},
+			ServiceAccountName: "my-sa",
+		},
+	}
+	wfClientset := fake.NewSimpleClientset(
+		source,
+		&wfv1.WorkflowEventBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-binding", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event: wfv1.Event{Selector: `discriminator == "my-source" && payload.message != ""`},
+				Submit: &wfv1.Submit{
+					WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"},
+					Arguments: &wfv1.Arguments{Parameters: []wfv1.Parameter{
+						{Name: "message", ValueFrom: &wfv1.ValueFrom{Event: "payload.message"}},
+					}},
+				},
+			},
+		},
+		// not listed by the event source
+		&wfv1.WorkflowEventBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-binding", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event:  wfv1.Event{Selector: "true"},
+				Submit: &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"}},
+			},
+		},
+		&wfv1.WorkflowTemplate{ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns"}},
+	)
+	kubeClientset := fakekube.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "my-ns"},
+		Data:       map[string][]byte{"token": []byte("my-token")},
+	})
+	c := NewController(ctx, nil, wfClientset, kubeClientset, config.WorkflowEventSources{MinPort: 1024, MaxPort: 65535}, "", "", events.NewEventRecorderManager(fakekube.NewSimpleClientset()))
+	// workflows are submitted as the service account of the event source
+	var serviceAccounts []string
+	c.newWfClient = func(namespace, serviceAccountName string) (versioned.Interface, error) {
+		serviceAccounts = append(serviceAccounts, namespace+"/"+serviceAccountName)
+		return wfClientset, nil
+	}
+	go c.Run(ctx)
+
+	// the webhook is started once the informer has synced
+	require.Eventually(t, func() bool {
+		status, err := post(port, `{"message": ""}`)
+		return err == nil && status == http.StatusOK
+	}, 10*time.Second, 50*time.Millisecond)
+
+	status, err := postWithToken(port, "wrong-token", `{"message": "hello"}`)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	status, err = post(port, `{"message": "hello"}`)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, []string{"my-ns/my-sa"}, serviceAccounts)
+	wfs, err := wfClientset.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, wfs.Items, 1)
+	assert.Equal(t, "my-wft", wfs.Items[0].Spec.WorkflowTemplateRef.Name)
+	assert.Equal(t, "hello", wfs.Items[0].Spec.Arguments.Parameters[0].Value.String())
+
+	status, err = post(port, `not-json`)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d/", port))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	// the webhook moves to the new port
+	newPort := freePort(t)
+	source.Spec.Source.Webhook.Port = newPort
+	_, err = wfClientset.ArgoprojV1alpha1().WorkflowEventSources("my-ns").Update(ctx, source, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		status, err := post(newPort, `{"message": ""}`)
+		return err == nil && status == http.StatusOK
+	}, 10*time.Second, 50*time.Millisecond)
+	_, err = post(port, `{"message": ""}`)
+	require.Error(t, err)
+
+	// and is stopped when the event source is deleted
+	require.NoError(t, wfClientset.ArgoprojV1alpha1().WorkflowEventSources("my-ns").Delete(ctx, "my-source", metav1.DeleteOptions{}))
+	require.Eventually(t, func() bool {
+		_, err := post(newPort, `{"message": ""}`)
+		return err != nil
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestControllerPortNotAllowed(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	port := freePort(t)
+	wfClientset := fake.NewSimpleClientset(&wfv1.WorkflowEventSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-source", Namespace: "my-ns"},
+		Spec: wfv1.WorkflowEventSourceSpec{
+			Source: wfv1.EventSource{Webhook: &wfv1.WebhookEventSource{
+				Port: port,
+				SecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+					Key:                  "token",
+				},
+			}},
+		},
+	})
+	kubeClientset := fakekube.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "my-ns"},
+		Data:       map[string][]byte{"token": []byte("my-token")},
+	})
+	c := NewController(ctx, nil, wfClientset, kubeClientset, config.WorkflowEventSources{MinPort: port + 1, MaxPort: port + 1}, "", "", events.NewEventRecorderManager(fakekube.NewSimpleClientset()))
+	go c.informer.Informer().Run(ctx.Done())
+	require.Eventually(t, func() bool { return c.informer.Informer().HasSynced() }, 10*time.Second, 50*time.Millisecond)
+	c.sync(ctx, "my-ns/my-source")
+	assert.Empty(t, c.servers)
+	_, err := post(port, `{"message": ""}`)
+	require.Error(t, err)
+}
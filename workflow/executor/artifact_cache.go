@@ -0,0 +1,117 @@
+package executor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	artifactcommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// artifactCache is a directory of the input artifacts downloaded by the pods of a workflow. When the directory is on a
+// volume shared by the pods, an artifact that is the input of several tasks, such as the output of a task that many
+// tasks of a DAG depend on, is only downloaded once.
+//
+// Artifacts are keyed by their location, which for an artifact passed with from is unique to the output artifact
+// and the node that saved it.
+type artifactCache struct {
+	dir   string
+	locks sync.Map // key -> *sync.Mutex
+}
+
+// newArtifactCache returns the cache of the workflow in the directory, or nil if the directory is not set
+func newArtifactCache(dir string, workflowUID string) *artifactCache {
+	if dir == "" {
+		return nil
+	}
+	return &artifactCache{dir: filepath.Join(dir, workflowUID)}
+}
+
+// load loads the artifact to the path, from the cache if it has already been downloaded, and otherwise with the
+// driver, after which it is added to the cache. Only files are cached.
+func (c *artifactCache) load(ctx context.Context, driver artifactcommon.ArtifactDriver, art *wfv1.Artifact, path string) error {
+	if c == nil {
+		return driver.Load(ctx, art, path)
+	}
+	logger := logging.RequireLoggerFromContext(ctx)
+	key, err := artifactCacheKey(art)
+	if err != nil {
+		return err
+	}
+	// artifacts with the same location in the same pod are downloaded once
+	lock, _ := c.locks.LoadOrStore(key, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	cached := filepath.Join(c.dir, key)
+	if _, err := os.Stat(cached); err == nil {
+		logger.WithFields(logging.Fields{"name": art.Name, "cached": cached}).Info(ctx, "Loading artifact from the cache")
+		// the file is copied rather than linked, so that the main container cannot change the cached file
+		return copyFile(cached, path)
+	}
+	if err := driver.Load(ctx, art, path); err != nil {
+		return err
+	}
+	if err := c.add(path, cached); err != nil {
+		logger.WithError(err).WithField("name", art.Name).Warn(ctx, "Failed to add artifact to the cache")
+	}
+	return nil
+}
+
+// add adds the downloaded file to the cache. It is renamed into place, so that other pods never see a partial file.
+func (c *artifactCache) add(path, cached string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(c.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	_ = tmp.Close()
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if err := copyFile(path, tmp.Name()); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), cached)
+}
+
+func artifactCacheKey(art *wfv1.Artifact) (string, error) {
+	data, err := json.Marshal(art.ArtifactLocation)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the location of artifact %q: %w", art.Name, err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,109 @@
+package executor
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// countingArtifactServer serves every artifact, and counts the downloads
+func countingArtifactServer() (*httptest.Server, *atomic.Int32) {
+	var downloads atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads.Add(1)
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+	return server, &downloads
+}
+
+// sharedInputArtifact is an input artifact passed from the output of the same task
+func sharedInputArtifact(url, name string) wfv1.Artifact {
+	return wfv1.Artifact{
+		Name:             name,
+		Path:             "/tmp/" + name,
+		ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: url + "/my-wf-1234/result"}},
+		Archive:          &wfv1.ArchiveStrategy{None: &wfv1.NoneStrategy{}},
+	}
+}
+
+func TestWorkflowExecutor_LoadArtifactsCache(t *testing.T) {
+	server, downloads := countingArtifactServer()
+	defer server.Close()
+	t.Setenv(common.EnvVarArtifactCacheDir, t.TempDir())
+	t.Setenv(common.EnvVarConcurrentDownloads, "2")
+	defer func() { inputArtifactsDir = common.ExecutorArtifactBaseDir }()
+
+	ctx := logging.TestContext(t.Context())
+	for task := range 3 {
+		inputArtifactsDir = t.TempDir()
+		we := WorkflowExecutor{
+			workflowUID: "my-uid",
+			Template: wfv1.Template{
+				Inputs: wfv1.Inputs{Artifacts: []wfv1.Artifact{
+					sharedInputArtifact(server.URL, "a"),
+					sharedInputArtifact(server.URL, "b"),
+				}},
+			},
+		}
+		require.NoError(t, we.LoadArtifacts(ctx), "task %d", task)
+		for _, name := range []string{"a", "b"} {
+			data, err := os.ReadFile(filepath.Join(inputArtifactsDir, name))
+			require.NoError(t, err)
+			assert.Equal(t, "/my-wf-1234/result", string(data))
+		}
+	}
+	// downloaded once by the first task
+	assert.Equal(t, int32(1), downloads.Load())
+
+	// the cache is not shared by workflows
+	inputArtifactsDir = t.TempDir()
+	we := WorkflowExecutor{
+		workflowUID: "other-uid",
+		Template:    wfv1.Template{Inputs: wfv1.Inputs{Artifacts: []wfv1.Artifact{sharedInputArtifact(server.URL, "a")}}},
+	}
+	require.NoError(t, we.LoadArtifacts(ctx))
+	assert.Equal(t, int32(2), downloads.Load())
+}
+
+// BenchmarkLoadArtifactsSharedInputs loads the same input artifact in each of the 20 tasks of a DAG, and reports the
+// number of downloads
+func BenchmarkLoadArtifactsSharedInputs(b *testing.B) {
+	server, downloads := countingArtifactServer()
+	defer server.Close()
+	defer func() { inputArtifactsDir = common.ExecutorArtifactBaseDir }()
+
+	for name, cacheDir := range map[string]string{"NoCache": "", "Cache": b.TempDir()} {
+		b.Run(name, func(b *testing.B) {
+			b.Setenv(common.EnvVarArtifactCacheDir, cacheDir)
+			ctx := logging.TestContext(b.Context())
+			downloads.Store(0)
+			workflows := 0
+			for b.Loop() {
+				workflows++
+				for range 20 {
+					inputArtifactsDir = b.TempDir()
+					we := WorkflowExecutor{
+						workflowUID: types.UID(fmt.Sprintf("wf-%d", workflows)),
+						Template: wfv1.Template{
+							Inputs: wfv1.Inputs{Artifacts: []wfv1.Artifact{sharedInputArtifact(server.URL, "result")}},
+						},
+					}
+					require.NoError(b, we.LoadArtifacts(ctx))
+				}
+			}
+			b.ReportMetric(float64(downloads.Load())/float64(workflows), "downloads/op")
+		})
+	}
+}
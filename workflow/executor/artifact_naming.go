@@ -0,0 +1,126 @@
+package executor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// artifactFileName returns the file name of the key of an output artifact, renamed by its rename policy, with the
+// suffix of its naming strategy
+func (we *WorkflowExecutor) artifactFileName(ctx context.Context, art *wfv1.Artifact, key, fileName, localArtPath string) (string, error) {
+	if art.RenamePolicy != nil {
+		to := art.RenamePolicy.To
+		if to == "" || to == "." || to == ".." || path.Base(to) != to {
+			return "", fmt.Errorf("artifact %q has an invalid renamePolicy.to %q, it must be a file name", art.Name, to)
+		}
+		fileName = to
+	}
+	if art.Naming == nil {
+		return fileName, nil
+	}
+	switch art.Naming.Strategy {
+	case wfv1.ArtifactNamingHash:
+		sum, err := hashArtifact(localArtPath)
+		if err != nil {
+			return "", fmt.Errorf("failed to hash artifact %q: %w", art.Name, err)
+		}
+		return addFileNameSuffix(fileName, sum), nil
+	case wfv1.ArtifactNamingSequential:
+		existing, err := we.listArtifactKeys(ctx, key)
+		if err != nil {
+			return "", fmt.Errorf("failed to list the keys of artifact %q: %w", art.Name, err)
+		}
+		return addFileNameSuffix(fileName, strconv.Itoa(nextSequence(fileName, existing))), nil
+	case wfv1.ArtifactNamingTimestamp:
+		return addFileNameSuffix(fileName, strconv.FormatInt(time.Now().UnixNano(), 10)), nil
+	default:
+		return "", fmt.Errorf("artifact %q has an invalid naming strategy %q", art.Name, art.Naming.Strategy)
+	}
+}
+
+// listArtifactKeys lists the keys already saved under the key of the archive location
+func (we *WorkflowExecutor) listArtifactKeys(ctx context.Context, key string) ([]string, error) {
+	artLocation, err := we.Template.ArchiveLocation.Get()
+	if err != nil {
+		return nil, err
+	}
+	dir := &wfv1.Artifact{Name: "archive-location"}
+	if err := dir.SetType(artLocation); err != nil {
+		return nil, err
+	}
+	if err := dir.SetKey(key); err != nil {
+		return nil, err
+	}
+	driverArt, err := we.newDriverArt(dir)
+	if err != nil {
+		return nil, err
+	}
+	artDriver, err := we.InitDriver(ctx, driverArt)
+	if err != nil {
+		return nil, err
+	}
+	return artDriver.ListObjects(ctx, driverArt)
+}
+
+// addFileNameSuffix adds the suffix to the file name, before its extension
+func addFileNameSuffix(fileName, suffix string) string {
+	ext := path.Ext(fileName)
+	return fileName[:len(fileName)-len(ext)] + "-" + suffix + ext
+}
+
+// nextSequence returns one more than the highest sequence number of the file name in the existing keys, starting at 1
+func nextSequence(fileName string, existing []string) int {
+	ext := path.Ext(fileName)
+	re := regexp.MustCompile("^" + regexp.QuoteMeta(fileName[:len(fileName)-len(ext)]) + `-(\d+)` + regexp.QuoteMeta(ext) + "$")
+	next := 1
+	for _, key := range existing {
+		m := re.FindStringSubmatch(path.Base(key))
+		if m == nil {
+			continue
+		}
+		if n, err := strconv.Atoi(m[1]); err == nil && n >= next {
+			next = n + 1
+		}
+	}
+	return next
+}
+
+// hashArtifact returns the SHA256 of the file, or of the relative paths and contents of the files in the directory
+func hashArtifact(localArtPath string) (string, error) {
+	h := sha256.New()
+	err := filepath.WalkDir(localArtPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		if p != localArtPath {
+			rel, err := filepath.Rel(localArtPath, p)
+			if err != nil {
+				return err
+			}
+			_, _ = h.Write([]byte(filepath.ToSlash(rel) + "\x00"))
+		}
+		f, err := os.Open(filepath.Clean(p))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(h, f)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,131 @@
+package executor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestArtifactFileName(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	we := &WorkflowExecutor{}
+	localArtPath := filepath.Join(t.TempDir(), "my-art.tgz")
+	require.NoError(t, os.WriteFile(localArtPath, []byte("my-content"), 0o600))
+
+	t.Run("Default", func(t *testing.T) {
+		fileName, err := we.artifactFileName(ctx, &wfv1.Artifact{Name: "my-art"}, "my-key", "my-art.tgz", localArtPath)
+		require.NoError(t, err)
+		assert.Equal(t, "my-art.tgz", fileName)
+	})
+
+	t.Run("Hash", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "my-art", Naming: &wfv1.ArtifactNaming{Strategy: wfv1.ArtifactNamingHash}}
+		fileName, err := we.artifactFileName(ctx, art, "my-key", "my-art.tgz", localArtPath)
+		require.NoError(t, err)
+		sum := sha256.Sum256([]byte("my-content"))
+		assert.Equal(t, "my-art-"+hex.EncodeToString(sum[:])+".tgz", fileName)
+	})
+
+	t.Run("HashDirectory", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0o600))
+		first, err := hashArtifact(dir)
+		require.NoError(t, err)
+		require.NoError(t, os.Rename(filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt")))
+		second, err := hashArtifact(dir)
+		require.NoError(t, err)
+		// the paths of the files are part of the hash
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("Sequential", func(t *testing.T) {
+		assert.Equal(t, 1, nextSequence("my-art.tgz", nil))
+		assert.Equal(t, 3, nextSequence("my-art.tgz", []string{
+			"my-key/my-art-1.tgz",
+			"my-key/my-art-2.tgz",
+			"my-key/other-art-7.tgz",
+			"my-key/my-art-9.zip",
+			"my-key/my-art-x.tgz",
+		}))
+		assert.Equal(t, "my-art-3.tgz", addFileNameSuffix("my-art.tgz", "3"))
+		assert.Equal(t, "my-dir-3", addFileNameSuffix("my-dir", "3"))
+	})
+
+	t.Run("Timestamp", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "my-art", Naming: &wfv1.ArtifactNaming{Strategy: wfv1.ArtifactNamingTimestamp}}
+		before := time.Now().UnixNano()
+		fileName, err := we.artifactFileName(ctx, art, "my-key", "my-art.tgz", localArtPath)
+		require.NoError(t, err)
+		after := time.Now().UnixNano()
+		require.True(t, strings.HasPrefix(fileName, "my-art-") && strings.HasSuffix(fileName, ".tgz"), fileName)
+		ts, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(fileName, "my-art-"), ".tgz"), 10, 64)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, ts, before)
+		assert.LessOrEqual(t, ts, after)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "my-art", Naming: &wfv1.ArtifactNaming{Strategy: "random"}}
+		_, err := we.artifactFileName(ctx, art, "my-key", "my-art.tgz", localArtPath)
+		require.EqualError(t, err, `artifact "my-art" has an invalid naming strategy "random"`)
+	})
+
+	t.Run("Rename", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "my-art", RenamePolicy: &wfv1.ArtifactRenamePolicy{To: "report.csv"}}
+		fileName, err := we.artifactFileName(ctx, art, "my-key", "data.csv", localArtPath)
+		require.NoError(t, err)
+		assert.Equal(t, "report.csv", fileName)
+
+		art.Naming = &wfv1.ArtifactNaming{Strategy: wfv1.ArtifactNamingHash}
+		fileName, err = we.artifactFileName(ctx, art, "my-key", "data.csv", localArtPath)
+		require.NoError(t, err)
+		sum := sha256.Sum256([]byte("my-content"))
+		assert.Equal(t, "report-"+hex.EncodeToString(sum[:])+".csv", fileName)
+	})
+
+	t.Run("InvalidRename", func(t *testing.T) {
+		for _, to := range []string{"", "..", "reports/report.csv"} {
+			art := &wfv1.Artifact{Name: "my-art", RenamePolicy: &wfv1.ArtifactRenamePolicy{To: to}}
+			_, err := we.artifactFileName(ctx, art, "my-key", "data.csv", localArtPath)
+			require.EqualError(t, err, `artifact "my-art" has an invalid renamePolicy.to "`+to+`", it must be a file name`)
+		}
+	})
+}
+
+func TestSetArtifactKeyRenamePolicy(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	we := &WorkflowExecutor{Template: wfv1.Template{
+		ArchiveLocation: &wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, Key: "my-wf/my-pod"}},
+	}}
+	localArtPath := filepath.Join(t.TempDir(), "data.csv")
+	require.NoError(t, os.WriteFile(localArtPath, []byte("a,b"), 0o600))
+
+	// a single file saved with the none archive strategy is keyed by its base name
+	art := &wfv1.Artifact{Name: "my-art", Archive: &wfv1.ArchiveStrategy{None: &wfv1.NoneStrategy{}}}
+	require.NoError(t, we.setArtifactKey(ctx, art, "data.csv", localArtPath))
+	assert.Equal(t, "my-wf/my-pod/data.csv", art.S3.Key)
+
+	art = &wfv1.Artifact{
+		Name:         "my-art",
+		Archive:      &wfv1.ArchiveStrategy{None: &wfv1.NoneStrategy{}},
+		RenamePolicy: &wfv1.ArtifactRenamePolicy{To: "custom-name.csv"},
+	}
+	require.NoError(t, we.setArtifactKey(ctx, art, "data.csv", localArtPath))
+	assert.Equal(t, "my-wf/my-pod/custom-name.csv", art.S3.Key)
+	// the driver saves to the renamed key in the archive location
+	driverArt, err := we.newDriverArt(art)
+	require.NoError(t, err)
+	assert.Equal(t, "my-bucket", driverArt.S3.Bucket)
+	assert.Equal(t, "my-wf/my-pod/custom-name.csv", driverArt.S3.Key)
+}
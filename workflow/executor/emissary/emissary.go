@@ -36,7 +36,7 @@ In the main container, the emissary creates these files:
 
 If the container is named `main` it also copies base-layer artifacts to the shared volume:
 
-* `/var/run/argo/outputs/parameters/${path}` All output parameters are copied here, e.g. `/tmp/message` is moved to `/var/run/argo/outputs/parameters/tmp/message`.
+* `/var/run/argo/outputs/parameters/${path}` All output parameters are copied here, e.g. `/tmp/message` is moved to `/var/run/argo/outputs/parameters/tmp/message`. So are the artifacts that output parameters apply a `jqFilter` to.
 * `/var/run/argo/outputs/artifacts/${path}.tgz` All output artifacts are copied here, e.g. `/tmp/message` is moved to /var/run/argo/outputs/artifacts/tmp/message.tgz`.
 
 The wait container can create one file itself, used for terminating the sub-process:
@@ -133,6 +133,31 @@ func (e emissary) isComplete(containerNames []string) bool {
 	return true
 }
 
+func (e emissary) Glob(_ context.Context, _ string, pattern string) ([]string, error) {
+	// the emissary binary saves each path matching the pattern, so the saved archives are globbed instead
+	dir := filepath.Join(common.VarRunArgoPath, "outputs", "artifacts")
+	archives, err := filepath.Glob(filepath.Join(dir, strings.TrimSuffix(pattern, "/")+".tgz"))
+	if err != nil {
+		return nil, err
+	}
+	var matches []string
+	for _, a := range archives {
+		matches = append(matches, strings.TrimSuffix(strings.TrimPrefix(a, dir), ".tgz"))
+	}
+	return matches, nil
+}
+
+func (e emissary) Signal(ctx context.Context, containerNames []string, sig syscall.Signal) error {
+	for _, containerName := range containerNames {
+		// allow write-access by other users, because other containers
+		// should delete the signal after receiving it
+		if err := os.WriteFile(filepath.Join(common.VarRunArgoPath, "ctr", containerName, "signal"), []byte(strconv.Itoa(int(sig))), 0o666); err != nil { //nolint:gosec
+			return err
+		}
+	}
+	return nil
+}
+
 func (e emissary) Kill(ctx context.Context, containerNames []string, terminationGracePeriodDuration time.Duration) error {
 	for _, containerName := range containerNames {
 		// allow write-access by other users, because other containers
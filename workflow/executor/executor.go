@@ -6,6 +6,7 @@ import (
 	"bufio"
 	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,14 +17,18 @@ import (
 	"path"
 	"path/filepath"
 	"runtime/debug"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 
 	"github.com/argoproj/argo-workflows/v3/util/file"
 
+	"golang.org/x/sync/errgroup"
 	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,18 +43,22 @@ import (
 	argoprojv1 "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util"
 	"github.com/argoproj/argo-workflows/v3/util/archive"
+	"github.com/argoproj/argo-workflows/v3/util/env"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/retry"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
 	artifactcommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/executor/osspecific"
 	executorretry "github.com/argoproj/argo-workflows/v3/workflow/executor/retry"
 )
 
 const (
 	// This directory temporarily stores the tarballs of the artifacts before uploading
 	tempOutArtDir = "/tmp/argo/outputs/artifacts"
+	// This directory temporarily stores the container logs before uploading
+	tempLogsDir = "/tmp/argo/outputs/logs"
 )
 
 // WorkflowExecutor is program which runs as the init/wait container
@@ -68,6 +77,8 @@ type WorkflowExecutor struct {
 	Namespace           string
 	RuntimeExecutor     ContainerRuntimeExecutor
 
+	// guards the memoized configmaps and secrets, as artifacts can be loaded concurrently
+	memoizedLock sync.Mutex
 	// memoized configmaps
 	memoizedConfigMaps map[string]string
 	// memoized secrets
@@ -84,12 +95,25 @@ type WorkflowExecutor struct {
 
 	// flag to indicate if the task result was created
 	taskResultCreated bool
+
+	// the uploads of output artifacts from named pipes, by artifact name
+	namedPipeUploads map[string]*namedPipeUpload
 }
 
 type Initializer interface {
 	Init(tmpl wfv1.Template) error
 }
 
+// Signaler is implemented by runtime executors which can send a signal to the main containers
+type Signaler interface {
+	Signal(ctx context.Context, containerNames []string, sig syscall.Signal) error
+}
+
+// Globber is implemented by runtime executors which can expand a glob against a container's base image layer
+type Globber interface {
+	Glob(ctx context.Context, containerName string, pattern string) ([]string, error)
+}
+
 // ContainerRuntimeExecutor is the interface for interacting with a container runtime
 type ContainerRuntimeExecutor interface {
 	// GetFileContents returns the file contents of a file in a container as a string
@@ -174,104 +198,141 @@ func (we *WorkflowExecutor) HandleError(ctx context.Context) {
 func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 	logger := logging.RequireLoggerFromContext(ctx)
 	logger.Info(ctx, "Start loading input artifacts...")
+	concurrentDownloads := env.LookupEnvIntOr(ctx, common.EnvVarConcurrentDownloads, 1)
+	if concurrentDownloads < 1 {
+		concurrentDownloads = 1
+	}
+	cache := newArtifactCache(os.Getenv(common.EnvVarArtifactCacheDir), string(we.workflowUID))
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrentDownloads)
 	for _, art := range we.Template.Inputs.Artifacts {
-
-		logger.WithField("name", art.Name).Info(ctx, "Downloading artifact")
-
-		if !art.HasLocationOrKey() {
-			if art.Optional {
-				logger.WithField("name", art.Name).Warn(ctx, "Ignoring optional artifact which was not supplied")
-				continue
-			} else {
-				return argoerrs.Errorf(argoerrs.CodeNotFound, "required artifact '%s' not supplied", art.Name)
+		g.Go(func() error {
+			// do not start any more downloads once one has failed
+			if err := ctx.Err(); err != nil {
+				return err
 			}
+			return we.loadArtifact(ctx, art, cache)
+		})
+	}
+	return g.Wait()
+}
+
+// loadArtifact loads a single input artifact to its path in the container
+func (we *WorkflowExecutor) loadArtifact(ctx context.Context, art wfv1.Artifact, cache *artifactCache) error {
+	logger := logging.RequireLoggerFromContext(ctx)
+	logger.WithField("name", art.Name).Info(ctx, "Downloading artifact")
+	if !art.HasLocationOrKey() {
+		if art.Optional {
+			logger.WithField("name", art.Name).Warn(ctx, "Ignoring optional artifact which was not supplied")
+			return recordAbsentArtifact(art.Name)
+		}
+		return argoerrs.Errorf(argoerrs.CodeNotFound, "required artifact '%s' not supplied", art.Name)
+	}
+	err := art.CleanPath()
+	if err != nil {
+		return err
+	}
+	if art.FromImageLabel != nil {
+		err = we.resolveImageLabel(ctx, &art)
+		if art.Optional && argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+			logger.WithField("name", art.Name).Info(ctx, "Skipping optional input artifact whose image label was not found")
+			return recordAbsentArtifact(art.Name)
 		}
-		err := art.CleanPath()
 		if err != nil {
 			return err
 		}
-		driverArt, err := we.newDriverArt(&art)
-		if err != nil {
-			return fmt.Errorf("failed to load artifact '%s': %w", art.Name, err)
+	}
+	driverArt, err := we.newDriverArt(&art)
+	if err != nil {
+		return fmt.Errorf("failed to load artifact '%s': %w", art.Name, err)
+	}
+	artDriver, err := we.InitDriver(ctx, driverArt)
+	if err != nil {
+		return err
+	}
+	// Determine the file path of where to load the artifact
+	var artPath string
+	mnt := common.FindOverlappingVolume(&we.Template, art.Path)
+	if mnt == nil {
+		artPath = path.Join(inputArtifactsDir, art.Name)
+	} else {
+		// If we get here, it means the input artifact path overlaps with a user-specified
+		// volumeMount in the container. Because we also implement input artifacts as volume
+		// mounts, we need to load the artifact into the user specified volume mount,
+		// as opposed to the `input-artifacts` volume that is an implementation detail
+		// unbeknownst to the user.
+		logger.WithFields(logging.Fields{"path": art.Path, "mountPath": mnt.MountPath}).Info(ctx, "Specified artifact path overlaps with volume mount, extracting to volume mount")
+		artPath = path.Join(common.ExecutorMainFilesystemDir, art.Path)
+	}
+
+	// The artifact is downloaded to a temporary location, after which we determine if
+	// the file is a tarball or not. If it is, it is first extracted then renamed to
+	// the desired location. If not, it is simply renamed to the location.
+	tempArtPath := artPath + ".tmp"
+	// Ensure parent directory exist, create if missing
+	tempArtDir := filepath.Dir(tempArtPath)
+	if err := os.MkdirAll(tempArtDir, 0o700); err != nil {
+		return fmt.Errorf("failed to create artifact temporary parent directory %s: %w", tempArtDir, err)
+	}
+	err = cache.load(ctx, artDriver, driverArt, tempArtPath)
+	if err != nil {
+		if art.Optional && argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+			logger.WithField("name", art.Name).Info(ctx, "Skipping optional input artifact that was not found")
+			return recordAbsentArtifact(art.Name)
 		}
-		artDriver, err := we.InitDriver(ctx, driverArt)
+		return fmt.Errorf("artifact %s failed to load: %w", art.Name, err)
+	}
+
+	isTar := false
+	isZip := false
+	if art.UnpackTo != "" {
+		// extracted to the unpackTo directory instead
+		isTar = false
+		isZip = false
+	} else if art.GetArchive().None != nil {
+		// explicitly not a tar
+		isTar = false
+		isZip = false
+	} else if art.GetArchive().Tar != nil {
+		// explicitly a tar
+		isTar = true
+	} else if art.GetArchive().Zip != nil {
+		// explicitly a zip
+		isZip = true
+	} else {
+		// auto-detect if tarball
+		// (don't try to autodetect zip files for backwards compatibility)
+		isTar, err = isTarball(ctx, tempArtPath)
 		if err != nil {
 			return err
 		}
-		// Determine the file path of where to load the artifact
-		var artPath string
-		mnt := common.FindOverlappingVolume(&we.Template, art.Path)
-		if mnt == nil {
-			artPath = path.Join(common.ExecutorArtifactBaseDir, art.Name)
-		} else {
-			// If we get here, it means the input artifact path overlaps with a user-specified
-			// volumeMount in the container. Because we also implement input artifacts as volume
-			// mounts, we need to load the artifact into the user specified volume mount,
-			// as opposed to the `input-artifacts` volume that is an implementation detail
-			// unbeknownst to the user.
-			logger.WithFields(logging.Fields{"path": art.Path, "mountPath": mnt.MountPath}).Info(ctx, "Specified artifact path overlaps with volume mount, extracting to volume mount")
-			artPath = path.Join(common.ExecutorMainFilesystemDir, art.Path)
-		}
-
-		// The artifact is downloaded to a temporary location, after which we determine if
-		// the file is a tarball or not. If it is, it is first extracted then renamed to
-		// the desired location. If not, it is simply renamed to the location.
-		tempArtPath := artPath + ".tmp"
-		// Ensure parent directory exist, create if missing
-		tempArtDir := filepath.Dir(tempArtPath)
-		if err := os.MkdirAll(tempArtDir, 0o700); err != nil {
-			return fmt.Errorf("failed to create artifact temporary parent directory %s: %w", tempArtDir, err)
-		}
-		err = artDriver.Load(ctx, driverArt, tempArtPath)
-		if err != nil {
-			if art.Optional && argoerrs.IsCode(argoerrs.CodeNotFound, err) {
-				logger.WithField("name", art.Name).Info(ctx, "Skipping optional input artifact that was not found")
-				continue
-			}
-			return fmt.Errorf("artifact %s failed to load: %w", art.Name, err)
-		}
-
-		isTar := false
-		isZip := false
-		if art.GetArchive().None != nil {
-			// explicitly not a tar
-			isTar = false
-			isZip = false
-		} else if art.GetArchive().Tar != nil {
-			// explicitly a tar
-			isTar = true
-		} else if art.GetArchive().Zip != nil {
-			// explicitly a zip
-			isZip = true
-		} else {
-			// auto-detect if tarball
-			// (don't try to autodetect zip files for backwards compatibility)
-			isTar, err = isTarball(ctx, tempArtPath)
-			if err != nil {
-				return err
-			}
-		}
+	}
 
-		if isTar {
-			err = untar(tempArtPath, artPath)
-			_ = os.Remove(tempArtPath)
-		} else if isZip {
-			err = unzip(ctx, tempArtPath, artPath)
-			_ = os.Remove(tempArtPath)
-		} else {
-			err = os.Rename(tempArtPath, artPath)
-		}
+	if isTar {
+		err = untar(tempArtPath, artPath)
+		_ = os.Remove(tempArtPath)
+	} else if isZip {
+		err = unzip(ctx, tempArtPath, artPath)
+		_ = os.Remove(tempArtPath)
+	} else {
+		err = os.Rename(tempArtPath, artPath)
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.WithField("path", artPath).Info(ctx, "Successfully download file")
+	if art.Mode != nil {
+		err = chmod(artPath, *art.Mode, art.RecurseMode)
 		if err != nil {
 			return err
 		}
-
-		logger.WithField("path", artPath).Info(ctx, "Successfully download file")
-		if art.Mode != nil {
-			err = chmod(artPath, *art.Mode, art.RecurseMode)
-			if err != nil {
-				return err
-			}
+	}
+	if art.UnpackTo != "" {
+		if err := unpackArchive(ctx, artPath, filepath.Join(unpackToDir, art.UnpackTo)); err != nil {
+			return fmt.Errorf("failed to unpack artifact %s to %s: %w", art.Name, art.UnpackTo, err)
 		}
+		logger.WithField("unpackTo", art.UnpackTo).Info(ctx, "Successfully unpacked artifact")
 	}
 	return nil
 }
@@ -286,7 +347,11 @@ func (we *WorkflowExecutor) StageFiles(ctx context.Context) error {
 	case wfv1.TemplateTypeScript:
 		logger.WithField("path", common.ExecutorScriptSourcePath).Info(ctx, "Loading script source")
 		filePath = common.ExecutorScriptSourcePath
-		body = []byte(we.Template.Script.Source)
+		source, err := we.scriptSource()
+		if err != nil {
+			return err
+		}
+		body = []byte(source)
 		mode = os.FileMode(0o755)
 	case wfv1.TemplateTypeResource:
 		if we.Template.Resource.ManifestFrom != nil && we.Template.Resource.ManifestFrom.Artifact != nil {
@@ -322,13 +387,38 @@ func (we *WorkflowExecutor) SaveArtifacts(ctx context.Context) (wfv1.Artifacts,
 	}
 
 	aggregateError := ""
+	var outputArtifacts wfv1.Artifacts
 	for _, art := range we.Template.Outputs.Artifacts {
-		saved, err := we.saveArtifact(ctx, common.MainContainerName, &art)
+		if art.GlobPath == "" {
+			outputArtifacts = append(outputArtifacts, art)
+			continue
+		}
+		expanded, err := we.expandGlobArtifact(ctx, common.MainContainerName, art)
+		if err != nil {
+			aggregateError += err.Error() + "; "
+		}
+		outputArtifacts = append(outputArtifacts, expanded...)
+	}
+	for _, art := range outputArtifacts {
+		// the logs of artifacts in the archive location are archived with the template's, by SaveLogs
+		ownLocation := art.HasLocation()
+		var saved bool
+		var err error
+		if art.NamedPipe != nil {
+			saved, err = we.finishNamedPipeArtifact(ctx, &art)
+		} else {
+			saved, err = we.saveArtifact(ctx, common.MainContainerName, &art)
+		}
 		if err != nil {
 			aggregateError += err.Error() + "; "
 		}
 		if saved {
 			artifacts = append(artifacts, art)
+			if ownLocation && we.archiveLogsWith(&art) {
+				if err := we.saveLogsWithArtifact(ctx, &art); err != nil {
+					aggregateError += err.Error() + "; "
+				}
+			}
 		}
 	}
 	if aggregateError == "" {
@@ -338,16 +428,103 @@ func (we *WorkflowExecutor) SaveArtifacts(ctx context.Context) (wfv1.Artifacts,
 	}
 }
 
+// archiveLogsWith returns whether the logs should be archived with an output artifact. An artifact that does not set
+// archiveLogs follows the template, which the controller sets from the workflow and the artifact repository.
+func (we *WorkflowExecutor) archiveLogsWith(art *wfv1.Artifact) bool {
+	if art.ArchiveLogs != nil {
+		return *art.ArchiveLogs
+	}
+	return we.Template.ArchiveLocation.IsArchiveLogs()
+}
+
+// saveLogsWithArtifact archives the main container's logs next to an output artifact saved in its own location
+func (we *WorkflowExecutor) saveLogsWithArtifact(ctx context.Context, art *wfv1.Artifact) error {
+	key, err := art.GetKey()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(tempLogsDir, os.ModePerm); err != nil {
+		return argoerrs.InternalWrapError(err)
+	}
+	fileName := common.MainContainerName + ".log"
+	filePath := path.Join(tempLogsDir, art.Name+"-"+fileName)
+	if err := we.saveLogToFile(ctx, common.MainContainerName, filePath); err != nil {
+		return err
+	}
+	logArt := &wfv1.Artifact{Name: art.Name + "-logs", ArtifactLocation: *art.ArtifactLocation.DeepCopy()}
+	if err := logArt.SetKey(path.Join(path.Dir(key), fileName)); err != nil {
+		return err
+	}
+	return we.saveArtifactFromFile(ctx, logArt, fileName, filePath)
+}
+
+// expandGlobArtifact returns one artifact named <name>-<index> for each container path matching art.GlobPath.
+// No matches is not an error, as the glob may legitimately match nothing.
+func (we *WorkflowExecutor) expandGlobArtifact(ctx context.Context, containerName string, art wfv1.Artifact) (wfv1.Artifacts, error) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	var matches []string
+	if !we.isBaseImagePath(art.GlobPath) {
+		var err error
+		matches, err = globPaths(common.ExecutorMainFilesystemDir, art.GlobPath)
+		if err != nil {
+			return nil, argoerrs.Errorf(argoerrs.CodeBadRequest, "artifact %s globPath is invalid: %v", art.Name, err)
+		}
+	} else {
+		globber, ok := we.RuntimeExecutor.(Globber)
+		if !ok {
+			return nil, fmt.Errorf("artifact %s globPath is not supported by this executor", art.Name)
+		}
+		var err error
+		matches, err = globber.Glob(ctx, containerName, art.GlobPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(matches) == 0 {
+		logger.WithFields(logging.Fields{"name": art.Name, "globPath": art.GlobPath}).Warn(ctx, "No paths matched artifact globPath")
+		return nil, nil
+	}
+	sort.Strings(matches)
+	artifacts := make(wfv1.Artifacts, len(matches))
+	for i, match := range matches {
+		expanded := *art.DeepCopy()
+		expanded.Name = fmt.Sprintf("%s-%d", art.Name, i)
+		expanded.GlobPath = ""
+		expanded.Path = match
+		artifacts[i] = expanded
+	}
+	return artifacts, nil
+}
+
+// globPaths returns the paths matching pattern within the root directory, as paths relative to root
+func globPaths(root, pattern string) ([]string, error) {
+	found, err := filepath.Glob(filepath.Join(root, pattern))
+	if err != nil {
+		return nil, err
+	}
+	var matches []string
+	for _, f := range found {
+		matches = append(matches, strings.TrimPrefix(f, root))
+	}
+	return matches, nil
+}
+
 // save artifact
 // return whether artifact was in fact saved, and if there was an error
 func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName string, art *wfv1.Artifact) (bool, error) {
 	logger := logging.RequireLoggerFromContext(ctx)
-	// Determine the file path of where to find the artifact
-	err := art.CleanPath()
-	if err != nil {
-		return false, err
+	var fileName, localArtPath string
+	var err error
+	if len(art.Paths) > 0 {
+		fileName, localArtPath, err = we.stagePathsArchiveFile(ctx, containerName, art)
+	} else {
+		// Determine the file path of where to find the artifact
+		err = art.CleanPath()
+		if err != nil {
+			return false, err
+		}
+		fileName, localArtPath, err = we.stageArchiveFile(ctx, containerName, art)
 	}
-	fileName, localArtPath, err := we.stageArchiveFile(ctx, containerName, art)
 	if err != nil {
 		if art.Optional && argoerrs.IsCode(argoerrs.CodeNotFound, err) {
 			logger.WithField("name", art.Name).WithField("path", art.Path).WithError(err).Warn(ctx, "Ignoring optional artifact which does not exist in path")
@@ -363,25 +540,32 @@ func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName stri
 	if size == 0 {
 		logger.WithField("path", localArtPath).Warn(ctx, "The file is empty. It may not be uploaded successfully depending on the artifact driver")
 	}
+	setCompressionRatio(ctx, art, localArtPath, size)
 	err = we.saveArtifactFromFile(ctx, art, fileName, localArtPath)
 	return err == nil, err
 }
 
+// setCompressionRatio sets the artifact's compression ratio if it was archived with the tar or zip strategy
+func setCompressionRatio(ctx context.Context, art *wfv1.Artifact, localArtPath string, size int64) {
+	if art.Archive != nil && art.Archive.Tar == nil && art.Archive.Zip == nil {
+		return
+	}
+	rawSize, err := archive.UncompressedSize(localArtPath)
+	if err != nil {
+		logging.RequireLoggerFromContext(ctx).WithField("path", localArtPath).WithError(err).Warn(ctx, "Failed to determine the uncompressed size of the artifact")
+		return
+	}
+	if rawSize == 0 {
+		return
+	}
+	ratio := wfv1.NewAmount(math.Round(float64(size)/float64(rawSize)*10000) / 10000)
+	art.CompressionRatio = &ratio
+}
+
 // fileBase is probably path.Base(filePath), but can be something else
 func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.Artifact, fileName, localArtPath string) error {
 	if !art.HasKey() {
-		key, err := we.Template.ArchiveLocation.GetKey()
-		if err != nil {
-			return err
-		}
-		artLocation, err := we.Template.ArchiveLocation.Get()
-		if err != nil {
-			return err
-		}
-		if err = art.SetType(artLocation); err != nil {
-			return err
-		}
-		if err := art.SetKey(path.Join(key, fileName)); err != nil {
+		if err := we.setArtifactKey(ctx, art, fileName, localArtPath); err != nil {
 			return err
 		}
 	}
@@ -402,6 +586,26 @@ func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.
 	return nil
 }
 
+// setArtifactKey sets the key of an output artifact without one to the file name under the key of the archive location
+func (we *WorkflowExecutor) setArtifactKey(ctx context.Context, art *wfv1.Artifact, fileName, localArtPath string) error {
+	key, err := we.Template.ArchiveLocation.GetKey()
+	if err != nil {
+		return err
+	}
+	artLocation, err := we.Template.ArchiveLocation.Get()
+	if err != nil {
+		return err
+	}
+	if err = art.SetType(artLocation); err != nil {
+		return err
+	}
+	fileName, err = we.artifactFileName(ctx, art, key, fileName, localArtPath)
+	if err != nil {
+		return err
+	}
+	return art.SetKey(path.Join(key, fileName))
+}
+
 func (we *WorkflowExecutor) maybeDeleteLocalArtPath(ctx context.Context, localArtPath string) {
 	if os.Getenv("REMOVE_LOCAL_ART_PATH") == "true" {
 		logger := logging.RequireLoggerFromContext(ctx)
@@ -432,14 +636,7 @@ func (we *WorkflowExecutor) stageArchiveFile(ctx context.Context, containerName
 			Tar: &wfv1.TarStrategy{},
 		}
 	}
-	compressionLevel := gzip.NoCompression
-	if strategy.Tar != nil {
-		if l := strategy.Tar.CompressionLevel; l != nil {
-			compressionLevel = int(*l)
-		} else {
-			compressionLevel = gzip.DefaultCompression
-		}
-	}
+	compressionLevel := tarCompressionLevel(strategy)
 
 	if !we.isBaseImagePath(art.Path) {
 		// If we get here, we are uploading an artifact from a mirrored volume mount which the wait
@@ -548,6 +745,71 @@ func (we *WorkflowExecutor) stageArchiveFile(ctx context.Context, containerName
 	return fileName, localArtPath, nil
 }
 
+// tarCompressionLevel returns the gzip compression level to use for the archive strategy
+func tarCompressionLevel(strategy *wfv1.ArchiveStrategy) int {
+	if strategy.Tar == nil {
+		return gzip.NoCompression
+	}
+	if l := strategy.Tar.CompressionLevel; l != nil {
+		return int(*l)
+	}
+	return gzip.DefaultCompression
+}
+
+// stagePathsArchiveFile stages each of art.Paths in a container into a single tarball for upload.
+// Returns a filename and a local path for the upload, like stageArchiveFile.
+func (we *WorkflowExecutor) stagePathsArchiveFile(ctx context.Context, containerName string, art *wfv1.Artifact) (string, string, error) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	logger.WithFields(logging.Fields{"name": art.Name, "paths": art.Paths}).Info(ctx, "Staging artifact from multiple paths")
+	strategy := art.Archive
+	if strategy == nil {
+		strategy = &wfv1.ArchiveStrategy{Tar: &wfv1.TarStrategy{}}
+	}
+	if strategy.Tar == nil {
+		return "", "", argoerrs.Errorf(argoerrs.CodeBadRequest, "artifact %s paths requires the tar archive strategy", art.Name)
+	}
+	stagingDir := filepath.Join(tempOutArtDir, art.Name+".paths")
+	sources := make([]string, len(art.Paths))
+	for i, p := range art.Paths {
+		pathArt := wfv1.Artifact{Name: art.Name, Path: p}
+		if err := pathArt.CleanPath(); err != nil {
+			return "", "", err
+		}
+		if !we.isBaseImagePath(pathArt.Path) {
+			sources[i] = filepath.Join(common.ExecutorMainFilesystemDir, pathArt.Path)
+			continue
+		}
+		// copy out of the container's base image layer, and unpack so the path can be added to the combined tarball
+		copiedPath := filepath.Join(stagingDir, fmt.Sprintf("%d.tgz", i))
+		if err := os.MkdirAll(stagingDir, os.ModePerm); err != nil {
+			return "", "", argoerrs.InternalWrapError(err)
+		}
+		if err := we.RuntimeExecutor.CopyFile(ctx, containerName, pathArt.Path, copiedPath, gzip.NoCompression); err != nil {
+			return "", "", err
+		}
+		sources[i] = filepath.Join(stagingDir, strconv.Itoa(i), filepath.Base(pathArt.Path))
+		if err := untar(copiedPath, sources[i]); err != nil {
+			return "", "", err
+		}
+		if err := os.Remove(copiedPath); err != nil {
+			return "", "", argoerrs.InternalWrapError(err)
+		}
+	}
+	fileName := fmt.Sprintf("%s.tgz", art.Name)
+	localArtPath := filepath.Join(tempOutArtDir, fileName)
+	f, err := os.Create(localArtPath)
+	if err != nil {
+		return "", "", argoerrs.InternalWrapError(err)
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+	if err := archive.TarGzPathsToWriter(ctx, sources, tarCompressionLevel(strategy), w); err != nil {
+		return "", "", err
+	}
+	logger.WithFields(logging.Fields{"paths": art.Paths, "localArtPath": localArtPath}).Info(ctx, "Successfully staged artifact from multiple paths")
+	return fileName, localArtPath, nil
+}
+
 // isBaseImagePath checks if the given artifact path resides in the base image layer of the container
 // versus a shared volume mount between the wait and main container
 func (we *WorkflowExecutor) isBaseImagePath(path string) bool {
@@ -582,6 +844,30 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 	}
 	logger.Info(ctx, "Saving output parameters")
 	for i, param := range we.Template.Outputs.Parameters {
+		if param.ValueFrom != nil && param.ValueFrom.FromAnnotation != nil {
+			logger.WithFields(logging.Fields{"name": param.Name, "annotation": param.ValueFrom.FromAnnotation.Annotation}).Info(ctx, "Saving annotation output parameter")
+			output, err := we.getAnnotationParameter(ctx, param.ValueFrom)
+			if err != nil {
+				return err
+			}
+			value, err := formatParameterValue(param.ValueFrom.Format, strings.TrimSuffix(output.String(), "\n"))
+			if err != nil {
+				return err
+			}
+			we.Template.Outputs.Parameters[i].Value = wfv1.AnyStringPtr(value)
+			logger.WithField("name", param.Name).Info(ctx, "Successfully saved output parameter")
+			continue
+		}
+		if param.ValueFrom != nil && param.ValueFrom.ArtifactName != "" {
+			logger.WithFields(logging.Fields{"name": param.Name, "artifact": param.ValueFrom.ArtifactName}).Info(ctx, "Saving artifact output parameter")
+			output, err := we.getArtifactParameter(ctx, param.ValueFrom)
+			if err != nil {
+				return err
+			}
+			we.Template.Outputs.Parameters[i].Value = output
+			logger.WithField("name", param.Name).Info(ctx, "Successfully saved output parameter")
+			continue
+		}
 		logger.WithField("name", param.Name).Info(ctx, "Saving path output parameter")
 		// Determine the file path of where to find the parameter
 		if param.ValueFrom == nil || param.ValueFrom.Path == "" {
@@ -589,46 +875,110 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 		}
 
 		var output *wfv1.AnyString
-		if we.isBaseImagePath(param.ValueFrom.Path) {
-			logger.WithField("path", param.ValueFrom.Path).Info(ctx, "Copying from base image layer")
-			fileContents, err := we.RuntimeExecutor.GetFileContents(common.MainContainerName, param.ValueFrom.Path)
-			if err != nil {
-				// We have a default value to use instead of returning an error
-				if param.ValueFrom.Default != nil {
-					output = param.ValueFrom.Default
-				} else {
-					return err
-				}
+		fileContents, err := we.getMainFileContents(ctx, param.ValueFrom.Path)
+		if err != nil {
+			// We have a default value to use instead of returning an error
+			if param.ValueFrom.Default != nil {
+				output = param.ValueFrom.Default
 			} else {
-				output = wfv1.AnyStringPtr(fileContents)
+				return err
 			}
 		} else {
-			logger.WithField("path", param.ValueFrom.Path).Info(ctx, "Copying from volume mount")
-			mountedPath := filepath.Join(common.ExecutorMainFilesystemDir, param.ValueFrom.Path)
-			data, err := os.ReadFile(filepath.Clean(mountedPath))
-			if err != nil {
-				// We have a default value to use instead of returning an error
-				if param.ValueFrom.Default != nil {
-					output = param.ValueFrom.Default
-				} else {
-					return err
-				}
-			} else {
-				output = wfv1.AnyStringPtr(string(data))
-			}
+			output = wfv1.AnyStringPtr(fileContents)
 		}
 
 		// Trims off a single newline for user convenience
-		output = wfv1.AnyStringPtr(strings.TrimSuffix(output.String(), "\n"))
-		we.Template.Outputs.Parameters[i].Value = output
+		value, err := formatParameterValue(param.ValueFrom.Format, strings.TrimSuffix(output.String(), "\n"))
+		if err != nil {
+			return err
+		}
+		we.Template.Outputs.Parameters[i].Value = wfv1.AnyStringPtr(value)
 		logger.WithField("name", param.Name).Info(ctx, "Successfully saved output parameter")
 	}
 	return nil
 }
 
+// formatParameterValue applies the output parameter's valueFrom.format transformation to value
+func formatParameterValue(format wfv1.ValueFromFormat, value string) (string, error) {
+	switch format {
+	case "":
+		return value, nil
+	case wfv1.ValueFromFormatTrim:
+		return strings.TrimSpace(value), nil
+	case wfv1.ValueFromFormatBase64:
+		return base64.StdEncoding.EncodeToString([]byte(value)), nil
+	case wfv1.ValueFromFormatBase64URL:
+		return base64.URLEncoding.EncodeToString([]byte(value)), nil
+	default:
+		return "", argoerrs.Errorf(argoerrs.CodeBadRequest, "unknown output parameter format '%s'", format)
+	}
+}
+
+// getMainFileContents reads a file written by the main container, either from its base image layer
+// or from a volume mount shared with this container
+func (we *WorkflowExecutor) getMainFileContents(ctx context.Context, path string) (string, error) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	if we.isBaseImagePath(path) {
+		logger.WithField("path", path).Info(ctx, "Copying from base image layer")
+		return we.RuntimeExecutor.GetFileContents(common.MainContainerName, path)
+	}
+	logger.WithField("path", path).Info(ctx, "Copying from volume mount")
+	mountedPath := filepath.Join(common.ExecutorMainFilesystemDir, path)
+	data, err := os.ReadFile(filepath.Clean(mountedPath))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// getArtifactParameter retrieves an output parameter value by applying the jq filter to the named input or
+// output artifact of this template, falling back to the default value if the artifact cannot be read
+func (we *WorkflowExecutor) getArtifactParameter(ctx context.Context, valueFrom *wfv1.ValueFrom) (*wfv1.AnyString, error) {
+	name := valueFrom.ArtifactName
+	art := we.Template.GetArtifactByName(name)
+	if art == nil {
+		return nil, argoerrs.Errorf(argoerrs.CodeNotFound, "artifact '%s' not found", name)
+	}
+	if art.Path == "" {
+		return nil, argoerrs.Errorf(argoerrs.CodeBadRequest, "artifact '%s' does not have a path", name)
+	}
+	data, err := we.getMainFileContents(ctx, art.Path)
+	if err != nil {
+		if valueFrom.Default != nil {
+			return valueFrom.Default, nil
+		}
+		return nil, err
+	}
+	output, err := jqFilter(ctx, []byte(data), valueFrom.JQFilter)
+	if err != nil {
+		return nil, argoerrs.Errorf(argoerrs.CodeBadRequest, "failed to apply jqFilter to artifact '%s': %v", name, err)
+	}
+	return wfv1.AnyStringPtr(output), nil
+}
+
+// getAnnotationParameter retrieves an output parameter value from an annotation on this pod,
+// falling back to the default value if the annotation cannot be read
+func (we *WorkflowExecutor) getAnnotationParameter(ctx context.Context, valueFrom *wfv1.ValueFrom) (*wfv1.AnyString, error) {
+	key := valueFrom.FromAnnotation.Annotation
+	pod, err := we.ClientSet.CoreV1().Pods(we.Namespace).Get(ctx, we.PodName, metav1.GetOptions{})
+	if err != nil {
+		if valueFrom.Default != nil {
+			return valueFrom.Default, nil
+		}
+		return nil, argoerrs.InternalWrapError(err)
+	}
+	value, ok := pod.Annotations[key]
+	if !ok {
+		if valueFrom.Default != nil {
+			return valueFrom.Default, nil
+		}
+		return nil, argoerrs.Errorf(argoerrs.CodeNotFound, "annotation '%s' not found on pod %s", key, we.PodName)
+	}
+	return wfv1.AnyStringPtr(value), nil
+}
+
 func (we *WorkflowExecutor) SaveLogs(ctx context.Context) []wfv1.Artifact {
 	var logArtifacts []wfv1.Artifact
-	tempLogsDir := "/tmp/argo/outputs/logs"
 
 	if we.Template.SaveLogsAsArtifact() {
 		err := os.MkdirAll(tempLogsDir, os.ModePerm)
@@ -699,6 +1049,24 @@ func (we *WorkflowExecutor) saveLogToFile(ctx context.Context, containerName, pa
 	return nil
 }
 
+// absentArtifactsDir is a variable so that tests can record absent artifacts in a temporary directory
+var absentArtifactsDir = common.ArgoAbsentArtifactsPath
+
+// inputArtifactsDir is a variable so that tests can load input artifacts into a temporary directory
+var inputArtifactsDir = common.ExecutorArtifactBaseDir
+
+// recordAbsentArtifact leaves a marker for an optional input artifact that was not loaded, so that the emissary can
+// set ARGO_ARTIFACT_<NAME>_PRESENT=false in the main container
+func recordAbsentArtifact(name string) error {
+	if err := os.MkdirAll(absentArtifactsDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create absent artifacts directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(absentArtifactsDir, name), nil, 0o644); err != nil {
+		return fmt.Errorf("failed to record absent artifact '%s': %w", name, err)
+	}
+	return nil
+}
+
 func (we *WorkflowExecutor) newDriverArt(art *wfv1.Artifact) (*wfv1.Artifact, error) {
 	driverArt := art.DeepCopy()
 	err := driverArt.Relocate(we.Template.ArchiveLocation)
@@ -716,6 +1084,8 @@ func (we *WorkflowExecutor) InitDriver(ctx context.Context, art *wfv1.Artifact)
 
 // GetConfigMapKey retrieves a configmap value and memoizes the result
 func (we *WorkflowExecutor) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
+	we.memoizedLock.Lock()
+	defer we.memoizedLock.Unlock()
 	namespace := we.Namespace
 	cachedKey := fmt.Sprintf("%s/%s/%s", namespace, name, key)
 	if val, ok := we.memoizedConfigMaps[cachedKey]; ok {
@@ -745,6 +1115,8 @@ func (we *WorkflowExecutor) GetConfigMapKey(ctx context.Context, name, key strin
 
 // GetSecrets retrieves a secret value and memoizes the result
 func (we *WorkflowExecutor) GetSecrets(ctx context.Context, namespace, name, key string) ([]byte, error) {
+	we.memoizedLock.Lock()
+	defer we.memoizedLock.Unlock()
 	cachedKey := fmt.Sprintf("%s/%s/%s", namespace, name, key)
 	if val, ok := we.memoizedSecrets[cachedKey]; ok {
 		return val, nil
@@ -877,37 +1249,37 @@ func (we *WorkflowExecutor) InitializeOutput(ctx context.Context) {
 // ReportOutputs updates the WorkflowTaskResult (or falls back to annotate the Pod)
 func (we *WorkflowExecutor) ReportOutputs(ctx context.Context, artifacts []wfv1.Artifact) error {
 	outputs := we.Template.Outputs.DeepCopy()
-	
+
 	// Create a map of runtime artifacts by name for efficient lookup
 	runtimeArtMap := make(map[string]wfv1.Artifact)
 	for _, art := range artifacts {
 		runtimeArtMap[art.Name] = art
 	}
-	
+
 	// Merge runtime data into template artifacts to preserve template metadata like previewPath
 	for i := range outputs.Artifacts {
 		templateArt := &outputs.Artifacts[i]
 		if runtimeArt, exists := runtimeArtMap[templateArt.Name]; exists {
 			// Preserve template metadata, update runtime data
 			templateArt.ArtifactLocation = runtimeArt.ArtifactLocation
-			
+
 			// Update path if runtime path is different
 			if runtimeArt.Path != "" {
 				templateArt.Path = runtimeArt.Path
 			}
-			
+
 			// Update archive strategy if provided by runtime
 			if runtimeArt.Archive != nil {
 				templateArt.Archive = runtimeArt.Archive
 			}
-			
+
 			// Update other runtime fields as needed
 			if runtimeArt.Deleted {
 				templateArt.Deleted = runtimeArt.Deleted
 			}
 		}
 	}
-	
+
 	return we.reportResult(ctx, wfv1.NodeResult{Outputs: outputs})
 }
 
@@ -1190,6 +1562,11 @@ func chmod(artPath string, mode int32, recurse bool) error {
 	return nil
 }
 
+// livenessProbeRestartError is returned by Wait when a main container has been restarted after its livenessProbe failed
+type livenessProbeRestartError string
+
+func (e livenessProbeRestartError) Error() string { return string(e) }
+
 // Wait is the sidecar container logic which waits for the main container to complete.
 // Also monitors for updates in the pod annotations which may change (e.g. terminate)
 // Upon completion, kills any sidecars after it finishes.
@@ -1204,13 +1581,24 @@ func (we *WorkflowExecutor) Wait(ctx context.Context) error {
 	}
 
 	go we.monitorDeadline(ctx, containerNames)
+	go we.monitorSuspend(ctx, containerNames)
+
+	waitCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	go we.monitorLiveness(waitCtx, cancel)
 
 	err := retryutil.OnError(executorretry.ExecutorRetry(ctx), func(err error) bool {
 		return errorsutil.IsTransientErr(ctx, err)
 	}, func() error {
-		return we.RuntimeExecutor.Wait(ctx, containerNames)
+		return we.RuntimeExecutor.Wait(waitCtx, containerNames)
 	})
 
+	var restartErr livenessProbeRestartError
+	if errors.As(context.Cause(waitCtx), &restartErr) {
+		logger.WithError(restartErr).Info(ctx, "Main container restarted")
+		return restartErr
+	}
+
 	logger.WithError(err).Info(ctx, "Main container completed")
 
 	if err != nil && err != context.Canceled {
@@ -1298,6 +1686,111 @@ func (we *WorkflowExecutor) monitorDeadline(ctx context.Context, containerNames
 	we.killContainers(ctx, containerNames)
 }
 
+// monitorSuspend watches the pod for the suspended annotation, which the controller sets while the node is paused,
+// and stops or continues the main containers to match.
+func (we *WorkflowExecutor) monitorSuspend(ctx context.Context, containerNames []string) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	signaler, ok := we.RuntimeExecutor.(Signaler)
+	if !ok || osspecific.Stop == 0 {
+		return
+	}
+	logger.Info(ctx, "Starting suspend monitor")
+	suspended := false
+	for {
+		w, err := we.ClientSet.CoreV1().Pods(we.Namespace).Watch(ctx, metav1.ListOptions{FieldSelector: "metadata.name=" + we.PodName})
+		if apierr.IsForbidden(err) {
+			logger.WithError(err).Info(ctx, "Suspend monitor stopped, the executor is not allowed to watch its pod")
+			return
+		}
+		if err != nil {
+			logger.WithError(err).Warn(ctx, "Failed to watch pod for suspend")
+		} else {
+			for event := range w.ResultChan() {
+				pod, ok := event.Object.(*apiv1.Pod)
+				if !ok {
+					continue
+				}
+				_, suspend := pod.Annotations[common.AnnotationKeySuspended]
+				if suspend == suspended {
+					continue
+				}
+				sig := osspecific.Cont
+				if suspend {
+					sig = osspecific.Stop
+				}
+				if err := signaler.Signal(ctx, containerNames, sig); err != nil {
+					logger.WithField("containerNames", containerNames).WithError(err).Warn(ctx, "Failed to signal")
+					continue
+				}
+				logger.WithField("suspend", suspend).Info(ctx, "Signalled main containers")
+				suspended = suspend
+			}
+			w.Stop()
+		}
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "Suspend monitor stopped")
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// monitorLiveness watches the pod for main containers which have been restarted after their livenessProbe failed, and
+// stops the wait, so that the node fails and can be retried.
+func (we *WorkflowExecutor) monitorLiveness(ctx context.Context, cancel context.CancelCauseFunc) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	if !hasLivenessProbe(we.Template) {
+		return
+	}
+	logger.Info(ctx, "Starting liveness monitor")
+	for {
+		w, err := we.ClientSet.CoreV1().Pods(we.Namespace).Watch(ctx, metav1.ListOptions{FieldSelector: "metadata.name=" + we.PodName})
+		if apierr.IsForbidden(err) {
+			logger.WithError(err).Info(ctx, "Liveness monitor stopped, the executor is not allowed to watch its pod")
+			return
+		}
+		if err != nil {
+			logger.WithError(err).Warn(ctx, "Failed to watch pod for liveness")
+		} else {
+			for event := range w.ResultChan() {
+				pod, ok := event.Object.(*apiv1.Pod)
+				if !ok {
+					continue
+				}
+				if message := common.LivenessProbeRestart(pod, &we.Template); message != "" {
+					w.Stop()
+					cancel(livenessProbeRestartError(message))
+					return
+				}
+			}
+			w.Stop()
+		}
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "Liveness monitor stopped")
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func hasLivenessProbe(tmpl wfv1.Template) bool {
+	switch {
+	case tmpl.Container != nil:
+		return tmpl.Container.LivenessProbe != nil
+	case tmpl.Script != nil:
+		return tmpl.Script.LivenessProbe != nil
+	case tmpl.ContainerSet != nil:
+		for _, c := range tmpl.ContainerSet.GetContainers() {
+			if c.LivenessProbe != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (we *WorkflowExecutor) killContainers(ctx context.Context, containerNames []string) {
 	logger := logging.RequireLoggerFromContext(ctx)
 	logger.Info(ctx, "Killing containers")
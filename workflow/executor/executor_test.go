@@ -1,11 +1,19 @@
 package executor
 
 import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
+	"syscall"
 	"testing"
 	"time"
 
@@ -14,11 +22,14 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/utils/ptr"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	argofake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/archive"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/mocks"
@@ -86,6 +97,100 @@ func TestWorkflowExecutor_LoadArtifacts(t *testing.T) {
 	}
 }
 
+func TestWorkflowExecutor_LoadArtifactsOptional(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	absentArtifactsDir = t.TempDir()
+	defer func() { absentArtifactsDir = common.ArgoAbsentArtifactsPath }()
+	we := WorkflowExecutor{
+		Template: wfv1.Template{
+			Inputs: wfv1.Inputs{
+				Artifacts: []wfv1.Artifact{{Name: "foo", Path: "/tmp/foo.txt", Optional: true}},
+			},
+		},
+	}
+	require.NoError(t, we.LoadArtifacts(ctx))
+	assert.FileExists(t, filepath.Join(absentArtifactsDir, "foo"))
+}
+
+// delayedArtifactServer serves every artifact after a delay, and records the largest number of concurrent downloads
+func delayedArtifactServer(delay time.Duration) (*httptest.Server, *int32) {
+	var mu sync.Mutex
+	var current, largest int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		current++
+		largest = max(largest, current)
+		mu.Unlock()
+		time.Sleep(delay)
+		mu.Lock()
+		current--
+		mu.Unlock()
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+	return server, &largest
+}
+
+func inputArtifacts(url string, n int) []wfv1.Artifact {
+	var artifacts []wfv1.Artifact
+	for i := range n {
+		name := fmt.Sprintf("art-%d", i)
+		artifacts = append(artifacts, wfv1.Artifact{
+			Name: name,
+			Path: "/tmp/" + name,
+			ArtifactLocation: wfv1.ArtifactLocation{
+				HTTP: &wfv1.HTTPArtifact{URL: url + "/" + name},
+			},
+			Archive: &wfv1.ArchiveStrategy{None: &wfv1.NoneStrategy{}},
+		})
+	}
+	return artifacts
+}
+
+func TestWorkflowExecutor_LoadArtifactsConcurrently(t *testing.T) {
+	server, largest := delayedArtifactServer(50 * time.Millisecond)
+	defer server.Close()
+	inputArtifactsDir = t.TempDir()
+	defer func() { inputArtifactsDir = common.ExecutorArtifactBaseDir }()
+	t.Setenv(common.EnvVarConcurrentDownloads, "3")
+
+	ctx := logging.TestContext(t.Context())
+	we := WorkflowExecutor{
+		Template: wfv1.Template{
+			Inputs: wfv1.Inputs{Artifacts: inputArtifacts(server.URL, 6)},
+		},
+	}
+	require.NoError(t, we.LoadArtifacts(ctx))
+	for i := range 6 {
+		data, err := os.ReadFile(filepath.Join(inputArtifactsDir, fmt.Sprintf("art-%d", i)))
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("/art-%d", i), string(data))
+	}
+	assert.LessOrEqual(t, *largest, int32(3))
+	assert.Greater(t, *largest, int32(1))
+}
+
+func BenchmarkLoadArtifacts(b *testing.B) {
+	server, _ := delayedArtifactServer(10 * time.Millisecond)
+	defer server.Close()
+	inputArtifactsDir = b.TempDir()
+	defer func() { inputArtifactsDir = common.ExecutorArtifactBaseDir }()
+
+	for name, concurrentDownloads := range map[string]string{"Sequential": "1", "Parallel": "10"} {
+		b.Run(name, func(b *testing.B) {
+			b.Setenv(common.EnvVarConcurrentDownloads, concurrentDownloads)
+			ctx := logging.TestContext(b.Context())
+			we := WorkflowExecutor{
+				Template: wfv1.Template{
+					Inputs: wfv1.Inputs{Artifacts: inputArtifacts(server.URL, 10)},
+				},
+			}
+			for b.Loop() {
+				require.NoError(b, we.LoadArtifacts(ctx))
+			}
+		})
+	}
+}
+
 func TestSaveParameters(t *testing.T) {
 	fakeClientset := fake.NewSimpleClientset()
 	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
@@ -116,6 +221,285 @@ func TestSaveParameters(t *testing.T) {
 	assert.Equal(t, "has a newline", we.Template.Outputs.Parameters[0].Value.String())
 }
 
+func TestSaveParametersFromAnnotation(t *testing.T) {
+	templateWithOutParam := wfv1.Template{
+		Outputs: wfv1.Outputs{
+			Parameters: []wfv1.Parameter{
+				{
+					Name: "my-out",
+					ValueFrom: &wfv1.ValueFrom{
+						FromAnnotation: &wfv1.AnnotationValueFrom{Annotation: "my.annotation"},
+					},
+				},
+			},
+		},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fakePodName,
+			Namespace:   fakeNamespace,
+			Annotations: map[string]string{"my.annotation": "injected by sidecar\n"},
+		},
+	}
+
+	t.Run("Found", func(t *testing.T) {
+		we := WorkflowExecutor{
+			PodName:   fakePodName,
+			Template:  *templateWithOutParam.DeepCopy(),
+			ClientSet: fake.NewSimpleClientset(pod),
+			Namespace: fakeNamespace,
+		}
+		ctx := logging.TestContext(t.Context())
+		err := we.SaveParameters(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "injected by sidecar", we.Template.Outputs.Parameters[0].Value.String())
+	})
+	t.Run("Missing", func(t *testing.T) {
+		we := WorkflowExecutor{
+			PodName:   fakePodName,
+			Template:  *templateWithOutParam.DeepCopy(),
+			ClientSet: fake.NewSimpleClientset(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: fakePodName, Namespace: fakeNamespace}}),
+			Namespace: fakeNamespace,
+		}
+		ctx := logging.TestContext(t.Context())
+		err := we.SaveParameters(ctx)
+		require.EqualError(t, err, "annotation 'my.annotation' not found on pod "+fakePodName)
+	})
+	t.Run("MissingWithDefault", func(t *testing.T) {
+		tmpl := templateWithOutParam.DeepCopy()
+		tmpl.Outputs.Parameters[0].ValueFrom.Default = wfv1.AnyStringPtr("Default Value")
+		we := WorkflowExecutor{
+			PodName:   fakePodName,
+			Template:  *tmpl,
+			ClientSet: fake.NewSimpleClientset(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: fakePodName, Namespace: fakeNamespace}}),
+			Namespace: fakeNamespace,
+		}
+		ctx := logging.TestContext(t.Context())
+		err := we.SaveParameters(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "Default Value", we.Template.Outputs.Parameters[0].Value.String())
+	})
+}
+
+func TestSaveParametersFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   wfv1.ValueFromFormat
+		contents string
+		expected string
+	}{
+		{"None", "", "  padded  \n", "  padded  "},
+		{"Trim", wfv1.ValueFromFormatTrim, "  padded  \n", "padded"},
+		{"TrimMultiLine", wfv1.ValueFromFormatTrim, "\n\tfirst line\nsecond line\n\n\n", "first line\nsecond line"},
+		{"Base64", wfv1.ValueFromFormatBase64, "hello?world>\n", "aGVsbG8/d29ybGQ+"},
+		{"Base64MultiLine", wfv1.ValueFromFormatBase64, "line one\nline two\n", "bGluZSBvbmUKbGluZSB0d28="},
+		{"Base64URL", wfv1.ValueFromFormatBase64URL, "hello?world>\n", "aGVsbG8_d29ybGQ-"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+			mockRuntimeExecutor.On("GetFileContents", fakeContainerName, "/path").Return(test.contents, nil)
+			we := WorkflowExecutor{
+				PodName: fakePodName,
+				Template: wfv1.Template{
+					Outputs: wfv1.Outputs{
+						Parameters: []wfv1.Parameter{
+							{
+								Name:      "my-out",
+								ValueFrom: &wfv1.ValueFrom{Path: "/path", Format: test.format},
+							},
+						},
+					},
+				},
+				ClientSet:       fake.NewSimpleClientset(),
+				Namespace:       fakeNamespace,
+				RuntimeExecutor: &mockRuntimeExecutor,
+			}
+			ctx := logging.TestContext(t.Context())
+			err := we.SaveParameters(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, we.Template.Outputs.Parameters[0].Value.String())
+		})
+	}
+	t.Run("FromAnnotation", func(t *testing.T) {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        fakePodName,
+				Namespace:   fakeNamespace,
+				Annotations: map[string]string{"my.annotation": "  injected by sidecar \n"},
+			},
+		}
+		we := WorkflowExecutor{
+			PodName: fakePodName,
+			Template: wfv1.Template{
+				Outputs: wfv1.Outputs{
+					Parameters: []wfv1.Parameter{
+						{
+							Name: "my-out",
+							ValueFrom: &wfv1.ValueFrom{
+								FromAnnotation: &wfv1.AnnotationValueFrom{Annotation: "my.annotation"},
+								Format:         wfv1.ValueFromFormatTrim,
+							},
+						},
+					},
+				},
+			},
+			ClientSet: fake.NewSimpleClientset(pod),
+			Namespace: fakeNamespace,
+		}
+		ctx := logging.TestContext(t.Context())
+		err := we.SaveParameters(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "injected by sidecar", we.Template.Outputs.Parameters[0].Value.String())
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := formatParameterValue("hex", "value")
+		require.EqualError(t, err, "unknown output parameter format 'hex'")
+	})
+}
+
+// TestStagePathsArchiveFile verifies that an artifact with multiple paths is staged as a single tarball containing
+// each path under its base name
+func TestStagePathsArchiveFile(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	// containerRoot stands in for the main container's filesystem
+	containerRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(containerRoot, "out", "reports"), 0o700))
+	require.NoError(t, os.MkdirAll(filepath.Join(containerRoot, "var", "log", "app"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(containerRoot, "out", "reports", "a.txt"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(containerRoot, "var", "log", "app", "b.log"), []byte("b"), 0o600))
+
+	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	mockRuntimeExecutor.On("CopyFile", mock.Anything, common.MainContainerName, mock.Anything, mock.Anything, gzip.NoCompression).
+		Run(func(args mock.Arguments) {
+			f, err := os.Create(args.String(3))
+			require.NoError(t, err)
+			defer f.Close()
+			require.NoError(t, archive.TarGzToWriter(ctx, filepath.Join(containerRoot, args.String(2)), gzip.NoCompression, f))
+		}).
+		Return(nil)
+	we := WorkflowExecutor{
+		Template:        wfv1.Template{Container: &corev1.Container{}},
+		RuntimeExecutor: &mockRuntimeExecutor,
+	}
+	art := &wfv1.Artifact{Name: "results-" + t.Name(), Paths: []string{"/out/reports", "/var/log/app/"}}
+	require.NoError(t, os.MkdirAll(tempOutArtDir, os.ModePerm))
+	t.Cleanup(func() {
+		_ = os.RemoveAll(filepath.Join(tempOutArtDir, art.Name+".tgz"))
+		_ = os.RemoveAll(filepath.Join(tempOutArtDir, art.Name+".paths"))
+	})
+
+	fileName, localArtPath, err := we.stagePathsArchiveFile(ctx, common.MainContainerName, art)
+	require.NoError(t, err)
+	assert.Equal(t, art.Name+".tgz", fileName)
+	mockRuntimeExecutor.AssertNumberOfCalls(t, "CopyFile", 2)
+
+	f, err := os.Open(localArtPath)
+	require.NoError(t, err)
+	defer f.Close()
+	gzr, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	tr := tar.NewReader(gzr)
+	contents := map[string]string{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if header.Typeflag == tar.TypeReg {
+			data, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			contents[header.Name] = string(data)
+		}
+	}
+	assert.Equal(t, map[string]string{"reports/a.txt": "a", "app/b.log": "b"}, contents)
+}
+
+func TestSaveParametersFromArtifact(t *testing.T) {
+	report := `{"status": {"phase": "Succeeded", "nodes": [{"name": "a", "outputs": {"exitCode": "0"}}, {"name": "b", "outputs": {"exitCode": "1"}}]}}`
+	tests := []struct {
+		name     string
+		filter   string
+		expected string
+	}{
+		{"Field", ".status.phase", "Succeeded"},
+		{"NestedArray", ".status.nodes[1].outputs.exitCode", "1"},
+		{"Select", `.status.nodes[] | select(.outputs.exitCode != "0") | .name`, "b"},
+		{"Object", `{failed: [.status.nodes[] | select(.outputs.exitCode != "0") | .name]}`, `{"failed":["b"]}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+			mockRuntimeExecutor.On("GetFileContents", fakeContainerName, "/tmp/report.json").Return(report, nil)
+			we := WorkflowExecutor{
+				PodName: fakePodName,
+				Template: wfv1.Template{
+					Outputs: wfv1.Outputs{
+						Artifacts: []wfv1.Artifact{{Name: "report", Path: "/tmp/report.json"}},
+						Parameters: []wfv1.Parameter{
+							{
+								Name:      "my-out",
+								ValueFrom: &wfv1.ValueFrom{ArtifactName: "report", JQFilter: test.filter},
+							},
+						},
+					},
+				},
+				ClientSet:       fake.NewSimpleClientset(),
+				Namespace:       fakeNamespace,
+				RuntimeExecutor: &mockRuntimeExecutor,
+			}
+			ctx := logging.TestContext(t.Context())
+			err := we.SaveParameters(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, we.Template.Outputs.Parameters[0].Value.String())
+		})
+	}
+	t.Run("NotJSON", func(t *testing.T) {
+		mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+		mockRuntimeExecutor.On("GetFileContents", fakeContainerName, "/tmp/report.json").Return("not json", nil)
+		we := WorkflowExecutor{
+			Template: wfv1.Template{
+				Outputs: wfv1.Outputs{
+					Artifacts: []wfv1.Artifact{{Name: "report", Path: "/tmp/report.json"}},
+					Parameters: []wfv1.Parameter{
+						{
+							Name:      "my-out",
+							ValueFrom: &wfv1.ValueFrom{ArtifactName: "report", JQFilter: ".status"},
+						},
+					},
+				},
+			},
+			RuntimeExecutor: &mockRuntimeExecutor,
+		}
+		ctx := logging.TestContext(t.Context())
+		err := we.SaveParameters(ctx)
+		require.ErrorContains(t, err, "failed to apply jqFilter to artifact 'report'")
+	})
+	t.Run("Default", func(t *testing.T) {
+		mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+		mockRuntimeExecutor.On("GetFileContents", fakeContainerName, "/tmp/report.json").Return("", fmt.Errorf("file not found"))
+		we := WorkflowExecutor{
+			Template: wfv1.Template{
+				Outputs: wfv1.Outputs{
+					Artifacts: []wfv1.Artifact{{Name: "report", Path: "/tmp/report.json"}},
+					Parameters: []wfv1.Parameter{
+						{
+							Name:      "my-out",
+							ValueFrom: &wfv1.ValueFrom{ArtifactName: "report", JQFilter: ".status", Default: wfv1.AnyStringPtr("unknown")},
+						},
+					},
+				},
+			},
+			RuntimeExecutor: &mockRuntimeExecutor,
+		}
+		ctx := logging.TestContext(t.Context())
+		err := we.SaveParameters(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "unknown", we.Template.Outputs.Parameters[0].Value.String())
+	})
+}
+
 // TestIsBaseImagePath tests logic of isBaseImagePath which determines if a path is coming from a
 // base image layer versus a shared volumeMount.
 func TestIsBaseImagePath(t *testing.T) {
@@ -479,7 +863,8 @@ func TestSaveArtifacts(t *testing.T) {
 		},
 	}
 
-	for _, tt := range tests {
+	for i := range tests {
+		tt := &tests[i]
 		ctx := logging.TestContext(t.Context())
 		_, err := tt.workflowExecutor.SaveArtifacts(ctx)
 		if err != nil {
@@ -490,6 +875,93 @@ func TestSaveArtifacts(t *testing.T) {
 	}
 }
 
+// TestSaveArtifactsArchiveLogs checks that an output artifact's archiveLogs overrides the template's for the logs saved
+// next to it
+func TestSaveArtifactsArchiveLogs(t *testing.T) {
+	var mu sync.Mutex
+	var saved []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		saved = append(saved, r.URL.Path)
+	}))
+	defer server.Close()
+
+	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	mockRuntimeExecutor.On("CopyFile", mock.Anything, common.MainContainerName, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			require.NoError(t, os.WriteFile(args.String(3), []byte("data"), 0o600))
+		}).
+		Return(nil)
+	mockRuntimeExecutor.On("GetOutputStream", mock.Anything, common.MainContainerName, true).
+		Return(func(context.Context, string, bool) io.ReadCloser {
+			return io.NopCloser(strings.NewReader("hello world"))
+		}, nil)
+	require.NoError(t, os.MkdirAll(tempOutArtDir, os.ModePerm))
+
+	artifact := func(name string, archiveLogs *bool) wfv1.Artifact {
+		return wfv1.Artifact{
+			Name: name,
+			Path: "/" + name,
+			ArtifactLocation: wfv1.ArtifactLocation{
+				ArchiveLogs: archiveLogs,
+				HTTP:        &wfv1.HTTPArtifact{URL: server.URL + "/" + name + "/" + name + ".tgz"},
+			},
+		}
+	}
+	we := WorkflowExecutor{
+		Template: wfv1.Template{
+			// set by the controller from the workflow's spec.archiveLogs
+			ArchiveLocation: &wfv1.ArtifactLocation{ArchiveLogs: ptr.To(true)},
+			Outputs: wfv1.Outputs{Artifacts: wfv1.Artifacts{
+				artifact("default", nil),
+				artifact("skipped", ptr.To(false)),
+				artifact("enabled", ptr.To(true)),
+			}},
+		},
+		RuntimeExecutor: &mockRuntimeExecutor,
+	}
+
+	ctx := logging.TestContext(t.Context())
+	artifacts, err := we.SaveArtifacts(ctx)
+	require.NoError(t, err)
+	assert.Len(t, artifacts, 3)
+	assert.ElementsMatch(t, []string{
+		"/default/default.tgz", "/default/main.log",
+		"/skipped/skipped.tgz",
+		"/enabled/enabled.tgz", "/enabled/main.log",
+	}, saved)
+}
+
+func TestSetCompressionRatio(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	dir := t.TempDir()
+	src := filepath.Join(dir, "data.txt")
+	require.NoError(t, os.WriteFile(src, []byte(strings.Repeat("a", 10000)), 0o600))
+	tgz := filepath.Join(dir, "data.tgz")
+	f, err := os.Create(tgz)
+	require.NoError(t, err)
+	require.NoError(t, archive.TarGzToWriter(ctx, src, gzip.DefaultCompression, f))
+	require.NoError(t, f.Close())
+	fi, err := os.Stat(tgz)
+	require.NoError(t, err)
+
+	t.Run("Tar", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "data"}
+		setCompressionRatio(ctx, art, tgz, fi.Size())
+		require.NotNil(t, art.CompressionRatio)
+		ratio, err := art.CompressionRatio.Float64()
+		require.NoError(t, err)
+		assert.InDelta(t, float64(fi.Size())/10000, ratio, 0.0001)
+		assert.Less(t, ratio, 1.0)
+	})
+	t.Run("None", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "data", Archive: &wfv1.ArchiveStrategy{None: &wfv1.NoneStrategy{}}}
+		setCompressionRatio(ctx, art, src, 10000)
+		assert.Nil(t, art.CompressionRatio)
+	})
+}
+
 func TestMonitorProgress(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
 
@@ -537,6 +1009,95 @@ func TestMonitorProgress(t *testing.T) {
 	assert.Equal(t, wfv1.Progress("100/100"), result.Progress)
 }
 
+type fakeSignaler struct {
+	mocks.ContainerRuntimeExecutor
+	signals chan syscall.Signal
+}
+
+func (f *fakeSignaler) Signal(_ context.Context, _ []string, sig syscall.Signal) error {
+	f.signals <- sig
+	return nil
+}
+
+func TestMonitorSuspend(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("processes cannot be stopped with a signal in windows")
+	}
+	ctx := logging.TestContext(t.Context())
+
+	podWatch := watch.NewFake()
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependWatchReactor("pods", k8stesting.DefaultWatchReactor(podWatch, nil))
+	signaler := &fakeSignaler{signals: make(chan syscall.Signal, 1)}
+	we := WorkflowExecutor{
+		PodName:         fakePodName,
+		Namespace:       fakeNamespace,
+		ClientSet:       clientset,
+		RuntimeExecutor: signaler,
+	}
+	go we.monitorSuspend(ctx, []string{fakeContainerName})
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: fakePodName, Namespace: fakeNamespace}}
+	podWatch.Add(pod.DeepCopy())
+	pod.Annotations = map[string]string{common.AnnotationKeySuspended: "true"}
+	podWatch.Modify(pod.DeepCopy())
+	assert.Equal(t, syscall.SIGSTOP, <-signaler.signals)
+
+	// already stopped, so no further signal is sent
+	podWatch.Modify(pod.DeepCopy())
+	pod.Annotations = nil
+	podWatch.Modify(pod.DeepCopy())
+	assert.Equal(t, syscall.SIGCONT, <-signaler.signals)
+	podWatch.Stop()
+}
+
+type fakeGlobber struct {
+	mocks.ContainerRuntimeExecutor
+	matches []string
+}
+
+func (f *fakeGlobber) Glob(_ context.Context, _ string, _ string) ([]string, error) {
+	return f.matches, nil
+}
+
+func TestGlobPaths(t *testing.T) {
+	root := t.TempDir()
+	for _, p := range []string{"out/a/1.txt", "out/b/2.txt", "out/b/3.log", "out/4.txt"} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(root, p)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, p), nil, 0o600))
+	}
+	matches, err := globPaths(root, "/out/*/*.txt")
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.FromSlash("/out/a/1.txt"), filepath.FromSlash("/out/b/2.txt")}, matches)
+
+	matches, err = globPaths(root, "/out/*.csv")
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+}
+
+func TestExpandGlobArtifact(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	art := wfv1.Artifact{Name: "reports", GlobPath: "/out/*/*.txt"}
+
+	we := WorkflowExecutor{RuntimeExecutor: &fakeGlobber{matches: []string{"/out/b/2.txt", "/out/a/1.txt"}}}
+	artifacts, err := we.expandGlobArtifact(ctx, fakeContainerName, art)
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.Artifacts{
+		{Name: "reports-0", Path: "/out/a/1.txt"},
+		{Name: "reports-1", Path: "/out/b/2.txt"},
+	}, artifacts)
+
+	t.Run("NoMatches", func(t *testing.T) {
+		we := WorkflowExecutor{
+			Template:        wfv1.Template{Outputs: wfv1.Outputs{Artifacts: wfv1.Artifacts{art}}},
+			RuntimeExecutor: &fakeGlobber{},
+		}
+		artifacts, err := we.SaveArtifacts(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, artifacts)
+	})
+}
+
 func TestSaveLogs(t *testing.T) {
 	const artStorageError = "You need to configure artifact storage. More information on how to do this can be found in the docs: https://argo-workflows.readthedocs.io/en/latest/configure-artifact-repository/"
 	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
@@ -589,3 +1150,44 @@ func TestReportOutputs(t *testing.T) {
 	})
 
 }
+
+type fakeWaiter struct {
+	mocks.ContainerRuntimeExecutor
+}
+
+func (f *fakeWaiter) Wait(ctx context.Context, _ []string) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestWaitLivenessProbeRestart(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+
+	podWatch := watch.NewFake()
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependWatchReactor("pods", k8stesting.DefaultWatchReactor(podWatch, nil))
+	probe := &corev1.Probe{ProbeHandler: corev1.ProbeHandler{Exec: &corev1.ExecAction{Command: []string{"true"}}}}
+	we := WorkflowExecutor{
+		PodName:         fakePodName,
+		Namespace:       fakeNamespace,
+		ClientSet:       clientset,
+		RuntimeExecutor: &fakeWaiter{},
+		Template:        wfv1.Template{Container: &corev1.Container{LivenessProbe: probe}},
+	}
+	errs := make(chan error, 1)
+	go func() { errs <- we.Wait(ctx) }()
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: fakePodName, Namespace: fakeNamespace},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: common.WaitContainerName}, {Name: fakeContainerName, LivenessProbe: probe}}},
+		Status:     corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{Name: common.WaitContainerName}, {Name: fakeContainerName}}},
+	}
+	podWatch.Add(pod.DeepCopy())
+	// a restarted container runs again, rather than completing
+	pod.Status.ContainerStatuses[1].RestartCount = 1
+	pod.Status.ContainerStatuses[1].LastTerminationState.Terminated = &corev1.ContainerStateTerminated{ExitCode: 137}
+	podWatch.Modify(pod.DeepCopy())
+
+	require.EqualError(t, <-errs, "main: container restarted after its livenessProbe failed (exit code 137)")
+	podWatch.Stop()
+}
@@ -0,0 +1,69 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/authn/k8schain"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// lookupImageLabels returns the labels in the config of the image, read from its registry.
+// Only the node's cloud provider credentials are available to the executor, so private images must be readable with those.
+var lookupImageLabels = func(ctx context.Context, image string) (map[string]string, error) {
+	kc, err := k8schain.NewNoClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	img, err := remote.Image(ref, remote.WithAuthFromKeychain(kc), remote.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	f, err := img.ConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	return f.Config.Labels, nil
+}
+
+// resolveImageLabel sets the URL of an artifact whose location is read from an image label, so that it can be
+// loaded by the http or artifactory driver.
+func (we *WorkflowExecutor) resolveImageLabel(ctx context.Context, art *wfv1.Artifact) error {
+	src := art.FromImageLabel
+	image := src.Image
+	if image == "" {
+		switch {
+		case we.Template.Container != nil:
+			image = we.Template.Container.Image
+		case we.Template.Script != nil:
+			image = we.Template.Script.Image
+		default:
+			return argoerrs.Errorf(argoerrs.CodeBadRequest, "artifact '%s' fromImageLabel.image is required for templates without a container or script", art.Name)
+		}
+	}
+	labels, err := lookupImageLabels(ctx, image)
+	if err != nil {
+		return fmt.Errorf("failed to read labels of image %s: %w", image, err)
+	}
+	url := labels[src.Label]
+	if url == "" {
+		return argoerrs.Errorf(argoerrs.CodeNotFound, "image %s has no label '%s'", image, src.Label)
+	}
+	if art.Artifactory != nil {
+		art.Artifactory.URL = url
+	} else {
+		if art.HTTP == nil {
+			art.HTTP = &wfv1.HTTPArtifact{}
+		}
+		art.HTTP.URL = url
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestWorkflowExecutor_resolveImageLabel(t *testing.T) {
+	defer func(f func(context.Context, string) (map[string]string, error)) { lookupImageLabels = f }(lookupImageLabels)
+	lookupImageLabels = func(ctx context.Context, image string) (map[string]string, error) {
+		if image == "my-image" {
+			return map[string]string{"artifact-url": "https://example.com/" + image}, nil
+		}
+		return nil, fmt.Errorf("not found")
+	}
+	ctx := logging.TestContext(t.Context())
+	we := WorkflowExecutor{Template: wfv1.Template{Container: &apiv1.Container{Image: "my-image"}}}
+	t.Run("MainContainerImage", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "foo", ArtifactLocation: wfv1.ArtifactLocation{FromImageLabel: &wfv1.ImageLabelSource{Label: "artifact-url"}}}
+		require.NoError(t, we.resolveImageLabel(ctx, art))
+		require.NotNil(t, art.HTTP)
+		assert.Equal(t, "https://example.com/my-image", art.HTTP.URL)
+		assert.True(t, art.HasLocation())
+	})
+	t.Run("Artifactory", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "foo", ArtifactLocation: wfv1.ArtifactLocation{
+			FromImageLabel: &wfv1.ImageLabelSource{Image: "my-image", Label: "artifact-url"},
+			Artifactory:    &wfv1.ArtifactoryArtifact{},
+		}}
+		require.NoError(t, we.resolveImageLabel(ctx, art))
+		assert.Nil(t, art.HTTP)
+		assert.Equal(t, "https://example.com/my-image", art.Artifactory.URL)
+	})
+	t.Run("ErrNoLabel", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "foo", ArtifactLocation: wfv1.ArtifactLocation{FromImageLabel: &wfv1.ImageLabelSource{Label: "other"}}}
+		require.EqualError(t, we.resolveImageLabel(ctx, art), "image my-image has no label 'other'")
+	})
+	t.Run("ErrLookup", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "foo", ArtifactLocation: wfv1.ArtifactLocation{FromImageLabel: &wfv1.ImageLabelSource{Image: "other-image", Label: "artifact-url"}}}
+		require.EqualError(t, we.resolveImageLabel(ctx, art), "failed to read labels of image other-image: not found")
+	})
+	t.Run("OptionalNotFound", func(t *testing.T) {
+		we := WorkflowExecutor{Template: wfv1.Template{
+			Container: &apiv1.Container{Image: "my-image"},
+			Inputs: wfv1.Inputs{Artifacts: []wfv1.Artifact{{
+				Name:             "foo",
+				Path:             "/tmp/foo",
+				Optional:         true,
+				ArtifactLocation: wfv1.ArtifactLocation{FromImageLabel: &wfv1.ImageLabelSource{Label: "other"}},
+			}}},
+		}}
+		require.NoError(t, we.LoadArtifacts(ctx))
+	})
+}
@@ -0,0 +1,172 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	artifactcommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/executor/osspecific"
+)
+
+// namedPipeDir is where the wait container finds the named pipes of output artifacts, in the mirrored volume mounts of
+// the main container
+var namedPipeDir = common.ExecutorMainFilesystemDir
+
+// namedPipeUpload is the upload of an output artifact from a named pipe, which runs while the main container does
+type namedPipeUpload struct {
+	art  wfv1.Artifact
+	path string
+	done chan struct{}
+	err  error
+}
+
+// StartNamedPipeArtifacts creates the named pipe of each output artifact that has one, and starts uploading what the
+// main container writes to it. SaveArtifacts waits for the uploads to finish.
+func (we *WorkflowExecutor) StartNamedPipeArtifacts(ctx context.Context) {
+	for _, art := range we.Template.Outputs.Artifacts {
+		if art.NamedPipe == nil {
+			continue
+		}
+		upload := &namedPipeUpload{
+			art:  art,
+			path: filepath.Join(namedPipeDir, art.NamedPipe.Path),
+			done: make(chan struct{}),
+		}
+		if we.namedPipeUploads == nil {
+			we.namedPipeUploads = make(map[string]*namedPipeUpload)
+		}
+		we.namedPipeUploads[art.Name] = upload
+		if err := CreateNamedPipe(upload.path); err != nil {
+			upload.err = fmt.Errorf("failed to create the named pipe of artifact %q: %w", art.Name, err)
+			close(upload.done)
+			continue
+		}
+		logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"name": art.Name, "path": art.NamedPipe.Path}).Info(ctx, "Uploading artifact from named pipe")
+		go func() {
+			defer close(upload.done)
+			upload.err = we.saveNamedPipeArtifact(ctx, &upload.art, upload.path)
+		}()
+	}
+}
+
+// CreateNamedPipe creates a named pipe that any container user can write to, unless it was already created. The emissary
+// creates the pipes before it runs the main container's command, so that the command never creates a regular file in
+// their place, and the wait container creates them in case it starts first.
+func CreateNamedPipe(pipePath string) error {
+	err := osspecific.Mkfifo(pipePath, 0o666)
+	if errors.Is(err, fs.ErrExist) {
+		fi, err := os.Lstat(pipePath)
+		if err != nil {
+			return err
+		}
+		if fi.Mode()&fs.ModeNamedPipe == 0 {
+			return fmt.Errorf("%s is not a named pipe", pipePath)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// the umask of the container applies to mkfifo
+	return os.Chmod(pipePath, 0o666)
+}
+
+// saveNamedPipeArtifact uploads what is written to the named pipe until the main container closes it
+func (we *WorkflowExecutor) saveNamedPipeArtifact(ctx context.Context, art *wfv1.Artifact, pipePath string) error {
+	// opening blocks until the main container opens the pipe for writing
+	f, err := os.Open(filepath.Clean(pipePath))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	err = we.saveStream(ctx, art, f)
+	// drain the pipe, so that the main container is not blocked writing to it if the upload failed
+	_, _ = io.Copy(io.Discard, f)
+	return err
+}
+
+func (we *WorkflowExecutor) saveStream(ctx context.Context, art *wfv1.Artifact, reader io.Reader) error {
+	if !art.HasKey() {
+		if err := we.setArtifactKey(ctx, art, path.Base(art.NamedPipe.Path), ""); err != nil {
+			return err
+		}
+	}
+	driverArt, err := we.newDriverArt(art)
+	if err != nil {
+		return err
+	}
+	artDriver, err := we.InitDriver(ctx, driverArt)
+	if err != nil {
+		return err
+	}
+	if saver, ok := artDriver.(artifactcommon.ArtifactStreamSaver); ok {
+		err := saver.SaveStream(ctx, reader, driverArt)
+		if !errors.Is(err, artifactcommon.ErrSaveStreamNotSupported) {
+			return err
+		}
+	}
+	// the artifact storage can only save files
+	logging.RequireLoggerFromContext(ctx).WithField("name", art.Name).Warn(ctx, "The artifact storage cannot save a stream, saving the named pipe to a file first")
+	if err := os.MkdirAll(tempOutArtDir, os.ModePerm); err != nil {
+		return err
+	}
+	localArtPath := filepath.Join(tempOutArtDir, art.Name)
+	if err := copyToFile(localArtPath, reader); err != nil {
+		return err
+	}
+	if err := artDriver.Save(ctx, localArtPath, driverArt); err != nil {
+		return err
+	}
+	we.maybeDeleteLocalArtPath(ctx, localArtPath)
+	return nil
+}
+
+func copyToFile(localPath string, reader io.Reader) error {
+	f, err := os.Create(filepath.Clean(localPath))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, reader); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// finishNamedPipeArtifact waits for the upload of an output artifact from its named pipe, once the main container has
+// completed
+func (we *WorkflowExecutor) finishNamedPipeArtifact(ctx context.Context, art *wfv1.Artifact) (bool, error) {
+	upload, ok := we.namedPipeUploads[art.Name]
+	if !ok {
+		return false, fmt.Errorf("the upload of artifact %q from its named pipe was not started", art.Name)
+	}
+	for done := false; !done; {
+		// if the main container never opened the pipe, the upload is still waiting to open it, so open it for writing,
+		// and close it, to end the upload
+		if w, err := os.OpenFile(upload.path, os.O_WRONLY|syscall.O_NONBLOCK, 0); err == nil {
+			_ = w.Close()
+		}
+		select {
+		case <-upload.done:
+			done = true
+		case <-time.After(time.Second):
+		}
+	}
+	if upload.err != nil {
+		return false, upload.err
+	}
+	*art = upload.art
+	logging.RequireLoggerFromContext(ctx).WithField("name", art.Name).Info(ctx, "Successfully saved artifact from named pipe")
+	return true, nil
+}
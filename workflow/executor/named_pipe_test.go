@@ -0,0 +1,86 @@
+//go:build !windows
+
+package executor
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+func TestNamedPipeArtifacts(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	dir := t.TempDir()
+	defer func(dir string) { namedPipeDir = dir }(namedPipeDir)
+	namedPipeDir = dir
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "pipes"), 0o700))
+
+	var mu sync.Mutex
+	saved := map[string]string{}
+	// a stream is uploaded without a content length
+	var contentLengths []int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		mu.Lock()
+		defer mu.Unlock()
+		saved[r.URL.Path] = string(data)
+		contentLengths = append(contentLengths, r.ContentLength)
+	}))
+	defer server.Close()
+
+	artifact := func(name string) wfv1.Artifact {
+		return wfv1.Artifact{
+			Name:             name,
+			NamedPipe:        &wfv1.NamedPipeArtifact{Path: "/pipes/" + name + ".txt"},
+			ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + "/" + name + ".txt"}},
+		}
+	}
+	we := WorkflowExecutor{
+		Template: wfv1.Template{
+			Outputs: wfv1.Outputs{Artifacts: wfv1.Artifacts{artifact("numbers"), artifact("unused")}},
+		},
+	}
+	we.StartNamedPipeArtifacts(ctx)
+
+	// the main container writes to the pipe while it runs
+	data := strings.Repeat("0123456789\n", 100000)
+	pipe, err := os.OpenFile(filepath.Join(dir, "pipes", "numbers.txt"), os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = pipe.WriteString(data)
+	require.NoError(t, err)
+	require.NoError(t, pipe.Close())
+
+	// the main container never opens the unused pipe
+	artifacts, err := we.SaveArtifacts(ctx)
+	require.NoError(t, err)
+	assert.Len(t, artifacts, 2)
+	assert.Equal(t, map[string]string{"/numbers.txt": data, "/unused.txt": ""}, saved)
+	assert.Equal(t, []int64{-1, -1}, contentLengths)
+}
+
+func TestCreateNamedPipe(t *testing.T) {
+	dir := t.TempDir()
+	pipePath := filepath.Join(dir, "pipe")
+	require.NoError(t, CreateNamedPipe(pipePath))
+	fi, err := os.Lstat(pipePath)
+	require.NoError(t, err)
+	assert.Equal(t, os.ModeNamedPipe|0o666, fi.Mode())
+	// the main container already created it
+	require.NoError(t, CreateNamedPipe(pipePath))
+
+	filePath := filepath.Join(dir, "file")
+	require.NoError(t, os.WriteFile(filePath, nil, 0o600))
+	require.EqualError(t, CreateNamedPipe(filePath), filePath+" is not a named pipe")
+}
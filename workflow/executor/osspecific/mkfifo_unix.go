@@ -0,0 +1,10 @@
+//go:build linux || darwin
+
+package osspecific
+
+import "syscall"
+
+// Mkfifo creates a named pipe
+func Mkfifo(path string, mode uint32) error {
+	return syscall.Mkfifo(path, mode)
+}
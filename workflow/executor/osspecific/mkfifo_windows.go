@@ -0,0 +1,8 @@
+package osspecific
+
+import "errors"
+
+// Mkfifo creates a named pipe
+func Mkfifo(string, uint32) error {
+	return errors.New("named pipes are not supported on windows")
+}
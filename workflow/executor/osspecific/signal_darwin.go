@@ -10,6 +10,8 @@ import (
 
 var (
 	Term = syscall.SIGTERM
+	Stop = syscall.SIGSTOP
+	Cont = syscall.SIGCONT
 )
 
 func CanIgnoreSignal(s os.Signal) bool {
@@ -12,6 +12,9 @@ import (
 
 var (
 	Term = os.Interrupt
+	// Stop and Cont are zero as processes cannot be paused with a signal on windows
+	Stop syscall.Signal
+	Cont syscall.Signal
 
 	modkernel32            = windows.NewLazySystemDLL("kernel32.dll")
 	procCreateRemoteThread = modkernel32.NewProc("CreateRemoteThread")
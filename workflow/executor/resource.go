@@ -19,10 +19,12 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/util/jsonpath"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/gengo/namer"
 	gengotypes "k8s.io/gengo/types"
 	kubectlcmd "k8s.io/kubectl/pkg/cmd"
+	kubectlget "k8s.io/kubectl/pkg/cmd/get"
 	kubectlutil "k8s.io/kubectl/pkg/cmd/util"
 	"sigs.k8s.io/yaml"
 
@@ -31,17 +33,26 @@ import (
 	envutil "github.com/argoproj/argo-workflows/v3/util/env"
 	argoerr "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 // ExecResource will run kubectl action against a manifest
 func (we *WorkflowExecutor) ExecResource(ctx context.Context, action string, manifestPath string, flags []string) (string, string, string, error) {
+	// flags may contain parameters, so they are validated again once they have been substituted
+	if err := common.ValidateResourceFlags(flags); err != nil {
+		return "", "", "", errors.New(errors.CodeBadRequest, err.Error())
+	}
 	args, err := we.getKubectlArguments(action, manifestPath, flags)
 	if err != nil {
 		return "", "", "", err
 	}
 
+	backoff, err := we.Template.Resource.GetBackoff(retry.DefaultBackoff)
+	if err != nil {
+		return "", "", "", errors.Errorf(errors.CodeBadRequest, "resource: %s", err)
+	}
 	var out []byte
-	err = retry.OnError(retry.DefaultBackoff, func(err error) bool {
+	err = retry.OnError(backoff, func(err error) bool {
 		return argoerr.IsTransientErr(ctx, err)
 	}, func() error {
 		out, err = runKubectl(ctx, args...)
@@ -131,7 +142,13 @@ func (we *WorkflowExecutor) getKubectlArguments(action string, manifestPath stri
 	}
 
 	appendFileFlag := true
-	if action == "patch" {
+	if we.Template.Resource != nil && we.Template.Resource.MergeStrategy == common.ResourceMergeStrategyServerSide {
+		// server-side apply sends the whole manifest as a PATCH with the application/apply-patch+yaml content type
+		args = []string{"kubectl", "apply", "--server-side"}
+		if !common.HasResourceFlag(flags, "--field-manager") {
+			args = append(args, "--field-manager", common.ResourceFieldManager)
+		}
+	} else if action == "patch" {
 		mergeStrategy := "strategic"
 		if we.Template.Resource.MergeStrategy != "" {
 			mergeStrategy = we.Template.Resource.MergeStrategy
@@ -245,28 +262,115 @@ func (we *WorkflowExecutor) WaitResource(ctx context.Context, resourceNamespace,
 // checkResourceState performs resource status checking and then waiting on json reading.
 // The returning boolean indicates whether we should retry.
 func (we *WorkflowExecutor) checkResourceState(ctx context.Context, selfLink string, successReqs labels.Requirements, failReqs labels.Requirements) (bool, error) {
+	jsonBytes, err := we.getResourceJSON(ctx, selfLink)
+	if err != nil {
+		return false, err
+	}
+	return matchConditions(ctx, jsonBytes, successReqs, failReqs)
+}
+
+// getResourceJSON gets the resource as JSON. An error that is not transient should not be retried.
+func (we *WorkflowExecutor) getResourceJSON(ctx context.Context, selfLink string) ([]byte, error) {
 	request := we.RESTClient.Get().RequestURI(selfLink)
 	stream, err := request.Stream(ctx)
 
 	if err != nil {
 		err = errors.Cause(err)
 		if apierr.IsNotFound(err) {
-			return false, errors.Errorf(errors.CodeNotFound, "The resource has been deleted while its status was still being checked. Will not be retried: %v", err)
+			return nil, errors.Errorf(errors.CodeNotFound, "The resource has been deleted while its status was still being checked. Will not be retried: %v", err)
 		}
-		return false, err
+		return nil, err
 	}
 
 	defer func() { _ = stream.Close() }()
 	jsonBytes, err := io.ReadAll(stream)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	jsonString := string(jsonBytes)
 	logging.RequireLoggerFromContext(ctx).Debug(ctx, jsonString)
 	if !gjson.Valid(jsonString) {
-		return false, errors.Errorf(errors.CodeNotFound, "Encountered invalid JSON response when checking resource status. Will not be retried: %q", jsonString)
+		return nil, errors.Errorf(errors.CodeNotFound, "Encountered invalid JSON response when checking resource status. Will not be retried: %q", jsonString)
 	}
-	return matchConditions(ctx, jsonBytes, successReqs, failReqs)
+	return jsonBytes, nil
+}
+
+// WaitForResource polls the resource until the JSON path of the template's waitForResource has its value, or the
+// timeout elapses
+func (we *WorkflowExecutor) WaitForResource(ctx context.Context, resourceNamespace, resourceName, selfLink string) error {
+	waitFor := we.Template.Resource.WaitForResource
+	if waitFor == nil {
+		return nil
+	}
+	logger := logging.RequireLoggerFromContext(ctx).WithFields(logging.Fields{"name": resourceName, "namespace": resourceNamespace})
+	path, err := parseJSONPath(waitFor.JSONPath)
+	if err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "waitForResource.jsonPath '%s' failed to parse: %v", waitFor.JSONPath, err)
+	}
+	interval := envutil.LookupEnvDurationOr(ctx, "RESOURCE_STATE_CHECK_INTERVAL", time.Second*5)
+	if waitFor.PollInterval != nil {
+		interval = waitFor.PollInterval.Duration
+	}
+	logger.WithFields(logging.Fields{"jsonPath": waitFor.JSONPath, "value": waitFor.Value}).Info(ctx, "Waiting for resource")
+	var value string
+	// the resource is got with the context of the executor rather than of the poll, so that a get that is in progress
+	// when the timeout elapses is not reported instead of the timeout
+	condition := func(context.Context) (bool, error) {
+		jsonBytes, err := we.getResourceJSON(ctx, selfLink)
+		if err != nil {
+			if argoerr.IsTransientErr(ctx, err) {
+				logger.WithError(err).Info(ctx, "Waiting for resource resulted in retryable error")
+				return false, nil
+			}
+			return false, err
+		}
+		value, err = evaluateJSONPath(path, jsonBytes)
+		if err != nil {
+			return false, err
+		}
+		logger.WithField("value", value).Debug(ctx, "Evaluated waitForResource.jsonPath")
+		return value == waitFor.Value, nil
+	}
+	if waitFor.Timeout != nil {
+		err = wait.PollUntilContextTimeout(ctx, interval, waitFor.Timeout.Duration, true, condition)
+	} else {
+		err = wait.PollUntilContextCancel(ctx, interval, true, condition)
+	}
+	if wait.Interrupted(err) && waitFor.Timeout != nil && ctx.Err() == nil {
+		return errors.Errorf(errors.CodeTimeout, "timed out after %s waiting for %s to be %q, it was %q", waitFor.Timeout.Duration, waitFor.JSONPath, waitFor.Value, value)
+	}
+	if err != nil {
+		return err
+	}
+	logger.Info(ctx, "Returning from successful wait for resource")
+	return nil
+}
+
+// parseJSONPath parses a kubectl JSON path expression, which may omit the braces and leading dot
+func parseJSONPath(expr string) (*jsonpath.JSONPath, error) {
+	relaxed, err := kubectlget.RelaxedJSONPathExpression(expr)
+	if err != nil {
+		return nil, err
+	}
+	path := jsonpath.New("waitForResource").AllowMissingKeys(true)
+	if err := path.Parse(relaxed); err != nil {
+		return nil, err
+	}
+	return path, nil
+}
+
+// evaluateJSONPath returns the results of the JSON path in the resource, separated by spaces. A path that is missing
+// from the resource, such as a status that has not been set yet, is empty.
+func evaluateJSONPath(path *jsonpath.JSONPath, jsonBytes []byte) (string, error) {
+	var obj interface{}
+	if err := json.Unmarshal(jsonBytes, &obj); err != nil {
+		return "", err
+	}
+	buf := &bytes.Buffer{}
+	if err := path.Execute(buf, obj); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
 }
 
 // matchConditions checks whether the returned JSON bytes match success or failure conditions.
@@ -381,7 +485,8 @@ func jqFilter(ctx context.Context, input []byte, filter string) (string, error)
 	return strings.TrimSpace(buf.String()), nil
 }
 
-func runKubectl(ctx context.Context, args ...string) ([]byte, error) {
+// runKubectl is a variable so that tests can replace kubectl
+var runKubectl = func(ctx context.Context, args ...string) ([]byte, error) {
 	logging.RequireLoggerFromContext(ctx).Info(ctx, strings.Join(args, " "))
 	osArgs := append([]string{}, os.Args...)
 	os.Args = args
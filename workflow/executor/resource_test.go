@@ -1,18 +1,30 @@
 package executor
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
+	"path/filepath"
 	"runtime"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
@@ -126,6 +138,22 @@ func TestResourcePatchFlags(t *testing.T) {
 	}
 }
 
+func TestResourceServerSideFlags(t *testing.T) {
+	manifestPath := "../../examples/hello-world.yaml"
+	for _, action := range []string{"apply", "patch"} {
+		t.Run(action, func(t *testing.T) {
+			we := WorkflowExecutor{Template: wfv1.Template{Resource: &wfv1.ResourceTemplate{Action: action, MergeStrategy: "server-side"}}}
+			args, err := we.getKubectlArguments(action, manifestPath, nil)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"kubectl", "apply", "--server-side", "--field-manager", "argo-workflows", "-f", manifestPath, "-o", "json"}, args)
+
+			args, err = we.getKubectlArguments(action, manifestPath, []string{"--field-manager=my-manager", "--force-conflicts"})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"kubectl", "apply", "--server-side", "--field-manager=my-manager", "--force-conflicts", "-f", manifestPath, "-o", "json"}, args)
+		})
+	}
+}
+
 // TestResourceConditionsMatching tests whether the JSON response match
 // with either success or failure conditions.
 func TestResourceConditionsMatching(t *testing.T) {
@@ -157,6 +185,79 @@ func TestResourceConditionsMatching(t *testing.T) {
 	assert.True(t, finished)
 }
 
+// phaseServer serves a resource whose phase is Pending until it has been got succeedAfter times
+func phaseServer(t *testing.T, succeedAfter int32) (rest.Interface, *atomic.Int32) {
+	var gets atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		phase := "Pending"
+		if gets.Add(1) > succeedAfter {
+			phase = "Succeeded"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"metadata":{"name":"my-job"},"status":{"phase":"` + phase + `","conditions":[{"type":"Ready","status":"True"}]}}`))
+	}))
+	t.Cleanup(server.Close)
+	client, err := rest.UnversionedRESTClientFor(&rest.Config{
+		Host:          server.URL,
+		ContentConfig: rest.ContentConfig{NegotiatedSerializer: scheme.Codecs.WithoutConversion()},
+	})
+	require.NoError(t, err)
+	return client, &gets
+}
+
+func TestWaitForResource(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	newExecutor := func(client rest.Interface, waitFor *wfv1.WaitForResource) *WorkflowExecutor {
+		return &WorkflowExecutor{
+			RESTClient: client,
+			Template:   wfv1.Template{Resource: &wfv1.ResourceTemplate{Action: "create", WaitForResource: waitFor}},
+		}
+	}
+	interval := &metav1.Duration{Duration: 10 * time.Millisecond}
+
+	t.Run("Success", func(t *testing.T) {
+		client, gets := phaseServer(t, 2)
+		we := newExecutor(client, &wfv1.WaitForResource{JSONPath: ".status.phase", Value: "Succeeded", PollInterval: interval})
+		require.NoError(t, we.WaitForResource(ctx, "default", "my-job", "/apis/batch/v1/namespaces/default/jobs/my-job"))
+		assert.Equal(t, int32(3), gets.Load())
+	})
+	t.Run("Filter", func(t *testing.T) {
+		client, _ := phaseServer(t, 0)
+		we := newExecutor(client, &wfv1.WaitForResource{JSONPath: `{.status.conditions[?(@.type=="Ready")].status}`, Value: "True", PollInterval: interval})
+		require.NoError(t, we.WaitForResource(ctx, "default", "my-job", "/apis/batch/v1/namespaces/default/jobs/my-job"))
+	})
+	t.Run("Timeout", func(t *testing.T) {
+		client, _ := phaseServer(t, 1000)
+		we := newExecutor(client, &wfv1.WaitForResource{
+			JSONPath:     ".status.phase",
+			Value:        "Succeeded",
+			Timeout:      &metav1.Duration{Duration: 50 * time.Millisecond},
+			PollInterval: interval,
+		})
+		err := we.WaitForResource(ctx, "default", "my-job", "/apis/batch/v1/namespaces/default/jobs/my-job")
+		require.EqualError(t, err, `timed out after 50ms waiting for .status.phase to be "Succeeded", it was "Pending"`)
+	})
+	t.Run("InvalidJSONPath", func(t *testing.T) {
+		we := newExecutor(nil, &wfv1.WaitForResource{JSONPath: "{.status.phase", Value: "Succeeded"})
+		err := we.WaitForResource(ctx, "default", "my-job", "/apis/batch/v1/namespaces/default/jobs/my-job")
+		require.ErrorContains(t, err, "waitForResource.jsonPath '{.status.phase' failed to parse")
+	})
+}
+
+func Test_evaluateJSONPath(t *testing.T) {
+	path, err := parseJSONPath("{.status.missing}")
+	require.NoError(t, err)
+	value, err := evaluateJSONPath(path, []byte(`{"status":{}}`))
+	require.NoError(t, err)
+	assert.Empty(t, value)
+
+	path, err = parseJSONPath(".spec.containers[*].name")
+	require.NoError(t, err)
+	value, err = evaluateJSONPath(path, []byte(`{"spec":{"containers":[{"name":"main"},{"name":"wait"}]}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "main wait", value)
+}
+
 // TestInferSelfLink tests whether the inferred self link for k8s objects are correct.
 func TestInferSelfLink(t *testing.T) {
 	obj := unstructured.Unstructured{}
@@ -223,6 +324,49 @@ func TestResourceExecRetry(t *testing.T) {
 	require.ErrorContains(t, err, "no more retries")
 }
 
+// TestExecResourceBackoff tests that the action is retried after transient errors using the template's backoff
+func TestExecResourceBackoff(t *testing.T) {
+	defer func(f func(context.Context, ...string) ([]byte, error)) { runKubectl = f }(runKubectl)
+	var calls int
+	runKubectl = func(context.Context, ...string) ([]byte, error) {
+		calls++
+		if calls <= 2 {
+			return nil, apierr.NewTooManyRequests("try again later", 0)
+		}
+		return []byte(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "my-cm", "namespace": "default"}}`), nil
+	}
+	resource := &wfv1.ResourceTemplate{
+		Action:       "create",
+		BackoffLimit: ptr.To(int32(2)),
+		Backoff:      &wfv1.Backoff{Duration: "1ms", Factor: ptr.To(intstr.FromInt32(2))},
+	}
+	we := WorkflowExecutor{Template: wfv1.Template{Resource: resource}}
+	ctx := logging.TestContext(t.Context())
+
+	t.Run("Success", func(t *testing.T) {
+		calls = 0
+		namespace, name, _, err := we.ExecResource(ctx, "create", "../../examples/hello-world.yaml", nil)
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, "default", namespace)
+		assert.Equal(t, "configmap./my-cm", name)
+	})
+	t.Run("NoMoreRetries", func(t *testing.T) {
+		calls = 0
+		resource.BackoffLimit = ptr.To(int32(1))
+		_, _, _, err := we.ExecResource(ctx, "create", "../../examples/hello-world.yaml", nil)
+		require.ErrorContains(t, err, "no more retries")
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestExecResourceDisallowedFlags(t *testing.T) {
+	we := WorkflowExecutor{Template: wfv1.Template{Resource: &wfv1.ResourceTemplate{Action: "apply"}}}
+	ctx := logging.TestContext(t.Context())
+	_, _, _, err := we.ExecResource(ctx, "apply", "../../examples/hello-world.yaml", []string{"--server", "https://example.com"})
+	require.EqualError(t, err, `flag "--server" is not allowed`)
+}
+
 func Test_jqFilter(t *testing.T) {
 	for _, testCase := range []struct {
 		input  []byte
@@ -247,3 +391,61 @@ func Test_runKubectl(t *testing.T) {
 	require.NoError(t, err)
 	assert.Contains(t, string(out), "clientVersion")
 }
+
+func TestExecResourceServerSideApply(t *testing.T) {
+	var contentType, fieldManager string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api":
+			_, _ = w.Write([]byte(`{"kind": "APIVersions", "versions": ["v1"]}`))
+		case r.URL.Path == "/apis":
+			_, _ = w.Write([]byte(`{"kind": "APIGroupList", "groups": []}`))
+		case r.URL.Path == "/api/v1":
+			_, _ = w.Write([]byte(`{"kind": "APIResourceList", "groupVersion": "v1", "resources": [{"name": "configmaps", "namespaced": true, "kind": "ConfigMap", "verbs": ["get", "patch"]}]}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/namespaces/default/configmaps/my-cm":
+			contentType = r.Header.Get("Content-Type")
+			fieldManager = r.URL.Query().Get("fieldManager")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "my-cm", "namespace": "default"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	kubeconfig := filepath.Join(dir, "kubeconfig")
+	require.NoError(t, os.WriteFile(kubeconfig, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: `+server.URL+`
+contexts:
+- name: test
+  context:
+    cluster: test
+    namespace: default
+current-context: test
+`), 0o600))
+	manifest := filepath.Join(dir, "manifest.yaml")
+	require.NoError(t, os.WriteFile(manifest, []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-cm
+data:
+  foo: bar
+`), 0o600))
+	t.Setenv("KUBECONFIG", kubeconfig)
+	t.Setenv("HOME", dir)
+
+	we := WorkflowExecutor{Template: wfv1.Template{Resource: &wfv1.ResourceTemplate{Action: "apply", MergeStrategy: "server-side"}}}
+	ctx := logging.TestContext(t.Context())
+	// the fake API server does not serve the OpenAPI schema used for client-side validation
+	namespace, name, _, err := we.ExecResource(ctx, "apply", manifest, []string{"--validate=false"})
+	require.NoError(t, err)
+	assert.Equal(t, "default", namespace)
+	assert.Equal(t, "configmap./my-cm", name)
+	assert.Equal(t, "application/apply-patch+yaml", contentType)
+	assert.Equal(t, "argo-workflows", fieldManager)
+}
@@ -0,0 +1,57 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// scriptConfigMapDir is a variable so that tests can read the script source from a temporary directory
+var scriptConfigMapDir = common.ExecutorScriptConfigMapDir
+
+// scriptSource returns the source of the script template, which is read from the ConfigMap mounted by the controller
+// when the workflow does not include scripts in the template
+func (we *WorkflowExecutor) scriptSource() (string, error) {
+	if we.Template.Script.Source != "" {
+		return we.Template.Script.Source, nil
+	}
+	data, err := os.ReadFile(filepath.Join(scriptConfigMapDir, common.ScriptConfigMapKey))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", argoerrs.InternalWrapError(err)
+	}
+	return string(data), nil
+}
+
+// PreprocessScript pipes the source of a script through each of the preprocessors in order, and returns the output of
+// the last one. The emissary runs it in the main container, so that the commands are those of the script's image.
+func PreprocessScript(ctx context.Context, source string, preprocessors []wfv1.Preprocessor) ([]byte, error) {
+	logger := logging.RequireLoggerFromContext(ctx)
+	body := []byte(source)
+	for i, p := range preprocessors {
+		if len(p.Command) == 0 {
+			return nil, argoerrs.Errorf(argoerrs.CodeBadRequest, "script preprocessor %d has no command", i)
+		}
+		logger.WithField("command", p.Command).Info(ctx, "Preprocessing script source")
+		var stdout, stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, p.Command[0], p.Command[1:]...)
+		cmd.Stdin = bytes.NewReader(body)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return nil, argoerrs.Errorf(argoerrs.CodeBadRequest, "script preprocessor %v failed: %v: %s", p.Command, err, strings.TrimSpace(stderr.String()))
+		}
+		body = stdout.Bytes()
+	}
+	return body, nil
+}
@@ -0,0 +1,69 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func Test_PreprocessScript(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	source := "echo hello\n"
+	t.Run("None", func(t *testing.T) {
+		body, err := PreprocessScript(ctx, source, nil)
+		require.NoError(t, err)
+		assert.Equal(t, source, string(body))
+	})
+	t.Run("Uppercase", func(t *testing.T) {
+		body, err := PreprocessScript(ctx, source, []wfv1.Preprocessor{{Command: []string{"tr", "a-z", "A-Z"}}})
+		require.NoError(t, err)
+		assert.Equal(t, "ECHO HELLO\n", string(body))
+	})
+	t.Run("InOrder", func(t *testing.T) {
+		body, err := PreprocessScript(ctx, source, []wfv1.Preprocessor{
+			{Command: []string{"tr", "a-z", "A-Z"}},
+			{Command: []string{"sed", "s/HELLO/world/"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ECHO world\n", string(body))
+	})
+	t.Run("Failed", func(t *testing.T) {
+		_, err := PreprocessScript(ctx, source, []wfv1.Preprocessor{{Command: []string{"sh", "-c", "echo oops >&2; exit 1"}}})
+		require.EqualError(t, err, "script preprocessor [sh -c echo oops >&2; exit 1] failed: exit status 1: oops")
+	})
+	t.Run("NoCommand", func(t *testing.T) {
+		_, err := PreprocessScript(ctx, source, []wfv1.Preprocessor{{}})
+		require.EqualError(t, err, "script preprocessor 0 has no command")
+	})
+}
+
+func TestWorkflowExecutor_scriptSource(t *testing.T) {
+	scriptConfigMapDir = t.TempDir()
+	defer func() { scriptConfigMapDir = common.ExecutorScriptConfigMapDir }()
+	t.Run("Included", func(t *testing.T) {
+		we := WorkflowExecutor{Template: wfv1.Template{Script: &wfv1.ScriptTemplate{Source: "echo hello\n"}}}
+		source, err := we.scriptSource()
+		require.NoError(t, err)
+		assert.Equal(t, "echo hello\n", source)
+	})
+	t.Run("NotMounted", func(t *testing.T) {
+		we := WorkflowExecutor{Template: wfv1.Template{Script: &wfv1.ScriptTemplate{}}}
+		source, err := we.scriptSource()
+		require.NoError(t, err)
+		assert.Empty(t, source)
+	})
+	t.Run("ConfigMap", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(scriptConfigMapDir, common.ScriptConfigMapKey), []byte("echo mounted\n"), 0o644))
+		we := WorkflowExecutor{Template: wfv1.Template{Script: &wfv1.ScriptTemplate{}}}
+		source, err := we.scriptSource()
+		require.NoError(t, err)
+		assert.Equal(t, "echo mounted\n", source)
+	})
+}
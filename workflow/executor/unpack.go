@@ -0,0 +1,203 @@
+package executor
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// unpackToDir is where the init container finds the unpackTo directories of input artifacts, in the mirrored volume
+// mounts of the main container
+var unpackToDir = common.ExecutorMainFilesystemDir
+
+var errNotAnArchive = errors.New("not a tar, tar.gz, tar.zst or zip archive")
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	zipMagic  = []byte("PK\x03\x04")
+	// an empty zip archive only has the end of central directory record
+	emptyZipMagic = []byte("PK\x05\x06")
+	// the magic of both POSIX and GNU tar headers, at offset 257
+	tarMagic       = []byte("ustar")
+	tarMagicOffset = 257
+)
+
+// unpackArchive extracts an archive into a directory, detecting its format from its contents rather than its name
+func unpackArchive(ctx context.Context, archivePath string, destDir string) error {
+	f, err := os.Open(filepath.Clean(archivePath))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	// a short archive is detected from the bytes that there are
+	magic, _ := r.Peek(tarMagicOffset + len(tarMagic))
+	if err := os.MkdirAll(destDir, 0o755); err != nil {
+		return err
+	}
+	logger := logging.RequireLoggerFromContext(ctx)
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		logger.WithFields(logging.Fields{"src": archivePath, "dest": destDir}).Info(ctx, "Extracting tar.gz archive")
+		gzr, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gzr.Close()
+		return extractTar(gzr, destDir)
+	case bytes.HasPrefix(magic, zstdMagic):
+		logger.WithFields(logging.Fields{"src": archivePath, "dest": destDir}).Info(ctx, "Extracting tar.zst archive")
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		return extractTar(zr, destDir)
+	case bytes.HasPrefix(magic, zipMagic), bytes.HasPrefix(magic, emptyZipMagic):
+		logger.WithFields(logging.Fields{"src": archivePath, "dest": destDir}).Info(ctx, "Extracting zip archive")
+		return extractZip(archivePath, destDir)
+	case len(magic) > tarMagicOffset && bytes.HasPrefix(magic[tarMagicOffset:], tarMagic):
+		logger.WithFields(logging.Fields{"src": archivePath, "dest": destDir}).Info(ctx, "Extracting tar archive")
+		return extractTar(r, destDir)
+	}
+	return errNotAnArchive
+}
+
+// archiveTarget returns where an entry of an archive is extracted to, which must be in the destination directory and
+// must not be reached through a symlink, as it could have been created by an earlier entry to point outside it
+func archiveTarget(destDir string, name string) (string, error) {
+	target := filepath.Join(destDir, name) //nolint:gosec
+	if !inDir(destDir, target) {
+		return "", fmt.Errorf("%s: illegal file path", name)
+	}
+	for dir := target; dir != destDir; dir = filepath.Dir(dir) {
+		info, err := os.Lstat(dir)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return "", fmt.Errorf("%s: illegal file path through symlink %s", name, dir)
+		}
+	}
+	return target, nil
+}
+
+// inDir returns whether the path is the directory or in it
+func inDir(dir string, path string) bool {
+	return path == dir || strings.HasPrefix(path, dir+string(os.PathSeparator))
+}
+
+func extractTar(r io.Reader, destDir string) error {
+	destDir = filepath.Clean(destDir)
+	tr := tar.NewReader(r)
+	for first := true; ; first = false {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if first {
+				// a compressed file that is not a tar
+				return fmt.Errorf("%w: %w", errNotAnArchive, err)
+			}
+			return err
+		}
+		target, err := archiveTarget(destDir, header.Name)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			// a symlink may only point within the directory, even though it is not followed when extracting
+			if filepath.IsAbs(header.Linkname) || !inDir(destDir, filepath.Join(filepath.Dir(target), header.Linkname)) {
+				return fmt.Errorf("%s: illegal symlink target %s", header.Name, header.Linkname)
+			}
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return err
+			}
+		case tar.TypeLink:
+			linkTarget, err := archiveTarget(destDir, header.Linkname)
+			if err != nil {
+				return err
+			}
+			if err := os.Link(linkTarget, target); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := extractFile(target, os.FileMode(header.Mode).Perm(), tr); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func extractZip(zipPath string, destDir string) error {
+	destDir = filepath.Clean(destDir)
+	r, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	for _, f := range r.File {
+		target, err := archiveTarget(destDir, f.Name)
+		if err != nil {
+			return err
+		}
+		if f.FileInfo().IsDir() {
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		err = extractFile(target, f.Mode().Perm(), rc)
+		_ = rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractFile(target string, mode os.FileMode, r io.Reader) error {
+	f, err := os.OpenFile(filepath.Clean(target), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil { //nolint:gosec
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,224 @@
+package executor
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// archivedFiles are the files in each of the test archives
+var archivedFiles = map[string]string{
+	"README.md":   "hello",
+	"src/main.go": "package main",
+}
+
+func tarArchive(t *testing.T) []byte {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "src/", Typeflag: tar.TypeDir, Mode: 0o755}))
+	for _, name := range []string{"README.md", "src/main.go"} {
+		data := archivedFiles[name]
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(data))}))
+		_, err := tw.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+func tarGzArchive(t *testing.T) []byte {
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	_, err := gzw.Write(tarArchive(t))
+	require.NoError(t, err)
+	require.NoError(t, gzw.Close())
+	return buf.Bytes()
+}
+
+func tarZstArchive(t *testing.T) []byte {
+	var buf bytes.Buffer
+	zw, err := zstd.NewWriter(&buf)
+	require.NoError(t, err)
+	_, err = zw.Write(tarArchive(t))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func zipArchive(t *testing.T) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range []string{"README.md", "src/main.go"} {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(archivedFiles[name]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+// loadUnpackToArtifact loads an input artifact with the data, which is extracted to /src/argo
+func loadUnpackToArtifact(t *testing.T, data []byte) (string, error) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+	defer func(dir string) { inputArtifactsDir = dir }(inputArtifactsDir)
+	defer func(dir string) { unpackToDir = dir }(unpackToDir)
+	inputArtifactsDir = t.TempDir()
+	unpackToDir = t.TempDir()
+
+	we := WorkflowExecutor{
+		Template: wfv1.Template{
+			Inputs: wfv1.Inputs{Artifacts: []wfv1.Artifact{{
+				Name:             "source",
+				Path:             "/tmp/source",
+				UnpackTo:         "/src/argo",
+				ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + "/source"}},
+			}}},
+		},
+	}
+	err := we.LoadArtifacts(logging.TestContext(t.Context()))
+	if err == nil {
+		// the artifact is loaded to its path as it was downloaded
+		loaded, err := os.ReadFile(filepath.Join(inputArtifactsDir, "source"))
+		require.NoError(t, err)
+		assert.Equal(t, data, loaded)
+	}
+	return filepath.Join(unpackToDir, "src", "argo"), err
+}
+
+func TestLoadArtifactUnpackTo(t *testing.T) {
+	for name, archive := range map[string]func(*testing.T) []byte{
+		"TarGz":  tarGzArchive,
+		"Tar":    tarArchive,
+		"Zip":    zipArchive,
+		"TarZst": tarZstArchive,
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir, err := loadUnpackToArtifact(t, archive(t))
+			require.NoError(t, err)
+			for name, data := range archivedFiles {
+				extracted, err := os.ReadFile(filepath.Join(dir, name))
+				require.NoError(t, err)
+				assert.Equal(t, data, string(extracted))
+			}
+		})
+	}
+	t.Run("NotAnArchive", func(t *testing.T) {
+		_, err := loadUnpackToArtifact(t, []byte("hello"))
+		require.EqualError(t, err, "failed to unpack artifact source to /src/argo: not a tar, tar.gz, tar.zst or zip archive")
+	})
+	t.Run("CompressedNotATar", func(t *testing.T) {
+		var buf bytes.Buffer
+		gzw := gzip.NewWriter(&buf)
+		_, err := gzw.Write([]byte("hello"))
+		require.NoError(t, err)
+		require.NoError(t, gzw.Close())
+		_, err = loadUnpackToArtifact(t, buf.Bytes())
+		require.ErrorIs(t, err, errNotAnArchive)
+	})
+}
+
+func TestUnpackArchiveIllegalPath(t *testing.T) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "../escaped", Typeflag: tar.TypeReg, Mode: 0o644}))
+	require.NoError(t, tw.Close())
+	dir := t.TempDir()
+	archivePath := filepath.Join(dir, "archive.tar")
+	require.NoError(t, os.WriteFile(archivePath, buf.Bytes(), 0o600))
+
+	err := unpackArchive(logging.TestContext(t.Context()), archivePath, filepath.Join(dir, "dest"))
+	require.EqualError(t, err, "../escaped: illegal file path")
+	assert.NoFileExists(t, filepath.Join(dir, "escaped"))
+}
+
+func TestUnpackArchiveSymlinkEscape(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	unpack := func(t *testing.T, entries ...*tar.Header) (string, error) {
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		for _, header := range entries {
+			require.NoError(t, tw.WriteHeader(header))
+			if header.Size > 0 {
+				_, err := tw.Write([]byte("pwned"))
+				require.NoError(t, err)
+			}
+		}
+		require.NoError(t, tw.Close())
+		dir := t.TempDir()
+		archivePath := filepath.Join(dir, "archive.tar")
+		require.NoError(t, os.WriteFile(archivePath, buf.Bytes(), 0o600))
+		return dir, unpackArchive(ctx, archivePath, filepath.Join(dir, "dest"))
+	}
+	t.Run("Absolute", func(t *testing.T) {
+		outside := t.TempDir()
+		_, err := unpack(t,
+			&tar.Header{Name: "x", Typeflag: tar.TypeSymlink, Linkname: outside},
+			&tar.Header{Name: "x/argoexec", Typeflag: tar.TypeReg, Mode: 0o755, Size: 5},
+		)
+		require.EqualError(t, err, "x: illegal symlink target "+outside)
+		assert.NoFileExists(t, filepath.Join(outside, "argoexec"))
+	})
+	t.Run("Relative", func(t *testing.T) {
+		dir, err := unpack(t,
+			&tar.Header{Name: "x", Typeflag: tar.TypeSymlink, Linkname: "../"},
+			&tar.Header{Name: "x/escaped", Typeflag: tar.TypeReg, Mode: 0o644, Size: 5},
+		)
+		require.EqualError(t, err, "x: illegal symlink target ../")
+		assert.NoFileExists(t, filepath.Join(dir, "escaped"))
+	})
+	t.Run("Within", func(t *testing.T) {
+		dir, err := unpack(t,
+			&tar.Header{Name: "src/", Typeflag: tar.TypeDir, Mode: 0o755},
+			&tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "src"},
+		)
+		require.NoError(t, err)
+		linkname, err := os.Readlink(filepath.Join(dir, "dest", "link"))
+		require.NoError(t, err)
+		assert.Equal(t, "src", linkname)
+	})
+	t.Run("ThroughSymlink", func(t *testing.T) {
+		// a symlink within the directory is not written through
+		dir, err := unpack(t,
+			&tar.Header{Name: "src/", Typeflag: tar.TypeDir, Mode: 0o755},
+			&tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "src"},
+			&tar.Header{Name: "link/file", Typeflag: tar.TypeReg, Mode: 0o644, Size: 5},
+		)
+		require.EqualError(t, err, "link/file: illegal file path through symlink "+filepath.Join(dir, "dest", "link"))
+		assert.NoFileExists(t, filepath.Join(dir, "dest", "src", "file"))
+	})
+	t.Run("ExistingSymlink", func(t *testing.T) {
+		outside := t.TempDir()
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "x/argoexec", Typeflag: tar.TypeReg, Mode: 0o755, Size: 5}))
+		_, err := tw.Write([]byte("pwned"))
+		require.NoError(t, err)
+		require.NoError(t, tw.Close())
+		dir := t.TempDir()
+		archivePath := filepath.Join(dir, "archive.tar")
+		require.NoError(t, os.WriteFile(archivePath, buf.Bytes(), 0o600))
+		dest := filepath.Join(dir, "dest")
+		require.NoError(t, os.MkdirAll(dest, 0o755))
+		require.NoError(t, os.Symlink(outside, filepath.Join(dest, "x")))
+		err = unpackArchive(ctx, archivePath, dest)
+		require.EqualError(t, err, "x/argoexec: illegal file path through symlink "+filepath.Join(dest, "x"))
+		assert.NoFileExists(t, filepath.Join(outside, "argoexec"))
+	})
+}
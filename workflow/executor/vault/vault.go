@@ -0,0 +1,177 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// EnvVarAgentAddr is the address of the Vault agent, as used by the Vault CLI
+	EnvVarAgentAddr = "VAULT_AGENT_ADDR"
+	// EnvVarToken is a Vault token, only needed if the agent does not use its auto-auth token
+	EnvVarToken = "VAULT_TOKEN"
+	// DefaultAgentAddr is the address of the Vault agent if VAULT_AGENT_ADDR is not set
+	DefaultAgentAddr = "unix:///vault/agent.sock"
+)
+
+// SecretRef selects a key of a Vault secret
+type SecretRef struct {
+	// Path of the secret, e.g. `database/creds/readonly` or `secret/data/my-app`
+	Path string `json:"path"`
+	// Key within the data of the secret
+	Key string `json:"key"`
+}
+
+// EnvVarSource is the source of an environment variable read from Vault
+type EnvVarSource struct {
+	VaultSecretRef *SecretRef `json:"vaultSecretRef"`
+}
+
+// ParseEnv parses the value of the vault-env annotation, a map of environment variable names to their sources
+func ParseEnv(annotation string) (map[string]EnvVarSource, error) {
+	env := make(map[string]EnvVarSource)
+	if err := yaml.UnmarshalStrict([]byte(annotation), &env); err != nil {
+		return nil, fmt.Errorf("failed to parse vault env: %w", err)
+	}
+	for name, source := range env {
+		if source.VaultSecretRef == nil || source.VaultSecretRef.Path == "" || source.VaultSecretRef.Key == "" {
+			return nil, fmt.Errorf("vault env %q must have a vaultSecretRef with a path and a key", name)
+		}
+	}
+	return env, nil
+}
+
+// Client reads secrets from a Vault agent listening on a UNIX socket
+type Client struct {
+	httpClient *http.Client
+	token      string
+}
+
+// NewClient creates a client for the agent at the address, e.g. unix:///vault/agent.sock
+func NewClient(addr, token string) (*Client, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vault agent address %q: %w", addr, err)
+	}
+	if u.Scheme != "unix" || u.Path == "" {
+		return nil, fmt.Errorf("invalid vault agent address %q: must be a UNIX socket, e.g. %s", addr, DefaultAgentAddr)
+	}
+	dialer := &net.Dialer{}
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return dialer.DialContext(ctx, "unix", u.Path)
+				},
+			},
+		},
+		token: token,
+	}, nil
+}
+
+// Secret is the data of a Vault secret
+type Secret struct {
+	path string
+	data map[string]interface{}
+}
+
+// Read reads the secret at the path. Each read of a dynamic secret, e.g. database credentials, issues a new lease,
+// so all the keys needed from it must be taken from the one secret.
+func (c *Client) Read(ctx context.Context, path string) (*Secret, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://vault-agent/v1/"+strings.TrimPrefix(path, "/"), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.token != "" {
+		req.Header.Set("X-Vault-Token", c.token)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vault secret %q: %w", path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to read vault secret %q: %s", path, resp.Status)
+	}
+	var secret struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return nil, fmt.Errorf("failed to decode vault secret %q: %w", path, err)
+	}
+	return &Secret{path: path, data: secret.Data}, nil
+}
+
+// Get returns the value of a key of the secret
+func (s *Secret) Get(key string) (string, error) {
+	data := s.data
+	// the data of a KV version 2 secret is nested, alongside its metadata
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, found := data[key]; !found {
+			data = nested
+		}
+	}
+	value, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("vault secret %q has no key %q", s.path, key)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	out, err := json.Marshal(value)
+	return string(out), err
+}
+
+// Env returns the environment variables of the vault-env annotation, as NAME=value, read from the agent configured
+// by the environment
+func Env(ctx context.Context, annotation string) ([]string, error) {
+	sources, err := ParseEnv(annotation)
+	if err != nil {
+		return nil, err
+	}
+	addr := os.Getenv(EnvVarAgentAddr)
+	if addr == "" {
+		addr = DefaultAgentAddr
+	}
+	client, err := NewClient(addr, os.Getenv(EnvVarToken))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(sources))
+	for name := range sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	// each secret is read once, so that the keys of a dynamic secret are from the same lease
+	secrets := make(map[string]*Secret)
+	env := make([]string, 0, len(names))
+	for _, name := range names {
+		ref := sources[name].VaultSecretRef
+		path := strings.TrimPrefix(ref.Path, "/")
+		secret, ok := secrets[path]
+		if !ok {
+			secret, err = client.Read(ctx, ref.Path)
+			if err != nil {
+				return nil, err
+			}
+			secrets[path] = secret
+		}
+		value, err := secret.Get(ref.Key)
+		if err != nil {
+			return nil, err
+		}
+		env = append(env, name+"="+value)
+	}
+	return env, nil
+}
@@ -0,0 +1,113 @@
+//go:build !windows
+
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-workflows/v3/util/logging"
+)
+
+// newMockAgent starts a mock Vault agent on a UNIX socket, serving the secrets by path, and returns its address
+func newMockAgent(t *testing.T, secrets map[string]map[string]interface{}) string {
+	socket := filepath.Join(t.TempDir(), "agent.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := secrets[r.URL.Path]
+		if !ok {
+			http.Error(w, `{"errors":[]}`, http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"lease_duration": 3600, "data": data})
+	}))
+	server.Listener = listener
+	server.Start()
+	t.Cleanup(server.Close)
+	return "unix://" + socket
+}
+
+func TestParseEnv(t *testing.T) {
+	env, err := ParseEnv(`
+DB_PASSWORD:
+  vaultSecretRef:
+    path: database/creds/readonly
+    key: password
+`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]EnvVarSource{"DB_PASSWORD": {VaultSecretRef: &SecretRef{Path: "database/creds/readonly", Key: "password"}}}, env)
+
+	_, err = ParseEnv(`DB_PASSWORD: {vaultSecretRef: {path: database/creds/readonly}}`)
+	require.EqualError(t, err, `vault env "DB_PASSWORD" must have a vaultSecretRef with a path and a key`)
+	_, err = ParseEnv(`DB_PASSWORD: {secretKeyRef: {name: my-secret, key: password}}`)
+	require.Error(t, err)
+}
+
+func TestNewClient(t *testing.T) {
+	_, err := NewClient("http://127.0.0.1:8200", "")
+	require.EqualError(t, err, `invalid vault agent address "http://127.0.0.1:8200": must be a UNIX socket, e.g. unix:///vault/agent.sock`)
+}
+
+func TestEnv(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	addr := newMockAgent(t, map[string]map[string]interface{}{
+		"/v1/database/creds/readonly": {"username": "v-token-readonly", "password": "my-password"},
+		"/v1/secret/data/my-app": {
+			"data":     map[string]interface{}{"api-key": "my-api-key", "port": 8080},
+			"metadata": map[string]interface{}{"version": 3},
+		},
+	})
+	t.Setenv(EnvVarAgentAddr, addr)
+
+	env, err := Env(ctx, `
+DB_USERNAME: {vaultSecretRef: {path: database/creds/readonly, key: username}}
+DB_PASSWORD: {vaultSecretRef: {path: database/creds/readonly, key: password}}
+API_KEY: {vaultSecretRef: {path: secret/data/my-app, key: api-key}}
+PORT: {vaultSecretRef: {path: /secret/data/my-app, key: port}}
+`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"API_KEY=my-api-key", "DB_PASSWORD=my-password", "DB_USERNAME=v-token-readonly", "PORT=8080"}, env)
+
+	_, err = Env(ctx, `DB_PASSWORD: {vaultSecretRef: {path: database/creds/admin, key: password}}`)
+	require.EqualError(t, err, `failed to read vault secret "database/creds/admin": 404 Not Found`)
+	_, err = Env(ctx, `DB_PASSWORD: {vaultSecretRef: {path: database/creds/readonly, key: token}}`)
+	require.EqualError(t, err, `vault secret "database/creds/readonly" has no key "token"`)
+}
+
+func TestEnvDynamicSecret(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	// each read of a dynamic secret issues new credentials
+	var leases atomic.Int32
+	socket := filepath.Join(t.TempDir(), "agent.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lease := leases.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{
+			"username": fmt.Sprintf("v-token-readonly-%d", lease),
+			"password": fmt.Sprintf("my-password-%d", lease),
+		}})
+	}))
+	server.Listener = listener
+	server.Start()
+	defer server.Close()
+	t.Setenv(EnvVarAgentAddr, "unix://"+socket)
+
+	env, err := Env(ctx, `
+DB_USERNAME: {vaultSecretRef: {path: database/creds/readonly, key: username}}
+DB_PASSWORD: {vaultSecretRef: {path: /database/creds/readonly, key: password}}
+`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"DB_PASSWORD=my-password-1", "DB_USERNAME=v-token-readonly-1"}, env)
+	assert.Equal(t, int32(1), leases.Load())
+}
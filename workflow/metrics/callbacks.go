@@ -1,8 +1,9 @@
 package metrics
 
 type Callbacks struct {
-	PodPhase          PodPhaseCallback
-	WorkflowPhase     WorkflowPhaseCallback
-	WorkflowCondition WorkflowConditionCallback
-	IsLeader          IsLeaderCallback
+	PodPhase                 PodPhaseCallback
+	WorkflowPhase            WorkflowPhaseCallback
+	WorkflowCondition        WorkflowConditionCallback
+	IsLeader                 IsLeaderCallback
+	ArtifactCompressionRatio ArtifactCompressionRatioCallback
 }
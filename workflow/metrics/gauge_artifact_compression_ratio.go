@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+// ArtifactCompressionRatioCallback is the function prototype to provide this gauge with the average artifact
+// compression ratio of the workflows in each namespace
+type ArtifactCompressionRatioCallback func(ctx context.Context) map[string]float64
+
+type artifactCompressionRatioGauge struct {
+	callback ArtifactCompressionRatioCallback
+	gauge    *telemetry.Instrument
+}
+
+func addArtifactCompressionRatioGauge(_ context.Context, m *Metrics) error {
+	err := m.CreateBuiltinInstrument(telemetry.InstrumentArtifactCompressionRatio)
+	if err != nil {
+		return err
+	}
+
+	if m.callbacks.ArtifactCompressionRatio != nil {
+		acrGauge := artifactCompressionRatioGauge{
+			callback: m.callbacks.ArtifactCompressionRatio,
+			gauge:    m.GetInstrument(telemetry.InstrumentArtifactCompressionRatio.Name()),
+		}
+		return acrGauge.gauge.RegisterCallback(m.Metrics, acrGauge.update)
+	}
+	return nil
+}
+
+func (g *artifactCompressionRatioGauge) update(ctx context.Context, o metric.Observer) error {
+	ratios := g.callback(ctx)
+	for namespace, val := range ratios {
+		g.gauge.ObserveFloat(ctx, o, val, telemetry.InstAttribs{{Name: telemetry.AttribWorkflowNamespace, Value: namespace}})
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func addWorkflowQueueDurationHistogram(_ context.Context, m *Metrics) error {
+	return m.CreateBuiltinInstrument(telemetry.InstrumentWorkflowQueueDurationSeconds)
+}
+
+// RecordWorkflowQueueDuration records the time a workflow waited between being queued and starting to run
+func (m *Metrics) RecordWorkflowQueueDuration(ctx context.Context, duration time.Duration, namespace string) {
+	m.Record(ctx, telemetry.InstrumentWorkflowQueueDurationSeconds.Name(), duration.Seconds(), telemetry.InstAttribs{
+		{Name: telemetry.AttribWorkflowNamespace, Value: namespace},
+	})
+}
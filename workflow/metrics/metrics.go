@@ -52,11 +52,13 @@ func New(ctx context.Context, serviceName, prometheusName string, config *teleme
 		addWorkflowPhaseCounter,
 		addWorkflowTemplateCounter,
 		addWorkflowTemplateHistogram,
+		addWorkflowQueueDurationHistogram,
 		addOperationDurationHistogram,
 		addErrorCounter,
 		addLogCounter,
 		addK8sRequests,
 		addWorkflowConditionGauge,
+		addArtifactCompressionRatioGauge,
 		addWorkQueueMetrics,
 	)
 	if err != nil {
@@ -37,6 +37,14 @@ func ValidateMetricValues(metric *wfv1.Prometheus) error {
 	if metric.Histogram != nil && metric.Histogram.Value == "" {
 		return errors.New("missing histogram.value")
 	}
+	if metric.PushInterval != nil {
+		if metric.PushInterval.Duration <= 0 {
+			return errors.New("pushInterval must be positive")
+		}
+		if metric.Histogram != nil || metric.IsRealtime() {
+			return errors.New("pushInterval can only be used with counters and non-realtime gauges")
+		}
+	}
 	return nil
 }
 
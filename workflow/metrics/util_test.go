@@ -2,8 +2,13 @@ package metrics
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 func TestMetricNames(t *testing.T) {
@@ -24,3 +29,17 @@ func TestMetricNames(t *testing.T) {
 		assert.False(t, IsValidMetricName(name), name)
 	}
 }
+
+func TestValidateMetricValuesPushInterval(t *testing.T) {
+	interval := &metav1.Duration{Duration: time.Minute}
+	require.NoError(t, ValidateMetricValues(&wfv1.Prometheus{Counter: &wfv1.Counter{Value: "1"}, PushInterval: interval}))
+	require.NoError(t, ValidateMetricValues(&wfv1.Prometheus{Gauge: &wfv1.Gauge{Value: "1"}, PushInterval: interval}))
+
+	realtime := true
+	require.EqualError(t, ValidateMetricValues(&wfv1.Prometheus{Gauge: &wfv1.Gauge{Value: "{{workflow.duration}}", Realtime: &realtime}, PushInterval: interval}),
+		"pushInterval can only be used with counters and non-realtime gauges")
+	require.EqualError(t, ValidateMetricValues(&wfv1.Prometheus{Histogram: &wfv1.Histogram{Value: "1"}, PushInterval: interval}),
+		"pushInterval can only be used with counters and non-realtime gauges")
+	require.EqualError(t, ValidateMetricValues(&wfv1.Prometheus{Counter: &wfv1.Counter{Value: "1"}, PushInterval: &metav1.Duration{}}),
+		"pushInterval must be positive")
+}
@@ -3,6 +3,8 @@ package sync
 import (
 	"context"
 	"time"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 type semaphore interface {
@@ -21,5 +23,10 @@ type semaphore interface {
 	unlock(ctx context.Context)
 }
 
+// fairnessSetter is implemented by semaphores whose queue order can be configured
+type fairnessSetter interface {
+	setFairness(ctx context.Context, fairness v1alpha1.SemaphoreFairness)
+}
+
 // expose for overriding in tests
 var nowFn = time.Now
@@ -200,12 +200,16 @@ type item struct {
 	key          string
 	creationTime time.Time
 	priority     int32
+	seq          uint64
 	index        int
 }
 
 type priorityQueue struct {
 	items     []*item
 	itemByKey map[string]*item
+	// fifo orders items by when they were added, rather than by priority and creation time
+	fifo    bool
+	nextSeq uint64
 }
 
 func (pq *priorityQueue) pop() *item {
@@ -223,7 +227,15 @@ func (pq *priorityQueue) add(key Key, priority int32, creationTime time.Time) {
 			heap.Fix(pq, res.index)
 		}
 	} else {
-		heap.Push(pq, &item{key: key, priority: priority, creationTime: creationTime})
+		heap.Push(pq, &item{key: key, priority: priority, creationTime: creationTime, seq: pq.nextSeq})
+		pq.nextSeq++
+	}
+}
+
+func (pq *priorityQueue) setFIFO(fifo bool) {
+	if pq.fifo != fifo {
+		pq.fifo = fifo
+		heap.Init(pq)
 	}
 }
 
@@ -237,6 +249,9 @@ func (pq *priorityQueue) remove(key Key) {
 func (pq priorityQueue) Len() int { return len(pq.items) }
 
 func (pq priorityQueue) Less(i, j int) bool {
+	if pq.fifo {
+		return pq.items[i].seq < pq.items[j].seq
+	}
 	if pq.items[i].priority == pq.items[j].priority {
 		return pq.items[i].creationTime.Before(pq.items[j].creationTime)
 	}
@@ -8,6 +8,7 @@ import (
 
 	sema "golang.org/x/sync/semaphore"
 
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 )
 
@@ -45,6 +46,15 @@ func newInternalSemaphore(ctx context.Context, name string, nextWorkflow NextWor
 	return sem, err
 }
 
+// setFairness changes the order in which waiting holders are granted the semaphore
+func (s *prioritySemaphore) setFairness(ctx context.Context, fairness v1alpha1.SemaphoreFairness) {
+	fifo := fairness == v1alpha1.SemaphoreFairnessFIFO
+	if s.pending.fifo != fifo {
+		s.logger(ctx).WithField("fairness", fairness).Info(ctx, "semaphore fairness changed")
+		s.pending.setFIFO(fifo)
+	}
+}
+
 func (s *prioritySemaphore) getName() string {
 	return s.name
 }
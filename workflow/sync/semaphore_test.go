@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/upper/db/v4"
 
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 	"github.com/argoproj/argo-workflows/v3/util/sqldb"
 )
@@ -216,3 +217,42 @@ func TestNotifyWorkflowFromTemplateSemaphore(t *testing.T) {
 		})
 	}
 }
+
+// TestSemaphoreFairness verifies the order three waiters are granted the semaphore in for each fairness
+func TestSemaphoreFairness(t *testing.T) {
+	for fairness, expected := range map[v1alpha1.SemaphoreFairness][]string{
+		v1alpha1.SemaphoreFairnessFIFO:     {"default/wf-01", "default/wf-02", "default/wf-03"},
+		v1alpha1.SemaphoreFairnessPriority: {"default/wf-02", "default/wf-03", "default/wf-01"},
+	} {
+		t.Run(string(fairness), func(t *testing.T) {
+			ctx := logging.TestContext(t.Context())
+			s, _, cleanup := createTestInternalSemaphore(ctx, t, "bar", "default", 1, func(string) {})
+			defer cleanup()
+			s.(fairnessSetter).setFairness(ctx, fairness)
+
+			now := time.Now()
+			require.NoError(t, s.addToQueue(ctx, "default/wf-00", 0, now))
+			acquired, _ := s.tryAcquire(ctx, "default/wf-00", nil)
+			require.True(t, acquired)
+
+			// wf-01 starts waiting first, but was created last and has the lowest priority
+			require.NoError(t, s.addToQueue(ctx, "default/wf-01", 0, now.Add(3*time.Second)))
+			require.NoError(t, s.addToQueue(ctx, "default/wf-02", 10, now.Add(time.Second)))
+			require.NoError(t, s.addToQueue(ctx, "default/wf-03", 5, now.Add(2*time.Second)))
+
+			holder := "default/wf-00"
+			var granted []string
+			for range expected {
+				require.True(t, s.release(ctx, holder))
+				for _, key := range []string{"default/wf-01", "default/wf-02", "default/wf-03"} {
+					if acquired, _ := s.tryAcquire(ctx, key, nil); acquired {
+						holder = key
+						granted = append(granted, key)
+						break
+					}
+				}
+			}
+			assert.Equal(t, expected, granted)
+		})
+	}
+}
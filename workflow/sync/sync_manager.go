@@ -374,6 +374,9 @@ func (sm *Manager) prepAcquire(ctx context.Context, wf *wfv1.Workflow, holderKey
 			}
 			sm.syncLockMap[lockKey] = lock
 		}
+		if setter, ok := lock.(fairnessSetter); ok && syncItems[i].semaphore != nil {
+			setter.setFairness(ctx, syncItems[i].semaphore.Fairness)
+		}
 
 		var priority int32
 		if wf.Spec.Priority != nil {
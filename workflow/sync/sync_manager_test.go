@@ -693,6 +693,93 @@ func TestSemaphoreTmplLevel(t *testing.T) {
 	})
 }
 
+const wfWithSharedTmplSemaphore = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: semaphore-tmpl-shared
+  namespace: default
+spec:
+  entrypoint: main
+  synchronization:
+    semaphores:
+      - configMapKeyRef:
+          key: workflow
+          name: my-config
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        template: a
+      - name: b
+        template: b
+  - name: a
+    container:
+      image: alpine:latest
+    synchronization:
+      semaphores:
+        - configMapKeyRef:
+            key: template
+            name: my-config
+  - name: b
+    container:
+      image: alpine:latest
+    synchronization:
+      semaphores:
+        - configMapKeyRef:
+            key: template
+            name: my-config
+`
+
+func TestSemaphoreTmplLevelShared(t *testing.T) {
+	kube := fake.NewSimpleClientset()
+	var cm v1.ConfigMap
+	wfv1.MustUnmarshal([]byte(configMap), &cm)
+
+	ctx := logging.TestContext(t.Context())
+	_, err := kube.CoreV1().ConfigMaps("default").Create(ctx, &cm, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	syncManager := NewLockManager(ctx, kube, "", nil, GetSyncLimitFunc(kube), func(key string) {}, WorkflowExistenceFunc)
+	wf := wfv1.MustUnmarshalWorkflow(wfWithSharedTmplSemaphore)
+	tmplA := wf.GetTemplateByName("a")
+	tmplB := wf.GetTemplateByName("b")
+
+	// the workflow-level semaphore is held independently of the template-level one
+	status, _, msg, failedLockName, err := syncManager.TryAcquire(ctx, wf, "", wf.Spec.Synchronization)
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+	assert.Empty(t, failedLockName)
+	assert.True(t, status)
+
+	status, wfUpdate, msg, failedLockName, err := syncManager.TryAcquire(ctx, wf, "semaphore-tmpl-shared-a", tmplA.Synchronization)
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+	assert.Empty(t, failedLockName)
+	assert.True(t, status)
+	assert.True(t, wfUpdate)
+
+	// both templates share the limit of one
+	status, _, msg, failedLockName, err = syncManager.TryAcquire(ctx, wf, "semaphore-tmpl-shared-b", tmplB.Synchronization)
+	require.NoError(t, err)
+	assert.NotEmpty(t, msg)
+	assert.Equal(t, "default/ConfigMap/my-config/template", failedLockName)
+	assert.False(t, status)
+
+	syncManager.Release(ctx, wf, "semaphore-tmpl-shared-a", tmplA.Synchronization)
+	status, _, msg, failedLockName, err = syncManager.TryAcquire(ctx, wf, "semaphore-tmpl-shared-b", tmplB.Synchronization)
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+	assert.Empty(t, failedLockName)
+	assert.True(t, status)
+	require.NotNil(t, wf.Status.Synchronization)
+	require.NotNil(t, wf.Status.Synchronization.Semaphore)
+	assert.Contains(t, wf.Status.Synchronization.Semaphore.Holding, wfv1.SemaphoreHolding{
+		Semaphore: "default/ConfigMap/my-config/template",
+		Holders:   []string{getHolderKey(wf, "semaphore-tmpl-shared-b")},
+	})
+}
+
 type mockGetSyncLimit struct {
 	callCount  int
 	outputSize int
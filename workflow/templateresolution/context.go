@@ -110,19 +110,42 @@ func (tplCtx *TemplateContext) GetTemplateByName(ctx context.Context, name strin
 	tplCtx.log.WithField("name", name).Debug(ctx, "Getting the template by name")
 
 	tmpl := tplCtx.tmplBase.GetTemplateByName(name)
+	if tmpl == nil {
+		return nil, errors.Errorf(errors.CodeNotFound, "template %s not found", name)
+	}
+	tmpl = tmpl.DeepCopy()
 
 	podMetadata := tplCtx.tmplBase.GetPodMetadata()
 	tplCtx.addPodMetadata(podMetadata, tmpl)
+	return tmpl, nil
+}
 
-	if tmpl == nil {
-		return nil, errors.Errorf(errors.CodeNotFound, "template %s not found", name)
+// CheckClusterWorkflowTemplateNamespace returns an error if workflows in the namespace may not reference the cluster workflow template.
+func CheckClusterWorkflowTemplateNamespace(cwftmpl *wfv1.ClusterWorkflowTemplate, namespace string) error {
+	if !cwftmpl.AllowsNamespace(namespace) {
+		return errors.Errorf(errors.CodeBadRequest, "cluster workflow template %s may not be used in namespace %s", cwftmpl.Name, namespace)
+	}
+	return nil
+}
+
+// getClusterWorkflowTemplate gets a cluster workflow template, checking the workflow's namespace may reference it.
+// Workflows without a namespace, such as those validating a ClusterWorkflowTemplate, are not checked.
+func (tplCtx *TemplateContext) getClusterWorkflowTemplate(ctx context.Context, name string) (*wfv1.ClusterWorkflowTemplate, error) {
+	cwftmpl, err := tplCtx.cwftmplGetter.Get(ctx, name)
+	if err != nil {
+		return nil, err
 	}
-	return tmpl.DeepCopy(), nil
+	if tplCtx.workflow != nil && tplCtx.workflow.Namespace != "" {
+		if err := CheckClusterWorkflowTemplateNamespace(cwftmpl, tplCtx.workflow.Namespace); err != nil {
+			return nil, err
+		}
+	}
+	return cwftmpl, nil
 }
 
 func (tplCtx *TemplateContext) GetTemplateGetterFromRef(ctx context.Context, tmplRef *wfv1.TemplateRef) (wfv1.TemplateHolder, error) {
 	if tmplRef.ClusterScope {
-		return tplCtx.cwftmplGetter.Get(ctx, tmplRef.Name)
+		return tplCtx.getClusterWorkflowTemplate(ctx, tmplRef.Name)
 	}
 	return tplCtx.wftmplGetter.Get(ctx, tmplRef.Name)
 }
@@ -134,7 +157,7 @@ func (tplCtx *TemplateContext) GetTemplateFromRef(ctx context.Context, tmplRef *
 	var wftmpl wfv1.TemplateHolder
 	var err error
 	if tmplRef.ClusterScope {
-		wftmpl, err = tplCtx.cwftmplGetter.Get(ctx, tmplRef.Name)
+		wftmpl, err = tplCtx.getClusterWorkflowTemplate(ctx, tmplRef.Name)
 	} else {
 		wftmpl, err = tplCtx.wftmplGetter.Get(ctx, tmplRef.Name)
 	}
@@ -147,14 +170,14 @@ func (tplCtx *TemplateContext) GetTemplateFromRef(ctx context.Context, tmplRef *
 	}
 
 	template = wftmpl.GetTemplateByName(tmplRef.Template)
-
-	podMetadata := wftmpl.GetPodMetadata()
-	tplCtx.addPodMetadata(podMetadata, template)
-
 	if template == nil {
 		return nil, errors.Errorf(errors.CodeNotFound, "template %s not found in workflow template %s", tmplRef.Template, tmplRef.Name)
 	}
-	return template.DeepCopy(), nil
+	template = template.DeepCopy()
+
+	podMetadata := wftmpl.GetPodMetadata()
+	tplCtx.addPodMetadata(podMetadata, template)
+	return template, nil
 }
 
 // GetTemplate returns a template found by template name or template ref.
@@ -275,27 +298,31 @@ func (tplCtx *TemplateContext) WithWorkflowTemplate(ctx context.Context, name st
 
 // WithClusterWorkflowTemplate creates new context with a wfv1.TemplateHolder.
 func (tplCtx *TemplateContext) WithClusterWorkflowTemplate(ctx context.Context, name string) (*TemplateContext, error) {
-	cwftmpl, err := tplCtx.cwftmplGetter.Get(ctx, name)
+	cwftmpl, err := tplCtx.getClusterWorkflowTemplate(ctx, name)
 	if err != nil {
 		return nil, err
 	}
 	return tplCtx.WithTemplateBase(cwftmpl), nil
 }
 
-// addPodMetadata add podMetadata in workflow template level to template
+// addPodMetadata add podMetadata in workflow template level to template, keeping the template's own values on conflict
 func (tplCtx *TemplateContext) addPodMetadata(podMetadata *wfv1.Metadata, tmpl *wfv1.Template) {
 	if podMetadata != nil {
 		if tmpl.Metadata.Annotations == nil {
 			tmpl.Metadata.Annotations = make(map[string]string)
 		}
 		for k, v := range podMetadata.Annotations {
-			tmpl.Metadata.Annotations[k] = v
+			if _, ok := tmpl.Metadata.Annotations[k]; !ok {
+				tmpl.Metadata.Annotations[k] = v
+			}
 		}
 		if tmpl.Metadata.Labels == nil {
 			tmpl.Metadata.Labels = make(map[string]string)
 		}
 		for k, v := range podMetadata.Labels {
-			tmpl.Metadata.Labels[k] = v
+			if _, ok := tmpl.Metadata.Labels[k]; !ok {
+				tmpl.Metadata.Labels[k] = v
+			}
 		}
 	}
 }
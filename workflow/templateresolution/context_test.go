@@ -116,6 +116,22 @@ func TestGetTemplateByName(t *testing.T) {
 	require.EqualError(t, err, "template unknown not found")
 }
 
+func TestGetTemplateByNameWithPodMetadata(t *testing.T) {
+	wfClientset := fakewfclientset.NewSimpleClientset()
+	wftmpl := unmarshalWftmpl(baseWorkflowTemplateYaml)
+	wftmpl.Spec.PodMetadata = &wfv1.Metadata{Annotations: map[string]string{"a": "workflow", "b": "workflow"}}
+	wftmpl.Spec.Templates[0].Metadata.Annotations = map[string]string{"b": "template"}
+	ctx := logging.TestContext(t.Context())
+	log := logging.RequireLoggerFromContext(ctx)
+	tplCtx := NewContextFromClientSet(wfClientset.ArgoprojV1alpha1().WorkflowTemplates(metav1.NamespaceDefault), wfClientset.ArgoprojV1alpha1().ClusterWorkflowTemplates(), wftmpl, nil, log)
+
+	tmpl, err := tplCtx.GetTemplateByName(ctx, "whalesay")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "workflow", "b": "template"}, tmpl.Metadata.Annotations)
+	// the workflow template itself is not modified
+	assert.Equal(t, map[string]string{"b": "template"}, wftmpl.Spec.Templates[0].Metadata.Annotations)
+}
+
 func TestGetTemplateFromRef(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
 	wfClientset := fakewfclientset.NewSimpleClientset()
@@ -73,7 +73,7 @@ func JoinWorkflowMetaData(wfMetaData, wfDefaultMetaData *metav1.ObjectMeta) {
 }
 
 // JoinWorkflowSpec will join the workflow specs with the following order of preference
-// 1. Workflow Spec, 2 WorkflowTemplate Spec (WorkflowTemplateRef), 3. WorkflowDefault Spec.
+// 1. Workflow Spec, 2 WorkflowTemplate Spec (WorkflowTemplateRef or EntrypointRef), 3. WorkflowDefault Spec.
 func JoinWorkflowSpec(wfSpec, wftSpec, wfDefaultSpec *wfv1.WorkflowSpec) (*wfv1.Workflow, error) {
 	if wfSpec == nil {
 		return nil, nil
@@ -97,6 +97,9 @@ func JoinWorkflowSpec(wfSpec, wftSpec, wfDefaultSpec *wfv1.WorkflowSpec) (*wfv1.
 	if wfSpec.Suspend != targetWf.Spec.Suspend {
 		targetWf.Spec.Suspend = wfSpec.Suspend
 	}
+	if wfSpec.EntrypointRef != nil {
+		targetWf.Spec.Entrypoint = wfSpec.EntrypointRef.Template
+	}
 	// The value of an argument should respect the priority order of the merge.
 	// However, if Value, ValueFrom and Default are set in different places,
 	// i.e. the Workflow, WorflowTemplate and WorkflowDefault, we should only keep the one with the greatest priority.
@@ -396,6 +396,69 @@ func TestJoinWfSpecArgumentsWithNil(t *testing.T) {
 	assert.Equal(result.Spec.Arguments, targetWf.Spec.Arguments)
 }
 
+var wfPartialArguments = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: test-workflow
+spec:
+  workflowTemplateRef:
+    name: test-workflow-template
+  arguments:
+    parameters:
+      - name: listed
+      - name: "null"
+        value: null
+      - name: empty
+        value: ""
+      - name: overridden
+        value: "Workflow value"
+`
+
+var wftPartialArguments = `
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: test-workflow-template
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+      - name: absent
+        value: "WorkflowTemplate absent"
+      - name: listed
+        value: "WorkflowTemplate listed"
+      - name: "null"
+        value: "WorkflowTemplate null"
+      - name: empty
+        value: "WorkflowTemplate empty"
+      - name: overridden
+        value: "WorkflowTemplate overridden"
+  templates:
+    - name: main
+      container:
+        image: busybox:latest
+`
+
+func TestJoinWfSpecPartialArguments(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(wfPartialArguments)
+	wft := wfv1.MustUnmarshalWorkflowTemplate(wftPartialArguments)
+
+	targetWf, err := JoinWorkflowSpec(&wf.Spec, wft.GetWorkflowSpec(), nil)
+	require.NoError(t, err)
+	values := map[string]string{}
+	for _, p := range targetWf.Spec.Arguments.Parameters {
+		values[p.Name] = p.GetValue()
+	}
+	assert.Equal(t, map[string]string{
+		"absent":     "WorkflowTemplate absent",
+		"listed":     "WorkflowTemplate listed",
+		"null":       "WorkflowTemplate null",
+		"empty":      "",
+		"overridden": "Workflow value",
+	}, values)
+}
+
 func TestJoinWorkflowMetaData(t *testing.T) {
 	assert := assert.New(t)
 	t.Run("WfDefaultMetaData", func(t *testing.T) {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"hash/fnv"
 	"os"
+	"strings"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
@@ -13,6 +14,9 @@ const (
 	maxK8sResourceNameLength = 253
 	k8sNamingHashLength      = 10
 	maxPrefixLength          = maxK8sResourceNameLength - k8sNamingHashLength
+	// maxFormattedPodNameLength is the length of a DNS label, so that pod names from a podNameFormat can be used as
+	// host names
+	maxFormattedPodNameLength = 63
 )
 
 // PodNameVersion stores which type of pod names should be used.
@@ -69,6 +73,37 @@ func GeneratePodName(workflowName, nodeName, templateName, nodeID string, versio
 
 }
 
+// GenerateWorkflowPodName returns the pod name of a node, using the pod name format of the workflow if it has one,
+// otherwise the pod name version of the workflow
+func GenerateWorkflowPodName(wf *v1alpha1.Workflow, nodeName, templateName, nodeID string) string {
+	if format := wf.GetExecSpec().PodNameFormat; format != "" {
+		return FormatPodName(format, wf.Name, nodeName, templateName, nodeID)
+	}
+	return GeneratePodName(wf.Name, nodeName, templateName, nodeID, GetWorkflowPodNameVersion(wf))
+}
+
+// FormatPodName returns a pod name from a podNameFormat. The tokens are replaced and a hash of the node name is
+// appended, after truncating the name so that it is a valid DNS label.
+func FormatPodName(format, workflowName, nodeName, templateName, nodeID string) string {
+	// workflow names and node IDs may contain dots, which are not allowed in DNS labels
+	value := func(s string) string { return strings.ReplaceAll(strings.ToLower(s), ".", "-") }
+	prefix := strings.NewReplacer(
+		"{{workflow.name}}", value(workflowName),
+		"{{template.name}}", value(templateName),
+		"{{node.id}}", value(nodeID),
+	).Replace(format)
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(nodeName))
+	suffix := fmt.Sprintf("-%v", h.Sum32())
+
+	if maxLength := maxFormattedPodNameLength - len(suffix); len(prefix) > maxLength {
+		prefix = prefix[0:maxLength]
+	}
+	// the template name may be empty, and truncation may have ended the prefix with a dash
+	return strings.Trim(prefix, "-") + suffix
+}
+
 func ensurePodNamePrefixLength(prefix string) string {
 	if len(prefix) > maxPrefixLength-1 {
 		return prefix[0 : maxPrefixLength-1]
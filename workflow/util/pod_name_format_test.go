@@ -0,0 +1,72 @@
+package util
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apivalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func nodeNameHash(nodeName string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(nodeName))
+	return fmt.Sprint(h.Sum32())
+}
+
+func TestFormatPodName(t *testing.T) {
+	nodeName := "my-wf[0].step"
+	hash := nodeNameHash(nodeName)
+	t.Run("Tokens", func(t *testing.T) {
+		name := FormatPodName("acme-{{workflow.name}}-{{template.name}}", "my-wf", nodeName, "My-Template", "my-wf-123")
+		assert.Equal(t, "acme-my-wf-my-template-"+hash, name)
+	})
+	t.Run("NodeID", func(t *testing.T) {
+		name := FormatPodName("acme-{{node.id}}", "my.wf", nodeName, "", "my.wf-123")
+		assert.Equal(t, "acme-my-wf-123-"+hash, name)
+	})
+	t.Run("NoTemplateName", func(t *testing.T) {
+		name := FormatPodName("{{workflow.name}}-{{template.name}}", "my-wf", nodeName, "", "my-wf-123")
+		assert.Equal(t, "my-wf-"+hash, name)
+	})
+	t.Run("Truncated", func(t *testing.T) {
+		longWfName := strings.Repeat("a", 40)
+		longTemplateName := strings.Repeat("b", 40)
+		name := FormatPodName("acme-{{workflow.name}}-{{template.name}}", longWfName, nodeName, longTemplateName, "my-wf-123")
+		assert.Len(t, name, maxFormattedPodNameLength)
+		assert.Empty(t, apivalidation.IsDNS1123Label(name))
+		assert.True(t, strings.HasPrefix(name, "acme-"+longWfName+"-bbb"))
+		assert.True(t, strings.HasSuffix(name, "-"+hash))
+
+		// each node still has its own pod
+		other := FormatPodName("acme-{{workflow.name}}-{{template.name}}", longWfName, "my-wf[1].step", longTemplateName, "my-wf-456")
+		assert.NotEqual(t, name, other)
+	})
+	t.Run("TruncatedAtDash", func(t *testing.T) {
+		// the prefix is truncated to 63 - len("-<hash>") characters
+		prefixLength := maxFormattedPodNameLength - len(hash) - 1
+		wfName := strings.Repeat("a", prefixLength-1)
+		name := FormatPodName("{{workflow.name}}-{{template.name}}", wfName, nodeName, "tmpl", "my-wf-123")
+		assert.Equal(t, wfName+"-"+hash, name)
+		assert.Empty(t, apivalidation.IsDNS1123Label(name))
+	})
+}
+
+func TestGenerateWorkflowPodName(t *testing.T) {
+	wf := &v1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf"}}
+	nodeName := "my-wf[0].step"
+	assert.Equal(t, GeneratePodName("my-wf", nodeName, "tmpl", "my-wf-123", GetWorkflowPodNameVersion(wf)), GenerateWorkflowPodName(wf, nodeName, "tmpl", "my-wf-123"))
+
+	wf.Spec.PodNameFormat = "acme-{{template.name}}"
+	assert.Equal(t, "acme-tmpl-"+nodeNameHash(nodeName), GenerateWorkflowPodName(wf, nodeName, "tmpl", "my-wf-123"))
+
+	// the format can come from a workflow template
+	wf.Spec.PodNameFormat = ""
+	wf.Status.StoredWorkflowSpec = &v1alpha1.WorkflowSpec{PodNameFormat: "team-{{template.name}}"}
+	assert.Equal(t, "team-tmpl-"+nodeNameHash(nodeName), GenerateWorkflowPodName(wf, nodeName, "tmpl", "my-wf-123"))
+}
@@ -15,6 +15,7 @@ import (
 	nruntime "runtime"
 	"slices"
 	"strconv"
+	"sort"
 	"strings"
 	"time"
 
@@ -365,6 +366,31 @@ func ReadParametersFile(file string, opts *wfv1.SubmitOpts) error {
 	return nil
 }
 
+// ReadParametersFromEnv adds a parameter for every environment variable named PREFIX_NAME, using the lower-cased
+// NAME as the parameter name. Parameters already in opts take precedence.
+func ReadParametersFromEnv(prefix string, environ []string, opts *wfv1.SubmitOpts) {
+	passedParams := make(map[string]bool)
+	for _, paramStr := range opts.Parameters {
+		name, _, _ := strings.Cut(paramStr, "=")
+		passedParams[name] = true
+	}
+	prefix += "_"
+	var params []string
+	for _, env := range environ {
+		key, value, ok := strings.Cut(env, "=")
+		if !ok || !strings.HasPrefix(key, prefix) || key == prefix {
+			continue
+		}
+		name := strings.ToLower(strings.TrimPrefix(key, prefix))
+		if passedParams[name] {
+			continue
+		}
+		params = append(params, fmt.Sprintf("%s=%s", name, value))
+	}
+	sort.Strings(params)
+	opts.Parameters = append(opts.Parameters, params...)
+}
+
 // SuspendWorkflow suspends a workflow by setting spec.suspend to true. Retries conflict errors
 func SuspendWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, workflowName string) error {
 	err := waitutil.Backoff(retry.DefaultRetry(ctx), func() (bool, error) {
@@ -389,6 +415,60 @@ func SuspendWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, workf
 	return err
 }
 
+// SetNodeSuspend pauses or un-pauses the main container of a single running pod node by setting
+// the node's suspend field. Retries conflict errors
+func SetNodeSuspend(ctx context.Context, wfIf v1alpha1.WorkflowInterface, hydrator hydrator.Interface, workflowName string, nodeID string, suspend bool) error {
+	action := creator.ActionResume
+	if suspend {
+		action = creator.ActionSuspend
+	}
+	err := waitutil.Backoff(retry.DefaultRetry(ctx), func() (bool, error) {
+		wf, err := wfIf.Get(ctx, workflowName, metav1.GetOptions{})
+		if err != nil {
+			return !errorsutil.IsTransientErr(ctx, err), err
+		}
+		if IsWorkflowCompleted(wf) {
+			if suspend {
+				return true, errSuspendedCompletedWorkflow
+			}
+			return true, errors.Errorf(errors.CodeBadRequest, "cannot resume completed workflows")
+		}
+
+		err = hydrator.Hydrate(ctx, wf)
+		if err != nil {
+			return true, err
+		}
+
+		node, err := wf.Status.Nodes.Get(nodeID)
+		if err != nil {
+			return true, errors.Errorf(errors.CodeNotFound, "node %s not found", nodeID)
+		}
+		if node.Type != wfv1.NodeTypePod || node.Phase != wfv1.NodeRunning {
+			return true, errors.Errorf(errors.CodeBadRequest, "node %s is not a running pod node", nodeID)
+		}
+		if node.Suspend == suspend {
+			if suspend {
+				return true, nil
+			}
+			return true, errors.Errorf(errors.CodeBadRequest, "node %s is not paused", nodeID)
+		}
+		node.Suspend = suspend
+		wf.Status.Nodes.Set(ctx, nodeID, *node)
+
+		err = hydrator.Dehydrate(ctx, wf)
+		if err != nil {
+			return true, fmt.Errorf("unable to compress or offload workflow nodes: %s", err)
+		}
+		creator.LabelActor(ctx, wf, action)
+		_, err = wfIf.Update(ctx, wf, metav1.UpdateOptions{})
+		if apierr.IsConflict(err) {
+			return false, nil
+		}
+		return !errorsutil.IsTransientErr(ctx, err), err
+	})
+	return err
+}
+
 func OverrideOutputParametersWithDefault(outputs *wfv1.Outputs) error {
 	if outputs == nil {
 		return nil
@@ -799,8 +879,7 @@ func isDescendantNodeSucceeded(ctx context.Context, wf *wfv1.Workflow, node wfv1
 
 func deletePodNodeDuringRetryWorkflow(wf *wfv1.Workflow, node wfv1.NodeStatus, deletedPods map[string]bool, podsToDelete []string) (map[string]bool, []string) {
 	templateName := GetTemplateFromNode(node)
-	version := GetWorkflowPodNameVersion(wf)
-	podName := GeneratePodName(wf.Name, node.Name, templateName, node.ID, version)
+	podName := GenerateWorkflowPodName(wf, node.Name, templateName, node.ID)
 	if _, ok := deletedPods[podName]; !ok {
 		deletedPods[podName] = true
 		podsToDelete = append(podsToDelete, podName)
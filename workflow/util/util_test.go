@@ -497,6 +497,51 @@ func TestUpdateSuspendedNode(t *testing.T) {
 	require.EqualError(t, err, "cannot set output parameters because node is not expecting any raw parameters")
 }
 
+func TestSetNodeSuspend(t *testing.T) {
+	wfIf := argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows("")
+	origWf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: pause-node
+spec:
+  entrypoint: main
+status:
+  phase: Running
+  nodes:
+    pause-node:
+      id: pause-node
+      name: pause-node
+      type: Pod
+      phase: Running
+    pause-node-1:
+      id: pause-node-1
+      name: pause-node.done
+      type: Pod
+      phase: Succeeded
+`)
+	ctx := logging.TestContext(t.Context())
+	_, err := wfIf.Create(ctx, origWf, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	err = SetNodeSuspend(ctx, wfIf, hydratorfake.Noop, "pause-node", "does-not-exist", true)
+	require.EqualError(t, err, "node does-not-exist not found")
+	err = SetNodeSuspend(ctx, wfIf, hydratorfake.Noop, "pause-node", "pause-node-1", true)
+	require.EqualError(t, err, "node pause-node-1 is not a running pod node")
+	err = SetNodeSuspend(ctx, wfIf, hydratorfake.Noop, "pause-node", "pause-node", false)
+	require.EqualError(t, err, "node pause-node is not paused")
+
+	err = SetNodeSuspend(ctx, wfIf, hydratorfake.Noop, "pause-node", "pause-node", true)
+	require.NoError(t, err)
+	wf, err := wfIf.Get(ctx, "pause-node", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, wf.Status.Nodes["pause-node"].Suspend)
+
+	err = SetNodeSuspend(ctx, wfIf, hydratorfake.Noop, "pause-node", "pause-node", false)
+	require.NoError(t, err)
+	wf, err = wfIf.Get(ctx, "pause-node", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.False(t, wf.Status.Nodes["pause-node"].Suspend)
+}
+
 func TestSelectorMatchesNode(t *testing.T) {
 	tests := map[string]struct {
 		selector string
@@ -629,6 +674,32 @@ func TestReadParametersFile(t *testing.T) {
 	assert.Equal(t, "a=81861780812", parameters[0])
 }
 
+func TestReadParametersFromEnv(t *testing.T) {
+	opts := &wfv1.SubmitOpts{Parameters: []string{"b=from-flag"}}
+	ReadParametersFromEnv("CI", []string{
+		"CI_MESSAGE=hello=world",
+		"CI_B=from-env",
+		"CI_=empty-name",
+		"CIX_OTHER=1",
+		"OTHER_CI_A=1",
+		"CI_API_TOKEN=secret",
+	}, opts)
+	assert.Equal(t, []string{"b=from-flag", "api_token=secret", "message=hello=world"}, opts.Parameters)
+
+	wf := &wfv1.Workflow{
+		Spec: wfv1.WorkflowSpec{
+			Arguments: wfv1.Arguments{
+				Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("default")}, {Name: "other", Value: wfv1.AnyStringPtr("0")}},
+			},
+		},
+	}
+	err := ApplySubmitOpts(wf, opts)
+	require.NoError(t, err)
+	assert.Equal(t, "hello=world", wf.Spec.Arguments.GetParameterByName("message").Value.String())
+	assert.Equal(t, "secret", wf.Spec.Arguments.GetParameterByName("api_token").Value.String())
+	assert.Equal(t, "0", wf.Spec.Arguments.GetParameterByName("other").Value.String())
+}
+
 func TestFormulateResubmitWorkflow(t *testing.T) {
 	ctx := logging.TestContext(t.Context())
 	t.Run("Labels", func(t *testing.T) {
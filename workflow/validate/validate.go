@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +17,7 @@ import (
 	"github.com/robfig/cron/v3"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apivalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
@@ -47,6 +50,10 @@ type ValidateOpts struct {
 	// Submit indicates that the current operation is a workflow submission. This will impose
 	// more stringent requirements (e.g. require input values for all spec arguments)
 	Submit bool
+
+	// Interpreters are the script interpreters configured in the controller. If not nil, the script.interpreter of a
+	// template must be one of them.
+	Interpreters map[string][]string
 }
 
 // templateValidationCtx is the context for validating a workflow spec
@@ -137,6 +144,22 @@ func validateHooks(hooks wfv1.LifecycleHooks, hookBaseName string) error {
 	return nil
 }
 
+// validateDeadlinePriorityEscalation checks that deadlinePriorityClassName and deadlinePriorityEscalationThreshold
+// are set together, and that the threshold is a valid duration.
+func validateDeadlinePriorityEscalation(spec *wfv1.WorkflowSpec) error {
+	if (spec.DeadlinePriorityClassName == "") != (spec.DeadlinePriorityEscalationThreshold == "") {
+		return errors.New(errors.CodeBadRequest, "spec.deadlinePriorityClassName and spec.deadlinePriorityEscalationThreshold must be set together")
+	}
+	threshold, err := spec.GetDeadlinePriorityEscalationThreshold()
+	if err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "spec.deadlinePriorityEscalationThreshold invalid: %v", err)
+	}
+	if threshold < 0 {
+		return errors.New(errors.CodeBadRequest, "spec.deadlinePriorityEscalationThreshold must not be negative")
+	}
+	return nil
+}
+
 // ValidateWorkflow accepts a workflow and performs validation against it.
 func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.WorkflowTemplateNamespacedGetter, cwftmplGetter templateresolution.ClusterWorkflowTemplateGetter, wf *wfv1.Workflow, wfDefaults *wfv1.Workflow, opts ValidateOpts) error {
 	tctx := newTemplateValidationCtx(wf, opts)
@@ -160,7 +183,12 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 			return err
 		}
 		if wf.Spec.WorkflowTemplateRef.ClusterScope {
-			wfSpecHolder, err = cwftmplGetter.Get(ctx, wf.Spec.WorkflowTemplateRef.Name)
+			var cwftmpl *wfv1.ClusterWorkflowTemplate
+			cwftmpl, err = cwftmplGetter.Get(ctx, wf.Spec.WorkflowTemplateRef.Name)
+			if err == nil && wf.Namespace != "" {
+				err = templateresolution.CheckClusterWorkflowTemplateNamespace(cwftmpl, wf.Namespace)
+			}
+			wfSpecHolder = cwftmpl
 		} else {
 			wfSpecHolder, err = wftmplGetter.Get(ctx, wf.Spec.WorkflowTemplateRef.Name)
 		}
@@ -172,11 +200,25 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 		}
 		wfTmplRef = wf.Spec.WorkflowTemplateRef.ToTemplateRef(entrypoint)
 	}
+	hasEntrypointRef := wf.Spec.EntrypointRef != nil
+	if hasEntrypointRef {
+		err := validateEntrypointRefFields(wf.Spec)
+		if err != nil {
+			return err
+		}
+		wfSpecHolder, err = wftmplGetter.Get(ctx, wf.Spec.EntrypointRef.WorkflowTemplate)
+		if err != nil {
+			return err
+		}
+		entrypoint = wf.Spec.EntrypointRef.Template
+		// the entrypoint is resolved in the scope of the WorkflowTemplate
+		wfTmplRef = wf.Spec.GetWorkflowTemplateRef().ToTemplateRef(entrypoint)
+	}
 	err = validateWorkflowFieldNames(wf.Spec.Templates)
 
 	wfArgs := wf.Spec.Arguments
 
-	if hasWorkflowTemplateRef {
+	if wfSpecHolder != nil {
 		wfArgs.Parameters = util.MergeParameters(wfArgs.Parameters, wfSpecHolder.GetWorkflowSpec().Arguments.Parameters)
 		wfArgs.Artifacts = util.MergeArtifacts(wfArgs.Artifacts, wfSpecHolder.GetWorkflowSpec().Arguments.Artifacts)
 	}
@@ -192,6 +234,10 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 	if err != nil {
 		return err
 	}
+	err = validateNoFromOutput("spec.arguments.parameters.", wfArgs.Parameters)
+	if err != nil {
+		return err
+	}
 	if len(wfArgs.Parameters) > 0 {
 		tctx.globalParams[common.GlobalVarWorkflowParameters] = placeholderGenerator.NextPlaceholder()
 		tctx.globalParams[common.GlobalVarWorkflowParametersJSON] = placeholderGenerator.NextPlaceholder()
@@ -217,7 +263,7 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 		annotationSources = append(annotationSources, maps.Keys(wfDefaults.Spec.WorkflowMetadata.Annotations))
 		labelSources = append(labelSources, maps.Keys(wfDefaults.Spec.WorkflowMetadata.Labels), maps.Keys(wfDefaults.Spec.WorkflowMetadata.LabelsFrom))
 	}
-	if wf.Spec.WorkflowTemplateRef != nil && wfSpecHolder.GetWorkflowSpec().WorkflowMetadata != nil {
+	if wfSpecHolder != nil && wfSpecHolder.GetWorkflowSpec().WorkflowMetadata != nil {
 		annotationSources = append(annotationSources, maps.Keys(wfSpecHolder.GetWorkflowSpec().WorkflowMetadata.Annotations))
 		labelSources = append(labelSources, maps.Keys(wfSpecHolder.GetWorkflowSpec().WorkflowMetadata.Labels), maps.Keys(wfSpecHolder.GetWorkflowSpec().WorkflowMetadata.LabelsFrom))
 	}
@@ -252,7 +298,7 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 			args = &FakeArguments{}
 		}
 		tmpl := &wfv1.WorkflowStep{Template: entrypoint}
-		if hasWorkflowTemplateRef {
+		if wfTmplRef != nil {
 			tmpl = &wfv1.WorkflowStep{TemplateRef: wfTmplRef}
 		}
 		_, err = tctx.validateTemplateHolder(ctx, tmpl, tmplCtx, args, opts.WorkflowTemplateValidation)
@@ -274,8 +320,8 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 		if hasWorkflowTemplateRef {
 			tmplHolder = &wfv1.WorkflowStep{TemplateRef: wf.Spec.WorkflowTemplateRef.ToTemplateRef(wf.Spec.OnExit)}
 		}
-	} else if hasWorkflowTemplateRef && wfSpecHolder.GetWorkflowSpec().OnExit != "" {
-		tmplHolder = &wfv1.WorkflowStep{TemplateRef: wf.Spec.WorkflowTemplateRef.ToTemplateRef(wfSpecHolder.GetWorkflowSpec().OnExit)}
+	} else if wfSpecHolder != nil && wfSpecHolder.GetWorkflowSpec().OnExit != "" {
+		tmplHolder = &wfv1.WorkflowStep{TemplateRef: wf.Spec.GetWorkflowTemplateRef().ToTemplateRef(wfSpecHolder.GetWorkflowSpec().OnExit)}
 	}
 	if tmplHolder != nil {
 		tctx.globalParams[common.GlobalVarWorkflowFailures] = placeholderGenerator.NextPlaceholder()
@@ -289,12 +335,37 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 		return err
 	}
 
+	if err := validatePodNameFormat(wf.Spec.PodNameFormat); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "podNameFormat %s", err)
+	}
+
 	if !wf.Spec.PodGC.GetStrategy().IsValid() {
 		return errors.Errorf(errors.CodeBadRequest, "podGC.strategy unknown strategy '%s'", wf.Spec.PodGC.Strategy)
 	}
 	if _, err := wf.Spec.PodGC.GetLabelSelector(); err != nil {
 		return errors.Errorf(errors.CodeBadRequest, "podGC.labelSelector invalid: %v", err)
 	}
+	if _, err := wf.Spec.PodGC.GetKeepFailedPodDuration(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "podGC.keepFailedPodDuration invalid: %v", err)
+	}
+	if err := validateDeadlinePriorityEscalation(&wf.Spec); err != nil {
+		return err
+	}
+	if wf.Spec.Metrics != nil {
+		for _, metric := range wf.Spec.Metrics.Prometheus {
+			if metric.PushInterval == nil {
+				continue
+			}
+			if err := metrics.ValidateMetricValues(metric); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "spec.metrics metric '%s' error: %s", metric.Name, err)
+			}
+		}
+	}
+	if wf.Spec.RuntimeClassName != nil {
+		if errs := apivalidation.IsDNS1123Label(*wf.Spec.RuntimeClassName); len(errs) > 0 {
+			return errors.Errorf(errors.CodeBadRequest, "spec.runtimeClassName '%s' is invalid: %s", *wf.Spec.RuntimeClassName, strings.Join(errs, ";"))
+		}
+	}
 
 	// Check if all templates can be resolved.
 	// If the Workflow is using a WorkflowTemplateRef, then the templates of the referred WorkflowTemplate will be validated.
@@ -314,6 +385,19 @@ func ValidateWorkflow(ctx context.Context, wftmplGetter templateresolution.Workf
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s %s", template.Name, err.Error())
 		}
 	}
+	// If the Workflow is using an EntrypointRef, then the templates of the referred WorkflowTemplate that are not
+	// overridden by the Workflow will also be validated.
+	if hasEntrypointRef {
+		for _, template := range wfSpecHolder.GetWorkflowSpec().Templates {
+			if slices.ContainsFunc(wf.Spec.Templates, func(t wfv1.Template) bool { return t.Name == template.Name }) {
+				continue
+			}
+			_, err := tctx.validateTemplateHolder(ctx, &wfv1.WorkflowStep{TemplateRef: wf.Spec.GetWorkflowTemplateRef().ToTemplateRef(template.Name)}, tmplCtx, &FakeArguments{}, opts.WorkflowTemplateValidation)
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s %s", template.Name, err.Error())
+			}
+		}
+	}
 	return nil
 }
 
@@ -335,6 +419,19 @@ func ValidateWorkflowTemplateRefFields(wfSpec wfv1.WorkflowSpec) error {
 	return nil
 }
 
+func validateEntrypointRefFields(wfSpec wfv1.WorkflowSpec) error {
+	if wfSpec.WorkflowTemplateRef != nil {
+		return errors.Errorf(errors.CodeBadRequest, "spec.entrypointRef may not be used together with spec.workflowTemplateRef")
+	}
+	if wfSpec.Entrypoint != "" {
+		return errors.Errorf(errors.CodeBadRequest, "spec.entrypointRef may not be used together with spec.entrypoint")
+	}
+	if wfSpec.EntrypointRef.WorkflowTemplate == "" || wfSpec.EntrypointRef.Template == "" {
+		return errors.Errorf(errors.CodeBadRequest, "spec.entrypointRef.workflowTemplate and spec.entrypointRef.template are required")
+	}
+	return nil
+}
+
 // ValidateWorkflowTemplate accepts a workflow template and performs validation against it.
 func ValidateWorkflowTemplate(ctx context.Context, wftmplGetter templateresolution.WorkflowTemplateNamespacedGetter, cwftmplGetter templateresolution.ClusterWorkflowTemplateGetter, wftmpl *wfv1.WorkflowTemplate, wfDefaults *wfv1.Workflow, opts ValidateOpts) error {
 	if len(wftmpl.Name) > maxCharsInObjectName {
@@ -414,6 +511,9 @@ func (tctx *templateValidationCtx) validateInitContainers(containers []wfv1.User
 		if len(container.Name) == 0 {
 			return errors.Errorf(errors.CodeBadRequest, "initContainers must all have container name")
 		}
+		if container.Name == common.InitContainerName || container.Name == common.WaitContainerName || container.Name == common.MainContainerName {
+			return errors.Errorf(errors.CodeBadRequest, "initContainers.%s uses a container name reserved by Argo", container.Name)
+		}
 	}
 	return nil
 }
@@ -467,6 +567,12 @@ func (tctx *templateValidationCtx) validateTemplate(ctx context.Context, tmpl *w
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s %s", tmpl.Name, err)
 	}
 
+	if newTmpl.RuntimeClassName != nil {
+		if errs := apivalidation.IsDNS1123Label(*newTmpl.RuntimeClassName); len(errs) > 0 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.runtimeClassName '%s' is invalid: %s", tmpl.Name, *newTmpl.RuntimeClassName, strings.Join(errs, ";"))
+		}
+	}
+
 	if newTmpl.Timeout != "" {
 		if !newTmpl.IsLeaf() {
 			return fmt.Errorf("%s template doesn't support timeout field.", newTmpl.GetType())
@@ -527,6 +633,9 @@ func (tctx *templateValidationCtx) validateTemplate(ctx context.Context, tmpl *w
 			if err := metrics.ValidateMetricValues(metric); err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s metric '%s' error: %s", tmpl.Name, metric.Name, err)
 			}
+			if metric.PushInterval != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s metric '%s' pushInterval is only valid in spec.metrics", tmpl.Name, metric.Name)
+			}
 		}
 	}
 	return nil
@@ -624,8 +733,15 @@ func validateInputs(tmpl *wfv1.Template) (map[string]interface{}, error) {
 	if err != nil {
 		return nil, errors.Errorf(errors.CodeBadRequest, "templates.%s.inputs.artifacts%s", tmpl.Name, err.Error())
 	}
+	err = validateNoFromOutput(fmt.Sprintf("templates.%s.inputs.parameters.", tmpl.Name), tmpl.Inputs.Parameters)
+	if err != nil {
+		return nil, err
+	}
 	scope := make(map[string]interface{})
 	for _, param := range tmpl.Inputs.Parameters {
+		if param.Default != nil && param.DefaultExpression != "" {
+			return nil, errors.Errorf(errors.CodeBadRequest, "templates.%s.inputs.parameters.%s cannot have both default and defaultExpression", tmpl.Name, param.Name)
+		}
 		scope[fmt.Sprintf("inputs.parameters.%s", param.Name)] = true
 	}
 	if len(tmpl.Inputs.Parameters) > 0 {
@@ -649,11 +765,37 @@ func validateInputs(tmpl *wfv1.Template) (map[string]interface{}, error) {
 		if art.From != "" {
 			return nil, errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.from not valid in inputs", tmpl.Name, artRef)
 		}
+		if art.NamedPipe != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.namedPipe not valid in inputs", tmpl.Name, artRef)
+		}
+		if art.UnpackTo != "" {
+			if tmpl.Container == nil && tmpl.Script == nil {
+				return nil, errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.unpackTo only valid in container/script templates", tmpl.Name, artRef)
+			}
+			unpackArt := wfv1.Artifact{Name: art.Name, Path: art.UnpackTo}
+			if err := unpackArt.CleanPath(); err != nil {
+				return nil, errors.Errorf(errors.CodeBadRequest, "error in templates.%s.%s.unpackTo: %s", tmpl.Name, artRef, err.Error())
+			}
+			if common.FindOverlappingVolume(tmpl, unpackArt.Path) == nil {
+				return nil, errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.unpackTo '%s' must be in a volume mounted by the container", tmpl.Name, artRef, art.UnpackTo)
+			}
+		}
 		errPrefix := fmt.Sprintf("templates.%s.%s", tmpl.Name, artRef)
 		err = validateArtifactLocation(errPrefix, art.ArtifactLocation)
 		if err != nil {
 			return nil, err
 		}
+		if src := art.FromImageLabel; src != nil {
+			if src.Label == "" {
+				return nil, errors.Errorf(errors.CodeBadRequest, "%s.fromImageLabel.label is required", errPrefix)
+			}
+			if src.Image == "" && tmpl.Container == nil && tmpl.Script == nil {
+				return nil, errors.Errorf(errors.CodeBadRequest, "%s.fromImageLabel.image is required for templates without a container or script", errPrefix)
+			}
+			if v, _ := art.Get(); v != nil && art.HTTP == nil && art.Artifactory == nil {
+				return nil, errors.Errorf(errors.CodeBadRequest, "%s.fromImageLabel can only be combined with an http or artifactory location", errPrefix)
+			}
+		}
 	}
 	return scope, nil
 }
@@ -729,6 +871,9 @@ func validateNonLeaf(tmpl *wfv1.Template) error {
 	if tmpl.ActiveDeadlineSeconds != nil {
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s.activeDeadlineSeconds is only valid for leaf templates", tmpl.Name)
 	}
+	if len(tmpl.OverrideCommand) > 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.overrideCommand is only valid for container, containerSet and script templates", tmpl.Name)
+	}
 	return nil
 }
 
@@ -756,6 +901,9 @@ func (tctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tm
 			}
 			mountPaths[art.Path] = fmt.Sprintf("inputs.artifacts.%s", art.Name)
 		}
+		if tmpl.Container.TTY && !tmpl.Container.Stdin {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.container.tty requires stdin to be true", tmpl.Name)
+		}
 		if tmpl.Container.Image == "" {
 			switch baseTemplate := tmplCtx.GetCurrentTemplateBase().(type) {
 			case *wfv1.Workflow:
@@ -792,6 +940,40 @@ func (tctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tm
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.action must be one of: get, create, apply, delete, replace, patch", tmpl.Name)
 			}
 		}
+		if !placeholderGenerator.IsPlaceholder(tmpl.Resource.MergeStrategy) {
+			switch tmpl.Resource.MergeStrategy {
+			case "", "strategic", "merge", "json":
+				// OK
+			case common.ResourceMergeStrategyServerSide:
+				if tmpl.Resource.Action != "apply" && tmpl.Resource.Action != "patch" && !placeholderGenerator.IsPlaceholder(tmpl.Resource.Action) {
+					return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.mergeStrategy %s can only be used with the apply and patch actions", tmpl.Name, common.ResourceMergeStrategyServerSide)
+				}
+			default:
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.mergeStrategy must be one of: strategic, merge, json, %s", tmpl.Name, common.ResourceMergeStrategyServerSide)
+			}
+		}
+		if err := common.ValidateResourceFlags(tmpl.Resource.Flags); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.flags: %s", tmpl.Name, err)
+		}
+		if tmpl.Resource.Backoff == nil || !placeholderGenerator.IsPlaceholder(tmpl.Resource.Backoff.Duration) {
+			if _, err := tmpl.Resource.GetBackoff(wait.Backoff{}); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.%s", tmpl.Name, err)
+			}
+		}
+		if waitFor := tmpl.Resource.WaitForResource; waitFor != nil {
+			if tmpl.Resource.Action == "delete" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.waitForResource is not supported for the delete action", tmpl.Name)
+			}
+			if waitFor.JSONPath == "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.waitForResource.jsonPath is required", tmpl.Name)
+			}
+			if waitFor.Timeout != nil && waitFor.Timeout.Duration <= 0 {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.waitForResource.timeout must be positive", tmpl.Name)
+			}
+			if waitFor.PollInterval != nil && waitFor.PollInterval.Duration <= 0 {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.waitForResource.pollInterval must be positive", tmpl.Name)
+			}
+		}
 		if tmpl.Resource.Action != "delete" && tmpl.Resource.Action != "get" {
 			if tmpl.Resource.Manifest == "" && tmpl.Resource.ManifestFrom == nil {
 				return errors.Errorf(errors.CodeBadRequest, "either templates.%s.resource.manifest or templates.%s.resource.manifestFrom must be specified", tmpl.Name, tmpl.Name)
@@ -827,7 +1009,27 @@ func (tctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tm
 		}
 	}
 	if tmpl.Script != nil {
-		if tmpl.Script.Image == "" {
+		if tmpl.Script.TTY && !tmpl.Script.Stdin {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.script.tty requires stdin to be true", tmpl.Name)
+		}
+		for i, p := range tmpl.Script.Preprocessors {
+			if len(p.Command) == 0 {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.script.preprocessors[%d].command is required", tmpl.Name, i)
+			}
+		}
+		if name := tmpl.Script.Interpreter; name != "" {
+			if len(tmpl.Script.Command) > 0 {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.script.interpreter may not be used together with command", tmpl.Name)
+			}
+			if _, ok := tctx.Interpreters[name]; !ok && tctx.Interpreters != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.script.interpreter %q is not one of the interpreters configured in the controller", tmpl.Name, name)
+			}
+		}
+		if ref := tmpl.Script.ImageRef; ref != nil {
+			if ref.ClusterWorkflowTemplate == "" || ref.ImageKey == "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.script.imageRef requires both clusterWorkflowTemplate and imageKey", tmpl.Name)
+			}
+		} else if tmpl.Script.Image == "" {
 			switch baseTemplate := tmplCtx.GetCurrentTemplateBase().(type) {
 			case *wfv1.Workflow:
 				if baseTemplate.Spec.TemplateDefaults == nil || baseTemplate.Spec.TemplateDefaults.Script == nil || baseTemplate.Spec.TemplateDefaults.Script.Image == "" {
@@ -842,6 +1044,24 @@ func (tctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tm
 			}
 		}
 	}
+	if tmpl.Data != nil {
+		source := tmpl.Data.Source
+		numSources := 0
+		for _, isSet := range []bool{source.ArtifactPaths != nil, source.ParameterName != "", source.ArtifactName != ""} {
+			if isSet {
+				numSources++
+			}
+		}
+		if numSources != 1 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.source must specify exactly one of artifactPaths, parameterName or artifactName", tmpl.Name)
+		}
+		if source.ParameterName != "" && tmpl.Inputs.GetParameterByName(source.ParameterName) == nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.source.parameterName refers to a non-existent input parameter %s", tmpl.Name, source.ParameterName)
+		}
+		if source.ArtifactName != "" && tmpl.Inputs.GetArtifactByName(source.ArtifactName) == nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.source.artifactName refers to a non-existent input artifact %s", tmpl.Name, source.ArtifactName)
+		}
+	}
 	// we don't validate tmpl.Plugin, because this is done by Plugin.UnmarshallJSON
 	if tmpl.ActiveDeadlineSeconds != nil {
 		if !intstr.IsValidIntOrArgoVariable(tmpl.ActiveDeadlineSeconds) && !placeholderGenerator.IsPlaceholder(tmpl.ActiveDeadlineSeconds.StrVal) {
@@ -851,6 +1071,14 @@ func (tctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tm
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.activeDeadlineSeconds must be a positive integer > 0 or an argo variable", tmpl.Name)
 		}
 	}
+	if len(tmpl.OverrideCommand) > 0 {
+		if tmpl.Container == nil && tmpl.ContainerSet == nil && tmpl.Script == nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.overrideCommand is only valid for container, containerSet and script templates", tmpl.Name)
+		}
+		if !tmpl.AllowOverrideCommand {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.overrideCommand requires allowOverrideCommand to be true", tmpl.Name)
+		}
+	}
 	if tmpl.Parallelism != nil {
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s.parallelism is only valid for steps and dag templates", tmpl.Name)
 	}
@@ -883,11 +1111,19 @@ func validateArgumentsFieldNames(prefix string, arguments wfv1.Arguments) error
 func validateArgumentsValues(prefix string, arguments wfv1.Arguments, allowEmptyValues bool) error {
 	for _, param := range arguments.Parameters {
 		// check if any value is defined
-		if param.ValueFrom == nil && param.Value == nil {
+		if param.ValueFrom == nil && param.Value == nil && param.FromOutput == nil {
 			if !allowEmptyValues {
 				return errors.Errorf(errors.CodeBadRequest, "%s%s.value or %s%s.valueFrom is required", prefix, param.Name, prefix, param.Name)
 			}
 		}
+		if ref := param.FromOutput; ref != nil {
+			if param.Value != nil || param.ValueFrom != nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s%s.fromOutput cannot be combined with value or valueFrom", prefix, param.Name)
+			}
+			if ref.Step == "" || ref.Parameter == "" {
+				return errors.Errorf(errors.CodeBadRequest, "%s%s.fromOutput.step and %s%s.fromOutput.parameter are required", prefix, param.Name, prefix, param.Name)
+			}
+		}
 		if param.ValueFrom != nil {
 			// check for valid valueFrom sub-parameters
 			// INFO: default needs to be accompanied by ConfigMapKeyRef.
@@ -933,6 +1169,47 @@ func validateArgumentsValues(prefix string, arguments wfv1.Arguments, allowEmpty
 	return nil
 }
 
+// validateNoFromOutput ensures fromOutput is not used outside of step and DAG task arguments
+func validateNoFromOutput(prefix string, params []wfv1.Parameter) error {
+	for _, param := range params {
+		if param.FromOutput != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s%s.fromOutput is only allowed in the arguments of a step or DAG task", prefix, param.Name)
+		}
+	}
+	return nil
+}
+
+// validateFromOutputParameter ensures that the template of the referenced step or task declares the output parameter.
+// A nil template cannot be resolved until runtime and is not checked.
+func validateFromOutputParameter(prefix string, param wfv1.Parameter, kind string, tmpl *wfv1.Template) error {
+	if tmpl == nil {
+		return nil
+	}
+	for _, out := range tmpl.Outputs.Parameters {
+		if out.Name == param.FromOutput.Parameter {
+			return nil
+		}
+	}
+	return errors.Errorf(errors.CodeBadRequest, "%s%s.fromOutput %s '%s' has no output parameter '%s'", prefix, param.Name, kind, param.FromOutput.Step, param.FromOutput.Parameter)
+}
+
+// validateStepFromOutputs ensures that every fromOutput in a step's arguments refers to a step in a previous step group
+func validateStepFromOutputs(prefix string, arguments wfv1.Arguments, previousSteps map[string]*wfv1.Template) error {
+	for _, param := range arguments.Parameters {
+		if param.FromOutput == nil {
+			continue
+		}
+		tmpl, ok := previousSteps[param.FromOutput.Step]
+		if !ok {
+			return errors.Errorf(errors.CodeBadRequest, "%s%s.fromOutput step '%s' does not exist in a previous step group", prefix, param.Name, param.FromOutput.Step)
+		}
+		if err := validateFromOutputParameter(prefix, param, "step", tmpl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (tctx *templateValidationCtx) validateSteps(ctx context.Context, scope map[string]interface{}, tmplCtx *templateresolution.TemplateContext, tmpl *wfv1.Template, workflowTemplateValidation bool) error {
 	err := validateNonLeaf(tmpl)
 	if err != nil {
@@ -940,6 +1217,7 @@ func (tctx *templateValidationCtx) validateSteps(ctx context.Context, scope map[
 	}
 	stepNames := make(map[string]bool)
 	resolvedTemplates := make(map[string]*wfv1.Template)
+	previousSteps := make(map[string]*wfv1.Template)
 	for i, stepGroup := range tmpl.Steps {
 		for _, step := range stepGroup.Steps {
 			if step.Name == "" {
@@ -955,14 +1233,27 @@ func (tctx *templateValidationCtx) validateSteps(ctx context.Context, scope map[
 			stepNames[step.Name] = true
 			prefix := fmt.Sprintf("steps.%s", step.Name)
 			scope[fmt.Sprintf("%s.status", prefix)] = true
-			err := addItemsToScope(step.WithItems, step.WithParam, step.WithSequence, scope)
+			err := addItemsToScope(step.WithItems, step.WithParam, step.WithParamArtifact, step.WithSequence, scope)
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
+			if ref := step.WithParamArtifact; ref != nil {
+				if ref.Step == "" || ref.Task != "" {
+					return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s.withParamArtifact.step is required in a steps template", tmpl.Name, i, step.Name)
+				}
+				if _, ok := scope[ref.Reference()]; !ok {
+					return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s.withParamArtifact references '%s', which is not an output artifact of a previous step", tmpl.Name, i, step.Name, ref.Reference())
+				}
+			}
 			err = validateArguments(fmt.Sprintf("templates.%s.steps[%d].%s.arguments.", tmpl.Name, i, step.Name), step.Arguments, false)
 			if err != nil {
 				return err
 			}
+			err = validateStepFromOutputs(fmt.Sprintf("templates.%s.steps[%d].%s.arguments.parameters.", tmpl.Name, i, step.Name), step.Arguments, previousSteps)
+			if err != nil {
+				return err
+			}
+			step.Arguments = common.ResolveFromOutputs(step.Arguments, "steps")
 			resolvedTmpl, err := tctx.validateTemplateHolder(ctx, &step, tmplCtx, &FakeArguments{}, workflowTemplateValidation)
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
@@ -999,7 +1290,7 @@ func (tctx *templateValidationCtx) validateSteps(ctx context.Context, scope map[
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps %s", tmpl.Name, err.Error())
 			}
 
-			aggregate := len(step.WithItems) > 0 || step.WithParam != ""
+			aggregate := len(step.WithItems) > 0 || step.WithParam != "" || step.WithParamArtifact != nil
 
 			tctx.addOutputsToScope(ctx, resolvedTmpl, fmt.Sprintf("steps.%s", step.Name), scope, aggregate, false)
 
@@ -1009,11 +1300,14 @@ func (tctx *templateValidationCtx) validateSteps(ctx context.Context, scope map[
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
 		}
+		for _, step := range stepGroup.Steps {
+			previousSteps[step.Name] = resolvedTemplates[step.Name]
+		}
 	}
 	return nil
 }
 
-func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1.Sequence, scope map[string]interface{}) error {
+func addItemsToScope(withItems []wfv1.Item, withParam string, withParamArtifact *wfv1.WithParamArtifact, withSequence *wfv1.Sequence, scope map[string]interface{}) error {
 	defined := 0
 	if len(withItems) > 0 {
 		defined++
@@ -1021,11 +1315,17 @@ func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1
 	if withParam != "" {
 		defined++
 	}
+	if withParamArtifact != nil {
+		defined++
+	}
 	if withSequence != nil {
 		defined++
 	}
 	if defined > 1 {
-		return fmt.Errorf("only one of withItems, withParam, withSequence can be specified")
+		return fmt.Errorf("only one of withItems, withParam, withParamArtifact, withSequence can be specified")
+	}
+	if withParamArtifact != nil && withParamArtifact.Artifact == "" {
+		return fmt.Errorf("withParamArtifact.artifact is required")
 	}
 	if len(withItems) > 0 {
 		for i := range withItems {
@@ -1045,7 +1345,7 @@ func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1
 				return fmt.Errorf("unsupported withItems type: %v", val)
 			}
 		}
-	} else if withParam != "" {
+	} else if withParam != "" || withParamArtifact != nil {
 		scope["item"] = true
 		// 'item.*' is magic placeholder value which resolveAllVariables() will look for
 		// when considering if all variables are resolveable.
@@ -1054,6 +1354,9 @@ func addItemsToScope(withItems []wfv1.Item, withParam string, withSequence *wfv1
 		if withSequence.Count != nil && withSequence.End != nil {
 			return errors.New(errors.CodeBadRequest, "only one of count or end can be defined in withSequence")
 		}
+		if withSequence.Format != "" && withSequence.GoTemplate != "" {
+			return errors.New(errors.CodeBadRequest, "only one of format or goTemplate can be defined in withSequence")
+		}
 		scope["item"] = true
 	}
 	return nil
@@ -1137,7 +1440,75 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 
 	for _, art := range tmpl.Outputs.Artifacts {
 		artRef := fmt.Sprintf("outputs.artifacts.%s", art.Name)
-		if tmpl.IsLeaf() {
+		if art.UnpackTo != "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.unpackTo not valid in outputs", tmpl.Name, artRef)
+		}
+		if pipe := art.NamedPipe; pipe != nil {
+			if tmpl.Container == nil && tmpl.Script == nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.namedPipe only valid in container/script templates", tmpl.Name, artRef)
+			}
+			if art.Path != "" || art.GlobPath != "" || len(art.Paths) > 0 || art.PersistentVolumeClaim != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s only one of path, globPath, paths, persistentVolumeClaim or namedPipe can be specified", tmpl.Name, artRef)
+			}
+			pipeArt := wfv1.Artifact{Name: art.Name, Path: pipe.Path}
+			if err := pipeArt.CleanPath(); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "error in templates.%s.%s.namedPipe: %s", tmpl.Name, artRef, err.Error())
+			}
+			if common.FindOverlappingVolume(tmpl, pipeArt.Path) == nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.namedPipe.path '%s' must be in a volume mounted by the container", tmpl.Name, artRef, pipe.Path)
+			}
+			if art.Archive != nil && art.Archive.None == nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.namedPipe requires the none archive strategy", tmpl.Name, artRef)
+			}
+			if art.Naming != nil && art.Naming.Strategy == wfv1.ArtifactNamingHash {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.namedPipe does not support the hash naming strategy", tmpl.Name, artRef)
+			}
+		} else if pvc := art.PersistentVolumeClaim; pvc != nil {
+			if tmpl.Container == nil && tmpl.Script == nil && tmpl.ContainerSet == nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.persistentVolumeClaim only valid in container/containerSet/script templates", tmpl.Name, artRef)
+			}
+			if art.Path != "" || art.GlobPath != "" || len(art.Paths) > 0 {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s only one of path, globPath, paths or persistentVolumeClaim can be specified", tmpl.Name, artRef)
+			}
+			if pvc.ClaimName == "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.persistentVolumeClaim.claimName is required", tmpl.Name, artRef)
+			}
+			if strings.Contains(pvc.SubPath, "..") {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.persistentVolumeClaim.subPath must not contain '..'", tmpl.Name, artRef)
+			}
+		} else if len(art.Paths) > 0 {
+			if !tmpl.IsLeaf() {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.paths only valid in container/script templates", tmpl.Name, artRef)
+			}
+			if art.Path != "" || art.GlobPath != "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s only one of path, globPath or paths can be specified", tmpl.Name, artRef)
+			}
+			if art.Archive != nil && art.Archive.Tar == nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.paths requires the tar archive strategy", tmpl.Name, artRef)
+			}
+			baseNames := make(map[string]bool)
+			for _, p := range art.Paths {
+				pathArt := wfv1.Artifact{Name: art.Name, Path: p}
+				if err := pathArt.CleanPath(); err != nil {
+					return errors.Errorf(errors.CodeBadRequest, "error in templates.%s.%s: %s", tmpl.Name, artRef, err.Error())
+				}
+				baseName := filepath.Base(pathArt.Path)
+				if baseNames[baseName] {
+					return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.paths must have unique base names: '%s' is repeated", tmpl.Name, artRef, baseName)
+				}
+				baseNames[baseName] = true
+			}
+		} else if art.GlobPath != "" {
+			if !tmpl.IsLeaf() {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.globPath only valid in container/script templates", tmpl.Name, artRef)
+			}
+			if art.Path != "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s only one of path or globPath can be specified", tmpl.Name, artRef)
+			}
+			if _, err := filepath.Match(art.GlobPath, ""); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.globPath '%s' is invalid: %v", tmpl.Name, artRef, art.GlobPath, err)
+			}
+		} else if tmpl.IsLeaf() {
 			err = art.CleanPath()
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "error in templates.%s.%s: %s", tmpl.Name, artRef, err.Error())
@@ -1164,13 +1535,22 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 			tmplType := tmpl.GetType()
 			switch tmplType {
 			case wfv1.TemplateTypeContainer, wfv1.TemplateTypeContainerSet, wfv1.TemplateTypeScript:
-				if param.ValueFrom.Path == "" {
+				if param.ValueFrom.ArtifactName != "" {
+					if tmpl.Outputs.GetArtifactByName(param.ValueFrom.ArtifactName) == nil && tmpl.Inputs.GetArtifactByName(param.ValueFrom.ArtifactName) == nil {
+						return errors.Errorf(errors.CodeBadRequest, "%s.valueFrom.artifactName '%s' is not an input or output artifact of the template", paramRef, param.ValueFrom.ArtifactName)
+					}
+					break
+				}
+				if param.ValueFrom.Path == "" && param.ValueFrom.FromAnnotation == nil {
 					return errors.Errorf(errors.CodeBadRequest, "%s.path must be specified for %s templates", paramRef, tmplType)
 				}
 			case wfv1.TemplateTypeResource:
 				if param.ValueFrom.JQFilter == "" && param.ValueFrom.JSONPath == "" {
 					return errors.Errorf(errors.CodeBadRequest, "%s .jqFilter or jsonPath must be specified for %s templates", paramRef, tmplType)
 				}
+				if param.ValueFrom.ArtifactName != "" {
+					return errors.Errorf(errors.CodeBadRequest, "%s.valueFrom.artifactName is not supported for %s templates", paramRef, tmplType)
+				}
 			case wfv1.TemplateTypeDAG, wfv1.TemplateTypeSteps:
 				if param.ValueFrom.Parameter == "" && param.ValueFrom.Expression == "" {
 					return errors.Errorf(errors.CodeBadRequest, "%s.parameter or expression must be specified for %s templates", paramRef, tmplType)
@@ -1210,12 +1590,29 @@ func validateOutputParameter(paramRef string, param *wfv1.Parameter) error {
 	if param.ValueFrom.Supplied != nil {
 		paramTypes++
 	}
+	if param.ValueFrom.FromAnnotation != nil {
+		if param.ValueFrom.FromAnnotation.Annotation == "" {
+			return errors.Errorf(errors.CodeBadRequest, "%s.valueFrom.fromAnnotation.annotation must be specified", paramRef)
+		}
+		paramTypes++
+	}
+	if param.ValueFrom.ArtifactName != "" && param.ValueFrom.JQFilter == "" {
+		return errors.Errorf(errors.CodeBadRequest, "%s.valueFrom.artifactName requires jqFilter", paramRef)
+	}
+	switch param.ValueFrom.Format {
+	case "", wfv1.ValueFromFormatTrim, wfv1.ValueFromFormatBase64, wfv1.ValueFromFormatBase64URL:
+	default:
+		return errors.Errorf(errors.CodeBadRequest, "%s.valueFrom.format '%s' is invalid. choose one of: trim, base64, base64url", paramRef, param.ValueFrom.Format)
+	}
+	if param.ValueFrom.Format != "" && param.ValueFrom.Path == "" && param.ValueFrom.FromAnnotation == nil {
+		return errors.Errorf(errors.CodeBadRequest, "%s.valueFrom.format is only supported with path or fromAnnotation", paramRef)
+	}
 	switch paramTypes {
 	case 0:
-		return errors.New(errors.CodeBadRequest, "valueFrom type unspecified. choose one of: path, jqFilter, jsonPath, parameter, raw, expression")
+		return errors.New(errors.CodeBadRequest, "valueFrom type unspecified. choose one of: path, jqFilter, jsonPath, parameter, raw, expression, fromAnnotation")
 	case 1:
 	default:
-		return errors.New(errors.CodeBadRequest, "multiple valueFrom types specified. choose one of: path, jqFilter, jsonPath, parameter, raw")
+		return errors.New(errors.CodeBadRequest, "multiple valueFrom types specified. choose one of: path, jqFilter, jsonPath, parameter, raw, fromAnnotation")
 	}
 	return nil
 }
@@ -1364,8 +1761,13 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 
 		resolvedTemplates[task.Name] = resolvedTmpl
 
+		err = tctx.validateDAGTaskPhaseHooks(ctx, &task, tmplCtx, workflowTemplateValidation)
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
+		}
+
 		prefix := fmt.Sprintf("tasks.%s", task.Name)
-		aggregate := len(task.WithItems) > 0 || task.WithParam != ""
+		aggregate := len(task.WithItems) > 0 || task.WithParam != "" || task.WithParamArtifact != nil
 		tctx.addOutputsToScope(ctx, resolvedTmpl, prefix, scope, aggregate, false)
 
 		err = common.ValidateTaskResults(&task)
@@ -1379,7 +1781,7 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 				return errors.Errorf(errors.CodeBadRequest,
 					"templates.%s.tasks.%s dependency '%s' not defined",
 					tmpl.Name, task.Name, depName)
-			} else if depType == common.DependencyTypeItems && len(task.WithItems) == 0 && task.WithParam == "" && task.WithSequence == nil {
+			} else if depType == common.DependencyTypeItems && !task.ShouldExpand() {
 				return errors.Errorf(errors.CodeBadRequest,
 					"templates.%s.tasks.%s dependency '%s' uses an items-based condition such as .AnySucceeded or .AllFailed but does not contain any items",
 					tmpl.Name, task.Name, depName)
@@ -1403,7 +1805,7 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 		// add all tasks outputs to scope so that a nested DAGs can have outputs
 		prefix := fmt.Sprintf("tasks.%s", task.Name)
 		// add self status reference for  hooks
-		if task.Hooks != nil {
+		if len(task.GetLifecycleHooks()) > 0 {
 			scope[fmt.Sprintf("%s.status", prefix)] = true
 		}
 		tctx.addOutputsToScope(ctx, resolvedTmpl, prefix, scope, false, false)
@@ -1423,7 +1825,7 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 			ancestorTask := dagValidationCtx.GetTask(ctx, ancestor)
 			resolvedTmpl := resolvedTemplates[ancestor]
 			ancestorPrefix := fmt.Sprintf("tasks.%s", ancestor)
-			aggregate := len(ancestorTask.WithItems) > 0 || ancestorTask.WithParam != ""
+			aggregate := len(ancestorTask.WithItems) > 0 || ancestorTask.WithParam != "" || ancestorTask.WithParamArtifact != nil
 			tctx.addOutputsToScope(ctx, resolvedTmpl, ancestorPrefix, taskScope, aggregate, true)
 		}
 		if i := task.Inline; i != nil {
@@ -1432,10 +1834,18 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 			}
 		}
 
-		err = addItemsToScope(task.WithItems, task.WithParam, task.WithSequence, taskScope)
+		err = addItemsToScope(task.WithItems, task.WithParam, task.WithParamArtifact, task.WithSequence, taskScope)
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
+		if ref := task.WithParamArtifact; ref != nil {
+			if ref.Task == "" || ref.Step != "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s.withParamArtifact.task is required in a DAG template", tmpl.Name, task.Name)
+			}
+			if _, ok := taskScope[ref.Reference()]; !ok || !slices.Contains(ancestry, ref.Task) {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s.withParamArtifact references '%s', which is not an output artifact of a dependency", tmpl.Name, task.Name, ref.Reference())
+			}
+		}
 		err = resolveAllVariables(taskScope, tctx.globalParams, string(taskBytes), workflowTemplateValidation)
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
@@ -1448,6 +1858,11 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
+		err = validateDAGTaskFromOutputs(fmt.Sprintf("templates.%s.tasks.%s.arguments.parameters.", tmpl.Name, task.Name), task.Arguments, nameToTask, ancestry, resolvedTemplates)
+		if err != nil {
+			return err
+		}
+		task.Arguments = common.ResolveFromOutputs(task.Arguments, "tasks")
 		// Validate the template again with actual arguments.
 		_, err = tctx.validateTemplateHolder(ctx, &task, tmplCtx, &task.Arguments, workflowTemplateValidation)
 		if err != nil {
@@ -1458,6 +1873,32 @@ func (tctx *templateValidationCtx) validateDAG(ctx context.Context, scope map[st
 	return nil
 }
 
+// validateDAGTaskPhaseHooks validates the onSuccess and onFailure hooks of a DAG task.
+func (tctx *templateValidationCtx) validateDAGTaskPhaseHooks(ctx context.Context, task *wfv1.DAGTask, tmplCtx *templateresolution.TemplateContext, workflowTemplateValidation bool) error {
+	for _, h := range []struct {
+		name wfv1.LifecycleEvent
+		hook *wfv1.LifecycleHook
+	}{{wfv1.SuccessLifecycleEvent, task.OnSuccess}, {wfv1.FailureLifecycleEvent, task.OnFailure}} {
+		if h.hook == nil {
+			continue
+		}
+		if _, ok := task.Hooks[h.name]; ok {
+			return errors.Errorf(errors.CodeBadRequest, "%s cannot be combined with hooks.%s", h.name, h.name)
+		}
+		if h.hook.Expression != "" {
+			return errors.Errorf(errors.CodeBadRequest, "%s.expression is not supported, the hook is triggered by the phase of the task", h.name)
+		}
+		if h.hook.Template == "" && h.hook.TemplateRef == nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s must specify a template or templateRef", h.name)
+		}
+		_, err := tctx.validateTemplateHolder(ctx, &wfv1.WorkflowStep{Template: h.hook.Template, TemplateRef: h.hook.TemplateRef}, tmplCtx, &FakeArguments{}, workflowTemplateValidation)
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s %s", h.name, err.Error())
+		}
+	}
+	return nil
+}
+
 func validateDAGTaskArgumentDependency(arguments wfv1.Arguments, ancestry []string) error {
 	ancestryMap := make(map[string]struct{}, len(ancestry))
 	for _, a := range ancestry {
@@ -1490,6 +1931,26 @@ func validateDAGTaskArgumentDependency(arguments wfv1.Arguments, ancestry []stri
 	return nil
 }
 
+// validateDAGTaskFromOutputs ensures that every fromOutput in a task's arguments refers to a task it depends on
+func validateDAGTaskFromOutputs(prefix string, arguments wfv1.Arguments, nameToTask map[string]wfv1.DAGTask, ancestry []string, resolvedTemplates map[string]*wfv1.Template) error {
+	for _, param := range arguments.Parameters {
+		if param.FromOutput == nil {
+			continue
+		}
+		refTaskName := param.FromOutput.Step
+		if _, ok := nameToTask[refTaskName]; !ok {
+			return errors.Errorf(errors.CodeBadRequest, "%s%s.fromOutput task '%s' does not exist", prefix, param.Name, refTaskName)
+		}
+		if !slices.Contains(ancestry, refTaskName) {
+			return errors.Errorf(errors.CodeBadRequest, "%s%s.fromOutput task '%s' is not a dependency", prefix, param.Name, refTaskName)
+		}
+		if err := validateFromOutputParameter(prefix, param, "task", resolvedTemplates[refTaskName]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func validateDAGTargets(tmpl *wfv1.Template, nameToTask map[string]wfv1.DAGTask) error {
 	if tmpl.DAG.Target == "" {
 		return nil
@@ -1595,6 +2056,30 @@ const (
 )
 
 // isValidWorkflowFieldName : workflow field name must consist of alpha-numeric characters or '-', and must start with an alpha-numeric character
+var (
+	podNameFormatTokenRegex = regexp.MustCompile(`{{[^}]*}}`)
+	podNameFormatRegex      = regexp.MustCompile(`^[a-z0-9-]*$`)
+)
+
+// validatePodNameFormat checks that a podNameFormat only has known tokens, and that the rest of it can be used in a pod
+// name. The tokens are made valid, and the name truncated, when the pod name is generated.
+func validatePodNameFormat(format string) error {
+	if format == "" {
+		return nil
+	}
+	for _, token := range podNameFormatTokenRegex.FindAllString(format, -1) {
+		switch token {
+		case "{{workflow.name}}", "{{template.name}}", "{{node.id}}":
+		default:
+			return fmt.Errorf("has unknown token '%s', must be one of {{workflow.name}}, {{template.name}} or {{node.id}}", token)
+		}
+	}
+	if !podNameFormatRegex.MatchString(podNameFormatTokenRegex.ReplaceAllString(format, "")) {
+		return fmt.Errorf("'%s' must only contain lower case alphanumeric characters, '-' and tokens", format)
+	}
+	return nil
+}
+
 func isValidWorkflowFieldName(name string) []string {
 	var errs []string
 	if len(name) > workflowFieldMaxLength {
@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -1124,3 +1125,65 @@ func TestFailDAGArgParamValueFromPathInTask(t *testing.T) {
 	err := validate(logging.TestContext(t.Context()), failDagArgParamValueFromPathInTask)
 	require.ErrorContains(t, err, "valueFrom only allows: default, configMapKeyRef and supplied")
 }
+
+var dagFromOutput = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: dag-from-output-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: generate
+        template: generate
+      - name: other
+        template: generate
+      - name: consume
+        template: consume
+        depends: generate
+        arguments:
+          parameters:
+          - name: message
+            fromOutput:
+              step: %s
+              parameter: %s
+  - name: generate
+    container:
+      image: alpine:latest
+      command: [sh, -c, "echo hello > /tmp/message"]
+    outputs:
+      parameters:
+      - name: message
+        valueFrom:
+          path: /tmp/message
+  - name: consume
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: alpine:latest
+      command: [echo, "{{inputs.parameters.message}}"]
+`
+
+func TestDAGFromOutput(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Valid", func(t *testing.T) {
+		err := validate(ctx, fmt.Sprintf(dagFromOutput, "generate", "message"))
+		require.NoError(t, err)
+	})
+	t.Run("MissingTask", func(t *testing.T) {
+		err := validate(ctx, fmt.Sprintf(dagFromOutput, "missing", "message"))
+		require.EqualError(t, err, "templates.main.tasks.consume.arguments.parameters.message.fromOutput task 'missing' does not exist")
+	})
+	t.Run("NotDependency", func(t *testing.T) {
+		err := validate(ctx, fmt.Sprintf(dagFromOutput, "other", "message"))
+		require.EqualError(t, err, "templates.main.tasks.consume.arguments.parameters.message.fromOutput task 'other' is not a dependency")
+	})
+	t.Run("MissingParameter", func(t *testing.T) {
+		err := validate(ctx, fmt.Sprintf(dagFromOutput, "generate", "missing"))
+		require.EqualError(t, err, "templates.main.tasks.consume.arguments.parameters.message.fromOutput task 'generate' has no output parameter 'missing'")
+	})
+}
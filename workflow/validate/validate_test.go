@@ -2,9 +2,11 @@ package validate
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-workflows/v3/util/logging"
 
@@ -12,6 +14,8 @@ import (
 	"github.com/stretchr/testify/require"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
@@ -985,6 +989,137 @@ func TestInvalidOutputParam(t *testing.T) {
 	require.ErrorContains(t, err, ".parameter or expression must be specified for Steps templates")
 }
 
+var outputParamFromAnnotation = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: output-param-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+    outputs:
+      parameters:
+      - name: outparam
+        valueFrom:
+          fromAnnotation:
+            annotation: %s
+`
+
+func TestOutputParamFromAnnotation(t *testing.T) {
+	err := validate(logging.TestContext(t.Context()), fmt.Sprintf(outputParamFromAnnotation, "my.annotation"))
+	require.NoError(t, err)
+
+	err = validate(logging.TestContext(t.Context()), fmt.Sprintf(outputParamFromAnnotation, `""`))
+	require.ErrorContains(t, err, "valueFrom.fromAnnotation.annotation must be specified")
+}
+
+var outputParamFormat = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: output-param-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+    outputs:
+      parameters:
+      - name: outparam
+        valueFrom:
+          %s
+          format: %s
+`
+
+func TestOutputParamFormat(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	for _, format := range []string{"trim", "base64", "base64url"} {
+		err := validate(ctx, fmt.Sprintf(outputParamFormat, "path: /tmp/out", format))
+		require.NoError(t, err)
+	}
+
+	err := validate(ctx, fmt.Sprintf(outputParamFormat, "fromAnnotation: {annotation: my.annotation}", "trim"))
+	require.NoError(t, err)
+
+	err = validate(ctx, fmt.Sprintf(outputParamFormat, "path: /tmp/out", "hex"))
+	require.ErrorContains(t, err, "valueFrom.format 'hex' is invalid")
+
+	err = validate(ctx, fmt.Sprintf(outputParamFormat, "expression: \"'foo'\"", "trim"))
+	require.ErrorContains(t, err, "valueFrom.format is only supported with path or fromAnnotation")
+}
+
+var outputParamFromArtifact = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: output-param-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+    outputs:
+      artifacts:
+      - name: report
+        path: /tmp/report.json
+      parameters:
+      - name: outparam
+        valueFrom:
+          artifactName: %s
+          %s
+`
+
+func TestOutputParamFromArtifact(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	err := validate(ctx, fmt.Sprintf(outputParamFromArtifact, "report", "jqFilter: .status.phase"))
+	require.NoError(t, err)
+
+	err = validate(ctx, fmt.Sprintf(outputParamFromArtifact, "missing", "jqFilter: .status.phase"))
+	require.ErrorContains(t, err, "valueFrom.artifactName 'missing' is not an input or output artifact of the template")
+
+	err = validate(ctx, fmt.Sprintf(outputParamFromArtifact, "report", "default: foo"))
+	require.ErrorContains(t, err, "valueFrom.artifactName requires jqFilter")
+}
+
+var inputParamDefaultExpression = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: default-expression-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: print
+        template: print
+  - name: print
+    inputs:
+      parameters:
+      - name: date
+        %s
+    container:
+      image: alpine:latest
+      args: ["{{inputs.parameters.date}}"]
+`
+
+func TestInputParamDefaultExpression(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	err := validate(ctx, fmt.Sprintf(inputParamDefaultExpression, "defaultExpression: 'sprig.trunc(10, workflow.creationTimestamp.RFC3339)'"))
+	require.NoError(t, err)
+
+	err = validate(ctx, fmt.Sprintf(inputParamDefaultExpression, "defaultExpression: 'sprig.trunc(10, '"))
+	require.ErrorContains(t, err, "failed to evaluate inputs.parameters.date.defaultExpression")
+
+	err = validate(ctx, fmt.Sprintf(inputParamDefaultExpression, "default: today\n        defaultExpression: workflow.name"))
+	require.ErrorContains(t, err, "templates.print.inputs.parameters.date cannot have both default and defaultExpression")
+}
+
 var multipleTemplateTypes = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -1445,6 +1580,332 @@ func TestSpecBadSequenceCountAndEnd(t *testing.T) {
 	require.Error(t, err)
 }
 
+var specSequenceGoTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: loops-sequence-
+spec:
+  entrypoint: loops-sequence
+  templates:
+  - name: loops-sequence
+    steps:
+    - - name: print-num
+        template: echo
+        arguments:
+          parameters:
+          - name: num
+            value: "{{item}}"
+        withSequence:
+          count: "10"
+          goTemplate: 'user-{{printf "%03d" .}}'
+  - name: echo
+    inputs:
+      parameters:
+      - name: num
+    container:
+      image: alpine:latest
+      command: [echo, "{{inputs.parameters.num}}"]
+`
+
+// TestSpecSequenceGoTemplate verifies goTemplate is accepted, but not together with format
+func TestSpecSequenceGoTemplate(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(specSequenceGoTemplate)
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{Lint: true})
+	require.NoError(t, err)
+
+	wf.Spec.Templates[0].Steps[0].Steps[0].WithSequence.Format = "user-%03d"
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{Lint: true})
+	require.EqualError(t, err, "templates.loops-sequence.steps[0].print-num only one of format or goTemplate can be defined in withSequence")
+}
+
+var scriptImageRef = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: script-image-ref-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    script:
+      imageRef:
+        clusterWorkflowTemplate: base-images
+        imageKey: python
+      command: [python]
+      source: print("hello")
+`
+
+// TestScriptImageRef verifies a script may omit image when it has an imageRef
+func TestScriptImageRef(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(scriptImageRef)
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.NoError(t, err)
+
+	wf.Spec.Templates[0].Script.ImageRef.ImageKey = ""
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.script.imageRef requires both clusterWorkflowTemplate and imageKey")
+}
+
+var scriptInterpreter = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: script-interpreter-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    script:
+      image: python:3.12
+      interpreter: python3
+      source: print("hello")
+`
+
+func TestScriptInterpreter(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(scriptInterpreter)
+	// the interpreters are not known outside the controller
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.NoError(t, err)
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{Interpreters: map[string][]string{"python3": {"/usr/bin/python3"}}})
+	require.NoError(t, err)
+
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{Interpreters: map[string][]string{"bash": {"/bin/bash"}}})
+	require.EqualError(t, err, `templates.main.script.interpreter "python3" is not one of the interpreters configured in the controller`)
+
+	wf.Spec.Templates[0].Script.Command = []string{"python"}
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.script.interpreter may not be used together with command")
+}
+
+var outputGlobPath = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: output-glob-path-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: alpine:latest
+      command: [sh, -c, "mkdir -p /out/a && touch /out/a/1.txt"]
+    outputs:
+      artifacts:
+      - name: reports
+        globPath: /out/*/*.txt
+`
+
+// TestOutputGlobPath verifies globPath is accepted, but not together with path
+func TestOutputGlobPath(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(outputGlobPath)
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.NoError(t, err)
+
+	wf.Spec.Templates[0].Outputs.Artifacts[0].Path = "/out"
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.outputs.artifacts.reports only one of path or globPath can be specified")
+
+	wf.Spec.Templates[0].Outputs.Artifacts[0].Path = ""
+	wf.Spec.Templates[0].Outputs.Artifacts[0].GlobPath = "/out/["
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.outputs.artifacts.reports.globPath '/out/[' is invalid: syntax error in pattern")
+}
+
+var outputPersistentVolumeClaim = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: output-pvc-
+spec:
+  entrypoint: main
+  volumes:
+  - name: workdir
+    persistentVolumeClaim:
+      claimName: my-pvc
+  templates:
+  - name: main
+    container:
+      image: alpine:latest
+      command: [sh, -c, "mkdir -p /mnt/work/out && touch /mnt/work/out/1.txt"]
+      volumeMounts:
+      - name: workdir
+        mountPath: /mnt/work
+    outputs:
+      artifacts:
+      - name: out
+        persistentVolumeClaim:
+          claimName: my-pvc
+          subPath: out
+`
+
+func TestOutputPersistentVolumeClaim(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(outputPersistentVolumeClaim)
+	require.NoError(t, ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{}))
+
+	wf.Spec.Templates[0].Outputs.Artifacts[0].Path = "/mnt/work/out"
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.outputs.artifacts.out only one of path, globPath, paths or persistentVolumeClaim can be specified")
+
+	wf = unmarshalWf(outputPersistentVolumeClaim)
+	wf.Spec.Templates[0].Outputs.Artifacts[0].PersistentVolumeClaim.ClaimName = ""
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.outputs.artifacts.out.persistentVolumeClaim.claimName is required")
+
+	wf = unmarshalWf(outputPersistentVolumeClaim)
+	wf.Spec.Templates[0].Outputs.Artifacts[0].PersistentVolumeClaim.SubPath = "../other"
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.outputs.artifacts.out.persistentVolumeClaim.subPath must not contain '..'")
+}
+
+var outputPaths = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: output-paths-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: alpine:latest
+      command: [sh, -c, "mkdir -p /out/reports /var/log/app && touch /out/reports/1.txt /var/log/app/1.log"]
+    outputs:
+      artifacts:
+      - name: results
+        paths: [/out/reports, /var/log/app]
+`
+
+// TestOutputPaths verifies paths is accepted, but not together with path
+func TestOutputPaths(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(outputPaths)
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.NoError(t, err)
+
+	wf.Spec.Templates[0].Outputs.Artifacts[0].Path = "/out"
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.outputs.artifacts.results only one of path, globPath or paths can be specified")
+
+	wf = unmarshalWf(outputPaths)
+	wf.Spec.Templates[0].Outputs.Artifacts[0].Paths = []string{"/out/app", "/var/log/app"}
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.outputs.artifacts.results.paths must have unique base names: 'app' is repeated")
+
+	wf = unmarshalWf(outputPaths)
+	wf.Spec.Templates[0].Outputs.Artifacts[0].Paths = []string{"/out/reports/../../etc"}
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.ErrorContains(t, err, "Directory traversal is not permitted")
+
+	wf = unmarshalWf(outputPaths)
+	wf.Spec.Templates[0].Outputs.Artifacts[0].Archive = &wfv1.ArchiveStrategy{None: &wfv1.NoneStrategy{}}
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.outputs.artifacts.results.paths requires the tar archive strategy")
+}
+
+var outputNamedPipe = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: output-named-pipe-
+spec:
+  entrypoint: main
+  volumes:
+  - name: pipes
+    emptyDir: {}
+  templates:
+  - name: main
+    container:
+      image: alpine:latest
+      command: [sh, -c, "seq 1000000 > /pipes/numbers.txt"]
+      volumeMounts:
+      - name: pipes
+        mountPath: /pipes
+    outputs:
+      artifacts:
+      - name: numbers
+        namedPipe:
+          path: /pipes/numbers.txt
+`
+
+// TestOutputNamedPipe verifies namedPipe is accepted for a pipe in a volume, but not together with path
+func TestOutputNamedPipe(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(outputNamedPipe)
+	require.NoError(t, ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{}))
+
+	wf.Spec.Templates[0].Outputs.Artifacts[0].Path = "/pipes/numbers.txt"
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.outputs.artifacts.numbers only one of path, globPath, paths, persistentVolumeClaim or namedPipe can be specified")
+
+	wf = unmarshalWf(outputNamedPipe)
+	wf.Spec.Templates[0].Outputs.Artifacts[0].NamedPipe.Path = "/tmp/numbers.txt"
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.outputs.artifacts.numbers.namedPipe.path '/tmp/numbers.txt' must be in a volume mounted by the container")
+
+	wf = unmarshalWf(outputNamedPipe)
+	wf.Spec.Templates[0].Outputs.Artifacts[0].Archive = &wfv1.ArchiveStrategy{Tar: &wfv1.TarStrategy{}}
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.outputs.artifacts.numbers.namedPipe requires the none archive strategy")
+
+	wf = unmarshalWf(outputNamedPipe)
+	wf.Spec.Templates[0].Inputs.Artifacts = []wfv1.Artifact{{Name: "in", Path: "/in", NamedPipe: &wfv1.NamedPipeArtifact{Path: "/pipes/in"}}}
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.inputs.artifacts.in.namedPipe not valid in inputs")
+}
+
+var inputUnpackTo = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: input-unpack-to-
+spec:
+  entrypoint: main
+  volumes:
+  - name: src
+    emptyDir: {}
+  templates:
+  - name: main
+    inputs:
+      artifacts:
+      - name: source
+        path: /tmp/source.tar.gz
+        unpackTo: /src/argo
+        http:
+          url: https://github.com/argoproj/argo-workflows/archive/refs/heads/main.tar.gz
+    container:
+      image: alpine:latest
+      command: [ls, /src/argo]
+      volumeMounts:
+      - name: src
+        mountPath: /src
+`
+
+// TestInputUnpackTo verifies unpackTo is accepted for a directory in a volume, and only for inputs
+func TestInputUnpackTo(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(inputUnpackTo)
+	require.NoError(t, ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{}))
+
+	wf.Spec.Templates[0].Inputs.Artifacts[0].UnpackTo = "/tmp/argo"
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.inputs.artifacts.source.unpackTo '/tmp/argo' must be in a volume mounted by the container")
+
+	wf.Spec.Templates[0].Inputs.Artifacts[0].UnpackTo = "/src/argo/../../etc"
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.ErrorContains(t, err, "error in templates.main.inputs.artifacts.source.unpackTo")
+
+	wf = unmarshalWf(inputUnpackTo)
+	wf.Spec.Templates[0].Outputs.Artifacts = []wfv1.Artifact{{Name: "out", Path: "/src/out", UnpackTo: "/src/argo"}}
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.outputs.artifacts.out.unpackTo not valid in outputs")
+}
+
 var customVariableInput = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -1687,6 +2148,86 @@ func TestInvalidResourceWorkflow(t *testing.T) {
 	require.EqualError(t, err, "templates.whalesay.resource.action must be one of: get, create, apply, delete, replace, patch")
 }
 
+var resourceFlagsWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: resource-flags-
+spec:
+  entrypoint: whalesay
+  arguments:
+    parameters:
+    - name: selector
+      value: app=whalesay
+  templates:
+  - name: whalesay
+    resource:
+      action: apply
+      flags: ["--server-side", "--field-manager=argo", "--force-conflicts", "-l", "{{workflow.parameters.selector}}"]
+      manifest: |
+        apiVersion: v1
+        kind: ConfigMap
+        metadata:
+          name: whalesay-cm
+`
+
+func TestResourceFlags(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(resourceFlagsWorkflow)
+	require.NoError(t, ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{}))
+
+	wf.Spec.Templates[0].Resource.Flags = append(wf.Spec.Templates[0].Resource.Flags, "--kubeconfig=/tmp/kubeconfig")
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, `templates.whalesay.resource.flags: flag "--kubeconfig" is not allowed`)
+}
+
+func TestResourceMergeStrategy(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(resourceFlagsWorkflow)
+	wf.Spec.Templates[0].Resource.MergeStrategy = "server-side"
+	require.NoError(t, ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{}))
+
+	wf.Spec.Templates[0].Resource.Action = "create"
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.whalesay.resource.mergeStrategy server-side can only be used with the apply and patch actions")
+
+	wf.Spec.Templates[0].Resource.MergeStrategy = "foo"
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.whalesay.resource.mergeStrategy must be one of: strategic, merge, json, server-side")
+}
+
+func TestResourceBackoff(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(resourceFlagsWorkflow)
+	wf.Spec.Templates[0].Resource.BackoffLimit = ptr.To(int32(2))
+	wf.Spec.Templates[0].Resource.Backoff = &wfv1.Backoff{Duration: "1s", Factor: ptr.To(intstr.FromInt32(2))}
+	require.NoError(t, ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{}))
+
+	wf.Spec.Templates[0].Resource.Backoff = &wfv1.Backoff{Duration: "1s", Cap: "1m"}
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.whalesay.resource.backoff: only duration and factor are supported")
+
+	wf.Spec.Templates[0].Resource.Backoff = nil
+	wf.Spec.Templates[0].Resource.BackoffLimit = ptr.To(int32(-1))
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.whalesay.resource.backoffLimit must not be negative")
+}
+
+func TestResourceWaitForResource(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(resourceFlagsWorkflow)
+	wf.Spec.Templates[0].Resource.WaitForResource = &wfv1.WaitForResource{JSONPath: ".status.phase", Value: "Active", Timeout: &metav1.Duration{Duration: time.Minute}}
+	require.NoError(t, ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{}))
+
+	wf.Spec.Templates[0].Resource.WaitForResource.PollInterval = &metav1.Duration{}
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.whalesay.resource.waitForResource.pollInterval must be positive")
+
+	wf.Spec.Templates[0].Resource.WaitForResource = &wfv1.WaitForResource{Value: "Active"}
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.whalesay.resource.waitForResource.jsonPath is required")
+}
+
 var invalidPodGC = `
 metadata:
   generateName: pod-gc-strategy-unknown-
@@ -1707,6 +2248,90 @@ func TestIncorrectPodGCStrategy(t *testing.T) {
 	require.EqualError(t, err, "podGC.strategy unknown strategy 'Foo'")
 }
 
+func TestInvalidPodGCKeepFailedPodDuration(t *testing.T) {
+	wf := unmarshalWf(invalidPodGC)
+	wf.Spec.PodGC = &wfv1.PodGC{Strategy: wfv1.PodGCOnPodFailure, KeepFailedPodDuration: "1x"}
+	err := ValidateWorkflow(logging.TestContext(t.Context()), wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.ErrorContains(t, err, "podGC.keepFailedPodDuration invalid: ")
+}
+
+func TestPodNameFormat(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(resourceFlagsWorkflow)
+	wf.Spec.PodNameFormat = "acme-{{workflow.name}}-{{template.name}}-{{node.id}}"
+	require.NoError(t, ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{}))
+
+	wf.Spec.PodNameFormat = "acme-{{workflow.uid}}"
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "podNameFormat has unknown token '{{workflow.uid}}', must be one of {{workflow.name}}, {{template.name}} or {{node.id}}")
+
+	wf.Spec.PodNameFormat = "Acme_{{workflow.name}}"
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "podNameFormat 'Acme_{{workflow.name}}' must only contain lower case alphanumeric characters, '-' and tokens")
+}
+
+var overrideCommandWorkflow = `
+metadata:
+  generateName: override-command-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: trace
+        template: trace
+  - name: trace
+    overrideCommand: [strace, -f]
+    allowOverrideCommand: true
+    container:
+      image: alpine
+      command: [echo, hello]
+`
+
+func TestOverrideCommand(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(overrideCommandWorkflow)
+	require.NoError(t, ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{}))
+
+	wf.Spec.Templates[1].AllowOverrideCommand = false
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.steps[0].trace templates.trace.overrideCommand requires allowOverrideCommand to be true")
+
+	wf = unmarshalWf(overrideCommandWorkflow)
+	wf.Spec.Templates[0].OverrideCommand = []string{"strace"}
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.overrideCommand is only valid for container, containerSet and script templates")
+
+	wf = unmarshalWf(overrideCommandWorkflow)
+	wf.Spec.Templates[1].Container = nil
+	wf.Spec.Templates[1].Suspend = &wfv1.SuspendTemplate{}
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.steps[0].trace templates.trace.overrideCommand is only valid for container, containerSet and script templates")
+}
+
+func TestScriptPreprocessors(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	wf := unmarshalWf(`
+metadata:
+  generateName: script-preprocessors-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    script:
+      image: alpine
+      command: [sh]
+      source: echo hello
+      preprocessors:
+      - command: [sops, --decrypt, /dev/stdin]
+`)
+	require.NoError(t, ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{}))
+
+	wf.Spec.Templates[0].Script.Preprocessors = append(wf.Spec.Templates[0].Script.Preprocessors, wfv1.Preprocessor{})
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.script.preprocessors[1].command is required")
+}
+
 func TestInvalidPodGCLabelSelector(t *testing.T) {
 	wf := unmarshalWf(`
 metadata:
@@ -1726,6 +2351,143 @@ spec:
 	require.EqualError(t, err, "podGC.labelSelector invalid: \"InvalidOperator\" is not a valid label selector operator")
 }
 
+func TestRuntimeClassName(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Valid", func(t *testing.T) {
+		wf := unmarshalWf(`
+metadata:
+  generateName: runtime-class-
+spec:
+  runtimeClassName: gvisor
+  entrypoint: main
+  templates:
+  - name: main
+    runtimeClassName: kata
+    container:
+      image: docker/whalesay
+`)
+		err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+		require.NoError(t, err)
+	})
+	t.Run("InvalidWorkflow", func(t *testing.T) {
+		wf := unmarshalWf(`
+metadata:
+  generateName: runtime-class-
+spec:
+  runtimeClassName: gVisor.Sandbox
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay
+`)
+		err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+		require.ErrorContains(t, err, "spec.runtimeClassName 'gVisor.Sandbox' is invalid")
+	})
+	t.Run("InvalidTemplate", func(t *testing.T) {
+		wf := unmarshalWf(`
+metadata:
+  generateName: runtime-class-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    runtimeClassName: Kata_Containers
+    container:
+      image: docker/whalesay
+`)
+		err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+		require.ErrorContains(t, err, "templates.main.runtimeClassName 'Kata_Containers' is invalid")
+	})
+}
+
+func TestInputArtifactFromImageLabel(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	for _, tt := range []struct {
+		name     string
+		artifact string
+		err      string
+	}{
+		{name: "Valid", artifact: "fromImageLabel: {label: org.example.artifact-url}"},
+		{name: "ValidHTTP", artifact: "fromImageLabel: {label: org.example.artifact-url}\n        http: {headers: [{name: Accept, value: '*/*'}]}"},
+		{name: "ValidOtherImage", artifact: "fromImageLabel: {image: my-image, label: org.example.artifact-url}"},
+		{name: "NoLabel", artifact: "fromImageLabel: {image: my-image}", err: "templates.main.inputs.artifacts.bin.fromImageLabel.label is required"},
+		{name: "S3", artifact: "fromImageLabel: {label: org.example.artifact-url}\n        s3: {key: my-key}", err: "templates.main.inputs.artifacts.bin.fromImageLabel can only be combined with an http or artifactory location"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			wf := unmarshalWf(fmt.Sprintf(`
+metadata:
+  generateName: image-label-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    inputs:
+      artifacts:
+      - name: bin
+        path: /bin/tool
+        %s
+    container:
+      image: docker/whalesay
+`, tt.artifact))
+			err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestDataTemplateSource(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	for _, tt := range []struct {
+		name   string
+		source string
+		err    string
+	}{
+		{name: "ArtifactPaths", source: "artifactPaths: {s3: {bucket: test}}"},
+		{name: "ParameterName", source: "parameterName: numbers"},
+		{name: "ArtifactName", source: "artifactName: numbers-file"},
+		{name: "NoSource", source: "{}", err: "templates.main.data.source must specify exactly one of artifactPaths, parameterName or artifactName"},
+		{name: "MultipleSources", source: "{parameterName: numbers, artifactName: numbers-file}", err: "templates.main.data.source must specify exactly one of artifactPaths, parameterName or artifactName"},
+		{name: "UnknownParameter", source: "parameterName: other", err: "templates.main.data.source.parameterName refers to a non-existent input parameter other"},
+		{name: "UnknownArtifact", source: "artifactName: other", err: "templates.main.data.source.artifactName refers to a non-existent input artifact other"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			wf := unmarshalWf(fmt.Sprintf(`
+metadata:
+  generateName: data-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: numbers
+        value: "[1, 2, 3]"
+      artifacts:
+      - name: numbers-file
+        path: /tmp/numbers.json
+        raw:
+          data: "[1, 2, 3]"
+    data:
+      source:
+        %s
+      transformation:
+      - expression: "filter(data, {# > 1})"
+`, tt.source))
+			err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
 var allowPlaceholderInVariableTakenFromInputs = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -2008,29 +2770,68 @@ func TestInvalidMetricHelp(t *testing.T) {
 	require.EqualError(t, err, "templates.whalesay metric 'metric_name' must contain a help string under 'help: ' field")
 }
 
-var invalidRealtimeMetricGauge = `
+var invalidRealtimeMetricGauge = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: hello-world-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    metrics:
+      prometheus:
+        - name: metric_name
+          help: please
+          gauge:
+            realtime: true
+            value: "{{resourcesDuration.cpu}}/{{resourcesDuration.memory}}"
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestInvalidMetricGauge(t *testing.T) {
+	err := validate(logging.TestContext(t.Context()), invalidRealtimeMetricGauge)
+	require.EqualError(t, err, "templates.whalesay metric 'metric_name' error: 'resourcesDuration.*' metrics cannot be used in real-time")
+}
+
+var invalidMetricPushInterval = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
 metadata:
   generateName: hello-world-
 spec:
   entrypoint: whalesay
+  metrics:
+    prometheus:
+      - name: workflow_metric
+        help: please
+        pushInterval: 1m
+        histogram:
+          value: "{{workflow.duration}}"
+          buckets: [1]
   templates:
   - name: whalesay
     metrics:
       prometheus:
         - name: metric_name
           help: please
-          gauge:
-            realtime: true
-            value: "{{resourcesDuration.cpu}}/{{resourcesDuration.memory}}"
+          pushInterval: 1m
+          counter:
+            value: "1"
     container:
       image: docker/whalesay:latest
 `
 
-func TestInvalidMetricGauge(t *testing.T) {
-	err := validate(logging.TestContext(t.Context()), invalidRealtimeMetricGauge)
-	require.EqualError(t, err, "templates.whalesay metric 'metric_name' error: 'resourcesDuration.*' metrics cannot be used in real-time")
+func TestInvalidMetricPushInterval(t *testing.T) {
+	wf := unmarshalWf(invalidMetricPushInterval)
+	ctx := logging.TestContext(t.Context())
+	err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.whalesay metric 'metric_name' pushInterval is only valid in spec.metrics")
+
+	wf.Spec.Templates[0].Metrics = nil
+	err = ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "spec.metrics metric 'workflow_metric' error: pushInterval can only be used with counters and non-realtime gauges")
 }
 
 var invalidNoValueMetricGauge = `
@@ -2197,6 +2998,65 @@ func TestWorkflowWithWFTRefWithEntrypoint(t *testing.T) {
 	require.NoError(t, err)
 }
 
+const wfTemplateForEntrypointRef = `
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: entrypoint-ref-target
+  namespace: default
+spec:
+  entrypoint: A
+  arguments:
+    parameters:
+    - name: message
+      value: hello
+  templates:
+  - name: A
+    steps:
+    - - name: B
+        template: B
+  - name: B
+    container:
+      image: alpine:latest
+      command: [echo, "{{workflow.parameters.message}}"]
+`
+
+func TestWorkflowWithEntrypointRef(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	err := createWorkflowTemplateFromSpec(ctx, wfTemplateForEntrypointRef)
+	require.NoError(t, err)
+	for _, tt := range []struct {
+		name string
+		spec string
+		err  string
+	}{
+		{name: "Valid", spec: "entrypointRef: {workflowTemplate: entrypoint-ref-target, template: B}"},
+		{name: "ValidOverride", spec: "entrypointRef: {workflowTemplate: entrypoint-ref-target, template: A}\n  templates:\n  - name: B\n    container: {image: alpine:latest, command: [echo, bye]}"},
+		{name: "UnknownTemplate", spec: "entrypointRef: {workflowTemplate: entrypoint-ref-target, template: C}", err: "template reference entrypoint-ref-target.C not found"},
+		{name: "UnknownWorkflowTemplate", spec: "entrypointRef: {workflowTemplate: unknown, template: A}", err: `workflowtemplates.argoproj.io "unknown" not found`},
+		{name: "WithEntrypoint", spec: "entrypoint: A\n  entrypointRef: {workflowTemplate: entrypoint-ref-target, template: A}", err: "spec.entrypointRef may not be used together with spec.entrypoint"},
+		{name: "WithWorkflowTemplateRef", spec: "workflowTemplateRef: {name: entrypoint-ref-target}\n  entrypointRef: {workflowTemplate: entrypoint-ref-target, template: A}", err: "spec.entrypointRef may not be used together with spec.workflowTemplateRef"},
+		{name: "NoTemplate", spec: "entrypointRef: {workflowTemplate: entrypoint-ref-target}", err: "spec.entrypointRef.workflowTemplate and spec.entrypointRef.template are required"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate(ctx, fmt.Sprintf(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: entrypoint-ref-
+  namespace: default
+spec:
+  %s
+`, tt.spec))
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
 const wfWithWFTRef = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -3238,6 +4098,12 @@ func TestInitContainerHasName(t *testing.T) {
 	require.EqualError(t, err, "templates.main.tasks.spurious initContainers must all have container name")
 }
 
+func TestInitContainerReservedName(t *testing.T) {
+	wf := unmarshalWf(strings.Replace(testInitContainerHasName, "# name: sleep", "name: wait", 1))
+	err := ValidateWorkflow(logging.TestContext(t.Context()), wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+	require.EqualError(t, err, "templates.main.tasks.spurious initContainers.wait uses a container name reserved by Argo")
+}
+
 var nodeNamePlumbsCorrectly = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -3365,3 +4231,435 @@ func TestShouldCheckValidationToSpacedParameters(t *testing.T) {
 	// Do not allow leading or trailing spaces in parameters
 	require.ErrorContains(t, err, "failed to resolve {{  workflow.thisdoesnotexist  }}")
 }
+
+var dagTaskPhaseHooks = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: dag-task-phase-hooks-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: A
+        template: produce
+        onSuccess:
+          template: notify
+          arguments:
+            parameters:
+            - name: message
+              value: "{{tasks.A.outputs.result}}"
+        onFailure:
+          template: notify
+          arguments:
+            parameters:
+            - name: message
+              value: "{{tasks.A.status}}"
+  - name: produce
+    script:
+      image: alpine:3.18
+      command: [sh]
+      source: echo hello
+  - name: notify
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: alpine:3.18
+      command: [echo, "{{inputs.parameters.message}}"]
+`
+
+func TestDAGTaskPhaseHooks(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, dagTaskPhaseHooks))
+	t.Run("Expression", func(t *testing.T) {
+		wf := unmarshalWf(dagTaskPhaseHooks)
+		wf.Spec.Templates[0].DAG.Tasks[0].OnSuccess.Expression = "true"
+		err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+		require.EqualError(t, err, "templates.main.tasks.A onSuccess.expression is not supported, the hook is triggered by the phase of the task")
+	})
+	t.Run("UndefinedTemplate", func(t *testing.T) {
+		wf := unmarshalWf(dagTaskPhaseHooks)
+		wf.Spec.Templates[0].DAG.Tasks[0].OnFailure.Template = "missing"
+		err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+		require.EqualError(t, err, "templates.main.tasks.A onFailure template name 'missing' undefined")
+	})
+	t.Run("HookNameConflict", func(t *testing.T) {
+		wf := unmarshalWf(dagTaskPhaseHooks)
+		wf.Spec.Templates[0].DAG.Tasks[0].Hooks = wfv1.LifecycleHooks{wfv1.SuccessLifecycleEvent: {Template: "notify", Expression: "true"}}
+		err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+		require.EqualError(t, err, "templates.main.tasks.A onSuccess cannot be combined with hooks.onSuccess")
+	})
+}
+
+var deadlinePriorityEscalation = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: deadline-priority-escalation-
+spec:
+  entrypoint: main
+  activeDeadlineSeconds: 600
+  templates:
+  - name: main
+    container:
+      image: alpine:3.18
+`
+
+func TestDeadlinePriorityEscalation(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	for _, tt := range []struct {
+		name      string
+		className string
+		threshold string
+		err       string
+	}{
+		{"Valid", "critical", "2m", ""},
+		{"Seconds", "critical", "120", ""},
+		{"MissingThreshold", "critical", "", "spec.deadlinePriorityClassName and spec.deadlinePriorityEscalationThreshold must be set together"},
+		{"MissingClassName", "", "2m", "spec.deadlinePriorityClassName and spec.deadlinePriorityEscalationThreshold must be set together"},
+		{"Invalid", "critical", "soon", `spec.deadlinePriorityEscalationThreshold invalid: unable to parse soon as a duration: time: invalid duration "soon"`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			wf := unmarshalWf(deadlinePriorityEscalation)
+			wf.Spec.DeadlinePriorityClassName = tt.className
+			wf.Spec.DeadlinePriorityEscalationThreshold = tt.threshold
+			err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+var restrictedClusterWorkflowTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: ClusterWorkflowTemplate
+metadata:
+  name: restricted-cluster-template
+spec:
+  entrypoint: main
+  allowedNamespaces: [allowed]
+  templates:
+  - name: main
+    container:
+      image: alpine:latest
+`
+
+var workflowTemplateRefRestricted = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: restricted-
+spec:
+  workflowTemplateRef:
+    name: restricted-cluster-template
+    clusterScope: true
+`
+
+var templateRefRestricted = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: restricted-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: step
+        templateRef:
+          name: restricted-cluster-template
+          template: main
+          clusterScope: true
+`
+
+func TestClusterWorkflowTemplateAllowedNamespaces(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	cwftmpl := wfv1.MustUnmarshalClusterWorkflowTemplate(restrictedClusterWorkflowTemplate)
+	_, err := wfClientset.ArgoprojV1alpha1().ClusterWorkflowTemplates().Create(ctx, cwftmpl, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	for name, spec := range map[string]string{"WorkflowTemplateRef": workflowTemplateRefRestricted, "TemplateRef": templateRefRestricted} {
+		t.Run(name, func(t *testing.T) {
+			wf := unmarshalWf(spec)
+			wf.Namespace = "allowed"
+			require.NoError(t, ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{}))
+
+			wf.Namespace = "denied"
+			err := ValidateWorkflow(ctx, wftmplGetter, cwftmplGetter, wf, nil, ValidateOpts{})
+			require.ErrorContains(t, err, "cluster workflow template restricted-cluster-template may not be used in namespace denied")
+		})
+	}
+}
+
+var stepsFromOutput = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: steps-from-output-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: generate
+        template: generate
+    - - name: consume
+        template: consume
+        arguments:
+          parameters:
+          - name: message
+            fromOutput:
+              step: %s
+              parameter: %s
+  - name: generate
+    container:
+      image: alpine:latest
+      command: [sh, -c, "echo hello > /tmp/message"]
+    outputs:
+      parameters:
+      - name: message
+        valueFrom:
+          path: /tmp/message
+  - name: consume
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: alpine:latest
+      command: [echo, "{{inputs.parameters.message}}"]
+`
+
+func TestStepsFromOutput(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Valid", func(t *testing.T) {
+		err := validate(ctx, fmt.Sprintf(stepsFromOutput, "generate", "message"))
+		require.NoError(t, err)
+	})
+	t.Run("MissingStep", func(t *testing.T) {
+		err := validate(ctx, fmt.Sprintf(stepsFromOutput, "missing", "message"))
+		require.EqualError(t, err, "templates.main.steps[1].consume.arguments.parameters.message.fromOutput step 'missing' does not exist in a previous step group")
+	})
+	t.Run("SameStep", func(t *testing.T) {
+		err := validate(ctx, fmt.Sprintf(stepsFromOutput, "consume", "message"))
+		require.EqualError(t, err, "templates.main.steps[1].consume.arguments.parameters.message.fromOutput step 'consume' does not exist in a previous step group")
+	})
+	t.Run("MissingParameter", func(t *testing.T) {
+		err := validate(ctx, fmt.Sprintf(stepsFromOutput, "generate", "missing"))
+		require.EqualError(t, err, "templates.main.steps[1].consume.arguments.parameters.message.fromOutput step 'generate' has no output parameter 'missing'")
+	})
+}
+
+var fromOutputInInputs = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: from-output-in-inputs-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: message
+        fromOutput:
+          step: generate
+          parameter: message
+    container:
+      image: alpine:latest
+`
+
+func TestFromOutputInInputs(t *testing.T) {
+	err := validate(logging.TestContext(t.Context()), fromOutputInInputs)
+	require.EqualError(t, err, "templates.main.inputs.parameters.message.fromOutput is only allowed in the arguments of a step or DAG task")
+}
+
+var containerTTY = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: container-tty-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    %s:
+      image: python:alpine3.6
+      tty: true
+      stdin: %t
+`
+
+func TestContainerTTY(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	for _, templateType := range []string{"container", "script"} {
+		t.Run(templateType, func(t *testing.T) {
+			err := validate(ctx, fmt.Sprintf(containerTTY, templateType, true))
+			require.NoError(t, err)
+			err = validate(ctx, fmt.Sprintf(containerTTY, templateType, false))
+			require.EqualError(t, err, fmt.Sprintf("templates.main.%s.tty requires stdin to be true", templateType))
+		})
+	}
+}
+
+var withParamArtifactSteps = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: with-param-artifact-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: gen
+        template: gen
+    - - name: fan
+        template: print
+        arguments:
+          parameters:
+          - name: item
+            value: "{{item}}"
+%s
+  - name: gen
+    container:
+      image: alpine:latest
+    outputs:
+      artifacts:
+      - name: items
+        path: /tmp/items.json
+  - name: print
+    inputs:
+      parameters:
+      - name: item
+    container:
+      image: alpine:latest
+`
+
+var withParamArtifactDAG = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: with-param-artifact-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: gen
+        template: gen
+      - name: other
+        template: gen
+      - name: fan
+        depends: gen
+        template: print
+        arguments:
+          parameters:
+          - name: item
+            value: "{{item}}"
+%s
+  - name: gen
+    container:
+      image: alpine:latest
+    outputs:
+      artifacts:
+      - name: items
+        path: /tmp/items.json
+  - name: print
+    inputs:
+      parameters:
+      - name: item
+    container:
+      image: alpine:latest
+`
+
+func TestWithParamArtifact(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	t.Run("Steps", func(t *testing.T) {
+		for name, tt := range map[string]struct {
+			fields string
+			err    string
+		}{
+			"Valid":         {fields: "withParamArtifact: {step: gen, artifact: items}"},
+			"WithParam":     {fields: "withParamArtifact: {step: gen, artifact: items}\n        withParam: '[1]'", err: "templates.main.steps[1].fan only one of withItems, withParam, withParamArtifact, withSequence can be specified"},
+			"NoArtifact":    {fields: "withParamArtifact: {step: gen}", err: "templates.main.steps[1].fan withParamArtifact.artifact is required"},
+			"Task":          {fields: "withParamArtifact: {task: gen, artifact: items}", err: "templates.main.steps[1].fan.withParamArtifact.step is required in a steps template"},
+			"UnknownStep":   {fields: "withParamArtifact: {step: fan, artifact: items}", err: "templates.main.steps[1].fan.withParamArtifact references 'steps.fan.outputs.artifacts.items', which is not an output artifact of a previous step"},
+			"UnknownOutput": {fields: "withParamArtifact: {step: gen, artifact: other}", err: "templates.main.steps[1].fan.withParamArtifact references 'steps.gen.outputs.artifacts.other', which is not an output artifact of a previous step"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				err := validate(ctx, fmt.Sprintf(withParamArtifactSteps, "        "+tt.fields))
+				if tt.err == "" {
+					require.NoError(t, err)
+				} else {
+					require.EqualError(t, err, tt.err)
+				}
+			})
+		}
+	})
+	t.Run("DAG", func(t *testing.T) {
+		for name, tt := range map[string]struct {
+			fields string
+			err    string
+		}{
+			"Valid":         {fields: "withParamArtifact: {task: gen, artifact: items}"},
+			"Step":          {fields: "withParamArtifact: {step: gen, artifact: items}", err: "templates.main.tasks.fan.withParamArtifact.task is required in a DAG template"},
+			"NotDependency": {fields: "withParamArtifact: {task: other, artifact: items}", err: "templates.main.tasks.fan.withParamArtifact references 'tasks.other.outputs.artifacts.items', which is not an output artifact of a dependency"},
+			"UnknownOutput": {fields: "withParamArtifact: {task: gen, artifact: other}", err: "templates.main.tasks.fan.withParamArtifact references 'tasks.gen.outputs.artifacts.other', which is not an output artifact of a dependency"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				err := validate(ctx, fmt.Sprintf(withParamArtifactDAG, "        "+tt.fields))
+				if tt.err == "" {
+					require.NoError(t, err)
+				} else {
+					require.EqualError(t, err, tt.err)
+				}
+			})
+		}
+	})
+}
+
+var templateInputEnum = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: template-input-enum-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: color
+      value: %s
+  templates:
+  - name: main
+    steps:
+    - - name: paint
+        template: paint
+        arguments:
+          parameters:
+          - name: color
+            value: "{{workflow.parameters.color}}"
+  - name: paint
+    inputs:
+      parameters:
+      - name: color
+        enum: [red, green]
+    container:
+      image: alpine:latest
+`
+
+func TestTemplateInputEnum(t *testing.T) {
+	ctx := logging.TestContext(t.Context())
+	require.NoError(t, validate(ctx, fmt.Sprintf(templateInputEnum, "red")))
+	err := validate(ctx, fmt.Sprintf(templateInputEnum, "blue"))
+	require.EqualError(t, err, "templates.main.steps[0].paint templates.paint inputs.parameters.color value 'blue' is not one of the allowed values: red, green")
+	// the arguments of a workflow template's entrypoint are not known until it is submitted
+	wftmpl := strings.Replace(fmt.Sprintf(templateInputEnum, "red"), "kind: Workflow", "kind: WorkflowTemplate", 1)
+	err = validateWorkflowTemplate(ctx, strings.Replace(wftmpl, "entrypoint: main", "entrypoint: paint", 1), ValidateOpts{})
+	require.NoError(t, err)
+}